@@ -47,3 +47,19 @@ func (cs *AdminContractService) Call(req *http.Request, args *requester.Params,
 
 	return wrapCall(ctx, cs.runner, cs.allowedMethods, req, args, requestBody, result)
 }
+
+// CallBatch makes several contract calls, that are signed separately and checked with a single seed.
+// Calls are executed one by one, if one of them fails, the rest are skipped.
+func (cs *AdminContractService) CallBatch(req *http.Request, args *requester.BatchParams, requestBody *rpc.RequestBody, result *requester.BatchResult) error {
+	ctx, instr := instrumenter.NewMethodInstrument("AdminContractService.callBatch")
+	defer instr.End()
+
+	inslogger.FromContext(ctx).WithFields(map[string]interface{}{
+		"calls":   len(args.Calls),
+		"uri":     req.RequestURI,
+		"service": "AdminContractService",
+		"seed":    args.Seed,
+	}).Infof("Incoming batch request")
+
+	return wrapBatchCall(ctx, cs.runner, cs.allowedMethods, req, args, requestBody, result)
+}
@@ -0,0 +1,327 @@
+// Copyright 2020 Insolar Network Ltd.
+// All rights reserved.
+// This material is licensed under the Insolar License version 1.0,
+// available at https://github.com/insolar/insolar/blob/master/LICENSE.md.
+
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/insolar/rpc/v2"
+	"github.com/insolar/rpc/v2/json2"
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/api/instrumenter"
+	"github.com/insolar/insolar/api/requester"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+)
+
+// maxBatchSize limits number of requests in JSON-RPC batch and number of calls in contract.callBatch.
+const maxBatchSize = 100
+
+// errBatchCallSkipped is returned for batch calls, that weren't executed because one of the previous calls failed.
+var errBatchCallSkipped = errors.New("call is skipped: previous call of the batch failed")
+
+// BatchHandler handles JSON-RPC 2.0 batches: requests sent as an array are served one by one
+// by the next handler and their responses are returned as an array in the same order.
+// Single requests are passed to the next handler as is.
+type BatchHandler struct {
+	next http.Handler
+}
+
+func NewBatchHandler(next http.Handler) *BatchHandler {
+	return &BatchHandler{next: next}
+}
+
+func (bh *BatchHandler) ServeHTTP(w http.ResponseWriter, httpReq *http.Request) {
+	body, err := ioutil.ReadAll(httpReq.Body)
+	if err != nil {
+		writeJSONRPCError(w, InvalidRequestError, InvalidRequestErrorMessage, "failed to read request body")
+		return
+	}
+
+	trimmed := bytes.TrimSpace(body)
+	if len(trimmed) == 0 || trimmed[0] != '[' {
+		httpReq.Body = ioutil.NopCloser(bytes.NewBuffer(body))
+		bh.next.ServeHTTP(w, httpReq)
+		return
+	}
+
+	var batch []json.RawMessage
+	if err := json.Unmarshal(trimmed, &batch); err != nil {
+		writeJSONRPCError(w, ParseError, ParseErrorMessage, err.Error())
+		return
+	}
+	if len(batch) == 0 {
+		writeJSONRPCError(w, InvalidRequestError, InvalidRequestErrorMessage, "batch is empty")
+		return
+	}
+	if len(batch) > maxBatchSize {
+		writeJSONRPCError(w, InvalidRequestError, InvalidRequestErrorMessage,
+			fmt.Sprintf("batch is too large: %d requests, max %d", len(batch), maxBatchSize))
+		return
+	}
+
+	responses := make([]json.RawMessage, len(batch))
+	wg := sync.WaitGroup{}
+	wg.Add(len(batch))
+	for i := range batch {
+		go func(i int) {
+			defer wg.Done()
+			responses[i] = bh.serveOne(httpReq, batch[i])
+		}(i)
+	}
+	wg.Wait()
+
+	// notifications have no responses
+	result := make([]json.RawMessage, 0, len(responses))
+	for _, resp := range responses {
+		if len(resp) != 0 {
+			result = append(result, resp)
+		}
+	}
+	if len(result) == 0 {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	err = json.NewEncoder(w).Encode(result)
+	if err != nil {
+		inslogger.FromContext(httpReq.Context()).Error(errors.Wrap(err, "failed to encode batch response"))
+	}
+}
+
+// serveOne serves a single request of the batch and returns its response.
+func (bh *BatchHandler) serveOne(httpReq *http.Request, body json.RawMessage) json.RawMessage {
+	req := httpReq.Clone(httpReq.Context())
+	req.Body = ioutil.NopCloser(bytes.NewReader(body))
+	req.ContentLength = int64(len(body))
+
+	rw := &batchResponseWriter{header: http.Header{}}
+	bh.next.ServeHTTP(rw, req)
+
+	resp := bytes.TrimSpace(rw.body.Bytes())
+	if len(resp) == 0 || json.Valid(resp) {
+		return resp
+	}
+
+	// next handler replied with a plain text error, wrap it into JSON-RPC response
+	var id struct {
+		ID *json.RawMessage `json:"id"`
+	}
+	_ = json.Unmarshal(body, &id)
+	res, err := json.Marshal(jsonRPCErrorResponse{
+		Version: "2.0",
+		Error: &json2.Error{
+			Code:    InvalidRequestError,
+			Message: InvalidRequestErrorMessage,
+			Data: requester.Data{
+				Trace: []string{string(resp)},
+			},
+		},
+		ID: id.ID,
+	})
+	if err != nil {
+		inslogger.FromContext(httpReq.Context()).Error(errors.Wrap(err, "failed to encode batch element response"))
+		return nil
+	}
+	return res
+}
+
+// batchResponseWriter buffers response of a single request of the batch.
+type batchResponseWriter struct {
+	header http.Header
+	body   bytes.Buffer
+}
+
+func (w *batchResponseWriter) Header() http.Header {
+	return w.header
+}
+
+func (w *batchResponseWriter) Write(data []byte) (int, error) {
+	return w.body.Write(data)
+}
+
+func (w *batchResponseWriter) WriteHeader(int) {}
+
+func writeJSONRPCError(w http.ResponseWriter, code json2.ErrorCode, message string, trace string) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	_ = json.NewEncoder(w).Encode(jsonRPCErrorResponse{
+		Version: "2.0",
+		Error: &json2.Error{
+			Code:    code,
+			Message: message,
+			Data: requester.Data{
+				Trace: []string{trace},
+			},
+		},
+		ID: nil,
+	})
+}
+
+func wrapBatchCall(ctx context.Context, runner *Runner, allowedMethods map[string]bool, req *http.Request, args *requester.BatchParams, requestBody *rpc.RequestBody, result *requester.BatchResult) error {
+	instr := instrumenter.GetInstrumenter(ctx)
+	traceID := instr.TraceID()
+	logger := inslogger.FromContext(ctx)
+
+	if !runner.isAvailable(ctx) {
+		logger.Error("API is not available")
+
+		instr.SetError(errors.New(ServiceUnavailableErrorMessage), ServiceUnavailableErrorShort)
+		return &json2.Error{
+			Code:    ServiceUnavailableError,
+			Message: ServiceUnavailableErrorMessage,
+			Data: requester.Data{
+				TraceID: traceID,
+			},
+		}
+	}
+
+	if len(args.Calls) == 0 || len(args.Calls) > maxBatchSize {
+		err := errors.Errorf("batch must contain from 1 to %d calls, got %d", maxBatchSize, len(args.Calls))
+		instr.SetError(err, InvalidParamsErrorShort)
+		return &json2.Error{
+			Code:    InvalidParamsError,
+			Message: InvalidParamsErrorMessage,
+			Data: requester.Data{
+				Trace:   []string{err.Error()},
+				TraceID: traceID,
+			},
+		}
+	}
+
+	requests := make([]requester.ContractRequest, len(args.Calls))
+	for i, call := range args.Calls {
+		err := parseBatchCall(call, args.Seed, &requests[i])
+		if err != nil {
+			err = errors.Wrapf(err, "call %d", i)
+			logger.Warn("invalid batch call: ", err.Error())
+			instr.SetError(err, InvalidParamsErrorShort)
+			return &json2.Error{
+				Code:    InvalidParamsError,
+				Message: InvalidParamsErrorMessage,
+				Data: requester.Data{
+					Trace:   []string{err.Error()},
+					TraceID: traceID,
+				},
+			}
+		}
+
+		callSite := requests[i].Params.CallSite
+		if _, ok := allowedMethods[callSite]; !ok {
+			logger.Warnf("CallSite '%s' is not in list of allowed methods", callSite)
+			instr.SetError(errors.New(MethodNotFoundErrorMessage), MethodNotFoundErrorShort)
+			return &json2.Error{
+				Code:    MethodNotFoundError,
+				Message: MethodNotFoundErrorMessage,
+				Data: requester.Data{
+					Trace:   []string{fmt.Sprintf("call site '%s' is not available", callSite)},
+					TraceID: traceID,
+				},
+			}
+		}
+		if requests[i].Params.Test != "" {
+			logger.Infof("ContractRequest related to %s", requests[i].Params.Test)
+		}
+	}
+
+	// signatures of the calls are checked by the contract, the signature of the batch only protects the envelope
+	_, err := validateRequestHeaders(req.Header.Get(requester.Digest), req.Header.Get(requester.Signature), requestBody.Raw)
+	if err != nil {
+		logger.Warn("validateRequestHeaders return error: ", err.Error())
+		instr.SetError(err, InvalidParamsErrorShort)
+		return &json2.Error{
+			Code:    InvalidParamsError,
+			Message: InvalidParamsErrorMessage,
+			Data: requester.Data{
+				Trace:   strings.Split(err.Error(), ": "),
+				TraceID: traceID,
+			},
+		}
+	}
+
+	// the whole batch is checked with a single seed
+	seedPulse, err := runner.checkSeed(ctx, args.Seed)
+	if err != nil {
+		logger.Warn("checkSeed returned error: ", err.Error())
+		instr.SetError(err, InvalidRequestErrorShort)
+		return &json2.Error{
+			Code:    InvalidRequestError,
+			Message: InvalidRequestErrorMessage,
+			Data: requester.Data{
+				Trace:   []string{err.Error()},
+				TraceID: traceID,
+			},
+		}
+	}
+
+	result.Results = make([]requester.BatchCallResult, len(args.Calls))
+	result.TraceID = traceID
+
+	var failed bool
+	for i, call := range args.Calls {
+		if failed {
+			result.Results[i].Error = batchCallError(errBatchCallSkipped, traceID, "")
+			continue
+		}
+
+		params := requests[i].Params
+		setRootReferenceIfNeeded(&params, runner.Options)
+
+		// every call is passed to the contract as a separate contract.call request signed by the caller
+		callResult, requestRef, err := runner.makeCall(ctx, params, call.Request, call.Signature, seedPulse)
+
+		var ref string
+		if requestRef != nil {
+			ref = requestRef.String()
+		}
+		result.Results[i].RequestReference = ref
+
+		if err != nil {
+			logger.Errorf("API batch call %d (%s) returned error: %s", i, params.CallSite, err.Error())
+			result.Results[i].Error = batchCallError(err, traceID, ref)
+			failed = true
+			continue
+		}
+		result.Results[i].CallResult = callResult
+	}
+
+	return nil
+}
+
+// parseBatchCall parses contract.call request of the batch call and checks, that it uses the seed of the batch.
+func parseBatchCall(call requester.BatchCall, seed string, request *requester.ContractRequest) error {
+	if len(call.Request) == 0 || call.Signature == "" {
+		return errors.New("request and signature are required")
+	}
+	err := json.Unmarshal(call.Request, request)
+	if err != nil {
+		return errors.Wrap(err, "failed to unmarshal request")
+	}
+	if request.Method != "contract.call" {
+		return errors.Errorf("unexpected method '%s', only contract.call is allowed", request.Method)
+	}
+	if request.Params.Seed != seed {
+		return errors.New("seed of the call differs from the seed of the batch")
+	}
+	return nil
+}
+
+func batchCallError(err error, traceID string, ref string) *requester.Error {
+	jsonErr, _ := callError(err, traceID, ref)
+	data, _ := jsonErr.Data.(requester.Data)
+	return &requester.Error{
+		Code:    int(jsonErr.Code),
+		Message: jsonErr.Message,
+		Data:    data,
+	}
+}
@@ -0,0 +1,285 @@
+// Copyright 2020 Insolar Network Ltd.
+// All rights reserved.
+// This material is licensed under the Insolar License version 1.0,
+// available at https://github.com/insolar/insolar/blob/master/LICENSE.md.
+
+package api
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/api/requester"
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/insolar/reply"
+	"github.com/insolar/insolar/insolar/secrets"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/logicrunner/builtin/foundation"
+	"github.com/insolar/insolar/testutils"
+)
+
+func TestBatchHandler(t *testing.T) {
+	// echoes "id" of the request, requests without "id" are notifications
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		var req struct {
+			ID     *json.RawMessage `json:"id"`
+			Method string           `json:"method"`
+		}
+		_ = json.Unmarshal(body, &req)
+		switch {
+		case req.Method == "plain.error":
+			http.Error(w, "plain text error", http.StatusBadRequest)
+		case req.ID != nil:
+			_, _ = w.Write([]byte(`{"jsonrpc":"2.0","result":"ok","id":` + string(*req.ID) + `}`))
+		}
+	})
+	handler := NewBatchHandler(next)
+
+	serve := func(body string) *httptest.ResponseRecorder {
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, httptest.NewRequest("POST", "/api/rpc", strings.NewReader(body)))
+		return rr
+	}
+
+	t.Run("single request", func(t *testing.T) {
+		rr := serve(`{"jsonrpc":"2.0","method":"node.getSeed","id":1}`)
+		require.JSONEq(t, `{"jsonrpc":"2.0","result":"ok","id":1}`, rr.Body.String())
+	})
+
+	t.Run("batch keeps order and skips notifications", func(t *testing.T) {
+		rr := serve(`[
+			{"jsonrpc":"2.0","method":"node.getSeed","id":1},
+			{"jsonrpc":"2.0","method":"node.getSeed"},
+			{"jsonrpc":"2.0","method":"node.getStatus","id":"two"}
+		]`)
+		require.JSONEq(t, `[
+			{"jsonrpc":"2.0","result":"ok","id":1},
+			{"jsonrpc":"2.0","result":"ok","id":"two"}
+		]`, rr.Body.String())
+	})
+
+	t.Run("plain text errors are wrapped", func(t *testing.T) {
+		rr := serve(`[{"jsonrpc":"2.0","method":"plain.error","id":3}]`)
+		var res []jsonRPCErrorResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &res))
+		require.Len(t, res, 1)
+		require.Equal(t, "3", string(*res[0].ID))
+		require.EqualValues(t, InvalidRequestError, res[0].Error.Code)
+	})
+
+	t.Run("empty batch", func(t *testing.T) {
+		rr := serve(`[]`)
+		var res jsonRPCErrorResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &res))
+		require.EqualValues(t, InvalidRequestError, res.Error.Code)
+	})
+
+	t.Run("too large batch", func(t *testing.T) {
+		elems := make([]string, maxBatchSize+1)
+		for i := range elems {
+			elems[i] = `{"jsonrpc":"2.0","method":"node.getSeed","id":1}`
+		}
+		rr := serve("[" + strings.Join(elems, ",") + "]")
+		var res jsonRPCErrorResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &res))
+		require.EqualValues(t, InvalidRequestError, res.Error.Code)
+	})
+
+	t.Run("invalid json", func(t *testing.T) {
+		rr := serve(`[{"jsonrpc":`)
+		var res jsonRPCErrorResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &res))
+		require.EqualValues(t, ParseError, res.Error.Code)
+	})
+}
+
+func TestContractService_CallBatch(t *testing.T) {
+	defer testutils.LeakTester(t)
+
+	ctx, _ := inslogger.WithTraceField(context.Background(), "APItests")
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	sKey, err := secrets.GeneratePrivateKeyEthereum()
+	require.NoError(t, err)
+	sKeyString, err := secrets.ExportPrivateKeyPEM(sKey)
+	require.NoError(t, err)
+	pKeyString, err := secrets.ExportPublicKeyPEM(secrets.ExtractPublicKey(sKey))
+	require.NoError(t, err)
+
+	user, err := requester.CreateUserConfig(gen.Reference().String(), string(sKeyString), string(pKeyString))
+	require.NoError(t, err)
+
+	// contract gets every call with its own signature, calls to failRef fail
+	var calls int32
+	failRef := gen.Reference()
+	cr := testutils.NewContractRequesterMock(mc)
+	cr.CallMock.Set(func(_ context.Context, ref *insolar.Reference, method string, args []interface{}, _ insolar.PulseNumber) (insolar.Reply, *insolar.Reference, error) {
+		require.Equal(t, "Call", method)
+		var rawRequest []byte
+		var signature string
+		require.NoError(t, insolar.Deserialize(args[0].([]byte), []interface{}{&rawRequest, &signature}))
+		require.NoError(t, foundation.VerifySignature(rawRequest, signature, string(pKeyString), string(pKeyString), false))
+
+		atomic.AddInt32(&calls, 1)
+		requestReference := gen.Reference()
+		if *ref == failRef {
+			return nil, &requestReference, errors.New("something went wrong")
+		}
+		data, _ := foundation.MarshalMethodResult("OK", nil)
+		return &reply.CallMethod{Result: data}, &requestReference, nil
+	})
+
+	checker := testutils.NewAvailabilityCheckerMock(mc)
+	checker.IsAvailableMock.Return(true)
+
+	cfg := configuration.NewAPIRunner(false)
+	cfg.Address = "localhost:19193"
+	cfg.SwaggerPath = "testdata/api-exported.yaml"
+	api, err := NewRunner(&cfg, nil, cr, nil, nil, nil, nil, nil, nil, checker, nil, nil, Options{
+		ContractMethods: map[string]bool{
+			"contract.registerNode": true,
+			"contract.getNodeRef":   true,
+		},
+	})
+	require.NoError(t, err)
+	defer api.Stop(ctx)
+
+	api.SeedManager.Stop()
+	api.SeedManager = newTestSeedManager(t, mockPulseAccessor(t))
+	newSeed := func() string {
+		seed, err := api.SeedManager.Issue(ctx)
+		require.NoError(t, err)
+		return base64.StdEncoding.EncodeToString(seed.Bytes())
+	}
+
+	callBatch := func(batch []requester.Params, seed string) requester.BatchResponse {
+		req, err := requester.MakeBatchRequestWithSeed(ctx, "http://"+cfg.Address+cfg.RPC, user, batch, seed)
+		require.NoError(t, err)
+
+		rr := httptest.NewRecorder()
+		api.Handler().ServeHTTP(rr, req)
+
+		var res requester.BatchResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &res))
+		return res
+	}
+
+	t.Run("signed calls succeed", func(t *testing.T) {
+		before := atomic.LoadInt32(&calls)
+		res := callBatch([]requester.Params{
+			{CallSite: "contract.getNodeRef", CallParams: map[string]interface{}{"publicKey": "key"}},
+			{CallSite: "contract.registerNode", CallParams: map[string]interface{}{"publicKey": "key", "role": "virtual"}},
+		}, newSeed())
+		require.Nil(t, res.Error)
+		require.Len(t, res.Result.Results, 2)
+		for _, callResult := range res.Result.Results {
+			require.Nil(t, callResult.Error)
+			require.Equal(t, "OK", callResult.CallResult)
+			require.NotEmpty(t, callResult.RequestReference)
+		}
+		require.Equal(t, before+2, atomic.LoadInt32(&calls))
+	})
+
+	t.Run("calls are executed until first failure", func(t *testing.T) {
+		before := atomic.LoadInt32(&calls)
+		seed := newSeed()
+		res := callBatch([]requester.Params{
+			{CallSite: "contract.getNodeRef", CallParams: map[string]interface{}{"publicKey": "key"}},
+			{CallSite: "contract.registerNode", CallParams: map[string]interface{}{"publicKey": "key", "role": "virtual"}, Reference: failRef.String()},
+			{CallSite: "contract.getNodeRef", CallParams: map[string]interface{}{"publicKey": "key"}},
+		}, seed)
+		require.Nil(t, res.Error)
+		require.Len(t, res.Result.Results, 3)
+
+		require.Nil(t, res.Result.Results[0].Error)
+		require.Equal(t, "OK", res.Result.Results[0].CallResult)
+
+		require.NotNil(t, res.Result.Results[1].Error)
+		require.Equal(t, ExecutionError, res.Result.Results[1].Error.Code)
+		require.NotEmpty(t, res.Result.Results[1].RequestReference)
+
+		require.NotNil(t, res.Result.Results[2].Error)
+		require.Contains(t, res.Result.Results[2].Error.Data.Trace, "call is skipped")
+		require.Equal(t, before+2, atomic.LoadInt32(&calls))
+
+		// the seed is used once for the whole batch
+		res = callBatch([]requester.Params{
+			{CallSite: "contract.getNodeRef", CallParams: map[string]interface{}{"publicKey": "key"}},
+		}, seed)
+		require.NotNil(t, res.Error)
+		require.Equal(t, InvalidRequestError, res.Error.Code)
+	})
+
+	t.Run("not allowed call site rejects the whole batch", func(t *testing.T) {
+		before := atomic.LoadInt32(&calls)
+		res := callBatch([]requester.Params{
+			{CallSite: "contract.getNodeRef", CallParams: map[string]interface{}{"publicKey": "key"}},
+			{CallSite: "cert.get", CallParams: map[string]interface{}{}},
+		}, newSeed())
+		require.NotNil(t, res.Error)
+		require.Equal(t, MethodNotFoundError, res.Error.Code)
+		require.Equal(t, before, atomic.LoadInt32(&calls))
+	})
+
+	t.Run("call with another seed rejects the whole batch", func(t *testing.T) {
+		before := atomic.LoadInt32(&calls)
+		url := "http://" + cfg.Address + cfg.RPC
+		getNodeRef := []requester.Params{
+			{CallSite: "contract.getNodeRef", CallParams: map[string]interface{}{"publicKey": "key"}},
+		}
+		batch := func(seed string) requester.BatchRequest {
+			req, err := requester.MakeBatchRequestWithSeed(ctx, url, user, getNodeRef, seed)
+			require.NoError(t, err)
+			var res requester.BatchRequest
+			require.NoError(t, json.NewDecoder(req.Body).Decode(&res))
+			return res
+		}
+
+		// signed call of one batch is put into the batch with another seed
+		request := batch(newSeed())
+		request.Params.Calls = batch(newSeed()).Params.Calls
+		body, err := json.Marshal(request)
+		require.NoError(t, err)
+		signature, err := requester.Sign(sKey, body)
+		require.NoError(t, err)
+
+		req := httptest.NewRequest("POST", url, bytes.NewReader(body))
+		req.Header.Set(requester.ContentType, "application/json")
+		digest := sha256.Sum256(body)
+		req.Header.Set(requester.Digest, "SHA-256="+base64.StdEncoding.EncodeToString(digest[:]))
+		req.Header.Set(requester.Signature, `keyId="member-pub-key", algorithm="ecdsa", headers="digest", signature=`+signature)
+
+		rr := httptest.NewRecorder()
+		api.Handler().ServeHTTP(rr, req)
+
+		var res requester.BatchResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &res))
+		require.NotNil(t, res.Error)
+		require.Equal(t, InvalidParamsError, res.Error.Code)
+		require.Contains(t, res.Error.Data.Trace[0], "seed of the call differs")
+		require.Equal(t, before, atomic.LoadInt32(&calls))
+	})
+
+	t.Run("empty batch", func(t *testing.T) {
+		res := callBatch(nil, newSeed())
+		require.NotNil(t, res.Error)
+		require.Equal(t, InvalidParamsError, res.Error.Code)
+	})
+}
@@ -24,7 +24,7 @@ func wrapCall(ctx context.Context, runner *Runner, allowedMethods map[string]boo
 	traceID := instr.TraceID()
 	logger := inslogger.FromContext(ctx)
 
-	if !runner.AvailabilityChecker.IsAvailable(ctx) {
+	if !runner.isAvailable(ctx) {
 		logger.Error("API is not available")
 
 		instr.SetError(errors.New(ServiceUnavailableErrorMessage), ServiceUnavailableErrorShort)
@@ -69,7 +69,7 @@ func wrapCall(ctx context.Context, runner *Runner, allowedMethods map[string]boo
 		}
 	}
 
-	seedPulse, err := runner.checkSeed(args.Seed)
+	seedPulse, err := runner.checkSeed(ctx, args.Seed)
 	if err != nil {
 		logger.Warn("checkSeed returned error: ", err.Error())
 		instr.SetError(err, InvalidRequestErrorShort)
@@ -95,42 +95,9 @@ func wrapCall(ctx context.Context, runner *Runner, allowedMethods map[string]boo
 	if err != nil {
 		// TODO: white list of errors that doesnt require log
 		logger.Error("API return error: ", err.Error())
-		if strings.Contains(err.Error(), "invalid signature") {
-			instr.SetError(err, UnauthorizedErrorShort)
-			return &json2.Error{
-				Code:    UnauthorizedError,
-				Message: UnauthorizedErrorMessage,
-				Data: requester.Data{
-					Trace:            strings.Split(err.Error(), ": "),
-					TraceID:          traceID,
-					RequestReference: ref,
-				},
-			}
-		}
-
-		if strings.Contains(err.Error(), "failed to parse") {
-			instr.SetError(err, ParseErrorShort)
-			return &json2.Error{
-				Code:    ParseError,
-				Message: ParseErrorMessage,
-				Data: requester.Data{
-					Trace:            strings.Split(err.Error(), ": "),
-					TraceID:          traceID,
-					RequestReference: ref,
-				},
-			}
-		}
-
-		instr.SetError(err, ExecutionErrorShort)
-		return &json2.Error{
-			Code:    ExecutionError,
-			Message: ExecutionErrorMessage,
-			Data: requester.Data{
-				Trace:            strings.Split(err.Error(), ": "),
-				TraceID:          traceID,
-				RequestReference: ref,
-			},
-		}
+		jsonErr, errShort := callError(err, traceID, ref)
+		instr.SetError(err, errShort)
+		return jsonErr
 	}
 
 	result.RequestReference = ref
@@ -138,3 +105,34 @@ func wrapCall(ctx context.Context, runner *Runner, allowedMethods map[string]boo
 	result.TraceID = traceID
 	return nil
 }
+
+// callError converts error returned by the contract call to the API error.
+func callError(err error, traceID string, ref string) (*json2.Error, string) {
+	data := requester.Data{
+		Trace:            strings.Split(err.Error(), ": "),
+		TraceID:          traceID,
+		RequestReference: ref,
+	}
+
+	if strings.Contains(err.Error(), "invalid signature") {
+		return &json2.Error{
+			Code:    UnauthorizedError,
+			Message: UnauthorizedErrorMessage,
+			Data:    data,
+		}, UnauthorizedErrorShort
+	}
+
+	if strings.Contains(err.Error(), "failed to parse") {
+		return &json2.Error{
+			Code:    ParseError,
+			Message: ParseErrorMessage,
+			Data:    data,
+		}, ParseErrorShort
+	}
+
+	return &json2.Error{
+		Code:    ExecutionError,
+		Message: ExecutionErrorMessage,
+		Data:    data,
+	}, ExecutionErrorShort
+}
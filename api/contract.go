@@ -19,7 +19,6 @@ import (
 
 	"github.com/insolar/insolar/api/instrumenter"
 	"github.com/insolar/insolar/api/requester"
-	"github.com/insolar/insolar/api/seedmanager"
 	"github.com/insolar/insolar/applicationbase/extractor"
 	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/insolar/reply"
@@ -52,21 +51,33 @@ func (cs *ContractService) Call(req *http.Request, args *requester.Params, reque
 	return wrapCall(ctx, cs.runner, cs.allowedMethods, req, args, requestBody, result)
 }
 
-func (ar *Runner) checkSeed(paramsSeed string) (insolar.PulseNumber, error) {
+// CallBatch makes several contract calls, that are signed separately and checked with a single seed.
+// Calls are executed one by one, if one of them fails, the rest are skipped.
+func (cs *ContractService) CallBatch(req *http.Request, args *requester.BatchParams, requestBody *rpc.RequestBody, result *requester.BatchResult) error {
+	ctx, instr := instrumenter.NewMethodInstrument("ContractService.callBatch")
+	defer instr.End()
+
+	inslogger.FromContext(ctx).WithFields(map[string]interface{}{
+		"calls":   len(args.Calls),
+		"uri":     req.RequestURI,
+		"service": "ContractService",
+		"seed":    args.Seed,
+	}).Infof("Incoming batch request")
+
+	return wrapBatchCall(ctx, cs.runner, cs.allowedMethods, req, args, requestBody, result)
+}
+
+func (ar *Runner) checkSeed(ctx context.Context, paramsSeed string) (insolar.PulseNumber, error) {
 	decoded, err := base64.StdEncoding.DecodeString(paramsSeed)
 	if err != nil {
 		return 0, errors.New("failed to decode seed from string")
 	}
-	seed := seedmanager.SeedFromBytes(decoded)
-	if seed == nil {
-		return 0, errors.New("bad input seed")
-	}
 
-	if pulse, ok := ar.SeedManager.Pop(*seed); ok {
-		return pulse, nil
+	pulse, err := ar.SeedManager.Use(ctx, decoded)
+	if err != nil {
+		return 0, errors.Wrap(err, "incorrect seed")
 	}
-
-	return 0, errors.New("incorrect seed")
+	return pulse, nil
 }
 
 func (ar *Runner) makeCall(ctx context.Context, params requester.Params, rawBody []byte, signature string, seedPulse insolar.PulseNumber) (interface{}, *insolar.Reference, error) {
@@ -125,7 +136,7 @@ func setRootReferenceIfNeeded(params *requester.Params, options Options) {
 
 func validateRequestHeaders(digest string, signature string, body []byte) (string, error) {
 	// Digest = "SHA-256=<hashString>"
-	// Signature = "keyId="member-pub-key", algorithm="ecdsa", headers="digest", signature=<signatureString>"
+	// Signature = "keyId="member-pub-key", algorithm="ecdsa|ed25519", headers="digest", signature=<signatureString>"
 	if len(digest) < 15 || strings.Count(digest, "=") < 2 || len(signature) == 15 ||
 		strings.Count(signature, "=") < 4 || len(body) == 0 {
 		return "", errors.Errorf("invalid input data length digest: %d, signature: %d, body: %d", len(digest),
@@ -150,6 +161,10 @@ func validateRequestHeaders(digest string, signature string, body []byte) (strin
 		return "", errors.New("incorrect digest")
 	}
 
+	err = checkSignatureAlgorithm(signature)
+	if err != nil {
+		return "", err
+	}
 	signature, err = parseSignature(signature)
 	if err != nil {
 		return "", err
@@ -157,6 +172,25 @@ func validateRequestHeaders(digest string, signature string, body []byte) (strin
 	return signature, nil
 }
 
+// checkSignatureAlgorithm accepts signatures of supported algorithms, ecdsa is assumed when algorithm isn't set.
+func checkSignatureAlgorithm(signature string) error {
+	index := strings.Index(signature, "algorithm=\"")
+	if index < 0 {
+		return nil
+	}
+	algorithm := signature[index+11:]
+	end := strings.IndexByte(algorithm, '"')
+	if end < 0 {
+		return errors.New("invalid signature algorithm")
+	}
+	switch algorithm[:end] {
+	case requester.AlgorithmECDSA, requester.AlgorithmEd25519:
+		return nil
+	default:
+		return errors.Errorf("unsupported signature algorithm %q", algorithm[:end])
+	}
+}
+
 func parseDigest(digest string) (string, error) {
 	index := strings.IndexByte(digest, '=')
 	if index < 1 || (index+1) >= len(digest) {
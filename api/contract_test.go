@@ -86,6 +86,8 @@ func TestTimeoutSuite(t *testing.T) {
 		nil,
 		nil,
 		checker,
+		nil,
+		nil,
 		Options{
 			ContractMethods: map[string]bool{
 				"contract.registerNode": true,
@@ -94,12 +96,12 @@ func TestTimeoutSuite(t *testing.T) {
 	)
 	require.NoError(t, err)
 	defer api.Stop(ctx)
-	seed, err := api.SeedGenerator.Next()
+	api.SeedManager.Stop()
+	api.SeedManager = newTestSeedManager(t, mockPulseAccessor(t))
+	seed, err := api.SeedManager.Issue(ctx)
 	require.NoError(t, err)
 
-	api.SeedManager.Add(*seed, 0)
-
-	seedString := base64.StdEncoding.EncodeToString(seed[:])
+	seedString := base64.StdEncoding.EncodeToString(seed.Bytes())
 
 	requester.SetTimeout(25)
 	req, err := requester.MakeRequestWithSeed(
@@ -154,4 +156,13 @@ func TestValidateRequestHeaders(t *testing.T) {
 	sig, err := validateRequestHeaders(calculatedDigest, signature, body)
 	require.NoError(t, err)
 	require.Equal(t, "bar", sig)
+
+	signature = `keyId="member-pub-key", algorithm="ed25519", headers="digest", signature=bar`
+	sig, err = validateRequestHeaders(calculatedDigest, signature, body)
+	require.NoError(t, err)
+	require.Equal(t, "bar", sig)
+
+	signature = `keyId="member-pub-key", algorithm="rsa", headers="digest", signature=bar`
+	_, err = validateRequestHeaders(calculatedDigest, signature, body)
+	require.Error(t, err)
 }
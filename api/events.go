@@ -0,0 +1,134 @@
+// Copyright 2020 Insolar Network Ltd.
+// All rights reserved.
+// This material is licensed under the Insolar License version 1.0,
+// available at https://github.com/insolar/insolar/blob/master/LICENSE.md.
+
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/api/subscriptions"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+)
+
+// eventsKeepAlivePeriod is a period of comments sent to idle event streams to keep connections open.
+const eventsKeepAlivePeriod = 15 * time.Second
+
+// EventsHandler streams events to the client as server-sent events.
+//
+//	Request structure:
+//	GET <events path>?pulses=true&network=true&request=<reference>&request=<reference>
+//
+//	Response is a stream of events:
+//	event: pulse|network|request
+//	data: {"type": str, "data": {...}}
+//
+// Results of requests are also sent to clients, that subscribe after the request is done,
+// if the result is still kept by the node.
+func (ar *Runner) EventsHandler(w http.ResponseWriter, r *http.Request) {
+	logger := inslogger.FromContext(r.Context())
+
+	if r.Method != http.MethodGet {
+		http.Error(w, "GET method required", http.StatusMethodNotAllowed)
+		return
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming is not supported", http.StatusInternalServerError)
+		return
+	}
+	if ar.AvailabilityChecker != nil && !ar.AvailabilityChecker.IsAvailable(r.Context()) {
+		http.Error(w, ServiceUnavailableErrorMessage, http.StatusServiceUnavailable)
+		return
+	}
+
+	filter, err := parseEventsFilter(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	sub, err := ar.Events.Subscribe(filter)
+	if err == subscriptions.ErrTooManySubscriptions || err == subscriptions.ErrStopped {
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	defer sub.Close()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	keepAlive := time.NewTicker(eventsKeepAlivePeriod)
+	defer keepAlive.Stop()
+
+	for {
+		select {
+		case event := <-sub.Events():
+			data, err := json.Marshal(event)
+			if err != nil {
+				logger.Error(errors.Wrap(err, "failed to marshal event"))
+				continue
+			}
+			_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event.Type, data)
+			if err != nil {
+				return
+			}
+		case <-keepAlive.C:
+			_, err := fmt.Fprint(w, ": keep-alive\n\n")
+			if err != nil {
+				return
+			}
+		case <-sub.Done():
+			if sub.Overflowed() {
+				_, _ = fmt.Fprint(w, "event: error\ndata: {\"error\":\"events are not read in time\"}\n\n")
+			}
+			return
+		case <-r.Context().Done():
+			return
+		}
+		flusher.Flush()
+	}
+}
+
+func parseEventsFilter(r *http.Request) (subscriptions.Filter, error) {
+	query := r.URL.Query()
+	filter := subscriptions.Filter{}
+
+	var err error
+	if v := query.Get("pulses"); v != "" {
+		filter.Pulses, err = strconv.ParseBool(v)
+		if err != nil {
+			return filter, errors.Wrap(err, "failed to parse 'pulses'")
+		}
+	}
+	if v := query.Get("network"); v != "" {
+		filter.Network, err = strconv.ParseBool(v)
+		if err != nil {
+			return filter, errors.Wrap(err, "failed to parse 'network'")
+		}
+	}
+	for _, v := range query["request"] {
+		ref, err := insolar.NewReferenceFromString(v)
+		if err != nil {
+			return filter, errors.Wrap(err, "failed to parse 'request'")
+		}
+		filter.Requests = append(filter.Requests, *ref)
+	}
+
+	return filter, nil
+}
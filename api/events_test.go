@@ -0,0 +1,85 @@
+// Copyright 2020 Insolar Network Ltd.
+// All rights reserved.
+// This material is licensed under the Insolar License version 1.0,
+// available at https://github.com/insolar/insolar/blob/master/LICENSE.md.
+
+package api
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/api/subscriptions"
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/testutils"
+)
+
+func TestRunner_EventsHandler(t *testing.T) {
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	checker := testutils.NewAvailabilityCheckerMock(mc)
+	checker.IsAvailableMock.Return(true)
+
+	cfg := configuration.NewAPIRunner(false)
+	cfg.SwaggerPath = "testdata/api-exported.yaml"
+	api, err := NewRunner(&cfg, nil, nil, nil, nil, nil, nil, nil, nil, checker, nil, nil, Options{})
+	require.NoError(t, err)
+	defer api.Stop(context.Background())
+
+	server := httptest.NewServer(api.Handler())
+	defer server.Close()
+
+	t.Run("bad filter", func(t *testing.T) {
+		for _, query := range []string{"", "?pulses=yes", "?request=not-a-reference"} {
+			resp, err := http.Get(server.URL + "/api/events" + query)
+			require.NoError(t, err)
+			resp.Body.Close()
+			require.Equal(t, http.StatusBadRequest, resp.StatusCode, query)
+		}
+	})
+
+	t.Run("pulses are streamed", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"/api/events?pulses=true", nil)
+		require.NoError(t, err)
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		require.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+		pn := gen.PulseNumber()
+		api.Events.BeginPulse(ctx, insolar.Pulse{PulseNumber: pn})
+
+		reader := bufio.NewReader(resp.Body)
+		line, err := reader.ReadString('\n')
+		require.NoError(t, err)
+		require.Equal(t, "event: pulse\n", line)
+
+		line, err = reader.ReadString('\n')
+		require.NoError(t, err)
+		require.True(t, strings.HasPrefix(line, "data: "))
+
+		var event struct {
+			Type string                   `json:"type"`
+			Data subscriptions.PulseEvent `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(strings.TrimPrefix(line, "data: ")), &event))
+		require.Equal(t, subscriptions.EventPulse, event.Type)
+		require.Equal(t, pn, event.Data.PulseNumber)
+	})
+}
@@ -0,0 +1,77 @@
+// Copyright 2020 Insolar Network Ltd.
+// All rights reserved.
+// This material is licensed under the Insolar License version 1.0,
+// available at https://github.com/insolar/insolar/blob/master/LICENSE.md.
+
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/insolar/rpc/v2"
+
+	"github.com/insolar/insolar/api/requester"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/utils"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+)
+
+// Leave requests graceful leave of the node. Node stops accepting new requests, completes pending work
+// and leaves the network after given count of pulses.
+func (s *NodeService) Leave(r *http.Request, args *requester.LeaveParams, _ *rpc.RequestBody, reply *requester.LeaveStatusResponse) error {
+	ctx, inslog := inslogger.WithTraceField(context.Background(), utils.RandTraceID())
+
+	inslog.Infof("[ NodeService.Leave ] Incoming request: %s", r.RequestURI)
+	if !s.runner.cfg.IsAdmin {
+		return errors.New("method not allowed")
+	}
+	leaver, ok := s.runner.NodeNetwork.(insolar.GracefulLeaver)
+	if !ok {
+		return errors.New("node can't leave the network")
+	}
+
+	err := leaver.RequestLeave(ctx, insolar.PulseNumber(args.Pulses))
+	if err != nil {
+		return err
+	}
+
+	setLeaveStatus(reply, leaver.LeaveStatus(ctx))
+	return nil
+}
+
+// GetLeaveStatus returns progress of graceful leave of the node.
+func (s *NodeService) GetLeaveStatus(r *http.Request, _ *interface{}, _ *rpc.RequestBody, reply *requester.LeaveStatusResponse) error {
+	ctx, inslog := inslogger.WithTraceField(context.Background(), utils.RandTraceID())
+
+	inslog.Debugf("[ NodeService.GetLeaveStatus ] Incoming request: %s", r.RequestURI)
+	if !s.runner.cfg.IsAdmin {
+		return errors.New("method not allowed")
+	}
+	leaver, ok := s.runner.NodeNetwork.(insolar.GracefulLeaver)
+	if !ok {
+		return errors.New("node can't leave the network")
+	}
+
+	setLeaveStatus(reply, leaver.LeaveStatus(ctx))
+	return nil
+}
+
+func setLeaveStatus(reply *requester.LeaveStatusResponse, status insolar.LeaveStatus) {
+	reply.State = status.State.String()
+	reply.LeavePulse = uint32(status.LeavePulse)
+	reply.Pending = status.Pending
+	reply.ClaimTimedOut = status.ClaimTimedOut
+}
+
+// isAvailable returns true if node accepts new requests: network is available and node isn't leaving.
+func (ar *Runner) isAvailable(ctx context.Context) bool {
+	if !ar.AvailabilityChecker.IsAvailable(ctx) {
+		return false
+	}
+	if leaver, ok := ar.NodeNetwork.(insolar.GracefulLeaver); ok {
+		return leaver.LeaveStatus(ctx).State == insolar.LeaveNotRequested
+	}
+	return true
+}
@@ -0,0 +1,82 @@
+// Copyright 2020 Insolar Network Ltd.
+// All rights reserved.
+// This material is licensed under the Insolar License version 1.0,
+// available at https://github.com/insolar/insolar/blob/master/LICENSE.md.
+
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/api/requester"
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar"
+	network2 "github.com/insolar/insolar/network"
+	"github.com/insolar/insolar/testutils"
+	"github.com/insolar/insolar/testutils/network"
+)
+
+type leavingNetwork struct {
+	network2.NodeNetwork
+	insolar.GracefulLeaver
+}
+
+func TestNodeService_Leave(t *testing.T) {
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	leaver := testutils.NewGracefulLeaverMock(mc)
+	checker := testutils.NewAvailabilityCheckerMock(mc).IsAvailableMock.Return(true)
+	runner := &Runner{
+		cfg:                 &configuration.APIRunner{IsAdmin: true},
+		NodeNetwork:         leavingNetwork{NodeNetwork: network.NewNodeNetworkMock(mc), GracefulLeaver: leaver},
+		AvailabilityChecker: checker,
+	}
+	service := NewNodeService(runner)
+	req := httptest.NewRequest("POST", "/admin-api/rpc", nil)
+
+	leaver.LeaveStatusMock.Return(insolar.LeaveStatus{})
+	assert.True(t, runner.isAvailable(context.Background()))
+
+	leaver.RequestLeaveMock.Set(func(ctx context.Context, pulses insolar.PulseNumber) error {
+		assert.Equal(t, insolar.PulseNumber(2), pulses)
+		return nil
+	})
+	leaver.LeaveStatusMock.Return(insolar.LeaveStatus{
+		State:      insolar.LeaveDraining,
+		LeavePulse: 65600,
+		Pending:    []string{"pending executions"},
+	})
+	reply := requester.LeaveStatusResponse{}
+	require.NoError(t, service.Leave(req, &requester.LeaveParams{Pulses: 2}, nil, &reply))
+	assert.Equal(t, requester.LeaveStatusResponse{
+		State:      "LeaveDraining",
+		LeavePulse: 65600,
+		Pending:    []string{"pending executions"},
+	}, reply)
+	assert.False(t, runner.isAvailable(context.Background()), "leaving node doesn't accept new requests")
+
+	reply = requester.LeaveStatusResponse{}
+	require.NoError(t, service.GetLeaveStatus(req, nil, nil, &reply))
+	assert.Equal(t, "LeaveDraining", reply.State)
+
+	leaver.RequestLeaveMock.Set(func(ctx context.Context, pulses insolar.PulseNumber) error {
+		return errors.New("leave is already requested")
+	})
+	require.Error(t, service.Leave(req, &requester.LeaveParams{Pulses: 2}, nil, &reply))
+}
+
+func TestNodeService_LeaveNotAdmin(t *testing.T) {
+	service := NewNodeService(&Runner{cfg: &configuration.APIRunner{}})
+	req := httptest.NewRequest("POST", "/api/rpc", nil)
+
+	require.Error(t, service.Leave(req, &requester.LeaveParams{}, nil, &requester.LeaveStatusResponse{}))
+	require.Error(t, service.GetLeaveStatus(req, nil, nil, &requester.LeaveStatusResponse{}))
+}
@@ -9,6 +9,7 @@ import (
 	"context"
 	"net"
 	"net/http"
+	"path"
 	"sync"
 	"time"
 
@@ -19,8 +20,10 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/insolar/insolar/api/seedmanager"
+	"github.com/insolar/insolar/api/subscriptions"
 	"github.com/insolar/insolar/insolar/pulse"
 	"github.com/insolar/insolar/network"
+	"github.com/insolar/insolar/network/controller"
 
 	"github.com/insolar/insolar/insolar/jet"
 
@@ -42,15 +45,19 @@ type Runner struct {
 	JetCoordinator      jet.Coordinator
 	NetworkStatus       insolar.NetworkStatus
 	AvailabilityChecker insolar.AvailabilityChecker
-
-	handler       http.Handler
-	server        *http.Server
-	rpcServer     *rpc.Server
-	cfg           *configuration.APIRunner
-	keyCache      map[string]crypto.PublicKey
-	cacheLock     *sync.RWMutex
-	SeedManager   *seedmanager.SeedManager
-	SeedGenerator seedmanager.SeedGenerator
+	// ValidationJournal exports results of validations on admin API, it's set only on virtual nodes.
+	ValidationJournal insolar.ValidationJournal
+
+	handler     http.Handler
+	server      *http.Server
+	rpcServer   *rpc.Server
+	cfg         *configuration.APIRunner
+	keyCache    map[string]crypto.PublicKey
+	cacheLock   *sync.RWMutex
+	SeedManager *seedmanager.SeedManager
+	// Events delivers pulses, results of requests and network state changes to subscribers.
+	// It must be notified by pulse manager and contract requester.
+	Events *subscriptions.Hub
 
 	Options Options
 }
@@ -67,6 +74,25 @@ type Options struct {
 	RootReference insolar.Reference
 	// ProxyToRootMethods is set of api methods, that need to be called with RootReference as Caller.
 	ProxyToRootMethods []string
+	// AllowCallSites is called with call sites set in config of public api, so application dispatches only them.
+	AllowCallSites func(callSites []string)
+}
+
+// allowedCallSites builds set of api methods from the list of call sites set in config.
+func allowedCallSites(callSites []string) map[string]bool {
+	res := make(map[string]bool, len(callSites))
+	for _, cs := range callSites {
+		res[cs] = true
+	}
+	return res
+}
+
+// seedDomain separates seeds of public and admin api. It must be the same on all nodes of the network.
+func seedDomain(cfg *configuration.APIRunner) string {
+	if cfg.IsAdmin {
+		return "api.useSeed.admin"
+	}
+	return "api.useSeed.public"
 }
 
 func checkConfig(cfg *configuration.APIRunner) error {
@@ -141,6 +167,8 @@ func NewRunner(cfg *configuration.APIRunner,
 	jetCoordinator jet.Coordinator,
 	networkStatus insolar.NetworkStatus,
 	availabilityChecker insolar.AvailabilityChecker,
+	cryptographyService insolar.CryptographyService,
+	rpcController controller.RPCController,
 	apiOptions Options,
 ) (*Runner, error) {
 
@@ -149,6 +177,13 @@ func NewRunner(cfg *configuration.APIRunner,
 		return nil, errors.Wrap(err, "[ NewAPIRunner ] Bad config")
 	}
 
+	if len(cfg.CallSites) > 0 {
+		apiOptions.ContractMethods = allowedCallSites(cfg.CallSites)
+		if !cfg.IsAdmin && apiOptions.AllowCallSites != nil {
+			apiOptions.AllowCallSites(cfg.CallSites)
+		}
+	}
+
 	rpcServer := rpc.NewServer()
 	ar := Runner{
 		CertificateManager:  certificateManager,
@@ -185,7 +220,8 @@ func NewRunner(cfg *configuration.APIRunner,
 
 	router := http.NewServeMux()
 	ar.server.Handler = router
-	ar.SeedManager = seedmanager.New()
+	ar.SeedManager = seedmanager.New(seedDomain(cfg), cryptographyService, nodeNetwork, pulseAccessor, rpcController)
+	ar.Events = subscriptions.New(networkStatus)
 
 	var (
 		server http.Handler = ar.rpcServer
@@ -197,7 +233,12 @@ func NewRunner(cfg *configuration.APIRunner,
 	}
 
 	router.HandleFunc("/healthcheck", hc.CheckHandler)
-	router.Handle(ar.cfg.RPC, server)
+	router.HandleFunc(path.Join(path.Dir(ar.cfg.RPC), "events"), ar.EventsHandler)
+	if cfg.IsAdmin {
+		router.HandleFunc(path.Join(path.Dir(ar.cfg.RPC), "misbehavior"), ar.MisbehaviorHandler)
+		router.HandleFunc(path.Join(path.Dir(ar.cfg.RPC), "validation"), ar.ValidationHandler)
+	}
+	router.Handle(ar.cfg.RPC, NewBatchHandler(server))
 	ar.handler = router
 
 	return &ar, nil
@@ -222,6 +263,8 @@ func (ar *Runner) Start(ctx context.Context) error {
 	if err != nil {
 		return errors.Wrap(err, "Can't start listening")
 	}
+	ar.Events.Start()
+	ar.SeedManager.Start()
 	go func() {
 		if err := ar.server.Serve(listener); err != http.ErrServerClosed {
 			logger.Error("Http server: ListenAndServe() error: ", err)
@@ -237,6 +280,8 @@ func (ar *Runner) Stop(ctx context.Context) error {
 	inslogger.FromContext(ctx).Infof("Shutting down server gracefully ...(waiting for %d seconds)", timeOut)
 	ctxWithTimeout, cancel := context.WithTimeout(ctx, time.Duration(timeOut)*time.Second)
 	defer cancel()
+	// closes event streams, otherwise shutdown waits for them
+	ar.Events.Stop()
 	err := ar.server.Shutdown(ctxWithTimeout)
 	if err != nil {
 		return errors.Wrap(err, "Can't gracefully stop API server")
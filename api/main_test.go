@@ -23,35 +23,61 @@ type MainAPISuite struct {
 }
 
 func (suite *MainAPISuite) TestNewApiRunnerNilConfig() {
-	_, err := NewRunner(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, Options{})
+	_, err := NewRunner(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, Options{})
 	suite.Contains(err.Error(), "config is nil")
 }
 
 func (suite *MainAPISuite) TestNewApiRunnerNoRequiredParams() {
 	cfg := configuration.APIRunner{}
-	_, err := NewRunner(&cfg, nil, nil, nil, nil, nil, nil, nil, nil, nil, Options{})
+	_, err := NewRunner(&cfg, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, Options{})
 	suite.Contains(err.Error(), "Address must not be empty")
 
 	cfg.Address = "address:100"
-	_, err = NewRunner(&cfg, nil, nil, nil, nil, nil, nil, nil, nil, nil, Options{})
+	_, err = NewRunner(&cfg, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, Options{})
 	suite.Contains(err.Error(), "RPC must exist")
 
 	cfg.RPC = "test"
-	_, err = NewRunner(&cfg, nil, nil, nil, nil, nil, nil, nil, nil, nil, Options{})
+	_, err = NewRunner(&cfg, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, Options{})
 	suite.Contains(err.Error(), "Missing openAPI spec file path")
 
 	cfg.SwaggerPath = "testdata/api-exported.yaml"
-	runner, err := NewRunner(&cfg, nil, nil, nil, nil, nil, nil, nil, nil, nil, Options{})
+	runner, err := NewRunner(&cfg, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, Options{})
 	suite.NoError(err)
 	suite.NoError(runner.Stop(context.Background()))
 }
 
+func (suite *MainAPISuite) TestNewApiRunnerCallSitesOverrideOptions() {
+	cfg := configuration.APIRunner{
+		Address:     "address:100",
+		RPC:         "test",
+		SwaggerPath: "testdata/api-exported.yaml",
+	}
+	var allowed []string
+	options := Options{
+		ContractMethods: map[string]bool{"first.New": true, "first.Get": true},
+		AllowCallSites:  func(callSites []string) { allowed = callSites },
+	}
+
+	runner, err := NewRunner(&cfg, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, options)
+	suite.NoError(err)
+	suite.Equal(options.ContractMethods, runner.Options.ContractMethods)
+	suite.Nil(allowed)
+	suite.NoError(runner.Stop(context.Background()))
+
+	cfg.CallSites = []string{"first.Get"}
+	runner, err = NewRunner(&cfg, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, options)
+	suite.NoError(err)
+	suite.Equal(map[string]bool{"first.Get": true}, runner.Options.ContractMethods)
+	suite.Equal([]string{"first.Get"}, allowed)
+	suite.NoError(runner.Stop(context.Background()))
+}
+
 func TestMainTestSuite(t *testing.T) {
 	ctx, _ := inslogger.WithTraceField(context.Background(), "APItests")
 	http.DefaultServeMux = new(http.ServeMux)
 	cfg := configuration.NewAPIRunner(false)
 	cfg.SwaggerPath = "testdata/api-exported.yaml"
-	api, err := NewRunner(&cfg, nil, nil, nil, nil, nil, nil, nil, nil, nil, Options{})
+	api, err := NewRunner(&cfg, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, Options{})
 	require.NoError(t, err, "new runner constructor")
 
 	cm := certificate.NewCertificateManager(&certificate.Certificate{})
@@ -0,0 +1,57 @@
+// Copyright 2020 Insolar Network Ltd.
+// All rights reserved.
+// This material is licensed under the Insolar License version 1.0,
+// available at https://github.com/insolar/insolar/blob/master/LICENSE.md.
+
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/network"
+)
+
+// MisbehaviorRegistryProvider is a node network, that keeps consensus misbehavior reports.
+type MisbehaviorRegistryProvider interface {
+	GetMisbehaviorRegistry() network.MisbehaviorRegistry
+}
+
+// MisbehaviorHandler is a HTTP handler of admin API for consensus misbehavior reports.
+//
+// GET returns nodes with actual reports, DELETE removes reports of the node set by "node" reference parameter.
+func (ar *Runner) MisbehaviorHandler(w http.ResponseWriter, r *http.Request) {
+	var registry network.MisbehaviorRegistry
+	if provider, ok := ar.NodeNetwork.(MisbehaviorRegistryProvider); ok {
+		registry = provider.GetMisbehaviorRegistry()
+	}
+	if registry == nil {
+		http.Error(w, "misbehavior registry is not available", http.StatusServiceUnavailable)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		w.Header().Set("Content-Type", "application/json")
+		err := json.NewEncoder(w).Encode(registry.Offenders())
+		if err != nil {
+			inslogger.FromContext(r.Context()).Error("failed to write misbehavior offenders: ", err)
+		}
+	case http.MethodDelete:
+		ref, err := insolar.NewReferenceFromString(r.URL.Query().Get("node"))
+		if err != nil {
+			http.Error(w, "invalid node reference", http.StatusBadRequest)
+			return
+		}
+		if !registry.Forgive(*ref) {
+			http.Error(w, "node has no misbehavior reports", http.StatusNotFound)
+			return
+		}
+		inslogger.FromContext(r.Context()).Infof("Misbehavior reports of node %s are removed", ref)
+		w.WriteHeader(http.StatusOK)
+	default:
+		http.Error(w, "GET or DELETE method required", http.StatusMethodNotAllowed)
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright 2020 Insolar Network Ltd.
+// All rights reserved.
+// This material is licensed under the Insolar License version 1.0,
+// available at https://github.com/insolar/insolar/blob/master/LICENSE.md.
+
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/gen"
+	network2 "github.com/insolar/insolar/network"
+	"github.com/insolar/insolar/testutils/network"
+)
+
+type misbehaviorNetwork struct {
+	network2.NodeNetwork
+	registry network2.MisbehaviorRegistry
+}
+
+func (n misbehaviorNetwork) GetMisbehaviorRegistry() network2.MisbehaviorRegistry {
+	return n.registry
+}
+
+func TestRunner_MisbehaviorHandler(t *testing.T) {
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	registry := network.NewMisbehaviorRegistryMock(mc)
+	ar := &Runner{NodeNetwork: misbehaviorNetwork{
+		NodeNetwork: network.NewNodeNetworkMock(mc),
+		registry:    registry,
+	}}
+
+	t.Run("offenders", func(t *testing.T) {
+		offenders := []network2.Offender{{Node: gen.Reference(), Score: 30, Evicted: true}}
+		registry.OffendersMock.Return(offenders)
+
+		w := httptest.NewRecorder()
+		ar.MisbehaviorHandler(w, httptest.NewRequest(http.MethodGet, "/admin-api/misbehavior", nil))
+		require.Equal(t, http.StatusOK, w.Code)
+		require.Equal(t, "application/json", w.Header().Get("Content-Type"))
+
+		var result []network2.Offender
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &result))
+		require.Equal(t, offenders, result)
+	})
+
+	t.Run("forgive", func(t *testing.T) {
+		offender := gen.Reference()
+		registry.ForgiveMock.Set(func(ref insolar.Reference) bool {
+			return ref == offender
+		})
+		forgive := func(ref insolar.Reference) *http.Request {
+			return httptest.NewRequest(http.MethodDelete, "/admin-api/misbehavior?node="+url.QueryEscape(ref.String()), nil)
+		}
+
+		w := httptest.NewRecorder()
+		ar.MisbehaviorHandler(w, forgive(offender))
+		require.Equal(t, http.StatusOK, w.Code)
+
+		w = httptest.NewRecorder()
+		ar.MisbehaviorHandler(w, forgive(gen.Reference()))
+		require.Equal(t, http.StatusNotFound, w.Code)
+
+		w = httptest.NewRecorder()
+		ar.MisbehaviorHandler(w, httptest.NewRequest(http.MethodDelete, "/admin-api/misbehavior?node=x", nil))
+		require.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("wrong method", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		ar.MisbehaviorHandler(w, httptest.NewRequest(http.MethodPost, "/admin-api/misbehavior", nil))
+		require.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	})
+
+	t.Run("no registry", func(t *testing.T) {
+		ar := &Runner{NodeNetwork: network.NewNodeNetworkMock(mc)}
+		w := httptest.NewRecorder()
+		ar.MisbehaviorHandler(w, httptest.NewRequest(http.MethodGet, "/admin-api/misbehavior", nil))
+		require.Equal(t, http.StatusServiceUnavailable, w.Code)
+	})
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/insolar/x-crypto/sha256"
 
 	"github.com/pkg/errors"
+	"golang.org/x/crypto/ed25519"
 
 	"github.com/insolar/insolar/instrumentation/inslogger"
 )
@@ -41,6 +42,12 @@ const (
 	JSONRPCVersion = "2.0"
 )
 
+// Signature algorithms of member keys in Signature header.
+const (
+	AlgorithmECDSA   = "ecdsa"
+	AlgorithmEd25519 = "ed25519"
+)
+
 func init() {
 	httpClient = createHTTPClient()
 }
@@ -108,6 +115,26 @@ type Params struct {
 	Test       string      `json:"test,omitempty"`
 }
 
+// BatchCall is a single call of the batch request: serialized contract.call request and its signature.
+// The request is passed to the contract as is, so the contract checks the signature the same way
+// as the signature of a single call.
+type BatchCall struct {
+	Request   []byte `json:"request"`
+	Signature string `json:"signature"`
+}
+
+// BatchParams are params of the contract.callBatch request, that carries several signed contract calls
+// checked with a single seed. All calls must have the seed of the batch.
+type BatchParams struct {
+	Seed  string      `json:"seed"`
+	Calls []BatchCall `json:"calls"`
+}
+
+type BatchRequest struct {
+	Request
+	Params BatchParams `json:"params,omitempty"`
+}
+
 // GetResponseBodyContract makes request to contract and extracts body
 func GetResponseBodyContract(url string, postP ContractRequest, signature string) ([]byte, error) {
 	req, err := MakeContractRequest(url, postP, signature)
@@ -117,24 +144,28 @@ func GetResponseBodyContract(url string, postP ContractRequest, signature string
 	return doReq(context.Background(), req)
 }
 
+// MakeContractRequest creates contract request signed by ECDSA key.
 func MakeContractRequest(url string, postP ContractRequest, signature string) (*http.Request, error) {
+	return MakeContractRequestWithAlgorithm(url, postP, signature, AlgorithmECDSA)
+}
+
+// MakeContractRequestWithAlgorithm creates contract request with signature of provided algorithm.
+func MakeContractRequestWithAlgorithm(url string, postP ContractRequest, signature string, algorithm string) (*http.Request, error) {
 	req, jsonValue, err := prepareReq(url, postP)
 	if err != nil {
 		return nil, errors.Wrap(err, "problem with preparing contract request")
 	}
-
-	h := sha256.New()
-	_, err = h.Write(jsonValue)
-	if err != nil {
-		return nil, errors.Wrap(err, "[ GetResponseBodyContract ] Cant get hash")
-	}
-	sha := base64.StdEncoding.EncodeToString(h.Sum(nil))
-	req.Header.Set(Digest, "SHA-256="+sha)
-	req.Header.Set(Signature, "keyId=\"member-pub-key\", algorithm=\"ecdsa\", headers=\"digest\", signature="+signature)
+	setSignatureHeaders(req, jsonValue, signature, algorithm)
 
 	return req, nil
 }
 
+func setSignatureHeaders(req *http.Request, body []byte, signature string, algorithm string) {
+	sha := sha256.Sum256(body)
+	req.Header.Set(Digest, "SHA-256="+base64.StdEncoding.EncodeToString(sha[:]))
+	req.Header.Set(Signature, "keyId=\"member-pub-key\", algorithm=\""+algorithm+"\", headers=\"digest\", signature="+signature)
+}
+
 // GetResponseBodyPlatform makes request to platform and extracts body
 func GetResponseBodyPlatform(url string, method string, params interface{}) ([]byte, error) {
 	request := PlatformRequest{
@@ -272,18 +303,42 @@ func MakeRequestWithSeed(ctx context.Context, url string, userCfg *UserConfigJSO
 	}
 	verboseInfo(ctx, "Signing request completed")
 
-	return MakeContractRequest(url, *request, signature)
+	algorithm, err := SignatureAlgorithm(userCfg.privateKeyObject)
+	if err != nil {
+		return nil, err
+	}
+	return MakeContractRequestWithAlgorithm(url, *request, signature, algorithm)
+}
+
+// SignatureAlgorithm returns name of signature algorithm of private key for Signature header.
+func SignatureAlgorithm(privateKey crypto.PrivateKey) (string, error) {
+	switch privateKey.(type) {
+	case *ecdsa.PrivateKey:
+		return AlgorithmECDSA, nil
+	case ed25519.PrivateKey:
+		return AlgorithmEd25519, nil
+	default:
+		return "", errors.Errorf("[ sign ] unsupported private key type %T", privateKey)
+	}
 }
 
+// Sign signs data with ECDSA or Ed25519 private key. ECDSA signs sha256 hash of data, Ed25519 signs data itself.
 func Sign(privateKey crypto.PrivateKey, data []byte) (string, error) {
-	hash := sha256.Sum256(data)
+	switch key := privateKey.(type) {
+	case *ecdsa.PrivateKey:
+		hash := sha256.Sum256(data)
 
-	r, s, err := ecdsa.Sign(rand.Reader, privateKey.(*ecdsa.PrivateKey), hash[:])
-	if err != nil {
-		return "", errors.Wrap(err, "[ sign ] Cant sign data")
-	}
+		r, s, err := ecdsa.Sign(rand.Reader, key, hash[:])
+		if err != nil {
+			return "", errors.Wrap(err, "[ sign ] Cant sign data")
+		}
 
-	return marshalSig(r, s)
+		return marshalSig(r, s)
+	case ed25519.PrivateKey:
+		return base64.StdEncoding.EncodeToString(ed25519.Sign(key, data)), nil
+	default:
+		return "", errors.Errorf("[ sign ] unsupported private key type %T", privateKey)
+	}
 }
 
 // marshalSig encodes ECDSA signature to ASN.1.
@@ -318,6 +373,102 @@ func Send(ctx context.Context, url string, userCfg *UserConfigJSON, params *Para
 	return response, nil
 }
 
+// SendBatchWithSeed sends batch of contract calls with known seed
+func SendBatchWithSeed(ctx context.Context, url string, userCfg *UserConfigJSON, calls []Params, seed string) ([]byte, error) {
+	req, err := MakeBatchRequestWithSeed(ctx, url, userCfg, calls, seed)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ SendBatchWithSeed ] Problem with creating target request")
+	}
+	b, err := doReq(ctx, req)
+	return b, errors.Wrap(err, "[ SendBatchWithSeed ] Problem with sending target request")
+}
+
+// MakeBatchRequestWithSeed creates contract.callBatch request with provided url, user config, calls and seed.
+// Every call is signed as a separate contract.call request with the seed of the batch,
+// calls without reference are made to the caller from user config.
+func MakeBatchRequestWithSeed(ctx context.Context, url string, userCfg *UserConfigJSON, calls []Params, seed string) (*http.Request, error) {
+	if userCfg == nil {
+		return nil, errors.New("configs must be initialized")
+	}
+
+	request := &BatchRequest{
+		Request: Request{
+			Version: JSONRPCVersion,
+			ID:      uint64(mathrand.Int63()),
+			Method:  "contract.callBatch",
+		},
+		Params: BatchParams{
+			Seed:  seed,
+			Calls: make([]BatchCall, 0, len(calls)),
+		},
+	}
+
+	verboseInfo(ctx, "Signing batch calls ...")
+	for _, params := range calls {
+		params.Seed = seed
+		if params.Reference == "" {
+			params.Reference = userCfg.Caller
+		}
+		callRequest, err := json.Marshal(&ContractRequest{
+			Request: Request{
+				Version: JSONRPCVersion,
+				ID:      uint64(mathrand.Int63()),
+				Method:  "contract.call",
+			},
+			Params: params,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "call request marshaling failed")
+		}
+		callSignature, err := Sign(userCfg.privateKeyObject, callRequest)
+		if err != nil {
+			return nil, errors.Wrap(err, "problem with signing call request")
+		}
+		request.Params.Calls = append(request.Params.Calls, BatchCall{Request: callRequest, Signature: callSignature})
+	}
+	verboseInfo(ctx, "Signing batch calls completed")
+
+	verboseInfo(ctx, "Signing batch request ...")
+	dataToSign, err := json.Marshal(request)
+	if err != nil {
+		return nil, errors.Wrap(err, "config request marshaling failed")
+	}
+	signature, err := Sign(userCfg.privateKeyObject, dataToSign)
+	if err != nil {
+		return nil, errors.Wrap(err, "problem with signing request")
+	}
+	verboseInfo(ctx, "Signing batch request completed")
+
+	req, _, err := prepareReq(url, request)
+	if err != nil {
+		return nil, errors.Wrap(err, "problem with preparing batch request")
+	}
+	algorithm, err := SignatureAlgorithm(userCfg.privateKeyObject)
+	if err != nil {
+		return nil, err
+	}
+	setSignatureHeaders(req, dataToSign, signature, algorithm)
+
+	return req, nil
+}
+
+// SendBatch first gets seed and after that makes batch request
+func SendBatch(ctx context.Context, url string, userCfg *UserConfigJSON, calls []Params) ([]byte, error) {
+	verboseInfo(ctx, "Sending GETSEED request ...")
+	seed, err := GetSeed(url)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ SendBatch ] Problem with getting seed")
+	}
+	verboseInfo(ctx, "GETSEED request completed. seed: "+seed)
+
+	response, err := SendBatchWithSeed(ctx, url, userCfg, calls, seed)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ SendBatch ]")
+	}
+
+	return response, nil
+}
+
 // Status makes rpc request to node.getStatus method and extracts it
 func Status(url string) (*StatusResponse, error) {
 	body, err := GetResponseBodyPlatform(url, "node.getStatus", nil)
@@ -337,3 +488,35 @@ func Status(url string) (*StatusResponse, error) {
 
 	return &statusResp.Result, nil
 }
+
+// Leave makes rpc request to node.leave method, node leaves the network after given count of pulses
+func Leave(url string, pulses uint32) (*LeaveStatusResponse, error) {
+	body, err := GetResponseBodyPlatform(url, "node.leave", LeaveParams{Pulses: pulses})
+	if err != nil {
+		return nil, errors.Wrap(err, "[ Leave ]")
+	}
+	return unmarshalLeaveStatus(body)
+}
+
+// LeaveStatus makes rpc request to node.getLeaveStatus method and extracts progress of the leave
+func LeaveStatus(url string) (*LeaveStatusResponse, error) {
+	body, err := GetResponseBodyPlatform(url, "node.getLeaveStatus", nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ LeaveStatus ]")
+	}
+	return unmarshalLeaveStatus(body)
+}
+
+func unmarshalLeaveStatus(body []byte) (*LeaveStatusResponse, error) {
+	statusResp := rpcLeaveStatusResponse{}
+
+	err := json.Unmarshal(body, &statusResp)
+	if err != nil {
+		return nil, errors.Wrap(err, "Can't unmarshal leave status")
+	}
+	if statusResp.Error != nil {
+		return nil, statusResp.Error
+	}
+
+	return &statusResp.Result, nil
+}
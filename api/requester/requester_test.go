@@ -26,6 +26,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ed25519"
 
 	"github.com/insolar/insolar/instrumentation/inslogger"
 	"github.com/insolar/insolar/log"
@@ -257,6 +258,26 @@ func TestMarshalSig(t *testing.T) {
 	require.Equal(t, s1, s2, errors.Errorf("Invalid R number"))
 }
 
+func TestSign_Ed25519(t *testing.T) {
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	data := []byte("test")
+
+	signature, err := Sign(privateKey, data)
+	require.NoError(t, err)
+	sig, err := base64.StdEncoding.DecodeString(signature)
+	require.NoError(t, err)
+	require.True(t, ed25519.Verify(publicKey, data, sig))
+
+	algorithm, err := SignatureAlgorithm(privateKey)
+	require.NoError(t, err)
+	require.Equal(t, AlgorithmEd25519, algorithm)
+
+	req, err := MakeContractRequestWithAlgorithm("http://localhost", ContractRequest{}, signature, algorithm)
+	require.NoError(t, err)
+	require.Contains(t, req.Header.Get(Signature), `algorithm="ed25519"`)
+}
+
 // unmarshalRequest unmarshals request to api
 func unmarshalRequest(req *http.Request, params interface{}) ([]byte, error) {
 	body, err := ioutil.ReadAll(req.Body)
@@ -42,6 +42,24 @@ type ContractResult struct {
 	TraceID          string      `json:"traceID,omitempty"`
 }
 
+type BatchResponse struct {
+	Response
+	Result *BatchResult `json:"result,omitempty"`
+}
+
+// BatchResult contains results of the batch calls in the same order as calls in the request.
+type BatchResult struct {
+	Results []BatchCallResult `json:"results"`
+	TraceID string            `json:"traceID,omitempty"`
+}
+
+// BatchCallResult contains either result or error of a single batch call.
+type BatchCallResult struct {
+	CallResult       interface{} `json:"callResult,omitempty"`
+	RequestReference string      `json:"requestReference,omitempty"`
+	Error            *Error      `json:"error,omitempty"`
+}
+
 type seedResponse struct {
 	Seed    string `json:"seed"`
 	TraceID string `json:"traceID"`
@@ -62,6 +80,26 @@ type rpcStatusResponse struct {
 	Result StatusResponse `json:"result"`
 }
 
+type rpcLeaveStatusResponse struct {
+	Response
+	Result LeaveStatusResponse `json:"result"`
+}
+
+// LeaveParams represents params of rpc node.leave method
+type LeaveParams struct {
+	// Pulses is a count of pulses, after that node leaves the network
+	Pulses uint32 `json:"pulses"`
+}
+
+// LeaveStatusResponse represents response from rpc on node.leave and node.getLeaveStatus methods
+type LeaveStatusResponse struct {
+	State      string   `json:"state"`
+	LeavePulse uint32   `json:"leavePulse"`
+	Pending    []string `json:"pending,omitempty"`
+	// ClaimTimedOut is true if the network hasn't approved leave claim in time
+	ClaimTimedOut bool `json:"claimTimedOut,omitempty"`
+}
+
 type Node struct {
 	Reference string `json:"reference"`
 	Role      string `json:"role"`
@@ -9,7 +9,6 @@ import (
 	"context"
 	"errors"
 	"github.com/gojuno/minimock/v3"
-	"github.com/insolar/insolar/api/seedmanager"
 	"github.com/insolar/insolar/configuration"
 	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/insolar/pulse"
@@ -51,8 +50,7 @@ func TestSpecService_Get(t *testing.T) {
 		cfg:                 &cfg,
 		AvailabilityChecker: checker,
 		PulseAccessor:       accessor,
-		SeedManager:         seedmanager.New(),
-		SeedGenerator:       seedmanager.SeedGenerator{},
+		SeedManager:         newTestSeedManager(t, accessor),
 	}
 	s := NewSpecService(&runner)
 	defer runner.SeedManager.Stop()
@@ -57,18 +57,12 @@ func NewNodeService(runner *Runner) *NodeService {
 func (s *NodeService) getSeed(ctx context.Context, _ *http.Request, _ *SeedArgs, reply *requester.SeedReply) error {
 	traceID := instrumenter.GetTraceID(ctx)
 
-	seed, err := s.runner.SeedGenerator.Next()
+	seed, err := s.runner.SeedManager.Issue(ctx)
 	if err != nil {
 		return err
 	}
 
-	p, err := s.runner.PulseAccessor.Latest(context.Background())
-	if err != nil {
-		return errors.Wrap(err, "couldn't receive pulse")
-	}
-	s.runner.SeedManager.Add(*seed, p.PulseNumber)
-
-	reply.Seed = seed[:]
+	reply.Seed = seed.Bytes()
 	reply.TraceID = traceID
 
 	return nil
@@ -84,7 +78,7 @@ func (s *NodeService) GetSeed(r *http.Request, args *SeedArgs, _ *rpc.RequestBod
 	logger := inslogger.FromContext(ctx)
 	logger.Info("[ NodeService.getSeed ] ", msg)
 
-	if !s.runner.AvailabilityChecker.IsAvailable(ctx) {
+	if !s.runner.isAvailable(ctx) {
 		logger.Warn("[ NodeService.getSeed ] API is not available")
 
 		instr.SetError(errors.New(ServiceUnavailableErrorMessage), ServiceUnavailableErrorShort)
@@ -18,11 +18,26 @@ import (
 
 	"github.com/insolar/insolar/api/requester"
 	"github.com/insolar/insolar/api/seedmanager"
+	"github.com/insolar/insolar/cryptography"
 	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/gen"
 	"github.com/insolar/insolar/insolar/pulse"
+	"github.com/insolar/insolar/network/node"
+	"github.com/insolar/insolar/platformpolicy"
 	"github.com/insolar/insolar/testutils"
+	networkUtils "github.com/insolar/insolar/testutils/network"
 )
 
+// newTestSeedManager creates seed manager of a single node network.
+func newTestSeedManager(t *testing.T, pulses pulse.Accessor) *seedmanager.SeedManager {
+	kp := platformpolicy.NewKeyProcessor()
+	key, err := kp.GeneratePrivateKey()
+	require.NoError(t, err)
+	origin := node.NewNode(gen.Reference(), insolar.StaticRoleVirtual, kp.ExtractPublicKey(key), "127.0.0.1:0", "")
+	nodes := networkUtils.NewNodeNetworkMock(t).GetOriginMock.Return(origin)
+	return seedmanager.New("test.useSeed", cryptography.NewKeyBoundCryptographyService(key), nodes, pulses, nil)
+}
+
 func TestNodeService_GetSeed(t *testing.T) {
 	defer testutils.LeakTester(t)
 
@@ -50,8 +65,7 @@ func TestNodeService_GetSeed(t *testing.T) {
 	runner := Runner{
 		AvailabilityChecker: checker,
 		PulseAccessor:       accessor,
-		SeedManager:         seedmanager.New(),
-		SeedGenerator:       seedmanager.SeedGenerator{},
+		SeedManager:         newTestSeedManager(t, accessor),
 	}
 	s := NewNodeService(&runner)
 	defer runner.SeedManager.Stop()
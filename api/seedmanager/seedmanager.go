@@ -6,47 +6,131 @@
 package seedmanager
 
 import (
+	"context"
+	"encoding/binary"
 	"sync"
 	"time"
 
+	"github.com/pkg/errors"
+
 	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/pulse"
+	"github.com/insolar/insolar/network"
+	"github.com/insolar/insolar/network/controller"
 )
 
-// DefaultTTL is default time period for deleting expired seeds
+// DefaultTTL is default time period, that a seed is valid after it's issued
 const DefaultTTL = 600 * time.Second
 
-// DefaultCleanPeriod default time period for launching cleaning goroutine
+// DefaultCleanPeriod default time period for launching cleaning goroutine, that forgets expired used seeds
 const DefaultCleanPeriod = 60 * time.Second
 
-type storedSeed struct {
-	ts    time.Time
-	pulse insolar.PulseNumber
+const (
+	seedVersion byte = 1
+	// version | pulse | issue time | issuer short id | random part
+	seedPayloadSize = 1 + insolar.PulseNumberSize + 8 + 4 + int(SeedSize)
+)
+
+// SignedSeed is a seed, that is issued by a node of the network for a single request.
+// Any node of the network checks it by signature of the issuer. Issuer is the only node, that marks seed as used.
+type SignedSeed struct {
+	Pulse     insolar.PulseNumber
+	IssuedAt  time.Time
+	Issuer    insolar.ShortNodeID
+	Random    Seed
+	Signature []byte
+}
+
+func (s SignedSeed) payload() []byte {
+	buf := make([]byte, seedPayloadSize)
+	buf[0] = seedVersion
+	off := 1
+	copy(buf[off:], s.Pulse.Bytes())
+	off += insolar.PulseNumberSize
+	binary.BigEndian.PutUint64(buf[off:], uint64(s.IssuedAt.UnixNano()))
+	off += 8
+	binary.BigEndian.PutUint32(buf[off:], uint32(s.Issuer))
+	off += 4
+	copy(buf[off:], s.Random[:])
+	return buf
 }
 
-// SeedManager manages working with seed pool
-// It's thread safe
+// Bytes returns serialized seed, that is given to clients.
+func (s SignedSeed) Bytes() []byte {
+	return append(s.payload(), s.Signature...)
+}
+
+// SignedSeedFromBytes parses seed, that was serialized by Bytes.
+func SignedSeedFromBytes(raw []byte) (*SignedSeed, error) {
+	if len(raw) <= seedPayloadSize {
+		return nil, errors.New("seed is too short")
+	}
+	if raw[0] != seedVersion {
+		return nil, errors.Errorf("unknown seed version %d", raw[0])
+	}
+	off := 1
+	s := SignedSeed{Pulse: insolar.NewPulseNumber(raw[off:])}
+	off += insolar.PulseNumberSize
+	s.IssuedAt = time.Unix(0, int64(binary.BigEndian.Uint64(raw[off:])))
+	off += 8
+	s.Issuer = insolar.ShortNodeID(binary.BigEndian.Uint32(raw[off:]))
+	off += 4
+	copy(s.Random[:], raw[off:seedPayloadSize])
+	s.Signature = append([]byte(nil), raw[seedPayloadSize:]...)
+	return &s, nil
+}
+
+// SeedManager issues signed seeds and checks seeds of any node of the network.
+// Used seeds are remembered by their issuers until seeds expire, so a seed can't be used twice on different nodes.
 type SeedManager struct {
-	mutex    sync.Mutex
-	seedPool map[Seed]storedSeed
-	ttl      time.Duration
-	stopped  chan struct{}
+	mutex   sync.Mutex
+	used    map[Seed]time.Time
+	ttl     time.Duration
+	stopped chan struct{}
+
+	// domain separates seeds of different managers of the node, e.g. public and admin api.
+	// It is signed with the seed and is a name of remote procedure, that marks seeds as used.
+	domain    string
+	generator SeedGenerator
+	crypto    insolar.CryptographyService
+	nodes     network.NodeNetwork
+	pulses    pulse.Accessor
+	rpc       controller.RPCController
 }
 
 // New creates new seed manager with default params
-func New() *SeedManager {
-	return NewSpecified(DefaultTTL, DefaultCleanPeriod)
+func New(
+	domain string,
+	crypto insolar.CryptographyService,
+	nodes network.NodeNetwork,
+	pulses pulse.Accessor,
+	rpc controller.RPCController,
+) *SeedManager {
+	return NewSpecified(DefaultTTL, DefaultCleanPeriod, domain, crypto, nodes, pulses, rpc)
 }
 
 // NewSpecified creates new seed manager with custom params
-func NewSpecified(ttl time.Duration, cleanPeriod time.Duration) *SeedManager {
+func NewSpecified(
+	ttl time.Duration,
+	cleanPeriod time.Duration,
+	domain string,
+	crypto insolar.CryptographyService,
+	nodes network.NodeNetwork,
+	pulses pulse.Accessor,
+	rpc controller.RPCController,
+) *SeedManager {
 	sm := SeedManager{
-		seedPool: make(map[Seed]storedSeed),
-		ttl:      ttl,
-		stopped:  make(chan struct{}),
+		used:    make(map[Seed]time.Time),
+		ttl:     ttl,
+		stopped: make(chan struct{}),
+		domain:  domain,
+		crypto:  crypto,
+		nodes:   nodes,
+		pulses:  pulses,
+		rpc:     rpc,
 	}
 
 	ticker := time.NewTicker(cleanPeriod)
-	defer ticker.Stop()
 
 	go func() {
 		var stop = false
@@ -58,54 +142,138 @@ func NewSpecified(ttl time.Duration, cleanPeriod time.Duration) *SeedManager {
 				stop = true
 			}
 		}
+		ticker.Stop()
 		sm.stopped <- struct{}{}
 	}()
 
 	return &sm
 }
 
+// Start registers remote procedure, that other nodes call to use seeds of this node.
+// Network must be initialized before the call.
+func (sm *SeedManager) Start() {
+	if sm.rpc == nil {
+		return
+	}
+	sm.rpc.RemoteProcedureRegister(sm.domain, func(ctx context.Context, args []byte) ([]byte, error) {
+		_, err := sm.useOwn(args)
+		return nil, err
+	})
+}
+
 func (sm *SeedManager) Stop() {
 	sm.stopped <- struct{}{}
 	<-sm.stopped
 }
 
-// Add adds seed to pool
-func (sm *SeedManager) Add(seed Seed, pulse insolar.PulseNumber) {
-	sm.mutex.Lock()
-	defer sm.mutex.Unlock()
+func (sm *SeedManager) signedData(s SignedSeed) []byte {
+	return append([]byte(sm.domain), s.payload()...)
+}
 
-	sm.seedPool[seed] = storedSeed{
-		ts:    time.Now(),
-		pulse: pulse,
+// Issue returns new seed for the latest pulse, signed by the node.
+func (sm *SeedManager) Issue(ctx context.Context) (*SignedSeed, error) {
+	random, err := sm.generator.Next()
+	if err != nil {
+		return nil, err
+	}
+	p, err := sm.pulses.Latest(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't receive pulse")
 	}
 
+	s := SignedSeed{
+		Pulse:    p.PulseNumber,
+		IssuedAt: time.Now(),
+		Issuer:   sm.nodes.GetOrigin().ShortID(),
+		Random:   *random,
+	}
+	signature, err := sm.crypto.Sign(sm.signedData(s))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to sign seed")
+	}
+	s.Signature = signature.Bytes()
+	return &s, nil
 }
 
-func (sm *SeedManager) isExpired(ts time.Time) bool {
-	return time.Now().Sub(ts) > sm.ttl
+// Use checks the seed and marks it as used. Seeds of other nodes are marked as used by their issuers.
+// It returns pulse, that the seed is issued in.
+func (sm *SeedManager) Use(ctx context.Context, raw []byte) (insolar.PulseNumber, error) {
+	s, err := SignedSeedFromBytes(raw)
+	if err != nil {
+		return 0, err
+	}
+	origin := sm.nodes.GetOrigin()
+	if s.Issuer == origin.ShortID() {
+		return sm.useOwn(raw)
+	}
+
+	if sm.isExpired(s.IssuedAt) {
+		return 0, errors.New("seed is expired")
+	}
+	p, err := sm.pulses.Latest(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "couldn't receive pulse")
+	}
+	issuer := sm.nodes.GetAccessor(p.PulseNumber).GetActiveNodeByShortID(s.Issuer)
+	if issuer == nil {
+		return 0, errors.Errorf("seed issuer %d is not an active node", s.Issuer)
+	}
+	if !sm.crypto.Verify(issuer.PublicKey(), insolar.SignatureFromBytes(s.Signature), sm.signedData(*s)) {
+		return 0, errors.New("wrong seed signature")
+	}
+	if sm.rpc == nil {
+		return 0, errors.New("seeds of other nodes are not accepted")
+	}
+	_, err = sm.rpc.SendBytes(ctx, issuer.ID(), sm.domain, raw)
+	if err != nil {
+		return 0, errors.Wrap(err, "seed issuer refused the seed")
+	}
+	return s.Pulse, nil
 }
 
-// Pop deletes and returns seed from the pool
-func (sm *SeedManager) Pop(seed Seed) (insolar.PulseNumber, bool) {
+// useOwn checks the seed, that is issued by this node, and marks it as used.
+func (sm *SeedManager) useOwn(raw []byte) (insolar.PulseNumber, error) {
+	s, err := SignedSeedFromBytes(raw)
+	if err != nil {
+		return 0, err
+	}
+	if s.Issuer != sm.nodes.GetOrigin().ShortID() {
+		return 0, errors.Errorf("seed is issued by node %d", s.Issuer)
+	}
+	key, err := sm.crypto.GetPublicKey()
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to get public key")
+	}
+	if !sm.crypto.Verify(key, insolar.SignatureFromBytes(s.Signature), sm.signedData(*s)) {
+		return 0, errors.New("wrong seed signature")
+	}
+
 	sm.mutex.Lock()
 	defer sm.mutex.Unlock()
-	stored, ok := sm.seedPool[seed]
 
-	if ok && !sm.isExpired(stored.ts) {
-		delete(sm.seedPool, seed)
-		return stored.pulse, true
+	if sm.isExpired(s.IssuedAt) {
+		return 0, errors.New("seed is expired")
 	}
+	if _, ok := sm.used[s.Random]; ok {
+		return 0, errors.New("seed is already used")
+	}
+	sm.used[s.Random] = s.IssuedAt
+	return s.Pulse, nil
+}
 
-	return 0, false
+// isExpired checks time, that is set by the issuer. Issue time in the future is allowed within ttl to tolerate clock skew.
+func (sm *SeedManager) isExpired(issuedAt time.Time) bool {
+	age := time.Since(issuedAt)
+	return age > sm.ttl || age < -sm.ttl
 }
 
 func (sm *SeedManager) deleteExpired() {
 	sm.mutex.Lock()
 	defer sm.mutex.Unlock()
 
-	for seed, stored := range sm.seedPool {
-		if sm.isExpired(stored.ts) {
-			delete(sm.seedPool, seed)
+	for seed, issuedAt := range sm.used {
+		if sm.isExpired(issuedAt) {
+			delete(sm.used, seed)
 		}
 	}
 }
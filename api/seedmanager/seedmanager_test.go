@@ -6,15 +6,27 @@
 package seedmanager
 
 import (
+	"context"
 	"sync"
 	"testing"
 	"time"
 
+	"github.com/gojuno/minimock/v3"
 	"github.com/stretchr/testify/require"
 
+	"github.com/insolar/insolar/cryptography"
 	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/insolar/pulse"
+	"github.com/insolar/insolar/network"
+	"github.com/insolar/insolar/network/controller"
+	"github.com/insolar/insolar/network/node"
+	"github.com/insolar/insolar/platformpolicy"
+	networkUtils "github.com/insolar/insolar/testutils/network"
 )
 
+const testDomain = "test.useSeed"
+
 func TestSeedFromBytes_BadInputSize(t *testing.T) {
 	var badSeedBytes []byte
 	res := SeedFromBytes(badSeedBytes)
@@ -31,10 +43,25 @@ func TestSeedFromBytes(t *testing.T) {
 	require.Equal(t, seedBytes, res[:])
 }
 
-func TestNew(t *testing.T) {
-	sm := New()
-	require.Empty(t, sm.seedPool)
-	sm.Stop()
+func TestSignedSeedFromBytes(t *testing.T) {
+	s := SignedSeed{
+		Pulse:     insolar.GenesisPulse.PulseNumber,
+		IssuedAt:  time.Unix(0, time.Now().UnixNano()),
+		Issuer:    42,
+		Random:    getSeed(t),
+		Signature: []byte("signature"),
+	}
+	parsed, err := SignedSeedFromBytes(s.Bytes())
+	require.NoError(t, err)
+	require.Equal(t, s, *parsed)
+
+	_, err = SignedSeedFromBytes(s.payload())
+	require.Error(t, err)
+
+	raw := s.Bytes()
+	raw[0] = seedVersion + 1
+	_, err = SignedSeedFromBytes(raw)
+	require.Error(t, err)
 }
 
 func getSeed(t *testing.T) Seed {
@@ -44,62 +71,214 @@ func getSeed(t *testing.T) Seed {
 	return *seed
 }
 
-func TestSeedManager_Add(t *testing.T) {
-	sm := NewSpecified(time.Duration(1*time.Minute), DefaultCleanPeriod)
-	seed := getSeed(t)
-	sm.Add(seed, 5)
-	pulse, exists := sm.Pop(seed)
-	require.True(t, exists)
-	require.Equal(t, insolar.PulseNumber(5), pulse)
-	sm.Stop()
+// testNode is a node with its seed manager and network, that routes remote procedures to other test nodes.
+type testNode struct {
+	node   insolar.NetworkNode
+	sm     *SeedManager
+	rpc    *controller.RPCControllerMock
+	active map[insolar.ShortNodeID]insolar.NetworkNode
+
+	procedure controller.RemoteProcedure
+}
+
+func newTestNode(t *testing.T, mc *minimock.Controller, ttl time.Duration) *testNode {
+	kp := platformpolicy.NewKeyProcessor()
+	key, err := kp.GeneratePrivateKey()
+	require.NoError(t, err)
+
+	tn := &testNode{
+		node:   node.NewNode(gen.Reference(), insolar.StaticRoleVirtual, kp.ExtractPublicKey(key), "127.0.0.1:0", ""),
+		active: map[insolar.ShortNodeID]insolar.NetworkNode{},
+	}
+	tn.active[tn.node.ShortID()] = tn.node
+
+	accessor := networkUtils.NewAccessorMock(mc).GetActiveNodeByShortIDMock.Set(func(id insolar.ShortNodeID) insolar.NetworkNode {
+		return tn.active[id]
+	})
+	nodes := networkUtils.NewNodeNetworkMock(mc).
+		GetOriginMock.Return(tn.node).
+		GetAccessorMock.Set(func(insolar.PulseNumber) network.Accessor { return accessor })
+	pulses := pulse.NewAccessorMock(mc).LatestMock.Return(*insolar.GenesisPulse, nil)
+	tn.rpc = controller.NewRPCControllerMock(mc).RemoteProcedureRegisterMock.Set(func(name string, method controller.RemoteProcedure) {
+		require.Equal(t, testDomain, name)
+		tn.procedure = method
+	})
+
+	crypto := cryptography.NewKeyBoundCryptographyService(key)
+	tn.sm = NewSpecified(ttl, DefaultCleanPeriod, testDomain, crypto, nodes, pulses, tn.rpc)
+	tn.sm.Start()
+	return tn
+}
+
+// connect makes nodes active for each other and routes remote procedures between them.
+func connect(nodes ...*testNode) {
+	byRef := map[insolar.Reference]*testNode{}
+	for _, n := range nodes {
+		byRef[n.node.ID()] = n
+		for _, other := range nodes {
+			n.active[other.node.ShortID()] = other.node
+		}
+	}
+	for _, n := range nodes {
+		n.rpc.SendBytesMock.Set(func(ctx context.Context, nodeID insolar.Reference, name string, msgBytes []byte) ([]byte, error) {
+			return byRef[nodeID].procedure(ctx, msgBytes)
+		})
+	}
+}
+
+func TestSeedManager_Use(t *testing.T) {
+	ctx := context.Background()
+	mc := minimock.NewController(t)
+
+	tn := newTestNode(t, mc, time.Minute)
+	defer tn.sm.Stop()
+
+	seed, err := tn.sm.Issue(ctx)
+	require.NoError(t, err)
+	require.Equal(t, tn.node.ShortID(), seed.Issuer)
+
+	pn, err := tn.sm.Use(ctx, seed.Bytes())
+	require.NoError(t, err)
+	require.Equal(t, insolar.GenesisPulse.PulseNumber, pn)
+
+	// single use
+	_, err = tn.sm.Use(ctx, seed.Bytes())
+	require.Error(t, err)
+
+	// pulse is signed
+	seed, err = tn.sm.Issue(ctx)
+	require.NoError(t, err)
+	seed.Pulse++
+	_, err = tn.sm.Use(ctx, seed.Bytes())
+	require.Error(t, err)
 }
 
 func TestSeedManager_ExpiredSeed(t *testing.T) {
-	expTime := time.Duration(1 * time.Minute)
-	sm := NewSpecified(expTime, DefaultCleanPeriod)
-	seed := getSeed(t)
-	sm.Add(seed, 0)
-	sm.seedPool[seed] = storedSeed{time.Now().Add(-expTime).Add(-1 * time.Second), 0}
-	_, exists := sm.Pop(seed)
-	require.False(t, exists)
-	sm.Stop()
+	ctx := context.Background()
+	mc := minimock.NewController(t)
+
+	ttl := time.Minute
+	tn := newTestNode(t, mc, ttl)
+	defer tn.sm.Stop()
+
+	seed, err := tn.sm.Issue(ctx)
+	require.NoError(t, err)
+	seed.IssuedAt = seed.IssuedAt.Add(-ttl - time.Second)
+	signature, err := tn.sm.crypto.Sign(tn.sm.signedData(*seed))
+	require.NoError(t, err)
+	seed.Signature = signature.Bytes()
+
+	_, err = tn.sm.Use(ctx, seed.Bytes())
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "expired")
+}
+
+func TestSeedManager_OtherNodes(t *testing.T) {
+	ctx := context.Background()
+	mc := minimock.NewController(t)
+
+	issuer := newTestNode(t, mc, time.Minute)
+	defer issuer.sm.Stop()
+	first := newTestNode(t, mc, time.Minute)
+	defer first.sm.Stop()
+	second := newTestNode(t, mc, time.Minute)
+	defer second.sm.Stop()
+
+	seed, err := issuer.sm.Issue(ctx)
+	require.NoError(t, err)
+
+	// seed of unknown node is rejected
+	_, err = first.sm.Use(ctx, seed.Bytes())
+	require.Error(t, err)
+
+	connect(issuer, first, second)
+	pn, err := first.sm.Use(ctx, seed.Bytes())
+	require.NoError(t, err)
+	require.Equal(t, seed.Pulse, pn)
+
+	// replay on any node is rejected by the issuer
+	_, err = second.sm.Use(ctx, seed.Bytes())
+	require.Error(t, err)
+	_, err = issuer.sm.Use(ctx, seed.Bytes())
+	require.Error(t, err)
+
+	// seed of the other domain is not accepted
+	seed, err = issuer.sm.Issue(ctx)
+	require.NoError(t, err)
+	first.sm.domain = "other.useSeed"
+	_, err = first.sm.Use(ctx, seed.Bytes())
+	require.Error(t, err)
+}
+
+func TestSeedManager_DeleteExpired(t *testing.T) {
+	ctx := context.Background()
+	mc := minimock.NewController(t)
+
+	ttl := time.Minute
+	tn := newTestNode(t, mc, ttl)
+	defer tn.sm.Stop()
+
+	seed, err := tn.sm.Issue(ctx)
+	require.NoError(t, err)
+	_, err = tn.sm.Use(ctx, seed.Bytes())
+	require.NoError(t, err)
+	require.Len(t, tn.sm.used, 1)
+
+	tn.sm.used[seed.Random] = time.Now().Add(-ttl - time.Second)
+	tn.sm.deleteExpired()
+	require.Empty(t, tn.sm.used)
 }
 
-func TestSeedManager_ExpiredSeedAfterCleaning(t *testing.T) {
-	expTime := time.Duration(1 * time.Minute)
-	sm := NewSpecified(expTime, 1*time.Minute)
-	seed := getSeed(t)
-	sm.Add(seed, 0)
-	sm.seedPool[seed] = storedSeed{time.Now().Add(-expTime).Add(-1 * time.Second), 0}
-	_, exists := sm.Pop(seed)
-	require.False(t, exists)
-	sm.Stop()
+func TestSeedManager_CleanPeriod(t *testing.T) {
+	ctx := context.Background()
+	mc := minimock.NewController(t)
+
+	tn := newTestNode(t, mc, time.Minute)
+	tn.sm.Stop()
+	ttl := 50 * time.Millisecond
+	tn.sm = NewSpecified(ttl, time.Millisecond, testDomain, tn.sm.crypto, tn.sm.nodes, tn.sm.pulses, nil)
+	defer tn.sm.Stop()
+
+	seed, err := tn.sm.Issue(ctx)
+	require.NoError(t, err)
+	_, err = tn.sm.Use(ctx, seed.Bytes())
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		tn.sm.mutex.Lock()
+		defer tn.sm.mutex.Unlock()
+		return len(tn.sm.used) == 0
+	}, time.Minute, time.Millisecond, "expired used seed isn't purged")
 }
 
 func TestRace(t *testing.T) {
 	const numConcurrent = 10
 
-	expTime := time.Duration(2 * time.Millisecond)
-	cleanPeriod := time.Duration(1 * time.Millisecond)
-	sm := NewSpecified(expTime, cleanPeriod)
+	ctx := context.Background()
+	mc := minimock.NewController(t)
+
+	tn := newTestNode(t, mc, time.Minute)
+	tn.sm.Stop()
+	tn.sm = NewSpecified(2*time.Millisecond, time.Millisecond, testDomain, tn.sm.crypto, tn.sm.nodes, tn.sm.pulses, nil)
 
 	wg := sync.WaitGroup{}
 	wg.Add(numConcurrent)
 	for i := 0; i < numConcurrent; i++ {
 		go func() {
 			defer wg.Done()
-			var seeds []Seed
-			numIterations := 300
+			var seeds []*SignedSeed
+			numIterations := 30
 			for j := 0; j < numIterations; j++ {
-				seeds = append(seeds, getSeed(t))
-				sm.Add(seeds[len(seeds)-1], 0)
+				seed, err := tn.sm.Issue(ctx)
+				require.NoError(t, err)
+				seeds = append(seeds, seed)
 			}
-			<-time.After(cleanPeriod)
+			<-time.After(time.Millisecond)
 			for j := 0; j < numIterations; j++ {
-				sm.Pop(seeds[j])
+				_, _ = tn.sm.Use(ctx, seeds[j].Bytes())
 			}
 		}()
 	}
 	wg.Wait()
-	sm.Stop()
+	tn.sm.Stop()
 }
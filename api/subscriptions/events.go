@@ -0,0 +1,108 @@
+// Copyright 2020 Insolar Network Ltd.
+// All rights reserved.
+// This material is licensed under the Insolar License version 1.0,
+// available at https://github.com/insolar/insolar/blob/master/LICENSE.md.
+
+package subscriptions
+
+import (
+	"bytes"
+
+	"github.com/insolar/insolar/applicationbase/extractor"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/payload"
+	"github.com/insolar/insolar/insolar/reply"
+)
+
+// Types of events.
+const (
+	EventPulse   = "pulse"
+	EventRequest = "request"
+	EventNetwork = "network"
+)
+
+// Event is sent to subscribers.
+type Event struct {
+	Type string      `json:"type"`
+	Data interface{} `json:"data"`
+
+	// request is a reference of the request for request events
+	request insolar.Reference
+}
+
+// PulseEvent is sent when node gets a new pulse.
+type PulseEvent struct {
+	PulseNumber     insolar.PulseNumber `json:"pulseNumber"`
+	PrevPulseNumber insolar.PulseNumber `json:"prevPulseNumber"`
+	NextPulseNumber insolar.PulseNumber `json:"nextPulseNumber"`
+	PulseTimestamp  int64               `json:"pulseTimestamp"`
+}
+
+// RequestEvent is sent when results of a request are received.
+type RequestEvent struct {
+	RequestReference string      `json:"requestReference"`
+	CallResult       interface{} `json:"callResult,omitempty"`
+	Error            string      `json:"error,omitempty"`
+}
+
+// NetworkEvent is sent when network state of the node changes.
+type NetworkEvent struct {
+	State       string              `json:"state"`
+	PrevState   string              `json:"prevState"`
+	PulseNumber insolar.PulseNumber `json:"pulseNumber"`
+}
+
+func newPulseEvent(pulse insolar.Pulse) Event {
+	return Event{
+		Type: EventPulse,
+		Data: PulseEvent{
+			PulseNumber:     pulse.PulseNumber,
+			PrevPulseNumber: pulse.PrevPulseNumber,
+			NextPulseNumber: pulse.NextPulseNumber,
+			PulseTimestamp:  pulse.PulseTimestamp,
+		},
+	}
+}
+
+func newRequestEvent(msg *payload.ReturnResults) Event {
+	data := RequestEvent{
+		RequestReference: msg.RequestRef.String(),
+		Error:            msg.Error,
+	}
+	if msg.Error == "" {
+		data.CallResult, data.Error = extractResult(msg.Reply)
+	}
+	return Event{Type: EventRequest, Data: data, request: msg.RequestRef}
+}
+
+// extractResult extracts result of the contract call the same way API does it for contract.call.
+func extractResult(rawReply []byte) (interface{}, string) {
+	rep, err := reply.Deserialize(bytes.NewReader(rawReply))
+	if err != nil {
+		return nil, "failed to deserialize reply: " + err.Error()
+	}
+	callMethod, ok := rep.(*reply.CallMethod)
+	if !ok {
+		return nil, ""
+	}
+
+	result, contractErr, err := extractor.CallResponse(callMethod.Result)
+	if err != nil {
+		return nil, err.Error()
+	}
+	if contractErr != nil {
+		return nil, contractErr.Error()
+	}
+	return result, ""
+}
+
+func newNetworkEvent(prev, state insolar.NetworkState, pulse insolar.PulseNumber) Event {
+	return Event{
+		Type: EventNetwork,
+		Data: NetworkEvent{
+			State:       state.String(),
+			PrevState:   prev.String(),
+			PulseNumber: pulse,
+		},
+	}
+}
@@ -0,0 +1,314 @@
+// Copyright 2020 Insolar Network Ltd.
+// All rights reserved.
+// This material is licensed under the Insolar License version 1.0,
+// available at https://github.com/insolar/insolar/blob/master/LICENSE.md.
+
+// Package subscriptions delivers pulses, results of requests and network state changes
+// to API clients subscribed to them.
+package subscriptions
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/payload"
+)
+
+const (
+	// DefaultBufferSize is a number of events buffered for a subscriber.
+	// Subscriber, that doesn't read events in time, is closed.
+	DefaultBufferSize = 64
+	// DefaultMaxSubscriptions limits number of simultaneous subscriptions.
+	DefaultMaxSubscriptions = 1000
+	// DefaultRecentResults is a number of latest request results kept for late subscribers.
+	DefaultRecentResults = 1024
+	// DefaultNetworkCheckPeriod is a period of network state checks between pulses.
+	DefaultNetworkCheckPeriod = time.Second
+)
+
+var (
+	ErrTooManySubscriptions = errors.New("too many subscriptions")
+	ErrEmptyFilter          = errors.New("subscription has no topics")
+	ErrStopped              = errors.New("hub is stopped")
+)
+
+// Filter describes events the subscriber wants to get.
+type Filter struct {
+	Pulses   bool
+	Network  bool
+	Requests []insolar.Reference
+}
+
+func (f Filter) empty() bool {
+	return !f.Pulses && !f.Network && len(f.Requests) == 0
+}
+
+// Subscription receives events matching its filter.
+type Subscription struct {
+	hub      *Hub
+	pulses   bool
+	network  bool
+	requests map[insolar.Reference]struct{}
+
+	events   chan Event
+	done     chan struct{}
+	overflow bool
+}
+
+// Events returns a channel with events of the subscription.
+func (s *Subscription) Events() <-chan Event {
+	return s.events
+}
+
+// Done is closed when the subscription is closed.
+func (s *Subscription) Done() <-chan struct{} {
+	return s.done
+}
+
+// Overflowed returns true if the subscription was closed because the subscriber didn't read events in time.
+func (s *Subscription) Overflowed() bool {
+	s.hub.lock.RLock()
+	defer s.hub.lock.RUnlock()
+
+	return s.overflow
+}
+
+// Close unsubscribes from events.
+func (s *Subscription) Close() {
+	s.hub.lock.Lock()
+	defer s.hub.lock.Unlock()
+
+	s.hub.remove(s)
+}
+
+func (s *Subscription) matches(event Event) bool {
+	switch event.Type {
+	case EventPulse:
+		return s.pulses
+	case EventNetwork:
+		return s.network
+	case EventRequest:
+		_, ok := s.requests[event.request]
+		return ok
+	default:
+		return false
+	}
+}
+
+// Hub receives events from pulse manager and contract requester and delivers them to subscribers.
+// Hub implements dispatcher.Dispatcher to be notified by pulse manager about new pulses.
+// It's thread safe.
+type Hub struct {
+	networkStatus insolar.NetworkStatus
+
+	lock          sync.RWMutex
+	subscriptions map[*Subscription]struct{}
+	networkState  insolar.NetworkState
+	latestPulse   insolar.PulseNumber
+
+	// results of latest requests for subscribers, that subscribe after request is done
+	recent      map[insolar.Reference]Event
+	recentOrder []insolar.Reference
+
+	bufferSize         int
+	maxSubscriptions   int
+	recentResults      int
+	networkCheckPeriod time.Duration
+
+	started bool
+	stopped chan struct{}
+}
+
+// New creates new hub with default params.
+func New(networkStatus insolar.NetworkStatus) *Hub {
+	return NewSpecified(networkStatus, DefaultBufferSize, DefaultMaxSubscriptions, DefaultRecentResults, DefaultNetworkCheckPeriod)
+}
+
+// NewSpecified creates new hub with custom params.
+func NewSpecified(
+	networkStatus insolar.NetworkStatus,
+	bufferSize, maxSubscriptions, recentResults int,
+	networkCheckPeriod time.Duration,
+) *Hub {
+	return &Hub{
+		networkStatus:      networkStatus,
+		subscriptions:      map[*Subscription]struct{}{},
+		recent:             map[insolar.Reference]Event{},
+		bufferSize:         bufferSize,
+		maxSubscriptions:   maxSubscriptions,
+		recentResults:      recentResults,
+		networkCheckPeriod: networkCheckPeriod,
+		stopped:            make(chan struct{}),
+	}
+}
+
+// Start starts network state checks. Network must be initialized at this moment.
+func (h *Hub) Start() {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	if h.started || h.networkStatus == nil {
+		return
+	}
+	h.started = true
+	h.networkState = h.networkStatus.GetNetworkStatus().NetworkState
+
+	go func() {
+		ticker := time.NewTicker(h.networkCheckPeriod)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				h.checkNetworkState()
+			case <-h.stopped:
+				return
+			}
+		}
+	}()
+}
+
+// Stop closes all subscriptions and stops network state checks.
+func (h *Hub) Stop() {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	select {
+	case <-h.stopped:
+		return
+	default:
+	}
+	close(h.stopped)
+
+	for s := range h.subscriptions {
+		h.remove(s)
+	}
+}
+
+// Subscribe creates a subscription for events matching the filter.
+func (h *Hub) Subscribe(filter Filter) (*Subscription, error) {
+	if filter.empty() {
+		return nil, ErrEmptyFilter
+	}
+
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	select {
+	case <-h.stopped:
+		return nil, ErrStopped
+	default:
+	}
+	if len(h.subscriptions) >= h.maxSubscriptions {
+		return nil, ErrTooManySubscriptions
+	}
+
+	s := &Subscription{
+		hub:      h,
+		pulses:   filter.Pulses,
+		network:  filter.Network,
+		requests: make(map[insolar.Reference]struct{}, len(filter.Requests)),
+		events:   make(chan Event, h.bufferSize),
+		done:     make(chan struct{}),
+	}
+	h.subscriptions[s] = struct{}{}
+
+	for _, ref := range filter.Requests {
+		s.requests[ref] = struct{}{}
+		if event, ok := h.recent[ref]; ok {
+			h.send(s, event)
+		}
+	}
+
+	return s, nil
+}
+
+// OnResult is called by contract requester when results of a request are received.
+func (h *Hub) OnResult(_ context.Context, msg *payload.ReturnResults) {
+	event := newRequestEvent(msg)
+
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	if _, ok := h.recent[msg.RequestRef]; !ok {
+		h.recentOrder = append(h.recentOrder, msg.RequestRef)
+		if len(h.recentOrder) > h.recentResults {
+			delete(h.recent, h.recentOrder[0])
+			h.recentOrder = h.recentOrder[1:]
+		}
+	}
+	h.recent[msg.RequestRef] = event
+
+	h.publish(event)
+}
+
+// BeginPulse is called by pulse manager when a new pulse begins.
+func (h *Hub) BeginPulse(_ context.Context, pulse insolar.Pulse) {
+	h.lock.Lock()
+	h.latestPulse = pulse.PulseNumber
+	h.publish(newPulseEvent(pulse))
+	h.lock.Unlock()
+
+	h.checkNetworkState()
+}
+
+// ClosePulse is called by pulse manager when the current pulse is closed.
+func (h *Hub) ClosePulse(context.Context, insolar.Pulse) {}
+
+// Process does nothing, hub doesn't handle messages.
+func (h *Hub) Process(*message.Message) error {
+	return nil
+}
+
+func (h *Hub) checkNetworkState() {
+	h.lock.RLock()
+	started := h.started
+	h.lock.RUnlock()
+	if !started {
+		return
+	}
+	state := h.networkStatus.GetNetworkStatus().NetworkState
+
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	if state == h.networkState {
+		return
+	}
+	event := newNetworkEvent(h.networkState, state, h.latestPulse)
+	h.networkState = state
+	h.publish(event)
+}
+
+// publish must be called under the write lock.
+func (h *Hub) publish(event Event) {
+	for s := range h.subscriptions {
+		if s.matches(event) {
+			h.send(s, event)
+		}
+	}
+}
+
+// send must be called under the write lock.
+func (h *Hub) send(s *Subscription, event Event) {
+	select {
+	case s.events <- event:
+	default:
+		s.overflow = true
+		h.remove(s)
+	}
+}
+
+// remove must be called under the write lock.
+func (h *Hub) remove(s *Subscription) {
+	if _, ok := h.subscriptions[s]; !ok {
+		return
+	}
+	delete(h.subscriptions, s)
+	close(s.done)
+}
@@ -0,0 +1,183 @@
+// Copyright 2020 Insolar Network Ltd.
+// All rights reserved.
+// This material is licensed under the Insolar License version 1.0,
+// available at https://github.com/insolar/insolar/blob/master/LICENSE.md.
+
+package subscriptions
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/insolar/payload"
+	"github.com/insolar/insolar/insolar/reply"
+	"github.com/insolar/insolar/logicrunner/builtin/foundation"
+)
+
+type networkStatus struct {
+	lock  sync.Mutex
+	state insolar.NetworkState
+}
+
+func (n *networkStatus) GetNetworkStatus() insolar.StatusReply {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	return insolar.StatusReply{NetworkState: n.state}
+}
+
+func (n *networkStatus) set(state insolar.NetworkState) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	n.state = state
+}
+
+func receive(t *testing.T, s *Subscription) Event {
+	select {
+	case event := <-s.Events():
+		return event
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "event is not received")
+	}
+	return Event{}
+}
+
+func returnResults(t *testing.T, ref insolar.Reference, result interface{}) *payload.ReturnResults {
+	data, err := foundation.MarshalMethodResult(result, nil)
+	require.NoError(t, err)
+	return &payload.ReturnResults{
+		RequestRef: ref,
+		Reply:      reply.ToBytes(&reply.CallMethod{Result: data}),
+	}
+}
+
+func TestHub_Pulses(t *testing.T) {
+	ctx := context.Background()
+	h := New(&networkStatus{state: insolar.CompleteNetworkState})
+	h.Start()
+	defer h.Stop()
+
+	pulses, err := h.Subscribe(Filter{Pulses: true})
+	require.NoError(t, err)
+	requests, err := h.Subscribe(Filter{Requests: []insolar.Reference{gen.Reference()}})
+	require.NoError(t, err)
+
+	pn := gen.PulseNumber()
+	h.BeginPulse(ctx, insolar.Pulse{PulseNumber: pn, PrevPulseNumber: pn - 10, NextPulseNumber: pn + 10})
+
+	event := receive(t, pulses)
+	require.Equal(t, EventPulse, event.Type)
+	require.Equal(t, PulseEvent{PulseNumber: pn, PrevPulseNumber: pn - 10, NextPulseNumber: pn + 10}, event.Data)
+	require.Len(t, requests.Events(), 0)
+}
+
+func TestHub_Requests(t *testing.T) {
+	ctx := context.Background()
+	h := New(nil)
+	defer h.Stop()
+
+	ref := gen.Reference()
+	sub, err := h.Subscribe(Filter{Requests: []insolar.Reference{ref}})
+	require.NoError(t, err)
+
+	h.OnResult(ctx, returnResults(t, gen.Reference(), "other"))
+	h.OnResult(ctx, returnResults(t, ref, "OK"))
+
+	event := receive(t, sub)
+	require.Equal(t, EventRequest, event.Type)
+	require.Equal(t, RequestEvent{RequestReference: ref.String(), CallResult: "OK"}, event.Data)
+	require.Len(t, sub.Events(), 0)
+
+	// late subscriber gets result of the finished request
+	late, err := h.Subscribe(Filter{Requests: []insolar.Reference{ref}})
+	require.NoError(t, err)
+	event = receive(t, late)
+	require.Equal(t, RequestEvent{RequestReference: ref.String(), CallResult: "OK"}, event.Data)
+
+	h.OnResult(ctx, &payload.ReturnResults{RequestRef: ref, Error: "failed"})
+	event = receive(t, sub)
+	require.Equal(t, RequestEvent{RequestReference: ref.String(), Error: "failed"}, event.Data)
+}
+
+func TestHub_RecentResultsLimit(t *testing.T) {
+	ctx := context.Background()
+	h := NewSpecified(nil, DefaultBufferSize, DefaultMaxSubscriptions, 1, DefaultNetworkCheckPeriod)
+	defer h.Stop()
+
+	first, second := gen.Reference(), gen.Reference()
+	h.OnResult(ctx, returnResults(t, first, "first"))
+	h.OnResult(ctx, returnResults(t, second, "second"))
+
+	sub, err := h.Subscribe(Filter{Requests: []insolar.Reference{first, second}})
+	require.NoError(t, err)
+	event := receive(t, sub)
+	require.Equal(t, second.String(), event.Data.(RequestEvent).RequestReference)
+	require.Len(t, sub.Events(), 0)
+}
+
+func TestHub_Network(t *testing.T) {
+	ctx := context.Background()
+	status := &networkStatus{state: insolar.WaitConsensus}
+	h := NewSpecified(status, DefaultBufferSize, DefaultMaxSubscriptions, DefaultRecentResults, time.Millisecond)
+	h.Start()
+	defer h.Stop()
+
+	sub, err := h.Subscribe(Filter{Network: true})
+	require.NoError(t, err)
+
+	status.set(insolar.CompleteNetworkState)
+	event := receive(t, sub)
+	require.Equal(t, EventNetwork, event.Type)
+	require.Equal(t, NetworkEvent{
+		State:     insolar.CompleteNetworkState.String(),
+		PrevState: insolar.WaitConsensus.String(),
+	}, event.Data)
+
+	// the same state isn't sent twice
+	h.BeginPulse(ctx, insolar.Pulse{PulseNumber: gen.PulseNumber()})
+	require.Len(t, sub.Events(), 0)
+}
+
+func TestHub_Subscribe_Errors(t *testing.T) {
+	h := NewSpecified(nil, DefaultBufferSize, 1, DefaultRecentResults, DefaultNetworkCheckPeriod)
+
+	_, err := h.Subscribe(Filter{})
+	require.Equal(t, ErrEmptyFilter, err)
+
+	sub, err := h.Subscribe(Filter{Pulses: true})
+	require.NoError(t, err)
+	_, err = h.Subscribe(Filter{Pulses: true})
+	require.Equal(t, ErrTooManySubscriptions, err)
+
+	sub.Close()
+	_, err = h.Subscribe(Filter{Pulses: true})
+	require.NoError(t, err)
+
+	h.Stop()
+	_, err = h.Subscribe(Filter{Pulses: true})
+	require.Equal(t, ErrStopped, err)
+}
+
+func TestHub_Overflow(t *testing.T) {
+	ctx := context.Background()
+	h := NewSpecified(nil, 1, DefaultMaxSubscriptions, DefaultRecentResults, DefaultNetworkCheckPeriod)
+	defer h.Stop()
+
+	sub, err := h.Subscribe(Filter{Pulses: true})
+	require.NoError(t, err)
+
+	h.BeginPulse(ctx, insolar.Pulse{PulseNumber: gen.PulseNumber()})
+	h.BeginPulse(ctx, insolar.Pulse{PulseNumber: gen.PulseNumber()})
+
+	select {
+	case <-sub.Done():
+	default:
+		require.FailNow(t, "subscription is not closed")
+	}
+	require.True(t, sub.Overflowed())
+}
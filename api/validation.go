@@ -0,0 +1,31 @@
+// Copyright 2020 Insolar Network Ltd.
+// All rights reserved.
+// This material is licensed under the Insolar License version 1.0,
+// available at https://github.com/insolar/insolar/blob/master/LICENSE.md.
+
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/insolar/insolar/instrumentation/inslogger"
+)
+
+// ValidationHandler is a HTTP handler of admin API, that exports results of the latest validations made by the node.
+func (ar *Runner) ValidationHandler(w http.ResponseWriter, r *http.Request) {
+	if ar.ValidationJournal == nil {
+		http.Error(w, "validation journal is not available", http.StatusServiceUnavailable)
+		return
+	}
+	if r.Method != http.MethodGet {
+		http.Error(w, "GET method required", http.StatusMethodNotAllowed)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err := json.NewEncoder(w).Encode(ar.ValidationJournal.ValidationResults())
+	if err != nil {
+		inslogger.FromContext(r.Context()).Error("failed to write validation results: ", err)
+	}
+}
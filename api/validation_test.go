@@ -0,0 +1,62 @@
+// Copyright 2020 Insolar Network Ltd.
+// All rights reserved.
+// This material is licensed under the Insolar License version 1.0,
+// available at https://github.com/insolar/insolar/blob/master/LICENSE.md.
+
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/insolar"
+)
+
+type validationJournal []insolar.ValidationResult
+
+func (j validationJournal) ValidationResults() []insolar.ValidationResult {
+	return j
+}
+
+func TestRunner_ValidationHandler(t *testing.T) {
+	journal := validationJournal{{
+		Time:     time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+		Pulse:    65537,
+		Object:   "object",
+		Request:  "request",
+		Executor: "executor",
+		Status:   insolar.ValidationMismatch,
+		Details:  "result differs from registered",
+	}}
+	ar := &Runner{ValidationJournal: journal}
+
+	t.Run("results", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		ar.ValidationHandler(w, httptest.NewRequest(http.MethodGet, "/admin-api/validation", nil))
+		require.Equal(t, http.StatusOK, w.Code)
+		require.Equal(t, "application/json", w.Header().Get("Content-Type"))
+
+		var result []insolar.ValidationResult
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &result))
+		require.Equal(t, []insolar.ValidationResult(journal), result)
+	})
+
+	t.Run("wrong method", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		ar.ValidationHandler(w, httptest.NewRequest(http.MethodDelete, "/admin-api/validation", nil))
+		require.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	})
+
+	t.Run("no journal", func(t *testing.T) {
+		ar := &Runner{}
+
+		w := httptest.NewRecorder()
+		ar.ValidationHandler(w, httptest.NewRequest(http.MethodGet, "/admin-api/validation", nil))
+		require.Equal(t, http.StatusServiceUnavailable, w.Code)
+	})
+}
@@ -88,6 +88,9 @@ func (rv *RequestValidator) ServeHTTP(w http.ResponseWriter, httpReq *http.Reque
 	rv.nextHandler.ServeHTTP(w, httpReq)
 }
 
+// batchCallMethod is a name of the method, that carries several contract calls.
+const batchCallMethod = "contract.callBatch"
+
 var (
 	findMethodNameRE = regexp.MustCompile(`"method":\s*"([^"]+)"`)
 	findCallSiteRE   = regexp.MustCompile(`"callSite":\s*"([^"]+)"`)
@@ -130,6 +133,35 @@ func (rv *RequestValidator) Validate(ctx context.Context, httpReq *http.Request)
 
 	httpReq.Body = ioutil.NopCloser(bytes.NewBuffer(body))
 
+	if match := findMethodNameRE.FindSubmatch(body); match != nil && string(match[1]) == batchCallMethod {
+		return rv.validateBatch(ctx, httpReq, reqURL, body)
+	}
+
+	return rv.validateBody(ctx, httpReq, reqURL, body)
+}
+
+// validateBatch validates request of every call of the contract.callBatch request as a separate contract.call request.
+func (rv *RequestValidator) validateBatch(ctx context.Context, httpReq *http.Request, reqURL *url.URL, body []byte) error {
+	var batch requester.BatchRequest
+	err := json.Unmarshal(body, &batch)
+	if err != nil {
+		return errors.Wrap(err, "failed to unmarshal batch request")
+	}
+
+	for i, call := range batch.Params.Calls {
+		callReq := httpReq.Clone(ctx)
+		callReq.Body = ioutil.NopCloser(bytes.NewBuffer(call.Request))
+		callURL := *reqURL
+		err = rv.validateBody(ctx, callReq, &callURL, call.Request)
+		if err != nil {
+			return errors.Wrapf(err, "call %d", i)
+		}
+	}
+
+	return nil
+}
+
+func (rv *RequestValidator) validateBody(ctx context.Context, httpReq *http.Request, reqURL *url.URL, body []byte) error {
 	match := findCallSiteRE.FindSubmatch(body)
 	if match == nil {
 		match = findMethodNameRE.FindSubmatch(body)
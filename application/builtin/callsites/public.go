@@ -0,0 +1,38 @@
+// Copyright 2020 Insolar Network Ltd.
+// All rights reserved.
+// This material is licensed under the Insolar License version 1.0,
+// available at https://github.com/insolar/insolar/blob/master/LICENSE.md.
+
+package callsites
+
+import (
+	"sync/atomic"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/logicrunner/builtin/callsite"
+)
+
+// allowed is a registry restricted to call sites, that users can call through member.
+var allowed atomic.Value
+
+func init() {
+	Allow()
+}
+
+// PublicCallSites returns names of call sites marked with `ins:public` in the source of the contracts.
+func PublicCallSites() []string {
+	return Registry.Public()
+}
+
+// Allow sets call sites, that users can call through member. Empty list means public call sites.
+func Allow(names ...string) {
+	if len(names) == 0 {
+		names = PublicCallSites()
+	}
+	allowed.Store(Registry.Restrict(names...))
+}
+
+// Call dispatches a call of an allowed call site from API request.
+func Call(name string, caller insolar.Reference, params map[string]interface{}) (interface{}, error) {
+	return allowed.Load().(*callsite.Registry).Call(name, caller, params)
+}
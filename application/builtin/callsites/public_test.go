@@ -0,0 +1,35 @@
+// Copyright 2020 Insolar Network Ltd.
+// All rights reserved.
+// This material is licensed under the Insolar License version 1.0,
+// available at https://github.com/insolar/insolar/blob/master/LICENSE.md.
+
+package callsites
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/logicrunner/builtin/callsite"
+)
+
+func requireNotAllowed(t *testing.T, name string) {
+	_, err := Call(name, gen.Reference(), map[string]interface{}{})
+	require.IsType(t, &callsite.Error{}, err)
+	require.Equal(t, callsite.ErrorNotAllowed, err.(*callsite.Error).Code)
+}
+
+func TestAllow(t *testing.T) {
+	defer Allow()
+
+	require.Contains(t, PublicCallSites(), "first.Get")
+	require.NotContains(t, PublicCallSites(), "first.DoNothing")
+	requireNotAllowed(t, "first.DoNothing")
+
+	Allow("first.DoNothing")
+	requireNotAllowed(t, "first.Get")
+
+	Allow()
+	requireNotAllowed(t, "first.DoNothing")
+}
@@ -20,32 +20,40 @@ type One struct {
 	Friend insolar.Reference
 }
 
+//ins:public
 func New() (*One, error) {
 	return &One{}, nil
 }
 
+//ins:public
 func (r *One) Panic() error {
 	panic("AAAAAAAA!")
 	return nil
 }
+
+//ins:public
 func NewPanic() (*One, error) {
 	panic("BBBBBBBB!")
 }
 
+//ins:public
 func (r *One) Recursive() error {
 	remoteSelf := recursive.GetObject(r.GetReference())
 	err := remoteSelf.Recursive()
 	return err
 }
 
+//ins:public
 func (r *One) Test(firstRef *insolar.Reference) (string, error) {
 	return second.GetObject(*firstRef).GetName()
 }
 
+//ins:public
 func NewZero() (*One, error) {
 	return &One{Number: 0}, nil
 }
 
+//ins:public(num=amount)
 func NewWithNumber(num int) (*One, error) {
 	return &One{Number: num}, nil
 }
@@ -54,20 +62,24 @@ func (r *One) DoNothing() error {
 	return nil
 }
 
+//ins:public
 func (r *One) Inc() (int, error) {
 	r.Number++
 	return r.Number, nil
 }
 
+//ins:public
 func (r *One) Get() (int, error) {
 	return r.Number, nil
 }
 
+//ins:public
 func (r *One) Dec() (int, error) {
 	r.Number--
 	return r.Number, nil
 }
 
+//ins:public(s=name)
 func (r *One) Hello(s string) (string, error) {
 	holder := second.NewWithX()
 	friend, err := holder.AsChild(r.GetReference())
@@ -82,6 +94,7 @@ func (r *One) Hello(s string) (string, error) {
 	return "Hi, " + s + "! Two said: " + res, nil
 }
 
+//ins:public(s=name)
 func (r *One) Again(s string) (string, error) {
 	res, err := second.GetObject(r.Friend).Hello(s)
 	if err != nil {
@@ -90,10 +103,12 @@ func (r *One) Again(s string) (string, error) {
 	return "Hi, " + s + "! Two said: " + res, nil
 }
 
+//ins:public
 func (r *One) GetFriend() (string, error) {
 	return r.Friend.String(), nil
 }
 
+//ins:public
 func (r *One) TestPayload() (second.Payload, error) {
 	f := second.GetObject(r.Friend)
 	err := f.SetPayload(second.Payload{Int: 10, Str: "HiHere"})
@@ -114,6 +129,7 @@ func (r *One) TestPayload() (second.Payload, error) {
 	return p, nil
 }
 
+//ins:public
 func (r *One) ManyTimes() error {
 	holder := second.New()
 	friend, err := holder.AsChild(r.GetReference())
@@ -129,10 +145,12 @@ func (r *One) ManyTimes() error {
 	return nil
 }
 
+//ins:public
 func NewSaga() (*One, error) {
 	return &One{Number: 100}, nil
 }
 
+//ins:public(n=amount)
 func (r *One) Transfer(n int) (string, error) {
 	rec := recursive.NewSaga()
 	w2, err := rec.AsChild(r.GetReference())
@@ -147,6 +165,7 @@ func (r *One) Transfer(n int) (string, error) {
 	return w2.GetReference().String(), nil
 }
 
+//ins:public(ref=toRef, n=amount)
 func (r *One) TransferTo(ref string, n int) error {
 	recipientReference, err := insolar.NewObjectReferenceFromString(ref)
 	if err != nil {
@@ -162,6 +181,7 @@ func (r *One) TransferTo(ref string, n int) error {
 	return nil
 }
 
+//ins:public
 func (r *One) GetBalance() (int, error) {
 	return r.Number, nil
 }
@@ -181,6 +201,7 @@ type StepOneArgs struct {
 	Amount    int
 }
 
+//ins:public(n=amount)
 func (r *One) TransferWithRollback(n int) (string, error) {
 	second := recursive.NewSaga()
 	w2, err := second.AsChild(r.GetReference())
@@ -222,6 +243,7 @@ func (r *One) RollbackStepTwo(amount int) error {
 	return nil
 }
 
+//ins:public(n=amount)
 func (r *One) TransferTwice(n int) (string, error) {
 	second := recursive.NewSaga()
 	w2, err := second.AsChild(r.GetReference())
@@ -243,6 +265,7 @@ func (r *One) TransferTwice(n int) (string, error) {
 	return w2.GetReference().String(), nil
 }
 
+//ins:public(n=amount)
 func (r *One) TransferToAnotherContract(n int) (string, error) {
 	second := second.NewSaga()
 	w2, err := second.AsChild(r.GetReference())
@@ -257,10 +280,12 @@ func (r *One) TransferToAnotherContract(n int) (string, error) {
 	return w2.GetReference().String(), nil
 }
 
+//ins:public
 func (r *One) SelfRef() (string, error) {
 	return r.GetReference().String(), nil
 }
 
+//ins:public
 func (r *One) AnError() error {
 	holder := second.New()
 	friend, err := holder.AsChild(r.GetReference())
@@ -270,6 +295,7 @@ func (r *One) AnError() error {
 	return friend.AnError()
 }
 
+//ins:public
 func (r *One) NoError() error {
 	holder := second.New()
 	friend, err := holder.AsChild(r.GetReference())
@@ -279,6 +305,7 @@ func (r *One) NoError() error {
 	return friend.NoError()
 }
 
+//ins:public
 func (r *One) ReturnNil() (*string, error) {
 	holder := second.New()
 	friend, err := holder.AsChild(r.GetReference())
@@ -288,6 +315,7 @@ func (r *One) ReturnNil() (*string, error) {
 	return friend.ReturnNil()
 }
 
+//ins:public
 func (r *One) ConstructorReturnNil() (*string, error) {
 	holder := second.NewNil()
 	_, err := holder.AsChild(r.GetReference())
@@ -298,6 +326,7 @@ func (r *One) ConstructorReturnNil() (*string, error) {
 	return &ok, nil
 }
 
+//ins:public
 func (r *One) ConstructorReturnError() (*string, error) {
 	holder := second.NewWithErr()
 	_, err := holder.AsChild(r.GetReference())
@@ -308,6 +337,7 @@ func (r *One) ConstructorReturnError() (*string, error) {
 	return &ok, nil
 }
 
+//ins:public
 func (r *One) GetChildPrototype() (string, error) {
 	holder := second.New()
 	child, err := holder.AsChild(r.GetReference())
@@ -318,6 +348,7 @@ func (r *One) GetChildPrototype() (string, error) {
 	return ref.String(), err
 }
 
+//ins:public
 func (r *One) ExternalImmutableCall() (int, error) {
 	holder := second.New()
 	objTwo, err := holder.AsChild(r.GetReference())
@@ -327,6 +358,7 @@ func (r *One) ExternalImmutableCall() (int, error) {
 	return objTwo.GetAsImmutable()
 }
 
+//ins:public
 func (r *One) ExternalImmutableCallMakesExternalCall() error {
 	holder := second.New()
 	objTwo, err := holder.AsChild(r.GetReference())
@@ -336,6 +368,7 @@ func (r *One) ExternalImmutableCallMakesExternalCall() error {
 	return objTwo.ExternalCallDoNothing()
 }
 
+//ins:public
 func (r *One) AddChildAndReturnMyselfAsParent() (string, error) {
 	holder := second.New()
 	friend, err := holder.AsChild(r.GetReference())
@@ -345,6 +378,7 @@ func (r *One) AddChildAndReturnMyselfAsParent() (string, error) {
 	return friend.GetParent()
 }
 
+//ins:public
 func (r *One) Kill() error {
 	err := r.SelfDestruct()
 	return err
@@ -11,16 +11,20 @@ type One struct {
 	foundation.BaseContract
 }
 
+//ins:public
 func New() (*One, error) {
 	return &One{}, nil
 }
 
 var INSATTR_Panic_API = true
 
+//ins:public
 func (r *One) Panic() error {
 	panic("AAAAAAAA!")
 	return nil
 }
+
+//ins:public
 func NewPanic() (*One, error) {
 	panic("BBBBBBBB!")
 }
@@ -30,6 +30,8 @@ func (r *Second) GetName() (string, error) {
 func New() (*Second, error) {
 	return &Second{Number: 10, OneRef: *insolar.NewEmptyReference()}, nil
 }
+
+//ins:public
 func NewWithOne(oneNumber int) (*Second, error) {
 	holder := one.NewWithNumber(oneNumber)
 	objOne, err := holder.AsChild(foundation.GetNodeDomain())
@@ -56,6 +58,7 @@ func NewWithX() (*Second, error) {
 	return &Second{X: 0}, nil
 }
 
+//ins:public(s=name)
 func (r *Second) Hello(s string) (string, error) {
 	r.X++
 	return fmt.Sprintf("Hello you too, %s. %d times!", s, r.X), nil
@@ -77,6 +80,8 @@ func (r *Second) GetPayloadString() (string, error) {
 func NewSaga() (*Second, error) {
 	return &Second{Number: 100}, nil
 }
+
+//ins:public
 func (r *Second) GetBalance() (int, error) {
 	return r.Number, nil
 }
@@ -99,6 +104,7 @@ func (r *Second) ReturnNil() (*string, error) {
 	return nil, nil
 }
 
+//ins:public
 func (r *Second) ExternalCallDoNothing() error {
 	holder := third.New()
 	objThree, err := holder.AsChild(r.GetReference())
@@ -108,6 +114,7 @@ func (r *Second) ExternalCallDoNothing() error {
 	return objThree.DoNothing()
 }
 
+//ins:public
 func (r *Second) GetParent() (string, error) {
 	return r.GetContext().Parent.String(), nil
 }
@@ -15,6 +15,7 @@ type Third struct {
 	SagaCallsNum int
 }
 
+//ins:public
 func New() (*Third, error) {
 	return &Third{SagaCallsNum: 0}, nil
 }
@@ -23,6 +24,7 @@ func (c *Third) GetName() (string, error) {
 	return "YOU ARE ROBBED!", nil
 }
 
+//ins:public(delta=amount)
 func (c *Third) Transfer(delta int) error {
 	proxy := third.GetObject(c.GetReference())
 	err := proxy.Accept(delta)
@@ -32,6 +34,7 @@ func (c *Third) Transfer(delta int) error {
 	return nil
 }
 
+//ins:public
 func (c *Third) GetSagaCallsNum() (int, error) {
 	return c.SagaCallsNum, nil
 }
@@ -46,6 +49,7 @@ func (c *Third) Rollback(delta int) error {
 	return nil
 }
 
+//ins:public
 func (c *Third) DoNothing() error {
 	return nil
 }
@@ -0,0 +1,92 @@
+// Copyright 2020 Insolar Network Ltd.
+// All rights reserved.
+// This material is licensed under the Insolar License version 1.0,
+// available at https://github.com/insolar/insolar/blob/master/LICENSE.md.
+
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/insolar/insolar/api/requester"
+	"github.com/insolar/insolar/insolar"
+)
+
+func leaveCommand() *cobra.Command {
+	var (
+		adminURL string
+		pulses   uint32
+		status   bool
+		wait     bool
+		period   time.Duration
+	)
+	c := &cobra.Command{
+		Use:   "leave",
+		Short: "asks node to complete pending work and leave the network gracefully",
+		Run: func(cmd *cobra.Command, args []string) {
+			var (
+				resp *requester.LeaveStatusResponse
+				err  error
+			)
+			if status {
+				resp, err = requester.LeaveStatus(adminURL)
+			} else {
+				resp, err = requester.Leave(adminURL, pulses)
+			}
+			check("[ leave ]", err)
+			printLeaveStatus(resp)
+
+			if wait {
+				watchLeave(adminURL, resp, period)
+			}
+		},
+	}
+	c.Flags().StringVarP(
+		&adminURL, "admin-url", "a", defaultAdminURL(), "ADMIN URL of the leaving node")
+	c.Flags().Uint32VarP(
+		&pulses, "pulses", "p", 2, "count of pulses, after that node leaves the network")
+	c.Flags().BoolVarP(
+		&status, "status", "s", false, "only print leave status of the node")
+	c.Flags().BoolVarP(
+		&wait, "wait", "w", false, "watch leave progress until node leaves the network")
+	c.Flags().DurationVarP(
+		&period, "period", "", time.Second, "period of leave status requests with --wait")
+
+	return c
+}
+
+// watchLeave prints leave status, when it changes. Node stops after leave, so it stops responding as well.
+func watchLeave(adminURL string, last *requester.LeaveStatusResponse, period time.Duration) {
+	for last.State != insolar.LeaveApproved.String() {
+		time.Sleep(period)
+
+		resp, err := requester.LeaveStatus(adminURL)
+		if err != nil {
+			if last.State == insolar.LeaveAnnounced.String() {
+				fmt.Println("Node has left the network")
+				return
+			}
+			check("[ leave ]", err)
+		}
+		if resp.State != last.State || resp.ClaimTimedOut != last.ClaimTimedOut ||
+			strings.Join(resp.Pending, "") != strings.Join(last.Pending, "") {
+			printLeaveStatus(resp)
+		}
+		last = resp
+	}
+}
+
+func printLeaveStatus(resp *requester.LeaveStatusResponse) {
+	fmt.Printf("State      : %s\n", resp.State)
+	fmt.Printf("LeavePulse : %d\n", resp.LeavePulse)
+	for _, p := range resp.Pending {
+		fmt.Printf("Pending    : %s\n", p)
+	}
+	if resp.ClaimTimedOut {
+		fmt.Println("Leave claim isn't approved by the network in time, node keeps waiting for it")
+	}
+}
@@ -7,6 +7,7 @@ package main
 
 import (
 	"context"
+	"crypto"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -14,12 +15,14 @@ import (
 
 	"github.com/insolar/insolar/application/sdk"
 	"github.com/insolar/insolar/insolar/secrets"
+	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 
 	"github.com/insolar/insolar/api/requester"
 	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/keystore"
 	"github.com/insolar/insolar/platformpolicy"
 	"github.com/insolar/insolar/version"
 )
@@ -74,16 +77,30 @@ func main() {
 	addURLFlag(createMemberCmd.Flags())
 	rootCmd.AddCommand(createMemberCmd)
 
-	var targetValue string
+	var (
+		targetValue string
+		encrypt     bool
+		kdf         string
+		algorithm   string
+	)
 	var genKeysPairCmd = &cobra.Command{
 		Use:   "gen-key-pair",
 		Short: "generates public/private keys pair",
+		Long: "generates public/private keys pair. Node private key can be encrypted with passphrase, " +
+			"that is taken from " + keystore.PassphraseEnv + ", from the file in " + keystore.PassphraseFileEnv +
+			" or asked in the terminal.",
 		Run: func(cmd *cobra.Command, args []string) {
-			generateKeysPair(targetValue)
+			generateKeysPair(targetValue, encrypt, kdf, algorithm)
 		},
 	}
 	genKeysPairCmd.Flags().StringVarP(
 		&targetValue, "target", "t", "", "target for whom need to generate keys (possible values: node, user)")
+	genKeysPairCmd.Flags().BoolVarP(
+		&encrypt, "encrypt", "e", false, "encrypt node private key with passphrase")
+	genKeysPairCmd.Flags().StringVar(
+		&kdf, "kdf", keystore.KDFArgon2id, "key derivation function for encrypted private key (possible values: argon2id, scrypt)")
+	genKeysPairCmd.Flags().StringVar(
+		&algorithm, "algorithm", platformpolicy.ECDSA, "signature algorithm of keys (possible values: ecdsa, ed25519)")
 	rootCmd.AddCommand(genKeysPairCmd)
 
 	var rootKeysFile string
@@ -143,6 +160,7 @@ func main() {
 	rootCmd.AddCommand(certgenCmd)
 
 	rootCmd.AddCommand(bootstrapCommand())
+	rootCmd.AddCommand(leaveCommand())
 
 	var (
 		configsOutputDir string
@@ -245,13 +263,17 @@ func mustWrite(out io.Writer, data string) {
 	check("Can't write data to output", err)
 }
 
-func generateKeysPair(targetValue string) {
+func generateKeysPair(targetValue string, encrypt bool, kdf string, algorithm string) {
 	switch targetValue {
 	case "node":
-		generateKeysPairFast()
+		generateKeysPairFast(encrypt, kdf, algorithm)
 		return
 	case "user":
-		generateKeysPairEthereum()
+		if encrypt {
+			fmt.Fprintln(os.Stderr, "Encryption is supported only for node keys.")
+			os.Exit(1)
+		}
+		generateKeysPairEthereum(algorithm)
 		return
 	default:
 		fmt.Fprintln(os.Stderr, "Unknown target. Possible values: node, user.")
@@ -259,8 +281,9 @@ func generateKeysPair(targetValue string) {
 	}
 }
 
-func generateKeysPairFast() {
-	ks := platformpolicy.NewKeyProcessor()
+func generateKeysPairFast(encrypt bool, kdf string, algorithm string) {
+	ks, err := platformpolicy.NewKeyProcessorWithAlgorithm(algorithm)
+	check("Problems with signature algorithm:", err)
 
 	privKey, err := ks.GeneratePrivateKey()
 	check("Problems with generating of private key:", err)
@@ -271,17 +294,36 @@ func generateKeysPairFast() {
 	pubKeyStr, err := ks.ExportPublicKeyPEM(ks.ExtractPublicKey(privKey))
 	check("Problems with serialization of public key:", err)
 
-	result, err := json.MarshalIndent(map[string]interface{}{
-		"private_key": string(privKeyStr),
-		"public_key":  string(pubKeyStr),
-	}, "", "    ")
+	keys := keystore.KeysFile{
+		PublicKey: string(pubKeyStr),
+	}
+	if encrypt {
+		passphrase, err := keystore.DefaultPassphrase(true)()
+		check("Problems with passphrase:", err)
+
+		keys.EncryptedPrivateKey, err = keystore.EncryptPrivateKey(privKeyStr, passphrase, kdf)
+		check("Problems with encryption of private key:", err)
+	} else {
+		keys.PrivateKey = string(privKeyStr)
+	}
+
+	result, err := json.MarshalIndent(keys, "", "    ")
 	check("Problems with marshaling keys:", err)
 
 	mustWrite(os.Stdout, string(result))
 }
 
-func generateKeysPairEthereum() {
-	privKey, err := secrets.GeneratePrivateKeyEthereum()
+func generateKeysPairEthereum(algorithm string) {
+	var privKey crypto.PrivateKey
+	var err error
+	switch algorithm {
+	case platformpolicy.ECDSA:
+		privKey, err = secrets.GeneratePrivateKeyEthereum()
+	case platformpolicy.Ed25519:
+		privKey, err = secrets.GeneratePrivateKeyEd25519()
+	default:
+		err = errors.Errorf("unknown signature algorithm %q", algorithm)
+	}
 	check("Problems with generating of private key:", err)
 
 	privKeyStr, err := secrets.ExportPrivateKeyPEM(privKey)
@@ -9,6 +9,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/insolar/insolar/api"
+	"github.com/insolar/insolar/application/builtin/callsites"
 )
 
 // initAPIInfoResponse creates application-specific data,
@@ -38,52 +39,10 @@ func InitAPIOptions() (api.Options, error) {
 	}
 	adminContractMethods := map[string]bool{}
 	contractMethods := map[string]bool{
-		"member.create":                   true,
-		"first.New":                       true,
-		"first.NewPanic":                  true,
-		"first.Panic":                     true,
-		"panicAsLogicalError.New":         true,
-		"panicAsLogicalError.NewPanic":    true,
-		"panicAsLogicalError.Panic":       true,
-		"first.Recursive":                 true,
-		"first.Test":                      true,
-		"third.New":                       true,
-		"first.NewZero":                   true,
-		"second.NewWithOne":               true,
-		"first.Get":                       true,
-		"first.Inc":                       true,
-		"first.Dec":                       true,
-		"first.Hello":                     true,
-		"first.Again":                     true,
-		"first.GetFriend":                 true,
-		"second.Hello":                    true,
-		"first.TestPayload":               true,
-		"first.ManyTimes":                 true,
-		"first.NewSaga":                   true,
-		"first.NewWithNumber":             true,
-		"first.Transfer":                  true,
-		"first.GetBalance":                true,
-		"first.TransferWithRollback":      true,
-		"first.TransferTwice":             true,
-		"first.TransferToAnotherContract": true,
-		"second.GetBalance":               true,
-		"third.Transfer":                  true,
-		"third.GetSagaCallsNum":           true,
-		"first.SelfRef":                   true,
-		"first.AnError":                   true,
-		"first.NoError":                   true,
-		"first.ReturnNil":                 true,
-		"first.ConstructorReturnNil":      true,
-		"first.ConstructorReturnError":    true,
-		"first.GetChildPrototype":         true,
-		"first.ExternalImmutableCall":     true,
-		"second.ExternalCallDoNothing":    true,
-		"third.DoNothing":                 true,
-		"first.ExternalImmutableCallMakesExternalCall": true,
-		"first.TransferTo":                      true,
-		"first.AddChildAndReturnMyselfAsParent": true,
-		"second.GetParent":                      true,
-		"first.Kill":                            true,
+		"member.create": true,
+	}
+	for _, callSite := range callsites.PublicCallSites() {
+		contractMethods[callSite] = true
 	}
 	proxyToRootMethods := []string{"member.create"}
 
@@ -93,5 +52,6 @@ func InitAPIOptions() (api.Options, error) {
 		InfoResponse:         apiInfoResponse,
 		RootReference:        GetRootMember(),
 		ProxyToRootMethods:   proxyToRootMethods,
+		AllowCallSites:       func(callSites []string) { callsites.Allow(callSites...) },
 	}, nil
 }
@@ -112,6 +112,7 @@ func (g *Generator) Run(ctx context.Context, properName bool) error {
 type nodeInfo struct {
 	privateKey crypto.PrivateKey
 	publicKey  string
+	algorithm  string
 	role       string
 	certName   string
 }
@@ -126,6 +127,7 @@ func (g *Generator) makeCertificates(ctx context.Context, nodesInfo []nodeInfo,
 		c := certificate.Certificate{
 			AuthorizationCertificate: certificate.AuthorizationCertificate{
 				PublicKey: node.publicKey,
+				Algorithm: node.algorithm,
 				Role:      node.role,
 				Reference: node.reference().String(),
 			},
@@ -140,6 +142,7 @@ func (g *Generator) makeCertificates(ctx context.Context, nodesInfo []nodeInfo,
 			host := g.config.DiscoveryNodes[j].Host
 			c.BootstrapNodes = append(c.BootstrapNodes, certificate.BootstrapNode{
 				PublicKey: n2.publicKey,
+				Algorithm: n2.algorithm,
 				Host:      host,
 				NodeRef:   n2.reference().String(),
 				NodeRole:  n2.role,
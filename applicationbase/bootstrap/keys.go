@@ -7,6 +7,7 @@ package bootstrap
 
 import (
 	"context"
+	"crypto"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -24,9 +25,20 @@ func keysToNodeInfo(kp *secrets.KeyPair) nodeInfo {
 	return nodeInfo{
 		privateKey: kp.Private,
 		publicKey:  platformpolicy.MustPublicKeyToString(kp.Public),
+		algorithm:  certificateAlgorithm(kp.Public),
 	}
 }
 
+// certificateAlgorithm returns algorithm of key for certificate. It is omitted for ECDSA keys,
+// so certificates of ECDSA deployments stay the same.
+func certificateAlgorithm(publicKey crypto.PublicKey) string {
+	algorithm, err := platformpolicy.KeyAlgorithm(publicKey)
+	if err != nil || algorithm == platformpolicy.ECDSA {
+		return ""
+	}
+	return algorithm
+}
+
 func keyPairsToNodeInfo(kp ...*secrets.KeyPair) []nodeInfo {
 	nodes := make([]nodeInfo, 0, len(kp))
 	for _, p := range kp {
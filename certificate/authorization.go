@@ -16,6 +16,7 @@ import (
 // AuthorizationCertificate holds info about node from it certificate
 type AuthorizationCertificate struct {
 	PublicKey      string                       `json:"public_key"`
+	Algorithm      string                       `json:"algorithm,omitempty"`
 	Reference      string                       `json:"reference"`
 	Role           string                       `json:"role"`
 	DiscoverySigns map[insolar.Reference][]byte `json:"-" codec:"discoverysigns"`
@@ -24,6 +24,7 @@ import (
 // BootstrapNode holds info about bootstrap nodes
 type BootstrapNode struct {
 	PublicKey   string `json:"public_key"`
+	Algorithm   string `json:"algorithm,omitempty"`
 	Host        string `json:"host"`
 	NetworkSign []byte `json:"network_sign"`
 	NodeSign    []byte `json:"node_sign"`
@@ -145,6 +146,10 @@ func (cert *Certificate) fillExtraFields(keyProcessor insolar.KeyProcessor) erro
 	if err != nil {
 		return errors.Wrapf(err, "[ fillExtraFields ] Bad PublicKey: %s", cert.PublicKey)
 	}
+	err = checkNodeKeyAlgorithm(cert.Algorithm, importedNodePubKey)
+	if err != nil {
+		return errors.Wrapf(err, "[ fillExtraFields ] Bad PublicKey: %s", cert.PublicKey)
+	}
 	cert.nodePublicKey = importedNodePubKey
 
 	for i := 0; i < len(cert.BootstrapNodes); i++ {
@@ -153,12 +158,44 @@ func (cert *Certificate) fillExtraFields(keyProcessor insolar.KeyProcessor) erro
 		if err != nil {
 			return errors.Wrapf(err, "[ fillExtraFields ] Bad Bootstrap PublicKey: %s", currentNode.PublicKey)
 		}
+		err = checkNodeKeyAlgorithm(currentNode.Algorithm, importedBNodePubKey)
+		if err != nil {
+			return errors.Wrapf(err, "[ fillExtraFields ] Bad Bootstrap PublicKey: %s", currentNode.PublicKey)
+		}
 		currentNode.nodePublicKey = importedBNodePubKey
 	}
 
 	return nil
 }
 
+// checkKeyAlgorithm checks that key has algorithm from certificate. Certificates without algorithm have ECDSA keys.
+func checkKeyAlgorithm(algorithm string, publicKey crypto.PublicKey) error {
+	if algorithm == "" {
+		algorithm = platformpolicy.ECDSA
+	}
+	keyAlgorithm, err := platformpolicy.KeyAlgorithm(publicKey)
+	if err != nil {
+		return err
+	}
+	if keyAlgorithm != algorithm {
+		return errors.Errorf("key algorithm %s differs from certificate algorithm %s", keyAlgorithm, algorithm)
+	}
+	return nil
+}
+
+// checkNodeKeyAlgorithm checks algorithm of the node key. Consensus supports only ECDSA keys of nodes yet,
+// so nodes with keys of other algorithms can't join the network.
+func checkNodeKeyAlgorithm(algorithm string, publicKey crypto.PublicKey) error {
+	err := checkKeyAlgorithm(algorithm, publicKey)
+	if err != nil {
+		return err
+	}
+	if algorithm != "" && algorithm != platformpolicy.ECDSA {
+		return errors.Errorf("node key algorithm %s isn't supported by consensus", algorithm)
+	}
+	return nil
+}
+
 // GetDiscoveryNodes return bootstrap nodes array
 func (cert *Certificate) GetDiscoveryNodes() []insolar.DiscoveryNode {
 	result := make([]insolar.DiscoveryNode, 0)
@@ -8,6 +8,7 @@ package certificate
 import (
 	"bytes"
 	"encoding/json"
+	"io/ioutil"
 	"testing"
 
 	"github.com/insolar/insolar/cryptography"
@@ -108,6 +109,47 @@ func TestReadCertificate_BadBootstrapPublicKey(t *testing.T) {
 	require.Contains(t, err.Error(), "Incorrect fields: [ fillExtraFields ] Bad Bootstrap PublicKey")
 }
 
+func TestReadCertificate_WrongAlgorithm(t *testing.T) {
+	cs, _ := cryptography.NewStorageBoundCryptographyService(TestKeys)
+	kp := platformpolicy.NewKeyProcessor()
+	pk, _ := cs.GetPublicKey()
+
+	data, err := ioutil.ReadFile(TestCert)
+	require.NoError(t, err)
+	cert := map[string]interface{}{}
+	require.NoError(t, json.Unmarshal(data, &cert))
+	cert["algorithm"] = platformpolicy.Ed25519
+	data, err = json.Marshal(cert)
+	require.NoError(t, err)
+
+	_, err = ReadCertificateFromReader(pk, kp, bytes.NewReader(data))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "differs from certificate algorithm")
+}
+
+func TestReadCertificate_Ed25519NodeKey(t *testing.T) {
+	kp, err := platformpolicy.NewKeyProcessorWithAlgorithm(platformpolicy.Ed25519)
+	require.NoError(t, err)
+	key, err := kp.GeneratePrivateKey()
+	require.NoError(t, err)
+	pk := kp.ExtractPublicKey(key)
+	pem, err := kp.ExportPublicKeyPEM(pk)
+	require.NoError(t, err)
+
+	data, err := ioutil.ReadFile(TestCert)
+	require.NoError(t, err)
+	cert := map[string]interface{}{}
+	require.NoError(t, json.Unmarshal(data, &cert))
+	cert["public_key"] = string(pem)
+	cert["algorithm"] = platformpolicy.Ed25519
+	data, err = json.Marshal(cert)
+	require.NoError(t, err)
+
+	_, err = ReadCertificateFromReader(pk, kp, bytes.NewReader(data))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "isn't supported by consensus")
+}
+
 func TestReadPrivateKey_BadJson(t *testing.T) {
 	keyProcessor := platformpolicy.NewKeyProcessor()
 	_, err := ReadCertificate(nil, keyProcessor, TestBadCert)
@@ -9,6 +9,7 @@ import (
 	"crypto"
 
 	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/platformpolicy"
 	"github.com/pkg/errors"
 )
 
@@ -51,6 +52,7 @@ func NewUnsignedCertificate(baseCert insolar.Certificate, pKey string, role stri
 		MinRoles:     cert.MinRoles,
 		AuthorizationCertificate: AuthorizationCertificate{
 			PublicKey: pKey,
+			Algorithm: pemKeyAlgorithm(pKey),
 			Reference: ref,
 			Role:      role,
 		},
@@ -60,6 +62,7 @@ func NewUnsignedCertificate(baseCert insolar.Certificate, pKey string, role stri
 		newCert.BootstrapNodes[i].Host = node.Host
 		newCert.BootstrapNodes[i].NodeRef = node.NodeRef
 		newCert.BootstrapNodes[i].PublicKey = node.PublicKey
+		newCert.BootstrapNodes[i].Algorithm = node.Algorithm
 		newCert.BootstrapNodes[i].NetworkSign = node.NetworkSign
 		newCert.BootstrapNodes[i].NodeRole = node.NodeRole
 	}
@@ -75,3 +78,16 @@ func NewManagerReadCertificate(publicKey crypto.PublicKey, keyProcessor insolar.
 	certManager := NewCertificateManager(cert)
 	return certManager, nil
 }
+
+// pemKeyAlgorithm returns algorithm of PEM encoded key for certificate, it is omitted for ECDSA keys.
+func pemKeyAlgorithm(pKey string) string {
+	publicKey, err := platformpolicy.NewKeyProcessor().ImportPublicKeyPEM([]byte(pKey))
+	if err != nil {
+		return ""
+	}
+	algorithm, err := platformpolicy.KeyAlgorithm(publicKey)
+	if err != nil || algorithm == platformpolicy.ECDSA {
+		return ""
+	}
+	return algorithm
+}
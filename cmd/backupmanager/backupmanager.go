@@ -185,6 +185,9 @@ func parseInputParams() {
 	}
 
 	rootCmd.AddCommand(parsePrepareBackupParams())
+	rootCmd.AddCommand(parseListParams())
+	rootCmd.AddCommand(parseCheckParams())
+	rootCmd.AddCommand(parseRestoreParams())
 
 	exit(rootCmd.Execute())
 }
@@ -0,0 +1,205 @@
+// Copyright 2020 Insolar Network Ltd.
+// All rights reserved.
+// This material is licensed under the Insolar License version 1.0,
+// available at https://github.com/insolar/insolar/blob/master/LICENSE.md.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/ledger/heavy/executor"
+)
+
+// backupDir is a directory with incremental backup of a pulse, that is made by executor.BackupMakerDefault.
+type backupDir struct {
+	Path  string
+	Pulse insolar.PulseNumber
+	// Info is nil if there is no meta info file
+	Info          *executor.BackupInfo
+	Confirmed     bool
+	HasBackupFile bool
+}
+
+func (d backupDir) backupFile(cfg configuration.Backup) string {
+	return filepath.Join(d.Path, cfg.BackupFile)
+}
+
+// readBackupDirs returns backup directories of targetDir sorted by pulse.
+func readBackupDirs(targetDir string, cfg configuration.Backup) ([]backupDir, error) {
+	entries, err := ioutil.ReadDir(targetDir)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read target directory")
+	}
+
+	var dirs []backupDir
+	for _, e := range entries {
+		if !e.IsDir() {
+			continue
+		}
+		var pn insolar.PulseNumber
+		_, err := fmt.Sscanf(e.Name(), cfg.DirNameTemplate, &pn)
+		if err != nil || fmt.Sprintf(cfg.DirNameTemplate, pn) != e.Name() {
+			continue
+		}
+
+		dir := backupDir{Path: filepath.Join(targetDir, e.Name()), Pulse: pn}
+		dir.Confirmed = fileExists(filepath.Join(dir.Path, cfg.ConfirmFile))
+		dir.HasBackupFile = fileExists(dir.backupFile(cfg))
+
+		data, err := ioutil.ReadFile(filepath.Join(dir.Path, cfg.MetaInfoFile))
+		if err != nil && !os.IsNotExist(err) {
+			return nil, errors.Wrapf(err, "failed to read meta info of %s", dir.Path)
+		}
+		if err == nil {
+			info := executor.BackupInfo{}
+			err = json.Unmarshal(data, &info)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to parse meta info of %s", dir.Path)
+			}
+			if info.Pulse != pn {
+				return nil, errors.Errorf("meta info of %s is for pulse %s", dir.Path, info.Pulse)
+			}
+			dir.Info = &info
+		}
+		dirs = append(dirs, dir)
+	}
+
+	sort.Slice(dirs, func(i, j int) bool {
+		return dirs[i].Pulse < dirs[j].Pulse
+	})
+	return dirs, nil
+}
+
+func fileExists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
+
+// restoreChain returns confirmed backups, that must be loaded one after another to restore data of the pulse.
+// Chain starts from the last full backup before the pulse.
+func restoreChain(dirs []backupDir, cfg configuration.Backup, pulse insolar.PulseNumber) ([]backupDir, error) {
+	last := -1
+	for i, d := range dirs {
+		if d.Pulse == pulse {
+			last = i
+		}
+	}
+	if last == -1 {
+		return nil, errors.Errorf("no backup for pulse %s", pulse)
+	}
+
+	var chain []backupDir
+	for i := last; i >= 0; i-- {
+		d := dirs[i]
+		if !d.Confirmed {
+			if i == last {
+				return nil, errors.Errorf("backup for pulse %s is not confirmed", pulse)
+			}
+			continue
+		}
+		if !d.HasBackupFile || d.Info == nil {
+			return nil, errors.Errorf("backup %s has no %s or %s", d.Path, cfg.BackupFile, cfg.MetaInfoFile)
+		}
+		if len(chain) > 0 && chain[0].Info.Since != d.Info.LastBackupedVersion {
+			return nil, errors.Errorf(
+				"backup %s doesn't continue %s: since %d, previous version %d",
+				chain[0].Path, d.Path, chain[0].Info.Since, d.Info.LastBackupedVersion,
+			)
+		}
+		chain = append([]backupDir{d}, chain...)
+		if d.Info.Since == 0 {
+			return chain, nil
+		}
+	}
+	return nil, errors.Errorf("no full backup before pulse %s", pulse)
+}
+
+func listBackups(targetDir string, cfg configuration.Backup) error {
+	dirs, err := readBackupDirs(targetDir, cfg)
+	if err != nil {
+		return err
+	}
+	if len(dirs) == 0 {
+		fmt.Println("No backups in", targetDir)
+		return nil
+	}
+
+	var previous *backupDir
+	for i, d := range dirs {
+		status := "confirmed"
+		if !d.Confirmed {
+			status = "not confirmed"
+		}
+		if !d.HasBackupFile {
+			status += ", no backup file"
+		}
+
+		versions := "no meta info"
+		if d.Info != nil {
+			versions = fmt.Sprintf("versions %d - %d", d.Info.Since, d.Info.LastBackupedVersion)
+			if d.Info.Since == 0 {
+				versions += " (full)"
+			} else if previous == nil || previous.Info == nil || previous.Info.LastBackupedVersion != d.Info.Since {
+				versions += " (previous backup is missing)"
+			}
+		}
+
+		pulses := fmt.Sprintf("pulses up to %s", d.Pulse)
+		if previous != nil {
+			pulses = fmt.Sprintf("pulses %s - %s", previous.Pulse+1, d.Pulse)
+		}
+		fmt.Printf("%s: %s, %s, %s\n", filepath.Base(d.Path), pulses, versions, status)
+
+		if d.Confirmed {
+			previous = &dirs[i]
+		}
+	}
+	return nil
+}
+
+// addBackupLayoutFlags adds flags, that describe backup directories. Defaults are taken from default ledger config.
+func addBackupLayoutFlags(cmd *cobra.Command, cfg *configuration.Backup) {
+	*cfg = configuration.NewLedger().Backup
+	cmd.Flags().StringVar(&cfg.DirNameTemplate, "dir-template", cfg.DirNameTemplate, "template of backup directory names")
+	cmd.Flags().StringVar(&cfg.BackupFile, "backup-file", cfg.BackupFile, "name of incremental backup file")
+	cmd.Flags().StringVar(&cfg.MetaInfoFile, "meta-file", cfg.MetaInfoFile, "name of meta info file")
+	cmd.Flags().StringVar(&cfg.ConfirmFile, "confirm-file", cfg.ConfirmFile, "name of backup confirmation file")
+}
+
+func parseListParams() *cobra.Command {
+	var (
+		targetDir string
+		cfg       configuration.Backup
+	)
+	var listCmd = &cobra.Command{
+		Use:   "list",
+		Short: "list backup directories and pulses they cover",
+		Run: func(cmd *cobra.Command, args []string) {
+			exit(listBackups(targetDir, cfg))
+		},
+	}
+
+	targetDirFlagName := "target-dir"
+	listCmd.Flags().StringVarP(
+		&targetDir, targetDirFlagName, "t", "", "directory with backup directories (required)")
+	addBackupLayoutFlags(listCmd, &cfg)
+
+	err := cobra.MarkFlagRequired(listCmd.Flags(), targetDirFlagName)
+	if err != nil {
+		err = errors.Wrap(err, "failed to set required param: "+targetDirFlagName)
+		exitWithError(err)
+	}
+
+	return listCmd
+}
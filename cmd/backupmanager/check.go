@@ -0,0 +1,234 @@
+// Copyright 2020 Insolar Network Ltd.
+// All rights reserved.
+// This material is licensed under the Insolar License version 1.0,
+// available at https://github.com/insolar/insolar/blob/master/LICENSE.md.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/dgraph-io/badger"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/jet"
+	"github.com/insolar/insolar/insolar/pulse"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/insolar/store"
+	"github.com/insolar/insolar/ledger/drop"
+	"github.com/insolar/insolar/ledger/heavy/executor"
+	"github.com/insolar/insolar/log"
+	"github.com/insolar/insolar/platformpolicy"
+)
+
+// scopeKey is used to iterate over all keys of a scope.
+type scopeKey struct {
+	scope store.Scope
+	id    []byte
+}
+
+func (k scopeKey) Scope() store.Scope {
+	return k.scope
+}
+
+func (k scopeKey) ID() []byte {
+	return k.id
+}
+
+// checkResult contains found problems and statistics of checked data.
+type checkResult struct {
+	TopSyncPulse    insolar.PulseNumber
+	LastBackupPulse insolar.PulseNumber
+	Pulses          int
+	Drops           int
+	Records         int
+	Problems        []string
+}
+
+func (r *checkResult) problem(format string, args ...interface{}) {
+	r.Problems = append(r.Problems, fmt.Sprintf(format, args...))
+}
+
+// checkDB checks, that:
+// 1. the last backed up pulse is finalized
+// 2. every finalized pulse has drops for all jets of its jet tree and no other drops
+// 3. ids of all records match their hashes
+func checkDB(ctx context.Context, bdb *store.BadgerDB, scheme insolar.PlatformCryptographyScheme) (checkResult, error) {
+	var result checkResult
+
+	pulses := pulse.NewBadgerDB(bdb)
+	jets := jet.NewBadgerDBStore(bdb)
+	jetKeeper := executor.NewBadgerJetKeeper(jets, bdb, pulses)
+	result.TopSyncPulse = jetKeeper.TopSyncPulse()
+
+	it := bdb.NewIterator(executor.BackupStartKey(math.MaxUint32), true)
+	if it.Next() {
+		result.LastBackupPulse = insolar.NewPulseNumber(it.Key())
+	}
+	it.Close()
+	switch {
+	case result.LastBackupPulse == 0:
+		result.problem("no backup start keys")
+	case result.LastBackupPulse > result.TopSyncPulse:
+		result.problem("last backed up pulse %s is not finalized, top sync pulse is %s. Run prepare_backup", result.LastBackupPulse, result.TopSyncPulse)
+	case result.LastBackupPulse < result.TopSyncPulse:
+		result.problem("top sync pulse %s is not backed up, last backed up pulse is %s", result.TopSyncPulse, result.LastBackupPulse)
+	}
+
+	var pulseNumbers []insolar.PulseNumber
+	it = bdb.NewIterator(scopeKey{scope: store.ScopePulse}, false)
+	for it.Next() {
+		pulseNumbers = append(pulseNumbers, insolar.NewPulseNumber(it.Key()))
+	}
+	it.Close()
+
+	for _, pn := range pulseNumbers {
+		if pn <= insolar.GenesisPulse.PulseNumber || pn > result.TopSyncPulse {
+			continue
+		}
+		result.Pulses++
+		err := checkDrops(ctx, bdb, jets, pn, &result)
+		if err != nil {
+			return result, err
+		}
+		if !jetKeeper.HasAllJetConfirms(ctx, pn) {
+			result.problem("pulse %s is finalized, but doesn't have all jet confirmations", pn)
+		}
+	}
+
+	err := checkRecords(bdb, scheme, &result)
+	return result, err
+}
+
+func checkDrops(ctx context.Context, bdb *store.BadgerDB, jets jet.Storage, pn insolar.PulseNumber, result *checkResult) error {
+	drops := drop.NewBadgerDB(bdb)
+	leaves := jets.All(ctx, pn)
+	for _, jetID := range leaves {
+		d, err := drops.ForPulse(ctx, jetID, pn)
+		if err == store.ErrNotFound {
+			result.problem("pulse %s: no drop for jet %s", pn, jetID.DebugString())
+			continue
+		}
+		if err != nil {
+			return errors.Wrapf(err, "failed to get drop for jet %s in pulse %s", jetID.DebugString(), pn)
+		}
+		if d.Pulse != pn || d.JetID != jetID {
+			result.problem("pulse %s: drop of jet %s has pulse %s and jet %s", pn, jetID.DebugString(), d.Pulse, d.JetID.DebugString())
+		}
+	}
+
+	count := 0
+	it := bdb.NewIterator(scopeKey{scope: store.ScopeJetDrop, id: pn.Bytes()}, false)
+	defer it.Close()
+	for it.Next() && bytes.HasPrefix(it.Key(), pn.Bytes()) {
+		count++
+	}
+	result.Drops += count
+	if count != len(leaves) {
+		result.problem("pulse %s: jet tree has %d jets, but there are %d drops", pn, len(leaves), count)
+	}
+	return nil
+}
+
+func checkRecords(bdb *store.BadgerDB, scheme insolar.PlatformCryptographyScheme, result *checkResult) error {
+	it := bdb.NewIterator(scopeKey{scope: store.ScopeRecord}, false)
+	defer it.Close()
+	for it.Next() {
+		result.Records++
+		key := it.Key()
+		if len(key) != insolar.RecordIDSize {
+			result.problem("record key %x has wrong size", key)
+			continue
+		}
+		pn := insolar.NewPulseNumber(key)
+		id := insolar.NewID(pn, key[pn.Size():])
+
+		value, err := it.Value()
+		if err != nil {
+			return errors.Wrapf(err, "failed to read record %s", id.DebugString())
+		}
+		rec := record.Material{}
+		err = rec.Unmarshal(value)
+		if err != nil {
+			result.problem("record %s can't be unmarshaled: %s", id.DebugString(), err)
+			continue
+		}
+		if rec.ID != *id {
+			result.problem("record %s is stored with id %s", rec.ID.DebugString(), id.DebugString())
+		}
+		hash := record.HashVirtual(scheme.ReferenceHasher(), rec.Virtual)
+		if calculated := insolar.NewID(pn, hash); *calculated != *id {
+			result.problem("record %s has hash of %s", id.DebugString(), calculated.DebugString())
+		}
+	}
+	return nil
+}
+
+func check(dbDir string, algorithm string) {
+	log.Info("check. dbDir: ", dbDir)
+
+	scheme, err := platformpolicy.NewPlatformCryptographySchemeWithAlgorithm(algorithm)
+	if err != nil {
+		exitWithError(err)
+	}
+
+	ops := badger.DefaultOptions(dbDir)
+	ops.Logger = badgerLogger
+	ops.ReadOnly = true
+	bdb, err := store.NewBadgerDB(ops)
+	if err != nil {
+		err := errors.Wrap(err, "failed to open DB")
+		exitWithError(err)
+	}
+	ctx := context.Background()
+
+	result, err := checkDB(ctx, bdb, scheme)
+	if err != nil {
+		stopDB(ctx, bdb, errors.Wrap(err, "failed to check DB"))
+	}
+	stopDB(ctx, bdb, nil)
+
+	fmt.Printf("Top sync pulse: %s\n", result.TopSyncPulse)
+	fmt.Printf("Last backed up pulse: %s\n", result.LastBackupPulse)
+	fmt.Printf("Checked pulses: %d, drops: %d, records: %d\n", result.Pulses, result.Drops, result.Records)
+	for _, p := range result.Problems {
+		fmt.Println("Problem:", p)
+	}
+	if len(result.Problems) > 0 {
+		exitWithError(errors.Errorf("backup is inconsistent: %d problems found", len(result.Problems)))
+	}
+	log.Info("Backup is consistent")
+}
+
+func parseCheckParams() *cobra.Command {
+	var (
+		dbDir     string
+		algorithm string
+	)
+	var checkCmd = &cobra.Command{
+		Use:   "check",
+		Short: "check consistency of merged backup",
+		Run: func(cmd *cobra.Command, args []string) {
+			check(dbDir, algorithm)
+		},
+	}
+
+	dbDirFlagName := "db-dir"
+	checkCmd.Flags().StringVarP(
+		&dbDir, dbDirFlagName, "d", "", "directory of DB to check (required)")
+	checkCmd.Flags().StringVarP(
+		&algorithm, "signature-algorithm", "a", platformpolicy.ECDSA, "signature algorithm of the deployment (possible values: ecdsa, ed25519)")
+
+	err := cobra.MarkFlagRequired(checkCmd.Flags(), dbDirFlagName)
+	if err != nil {
+		err = errors.Wrap(err, "failed to set required param: "+dbDirFlagName)
+		exitWithError(err)
+	}
+
+	return checkCmd
+}
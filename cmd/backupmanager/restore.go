@@ -0,0 +1,152 @@
+// Copyright 2020 Insolar Network Ltd.
+// All rights reserved.
+// This material is licensed under the Insolar License version 1.0,
+// available at https://github.com/insolar/insolar/blob/master/LICENSE.md.
+
+package main
+
+import (
+	"context"
+	"os"
+
+	"github.com/dgraph-io/badger"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/jet"
+	"github.com/insolar/insolar/insolar/node"
+	"github.com/insolar/insolar/insolar/pulse"
+	"github.com/insolar/insolar/insolar/store"
+	"github.com/insolar/insolar/ledger/drop"
+	"github.com/insolar/insolar/ledger/heavy/executor"
+	"github.com/insolar/insolar/ledger/object"
+	"github.com/insolar/insolar/log"
+)
+
+// maxPendingWrites limits memory usage while loading backups.
+const maxPendingWrites = 256
+
+func loadBackup(bdb *store.BadgerDB, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return errors.Wrap(err, "failed to open backup file")
+	}
+	defer f.Close() // nolint
+
+	return bdb.Backend().Load(f, maxPendingWrites)
+}
+
+// restoreDB loads backups of the chain to empty DB, finalizes the pulse and removes data of later pulses,
+// that could get to the last backup.
+func restoreDB(ctx context.Context, bdb *store.BadgerDB, chain []backupDir, cfg configuration.Backup, pn insolar.PulseNumber) error {
+	err := isDBEmpty(bdb.Backend())
+	if err != nil {
+		return errors.Wrap(err, "DB must be empty")
+	}
+
+	for _, d := range chain {
+		log.Infof("Loading backup of pulse %s from %s", d.Pulse, d.Path)
+		err := loadBackup(bdb, d.backupFile(cfg))
+		if err != nil {
+			return errors.Wrapf(err, "failed to load backup %s", d.Path)
+		}
+	}
+
+	topSyncPulse, err := finalizeLastPulse(ctx, bdb)
+	if err != nil {
+		return errors.Wrap(err, "failed to finalizeLastPulse")
+	}
+	if topSyncPulse != pn {
+		return errors.Errorf("top sync pulse %s is not equal to restored pulse %s", topSyncPulse, pn)
+	}
+
+	pulses := pulse.NewBadgerDB(bdb)
+	jets := jet.NewBadgerDBStore(bdb)
+	jetKeeper := executor.NewBadgerJetKeeper(jets, bdb, pulses)
+	records := object.NewBadgerRecordDB(bdb)
+	backupMaker, err := executor.NewBackupMaker(ctx, bdb, configuration.Ledger{}, pn, bdb)
+	if err != nil {
+		return errors.Wrap(err, "failed to create backup maker")
+	}
+	rollback := executor.NewDBRollback(
+		jetKeeper,
+		drop.NewBadgerDB(bdb),
+		records,
+		object.NewBadgerIndexDB(bdb, records),
+		jets,
+		pulses,
+		jetKeeper,
+		node.NewBadgerStorageDB(bdb),
+		backupMaker,
+	)
+	return errors.Wrap(rollback.Start(ctx), "failed to remove data after restored pulse")
+}
+
+// restore creates new DB from incremental backups of targetDir up to the pulse.
+func restore(targetDir string, cfg configuration.Backup, dbDir string, pn insolar.PulseNumber) {
+	log.Infof("restore. targetDir: %s, dbDir: %s, pulse: %s", targetDir, dbDir, pn)
+
+	dirs, err := readBackupDirs(targetDir, cfg)
+	if err != nil {
+		exitWithError(err)
+	}
+	chain, err := restoreChain(dirs, cfg, pn)
+	if err != nil {
+		exitWithError(err)
+	}
+
+	ops := badger.DefaultOptions(dbDir)
+	ops.Logger = badgerLogger
+	bdb, err := store.NewBadgerDB(ops)
+	if err != nil {
+		err := errors.Wrap(err, "failed to open DB")
+		exitWithError(err)
+	}
+	ctx := context.Background()
+
+	err = restoreDB(ctx, bdb, chain, cfg, pn)
+	if err != nil {
+		stopDB(ctx, bdb, err)
+	}
+	stopDB(ctx, bdb, nil)
+	log.Info("DB is restored to pulse ", pn.String())
+}
+
+func parseRestoreParams() *cobra.Command {
+	var (
+		targetDir string
+		dbDir     string
+		pn        uint32
+		cfg       configuration.Backup
+	)
+	var restoreCmd = &cobra.Command{
+		Use:   "restore",
+		Short: "restore DB to backed up pulse from incremental backups",
+		Run: func(cmd *cobra.Command, args []string) {
+			restore(targetDir, cfg, dbDir, insolar.PulseNumber(pn))
+		},
+	}
+
+	targetDirFlagName := "target-dir"
+	restoreCmd.Flags().StringVarP(
+		&targetDir, targetDirFlagName, "t", "", "directory with backup directories (required)")
+	dbDirFlagName := "db-dir"
+	restoreCmd.Flags().StringVarP(
+		&dbDir, dbDirFlagName, "d", "", "directory where new DB will be created (required)")
+	pulseFlagName := "pulse"
+	restoreCmd.Flags().Uint32VarP(
+		&pn, pulseFlagName, "p", 0, "backed up pulse to restore (required)")
+	addBackupLayoutFlags(restoreCmd, &cfg)
+
+	for _, name := range []string{targetDirFlagName, dbDirFlagName, pulseFlagName} {
+		err := cobra.MarkFlagRequired(restoreCmd.Flags(), name)
+		if err != nil {
+			err = errors.Wrap(err, "failed to set required param: "+name)
+			exitWithError(err)
+		}
+	}
+
+	return restoreCmd
+}
@@ -0,0 +1,190 @@
+// Copyright 2020 Insolar Network Ltd.
+// All rights reserved.
+// This material is licensed under the Insolar License version 1.0,
+// available at https://github.com/insolar/insolar/blob/master/LICENSE.md.
+
+package main
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/dgraph-io/badger"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/jet"
+	"github.com/insolar/insolar/insolar/pulse"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/insolar/store"
+	"github.com/insolar/insolar/ledger/drop"
+	"github.com/insolar/insolar/ledger/heavy/executor"
+	"github.com/insolar/insolar/ledger/object"
+	"github.com/insolar/insolar/platformpolicy"
+)
+
+type testHeavy struct {
+	db        *store.BadgerDB
+	pulses    *pulse.BadgerDB
+	jets      *jet.BadgerDBStore
+	jetKeeper *executor.BadgerDBJetKeeper
+	records   *object.BadgerRecordDB
+	backup    *executor.BackupMakerDefault
+}
+
+func openDB(t *testing.T, dir string) *store.BadgerDB {
+	ops := badger.DefaultOptions(dir)
+	ops.Logger = nil
+	db, err := store.NewBadgerDB(ops)
+	require.NoError(t, err)
+	return db
+}
+
+func newTestHeavy(t *testing.T, dir string, cfg configuration.Backup) *testHeavy {
+	db := openDB(t, dir)
+	h := &testHeavy{db: db, pulses: pulse.NewBadgerDB(db), jets: jet.NewBadgerDBStore(db), records: object.NewBadgerRecordDB(db)}
+	h.jetKeeper = executor.NewBadgerJetKeeper(h.jets, db, h.pulses)
+
+	var err error
+	h.backup, err = executor.NewBackupMaker(context.Background(), db, configuration.Ledger{Backup: cfg}, insolar.GenesisPulse.PulseNumber, db)
+	require.NoError(t, err)
+	require.NoError(t, h.pulses.Append(context.Background(), *insolar.GenesisPulse))
+	return h
+}
+
+// addPulse writes data of a pulse with a single jet like light nodes do.
+func (h *testHeavy) addPulse(t *testing.T, pn insolar.PulseNumber) insolar.ID {
+	ctx := context.Background()
+	require.NoError(t, h.pulses.Append(ctx, insolar.Pulse{PulseNumber: pn}))
+	require.NoError(t, h.jets.Update(ctx, pn, true, insolar.ZeroJetID))
+	require.NoError(t, drop.NewBadgerDB(h.db).Set(ctx, drop.Drop{Pulse: pn, JetID: insolar.ZeroJetID}))
+
+	virtual := record.Wrap(&record.Code{Code: pn.Bytes()})
+	hash := record.HashVirtual(platformpolicy.NewPlatformCryptographyScheme().ReferenceHasher(), virtual)
+	id := *insolar.NewID(pn, hash)
+	require.NoError(t, h.records.Set(ctx, record.Material{ID: id, Virtual: virtual, JetID: insolar.ZeroJetID}))
+
+	require.NoError(t, h.jetKeeper.AddHotConfirmation(ctx, pn, insolar.ZeroJetID, false, false))
+	require.NoError(t, h.jetKeeper.AddDropConfirmation(ctx, pn, insolar.ZeroJetID, false, false))
+	return id
+}
+
+func (h *testHeavy) finalize(t *testing.T, pn insolar.PulseNumber) {
+	ctx := context.Background()
+	require.NoError(t, h.backup.MakeBackup(ctx, pn))
+	require.NoError(t, h.jetKeeper.AddBackupConfirmation(ctx, pn))
+	require.Equal(t, pn, h.jetKeeper.TopSyncPulse())
+}
+
+func makeBackupConfig(t *testing.T, dir string) configuration.Backup {
+	cfg := configuration.NewLedger().Backup
+	cfg.Enabled = true
+	cfg.Incremental = true
+	cfg.TmpDirectory = filepath.Join(dir, "tmp")
+	cfg.TargetDirectory = filepath.Join(dir, "target")
+	cfg.BackupWaitPeriod = 5
+	cfg.PostProcessBackupCmd = []string{"bash", "-c", "touch $INSOLAR_CURRENT_BACKUP_DIR/" + cfg.ConfirmFile}
+	require.NoError(t, os.MkdirAll(cfg.TmpDirectory, 0777))
+	require.NoError(t, os.MkdirAll(cfg.TargetDirectory, 0777))
+	return cfg
+}
+
+func restoreToPulse(t *testing.T, cfg configuration.Backup, dir string, pn insolar.PulseNumber) *store.BadgerDB {
+	dirs, err := readBackupDirs(cfg.TargetDirectory, cfg)
+	require.NoError(t, err)
+	chain, err := restoreChain(dirs, cfg, pn)
+	require.NoError(t, err)
+
+	db := openDB(t, dir)
+	require.NoError(t, restoreDB(context.Background(), db, chain, cfg, pn))
+	return db
+}
+
+func TestRestore(t *testing.T) {
+	ctx := context.Background()
+	tmpdir, err := ioutil.TempDir("", "backupmanager-test-")
+	require.NoError(t, err)
+	defer os.RemoveAll(tmpdir)
+
+	cfg := makeBackupConfig(t, tmpdir)
+	heavy := newTestHeavy(t, filepath.Join(tmpdir, "heavy"), cfg)
+	defer heavy.db.Stop(ctx) // nolint
+
+	first := insolar.GenesisPulse.PulseNumber + 10
+	second := first + 10
+	third := second + 10
+
+	heavy.addPulse(t, first)
+	heavy.finalize(t, first)
+	secondRecord := heavy.addPulse(t, second)
+	// data of the next pulse comes before the backup
+	thirdRecord := heavy.addPulse(t, third)
+	heavy.finalize(t, second)
+
+	dirs, err := readBackupDirs(cfg.TargetDirectory, cfg)
+	require.NoError(t, err)
+	require.Len(t, dirs, 2)
+	require.Equal(t, first, dirs[0].Pulse)
+	require.True(t, dirs[0].Confirmed)
+	require.Equal(t, uint64(0), dirs[0].Info.Since)
+	require.Equal(t, dirs[0].Info.LastBackupedVersion, dirs[1].Info.Since)
+
+	t.Run("first pulse", func(t *testing.T) {
+		db := restoreToPulse(t, cfg, filepath.Join(tmpdir, "first"), first)
+		defer db.Stop(ctx) // nolint
+
+		_, err := object.NewBadgerRecordDB(db).ForID(ctx, secondRecord)
+		require.Equal(t, object.ErrNotFound, err)
+
+		result, err := checkDB(ctx, db, platformpolicy.NewPlatformCryptographyScheme())
+		require.NoError(t, err)
+		require.Empty(t, result.Problems)
+		require.Equal(t, first, result.TopSyncPulse)
+		require.Equal(t, 1, result.Pulses)
+		require.Equal(t, 1, result.Records)
+	})
+
+	t.Run("second pulse", func(t *testing.T) {
+		db := restoreToPulse(t, cfg, filepath.Join(tmpdir, "second"), second)
+		defer db.Stop(ctx) // nolint
+
+		records := object.NewBadgerRecordDB(db)
+		_, err := records.ForID(ctx, secondRecord)
+		require.NoError(t, err)
+		_, err = records.ForID(ctx, thirdRecord)
+		require.Equal(t, object.ErrNotFound, err)
+
+		result, err := checkDB(ctx, db, platformpolicy.NewPlatformCryptographyScheme())
+		require.NoError(t, err)
+		require.Empty(t, result.Problems)
+		require.Equal(t, second, result.TopSyncPulse)
+		require.Equal(t, second, result.LastBackupPulse)
+		require.Equal(t, 2, result.Pulses)
+		require.Equal(t, 2, result.Drops)
+
+		// broken data is found
+		require.NoError(t, db.Delete(scopeKey{scope: store.ScopeJetDrop, id: append(second.Bytes(), insolar.ZeroJetID.Prefix()...)}))
+		result, err = checkDB(ctx, db, platformpolicy.NewPlatformCryptographyScheme())
+		require.NoError(t, err)
+		require.Len(t, result.Problems, 2)
+		require.Contains(t, result.Problems[0], "no drop for jet")
+	})
+
+	t.Run("not backed up pulse", func(t *testing.T) {
+		_, err := restoreChain(dirs, cfg, third)
+		require.Error(t, err)
+	})
+
+	t.Run("missing full backup", func(t *testing.T) {
+		require.NoError(t, os.Remove(filepath.Join(dirs[0].Path, cfg.ConfirmFile)))
+		dirs, err := readBackupDirs(cfg.TargetDirectory, cfg)
+		require.NoError(t, err)
+		_, err = restoreChain(dirs, cfg, second)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "no full backup")
+	})
+}
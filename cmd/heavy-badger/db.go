@@ -94,12 +94,8 @@ func iterate(
 	defer it.Close()
 	prefix := append(start.Scope().Bytes(), start.ID()...)
 
-	i := 0
+	w := newWalker(opts, fns)
 	for it.Seek(prefix); it.ValidForPrefix(prefix); it.Next() {
-		i++
-		if opts.counter {
-			_, _ = fmt.Printf("\r%20s scanned", formatInt(i, " "))
-		}
 		var err error
 		var b []byte
 
@@ -112,17 +108,46 @@ func iterate(
 				panic(err)
 			}
 		}
-		for _, fn := range fns {
-			if fn == nil {
-				continue
-			}
-			err = fn(k, b)
-			if err != nil {
-				panic(err)
-			}
+		w.walk(k, b)
+	}
+	w.done()
+}
+
+// walker passes key-value pairs to iterations and shows scan progress.
+type walker struct {
+	opts  *iterOptions
+	fns   []iteration
+	count int
+}
+
+func newWalker(opts *iterOptions, fns []iteration) *walker {
+	if opts == nil {
+		opts = &iterOptions{}
+	}
+	return &walker{opts: opts, fns: fns}
+}
+
+func (w *walker) walk(k, v []byte) {
+	w.count++
+	if w.opts.counter {
+		_, _ = fmt.Printf("\r%20s scanned", formatInt(w.count, " "))
+	}
+	if w.opts.keysOnly {
+		v = nil
+	}
+	for _, fn := range w.fns {
+		if fn == nil {
+			continue
+		}
+		err := fn(k, v)
+		if err != nil {
+			panic(err)
 		}
 	}
-	if opts.counter {
+}
+
+func (w *walker) done() {
+	if w.opts.counter {
 		fmt.Println()
 	}
 }
@@ -41,11 +41,11 @@ func (app *appCtx) valueHexDumpCommand() *cobra.Command {
 		Short: "dump binary value by key",
 		Args:  keyArgCheck,
 		Run: func(_ *cobra.Command, _ []string) {
-			db, close := openDB(app.dataDir)
-			defer close()
-			value, err := readValueByKey(db, key)
+			db := app.openStorage()
+			defer db.close()
+			value, err := db.value(key)
 			if err != nil {
-				fatalf("failed to get key from badger: %v", err)
+				fatalf("failed to get value: %v", err)
 			}
 			_, err = io.Copy(os.Stdout, bytes.NewReader(value))
 			if err != nil {
@@ -59,11 +59,11 @@ func (app *appCtx) valueHexDumpCommand() *cobra.Command {
 		Short: "dump record protobuf by key",
 		Args:  keyArgCheck,
 		Run: func(_ *cobra.Command, _ []string) {
-			db, close := openDB(app.dataDir)
-			defer close()
-			value, err := readValueByKey(db, key)
+			db := app.openStorage()
+			defer db.close()
+			value, err := db.value(key)
 			if err != nil {
-				fatalf("failed to get key from badger: %v", err)
+				fatalf("failed to get value: %v", err)
 			}
 			var material record.Material
 			err = material.Unmarshal(value)
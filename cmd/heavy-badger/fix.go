@@ -14,8 +14,10 @@ func (app *appCtx) fixCommand() *cobra.Command {
 		Use:   "fix",
 		Short: "opens and closes badger database. Could fix 'Database was not properly closed' error.",
 		Run: func(_ *cobra.Command, _ []string) {
-			_, close := openDB(app.dataDir)
-			close()
+			if app.backend != backendBadger {
+				fatalf("fix is only needed for %v backend\n", backendBadger)
+			}
+			app.openStorage().close()
 		},
 	}
 	return fixCmd
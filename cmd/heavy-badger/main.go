@@ -9,10 +9,14 @@ import (
 	"os"
 
 	"github.com/spf13/cobra"
+
+	"github.com/insolar/insolar/configuration"
 )
 
 type appCtx struct {
+	backend string
 	dataDir string
+	pgURL   string
 }
 
 func main() {
@@ -25,11 +29,11 @@ func main() {
 			fatalf("bye!")
 		},
 	}
-	dirFlagName := "dir"
-	rootCmd.PersistentFlags().StringVarP(&app.dataDir, dirFlagName, "d", "", "badger data dir")
-	if err := rootCmd.MarkPersistentFlagRequired(dirFlagName); err != nil {
-		fatalf("cobra error: %v", err)
-	}
+	rootCmd.PersistentFlags().StringVarP(&app.backend, "backend", "b", backendBadger,
+		"heavy storage backend (badger or postgres)")
+	rootCmd.PersistentFlags().StringVarP(&app.dataDir, "dir", "d", "", "badger data dir (required for badger backend)")
+	rootCmd.PersistentFlags().StringVar(&app.pgURL, "pg-url", configuration.NewLedgerPg().PostgreSQL.URL,
+		"postgres connection string (for postgres backend)")
 
 	rootCmd.AddCommand(
 		scopesListCommand(),
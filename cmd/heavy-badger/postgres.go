@@ -0,0 +1,301 @@
+// Copyright 2020 Insolar Network Ltd.
+// All rights reserved.
+// This material is licensed under the Insolar License version 1.0,
+// available at https://github.com/insolar/insolar/blob/master/LICENSE.md.
+
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+
+	"github.com/insolar/insolar/insolar"
+	pulsedb "github.com/insolar/insolar/insolar/pulse"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/insolar/store"
+	"github.com/insolar/insolar/ledger/drop"
+	"github.com/insolar/insolar/ledger/object"
+)
+
+// postgresStorage converts rows of postgres heavy tables to badger keys and values:
+//
+//	ScopePulse:   pulse number -> pulse proto (prev and next pulse numbers are not kept, unlike badger)
+//	ScopeRecord:  record id -> record.Material
+//	ScopeJetDrop: pulse number + jet prefix -> drop.Drop
+//	ScopeIndex:   pulse number + object id -> record.Index
+type postgresStorage struct {
+	pool *pgxpool.Pool
+}
+
+func openPostgres(url string) *postgresStorage {
+	pool, err := pgxpool.Connect(context.Background(), url)
+	if err != nil {
+		fatalf("failed to connect to postgres %v: %v\n", url, err)
+	}
+	return &postgresStorage{pool: pool}
+}
+
+func (s *postgresStorage) pulses() pulseStorage {
+	return pulsedb.NewPostgresDB(s.pool)
+}
+
+func (s *postgresStorage) scopes() []store.Scope {
+	return []store.Scope{store.ScopePulse, store.ScopeRecord, store.ScopeJetDrop, store.ScopeIndex}
+}
+
+func (s *postgresStorage) iterate(scope store.Scope, pn insolar.PulseNumber, opts *iterOptions, fns ...iteration) error {
+	ctx := context.Background()
+	w := newWalker(opts, fns)
+	defer w.done()
+
+	switch scope {
+	case store.ScopeRecord:
+		return s.iterateRecords(ctx, pn, w)
+	case store.ScopeJetDrop:
+		return s.iterateDrops(ctx, pn, w)
+	}
+
+	pulses := []insolar.PulseNumber{pn}
+	if pn == 0 {
+		var err error
+		pulses, err = s.pulseNumbers(ctx)
+		if err != nil {
+			return err
+		}
+	}
+	for _, pn := range pulses {
+		var err error
+		switch scope {
+		case store.ScopePulse:
+			err = s.walkPulse(ctx, pn, w)
+		case store.ScopeIndex:
+			err = s.walkIndexes(ctx, pn, w)
+		default:
+			return fmt.Errorf("scope %v is not kept in postgres", scope)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *postgresStorage) pulseNumbers(ctx context.Context) ([]insolar.PulseNumber, error) {
+	rows, err := s.pool.Query(ctx, "SELECT pulse_number FROM pulses ORDER BY pulse_number")
+	if err != nil {
+		return nil, fmt.Errorf("failed to select pulses: %v", err)
+	}
+	defer rows.Close()
+
+	var pulses []insolar.PulseNumber
+	for rows.Next() {
+		var pn insolar.PulseNumber
+		if err := rows.Scan(&pn); err != nil {
+			return nil, fmt.Errorf("failed to read pulse: %v", err)
+		}
+		pulses = append(pulses, pn)
+	}
+	return pulses, rows.Err()
+}
+
+// pulseFilter returns condition, that selects rows of the pulse or all rows, if pn is zero.
+func pulseFilter(pn insolar.PulseNumber) (string, []interface{}) {
+	if pn == 0 {
+		return "", nil
+	}
+	return " WHERE pulse_number = $1", []interface{}{pn}
+}
+
+func (s *postgresStorage) iterateRecords(ctx context.Context, pn insolar.PulseNumber, w *walker) error {
+	columns := "record_id, object_id, jet_id, signature, polymorph, virtual"
+	if w.opts.keysOnly {
+		columns = "record_id"
+	}
+	where, args := pulseFilter(pn)
+	rows, err := s.pool.Query(ctx, "SELECT "+columns+" FROM records"+where+" ORDER BY pulse_number, position", args...)
+	if err != nil {
+		return fmt.Errorf("failed to select records: %v", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id []byte
+		if w.opts.keysOnly {
+			err = rows.Scan(&id)
+			if err != nil {
+				return fmt.Errorf("failed to read record: %v", err)
+			}
+			w.walk(badgerKey(store.ScopeRecord, id), nil)
+			continue
+		}
+
+		rec, err := scanRecord(rows, &id)
+		if err != nil {
+			return err
+		}
+		value, err := rec.Marshal()
+		if err != nil {
+			return fmt.Errorf("failed to marshal record %v: %v", rec.ID.DebugString(), err)
+		}
+		w.walk(badgerKey(store.ScopeRecord, id), value)
+	}
+	return rows.Err()
+}
+
+func scanRecord(row pgx.Row, id *[]byte) (record.Material, error) {
+	var (
+		rec     record.Material
+		objID   []byte
+		jetID   []byte
+		virtual []byte
+	)
+	err := row.Scan(id, &objID, &jetID, &rec.Signature, &rec.Polymorph, &virtual)
+	if err != nil {
+		return rec, fmt.Errorf("failed to read record: %v", err)
+	}
+	rec.ID = *insolar.NewIDFromBytes(*id)
+	err = rec.ObjectID.UnmarshalBinary(objID)
+	if err != nil {
+		return rec, fmt.Errorf("failed to unmarshal object id of record %v: %v", rec.ID.DebugString(), err)
+	}
+	err = rec.JetID.Unmarshal(jetID)
+	if err != nil {
+		return rec, fmt.Errorf("failed to unmarshal jet id of record %v: %v", rec.ID.DebugString(), err)
+	}
+	err = rec.Virtual.Unmarshal(virtual)
+	if err != nil {
+		return rec, fmt.Errorf("failed to unmarshal virtual of record %v: %v", rec.ID.DebugString(), err)
+	}
+	return rec, nil
+}
+
+func (s *postgresStorage) iterateDrops(ctx context.Context, pn insolar.PulseNumber, w *walker) error {
+	where, args := pulseFilter(pn)
+	rows, err := s.pool.Query(ctx,
+		"SELECT id_prefix, pulse_number, jet_id, split_threshold_exceeded, split FROM drops"+where+" ORDER BY pulse_number, id_prefix",
+		args...)
+	if err != nil {
+		return fmt.Errorf("failed to select drops: %v", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var prefix []byte
+		d, err := scanDrop(rows, &prefix)
+		if err != nil {
+			return err
+		}
+		value, err := d.Marshal()
+		if err != nil {
+			return fmt.Errorf("failed to marshal drop: %v", err)
+		}
+		w.walk(badgerKey(store.ScopeJetDrop, d.Pulse.Bytes(), prefix), value)
+	}
+	return rows.Err()
+}
+
+func scanDrop(row pgx.Row, prefix *[]byte) (drop.Drop, error) {
+	var d drop.Drop
+	var jetID []byte
+	err := row.Scan(prefix, &d.Pulse, &jetID, &d.SplitThresholdExceeded, &d.Split)
+	if err != nil {
+		return d, fmt.Errorf("failed to read drop: %v", err)
+	}
+	d.JetID = insolar.JetID(*insolar.NewIDFromBytes(jetID))
+	return d, nil
+}
+
+func (s *postgresStorage) walkPulse(ctx context.Context, pn insolar.PulseNumber, w *walker) error {
+	p, err := pulsedb.NewPostgresDB(s.pool).ForPulseNumber(ctx, pn)
+	if err != nil {
+		return fmt.Errorf("failed to get pulse %v: %v", pn, err)
+	}
+	value, err := pulsedb.ToProto(&p).Marshal()
+	if err != nil {
+		return fmt.Errorf("failed to marshal pulse %v: %v", pn, err)
+	}
+	w.walk(badgerKey(store.ScopePulse, pn.Bytes()), value)
+	return nil
+}
+
+func (s *postgresStorage) walkIndexes(ctx context.Context, pn insolar.PulseNumber, w *walker) error {
+	indexes, err := s.indexes().ForPulse(ctx, pn)
+	if err == object.ErrIndexNotFound {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get indexes of pulse %v: %v", pn, err)
+	}
+	for _, idx := range indexes {
+		value, err := idx.Marshal()
+		if err != nil {
+			return fmt.Errorf("failed to marshal index of %v: %v", idx.ObjID.DebugString(), err)
+		}
+		w.walk(badgerKey(store.ScopeIndex, pn.Bytes(), idx.ObjID.Bytes()), value)
+	}
+	return nil
+}
+
+func (s *postgresStorage) indexes() *object.PostgresIndexDB {
+	return object.NewPostgresIndexDB(s.pool, object.NewPostgresRecordDB(s.pool))
+}
+
+func (s *postgresStorage) value(key []byte) ([]byte, error) {
+	ctx := context.Background()
+	if len(key) < 1+insolar.PulseNumberSize {
+		return nil, fmt.Errorf("key %x is too short", key)
+	}
+	scope, id := store.Scope(key[0]), key[1:]
+	pn := insolar.NewPulseNumber(id)
+
+	switch scope {
+	case store.ScopePulse:
+		p, err := pulsedb.NewPostgresDB(s.pool).ForPulseNumber(ctx, pn)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get pulse %v: %v", pn, err)
+		}
+		return pulsedb.ToProto(&p).Marshal()
+	case store.ScopeRecord:
+		rec, err := scanRecord(s.pool.QueryRow(ctx,
+			"SELECT record_id, object_id, jet_id, signature, polymorph, virtual FROM records WHERE record_id = $1", id),
+			&id)
+		if err != nil {
+			return nil, err
+		}
+		return rec.Marshal()
+	case store.ScopeJetDrop:
+		var prefix []byte
+		d, err := scanDrop(s.pool.QueryRow(ctx,
+			"SELECT id_prefix, pulse_number, jet_id, split_threshold_exceeded, split FROM drops WHERE pulse_number = $1 AND id_prefix = $2",
+			pn, id[pn.Size():]),
+			&prefix)
+		if err != nil {
+			return nil, err
+		}
+		return d.Marshal()
+	case store.ScopeIndex:
+		objID := insolar.NewIDFromBytes(id[pn.Size():])
+		idx, err := s.indexes().ForID(ctx, pn, *objID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get index of %v in pulse %v: %v", objID.DebugString(), pn, err)
+		}
+		return idx.Marshal()
+	default:
+		return nil, fmt.Errorf("scope %v is not kept in postgres", scope)
+	}
+}
+
+func (s *postgresStorage) close() {
+	s.pool.Close()
+}
+
+func badgerKey(scope store.Scope, parts ...[]byte) []byte {
+	key := scope.Bytes()
+	for _, p := range parts {
+		key = append(key, p...)
+	}
+	return key
+}
@@ -33,6 +33,8 @@ func prettyPrintVirtual(v *record.Virtual) string {
 		return pf.Pairs("Type", "Deactivate")
 	case *record.Virtual_PendingFilament:
 		return pf.Pairs("Type", "PendingFilament")
+	case *record.Virtual_Event:
+		return pf.Pairs("Type", "Event", "topic", r.Event.Topic)
 	case nil:
 		return "nil"
 	default:
@@ -23,7 +23,7 @@ import (
 var mb = float64(1 << 20)
 
 type dbScanner struct {
-	db *store.BadgerDB
+	db storage
 
 	nonStrict bool
 
@@ -42,11 +42,6 @@ type dbScanner struct {
 
 func (app *appCtx) scanCommand() *cobra.Command {
 	scan := &dbScanner{}
-	defer func() {
-		if scan.db != nil {
-			scan.closeDB()
-		}
-	}()
 
 	var scanCmd = &cobra.Command{
 		Use:   "scan",
@@ -94,7 +89,8 @@ func (app *appCtx) scanCommand() *cobra.Command {
 			if scan.perPulseStat || scan.searchValuesGreaterThan > 0 {
 				scan.disableProgressbar = true
 			}
-			scan.openDB(app.dataDir)
+			scan.openDB(app)
+			defer scan.closeDB()
 			scan.scanScopePulesByName(scopeName)
 		},
 	}
@@ -113,7 +109,8 @@ func (app *appCtx) scanCommand() *cobra.Command {
 		Use:   "scopes-stat",
 		Short: "show statistic by scope (by default scans all scopes)",
 		Run: func(_ *cobra.Command, _ []string) {
-			scan.openDB(app.dataDir)
+			scan.openDB(app)
+			defer scan.closeDB()
 			scan.scopesReport(fastScan, names, ids)
 		},
 	}
@@ -129,7 +126,8 @@ func (app *appCtx) scanCommand() *cobra.Command {
 		Use:   "pulses",
 		Short: "report on pulses",
 		Run: func(_ *cobra.Command, _ []string) {
-			scan.openDB(app.dataDir)
+			scan.openDB(app)
+			defer scan.closeDB()
 			scan.pulsesReport(printAll)
 		},
 	}
@@ -144,16 +142,12 @@ func (app *appCtx) scanCommand() *cobra.Command {
 	return scanCmd
 }
 
-func (dbs *dbScanner) openDB(dataDir string) {
-	db, _ := openDB(dataDir)
-	dbs.db = db
+func (dbs *dbScanner) openDB(app *appCtx) {
+	dbs.db = app.openStorage()
 }
 
 func (dbs *dbScanner) closeDB() {
-	err := dbs.db.Backend().Close()
-	if err != nil {
-		fatalf("failed close database: %v", err)
-	}
+	dbs.db.close()
 }
 
 func (dbs *dbScanner) getAllPulses() (pulses []insolar.Pulse) {
@@ -166,7 +160,7 @@ func (dbs *dbScanner) getAllPulses() (pulses []insolar.Pulse) {
 	}
 	ctx := context.Background()
 
-	var pulseStore = pulsedb.NewBadgerDB(dbs.db)
+	var pulseStore = dbs.db.pulses()
 	p, err := pulseStore.Latest(ctx)
 	pulses = append(pulses, p)
 	if err == pulsedb.ErrNotFound {
@@ -265,7 +259,7 @@ func (dbs *dbScanner) scopesReport(fast bool, names []string, ids []int) {
 		toScan = append(toScan, scope)
 	}
 	if len(toScan) == 0 {
-		toScan = allScopes()
+		toScan = dbs.db.scopes()
 	}
 	for _, scope := range toScan {
 		dbs.scanWholeScope(scope, fast)
@@ -283,7 +277,10 @@ func (dbs *dbScanner) scanWholeScope(scope store.Scope, fast bool) {
 		counter:  true,
 		keysOnly: fast,
 	}
-	iterate(dbs.db, scopeKey{scope}, opts, h.iter)
+	err := dbs.db.iterate(scope, 0, opts, h.iter)
+	if err != nil {
+		fatalf("scan failed: %v\n", err)
+	}
 
 	h.PrintKeys()
 	if !fast {
@@ -382,12 +379,10 @@ func (dbs *dbScanner) scanScopePules(scope store.Scope) {
 		}
 
 		// actual iteration happens here
-		iterate(
-			dbs.db,
-			pulseKey{store.ScopeRecord, pn},
-			nil,
-			iters...,
-		)
+		err := dbs.db.iterate(store.ScopeRecord, pn, nil, iters...)
+		if err != nil {
+			fatalf("scan of pulse %v failed: %v\n", pn, err)
+		}
 		statGraphAdd()
 		pulsePrinter()
 	}
@@ -0,0 +1,90 @@
+// Copyright 2020 Insolar Network Ltd.
+// All rights reserved.
+// This material is licensed under the Insolar License version 1.0,
+// available at https://github.com/insolar/insolar/blob/master/LICENSE.md.
+
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/insolar/insolar/insolar"
+	pulsedb "github.com/insolar/insolar/insolar/pulse"
+	"github.com/insolar/insolar/insolar/store"
+)
+
+const (
+	backendBadger   = "badger"
+	backendPostgres = "postgres"
+)
+
+// pulseStorage is a part of pulse storages, that is required for pulse reports.
+type pulseStorage interface {
+	Latest(ctx context.Context) (insolar.Pulse, error)
+	Backwards(ctx context.Context, pn insolar.PulseNumber, steps int) (insolar.Pulse, error)
+}
+
+// storage provides heavy data in badger layout: every value has a key, that is made of a scope byte and an id.
+// So reports are the same for all heavy backends.
+type storage interface {
+	// pulses returns pulse storage of the heavy.
+	pulses() pulseStorage
+	// scopes returns scopes, that are kept by the backend.
+	scopes() []store.Scope
+	// iterate passes key-value pairs of the scope to fns. Only pairs of the pulse are passed, if pn is not zero.
+	iterate(scope store.Scope, pn insolar.PulseNumber, opts *iterOptions, fns ...iteration) error
+	// value returns value by key.
+	value(key []byte) ([]byte, error)
+	close()
+}
+
+func (app *appCtx) openStorage() storage {
+	switch app.backend {
+	case backendBadger:
+		if app.dataDir == "" {
+			fatalf("--dir flag is required for %v backend\n", backendBadger)
+		}
+		db, close := openDB(app.dataDir)
+		return &badgerStorage{db: db, closeDB: close}
+	case backendPostgres:
+		return openPostgres(app.pgURL)
+	default:
+		fatalf("unknown backend %v, should be %v or %v\n", app.backend, backendBadger, backendPostgres)
+	}
+	return nil
+}
+
+type badgerStorage struct {
+	db      *store.BadgerDB
+	closeDB func()
+}
+
+func (s *badgerStorage) pulses() pulseStorage {
+	return pulsedb.NewBadgerDB(s.db)
+}
+
+func (s *badgerStorage) scopes() []store.Scope {
+	return allScopes()
+}
+
+func (s *badgerStorage) iterate(scope store.Scope, pn insolar.PulseNumber, opts *iterOptions, fns ...iteration) error {
+	var start store.Key = scopeKey{scope}
+	if pn != 0 {
+		start = pulseKey{scope, pn}
+	}
+	iterate(s.db, start, opts, fns...)
+	return nil
+}
+
+func (s *badgerStorage) value(key []byte) ([]byte, error) {
+	value, err := readValueByKey(s.db, key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get key from badger: %v", err)
+	}
+	return value, nil
+}
+
+func (s *badgerStorage) close() {
+	s.closeDB()
+}
@@ -156,6 +156,14 @@ func openDefaultInitializationPath(output *outputFlag, initPath string) error {
 	return err
 }
 
+func openDefaultCallSitesPath(output *outputFlag, buildInPath string) error {
+	callSitesPath, err := mkdirIfNotExists(buildInPath, "callsites")
+	if err != nil {
+		return err
+	}
+	return output.SetJoin(callSitesPath, "callsites.go")
+}
+
 func checkError(err error) {
 	if err != nil {
 		fmt.Println(err)
@@ -163,6 +171,23 @@ func checkError(err error) {
 	}
 }
 
+// checkLint prints non-deterministic constructs found in contracts and
+// exits if any of them is an error.
+func checkLint(rules preprocessor.LintRules, parsedFiles ...*preprocessor.ParsedFile) {
+	failed := false
+	for _, parsed := range parsedFiles {
+		issues := parsed.Lint(rules)
+		for _, issue := range issues {
+			fmt.Fprintln(os.Stderr, issue)
+		}
+		failed = failed || preprocessor.HasLintErrors(issues)
+	}
+	if failed {
+		fmt.Println("contract code is not deterministic, see errors above")
+		os.Exit(1)
+	}
+}
+
 func findContractPath(contractDirPath string) *string {
 	contractName := path.Base(contractDirPath)
 	for _, contractFileName := range []string{"main.go", contractName + ".go"} {
@@ -180,6 +205,8 @@ func main() {
 	proxyOut := newOutputFlag("")
 	machineType := newMachineTypeFlag("builtin")
 	var panicIsLogicalError bool
+	lintRules := preprocessor.DefaultLintRules()
+	lintUsage := "severity of determinism checks, comma separated list of rule=off|warning|error"
 
 	var cmdProxy = &cobra.Command{
 		Use:   "proxy [flags] <file name to process>",
@@ -191,6 +218,7 @@ func main() {
 				fmt.Println(errors.Wrap(err, "couldn't parse"))
 				os.Exit(1)
 			}
+			checkLint(lintRules, parsed)
 
 			if proxyOut.String() == "" {
 				err = openDefaultProxyPath(proxyOut, machineType.Value(), parsed, "")
@@ -204,6 +232,7 @@ func main() {
 	cmdProxy.Flags().StringVarP(&reference, "code-reference", "r", "", "reference to code of")
 	cmdProxy.Flags().VarP(proxyOut, "output", "o", "output file (use - for STDOUT)")
 	cmdProxy.Flags().VarP(machineType, "machine-type", "m", "machine type (one of builtin/go)")
+	cmdProxy.Flags().VarP(lintRules, "lint-rules", "l", lintUsage)
 
 	var cmdWrapper = &cobra.Command{
 		Use:   "wrapper [flags] <file name to process>",
@@ -215,6 +244,7 @@ func main() {
 				fmt.Println(errors.Wrap(err, "couldn't parse"))
 				os.Exit(1)
 			}
+			checkLint(lintRules, parsed)
 			if panicIsLogicalError {
 				parsed.SetPanicIsLogicalError()
 			}
@@ -226,6 +256,7 @@ func main() {
 	cmdWrapper.Flags().VarP(output, "output", "o", "output file (use - for STDOUT)")
 	cmdWrapper.Flags().VarP(machineType, "machine-type", "m", "machine type (one of builtin/go)")
 	cmdWrapper.Flags().BoolVarP(&panicIsLogicalError, "panic-logical", "p", false, "panics are logical errors (turned off by default)")
+	cmdWrapper.Flags().VarP(lintRules, "lint-rules", "l", lintUsage)
 
 	var (
 		importPath    string
@@ -270,6 +301,12 @@ func main() {
 				}
 			}
 
+			parsedFiles := make([]*preprocessor.ParsedFile, 0, len(contractList))
+			for _, contract := range contractList {
+				parsedFiles = append(parsedFiles, contract.Parsed)
+			}
+			checkLint(lintRules, parsedFiles...)
+
 			for _, contract := range contractList {
 				/* write proxy */
 				output := newOutputFlag("")
@@ -294,12 +331,22 @@ func main() {
 
 			err = preprocessor.GenerateInitializationList(initializeOutput.writer, contractList)
 			checkError(err)
+
+			// write registry of call sites
+			callSitesOutput := newOutputFlag("")
+			err = openDefaultCallSitesPath(callSitesOutput, buildInPath)
+			checkError(err)
+
+			proxyImportPath := path.Join(path.Dir(importPath), "proxy")
+			err = preprocessor.GenerateCallSiteRegistry(callSitesOutput.writer, contractList, proxyImportPath)
+			checkError(err)
 		},
 	}
 	cmdGenerateBuiltins.Flags().StringVarP(
 		&importPath, "importPath", "i", "", "import path for builtin contracts packages, example: github.com/insolar/insolar/application/builtin/contract")
 	cmdGenerateBuiltins.Flags().StringVarP(
 		&contractsPath, "contractsPath", "c", "", "dir path to builtin contracts, example: application/builtin/contract")
+	cmdGenerateBuiltins.Flags().VarP(lintRules, "lint-rules", "l", lintUsage)
 
 	var rootCmd = &cobra.Command{Use: "insgocc"}
 	rootCmd.AddCommand(
@@ -29,9 +29,12 @@ type KeeperConfig struct {
 	ListenAddress string
 	FakeTrue      bool
 	PollPeriod    time.Duration
-	QueryURL      string
-	Queries       []string
-	MaxMetricLag  time.Duration
+	// Targets are URLs of metrics endpoints of nodes, e.g. http://127.0.0.1:8001/metrics.
+	Targets       []string
+	ScrapeTimeout time.Duration
+	// MaxMetricLag is the maximum time since the last successful scrape of a target.
+	MaxMetricLag time.Duration
+	Rules        []RuleConfig
 }
 
 func NewKeeperConfig() KeeperConfig {
@@ -39,8 +42,25 @@ func NewKeeperConfig() KeeperConfig {
 		ListenAddress: ":12012",
 		FakeTrue:      false,
 		PollPeriod:    5 * time.Second,
-		QueryURL:      "https://prometheus.insolar.io/api/v1/query?query=",
-		Queries:       make([]string, 0),
+		Targets:       make([]string, 0),
+		ScrapeTimeout: 3 * time.Second,
 		MaxMetricLag:  2 * time.Minute,
+		Rules:         make([]RuleConfig, 0),
 	}
 }
+
+// RuleConfig is a threshold rule, that is evaluated for every target.
+type RuleConfig struct {
+	Name string
+	// Type is one of "value", "increase", "rate" or "ratio".
+	Type string
+	// Metric is a metric selector, e.g. `insolar_requests_opened{role="virtual"}`. Matching series are summed.
+	Metric string
+	// Total is a metric selector of the denominator of "ratio" rules.
+	Total string
+	// Op is one of <, <=, >, >=, ==, !=. Rule passes, if "value Op Threshold" is true.
+	Op        string
+	Threshold float64
+	// Window is a time range of "increase", "rate" and "ratio" rules.
+	Window time.Duration
+}
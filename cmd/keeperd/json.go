@@ -6,24 +6,15 @@
 package main
 
 type KeeperRsp struct {
-	Available bool `json:"available"`
+	Available bool          `json:"available"`
+	Checks    []CheckResult `json:"checks"`
 }
 
-type PromRsp struct {
-	Status string `json:"status"`
-	Data   struct {
-		ResultType string `json:"resultType"`
-		Result     []struct {
-			Metric struct {
-				Installation string `json:"installation"`
-				Instance     string `json:"instance"`
-				Job          string `json:"job"`
-				Role         string `json:"role"`
-			} `json:"metric"`
-			Value []interface{} `json:"value"`
-		} `json:"result"`
-	} `json:"data"`
-
-	ErrorType string `json:"errorType"`
-	Error     string `json:"error"`
+// CheckResult is a result of a rule for a target.
+type CheckResult struct {
+	Name   string  `json:"name"`
+	Target string  `json:"target"`
+	OK     bool    `json:"ok"`
+	Value  float64 `json:"value"`
+	Error  string  `json:"error,omitempty"`
 }
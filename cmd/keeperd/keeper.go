@@ -9,26 +9,37 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
-	"net/url"
 	"sync"
 	"sync/atomic"
 	"time"
 
+	"github.com/pkg/errors"
 	"go.opencensus.io/stats"
 
 	"github.com/insolar/insolar/instrumentation/inslogger"
 )
 
+// metricLagCheck is a name of the check, that fails if a target was not scraped for MaxMetricLag.
+const metricLagCheck = "metric_lag"
+
 type Keeper struct {
 	config      KeeperConfig
 	configLock  sync.RWMutex
 	isAvailable uint32
+
+	client *http.Client
+
+	lock    sync.Mutex
+	targets map[string]*targetState
+	results []CheckResult
 }
 
 func NewKeeper(cfg KeeperConfig) *Keeper {
 	return &Keeper{
 		config:      cfg,
 		isAvailable: 0,
+		client:      &http.Client{},
+		targets:     make(map[string]*targetState),
 	}
 }
 
@@ -57,76 +68,140 @@ func (k *Keeper) startChecker(ctx context.Context) {
 }
 
 func (k *Keeper) checkMetrics(ctx context.Context) {
-	queries := k.Config().Queries
+	cfg := k.Config()
 	var isOK uint32 = 1
-	if !k.Config().FakeTrue {
-		for _, q := range queries {
-			if !k.checkMetric(ctx, q) {
+	results := []CheckResult{}
+	if !cfg.FakeTrue {
+		k.scrapeTargets(ctx, cfg)
+		results = k.evaluate(ctx, cfg, time.Now())
+		for _, r := range results {
+			if !r.OK {
 				isOK = 0
 			}
 		}
 	}
+
+	k.lock.Lock()
+	k.results = results
+	k.lock.Unlock()
 	atomic.StoreUint32(&k.isAvailable, isOK)
 	stats.Record(ctx, IsAvailable.M(int64(isOK)))
 }
 
-func (k *Keeper) checkMetric(ctx context.Context, query string) bool {
+// scrapeTargets scrapes all targets concurrently and forgets targets, that were removed from config.
+func (k *Keeper) scrapeTargets(ctx context.Context, cfg KeeperConfig) {
 	logger := inslogger.FromContext(ctx)
-	logger.Debugf("Checking metric: %s", query)
 
-	metricURL := k.Config().QueryURL + url.QueryEscape(query)
-	resp, err := http.Get(metricURL)
-	if err != nil {
-		logger.Errorf("Error while getting <<%s>> query: %s", query, err.Error())
-		return false
+	keep := cfg.PollPeriod
+	for _, r := range cfg.Rules {
+		if r.Window+cfg.PollPeriod > keep {
+			keep = r.Window + cfg.PollPeriod
+		}
 	}
-	defer func() {
-		if resp != nil && resp.Body != nil {
-			err = resp.Body.Close()
-			if err != nil {
-				logger.Warnf("Failed to close response body: %s", err.Error())
-			}
+
+	var wg sync.WaitGroup
+	states := make(map[string]*targetState, len(cfg.Targets))
+	k.lock.Lock()
+	for _, target := range cfg.Targets {
+		state, ok := k.targets[target]
+		if !ok {
+			state = &targetState{}
 		}
-	}()
+		states[target] = state
 
-	promRsp := PromRsp{}
-	err = json.NewDecoder(resp.Body).Decode(&promRsp)
-	if err != nil {
-		logger.Errorf("Metric <<%s>>. Failed to parse Prometheus response: %s", query, err.Error())
-		return false
-	}
-	if promRsp.Status != "success" {
-		logger.Errorf("Metric <<%s>>. Bad response from Prometheus: %s: %s", query, promRsp.ErrorType, promRsp.Error)
-		return false
+		wg.Add(1)
+		go func(target string, state *targetState) {
+			defer wg.Done()
+
+			scrapeCtx, cancel := context.WithTimeout(ctx, cfg.ScrapeTimeout)
+			defer cancel()
+			snapshot, err := scrape(scrapeCtx, k.client, target)
+
+			k.lock.Lock()
+			defer k.lock.Unlock()
+			if err != nil {
+				logger.Errorf("Failed to scrape %s: %s", target, err.Error())
+				state.lastError = err
+				return
+			}
+			logger.Debugf("Scraped %d samples from %s", len(snapshot.Samples), target)
+			state.add(snapshot, keep)
+		}(target, state)
 	}
-	for _, res := range promRsp.Data.Result {
-		ts, ok := res.Value[0].(float64)
-		if !ok {
-			logger.Errorf("Metric <<%s>> failed on instance %s. Cannot parse timestamp %v", query, res.Metric.Instance, res.Value[0])
-			return false
+	k.targets = states
+	k.lock.Unlock()
+	wg.Wait()
+}
+
+// evaluate checks metric lag of every target and applies rules to targets, that have the rule metric.
+func (k *Keeper) evaluate(ctx context.Context, cfg KeeperConfig, now time.Time) []CheckResult {
+	logger := inslogger.FromContext(ctx)
+	k.lock.Lock()
+	defer k.lock.Unlock()
+
+	results := make([]CheckResult, 0, len(cfg.Targets)*(len(cfg.Rules)+1))
+	for _, target := range cfg.Targets {
+		result := CheckResult{Name: metricLagCheck, Target: target}
+		lag, err := k.target(target).lag(now)
+		if err != nil {
+			result.Error = err.Error()
+		} else {
+			result.Value = lag.Seconds()
+			result.OK = lag <= cfg.MaxMetricLag
 		}
-		metricLag := time.Since(time.Unix(int64(ts), 0))
-		logger.Debugf("Metric <<%s>> on instance %s lag: %s", query, res.Metric.Instance, metricLag)
-		if metricLag > k.Config().MaxMetricLag {
-			logger.Errorf("Metric <<%s>> failed on instance %s. Last data point was too long ago: %s", query, res.Metric.Instance, res.Value[0], metricLag)
-			return false
+		results = append(results, result)
+	}
+
+	for _, rc := range cfg.Rules {
+		rule, err := NewRule(rc)
+		if err != nil {
+			results = append(results, CheckResult{Name: rc.Name, Error: err.Error()})
+			continue
 		}
 
-		if res.Value[1] != "1" {
-			logger.Infof("Metric <<%s>> failed on instance %s. Value is %s", query, res.Metric.Instance, res.Value[1])
-			return false
+		applied := false
+		for _, target := range cfg.Targets {
+			history := k.target(target).history
+			if len(history) == 0 {
+				continue
+			}
+			value, ok, err := rule.Evaluate(history)
+			if err == errNoSamples {
+				continue
+			}
+			applied = true
+			result := CheckResult{Name: rc.Name, Target: target, OK: ok, Value: value}
+			if err != nil {
+				result.Error = err.Error()
+			}
+			if !result.OK {
+				logger.Infof("Rule <<%s>> failed on target %s. Value is %v, error: %s", rc.Name, target, value, result.Error)
+			}
+			results = append(results, result)
+		}
+		if !applied {
+			results = append(results, CheckResult{Name: rc.Name, Error: errors.Wrap(errNoSamples, "no target has the metric").Error()})
 		}
 	}
+	return results
+}
 
-	return true
+func (k *Keeper) target(url string) *targetState {
+	if state, ok := k.targets[url]; ok {
+		return state
+	}
+	return &targetState{}
 }
 
 func (k *Keeper) startServer(ctx context.Context) {
 	logger := inslogger.FromContext(ctx)
 	http.HandleFunc("/check", func(writer http.ResponseWriter, request *http.Request) {
+		k.lock.Lock()
 		response := KeeperRsp{
 			Available: atomic.LoadUint32(&k.isAvailable) > 0,
+			Checks:    k.results,
 		}
+		k.lock.Unlock()
 		writer.Header().Set("Content-Type", "application/json")
 		err := json.NewEncoder(writer).Encode(response)
 		if err != nil {
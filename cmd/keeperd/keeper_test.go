@@ -0,0 +1,191 @@
+// Copyright 2020 Insolar Network Ltd.
+// All rights reserved.
+// This material is licensed under the Insolar License version 1.0,
+// available at https://github.com/insolar/insolar/blob/master/LICENSE.md.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSelector(t *testing.T) {
+	sel, err := parseSelector(`insolar_requests{role="virtual", status!="ok"}`)
+	require.NoError(t, err)
+	require.Equal(t, "insolar_requests", sel.name)
+	require.Len(t, sel.matchers, 2)
+
+	assert.True(t, sel.matches(Sample{Name: "insolar_requests", Labels: map[string]string{"role": "virtual", "status": "error"}}))
+	assert.False(t, sel.matches(Sample{Name: "insolar_requests", Labels: map[string]string{"role": "virtual", "status": "ok"}}))
+	assert.False(t, sel.matches(Sample{Name: "insolar_requests", Labels: map[string]string{"role": "light"}}))
+	assert.False(t, sel.matches(Sample{Name: "insolar_requests_total", Labels: map[string]string{"role": "virtual"}}))
+
+	sel, err = parseSelector("insolar_current_pulse")
+	require.NoError(t, err)
+	assert.True(t, sel.matches(Sample{Name: "insolar_current_pulse"}))
+
+	for _, invalid := range []string{"", "1metric", `metric{role=virtual}`, `metric{role="virtual"`, `metric{role~"v"}`} {
+		_, err := parseSelector(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestNewRule_Invalid(t *testing.T) {
+	for _, cfg := range []RuleConfig{
+		{Type: RuleValue, Metric: "m", Op: "<"},
+		{Name: "r", Type: "unknown", Metric: "m", Op: "<"},
+		{Name: "r", Type: RuleValue, Metric: "m", Op: "=~"},
+		{Name: "r", Type: RuleRate, Metric: "m", Op: "<"},
+		{Name: "r", Type: RuleRatio, Metric: "m", Op: "<", Window: time.Minute},
+	} {
+		_, err := NewRule(cfg)
+		assert.Error(t, err, cfg)
+	}
+}
+
+func snapshot(at time.Time, values map[string]float64) Snapshot {
+	s := Snapshot{Time: at}
+	for name, v := range values {
+		s.Samples = append(s.Samples, Sample{Name: name, Value: v})
+	}
+	return s
+}
+
+func TestRule_Evaluate(t *testing.T) {
+	now := time.Now()
+	history := []Snapshot{
+		snapshot(now.Add(-2*time.Minute), map[string]float64{"pulse": 100, "errors": 0, "requests": 0}),
+		snapshot(now.Add(-time.Minute), map[string]float64{"pulse": 110, "errors": 5, "requests": 100}),
+		snapshot(now.Add(-30*time.Second), map[string]float64{"pulse": 110, "errors": 10, "requests": 150}),
+		snapshot(now, map[string]float64{"pulse": 110, "errors": 30, "requests": 200}),
+	}
+
+	table := []struct {
+		rule  RuleConfig
+		value float64
+		ok    bool
+	}{
+		{
+			rule:  RuleConfig{Type: RuleValue, Metric: "pulse", Op: ">", Threshold: 0},
+			value: 110,
+			ok:    true,
+		},
+		{
+			rule:  RuleConfig{Type: RuleIncrease, Metric: "pulse", Op: ">", Window: time.Minute},
+			value: 0,
+			ok:    false,
+		},
+		{
+			rule:  RuleConfig{Type: RuleIncrease, Metric: "pulse", Op: ">", Window: 2 * time.Minute},
+			value: 10,
+			ok:    true,
+		},
+		{
+			rule:  RuleConfig{Type: RuleRate, Metric: "requests", Op: "<", Threshold: 1, Window: time.Minute},
+			value: 100.0 / 60,
+			ok:    false,
+		},
+		{
+			rule:  RuleConfig{Type: RuleRatio, Metric: "errors", Total: "requests", Op: "<=", Threshold: 0.1, Window: time.Minute},
+			value: 0.25,
+			ok:    false,
+		},
+	}
+	for i, test := range table {
+		t.Run(fmt.Sprint(i), func(t *testing.T) {
+			test.rule.Name = "rule"
+			rule, err := NewRule(test.rule)
+			require.NoError(t, err)
+			value, ok, err := rule.Evaluate(history)
+			require.NoError(t, err)
+			assert.InDelta(t, test.value, value, 1e-9)
+			assert.Equal(t, test.ok, ok)
+		})
+	}
+
+	rule, err := NewRule(RuleConfig{Name: "rule", Type: RuleValue, Metric: "unknown", Op: ">"})
+	require.NoError(t, err)
+	_, _, err = rule.Evaluate(history)
+	assert.Equal(t, errNoSamples, err)
+
+	rule, err = NewRule(RuleConfig{Name: "rule", Type: RuleRate, Metric: "requests", Op: ">", Window: time.Minute})
+	require.NoError(t, err)
+	_, ok, err := rule.Evaluate(history[:1])
+	assert.Error(t, err)
+	assert.False(t, ok)
+}
+
+func TestKeeper_CheckMetrics(t *testing.T) {
+	ctx := context.Background()
+	var pulse int64 = 65537
+	virtual := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, "# TYPE insolar_current_pulse gauge\ninsolar_current_pulse{role=\"virtual\"} %d\n", atomic.LoadInt64(&pulse))
+		fmt.Fprint(w, "# TYPE insolar_requests_opened counter\ninsolar_requests_opened{role=\"virtual\"} 100\n")
+		fmt.Fprint(w, "# TYPE insolar_requests_closed counter\ninsolar_requests_closed{role=\"virtual\"} 90\n")
+	}))
+	defer virtual.Close()
+	light := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, "# TYPE insolar_current_pulse gauge\ninsolar_current_pulse{role=\"light_material\"} %d\n", atomic.LoadInt64(&pulse))
+	}))
+	defer light.Close()
+
+	cfg := NewKeeperConfig()
+	cfg.Targets = []string{virtual.URL, light.URL}
+	cfg.Rules = []RuleConfig{
+		{Name: "pulse", Type: RuleValue, Metric: "insolar_current_pulse", Op: ">", Threshold: 65536},
+		{Name: "opened", Type: RuleValue, Metric: "insolar_requests_opened", Op: "<", Threshold: 1000},
+	}
+	k := NewKeeper(cfg)
+
+	k.checkMetrics(ctx)
+	require.Equal(t, uint32(1), atomic.LoadUint32(&k.isAvailable))
+	// metric lag for both targets, pulse for both targets, opened only for virtual
+	require.Len(t, k.results, 5)
+	for _, r := range k.results {
+		assert.True(t, r.OK, r)
+		assert.NotEmpty(t, r.Target, r)
+	}
+
+	atomic.StoreInt64(&pulse, 1)
+	k.checkMetrics(ctx)
+	require.Equal(t, uint32(0), atomic.LoadUint32(&k.isAvailable))
+	failed := 0
+	for _, r := range k.results {
+		if !r.OK {
+			failed++
+			assert.Equal(t, "pulse", r.Name)
+			assert.Equal(t, float64(1), r.Value)
+		}
+	}
+	assert.Equal(t, 2, failed)
+
+	atomic.StoreInt64(&pulse, 65537)
+	k.checkMetrics(ctx)
+	require.Equal(t, uint32(1), atomic.LoadUint32(&k.isAvailable))
+
+	// unavailable target fails metric lag check, when its last data point is too old
+	light.Close()
+	k.checkMetrics(ctx)
+	require.Equal(t, uint32(1), atomic.LoadUint32(&k.isAvailable))
+	cfg.MaxMetricLag = 0
+	k.SetConfig(cfg)
+	k.checkMetrics(ctx)
+	require.Equal(t, uint32(0), atomic.LoadUint32(&k.isAvailable))
+
+	// rule without data in any target fails
+	cfg = NewKeeperConfig()
+	cfg.Targets = []string{virtual.URL}
+	cfg.Rules = []RuleConfig{{Name: "unknown", Type: RuleValue, Metric: "unknown_metric", Op: ">"}}
+	k.SetConfig(cfg)
+	k.checkMetrics(ctx)
+	require.Equal(t, uint32(0), atomic.LoadUint32(&k.isAvailable))
+}
@@ -0,0 +1,229 @@
+// Copyright 2020 Insolar Network Ltd.
+// All rights reserved.
+// This material is licensed under the Insolar License version 1.0,
+// available at https://github.com/insolar/insolar/blob/master/LICENSE.md.
+
+package main
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Types of rules.
+const (
+	// RuleValue compares the current value of the metric.
+	RuleValue = "value"
+	// RuleIncrease compares increase of the metric during the window,
+	// e.g. pulse is not advancing if increase of insolar_current_pulse is 0.
+	RuleIncrease = "increase"
+	// RuleRate compares per-second increase of the metric during the window.
+	RuleRate = "rate"
+	// RuleRatio compares increase of the metric with increase of Total metric during the window,
+	// e.g. rate of failed requests.
+	RuleRatio = "ratio"
+)
+
+// errNoSamples is returned, if the target doesn't have the metric. Rule is not applied to such targets.
+var errNoSamples = errors.New("no samples")
+
+// selector selects series of a metric, e.g. `insolar_requests_opened{role="virtual",instance!="a"}`.
+type selector struct {
+	name     string
+	matchers []labelMatcher
+}
+
+type labelMatcher struct {
+	name     string
+	value    string
+	negative bool
+}
+
+var (
+	selectorRegexp = regexp.MustCompile(`^\s*([a-zA-Z_:][a-zA-Z0-9_:]*)\s*(?:\{(.*)\})?\s*$`)
+	matcherRegexp  = regexp.MustCompile(`^\s*([a-zA-Z_][a-zA-Z0-9_]*)\s*(!?=)\s*"((?:[^"\\]|\\.)*)"\s*(?:,|$)`)
+)
+
+func parseSelector(s string) (selector, error) {
+	m := selectorRegexp.FindStringSubmatch(s)
+	if m == nil {
+		return selector{}, errors.Errorf("invalid metric selector %q", s)
+	}
+	sel := selector{name: m[1]}
+	rest := m[2]
+	for strings.TrimSpace(rest) != "" {
+		lm := matcherRegexp.FindStringSubmatch(rest)
+		if lm == nil {
+			return selector{}, errors.Errorf("invalid label matcher in %q", s)
+		}
+		value := strings.NewReplacer(`\"`, `"`, `\\`, `\`).Replace(lm[3])
+		sel.matchers = append(sel.matchers, labelMatcher{name: lm[1], value: value, negative: lm[2] == "!="})
+		rest = rest[len(lm[0]):]
+	}
+	return sel, nil
+}
+
+func (s selector) matches(sample Sample) bool {
+	if sample.Name != s.name {
+		return false
+	}
+	for _, m := range s.matchers {
+		if (sample.Labels[m.name] == m.value) == m.negative {
+			return false
+		}
+	}
+	return true
+}
+
+// sum returns sum of all series of the snapshot, that match the selector.
+func (s selector) sum(snapshot Snapshot) (float64, error) {
+	var (
+		found bool
+		sum   float64
+	)
+	for _, sample := range snapshot.Samples {
+		if s.matches(sample) {
+			found = true
+			sum += sample.Value
+		}
+	}
+	if !found {
+		return 0, errNoSamples
+	}
+	return sum, nil
+}
+
+// increase returns increase of the metric between snapshots. Counter resets are taken into account.
+func (s selector) increase(from, to Snapshot) (float64, error) {
+	first, err := s.sum(from)
+	if err != nil {
+		return 0, err
+	}
+	last, err := s.sum(to)
+	if err != nil {
+		return 0, err
+	}
+	if last < first {
+		return last, nil
+	}
+	return last - first, nil
+}
+
+// Rule is a compiled RuleConfig.
+type Rule struct {
+	config RuleConfig
+	metric selector
+	total  selector
+}
+
+// NewRule checks the rule config and parses its selectors.
+func NewRule(cfg RuleConfig) (*Rule, error) {
+	if cfg.Name == "" {
+		return nil, errors.New("rule name is empty")
+	}
+	switch cfg.Op {
+	case "<", "<=", ">", ">=", "==", "!=":
+	default:
+		return nil, errors.Errorf("rule %s: unknown operator %q", cfg.Name, cfg.Op)
+	}
+
+	r := &Rule{config: cfg}
+	var err error
+	r.metric, err = parseSelector(cfg.Metric)
+	if err != nil {
+		return nil, errors.Wrapf(err, "rule %s", cfg.Name)
+	}
+
+	switch cfg.Type {
+	case RuleValue:
+	case RuleIncrease, RuleRate, RuleRatio:
+		if cfg.Window <= 0 {
+			return nil, errors.Errorf("rule %s: window is required for %s rules", cfg.Name, cfg.Type)
+		}
+		if cfg.Type != RuleRatio {
+			break
+		}
+		r.total, err = parseSelector(cfg.Total)
+		if err != nil {
+			return nil, errors.Wrapf(err, "rule %s: total", cfg.Name)
+		}
+	default:
+		return nil, errors.Errorf("rule %s: unknown type %q", cfg.Name, cfg.Type)
+	}
+	return r, nil
+}
+
+// Evaluate computes the rule value from the target history, that is ordered by time.
+// It returns false, if the value doesn't satisfy the condition of the rule.
+func (r *Rule) Evaluate(history []Snapshot) (float64, bool, error) {
+	if len(history) == 0 {
+		return 0, false, errors.New("no data")
+	}
+	latest := history[len(history)-1]
+
+	var value float64
+	if r.config.Type == RuleValue {
+		var err error
+		value, err = r.metric.sum(latest)
+		if err != nil {
+			return 0, false, err
+		}
+		return value, r.compare(value), nil
+	}
+
+	start := windowStart(history, latest.Time.Add(-r.config.Window))
+	if start.Time.Equal(latest.Time) {
+		return 0, false, errors.Errorf("not enough data for %s", r.config.Window)
+	}
+	increase, err := r.metric.increase(start, latest)
+	if err != nil {
+		return 0, false, err
+	}
+
+	switch r.config.Type {
+	case RuleIncrease:
+		value = increase
+	case RuleRate:
+		value = increase / latest.Time.Sub(start.Time).Seconds()
+	case RuleRatio:
+		total, err := r.total.increase(start, latest)
+		if err != nil {
+			return 0, false, err
+		}
+		if total > 0 {
+			value = increase / total
+		}
+	}
+	return value, r.compare(value), nil
+}
+
+// windowStart returns the oldest snapshot, that is not older than from.
+func windowStart(history []Snapshot, from time.Time) Snapshot {
+	for _, s := range history {
+		if !s.Time.Before(from) {
+			return s
+		}
+	}
+	return history[len(history)-1]
+}
+
+func (r *Rule) compare(value float64) bool {
+	threshold := r.config.Threshold
+	switch r.config.Op {
+	case "<":
+		return value < threshold
+	case "<=":
+		return value <= threshold
+	case ">":
+		return value > threshold
+	case ">=":
+		return value >= threshold
+	case "==":
+		return value == threshold
+	default:
+		return value != threshold
+	}
+}
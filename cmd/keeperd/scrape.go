@@ -0,0 +1,144 @@
+// Copyright 2020 Insolar Network Ltd.
+// All rights reserved.
+// This material is licensed under the Insolar License version 1.0,
+// available at https://github.com/insolar/insolar/blob/master/LICENSE.md.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+)
+
+// Sample is a single value of a metric series.
+type Sample struct {
+	Name   string
+	Labels map[string]string
+	Value  float64
+}
+
+// Snapshot is a set of samples scraped from a target at once.
+type Snapshot struct {
+	Time    time.Time
+	Samples []Sample
+}
+
+// scrape reads metrics of a target in Prometheus text format.
+func scrape(ctx context.Context, client *http.Client, url string) (Snapshot, error) {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return Snapshot{}, errors.Wrap(err, "failed to create request")
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Accept", "text/plain;version=0.0.4")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return Snapshot{}, errors.Wrap(err, "failed to get metrics")
+	}
+	defer resp.Body.Close() // nolint
+	if resp.StatusCode != http.StatusOK {
+		return Snapshot{}, errors.Errorf("bad response status: %s", resp.Status)
+	}
+
+	var parser expfmt.TextParser
+	families, err := parser.TextToMetricFamilies(resp.Body)
+	if err != nil {
+		return Snapshot{}, errors.Wrap(err, "failed to parse metrics")
+	}
+	return Snapshot{Time: time.Now(), Samples: flatten(families)}, nil
+}
+
+// flatten converts metric families to samples in the same way, as Prometheus stores them.
+func flatten(families map[string]*dto.MetricFamily) []Sample {
+	var samples []Sample
+	for name, family := range families {
+		for _, m := range family.GetMetric() {
+			labels := make(map[string]string, len(m.GetLabel()))
+			for _, l := range m.GetLabel() {
+				labels[l.GetName()] = l.GetValue()
+			}
+			add := func(suffix string, value float64, extra ...string) {
+				sampleLabels := labels
+				if len(extra) > 0 {
+					sampleLabels = make(map[string]string, len(labels)+1)
+					for k, v := range labels {
+						sampleLabels[k] = v
+					}
+					sampleLabels[extra[0]] = extra[1]
+				}
+				samples = append(samples, Sample{Name: name + suffix, Labels: sampleLabels, Value: value})
+			}
+
+			switch family.GetType() {
+			case dto.MetricType_COUNTER:
+				add("", m.GetCounter().GetValue())
+			case dto.MetricType_GAUGE:
+				add("", m.GetGauge().GetValue())
+			case dto.MetricType_SUMMARY:
+				s := m.GetSummary()
+				for _, q := range s.GetQuantile() {
+					add("", q.GetValue(), "quantile", formatFloat(q.GetQuantile()))
+				}
+				add("_sum", s.GetSampleSum())
+				add("_count", float64(s.GetSampleCount()))
+			case dto.MetricType_HISTOGRAM:
+				h := m.GetHistogram()
+				for _, b := range h.GetBucket() {
+					add("_bucket", float64(b.GetCumulativeCount()), "le", formatFloat(b.GetUpperBound()))
+				}
+				add("_bucket", float64(h.GetSampleCount()), "le", "+Inf")
+				add("_sum", h.GetSampleSum())
+				add("_count", float64(h.GetSampleCount()))
+			default:
+				add("", m.GetUntyped().GetValue())
+			}
+		}
+	}
+	return samples
+}
+
+func formatFloat(f float64) string {
+	if math.IsInf(f, 1) {
+		return "+Inf"
+	}
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
+
+// targetState keeps recent snapshots of a target.
+type targetState struct {
+	history     []Snapshot
+	lastSuccess time.Time
+	lastError   error
+}
+
+func (t *targetState) add(s Snapshot, keep time.Duration) {
+	t.history = append(t.history, s)
+	t.lastSuccess = s.Time
+	t.lastError = nil
+
+	oldest := s.Time.Add(-keep)
+	i := 0
+	for i < len(t.history)-1 && t.history[i].Time.Before(oldest) {
+		i++
+	}
+	t.history = t.history[i:]
+}
+
+func (t *targetState) lag(now time.Time) (time.Duration, error) {
+	if t.lastSuccess.IsZero() {
+		if t.lastError != nil {
+			return 0, t.lastError
+		}
+		return 0, fmt.Errorf("target is not scraped yet")
+	}
+	return now.Sub(t.lastSuccess), nil
+}
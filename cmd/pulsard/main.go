@@ -7,6 +7,7 @@ package main
 
 import (
 	"context"
+	"crypto"
 	"fmt"
 	"os"
 	"os/signal"
@@ -28,6 +29,7 @@ import (
 	"github.com/insolar/insolar/platformpolicy"
 	"github.com/insolar/insolar/pulsar"
 	"github.com/insolar/insolar/pulsar/entropygenerator"
+	"github.com/insolar/insolar/pulsar/multipulsar"
 	"github.com/insolar/insolar/pulse"
 	"github.com/insolar/insolar/version"
 	"github.com/spf13/cobra"
@@ -103,10 +105,14 @@ func main() {
 	}
 
 	cm, server := initPulsar(ctx, cfg)
-	pulseTicker := runPulsar(ctx, server, cfg.Pulsar)
+
+	// in multi-pulsar mode only the initiator sends pulses, other pulsars take part in entropy generation
+	if !cfg.Pulsar.MultiPulsar.Enabled || cfg.Pulsar.MultiPulsar.Initiator {
+		pulseTicker := runPulsar(ctx, server, cfg.Pulsar)
+		defer pulseTicker.Stop()
+	}
 
 	defer func() {
-		pulseTicker.Stop()
 		err = cm.Stop(ctx)
 		if err != nil {
 			inslog.Error(err)
@@ -138,9 +144,19 @@ func initPulsar(ctx context.Context, cfg *configuration.PulsarConfiguration) (*c
 	}
 
 	cm := component.NewManager(nil)
-	cm.Register(cryptographyScheme, keyStore, keyProcessor, transport.NewFactory(cfg.Pulsar.DistributionTransport))
+	cm.Register(cryptographyScheme, keyStore, keyProcessor, distributionFactory(keyStore, cfg.Pulsar.DistributionTransport))
 	cm.Inject(cryptographyService, pulseDistributor)
 
+	var source *multipulsar.Source
+	if cfg.Pulsar.MultiPulsar.Enabled {
+		source = multipulsar.New(
+			cfg.Pulsar.MultiPulsar,
+			transport.NewFactory(cfg.Pulsar.MultiPulsar.Transport),
+			&entropygenerator.StandardEntropyGenerator{},
+		)
+		cm.Inject(source)
+	}
+
 	if err = cm.Init(ctx); err != nil {
 		panic(err)
 	}
@@ -157,10 +173,37 @@ func initPulsar(ctx context.Context, cfg *configuration.PulsarConfiguration) (*c
 		pulseDistributor,
 		&entropygenerator.StandardEntropyGenerator{},
 	)
+	if source != nil {
+		server.EntropySource = source
+	}
 
 	return cm, server
 }
 
+// distributionFactory creates transport factory for pulse distribution.
+// Pulses are public and signed, so pulsar accepts any node key in TLS handshakes,
+// nodes check the pulsar key with their list of pulsar keys.
+func distributionFactory(keyStore insolar.KeyStore, cfg configuration.Transport) transport.Factory {
+	if cfg.Protocol != "TLS" {
+		return transport.NewFactory(cfg)
+	}
+
+	privateKey, err := keyStore.GetPrivateKey("")
+	if err != nil {
+		panic(err)
+	}
+	signer, ok := privateKey.(crypto.Signer)
+	if !ok {
+		panic("pulsar key can't be used for TLS")
+	}
+	return transport.NewSecureFactory(cfg, transport.Credentials{
+		Signer: signer,
+		Verifier: transport.PeerVerifierFunc(func(string, crypto.PublicKey, []byte) error {
+			return nil
+		}),
+	})
+}
+
 func runPulsar(ctx context.Context, server *pulsar.Pulsar, cfg configuration.Pulsar) *time.Ticker {
 	nextPulseNumber := pulse.OfNow()
 	err := server.Send(ctx, nextPulseNumber)
@@ -172,6 +215,11 @@ func runPulsar(ctx context.Context, server *pulsar.Pulsar, cfg configuration.Pul
 	go func() {
 		for range pulseTicker.C {
 			err := server.Send(ctx, server.LastPN()+insolar.PulseNumber(cfg.NumberDelta))
+			if err != nil && cfg.MultiPulsar.Enabled {
+				// round fails if any of peers is unavailable, next round is tried on the next tick
+				inslogger.FromContext(ctx).Error("failed to send pulse: ", err)
+				continue
+			}
 			if err != nil {
 				panic(err)
 			}
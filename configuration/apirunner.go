@@ -16,6 +16,9 @@ type APIRunner struct {
 	// IsAdmin indicates status of api (internal or external)
 	IsAdmin     bool
 	SwaggerPath string
+	// CallSites is a list of `<contract>.<method>` call sites allowed to be called from api.
+	// Empty list means the default set of the application, e.g. call sites marked with `ins:public`.
+	CallSites []string
 }
 
 // NewAPIRunner creates new api config
@@ -37,6 +40,6 @@ func NewAPIRunner(admin bool) APIRunner {
 }
 
 func (ar *APIRunner) String() string {
-	res := fmt.Sprintf("Addr -> %s, RPC -> %s, IsAdmin -> %t, SwaggerPath -> %s\n", ar.Address, ar.RPC, ar.IsAdmin, ar.SwaggerPath)
+	res := fmt.Sprintf("Addr -> %s, RPC -> %s, IsAdmin -> %t, SwaggerPath -> %s, CallSites -> %v\n", ar.Address, ar.RPC, ar.IsAdmin, ar.SwaggerPath, ar.CallSites)
 	return res
 }
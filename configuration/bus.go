@@ -12,6 +12,8 @@ import (
 // Bus holds some timeout options
 type Bus struct {
 	ReplyTimeout time.Duration
+	// CaptureFile is a file, all incoming and outgoing bus messages are written to. Capture is disabled if empty.
+	CaptureFile string
 }
 
 func NewBus() Bus {
@@ -24,9 +24,12 @@ type GenericConfiguration struct {
 	AvailabilityChecker AvailabilityChecker
 	KeysPath            string
 	CertificatePath     string
-	Tracer              Tracer
-	Introspection       Introspection
-	Bus                 Bus
+	// SignatureAlgorithm is a signature algorithm of the deployment (ecdsa or ed25519). Signatures of keys
+	// with other supported algorithms are verified too. Keys of nodes must be ecdsa, it is required by consensus.
+	SignatureAlgorithm string
+	Tracer             Tracer
+	Introspection      Introspection
+	Bus                Bus
 
 	// LightChainLimit is maximum pulse difference (NOT number of pulses)
 	// between current and the latest replicated on heavy.
@@ -68,6 +71,7 @@ func NewGenericConfiguration() GenericConfiguration {
 		AvailabilityChecker: NewAvailabilityChecker(),
 		KeysPath:            "./",
 		CertificatePath:     "",
+		SignatureAlgorithm:  "ecdsa",
 		Tracer:              NewTracer(),
 		Introspection:       NewIntrospection(),
 		Bus:                 NewBus(),
@@ -18,6 +18,7 @@ type HeavyBadgerConfig struct {
 	DatabaseType         string
 	Ledger               Ledger
 	Exporter             Exporter
+	Replica              Replica
 }
 
 // ConfigHeavyPg contains configuration params for HeavyPg
@@ -26,6 +27,7 @@ type ConfigHeavyPg struct {
 	DatabaseType         string
 	Ledger               LedgerPg
 	Exporter             Exporter
+	Replica              Replica
 }
 
 // NewHeavyBadgerConfig creates new default configuration
@@ -34,6 +36,7 @@ func NewHeavyBadgerConfig() HeavyBadgerConfig {
 		DatabaseType:         DbTypeBadger,
 		Ledger:               NewLedger(),
 		Exporter:             NewExporter(),
+		Replica:              NewReplica(),
 		GenericConfiguration: NewGenericConfiguration(),
 	}
 }
@@ -44,6 +47,7 @@ func NewHeavyPgConfig() ConfigHeavyPg {
 		DatabaseType:         DbTypePg,
 		Ledger:               NewLedgerPg(),
 		Exporter:             NewExporter(),
+		Replica:              NewReplica(),
 		GenericConfiguration: NewGenericConfiguration(),
 	}
 	return cfg
@@ -15,6 +15,9 @@ type Exporter struct {
 	CheckVersion bool
 	// RateLimit specifies in/out limits for the Exporter API
 	RateLimit RateLimit
+	// ReplicationChunkSize specifies max size of replicated pulse message in bytes.
+	// Bigger pulses are sent to replicas in several messages.
+	ReplicationChunkSize int
 }
 
 // Auth specifies parameters for a token-based authorization of an observer
@@ -46,21 +49,33 @@ type Limits struct {
 
 type Handlers struct {
 	RecordExport            int
+	RecordExportFiltered    int
 	PulseExport             int
 	PulseTopSyncPulse       int
 	PulseNextFinalizedPulse int
+	ObjectHistoryExport     int
+	ReplicationExport       int
+	EventExport             int
 }
 
 func (h Handlers) Limit(method string) int {
 	switch method {
 	case "/exporter.RecordExporter/Export":
 		return h.RecordExport
+	case "/exporter.RecordExporter/ExportFiltered":
+		return h.RecordExportFiltered
 	case "/exporter.PulseExporter/Export":
 		return h.PulseExport
 	case "/exporter.PulseExporter/TopSyncPulse":
 		return h.PulseTopSyncPulse
 	case "/exporter.PulseExporter/NextFinalizedPulse":
 		return h.PulseNextFinalizedPulse
+	case "/exporter.ObjectHistoryExporter/Export":
+		return h.ObjectHistoryExport
+	case "/exporter.ReplicationExporter/Export":
+		return h.ReplicationExport
+	case "/exporter.EventExporter/Export":
+		return h.EventExport
 	default:
 		return 0
 	}
@@ -75,5 +90,6 @@ func NewExporter() Exporter {
 			Issuer:   "Insolar-auth-service",
 			Secret:   "",
 		},
+		ReplicationChunkSize: 1 << 20,
 	}
 }
@@ -5,9 +5,14 @@
 
 package configuration
 
+import (
+	"time"
+)
+
 // Transport holds transport protocol configuration for HostNetwork
 type Transport struct {
-	// protocol type
+	// protocol type: TCP or TLS.
+	// TLS encrypts streams between nodes and authenticates nodes with keys from certificate or active node list.
 	Protocol string
 	// Address to listen
 	Address string
@@ -15,6 +20,25 @@ type Transport struct {
 	FixedPublicAddress string
 }
 
+// Misbehavior holds configuration of registry of consensus misbehavior reports
+type Misbehavior struct {
+	FraudScore int // score of fraud report
+	BlameScore int // score of blame report
+	// ValidationScore is a score of validation mismatch report. It's 0 by default, because mismatch is found
+	// by a single validator and isn't agreed with others, so it doesn't lead to eviction or quarantine.
+	ValidationScore int
+	// ReportTTL is a time, reports are counted in score of the node for
+	ReportTTL time.Duration
+	// EvictThreshold is a score, node is evicted from consensus population on. 0 disables eviction.
+	// Eviction is disabled by default, because reports are local to the node and blacklist isn't agreed
+	// by consensus, so nodes with different reports may disagree on the population.
+	EvictThreshold int
+	// QuarantineThreshold is a score, node isn't allowed to join the network on. 0 disables quarantine
+	QuarantineThreshold int
+	// StorageFile is a file, reports are kept in between restarts. Reports are kept in memory only if empty
+	StorageFile string
+}
+
 // HostNetwork holds configuration for HostNetwork
 type HostNetwork struct {
 	Transport           Transport
@@ -23,6 +47,9 @@ type HostNetwork struct {
 	TimeoutMult         int   // bootstrap timout multiplier
 	SignMessages        bool  // signing a messages if true
 	HandshakeSessionTTL int32 // ms
+	// PulsarPublicKeys are keys of pulsars, that are allowed to connect to the node with TLS transport
+	PulsarPublicKeys []string
+	Misbehavior      Misbehavior
 }
 
 // NewHostNetwork creates new default HostNetwork configuration
@@ -37,5 +64,13 @@ func NewHostNetwork() HostNetwork {
 		TimeoutMult:         2,
 		SignMessages:        false,
 		HandshakeSessionTTL: 5000,
+		Misbehavior: Misbehavior{
+			FraudScore:          10,
+			BlameScore:          1,
+			ValidationScore:     0,
+			ReportTTL:           time.Hour,
+			EvictThreshold:      0,
+			QuarantineThreshold: 30,
+		},
 	}
 }
@@ -32,6 +32,10 @@ type Backup struct {
 	// Enabled switches on backuping
 	Enabled bool
 
+	// Incremental switches on writing of data changed since the previous backup to BackupFile and its meta info
+	// to MetaInfoFile before PostProcessBackupCmd is invoked. Otherwise PostProcessBackupCmd gets empty directory
+	Incremental bool
+
 	// TmpDirectory is directory for tmp storage of backup data. Must be created
 	TmpDirectory string
 
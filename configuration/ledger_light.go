@@ -19,6 +19,17 @@ type LedgerLight struct {
 
 	// FilamentCacheLimit holds the limit for cache items for an object
 	FilamentCacheLimit int
+
+	// WAL holds configuration of write-ahead log for light storages
+	WAL WAL
+}
+
+// WAL holds configuration for write-ahead log of drops, records and indexes.
+type WAL struct {
+	// Directory is a directory for log files. Log is disabled if directory is empty.
+	Directory string
+	// SyncWrites flushes every write to disk before it's acknowledged.
+	SyncWrites bool
 }
 
 // JetSplit holds configuration for jet split.
@@ -29,6 +40,11 @@ type JetSplit struct {
 	ThresholdOverflowCount int
 	// DepthLimit limits jet tree depth (maximum possible jets = 2^DepthLimit)
 	DepthLimit uint8
+	// MergeThresholdRecordsCount is a drop threshold in records, below which jet is merged with its sibling.
+	// Zero disables merge.
+	MergeThresholdRecordsCount int
+	// MergeUnderflowCount is a how many times in row both sibling jets should stay below MergeThresholdRecordsCount.
+	MergeUnderflowCount int
 }
 
 // NewLedger creates new default Ledger configuration.
@@ -39,10 +55,17 @@ func NewLedgerLight() LedgerLight {
 			ThresholdRecordsCount:  100,
 			ThresholdOverflowCount: 3,
 			DepthLimit:             5, // limit to 32 jets
+
+			MergeThresholdRecordsCount: 10,
+			MergeUnderflowCount:        10,
 		},
 
 		CleanerDelay:             3,    // 3 pulses
 		MaxNotificationsPerPulse: 100,  // 100 objects
 		FilamentCacheLimit:       3000, // 3000 records for every object
+
+		WAL: WAL{
+			SyncWrites: true,
+		},
 	}
 }
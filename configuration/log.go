@@ -15,14 +15,14 @@ type Log struct {
 	Adapter string
 	// Log output format - e.g. json or text
 	Formatter string
-	// Log output type - e.g. stderr, syslog
+	// Log output type - e.g. stderr, syslog, file
 	OutputType string
 	// Write-parallel limit for the output
 	OutputParallelLimit string
 	// Parameter for output - depends on OutputType
 	OutputParams string
 
-	// Number of regular log events that can be buffered, =0 to disable
+	// Number of regular log events that can be buffered, =0 to disable. For file output =0 means default size, <0 disables
 	BufferSize int
 	// Number of low-latency log events that can be buffered, =-1 to disable, =0 - default size
 	LLBufferSize int
@@ -9,11 +9,17 @@ package configuration
 type LogicRunner struct {
 	// PulseLRUSize - configuration of size of a pulse's cache
 	PulseLRUSize int
+	// ValidateRequests - enables sending of executed requests to validators of the object
+	ValidateRequests bool
+	// ValidationJournalSize - count of the latest validation results, that are kept for export
+	ValidationJournalSize int
 }
 
 // NewLogicRunner - returns default config of the logic runner
 func NewLogicRunner() LogicRunner {
 	return LogicRunner{
-		PulseLRUSize: 100,
+		PulseLRUSize:          100,
+		ValidateRequests:      false,
+		ValidationJournalSize: 1000,
 	}
 }
@@ -13,6 +13,8 @@ type Pulsar struct {
 
 	DistributionTransport Transport
 	PulseDistributor      PulseDistributor
+
+	MultiPulsar MultiPulsar
 }
 
 type PulseDistributor struct {
@@ -20,6 +22,21 @@ type PulseDistributor struct {
 	PulseRequestTimeout int32 // ms
 }
 
+// MultiPulsar holds configuration for entropy generation by several pulsars.
+// Pulsars run a commit-reveal round for every pulse, combine their entropy and co-sign the pulse.
+type MultiPulsar struct {
+	// Enabled turns on commit-reveal rounds with Peers.
+	Enabled bool
+	// Initiator starts rounds and distributes pulses, other pulsars only take part in rounds.
+	Initiator bool
+	// Transport is used to exchange round messages with Peers.
+	Transport Transport
+	// Peers are all other pulsars taking part in rounds.
+	Peers []PulsarNodeAddress
+	// RoundTimeout limits duration of a round.
+	RoundTimeout int32 // ms
+}
+
 type PulsarNodeAddress struct {
 	Address   string
 	PublicKey string
@@ -39,5 +56,14 @@ func NewPulsar() Pulsar {
 			BootstrapHosts:      []string{"localhost:53837"},
 			PulseRequestTimeout: 1000,
 		},
+		MultiPulsar: MultiPulsar{
+			Enabled:   false,
+			Initiator: true,
+			Transport: Transport{
+				Protocol: "TCP",
+				Address:  "0.0.0.0:18092",
+			},
+			RoundTimeout: 2000,
+		},
 	}
 }
@@ -0,0 +1,35 @@
+// Copyright 2020 Insolar Network Ltd.
+// All rights reserved.
+// This material is licensed under the Insolar License version 1.0,
+// available at https://github.com/insolar/insolar/blob/master/LICENSE.md.
+
+package configuration
+
+import (
+	"time"
+)
+
+// Replica holds configuration of read-only heavy replica.
+// Replica follows finalized pulses of the primary heavy and serves exporter API from its own database.
+type Replica struct {
+	// Enabled starts heavy as replica. Replica doesn't join the network.
+	Enabled bool
+	// PrimaryAddr specifies address of the primary heavy exporter.
+	PrimaryAddr string
+	// Token is a JWT for the primary heavy exporter, if it requires authorization.
+	Token string
+	// PollInterval specifies delay between replication requests, when replica has reached the primary.
+	PollInterval time.Duration
+	// MaxRecvMsgSize specifies max size of replicated pulse message in bytes, that replica accepts.
+	// It should be greater than exporter.replicationchunksize of the primary and the biggest record.
+	MaxRecvMsgSize int
+}
+
+// NewReplica creates new default configuration for heavy replica.
+func NewReplica() Replica {
+	return Replica{
+		Enabled:        false,
+		PollInterval:   time.Second,
+		MaxRecvMsgSize: 16 << 20,
+	}
+}
@@ -53,11 +53,18 @@ type ContractRequester struct {
 	ResultMutex sync.Mutex
 	ResultMap   map[[insolar.RecordHashSize]byte]chan *payload.ReturnResults
 
+	observersMutex  sync.RWMutex
+	resultObservers []ResultObserver
+
 	// callTimeout is mainly needed for unit tests which
 	// sometimes may unpredictably fail on CI with a default timeout
 	callTimeout time.Duration
 }
 
+// ResultObserver is notified about results of all requests received by ContractRequester.
+// It must not block.
+type ResultObserver func(ctx context.Context, msg *payload.ReturnResults)
+
 // New creates new ContractRequester
 func New(
 	sender bus.Sender,
@@ -343,7 +350,27 @@ func (cr *ContractRequester) handleRegisterResult(ctx context.Context, r *reply.
 	}
 }
 
+// AddResultObserver adds observers, that are notified about results of requests,
+// both awaited by the callers and unwanted ones.
+func (cr *ContractRequester) AddResultObserver(o ...ResultObserver) {
+	cr.observersMutex.Lock()
+	defer cr.observersMutex.Unlock()
+
+	cr.resultObservers = append(cr.resultObservers, o...)
+}
+
+func (cr *ContractRequester) notifyResultObservers(ctx context.Context, msg *payload.ReturnResults) {
+	cr.observersMutex.RLock()
+	defer cr.observersMutex.RUnlock()
+
+	for _, o := range cr.resultObservers {
+		o(ctx, msg)
+	}
+}
+
 func (cr *ContractRequester) result(ctx context.Context, msg *payload.ReturnResults) error {
+	cr.notifyResultObservers(ctx, msg)
+
 	cr.ResultMutex.Lock()
 	defer cr.ResultMutex.Unlock()
 
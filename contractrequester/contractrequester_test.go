@@ -261,11 +261,18 @@ func TestReceiveResult_UnwantedResult(t *testing.T) {
 			require.Equal(t, &reply.OK{}, replyData)
 		})
 
+	// observers are notified about unexpected results too
+	var observed *payload.ReturnResults
+	cReq.AddResultObserver(func(_ context.Context, msg *payload.ReturnResults) {
+		observed = msg
+	})
+
 	// unexpected result
 	res, err := serializeReply(msg)
 	require.NoError(t, err)
 	err = cReq.ReceiveResult(ctx, res)
 	require.NoError(t, err)
+	require.Equal(t, msgPayload, observed)
 }
 
 func TestReceiveResult_WantedResult(t *testing.T) {
@@ -45,6 +45,19 @@ func (cs *NodeCryptographyService) Sign(payload []byte) (*insolar.Signature, err
 	return signature, nil
 }
 
+// CryptoSigner returns signer with the node key for standard library, e.g. for TLS handshakes.
+func (cs *NodeCryptographyService) CryptoSigner() (crypto.Signer, error) {
+	privateKey, err := cs.KeyStore.GetPrivateKey("")
+	if err != nil {
+		return nil, errors.Wrap(err, "[ CryptoSigner ] Failed to get private privateKey")
+	}
+	signer, ok := privateKey.(crypto.Signer)
+	if !ok {
+		return nil, errors.New("[ CryptoSigner ] private key can't be used as crypto.Signer")
+	}
+	return signer, nil
+}
+
 func (cs *NodeCryptographyService) Verify(publicKey crypto.PublicKey, signature insolar.Signature, payload []byte) bool {
 	return cs.PlatformCryptographyScheme.DataVerifier(publicKey, cs.PlatformCryptographyScheme.IntegrityHasher()).Verify(signature, payload)
 }
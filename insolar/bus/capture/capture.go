@@ -0,0 +1,165 @@
+// Copyright 2020 Insolar Network Ltd.
+// All rights reserved.
+// This material is licensed under the Insolar License version 1.0,
+// available at https://github.com/insolar/insolar/blob/master/LICENSE.md.
+
+// Package capture records bus traffic of the node to a file and replays it into flow dispatcher.
+//
+// Capture file contains a JSON object per line, one for every message published to incoming
+// or outgoing bus topics, with marshaled payload.Meta, its pulse and the time of publishing.
+package capture
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/bus"
+	"github.com/insolar/insolar/insolar/bus/meta"
+	"github.com/insolar/insolar/insolar/payload"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+)
+
+const (
+	// DirectionIncoming is a direction of messages received by the node.
+	DirectionIncoming = "incoming"
+	// DirectionOutgoing is a direction of messages sent by the node.
+	DirectionOutgoing = "outgoing"
+)
+
+// Record is a captured bus message.
+type Record struct {
+	Time      time.Time           `json:"time"`
+	Direction string              `json:"direction"`
+	Topic     string              `json:"topic"`
+	UUID      string              `json:"uuid"`
+	Pulse     insolar.PulseNumber `json:"pulse"`
+	Type      string              `json:"type"`
+	Metadata  map[string]string   `json:"metadata,omitempty"`
+	// Meta is marshaled payload.Meta of the message.
+	Meta []byte `json:"meta"`
+}
+
+func newRecord(topic string, msg *message.Message) Record {
+	rec := Record{
+		Time:      time.Now(),
+		Direction: DirectionIncoming,
+		Topic:     topic,
+		UUID:      msg.UUID,
+		Type:      "unknown",
+		Metadata:  msg.Metadata,
+		Meta:      msg.Payload,
+	}
+	if topic == bus.TopicOutgoing {
+		rec.Direction = DirectionOutgoing
+	}
+
+	pl := payload.Meta{}
+	err := pl.Unmarshal(msg.Payload)
+	if err != nil {
+		return rec
+	}
+	rec.Pulse = pl.Pulse
+	if typ, err := payload.UnmarshalType(pl.Payload); err == nil {
+		rec.Type = typ.String()
+	}
+	return rec
+}
+
+// DecodeMeta unmarshals captured payload.Meta.
+func (r Record) DecodeMeta() (payload.Meta, error) {
+	pl := payload.Meta{}
+	err := pl.Unmarshal(r.Meta)
+	if err != nil {
+		return payload.Meta{}, errors.Wrap(err, "failed to unmarshal meta")
+	}
+	return pl, nil
+}
+
+// Message restores captured message, as it is passed to flow dispatcher.
+func (r Record) Message() *message.Message {
+	msg := message.NewMessage(r.UUID, r.Meta)
+	for k, v := range r.Metadata {
+		msg.Metadata.Set(k, v)
+	}
+	// Pulse is set by bus.IncomingMessageRouter after capture.
+	msg.Metadata.Set(meta.Pulse, r.Pulse.String())
+	return msg
+}
+
+// Publisher is a message.Publisher, that writes every published message to capture file.
+type Publisher struct {
+	pub message.Publisher
+
+	lock    sync.Mutex
+	file    *os.File
+	encoder *json.Encoder
+}
+
+// NewPublisher creates capture publisher, records are appended to the file.
+func NewPublisher(path string, pub message.Publisher) (*Publisher, error) {
+	file, err := os.OpenFile(filepath.Clean(path), os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open capture file")
+	}
+	return &Publisher{
+		pub:     pub,
+		file:    file,
+		encoder: json.NewEncoder(file),
+	}, nil
+}
+
+// Publish captures messages and publishes them. Capture errors don't prevent publishing.
+func (p *Publisher) Publish(topic string, messages ...*message.Message) error {
+	p.lock.Lock()
+	for _, msg := range messages {
+		err := p.encoder.Encode(newRecord(topic, msg))
+		if err != nil {
+			inslogger.FromContext(msg.Context()).Error(errors.Wrap(err, "failed to capture message"))
+		}
+	}
+	p.lock.Unlock()
+
+	return p.pub.Publish(topic, messages...)
+}
+
+// Close closes capture file and wrapped publisher.
+func (p *Publisher) Close() error {
+	p.lock.Lock()
+	err := p.file.Close()
+	p.lock.Unlock()
+	if err != nil {
+		return errors.Wrap(err, "failed to close capture file")
+	}
+	return p.pub.Close()
+}
+
+// Read reads all records from capture file.
+func Read(path string) ([]Record, error) {
+	file, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open capture file")
+	}
+	defer file.Close()
+
+	var records []Record
+	decoder := json.NewDecoder(file)
+	for {
+		var rec Record
+		err := decoder.Decode(&rec)
+		if err == io.EOF {
+			return records, nil
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to decode record %d", len(records))
+		}
+		records = append(records, rec)
+	}
+}
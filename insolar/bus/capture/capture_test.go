@@ -0,0 +1,169 @@
+// Copyright 2020 Insolar Network Ltd.
+// All rights reserved.
+// This material is licensed under the Insolar License version 1.0,
+// available at https://github.com/insolar/insolar/blob/master/LICENSE.md.
+
+package capture
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ThreeDotsLabs/watermill"
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/ThreeDotsLabs/watermill/pubsub/gochannel"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/bus"
+	"github.com/insolar/insolar/insolar/bus/meta"
+	"github.com/insolar/insolar/insolar/flow/dispatcher"
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/insolar/payload"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+)
+
+func metaMessage(t *testing.T, pn insolar.PulseNumber, origin payload.MessageHash, pl payload.Payload) *message.Message {
+	msg, err := payload.NewMessage(pl)
+	require.NoError(t, err)
+	m := payload.Meta{
+		Polymorph:  uint32(payload.TypeMeta),
+		Payload:    msg.Payload,
+		Pulse:      pn,
+		OriginHash: origin,
+		ID:         []byte(msg.UUID),
+	}
+	buf, err := m.Marshal()
+	require.NoError(t, err)
+	msg.Payload = buf
+	return msg
+}
+
+func hashOf(t *testing.T, msg *message.Message) payload.MessageHash {
+	m := payload.Meta{}
+	require.NoError(t, m.Unmarshal(msg.Payload))
+	h := payload.MessageHash{}
+	require.NoError(t, h.Unmarshal(m.ID))
+	return h
+}
+
+func TestPublisher(t *testing.T) {
+	dir, err := ioutil.TempDir("", "bus-capture-")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "capture.jsonl")
+	inner := gochannel.NewGoChannel(gochannel.Config{}, watermill.NopLogger{})
+	pub, err := NewPublisher(path, inner)
+	require.NoError(t, err)
+
+	pn := gen.PulseNumber()
+	in := metaMessage(t, pn, payload.MessageHash{}, &payload.GetObject{ObjectID: gen.ID()})
+	in.Metadata.Set(meta.Type, "custom")
+	out := metaMessage(t, pn, payload.MessageHash{}, &payload.GetCode{CodeID: gen.ID()})
+	require.NoError(t, pub.Publish(bus.TopicIncoming, in))
+	require.NoError(t, pub.Publish(bus.TopicOutgoing, out))
+	require.NoError(t, pub.Close())
+
+	records, err := Read(path)
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+
+	assert.Equal(t, DirectionIncoming, records[0].Direction)
+	assert.Equal(t, bus.TopicIncoming, records[0].Topic)
+	assert.Equal(t, in.UUID, records[0].UUID)
+	assert.Equal(t, pn, records[0].Pulse)
+	assert.Equal(t, payload.TypeGetObject.String(), records[0].Type)
+	assert.Equal(t, []byte(in.Payload), records[0].Meta)
+
+	restored := records[0].Message()
+	assert.Equal(t, in.Payload, restored.Payload)
+	assert.Equal(t, "custom", restored.Metadata.Get(meta.Type))
+	assert.Equal(t, pn.String(), restored.Metadata.Get(meta.Pulse))
+
+	assert.Equal(t, DirectionOutgoing, records[1].Direction)
+	assert.Equal(t, payload.TypeGetCode.String(), records[1].Type)
+}
+
+func TestReplayer(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	mc := minimock.NewController(t)
+
+	first := gen.PulseNumber()
+	second := first + 10
+
+	// node receives request, asks for code and gets reply, then receives request in the next pulse
+	request := metaMessage(t, first, payload.MessageHash{}, &payload.GetObject{ObjectID: gen.ID()})
+	getCode := metaMessage(t, first, payload.MessageHash{}, &payload.GetCode{CodeID: gen.ID()})
+	code := metaMessage(t, first, hashOf(t, getCode), &payload.Code{Record: []byte{1}})
+	next := metaMessage(t, second, payload.MessageHash{}, &payload.GetObject{ObjectID: gen.ID()})
+
+	var records []Record
+	for _, c := range []struct {
+		topic string
+		msg   *message.Message
+	}{
+		{bus.TopicIncoming, request},
+		{bus.TopicOutgoing, getCode},
+		{bus.TopicIncoming, code},
+		{bus.TopicIncoming, next},
+	} {
+		records = append(records, newRecord(c.topic, c.msg))
+	}
+
+	r, err := NewReplayer(records)
+	require.NoError(t, err)
+
+	var (
+		pulses    []insolar.PulseNumber
+		processed []string
+	)
+	d := dispatcher.NewDispatcherMock(mc).
+		BeginPulseMock.Set(func(_ context.Context, p insolar.Pulse) {
+		pulses = append(pulses, p.PulseNumber)
+	}).
+		ClosePulseMock.Set(func(_ context.Context, p insolar.Pulse) {
+		require.Equal(t, first, p.PulseNumber)
+	}).
+		ProcessMock.Set(func(msg *message.Message) error {
+		processed = append(processed, msg.UUID)
+		if msg.UUID != request.UUID {
+			return nil
+		}
+		sent, err := payload.NewMessage(&payload.GetCode{CodeID: gen.ID()})
+		require.NoError(t, err)
+		replies, done := r.SendRole(ctx, sent, insolar.DynamicRoleLightExecutor, gen.Reference())
+		defer done()
+		reply, ok := <-replies
+		require.True(t, ok)
+		require.Equal(t, code.UUID, reply.UUID)
+		_, ok = <-replies
+		require.False(t, ok)
+		return nil
+	})
+
+	var changed []insolar.PulseNumber
+	err = r.Replay(ctx, d, func(_ context.Context, pn insolar.PulseNumber) {
+		changed = append(changed, pn)
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []insolar.PulseNumber{first, second}, pulses)
+	assert.Equal(t, []insolar.PulseNumber{first, second}, changed)
+	assert.Equal(t, []string{request.UUID, next.UUID}, processed)
+	assert.Len(t, r.Sent(), 1)
+
+	// captured message is matched only once
+	sent, err := payload.NewMessage(&payload.GetCode{CodeID: gen.ID()})
+	require.NoError(t, err)
+	replies, _ := r.SendTarget(ctx, sent, gen.Reference())
+	_, ok := <-replies
+	assert.False(t, ok)
+
+	mc.Finish()
+}
@@ -0,0 +1,173 @@
+// Copyright 2020 Insolar Network Ltd.
+// All rights reserved.
+// This material is licensed under the Insolar License version 1.0,
+// available at https://github.com/insolar/insolar/blob/master/LICENSE.md.
+
+package capture
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/flow/dispatcher"
+	"github.com/insolar/insolar/insolar/payload"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+)
+
+type captured struct {
+	record Record
+	meta   payload.Meta
+	typ    payload.Type
+	hash   payload.MessageHash
+}
+
+// Replayer is a bus.Sender, that answers sent messages with replies from capture file instead of network.
+//
+// Sent message is matched with the first not yet matched captured outgoing message of the same payload type,
+// replies to the captured message are returned as replies to sent one.
+type Replayer struct {
+	records []captured
+
+	lock    sync.Mutex
+	used    map[int]bool
+	sent    []*message.Message
+	replies []*message.Message
+}
+
+// NewReplayer creates replayer for records of capture file.
+func NewReplayer(records []Record) (*Replayer, error) {
+	r := &Replayer{
+		used: map[int]bool{},
+	}
+	for i, rec := range records {
+		meta, err := rec.DecodeMeta()
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to decode record %d", i)
+		}
+		c := captured{record: rec, meta: meta}
+		c.typ, err = payload.UnmarshalType(meta.Payload)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to decode payload type of record %d", i)
+		}
+		err = c.hash.Unmarshal(meta.ID)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to decode message hash of record %d", i)
+		}
+		r.records = append(r.records, c)
+	}
+	return r, nil
+}
+
+// Replay passes captured incoming messages to dispatcher in order of capture. Pulse is changed in
+// dispatcher before the first message of the next pulse, changePulse is called for node components
+// to follow the pulse. Replies are not passed to dispatcher, they are returned by Replayer sends.
+func (r *Replayer) Replay(
+	ctx context.Context, d dispatcher.Dispatcher, changePulse func(ctx context.Context, pn insolar.PulseNumber),
+) error {
+	logger := inslogger.FromContext(ctx)
+
+	var current insolar.PulseNumber
+	for i, c := range r.records {
+		if c.record.Direction != DirectionIncoming {
+			continue
+		}
+		if c.record.Pulse > current {
+			if current != 0 {
+				d.ClosePulse(ctx, insolar.Pulse{PulseNumber: current})
+			}
+			current = c.record.Pulse
+			if changePulse != nil {
+				changePulse(ctx, current)
+			}
+			d.BeginPulse(ctx, insolar.Pulse{PulseNumber: current})
+		}
+		if !c.meta.OriginHash.IsZero() {
+			continue
+		}
+
+		logger.Debugf("replaying record %d of type %s", i, c.typ.String())
+		err := d.Process(c.record.Message())
+		if err != nil {
+			return errors.Wrapf(err, "failed to process record %d", i)
+		}
+	}
+	return nil
+}
+
+// SendRole returns captured replies for matched message.
+func (r *Replayer) SendRole(
+	ctx context.Context, msg *message.Message, _ insolar.DynamicRole, _ insolar.Reference,
+) (<-chan *message.Message, func()) {
+	return r.send(ctx, msg)
+}
+
+// SendTarget returns captured replies for matched message.
+func (r *Replayer) SendTarget(
+	ctx context.Context, msg *message.Message, _ insolar.Reference,
+) (<-chan *message.Message, func()) {
+	return r.send(ctx, msg)
+}
+
+// Reply records reply of the node, captured replies aren't compared with it.
+func (r *Replayer) Reply(_ context.Context, _ payload.Meta, reply *message.Message) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.replies = append(r.replies, reply)
+}
+
+func (r *Replayer) send(ctx context.Context, msg *message.Message) (<-chan *message.Message, func()) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.sent = append(r.sent, msg)
+
+	typ, err := payload.UnmarshalType(msg.Payload)
+	if err != nil {
+		inslogger.FromContext(ctx).Error(errors.Wrap(err, "failed to decode type of sent message"))
+	}
+
+	var replies []*message.Message
+	for i, c := range r.records {
+		if r.used[i] || c.record.Direction != DirectionOutgoing || !c.meta.OriginHash.IsZero() || c.typ != typ {
+			continue
+		}
+		r.used[i] = true
+		for _, reply := range r.records {
+			if reply.record.Direction == DirectionIncoming && reply.meta.OriginHash == c.hash {
+				replies = append(replies, reply.record.Message())
+			}
+		}
+		break
+	}
+	if replies == nil {
+		inslogger.FromContext(ctx).Warnf("no captured replies for sent message of type %s", typ.String())
+	}
+
+	ch := make(chan *message.Message, len(replies))
+	for _, reply := range replies {
+		ch <- reply
+	}
+	close(ch)
+	return ch, func() {}
+}
+
+// Sent returns messages sent by the node during replay.
+func (r *Replayer) Sent() []*message.Message {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	return append([]*message.Message(nil), r.sent...)
+}
+
+// Replies returns replies of the node during replay.
+func (r *Replayer) Replies() []*message.Message {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	return append([]*message.Message(nil), r.replies...)
+}
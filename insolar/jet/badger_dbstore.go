@@ -99,6 +99,23 @@ func (s *BadgerDBStore) Split(ctx context.Context, pulse insolar.PulseNumber, id
 	}
 	return left, right, nil
 }
+
+func (s *BadgerDBStore) Merge(ctx context.Context, pulse insolar.PulseNumber, id insolar.JetID) (insolar.JetID, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	tree := s.get(pulse)
+	parent, err := tree.Merge(id)
+	if err != nil {
+		return insolar.ZeroJetID, err
+	}
+	err = s.set(pulse, tree)
+	if err != nil {
+		return insolar.ZeroJetID, err
+	}
+	return parent, nil
+}
+
 func (s *BadgerDBStore) Clone(ctx context.Context, from, to insolar.PulseNumber, keepActual bool) error {
 	s.Lock()
 	defer s.Unlock()
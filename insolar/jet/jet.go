@@ -33,6 +33,8 @@ type Modifier interface {
 	Update(ctx context.Context, pulse insolar.PulseNumber, actual bool, ids ...insolar.JetID) error
 	// Split performs jet split and returns resulting jet ids. Always set Active flag to true for leafs.
 	Split(ctx context.Context, pulse insolar.PulseNumber, id insolar.JetID) (insolar.JetID, insolar.JetID, error)
+	// Merge performs merge of jet and its sibling and returns resulting parent jet id. Always set Active flag to true for it.
+	Merge(ctx context.Context, pulse insolar.PulseNumber, id insolar.JetID) (insolar.JetID, error)
 	// Clone copies tree from one pulse to another. Use it to copy the past tree into new pulse.
 	Clone(ctx context.Context, from, to insolar.PulseNumber, keepActual bool) error
 }
@@ -22,6 +22,12 @@ type ModifierMock struct {
 	beforeCloneCounter uint64
 	CloneMock          mModifierMockClone
 
+	funcMerge          func(ctx context.Context, pulse insolar.PulseNumber, id insolar.JetID) (j1 insolar.JetID, err error)
+	inspectFuncMerge   func(ctx context.Context, pulse insolar.PulseNumber, id insolar.JetID)
+	afterMergeCounter  uint64
+	beforeMergeCounter uint64
+	MergeMock          mModifierMockMerge
+
 	funcSplit          func(ctx context.Context, pulse insolar.PulseNumber, id insolar.JetID) (j1 insolar.JetID, j2 insolar.JetID, err error)
 	inspectFuncSplit   func(ctx context.Context, pulse insolar.PulseNumber, id insolar.JetID)
 	afterSplitCounter  uint64
@@ -45,6 +51,9 @@ func NewModifierMock(t minimock.Tester) *ModifierMock {
 	m.CloneMock = mModifierMockClone{mock: m}
 	m.CloneMock.callArgs = []*ModifierMockCloneParams{}
 
+	m.MergeMock = mModifierMockMerge{mock: m}
+	m.MergeMock.callArgs = []*ModifierMockMergeParams{}
+
 	m.SplitMock = mModifierMockSplit{mock: m}
 	m.SplitMock.callArgs = []*ModifierMockSplitParams{}
 
@@ -128,7 +137,7 @@ func (mmClone *mModifierMockClone) Return(err error) *ModifierMock {
 	return mmClone.mock
 }
 
-//Set uses given function f to mock the Modifier.Clone method
+// Set uses given function f to mock the Modifier.Clone method
 func (mmClone *mModifierMockClone) Set(f func(ctx context.Context, from insolar.PulseNumber, to insolar.PulseNumber, keepActual bool) (err error)) *ModifierMock {
 	if mmClone.defaultExpectation != nil {
 		mmClone.mock.t.Fatalf("Default expectation is already set for the Modifier.Clone method")
@@ -272,6 +281,224 @@ func (m *ModifierMock) MinimockCloneInspect() {
 	}
 }
 
+type mModifierMockMerge struct {
+	mock               *ModifierMock
+	defaultExpectation *ModifierMockMergeExpectation
+	expectations       []*ModifierMockMergeExpectation
+
+	callArgs []*ModifierMockMergeParams
+	mutex    sync.RWMutex
+}
+
+// ModifierMockMergeExpectation specifies expectation struct of the Modifier.Merge
+type ModifierMockMergeExpectation struct {
+	mock    *ModifierMock
+	params  *ModifierMockMergeParams
+	results *ModifierMockMergeResults
+	Counter uint64
+}
+
+// ModifierMockMergeParams contains parameters of the Modifier.Merge
+type ModifierMockMergeParams struct {
+	ctx   context.Context
+	pulse insolar.PulseNumber
+	id    insolar.JetID
+}
+
+// ModifierMockMergeResults contains results of the Modifier.Merge
+type ModifierMockMergeResults struct {
+	j1  insolar.JetID
+	err error
+}
+
+// Expect sets up expected params for Modifier.Merge
+func (mmMerge *mModifierMockMerge) Expect(ctx context.Context, pulse insolar.PulseNumber, id insolar.JetID) *mModifierMockMerge {
+	if mmMerge.mock.funcMerge != nil {
+		mmMerge.mock.t.Fatalf("ModifierMock.Merge mock is already set by Set")
+	}
+
+	if mmMerge.defaultExpectation == nil {
+		mmMerge.defaultExpectation = &ModifierMockMergeExpectation{}
+	}
+
+	mmMerge.defaultExpectation.params = &ModifierMockMergeParams{ctx, pulse, id}
+	for _, e := range mmMerge.expectations {
+		if minimock.Equal(e.params, mmMerge.defaultExpectation.params) {
+			mmMerge.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmMerge.defaultExpectation.params)
+		}
+	}
+
+	return mmMerge
+}
+
+// Inspect accepts an inspector function that has same arguments as the Modifier.Merge
+func (mmMerge *mModifierMockMerge) Inspect(f func(ctx context.Context, pulse insolar.PulseNumber, id insolar.JetID)) *mModifierMockMerge {
+	if mmMerge.mock.inspectFuncMerge != nil {
+		mmMerge.mock.t.Fatalf("Inspect function is already set for ModifierMock.Merge")
+	}
+
+	mmMerge.mock.inspectFuncMerge = f
+
+	return mmMerge
+}
+
+// Return sets up results that will be returned by Modifier.Merge
+func (mmMerge *mModifierMockMerge) Return(j1 insolar.JetID, err error) *ModifierMock {
+	if mmMerge.mock.funcMerge != nil {
+		mmMerge.mock.t.Fatalf("ModifierMock.Merge mock is already set by Set")
+	}
+
+	if mmMerge.defaultExpectation == nil {
+		mmMerge.defaultExpectation = &ModifierMockMergeExpectation{mock: mmMerge.mock}
+	}
+	mmMerge.defaultExpectation.results = &ModifierMockMergeResults{j1, err}
+	return mmMerge.mock
+}
+
+// Set uses given function f to mock the Modifier.Merge method
+func (mmMerge *mModifierMockMerge) Set(f func(ctx context.Context, pulse insolar.PulseNumber, id insolar.JetID) (j1 insolar.JetID, err error)) *ModifierMock {
+	if mmMerge.defaultExpectation != nil {
+		mmMerge.mock.t.Fatalf("Default expectation is already set for the Modifier.Merge method")
+	}
+
+	if len(mmMerge.expectations) > 0 {
+		mmMerge.mock.t.Fatalf("Some expectations are already set for the Modifier.Merge method")
+	}
+
+	mmMerge.mock.funcMerge = f
+	return mmMerge.mock
+}
+
+// When sets expectation for the Modifier.Merge which will trigger the result defined by the following
+// Then helper
+func (mmMerge *mModifierMockMerge) When(ctx context.Context, pulse insolar.PulseNumber, id insolar.JetID) *ModifierMockMergeExpectation {
+	if mmMerge.mock.funcMerge != nil {
+		mmMerge.mock.t.Fatalf("ModifierMock.Merge mock is already set by Set")
+	}
+
+	expectation := &ModifierMockMergeExpectation{
+		mock:   mmMerge.mock,
+		params: &ModifierMockMergeParams{ctx, pulse, id},
+	}
+	mmMerge.expectations = append(mmMerge.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Modifier.Merge return parameters for the expectation previously defined by the When method
+func (e *ModifierMockMergeExpectation) Then(j1 insolar.JetID, err error) *ModifierMock {
+	e.results = &ModifierMockMergeResults{j1, err}
+	return e.mock
+}
+
+// Merge implements Modifier
+func (mmMerge *ModifierMock) Merge(ctx context.Context, pulse insolar.PulseNumber, id insolar.JetID) (j1 insolar.JetID, err error) {
+	mm_atomic.AddUint64(&mmMerge.beforeMergeCounter, 1)
+	defer mm_atomic.AddUint64(&mmMerge.afterMergeCounter, 1)
+
+	if mmMerge.inspectFuncMerge != nil {
+		mmMerge.inspectFuncMerge(ctx, pulse, id)
+	}
+
+	mm_params := &ModifierMockMergeParams{ctx, pulse, id}
+
+	// Record call args
+	mmMerge.MergeMock.mutex.Lock()
+	mmMerge.MergeMock.callArgs = append(mmMerge.MergeMock.callArgs, mm_params)
+	mmMerge.MergeMock.mutex.Unlock()
+
+	for _, e := range mmMerge.MergeMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.j1, e.results.err
+		}
+	}
+
+	if mmMerge.MergeMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmMerge.MergeMock.defaultExpectation.Counter, 1)
+		mm_want := mmMerge.MergeMock.defaultExpectation.params
+		mm_got := ModifierMockMergeParams{ctx, pulse, id}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmMerge.t.Errorf("ModifierMock.Merge got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmMerge.MergeMock.defaultExpectation.results
+		if mm_results == nil {
+			mmMerge.t.Fatal("No results are set for the ModifierMock.Merge")
+		}
+		return (*mm_results).j1, (*mm_results).err
+	}
+	if mmMerge.funcMerge != nil {
+		return mmMerge.funcMerge(ctx, pulse, id)
+	}
+	mmMerge.t.Fatalf("Unexpected call to ModifierMock.Merge. %v %v %v", ctx, pulse, id)
+	return
+}
+
+// MergeAfterCounter returns a count of finished ModifierMock.Merge invocations
+func (mmMerge *ModifierMock) MergeAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMerge.afterMergeCounter)
+}
+
+// MergeBeforeCounter returns a count of ModifierMock.Merge invocations
+func (mmMerge *ModifierMock) MergeBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMerge.beforeMergeCounter)
+}
+
+// Calls returns a list of arguments used in each call to ModifierMock.Merge.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmMerge *mModifierMockMerge) Calls() []*ModifierMockMergeParams {
+	mmMerge.mutex.RLock()
+
+	argCopy := make([]*ModifierMockMergeParams, len(mmMerge.callArgs))
+	copy(argCopy, mmMerge.callArgs)
+
+	mmMerge.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockMergeDone returns true if the count of the Merge invocations corresponds
+// the number of defined expectations
+func (m *ModifierMock) MinimockMergeDone() bool {
+	for _, e := range m.MergeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.MergeMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterMergeCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcMerge != nil && mm_atomic.LoadUint64(&m.afterMergeCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockMergeInspect logs each unmet expectation
+func (m *ModifierMock) MinimockMergeInspect() {
+	for _, e := range m.MergeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ModifierMock.Merge with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.MergeMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterMergeCounter) < 1 {
+		if m.MergeMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ModifierMock.Merge")
+		} else {
+			m.t.Errorf("Expected call to ModifierMock.Merge with params: %#v", *m.MergeMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcMerge != nil && mm_atomic.LoadUint64(&m.afterMergeCounter) < 1 {
+		m.t.Error("Expected call to ModifierMock.Merge")
+	}
+}
+
 type mModifierMockSplit struct {
 	mock               *ModifierMock
 	defaultExpectation *ModifierMockSplitExpectation
@@ -347,7 +574,7 @@ func (mmSplit *mModifierMockSplit) Return(j1 insolar.JetID, j2 insolar.JetID, er
 	return mmSplit.mock
 }
 
-//Set uses given function f to mock the Modifier.Split method
+// Set uses given function f to mock the Modifier.Split method
 func (mmSplit *mModifierMockSplit) Set(f func(ctx context.Context, pulse insolar.PulseNumber, id insolar.JetID) (j1 insolar.JetID, j2 insolar.JetID, err error)) *ModifierMock {
 	if mmSplit.defaultExpectation != nil {
 		mmSplit.mock.t.Fatalf("Default expectation is already set for the Modifier.Split method")
@@ -565,7 +792,7 @@ func (mmUpdate *mModifierMockUpdate) Return(err error) *ModifierMock {
 	return mmUpdate.mock
 }
 
-//Set uses given function f to mock the Modifier.Update method
+// Set uses given function f to mock the Modifier.Update method
 func (mmUpdate *mModifierMockUpdate) Set(f func(ctx context.Context, pulse insolar.PulseNumber, actual bool, ids ...insolar.JetID) (err error)) *ModifierMock {
 	if mmUpdate.defaultExpectation != nil {
 		mmUpdate.mock.t.Fatalf("Default expectation is already set for the Modifier.Update method")
@@ -714,6 +941,8 @@ func (m *ModifierMock) MinimockFinish() {
 	if !m.minimockDone() {
 		m.MinimockCloneInspect()
 
+		m.MinimockMergeInspect()
+
 		m.MinimockSplitInspect()
 
 		m.MinimockUpdateInspect()
@@ -741,6 +970,7 @@ func (m *ModifierMock) minimockDone() bool {
 	done := true
 	return done &&
 		m.MinimockCloneDone() &&
+		m.MinimockMergeDone() &&
 		m.MinimockSplitDone() &&
 		m.MinimockUpdateDone()
 }
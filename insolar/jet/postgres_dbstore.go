@@ -74,6 +74,22 @@ func (s *PostgresDBStore) Split(ctx context.Context, pulse insolar.PulseNumber,
 	return left, right, nil
 }
 
+func (s *PostgresDBStore) Merge(ctx context.Context, pulse insolar.PulseNumber, id insolar.JetID) (insolar.JetID, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	tree := s.get(pulse)
+	parent, err := tree.Merge(id)
+	if err != nil {
+		return insolar.ZeroJetID, err
+	}
+	err = s.set(pulse, tree)
+	if err != nil {
+		return insolar.ZeroJetID, err
+	}
+	return parent, nil
+}
+
 func (s *PostgresDBStore) Clone(ctx context.Context, from, to insolar.PulseNumber, keepActual bool) error {
 	s.Lock()
 	defer s.Unlock()
@@ -34,6 +34,12 @@ type StorageMock struct {
 	beforeForIDCounter uint64
 	ForIDMock          mStorageMockForID
 
+	funcMerge          func(ctx context.Context, pulse insolar.PulseNumber, id insolar.JetID) (j1 insolar.JetID, err error)
+	inspectFuncMerge   func(ctx context.Context, pulse insolar.PulseNumber, id insolar.JetID)
+	afterMergeCounter  uint64
+	beforeMergeCounter uint64
+	MergeMock          mStorageMockMerge
+
 	funcSplit          func(ctx context.Context, pulse insolar.PulseNumber, id insolar.JetID) (j1 insolar.JetID, j2 insolar.JetID, err error)
 	inspectFuncSplit   func(ctx context.Context, pulse insolar.PulseNumber, id insolar.JetID)
 	afterSplitCounter  uint64
@@ -63,6 +69,9 @@ func NewStorageMock(t minimock.Tester) *StorageMock {
 	m.ForIDMock = mStorageMockForID{mock: m}
 	m.ForIDMock.callArgs = []*StorageMockForIDParams{}
 
+	m.MergeMock = mStorageMockMerge{mock: m}
+	m.MergeMock.callArgs = []*StorageMockMergeParams{}
+
 	m.SplitMock = mStorageMockSplit{mock: m}
 	m.SplitMock.callArgs = []*StorageMockSplitParams{}
 
@@ -144,7 +153,7 @@ func (mmAll *mStorageMockAll) Return(ja1 []insolar.JetID) *StorageMock {
 	return mmAll.mock
 }
 
-//Set uses given function f to mock the Storage.All method
+// Set uses given function f to mock the Storage.All method
 func (mmAll *mStorageMockAll) Set(f func(ctx context.Context, pulse insolar.PulseNumber) (ja1 []insolar.JetID)) *StorageMock {
 	if mmAll.defaultExpectation != nil {
 		mmAll.mock.t.Fatalf("Default expectation is already set for the Storage.All method")
@@ -362,7 +371,7 @@ func (mmClone *mStorageMockClone) Return(err error) *StorageMock {
 	return mmClone.mock
 }
 
-//Set uses given function f to mock the Storage.Clone method
+// Set uses given function f to mock the Storage.Clone method
 func (mmClone *mStorageMockClone) Set(f func(ctx context.Context, from insolar.PulseNumber, to insolar.PulseNumber, keepActual bool) (err error)) *StorageMock {
 	if mmClone.defaultExpectation != nil {
 		mmClone.mock.t.Fatalf("Default expectation is already set for the Storage.Clone method")
@@ -580,7 +589,7 @@ func (mmForID *mStorageMockForID) Return(j1 insolar.JetID, b1 bool) *StorageMock
 	return mmForID.mock
 }
 
-//Set uses given function f to mock the Storage.ForID method
+// Set uses given function f to mock the Storage.ForID method
 func (mmForID *mStorageMockForID) Set(f func(ctx context.Context, pulse insolar.PulseNumber, recordID insolar.ID) (j1 insolar.JetID, b1 bool)) *StorageMock {
 	if mmForID.defaultExpectation != nil {
 		mmForID.mock.t.Fatalf("Default expectation is already set for the Storage.ForID method")
@@ -724,6 +733,224 @@ func (m *StorageMock) MinimockForIDInspect() {
 	}
 }
 
+type mStorageMockMerge struct {
+	mock               *StorageMock
+	defaultExpectation *StorageMockMergeExpectation
+	expectations       []*StorageMockMergeExpectation
+
+	callArgs []*StorageMockMergeParams
+	mutex    sync.RWMutex
+}
+
+// StorageMockMergeExpectation specifies expectation struct of the Storage.Merge
+type StorageMockMergeExpectation struct {
+	mock    *StorageMock
+	params  *StorageMockMergeParams
+	results *StorageMockMergeResults
+	Counter uint64
+}
+
+// StorageMockMergeParams contains parameters of the Storage.Merge
+type StorageMockMergeParams struct {
+	ctx   context.Context
+	pulse insolar.PulseNumber
+	id    insolar.JetID
+}
+
+// StorageMockMergeResults contains results of the Storage.Merge
+type StorageMockMergeResults struct {
+	j1  insolar.JetID
+	err error
+}
+
+// Expect sets up expected params for Storage.Merge
+func (mmMerge *mStorageMockMerge) Expect(ctx context.Context, pulse insolar.PulseNumber, id insolar.JetID) *mStorageMockMerge {
+	if mmMerge.mock.funcMerge != nil {
+		mmMerge.mock.t.Fatalf("StorageMock.Merge mock is already set by Set")
+	}
+
+	if mmMerge.defaultExpectation == nil {
+		mmMerge.defaultExpectation = &StorageMockMergeExpectation{}
+	}
+
+	mmMerge.defaultExpectation.params = &StorageMockMergeParams{ctx, pulse, id}
+	for _, e := range mmMerge.expectations {
+		if minimock.Equal(e.params, mmMerge.defaultExpectation.params) {
+			mmMerge.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmMerge.defaultExpectation.params)
+		}
+	}
+
+	return mmMerge
+}
+
+// Inspect accepts an inspector function that has same arguments as the Storage.Merge
+func (mmMerge *mStorageMockMerge) Inspect(f func(ctx context.Context, pulse insolar.PulseNumber, id insolar.JetID)) *mStorageMockMerge {
+	if mmMerge.mock.inspectFuncMerge != nil {
+		mmMerge.mock.t.Fatalf("Inspect function is already set for StorageMock.Merge")
+	}
+
+	mmMerge.mock.inspectFuncMerge = f
+
+	return mmMerge
+}
+
+// Return sets up results that will be returned by Storage.Merge
+func (mmMerge *mStorageMockMerge) Return(j1 insolar.JetID, err error) *StorageMock {
+	if mmMerge.mock.funcMerge != nil {
+		mmMerge.mock.t.Fatalf("StorageMock.Merge mock is already set by Set")
+	}
+
+	if mmMerge.defaultExpectation == nil {
+		mmMerge.defaultExpectation = &StorageMockMergeExpectation{mock: mmMerge.mock}
+	}
+	mmMerge.defaultExpectation.results = &StorageMockMergeResults{j1, err}
+	return mmMerge.mock
+}
+
+// Set uses given function f to mock the Storage.Merge method
+func (mmMerge *mStorageMockMerge) Set(f func(ctx context.Context, pulse insolar.PulseNumber, id insolar.JetID) (j1 insolar.JetID, err error)) *StorageMock {
+	if mmMerge.defaultExpectation != nil {
+		mmMerge.mock.t.Fatalf("Default expectation is already set for the Storage.Merge method")
+	}
+
+	if len(mmMerge.expectations) > 0 {
+		mmMerge.mock.t.Fatalf("Some expectations are already set for the Storage.Merge method")
+	}
+
+	mmMerge.mock.funcMerge = f
+	return mmMerge.mock
+}
+
+// When sets expectation for the Storage.Merge which will trigger the result defined by the following
+// Then helper
+func (mmMerge *mStorageMockMerge) When(ctx context.Context, pulse insolar.PulseNumber, id insolar.JetID) *StorageMockMergeExpectation {
+	if mmMerge.mock.funcMerge != nil {
+		mmMerge.mock.t.Fatalf("StorageMock.Merge mock is already set by Set")
+	}
+
+	expectation := &StorageMockMergeExpectation{
+		mock:   mmMerge.mock,
+		params: &StorageMockMergeParams{ctx, pulse, id},
+	}
+	mmMerge.expectations = append(mmMerge.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Storage.Merge return parameters for the expectation previously defined by the When method
+func (e *StorageMockMergeExpectation) Then(j1 insolar.JetID, err error) *StorageMock {
+	e.results = &StorageMockMergeResults{j1, err}
+	return e.mock
+}
+
+// Merge implements Storage
+func (mmMerge *StorageMock) Merge(ctx context.Context, pulse insolar.PulseNumber, id insolar.JetID) (j1 insolar.JetID, err error) {
+	mm_atomic.AddUint64(&mmMerge.beforeMergeCounter, 1)
+	defer mm_atomic.AddUint64(&mmMerge.afterMergeCounter, 1)
+
+	if mmMerge.inspectFuncMerge != nil {
+		mmMerge.inspectFuncMerge(ctx, pulse, id)
+	}
+
+	mm_params := &StorageMockMergeParams{ctx, pulse, id}
+
+	// Record call args
+	mmMerge.MergeMock.mutex.Lock()
+	mmMerge.MergeMock.callArgs = append(mmMerge.MergeMock.callArgs, mm_params)
+	mmMerge.MergeMock.mutex.Unlock()
+
+	for _, e := range mmMerge.MergeMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.j1, e.results.err
+		}
+	}
+
+	if mmMerge.MergeMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmMerge.MergeMock.defaultExpectation.Counter, 1)
+		mm_want := mmMerge.MergeMock.defaultExpectation.params
+		mm_got := StorageMockMergeParams{ctx, pulse, id}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmMerge.t.Errorf("StorageMock.Merge got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmMerge.MergeMock.defaultExpectation.results
+		if mm_results == nil {
+			mmMerge.t.Fatal("No results are set for the StorageMock.Merge")
+		}
+		return (*mm_results).j1, (*mm_results).err
+	}
+	if mmMerge.funcMerge != nil {
+		return mmMerge.funcMerge(ctx, pulse, id)
+	}
+	mmMerge.t.Fatalf("Unexpected call to StorageMock.Merge. %v %v %v", ctx, pulse, id)
+	return
+}
+
+// MergeAfterCounter returns a count of finished StorageMock.Merge invocations
+func (mmMerge *StorageMock) MergeAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMerge.afterMergeCounter)
+}
+
+// MergeBeforeCounter returns a count of StorageMock.Merge invocations
+func (mmMerge *StorageMock) MergeBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMerge.beforeMergeCounter)
+}
+
+// Calls returns a list of arguments used in each call to StorageMock.Merge.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmMerge *mStorageMockMerge) Calls() []*StorageMockMergeParams {
+	mmMerge.mutex.RLock()
+
+	argCopy := make([]*StorageMockMergeParams, len(mmMerge.callArgs))
+	copy(argCopy, mmMerge.callArgs)
+
+	mmMerge.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockMergeDone returns true if the count of the Merge invocations corresponds
+// the number of defined expectations
+func (m *StorageMock) MinimockMergeDone() bool {
+	for _, e := range m.MergeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.MergeMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterMergeCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcMerge != nil && mm_atomic.LoadUint64(&m.afterMergeCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockMergeInspect logs each unmet expectation
+func (m *StorageMock) MinimockMergeInspect() {
+	for _, e := range m.MergeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StorageMock.Merge with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.MergeMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterMergeCounter) < 1 {
+		if m.MergeMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to StorageMock.Merge")
+		} else {
+			m.t.Errorf("Expected call to StorageMock.Merge with params: %#v", *m.MergeMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcMerge != nil && mm_atomic.LoadUint64(&m.afterMergeCounter) < 1 {
+		m.t.Error("Expected call to StorageMock.Merge")
+	}
+}
+
 type mStorageMockSplit struct {
 	mock               *StorageMock
 	defaultExpectation *StorageMockSplitExpectation
@@ -799,7 +1026,7 @@ func (mmSplit *mStorageMockSplit) Return(j1 insolar.JetID, j2 insolar.JetID, err
 	return mmSplit.mock
 }
 
-//Set uses given function f to mock the Storage.Split method
+// Set uses given function f to mock the Storage.Split method
 func (mmSplit *mStorageMockSplit) Set(f func(ctx context.Context, pulse insolar.PulseNumber, id insolar.JetID) (j1 insolar.JetID, j2 insolar.JetID, err error)) *StorageMock {
 	if mmSplit.defaultExpectation != nil {
 		mmSplit.mock.t.Fatalf("Default expectation is already set for the Storage.Split method")
@@ -1017,7 +1244,7 @@ func (mmUpdate *mStorageMockUpdate) Return(err error) *StorageMock {
 	return mmUpdate.mock
 }
 
-//Set uses given function f to mock the Storage.Update method
+// Set uses given function f to mock the Storage.Update method
 func (mmUpdate *mStorageMockUpdate) Set(f func(ctx context.Context, pulse insolar.PulseNumber, actual bool, ids ...insolar.JetID) (err error)) *StorageMock {
 	if mmUpdate.defaultExpectation != nil {
 		mmUpdate.mock.t.Fatalf("Default expectation is already set for the Storage.Update method")
@@ -1170,6 +1397,8 @@ func (m *StorageMock) MinimockFinish() {
 
 		m.MinimockForIDInspect()
 
+		m.MinimockMergeInspect()
+
 		m.MinimockSplitInspect()
 
 		m.MinimockUpdateInspect()
@@ -1199,6 +1428,7 @@ func (m *StorageMock) minimockDone() bool {
 		m.MinimockAllDone() &&
 		m.MinimockCloneDone() &&
 		m.MinimockForIDDone() &&
+		m.MinimockMergeDone() &&
 		m.MinimockSplitDone() &&
 		m.MinimockUpdateDone()
 }
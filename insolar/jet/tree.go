@@ -37,7 +37,9 @@ func (j *Jet) Find(val []byte, depth uint8) (*Jet, uint8) {
 func (j *Jet) Update(prefix []byte, setActual bool, maxDepth, depth uint8) {
 	if depth == maxDepth {
 		if setActual {
+			// Actual jet is a leaf. Branches are left from the past tree, if the jet was merged.
 			j.Actual = true
+			j.Left, j.Right = nil, nil
 		}
 		return
 	}
@@ -55,6 +57,10 @@ func (j *Jet) Update(prefix []byte, setActual bool, maxDepth, depth uint8) {
 	}
 }
 
+func (j *Jet) isSplit() bool {
+	return j != nil && (j.Left != nil || j.Right != nil)
+}
+
 // Clone clones tree either keeping actuality state or resetting it to false.
 func (j *Jet) Clone(keep bool) *Jet {
 	res := &Jet{
@@ -139,6 +145,36 @@ func (t *Tree) Split(id insolar.JetID) (insolar.JetID, insolar.JetID, error) {
 	return left, right, nil
 }
 
+// Merge looks for provided jet and replaces it and its sibling with their parent (that is returned).
+// If provided jet is not found or its sibling is split, an error will be returned.
+func (t *Tree) Merge(id insolar.JetID) (insolar.JetID, error) {
+	depth, prefix := id.Depth(), id.Prefix()
+	if depth == 0 {
+		return insolar.ZeroJetID, errors.New("failed to merge: root jet has no sibling")
+	}
+	_, foundDepth := t.Head.Find(prefix, 0)
+	if depth != foundDepth {
+		return insolar.ZeroJetID, errors.New("failed to merge: incorrect jet provided")
+	}
+
+	parent := t.Head
+	for i := uint8(0); i < depth-1; i++ {
+		if getBit(prefix, i) {
+			parent = parent.Right
+		} else {
+			parent = parent.Left
+		}
+	}
+	if parent.Left.isSplit() || parent.Right.isSplit() {
+		return insolar.ZeroJetID, errors.New("failed to merge: sibling jet is split")
+	}
+
+	parent.Left = nil
+	parent.Right = nil
+	parent.Actual = true
+	return Parent(id), nil
+}
+
 func (t *Tree) LeafIDs() []insolar.JetID {
 	var ids []insolar.JetID
 	t.Head.ExtractLeafIDs(&ids, make([]byte, insolar.RecordHashSize), 0)
@@ -124,6 +124,57 @@ func TestTree_Split(t *testing.T) {
 	})
 }
 
+func TestTree_Merge(t *testing.T) {
+	tree := Tree{
+		Head: &Jet{
+			Left: &Jet{},
+			Right: &Jet{
+				Left: &Jet{},
+				Right: &Jet{
+					Left:  &Jet{},
+					Right: &Jet{},
+				},
+			},
+		},
+	}
+
+	t.Run("root jet returns error", func(t *testing.T) {
+		_, err := tree.Merge(insolar.ZeroJetID)
+		assert.Error(t, err)
+	})
+
+	t.Run("not existing jet returns error", func(t *testing.T) {
+		_, err := tree.Merge(NewIDFromString("1111"))
+		assert.Error(t, err)
+	})
+
+	t.Run("split sibling returns error", func(t *testing.T) {
+		_, err := tree.Merge(NewIDFromString("10"))
+		assert.Error(t, err)
+	})
+
+	t.Run("merges jets", func(t *testing.T) {
+		parent, err := tree.Merge(NewIDFromString("111"))
+		require.NoError(t, err)
+		assert.Equal(t, NewIDFromString("11"), parent)
+		assert.Equal(t, []insolar.JetID{NewIDFromString("11")}, tree.LeafIDs())
+
+		parent, err = tree.Merge(NewIDFromString("10"))
+		require.NoError(t, err)
+		assert.Equal(t, NewIDFromString("1"), parent)
+		assert.Equal(t, []insolar.JetID{NewIDFromString("1")}, tree.LeafIDs())
+	})
+}
+
+func TestTree_UpdateMerged(t *testing.T) {
+	tree := NewTree(false)
+	tree.Update(NewIDFromString("10"), true)
+	tree.Update(NewIDFromString("11"), true)
+
+	tree.Update(NewIDFromString("1"), true)
+	assert.Equal(t, []insolar.JetID{NewIDFromString("1")}, tree.LeafIDs())
+}
+
 func TestTree_String(t *testing.T) {
 	tree := Tree{
 		Head: &Jet{
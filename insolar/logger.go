@@ -43,6 +43,7 @@ type LogOutput uint8
 const (
 	StdErrOutput LogOutput = iota
 	SysLogOutput
+	FileOutput
 	//JournalDOutput
 )
 const DefaultLogOutput = StdErrOutput
@@ -303,6 +304,8 @@ func ParseOutput(outputStr string, defValue LogOutput) (LogOutput, error) {
 		return StdErrOutput, nil
 	case SysLogOutput.String():
 		return SysLogOutput, nil
+	case FileOutput.String():
+		return FileOutput, nil
 		//case JournalDOutput.String():
 		//	return JournalDOutput, nil
 	}
@@ -315,6 +318,8 @@ func (l LogOutput) String() string {
 		return "stderr"
 	case SysLogOutput:
 		return "syslog"
+	case FileOutput:
+		return "file"
 		//case JournalDOutput:
 		//	return "journald"
 	}
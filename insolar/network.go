@@ -36,6 +36,50 @@ type Leaver interface {
 	Leave(ctx context.Context, ETA PulseNumber)
 }
 
+// LeaveState is a state of graceful leave of the node.
+type LeaveState int
+
+//go:generate stringer -type=LeaveState
+const (
+	// LeaveNotRequested state means that node works as usual
+	LeaveNotRequested LeaveState = iota
+	// LeaveDraining state means that node doesn't accept new tasks and waits for leave pulse and pending work
+	LeaveDraining
+	// LeaveAnnounced state means that node claimed leave and waits for the network to approve it
+	LeaveAnnounced
+	// LeaveApproved state means that node has left the network and can be stopped
+	LeaveApproved
+)
+
+// LeaveStatus is a progress of graceful leave of the node.
+type LeaveStatus struct {
+	State LeaveState
+	// LeavePulse is a pulse, since that node is allowed to claim leave
+	LeavePulse PulseNumber
+	// Pending is a list of pending work, that delays leave
+	Pending []string
+	// ClaimTimedOut is true if the network hasn't approved leave claim in time, node keeps claiming leave
+	ClaimTimedOut bool
+}
+
+//go:generate minimock -i github.com/insolar/insolar/insolar.GracefulLeaver -o ../testutils -s _mock.go -g
+
+// GracefulLeaver is a node, that can leave the network after it completes pending work.
+type GracefulLeaver interface {
+	// RequestLeave starts leave, node claims leave after given count of pulses when all pending work is done.
+	RequestLeave(ctx context.Context, leaveAfterPulses PulseNumber) error
+	// LeaveStatus returns progress of the leave.
+	LeaveStatus(ctx context.Context) LeaveStatus
+}
+
+//go:generate minimock -i github.com/insolar/insolar/insolar.Drainer -o ../testutils -s _mock.go -g
+
+// Drainer is a component with pending work, that should be completed before node leaves the network.
+type Drainer interface {
+	// PendingWork returns description of pending work or empty string if there is none.
+	PendingWork(ctx context.Context) string
+}
+
 //go:generate minimock -i github.com/insolar/insolar/insolar.CertificateGetter -o ../testutils -s _mock.go -g
 
 type CertificateGetter interface {
@@ -69,6 +69,8 @@ const (
 	TypeAdditionalCallFromPreviousExecutor
 	TypeStillExecuting
 	TypeErrorResultExitsts
+	TypeGetState
+	TypeValidateRequest
 
 	// should be the last (required by TypesMap)
 	_latestType
@@ -317,6 +319,12 @@ func Marshal(payload Payload) ([]byte, error) {
 	case *ErrorResultExists:
 		pl.Polymorph = uint32(TypeErrorResultExitsts)
 		return pl.Marshal()
+	case *GetState:
+		pl.Polymorph = uint32(TypeGetState)
+		return pl.Marshal()
+	case *ValidateRequest:
+		pl.Polymorph = uint32(TypeValidateRequest)
+		return pl.Marshal()
 	}
 
 	return nil, errors.New("unknown payload type")
@@ -528,6 +536,14 @@ func Unmarshal(data []byte) (Payload, error) {
 		pl := ErrorResultExists{}
 		err := pl.Unmarshal(data)
 		return &pl, err
+	case TypeGetState:
+		pl := GetState{}
+		err := pl.Unmarshal(data)
+		return &pl, err
+	case TypeValidateRequest:
+		pl := ValidateRequest{}
+		err := pl.Unmarshal(data)
+		return &pl, err
 	case TypeGetPulse:
 		pl := GetPulse{}
 		err := pl.Unmarshal(data)
@@ -892,8 +892,9 @@ func (m *SagaCallAcceptNotification) GetRequest() []byte {
 }
 
 type SetResult struct {
-	Polymorph uint32 `protobuf:"varint,16,opt,name=Polymorph,proto3" json:"Polymorph,omitempty"`
-	Result    []byte `protobuf:"bytes,20,opt,name=Result,proto3" json:"Result,omitempty"`
+	Polymorph uint32   `protobuf:"varint,16,opt,name=Polymorph,proto3" json:"Polymorph,omitempty"`
+	Result    []byte   `protobuf:"bytes,20,opt,name=Result,proto3" json:"Result,omitempty"`
+	Events    [][]byte `protobuf:"bytes,21,rep,name=Events,proto3" json:"Events,omitempty"`
 }
 
 func (m *SetResult) Reset()      { *m = SetResult{} }
@@ -942,10 +943,18 @@ func (m *SetResult) GetResult() []byte {
 	return nil
 }
 
+func (m *SetResult) GetEvents() [][]byte {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
 type Activate struct {
-	Polymorph uint32 `protobuf:"varint,16,opt,name=Polymorph,proto3" json:"Polymorph,omitempty"`
-	Record    []byte `protobuf:"bytes,20,opt,name=Record,proto3" json:"Record,omitempty"`
-	Result    []byte `protobuf:"bytes,21,opt,name=Result,proto3" json:"Result,omitempty"`
+	Polymorph uint32   `protobuf:"varint,16,opt,name=Polymorph,proto3" json:"Polymorph,omitempty"`
+	Record    []byte   `protobuf:"bytes,20,opt,name=Record,proto3" json:"Record,omitempty"`
+	Result    []byte   `protobuf:"bytes,21,opt,name=Result,proto3" json:"Result,omitempty"`
+	Events    [][]byte `protobuf:"bytes,22,rep,name=Events,proto3" json:"Events,omitempty"`
 }
 
 func (m *Activate) Reset()      { *m = Activate{} }
@@ -1001,10 +1010,18 @@ func (m *Activate) GetResult() []byte {
 	return nil
 }
 
+func (m *Activate) GetEvents() [][]byte {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
 type Deactivate struct {
-	Polymorph uint32 `protobuf:"varint,16,opt,name=Polymorph,proto3" json:"Polymorph,omitempty"`
-	Record    []byte `protobuf:"bytes,20,opt,name=Record,proto3" json:"Record,omitempty"`
-	Result    []byte `protobuf:"bytes,21,opt,name=Result,proto3" json:"Result,omitempty"`
+	Polymorph uint32   `protobuf:"varint,16,opt,name=Polymorph,proto3" json:"Polymorph,omitempty"`
+	Record    []byte   `protobuf:"bytes,20,opt,name=Record,proto3" json:"Record,omitempty"`
+	Result    []byte   `protobuf:"bytes,21,opt,name=Result,proto3" json:"Result,omitempty"`
+	Events    [][]byte `protobuf:"bytes,22,rep,name=Events,proto3" json:"Events,omitempty"`
 }
 
 func (m *Deactivate) Reset()      { *m = Deactivate{} }
@@ -1060,10 +1077,18 @@ func (m *Deactivate) GetResult() []byte {
 	return nil
 }
 
+func (m *Deactivate) GetEvents() [][]byte {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
 type Update struct {
-	Polymorph uint32 `protobuf:"varint,16,opt,name=Polymorph,proto3" json:"Polymorph,omitempty"`
-	Record    []byte `protobuf:"bytes,20,opt,name=Record,proto3" json:"Record,omitempty"`
-	Result    []byte `protobuf:"bytes,21,opt,name=Result,proto3" json:"Result,omitempty"`
+	Polymorph uint32   `protobuf:"varint,16,opt,name=Polymorph,proto3" json:"Polymorph,omitempty"`
+	Record    []byte   `protobuf:"bytes,20,opt,name=Record,proto3" json:"Record,omitempty"`
+	Result    []byte   `protobuf:"bytes,21,opt,name=Result,proto3" json:"Result,omitempty"`
+	Events    [][]byte `protobuf:"bytes,22,rep,name=Events,proto3" json:"Events,omitempty"`
 }
 
 func (m *Update) Reset()      { *m = Update{} }
@@ -1119,6 +1144,13 @@ func (m *Update) GetResult() []byte {
 	return nil
 }
 
+func (m *Update) GetEvents() [][]byte {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
 type GetFilament struct {
 	Polymorph uint32                                         `protobuf:"varint,16,opt,name=Polymorph,proto3" json:"Polymorph,omitempty"`
 	ObjectID  github_com_insolar_insolar_insolar.ID          `protobuf:"bytes,20,opt,name=ObjectID,proto3,customtype=github.com/insolar/insolar/insolar.ID" json:"ObjectID"`
@@ -1337,6 +1369,7 @@ type GotHotConfirmation struct {
 	JetID     github_com_insolar_insolar_insolar.JetID       `protobuf:"bytes,20,opt,name=JetID,proto3,customtype=github.com/insolar/insolar/insolar.JetID" json:"JetID"`
 	Pulse     github_com_insolar_insolar_insolar.PulseNumber `protobuf:"bytes,21,opt,name=Pulse,proto3,customtype=github.com/insolar/insolar/insolar.PulseNumber" json:"Pulse"`
 	Split     bool                                           `protobuf:"varint,22,opt,name=Split,proto3" json:"Split,omitempty"`
+	Merge     bool                                           `protobuf:"varint,23,opt,name=Merge,proto3" json:"Merge,omitempty"`
 }
 
 func (m *GotHotConfirmation) Reset()      { *m = GotHotConfirmation{} }
@@ -1385,6 +1418,13 @@ func (m *GotHotConfirmation) GetSplit() bool {
 	return false
 }
 
+func (m *GotHotConfirmation) GetMerge() bool {
+	if m != nil {
+		return m.Merge
+	}
+	return false
+}
+
 type ResultInfo struct {
 	Polymorph uint32                                `protobuf:"varint,16,opt,name=Polymorph,proto3" json:"Polymorph,omitempty"`
 	ObjectID  github_com_insolar_insolar_insolar.ID `protobuf:"bytes,20,opt,name=ObjectID,proto3,customtype=github.com/insolar/insolar/insolar.ID" json:"ObjectID"`
@@ -2692,6 +2732,99 @@ func (m *Pulse) GetPulse() pulse.PulseProto {
 	return pulse.PulseProto{}
 }
 
+type GetState struct {
+	Polymorph uint32                                `protobuf:"varint,16,opt,name=Polymorph,proto3" json:"Polymorph,omitempty"`
+	ObjectID  github_com_insolar_insolar_insolar.ID `protobuf:"bytes,20,opt,name=ObjectID,proto3,customtype=github.com/insolar/insolar/insolar.ID" json:"ObjectID"`
+	StateID   github_com_insolar_insolar_insolar.ID `protobuf:"bytes,21,opt,name=StateID,proto3,customtype=github.com/insolar/insolar/insolar.ID" json:"StateID"`
+}
+
+func (m *GetState) Reset()      { *m = GetState{} }
+func (*GetState) ProtoMessage() {}
+func (*GetState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33334fec96407f54, []int{51}
+}
+func (m *GetState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetState.Merge(m, src)
+}
+func (m *GetState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetState proto.InternalMessageInfo
+
+func (m *GetState) GetPolymorph() uint32 {
+	if m != nil {
+		return m.Polymorph
+	}
+	return 0
+}
+
+type ValidateRequest struct {
+	Polymorph           uint32                                         `protobuf:"varint,16,opt,name=Polymorph,proto3" json:"Polymorph,omitempty"`
+	ObjectRef           github_com_insolar_insolar_insolar.Reference   `protobuf:"bytes,20,opt,name=ObjectRef,proto3,customtype=github.com/insolar/insolar/insolar.Reference" json:"ObjectRef"`
+	RequestRef          github_com_insolar_insolar_insolar.Reference   `protobuf:"bytes,21,opt,name=RequestRef,proto3,customtype=github.com/insolar/insolar/insolar.Reference" json:"RequestRef"`
+	PrevStateID         *github_com_insolar_insolar_insolar.ID         `protobuf:"bytes,22,opt,name=PrevStateID,proto3,customtype=github.com/insolar/insolar/insolar.ID" json:"PrevStateID,omitempty"`
+	Parent              github_com_insolar_insolar_insolar.Reference   `protobuf:"bytes,23,opt,name=Parent,proto3,customtype=github.com/insolar/insolar/insolar.Reference" json:"Parent"`
+	OutgoingRequestRefs []github_com_insolar_insolar_insolar.Reference `protobuf:"bytes,24,rep,name=OutgoingRequestRefs,proto3,customtype=github.com/insolar/insolar/insolar.Reference" json:"OutgoingRequestRefs"`
+}
+
+func (m *ValidateRequest) Reset()      { *m = ValidateRequest{} }
+func (*ValidateRequest) ProtoMessage() {}
+func (*ValidateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33334fec96407f54, []int{52}
+}
+func (m *ValidateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateRequest.Merge(m, src)
+}
+func (m *ValidateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidateRequest proto.InternalMessageInfo
+
+func (m *ValidateRequest) GetPolymorph() uint32 {
+	if m != nil {
+		return m.Polymorph
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Meta)(nil), "payload.Meta")
 	proto.RegisterType((*Error)(nil), "payload.Error")
@@ -2744,133 +2877,140 @@ func init() {
 	proto.RegisterType((*UpdateJet)(nil), "payload.UpdateJet")
 	proto.RegisterType((*GetPulse)(nil), "payload.GetPulse")
 	proto.RegisterType((*Pulse)(nil), "payload.Pulse")
+	proto.RegisterType((*GetState)(nil), "payload.GetState")
+	proto.RegisterType((*ValidateRequest)(nil), "payload.ValidateRequest")
 }
 
 func init() { proto.RegisterFile("insolar/payload/payload.proto", fileDescriptor_33334fec96407f54) }
 
 var fileDescriptor_33334fec96407f54 = []byte{
-	// 1924 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5a, 0x4d, 0x6c, 0x5b, 0x59,
-	0x15, 0xf6, 0x73, 0xe2, 0xc4, 0x3e, 0x6e, 0x9a, 0xc9, 0xc3, 0x76, 0x4c, 0xc5, 0xb8, 0xd1, 0xd5,
-	0x0c, 0x0a, 0x82, 0x24, 0x33, 0x6d, 0x55, 0x36, 0x8c, 0xaa, 0x24, 0x4e, 0x1d, 0x0f, 0x4e, 0x63,
-	0xae, 0x33, 0x65, 0x34, 0x23, 0x21, 0x6e, 0xde, 0xbb, 0xb1, 0x1f, 0xf3, 0xfc, 0xae, 0x79, 0xef,
-	0x3a, 0xb4, 0x3b, 0xc4, 0x6c, 0x10, 0x2b, 0x16, 0x20, 0x81, 0x58, 0x23, 0xb1, 0x60, 0x0d, 0x0b,
-	0x16, 0xc0, 0x88, 0x45, 0x25, 0x16, 0x74, 0x39, 0x9a, 0x45, 0xa1, 0xa9, 0x90, 0xd8, 0x20, 0x95,
-	0x3d, 0x0b, 0x74, 0x7f, 0x9e, 0xfd, 0x9c, 0x96, 0xbe, 0x17, 0xdb, 0x35, 0x74, 0x13, 0xfb, 0xfe,
-	0x9c, 0xef, 0x9c, 0x7b, 0xee, 0x39, 0xe7, 0x9e, 0x73, 0x1c, 0x78, 0xdd, 0xf1, 0x02, 0xe6, 0x12,
-	0x7f, 0xab, 0x47, 0xee, 0xbb, 0x8c, 0xd8, 0xe1, 0xe7, 0x66, 0xcf, 0x67, 0x9c, 0x99, 0x8b, 0x7a,
-	0x78, 0x65, 0xa3, 0xed, 0xf0, 0x4e, 0xff, 0x78, 0xd3, 0x62, 0xdd, 0xad, 0x36, 0x6b, 0xb3, 0x2d,
-	0xb9, 0x7e, 0xdc, 0x3f, 0x91, 0x23, 0x39, 0x90, 0xdf, 0x14, 0xdd, 0x95, 0x9b, 0x91, 0xed, 0x21,
-	0x87, 0xf3, 0x9f, 0x3e, 0xb5, 0x98, 0x6f, 0xeb, 0x0f, 0x4d, 0x77, 0x23, 0x01, 0x5d, 0xaf, 0xef,
-	0x06, 0x54, 0xfd, 0xd5, 0x54, 0x6f, 0xbf, 0x80, 0xca, 0xa5, 0x76, 0x9b, 0xfa, 0x5b, 0xb6, 0xcf,
-	0x7a, 0xf2, 0x8f, 0x22, 0x41, 0xff, 0x4a, 0xc3, 0xfc, 0x01, 0xe5, 0xc4, 0xfc, 0x02, 0xe4, 0x9a,
-	0xcc, 0xbd, 0xdf, 0x65, 0x7e, 0xaf, 0x53, 0x7e, 0x6d, 0xcd, 0x58, 0x5f, 0xc2, 0xc3, 0x09, 0xb3,
-	0x0c, 0x8b, 0x4d, 0xa5, 0x81, 0x72, 0x61, 0xcd, 0x58, 0xbf, 0x84, 0xc3, 0xa1, 0xd9, 0x80, 0x85,
-	0x16, 0xf5, 0x6c, 0xea, 0x97, 0x8b, 0x62, 0x61, 0xe7, 0xc6, 0x83, 0x47, 0x57, 0x53, 0x9f, 0x3d,
-	0xba, 0xfa, 0x95, 0xf8, 0x13, 0x6c, 0x62, 0x7a, 0x42, 0x7d, 0xea, 0x59, 0x14, 0x6b, 0x0c, 0xb3,
-	0x09, 0x59, 0x4c, 0x2d, 0xea, 0x9c, 0x52, 0xbf, 0x5c, 0x9a, 0x00, 0x6f, 0x80, 0x62, 0x36, 0x20,
-	0xd3, 0x14, 0x2a, 0x2a, 0xaf, 0x4a, 0xb8, 0x9b, 0x1a, 0x6e, 0x33, 0x01, 0x9c, 0xa4, 0xbb, 0xd3,
-	0xef, 0x1e, 0x53, 0x1f, 0x2b, 0x10, 0xf3, 0x32, 0xa4, 0xeb, 0xd5, 0x72, 0x59, 0xaa, 0x20, 0x5d,
-	0xaf, 0x9a, 0xd7, 0x01, 0x0e, 0x7d, 0xa7, 0xed, 0x78, 0xfb, 0x24, 0xe8, 0x94, 0x3f, 0x2f, 0x59,
-	0x7c, 0x4e, 0xb3, 0xc8, 0x1f, 0xd0, 0x20, 0x20, 0x6d, 0x2a, 0x96, 0x70, 0x64, 0x1b, 0xfa, 0x36,
-	0x64, 0xf6, 0x7c, 0x9f, 0xf9, 0x31, 0x3a, 0x7f, 0x13, 0xe6, 0x77, 0x99, 0x4d, 0xa5, 0xc2, 0x97,
-	0x76, 0x56, 0x34, 0x6a, 0x4e, 0x92, 0x8a, 0x05, 0x2c, 0x97, 0x4d, 0x13, 0xe6, 0x8f, 0xe8, 0x3d,
-	0x2e, 0xd5, 0x9f, 0xc3, 0xf2, 0x3b, 0xfa, 0xa3, 0x01, 0xb9, 0x1a, 0xe5, 0x87, 0xc7, 0xdf, 0xa1,
-	0x16, 0x8f, 0x61, 0x53, 0x87, 0xac, 0xda, 0x57, 0xaf, 0xaa, 0xbb, 0xdd, 0xd9, 0xd0, 0xac, 0xde,
-	0x4c, 0xa0, 0xa3, 0x7a, 0x15, 0x0f, 0xc8, 0xcd, 0xaf, 0x43, 0x0e, 0xd3, 0xef, 0xf6, 0x69, 0x20,
-	0xb0, 0x8a, 0x03, 0x2c, 0x23, 0x39, 0xd6, 0x90, 0x1e, 0x79, 0xb0, 0x58, 0xa3, 0x5c, 0x1e, 0xf1,
-	0xc5, 0x07, 0xd8, 0x83, 0x05, 0xb1, 0x6b, 0x5c, 0xf1, 0x35, 0x31, 0xfa, 0x91, 0x01, 0xb9, 0x26,
-	0x09, 0x82, 0x16, 0x27, 0x3c, 0x8e, 0x65, 0x09, 0x16, 0xd4, 0x7d, 0x6a, 0x6f, 0xd0, 0x23, 0xb3,
-	0x06, 0x8b, 0x92, 0x7c, 0xe4, 0xf8, 0x17, 0x90, 0x25, 0xa4, 0x46, 0x5f, 0x83, 0x79, 0x21, 0xcb,
-	0x78, 0x62, 0xa0, 0x5b, 0xb0, 0xd8, 0x4a, 0xa4, 0xba, 0x12, 0x2c, 0x60, 0x19, 0x76, 0x42, 0x00,
-	0x35, 0x42, 0x3f, 0x33, 0x20, 0x53, 0xf7, 0x6c, 0x7a, 0x2f, 0x86, 0xbe, 0xa0, 0xb7, 0x69, 0x72,
-	0x4d, 0xf3, 0x21, 0xac, 0xec, 0x11, 0xdf, 0x75, 0x68, 0xc0, 0x27, 0x34, 0x87, 0x67, 0x71, 0xd0,
-	0x07, 0xb0, 0xdc, 0xa2, 0xc4, 0xb7, 0x3a, 0x92, 0x57, 0xdd, 0x3b, 0x61, 0x31, 0x32, 0x7e, 0x29,
-	0x2a, 0x63, 0xfe, 0xda, 0xd2, 0xa6, 0x0e, 0xb4, 0x72, 0x72, 0x67, 0x5e, 0x08, 0xa4, 0x05, 0x17,
-	0x5a, 0x9f, 0x40, 0x69, 0xef, 0x40, 0x26, 0xa1, 0xed, 0x3c, 0x97, 0x9c, 0x88, 0xd0, 0x12, 0x43,
-	0xfb, 0x8e, 0x0c, 0x3f, 0x63, 0x99, 0x79, 0xba, 0x5e, 0x45, 0x36, 0xcc, 0xd5, 0xab, 0x71, 0x46,
-	0x75, 0x4b, 0x6e, 0x2a, 0x17, 0xd6, 0xe6, 0x2e, 0xce, 0x44, 0x50, 0xa2, 0x8f, 0x0d, 0x98, 0x7b,
-	0x97, 0xc6, 0x85, 0x9d, 0xdb, 0x90, 0x79, 0x97, 0x0e, 0x63, 0xce, 0x5b, 0x9a, 0xd1, 0x7a, 0x02,
-	0x46, 0x92, 0x0e, 0x2b, 0x72, 0xa1, 0xce, 0x6d, 0x8b, 0xf7, 0x89, 0x2b, 0x2d, 0x2c, 0x8b, 0xf5,
-	0x08, 0x59, 0x60, 0xb6, 0x28, 0xaf, 0x7b, 0x16, 0xeb, 0x3a, 0x5e, 0x5b, 0xdb, 0x4f, 0x8c, 0x4c,
-	0x5b, 0xb0, 0xa8, 0x37, 0x6a, 0x63, 0x59, 0x0e, 0x8d, 0xe5, 0xae, 0xe3, 0x0b, 0x54, 0x69, 0x2e,
-	0x29, 0x1c, 0xee, 0xd2, 0x4c, 0x0e, 0xfb, 0xbc, 0xcd, 0x5e, 0x1e, 0x93, 0x7f, 0x1b, 0x70, 0xa5,
-	0x45, 0xda, 0x64, 0x97, 0xb8, 0xee, 0xb6, 0x65, 0xd1, 0x1e, 0xbf, 0xc3, 0xb8, 0x73, 0xe2, 0x58,
-	0x84, 0x3b, 0xcc, 0x9b, 0x5d, 0x74, 0xff, 0x10, 0x56, 0xaa, 0x94, 0x13, 0xab, 0x43, 0xed, 0xe7,
-	0xb9, 0xf5, 0x05, 0x30, 0x9f, 0xc5, 0x11, 0x09, 0x46, 0xa8, 0x95, 0x92, 0x4a, 0x30, 0xc2, 0xe3,
-	0x6f, 0x43, 0xae, 0x45, 0x39, 0xa6, 0x41, 0xdf, 0xe5, 0x49, 0x5c, 0x4b, 0xec, 0x1b, 0xba, 0x96,
-	0x18, 0xa1, 0xf7, 0x21, 0xbb, 0x6d, 0x71, 0xe7, 0x74, 0x6c, 0xe7, 0x8c, 0x20, 0x17, 0x47, 0x90,
-	0x3f, 0x00, 0xa8, 0x52, 0xf2, 0x72, 0xb0, 0xef, 0xc2, 0xc2, 0x7b, 0x3d, 0x7b, 0xfa, 0xb8, 0x3f,
-	0x4f, 0x43, 0xbe, 0x46, 0xf9, 0x6d, 0xc7, 0x25, 0x5d, 0xea, 0xcd, 0x36, 0x3d, 0x68, 0x71, 0xe2,
-	0xf3, 0xdb, 0x3e, 0xeb, 0x8e, 0x67, 0x38, 0x43, 0x7a, 0xf3, 0x48, 0xe4, 0x1a, 0xc4, 0x7e, 0xcf,
-	0xe3, 0x8e, 0xab, 0x53, 0xc5, 0x71, 0x73, 0xbb, 0x21, 0x10, 0xfa, 0x9d, 0x01, 0xcb, 0xa1, 0x62,
-	0x5a, 0xb4, 0x3d, 0x5b, 0xfd, 0xdc, 0x12, 0x3e, 0x20, 0xae, 0x2e, 0x28, 0x17, 0xd7, 0xe6, 0xd6,
-	0xf3, 0xd7, 0xae, 0x86, 0x91, 0x61, 0x97, 0x75, 0x7b, 0x2c, 0x70, 0x38, 0x0d, 0x65, 0x53, 0xfb,
-	0x86, 0x91, 0x42, 0x52, 0xa1, 0x9f, 0xa4, 0xe1, 0x72, 0x8d, 0x0e, 0x1e, 0xcb, 0xf8, 0xb7, 0xf1,
-	0xe5, 0xe7, 0x7e, 0xa9, 0x71, 0x72, 0xbf, 0x61, 0xd2, 0x5e, 0x9a, 0x42, 0xd2, 0x8e, 0x7e, 0x91,
-	0x86, 0xfc, 0xab, 0xaf, 0x93, 0xff, 0x1a, 0x21, 0x23, 0x8e, 0xbe, 0x1a, 0x75, 0x74, 0xf3, 0x0d,
-	0x58, 0x3a, 0x74, 0x6d, 0x1a, 0xf0, 0x83, 0x3e, 0x27, 0xc7, 0x2e, 0x95, 0x75, 0x4b, 0x16, 0x8f,
-	0x4e, 0xa2, 0x47, 0x06, 0x98, 0x35, 0xc6, 0xf7, 0x19, 0xdf, 0x65, 0xde, 0x89, 0xe3, 0x77, 0x93,
-	0x3c, 0x2b, 0xd3, 0x7a, 0xbd, 0x07, 0x17, 0x5d, 0x9c, 0x46, 0x75, 0x56, 0x80, 0x4c, 0xab, 0xe7,
-	0x3a, 0x4a, 0x41, 0x59, 0xac, 0x06, 0xe8, 0x0f, 0x06, 0x80, 0xd2, 0xc8, 0x6c, 0x6f, 0xbf, 0x2e,
-	0x6a, 0x59, 0xc9, 0x76, 0xcc, 0xcb, 0x1f, 0x90, 0xa3, 0xbf, 0x1a, 0xb0, 0x22, 0xeb, 0x3e, 0x35,
-	0xb3, 0x77, 0xcf, 0x09, 0x78, 0xf0, 0x2a, 0x9e, 0x24, 0x62, 0xab, 0xa5, 0x91, 0x47, 0xe9, 0xa7,
-	0x69, 0x80, 0x7d, 0xa6, 0x2b, 0xd6, 0x60, 0xd6, 0xd6, 0x37, 0x8d, 0x30, 0x63, 0xbe, 0x01, 0xf3,
-	0x55, 0x9f, 0xf5, 0xa4, 0x86, 0xf2, 0xd7, 0x60, 0x53, 0x76, 0x59, 0xc4, 0x8c, 0x0e, 0xd3, 0x72,
-	0xd5, 0xdc, 0x80, 0x45, 0x59, 0x6c, 0xd0, 0xa0, 0xbc, 0x2a, 0x83, 0xfc, 0x73, 0x0a, 0x92, 0x14,
-	0x0e, 0xf7, 0xa0, 0x4f, 0x0c, 0x80, 0x61, 0x48, 0x7f, 0x35, 0x43, 0x17, 0xfa, 0xa5, 0x31, 0x88,
-	0x5d, 0x31, 0x27, 0x18, 0x61, 0x5b, 0x98, 0x30, 0x62, 0x46, 0x32, 0xed, 0x62, 0xa2, 0x4c, 0xfb,
-	0x13, 0x03, 0xf2, 0x2d, 0xea, 0x9f, 0x3a, 0x16, 0xad, 0x92, 0xd8, 0x9e, 0x58, 0x05, 0xa0, 0xc1,
-	0xda, 0x47, 0x3e, 0xb1, 0xc2, 0xde, 0x43, 0x0e, 0x47, 0x66, 0xcc, 0x43, 0xc8, 0x36, 0x58, 0xbb,
-	0x41, 0x4f, 0xa9, 0xaa, 0x4d, 0x96, 0x76, 0xae, 0xeb, 0xa3, 0x7c, 0x39, 0xc1, 0x51, 0x42, 0x52,
-	0x3c, 0x00, 0x11, 0xf1, 0x5c, 0x62, 0xb7, 0x7a, 0xc4, 0x13, 0xf2, 0x69, 0x17, 0x1a, 0x9d, 0x44,
-	0xff, 0x4c, 0xc3, 0x12, 0xa6, 0xbc, 0xef, 0x7b, 0xca, 0xb5, 0xe2, 0x9c, 0xa9, 0x01, 0x0b, 0x47,
-	0xc4, 0x6f, 0x53, 0x9d, 0x34, 0x8f, 0xdb, 0xc0, 0x53, 0x18, 0xe6, 0x91, 0x88, 0xb5, 0x52, 0x9b,
-	0x98, 0x9e, 0x4c, 0xd4, 0x12, 0x8c, 0xe0, 0x08, 0x19, 0x31, 0x25, 0x01, 0xf3, 0x26, 0x6a, 0x0a,
-	0x6a, 0x0c, 0xf1, 0x4c, 0x60, 0xda, 0x73, 0xef, 0xeb, 0xe7, 0x52, 0x0d, 0xc4, 0xac, 0x0c, 0xb1,
-	0xf2, 0x95, 0xcc, 0x61, 0xdd, 0xa2, 0x5b, 0x13, 0xa9, 0x43, 0x40, 0x3d, 0x7b, 0x97, 0xf5, 0x3d,
-	0x2e, 0x3b, 0x7c, 0x4b, 0x38, 0x3a, 0x85, 0x7e, 0x6b, 0x00, 0x88, 0xd2, 0xec, 0x80, 0xf2, 0x0e,
-	0xb3, 0x63, 0x94, 0xfd, 0xf6, 0xf9, 0xe2, 0x6f, 0x75, 0xe8, 0xfd, 0x23, 0x95, 0xea, 0xf0, 0x75,
-	0x7f, 0x1f, 0xf2, 0x91, 0x60, 0xa3, 0x2d, 0x69, 0xdc, 0x50, 0x15, 0x85, 0x42, 0x9f, 0xa5, 0x61,
-	0x79, 0xef, 0x1e, 0xb5, 0xfa, 0x3c, 0x7c, 0x58, 0x12, 0xd8, 0x8a, 0x38, 0x2a, 0xf5, 0x27, 0xb3,
-	0x15, 0x85, 0x61, 0x62, 0xe1, 0xec, 0xe2, 0xf0, 0x93, 0x9a, 0xca, 0x10, 0xc6, 0xbc, 0x01, 0xc5,
-	0x86, 0xec, 0x74, 0xef, 0x93, 0xe0, 0x80, 0xf9, 0x54, 0x6b, 0x31, 0x90, 0x77, 0x9d, 0xc5, 0xcf,
-	0x5f, 0x34, 0xbf, 0x01, 0x8b, 0x4d, 0xea, 0xd9, 0x8e, 0xd7, 0x96, 0xb7, 0x9f, 0xd9, 0xf9, 0xaa,
-	0x96, 0x63, 0x2b, 0x89, 0x7e, 0x15, 0xa5, 0xec, 0xfd, 0xe0, 0x10, 0x07, 0x7d, 0x6c, 0xc0, 0xb2,
-	0xfe, 0x7e, 0xdb, 0xf1, 0x9c, 0xa0, 0x43, 0xe3, 0x6c, 0x03, 0x43, 0x4e, 0x85, 0x5f, 0xa1, 0x8e,
-	0x49, 0xf4, 0x3b, 0x84, 0x41, 0xbf, 0x99, 0x03, 0xb4, 0x6d, 0xdb, 0x8e, 0x48, 0xe9, 0x88, 0x2b,
-	0xf4, 0x2e, 0x8a, 0xa7, 0xa6, 0x4f, 0x4f, 0x1d, 0xd6, 0x0f, 0xc2, 0xcb, 0x8f, 0x11, 0xec, 0x5b,
-	0xb0, 0x3c, 0x40, 0x54, 0x2c, 0x26, 0x12, 0xef, 0x3c, 0x58, 0x54, 0xfb, 0xc5, 0xe9, 0x68, 0xff,
-	0x5c, 0x18, 0x2a, 0x4d, 0x29, 0x0c, 0x45, 0xbc, 0x77, 0x35, 0xa1, 0xf7, 0xde, 0x1c, 0x79, 0x51,
-	0xa4, 0x75, 0xe5, 0xaf, 0x15, 0x36, 0xc3, 0x5f, 0x97, 0x22, 0x6b, 0x38, 0xba, 0x11, 0xfd, 0x3a,
-	0x0d, 0x97, 0x5b, 0xdc, 0x71, 0x5d, 0x75, 0x47, 0xe2, 0x4c, 0x33, 0xb7, 0x1e, 0xb3, 0x09, 0xd9,
-	0xd0, 0x44, 0x26, 0xf2, 0xcf, 0x01, 0x8a, 0x79, 0x77, 0x50, 0x89, 0x61, 0x7a, 0x12, 0x94, 0x4b,
-	0xb2, 0xc9, 0x38, 0x1e, 0x68, 0x14, 0x08, 0xfd, 0xdd, 0x90, 0x3d, 0x0d, 0x7d, 0xfd, 0x33, 0x4c,
-	0x8d, 0x0b, 0x90, 0x51, 0x2f, 0x83, 0x8c, 0xcb, 0x58, 0x0d, 0xcc, 0x6f, 0xc2, 0x72, 0xeb, 0x23,
-	0xa7, 0xf7, 0xec, 0x51, 0x2f, 0xc8, 0xe7, 0x3c, 0x0a, 0x3a, 0x85, 0xfc, 0x3e, 0x09, 0x66, 0x7e,
-	0x4c, 0x74, 0x07, 0x2e, 0x85, 0x4c, 0x13, 0x14, 0x51, 0x6b, 0x23, 0x52, 0x4a, 0xde, 0x59, 0x1c,
-	0x9d, 0x42, 0x0f, 0x64, 0x49, 0xde, 0x73, 0x93, 0x35, 0x31, 0xff, 0x3f, 0xab, 0xcd, 0x48, 0x26,
-	0x5f, 0x8a, 0xcf, 0xe4, 0xcd, 0xb7, 0x86, 0xdd, 0x1d, 0x95, 0xf8, 0xbf, 0x16, 0x6e, 0x3f, 0x20,
-	0x9c, 0xfa, 0x4e, 0x34, 0x1d, 0x95, 0xdb, 0x06, 0x05, 0x45, 0xf9, 0x45, 0x05, 0x05, 0xfa, 0xb3,
-	0x01, 0x0b, 0x35, 0xca, 0xe3, 0x3b, 0xee, 0x53, 0xb4, 0xfa, 0x97, 0x97, 0x93, 0xfc, 0xd0, 0x80,
-	0xd7, 0xb7, 0x8f, 0x89, 0x67, 0x33, 0x6f, 0xd0, 0x1e, 0x0e, 0xfe, 0x27, 0xfd, 0x6e, 0xf4, 0x03,
-	0x03, 0x0a, 0x35, 0xca, 0x1b, 0x4e, 0xbb, 0xc3, 0xeb, 0x9e, 0xc3, 0x1d, 0xe2, 0x26, 0xf9, 0x7d,
-	0x67, 0xaa, 0x46, 0x86, 0xfe, 0x92, 0x86, 0x95, 0x8b, 0x4a, 0x80, 0xe0, 0xd2, 0x1d, 0xca, 0xbf,
-	0xc7, 0xfc, 0x8f, 0x64, 0xbb, 0x54, 0xfb, 0xdf, 0xc8, 0x9c, 0xb9, 0x0f, 0x0b, 0xd2, 0x27, 0x54,
-	0xab, 0x71, 0x1c, 0x9f, 0xd2, 0xf4, 0xe6, 0x17, 0x21, 0x23, 0xec, 0x30, 0x74, 0x82, 0x67, 0xcd,
-	0x54, 0x2d, 0x5f, 0xb0, 0xf0, 0x35, 0x37, 0x42, 0x35, 0x2a, 0xeb, 0x5f, 0xd9, 0x54, 0xff, 0xe8,
-	0x20, 0xe7, 0x9a, 0x3e, 0xe3, 0x2c, 0x44, 0x57, 0xce, 0xb8, 0x0e, 0xcb, 0x52, 0x4d, 0xbb, 0x1d,
-	0xe2, 0x78, 0x0d, 0xa7, 0xeb, 0x84, 0xb9, 0xfa, 0xf9, 0x69, 0x14, 0x40, 0xb6, 0x46, 0x79, 0x92,
-	0x5f, 0x37, 0xa7, 0x68, 0x4b, 0x7f, 0x92, 0x95, 0xe5, 0xe0, 0x67, 0xcb, 0xd9, 0x79, 0x6a, 0x03,
-	0x32, 0xaa, 0x45, 0x3e, 0xa1, 0x35, 0xaa, 0xf6, 0xf8, 0xef, 0x0d, 0xc8, 0xa9, 0xdf, 0x24, 0xe2,
-	0xc3, 0xcd, 0xc0, 0x0f, 0x0a, 0xd3, 0x08, 0xb6, 0x83, 0x27, 0xa0, 0x38, 0xd1, 0x13, 0x20, 0x9c,
-	0x5a, 0x5c, 0xbf, 0x02, 0x7d, 0xf1, 0x01, 0xce, 0x05, 0xb9, 0xc9, 0x8e, 0x31, 0x12, 0xe4, 0x8e,
-	0xb4, 0x6a, 0x62, 0x04, 0xd8, 0x88, 0x6a, 0x30, 0xd6, 0x05, 0x76, 0x6e, 0x3c, 0x7c, 0x5c, 0x49,
-	0x7d, 0xfa, 0xb8, 0x92, 0x7a, 0xfa, 0xb8, 0x62, 0x7c, 0xff, 0xac, 0x62, 0xfc, 0xea, 0xac, 0x62,
-	0x3c, 0x38, 0xab, 0x18, 0x0f, 0xcf, 0x2a, 0xc6, 0xdf, 0xce, 0x2a, 0xc6, 0x3f, 0xce, 0x2a, 0xa9,
-	0xa7, 0x67, 0x15, 0xe3, 0xc7, 0x4f, 0x2a, 0xa9, 0x87, 0x4f, 0x2a, 0xa9, 0x4f, 0x9f, 0x54, 0x52,
-	0xc7, 0x0b, 0xf2, 0xff, 0x80, 0xae, 0xff, 0x27, 0x00, 0x00, 0xff, 0xff, 0x37, 0x96, 0xe0, 0x07,
-	0x01, 0x25, 0x00, 0x00,
+	// 2009 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5a, 0x4d, 0x6c, 0x23, 0x49,
+	0x15, 0x76, 0x3b, 0xf1, 0xdf, 0xf3, 0x64, 0xb2, 0xe9, 0x4d, 0x3c, 0x66, 0xc4, 0x7a, 0xa2, 0xd2,
+	0x2e, 0x1a, 0x04, 0x49, 0x76, 0x67, 0x46, 0xc3, 0x85, 0xd5, 0x28, 0x89, 0x33, 0x89, 0x17, 0x67,
+	0x12, 0xca, 0xd9, 0xe1, 0x67, 0x25, 0x44, 0xc5, 0x5d, 0xb1, 0x9b, 0x6d, 0x77, 0x99, 0xea, 0x72,
+	0x98, 0xb9, 0x21, 0xb8, 0x20, 0x4e, 0x1c, 0x40, 0x02, 0x71, 0x46, 0xe2, 0xc0, 0x19, 0x0e, 0x20,
+	0x01, 0x2b, 0x0e, 0x23, 0x71, 0x60, 0x8e, 0xab, 0x3d, 0x2c, 0x3b, 0x19, 0x21, 0x71, 0x41, 0x5a,
+	0x24, 0x8e, 0x1c, 0x50, 0xfd, 0xb4, 0xdd, 0xce, 0x64, 0xa7, 0x3b, 0xb6, 0x63, 0x76, 0x2e, 0xb6,
+	0xab, 0xba, 0xde, 0xf7, 0x5e, 0xbd, 0x7a, 0xef, 0xd5, 0x7b, 0xaf, 0x0d, 0xaf, 0xb8, 0x7e, 0xc0,
+	0x3c, 0xc2, 0xd7, 0xba, 0xe4, 0xa1, 0xc7, 0x88, 0x13, 0x7e, 0xaf, 0x76, 0x39, 0x13, 0xcc, 0xce,
+	0x99, 0xe1, 0xd5, 0x95, 0x96, 0x2b, 0xda, 0xbd, 0xc3, 0xd5, 0x26, 0xeb, 0xac, 0xb5, 0x58, 0x8b,
+	0xad, 0xa9, 0xe7, 0x87, 0xbd, 0x23, 0x35, 0x52, 0x03, 0xf5, 0x4b, 0xd3, 0x5d, 0xbd, 0x1d, 0x59,
+	0x1e, 0x72, 0x38, 0xfd, 0xcd, 0x69, 0x93, 0x71, 0xc7, 0x7c, 0x19, 0xba, 0x5b, 0x09, 0xe8, 0xba,
+	0x3d, 0x2f, 0xa0, 0xfa, 0xd3, 0x50, 0xbd, 0xf1, 0x1c, 0x2a, 0x8f, 0x3a, 0x2d, 0xca, 0xd7, 0x1c,
+	0xce, 0xba, 0xea, 0x43, 0x93, 0xa0, 0x7f, 0xa7, 0x61, 0x76, 0x97, 0x0a, 0x62, 0x7f, 0x16, 0x0a,
+	0xfb, 0xcc, 0x7b, 0xd8, 0x61, 0xbc, 0xdb, 0x2e, 0xbf, 0xb4, 0x6c, 0x5d, 0x9f, 0xc3, 0x83, 0x09,
+	0xbb, 0x0c, 0xb9, 0x7d, 0xad, 0x81, 0xf2, 0xe2, 0xb2, 0x75, 0xfd, 0x12, 0x0e, 0x87, 0x76, 0x1d,
+	0xb2, 0x0d, 0xea, 0x3b, 0x94, 0x97, 0x97, 0xe4, 0x83, 0x8d, 0x5b, 0x8f, 0x3e, 0xbc, 0x96, 0xfa,
+	0xe0, 0xc3, 0x6b, 0x5f, 0x8c, 0xdf, 0xc1, 0x2a, 0xa6, 0x47, 0x94, 0x53, 0xbf, 0x49, 0xb1, 0xc1,
+	0xb0, 0xf7, 0x21, 0x8f, 0x69, 0x93, 0xba, 0xc7, 0x94, 0x97, 0x4b, 0x63, 0xe0, 0xf5, 0x51, 0xec,
+	0x3a, 0x64, 0xf6, 0xa5, 0x8a, 0xca, 0x57, 0x14, 0xdc, 0x6d, 0x03, 0xb7, 0x9a, 0x00, 0x4e, 0xd1,
+	0xdd, 0xeb, 0x75, 0x0e, 0x29, 0xc7, 0x1a, 0xc4, 0xbe, 0x0c, 0xe9, 0x5a, 0xb5, 0x5c, 0x56, 0x2a,
+	0x48, 0xd7, 0xaa, 0xf6, 0x4d, 0x80, 0x3d, 0xee, 0xb6, 0x5c, 0x7f, 0x87, 0x04, 0xed, 0xf2, 0x67,
+	0x14, 0x8b, 0x97, 0x0d, 0x8b, 0xe2, 0x2e, 0x0d, 0x02, 0xd2, 0xa2, 0xf2, 0x11, 0x8e, 0x2c, 0x43,
+	0xdf, 0x86, 0xcc, 0x16, 0xe7, 0x8c, 0xc7, 0xe8, 0xfc, 0x35, 0x98, 0xdd, 0x64, 0x0e, 0x55, 0x0a,
+	0x9f, 0xdb, 0x58, 0x30, 0xa8, 0x05, 0x45, 0x2a, 0x1f, 0x60, 0xf5, 0xd8, 0xb6, 0x61, 0xf6, 0x80,
+	0x3e, 0x10, 0x4a, 0xfd, 0x05, 0xac, 0x7e, 0xa3, 0x3f, 0x5b, 0x50, 0xd8, 0xa6, 0x62, 0xef, 0xf0,
+	0x3b, 0xb4, 0x29, 0x62, 0xd8, 0xd4, 0x20, 0xaf, 0xd7, 0xd5, 0xaa, 0xfa, 0x6c, 0x37, 0x56, 0x0c,
+	0xab, 0xd7, 0x12, 0xe8, 0xa8, 0x56, 0xc5, 0x7d, 0x72, 0xfb, 0x2b, 0x50, 0xc0, 0xf4, 0xbb, 0x3d,
+	0x1a, 0x48, 0xac, 0xa5, 0x3e, 0x96, 0x95, 0x1c, 0x6b, 0x40, 0x8f, 0x7c, 0xc8, 0x6d, 0x53, 0xa1,
+	0xb6, 0xf8, 0xfc, 0x0d, 0x6c, 0x41, 0x56, 0xae, 0x1a, 0x55, 0x7c, 0x43, 0x8c, 0x7e, 0x6c, 0x41,
+	0x61, 0x9f, 0x04, 0x41, 0x43, 0x10, 0x11, 0xc7, 0xb2, 0x04, 0x59, 0x7d, 0x9e, 0xc6, 0x1b, 0xcc,
+	0xc8, 0xde, 0x86, 0x9c, 0x22, 0x1f, 0xda, 0xfe, 0x39, 0x64, 0x09, 0xa9, 0xd1, 0x97, 0x61, 0x56,
+	0xca, 0x32, 0x9a, 0x18, 0xe8, 0x0e, 0xe4, 0x1a, 0x89, 0x54, 0x57, 0x82, 0x2c, 0x56, 0x61, 0x27,
+	0x04, 0xd0, 0x23, 0xf4, 0x73, 0x0b, 0x32, 0x35, 0xdf, 0xa1, 0x0f, 0x62, 0xe8, 0x17, 0xcd, 0x32,
+	0x43, 0x6e, 0x68, 0xde, 0x81, 0x85, 0x2d, 0xc2, 0x3d, 0x97, 0x06, 0x62, 0x4c, 0x73, 0x78, 0x16,
+	0x07, 0x7d, 0x13, 0xe6, 0x1b, 0x94, 0xf0, 0x66, 0x5b, 0xf1, 0xaa, 0xf9, 0x47, 0x2c, 0x46, 0xc6,
+	0xcf, 0x47, 0x65, 0x2c, 0xde, 0x98, 0x5b, 0x35, 0x81, 0x56, 0x4d, 0x6e, 0xcc, 0x4a, 0x81, 0x8c,
+	0xe0, 0x52, 0xeb, 0x63, 0x28, 0xed, 0x4d, 0xc8, 0x24, 0xb4, 0x9d, 0x33, 0xc9, 0x89, 0x0c, 0x2d,
+	0x31, 0xb4, 0x6f, 0xaa, 0xf0, 0x33, 0x92, 0x99, 0xa7, 0x6b, 0x55, 0xe4, 0xc0, 0x4c, 0xad, 0x1a,
+	0x67, 0x54, 0x77, 0xd4, 0xa2, 0xf2, 0xe2, 0xf2, 0xcc, 0xf9, 0x99, 0x48, 0x4a, 0xf4, 0x43, 0x0b,
+	0x66, 0xde, 0xa2, 0x71, 0x61, 0xe7, 0x2e, 0x64, 0xde, 0xa2, 0x83, 0x98, 0xf3, 0xba, 0x61, 0x74,
+	0x3d, 0x01, 0x23, 0x45, 0x87, 0x35, 0xb9, 0x54, 0xe7, 0x7a, 0x53, 0xf4, 0x88, 0xa7, 0x2c, 0x2c,
+	0x8f, 0xcd, 0x08, 0x35, 0xc1, 0x6e, 0x50, 0x51, 0xf3, 0x9b, 0xac, 0xe3, 0xfa, 0x2d, 0x63, 0x3f,
+	0x31, 0x32, 0xad, 0x41, 0xce, 0x2c, 0x34, 0xc6, 0x32, 0x1f, 0x1a, 0xcb, 0x7d, 0x97, 0x4b, 0x54,
+	0x65, 0x2e, 0x29, 0x1c, 0xae, 0x32, 0x4c, 0xf6, 0x7a, 0xa2, 0xc5, 0x2e, 0x8e, 0xc9, 0x7f, 0x2d,
+	0xb8, 0xda, 0x20, 0x2d, 0xb2, 0x49, 0x3c, 0x6f, 0xbd, 0xd9, 0xa4, 0x5d, 0x71, 0x8f, 0x09, 0xf7,
+	0xc8, 0x6d, 0x12, 0xe1, 0x32, 0x7f, 0x7a, 0xd1, 0xfd, 0x1d, 0x58, 0xa8, 0x52, 0x41, 0x9a, 0x6d,
+	0xea, 0x9c, 0xe5, 0xd6, 0xe7, 0xc0, 0x7c, 0x16, 0x47, 0x26, 0x18, 0xa1, 0x56, 0x4a, 0x3a, 0xc1,
+	0x08, 0xb7, 0xff, 0x0d, 0x28, 0x34, 0xa8, 0xc0, 0x34, 0xe8, 0x79, 0x22, 0x89, 0x6b, 0xc9, 0x75,
+	0x03, 0xd7, 0x52, 0x54, 0x25, 0xc8, 0x6e, 0x1d, 0x53, 0x5f, 0x04, 0xe5, 0x25, 0x69, 0xd5, 0xd8,
+	0x8c, 0x50, 0x17, 0xf2, 0xeb, 0x4d, 0xe1, 0x1e, 0x8f, 0xec, 0xb4, 0x11, 0x8e, 0x4b, 0x9f, 0xc0,
+	0xb1, 0x34, 0xc4, 0x91, 0x03, 0x54, 0x29, 0x99, 0x2e, 0x4f, 0x1f, 0xb2, 0x6f, 0x77, 0x9d, 0xe9,
+	0xf1, 0xfb, 0x45, 0x1a, 0x8a, 0xdb, 0x54, 0xdc, 0x75, 0x3d, 0xd2, 0xa1, 0xfe, 0x74, 0xd3, 0x8f,
+	0x86, 0x20, 0x5c, 0xdc, 0xe5, 0xac, 0x33, 0x9a, 0x61, 0x0e, 0xe8, 0xed, 0x03, 0x99, 0xcb, 0x10,
+	0xe7, 0x6d, 0x5f, 0xb8, 0x5e, 0xb9, 0x34, 0x56, 0xee, 0x38, 0x00, 0x42, 0xbf, 0xb7, 0x60, 0x3e,
+	0x54, 0x4c, 0x83, 0xb6, 0xa6, 0xab, 0x9f, 0x3b, 0x90, 0xd3, 0x47, 0xaa, 0xfd, 0xa0, 0x78, 0xe3,
+	0x5a, 0x18, 0x79, 0x36, 0x59, 0xa7, 0xcb, 0x02, 0x57, 0xd0, 0x50, 0x36, 0xbd, 0x6e, 0x10, 0x89,
+	0x14, 0x15, 0xfa, 0x69, 0x1a, 0x2e, 0x6f, 0xd3, 0xfe, 0x65, 0x1c, 0x7f, 0xf7, 0x5e, 0x7c, 0x6e,
+	0x99, 0x1a, 0x25, 0xb7, 0x1c, 0x14, 0x05, 0xa5, 0x09, 0x14, 0x05, 0xe8, 0x97, 0x69, 0x28, 0xbe,
+	0xf8, 0x3a, 0xf9, 0xc4, 0x08, 0x1c, 0x09, 0x00, 0x57, 0x86, 0x02, 0xc0, 0xab, 0x30, 0xb7, 0xe7,
+	0x39, 0x34, 0x10, 0xbb, 0x3d, 0x41, 0x0e, 0x3d, 0xaa, 0xea, 0xa2, 0x3c, 0x1e, 0x9e, 0x44, 0xff,
+	0xb1, 0xc0, 0xde, 0x66, 0x62, 0x87, 0x89, 0x4d, 0xe6, 0x1f, 0xb9, 0xbc, 0x93, 0xe4, 0xda, 0x9a,
+	0x54, 0x76, 0xd0, 0x3f, 0xe8, 0xa5, 0x49, 0x54, 0x7f, 0x8b, 0x90, 0x69, 0x74, 0x3d, 0x57, 0x2b,
+	0x28, 0x8f, 0xf5, 0x40, 0xce, 0xee, 0x52, 0xde, 0xd2, 0x15, 0x66, 0x1e, 0xeb, 0x01, 0xfa, 0x93,
+	0x05, 0xa0, 0xf5, 0x34, 0x5d, 0x9b, 0xa8, 0x41, 0xde, 0xb0, 0x1d, 0xd1, 0x24, 0xfa, 0xe4, 0xe8,
+	0xef, 0x16, 0x2c, 0xa8, 0x6a, 0x53, 0xcf, 0x6c, 0x3d, 0x70, 0x03, 0x11, 0xbc, 0x88, 0x3b, 0x89,
+	0x58, 0x70, 0x29, 0x6a, 0xc1, 0xe8, 0x67, 0x69, 0x80, 0x1d, 0x66, 0xea, 0xe4, 0x60, 0xda, 0x36,
+	0x39, 0x89, 0xe0, 0x63, 0xbf, 0x0a, 0xb3, 0x55, 0xce, 0xba, 0x4a, 0x43, 0xc5, 0x1b, 0xb0, 0xaa,
+	0x7a, 0x3b, 0x72, 0xc6, 0x04, 0x6f, 0xf5, 0xd4, 0x5e, 0x81, 0x9c, 0x2a, 0x71, 0x68, 0x50, 0xbe,
+	0xb2, 0x3c, 0x73, 0x76, 0x19, 0x94, 0xc2, 0xe1, 0x1a, 0xf4, 0x9e, 0x05, 0x30, 0x08, 0xf4, 0x2f,
+	0x66, 0x40, 0x43, 0xbf, 0xb2, 0x20, 0x97, 0x6c, 0x07, 0x43, 0x6c, 0x17, 0xc7, 0x8c, 0xa3, 0x91,
+	0xfc, 0x7e, 0x29, 0x51, 0x7e, 0xff, 0x9e, 0x05, 0xc5, 0x06, 0xe5, 0xc7, 0x6e, 0x93, 0x56, 0x49,
+	0x6c, 0x27, 0xae, 0x02, 0x50, 0x67, 0xad, 0x03, 0x4e, 0x9a, 0x61, 0xc7, 0xa3, 0x80, 0x23, 0x33,
+	0xf6, 0x1e, 0xe4, 0xeb, 0xac, 0x55, 0xa7, 0xc7, 0x54, 0x57, 0x44, 0x73, 0x1b, 0x37, 0xcd, 0x56,
+	0xbe, 0x90, 0x60, 0x2b, 0x21, 0x29, 0xee, 0x83, 0xc8, 0x28, 0xaf, 0xb0, 0x1b, 0x5d, 0xe2, 0x4b,
+	0xf9, 0x8c, 0x0b, 0x0d, 0x4f, 0xa2, 0x7f, 0xa5, 0x61, 0x0e, 0x53, 0xd1, 0xe3, 0xbe, 0x76, 0xad,
+	0x38, 0x67, 0xaa, 0x43, 0xf6, 0x80, 0xf0, 0x16, 0x35, 0xa9, 0xfa, 0xa8, 0x6d, 0x43, 0x8d, 0x61,
+	0x1f, 0x00, 0x18, 0x6d, 0x62, 0x7a, 0x34, 0x56, 0x23, 0x32, 0x82, 0x23, 0x65, 0xc4, 0x94, 0x04,
+	0xcc, 0x1f, 0xab, 0x15, 0x69, 0x30, 0xe4, 0x35, 0x81, 0x69, 0xd7, 0x7b, 0x68, 0x2e, 0x51, 0x3d,
+	0x90, 0xb3, 0x2a, 0xc4, 0xaa, 0xbb, 0xb3, 0x80, 0xf5, 0xc0, 0x5e, 0x96, 0x09, 0x45, 0x40, 0x7d,
+	0x67, 0x93, 0xf5, 0x7c, 0xa1, 0xfa, 0x8a, 0x73, 0x38, 0x3a, 0x85, 0x7e, 0x67, 0x01, 0xc8, 0x82,
+	0x70, 0x97, 0x8a, 0x36, 0x73, 0x62, 0x94, 0xfd, 0xc6, 0xe9, 0x92, 0xf3, 0xca, 0xc0, 0xfb, 0x87,
+	0xea, 0xe3, 0xc1, 0x9d, 0xff, 0x75, 0x28, 0x46, 0x82, 0x8d, 0xb1, 0xa4, 0x51, 0x43, 0x55, 0x14,
+	0x0a, 0x7d, 0x90, 0x86, 0xf9, 0xad, 0x07, 0xb4, 0xd9, 0x13, 0x8c, 0x27, 0xb6, 0x15, 0xb9, 0x55,
+	0xca, 0xc7, 0xb3, 0x15, 0x8d, 0x61, 0x63, 0xe9, 0xec, 0x72, 0xf3, 0xe3, 0x9a, 0xca, 0x00, 0xc6,
+	0xbe, 0x05, 0x4b, 0x75, 0xd5, 0x5f, 0xdf, 0x21, 0xc1, 0x2e, 0xe3, 0xd4, 0x68, 0x31, 0x30, 0x29,
+	0xc1, 0xd9, 0x0f, 0xed, 0xaf, 0x42, 0x6e, 0x9f, 0xfa, 0x8e, 0xeb, 0xb7, 0xd4, 0xe9, 0x67, 0x36,
+	0xbe, 0x64, 0xe4, 0x58, 0x4b, 0xa2, 0x5f, 0x4d, 0xa9, 0x3a, 0x4e, 0x38, 0xc4, 0x91, 0xbd, 0x97,
+	0x79, 0xf3, 0xfb, 0xae, 0xeb, 0xbb, 0x41, 0x9b, 0xc6, 0xd9, 0x06, 0x86, 0x82, 0x0e, 0xbf, 0x52,
+	0x1d, 0xe3, 0xe8, 0x77, 0x00, 0x83, 0x7e, 0x3b, 0x03, 0x68, 0xdd, 0x71, 0x5c, 0x99, 0xe8, 0x11,
+	0x4f, 0xea, 0x5d, 0x96, 0x54, 0xfb, 0x9c, 0x1e, 0xbb, 0xac, 0x17, 0x84, 0x87, 0x1f, 0x23, 0xd8,
+	0xb7, 0x60, 0xbe, 0x8f, 0xa8, 0x59, 0x8c, 0x25, 0xde, 0x69, 0xb0, 0xa8, 0xf6, 0x97, 0x26, 0xa3,
+	0xfd, 0x53, 0x61, 0xa8, 0x34, 0xa1, 0x30, 0x14, 0xf1, 0xde, 0x2b, 0x09, 0xbd, 0xf7, 0xf6, 0xd0,
+	0x8d, 0xa2, 0xac, 0xab, 0x78, 0x63, 0x71, 0x35, 0x7c, 0xa7, 0x15, 0x79, 0x86, 0xa3, 0x0b, 0xd1,
+	0x6f, 0xd2, 0x70, 0xb9, 0x21, 0x5c, 0xcf, 0xd3, 0x67, 0x24, 0xf7, 0x34, 0x75, 0xeb, 0x91, 0xef,
+	0x80, 0x42, 0x13, 0x19, 0xcb, 0x3f, 0xfb, 0x28, 0xf6, 0xfd, 0x7e, 0x7d, 0x86, 0xe9, 0x91, 0x69,
+	0x57, 0x8c, 0x08, 0x1a, 0x05, 0x42, 0xff, 0xb0, 0x54, 0xa7, 0xc3, 0x1c, 0xff, 0x14, 0x53, 0xe3,
+	0x45, 0xc8, 0xe8, 0x9b, 0x41, 0xc5, 0x65, 0xac, 0x07, 0xf6, 0xd7, 0x60, 0xbe, 0xf1, 0xae, 0xdb,
+	0x7d, 0x76, 0xab, 0xe7, 0xe4, 0x73, 0x1a, 0x05, 0x1d, 0x43, 0x71, 0x87, 0x04, 0x53, 0xdf, 0x26,
+	0xba, 0x07, 0x97, 0x42, 0xa6, 0x09, 0x8a, 0xa8, 0xe5, 0x21, 0x29, 0x15, 0xef, 0x3c, 0x8e, 0x4e,
+	0xa1, 0x47, 0xaa, 0x50, 0xef, 0x7a, 0xc9, 0x5a, 0xa7, 0x9f, 0xce, 0x1a, 0x34, 0x92, 0xc9, 0x97,
+	0xe2, 0x33, 0x79, 0xfb, 0xf5, 0x41, 0xcf, 0x47, 0x27, 0xfe, 0x2f, 0x85, 0xcb, 0x77, 0x89, 0xa0,
+	0xdc, 0x8d, 0xa6, 0xa3, 0x6a, 0x59, 0xbf, 0xa0, 0x28, 0x3f, 0xaf, 0xa0, 0x40, 0x7f, 0xb5, 0x20,
+	0xbb, 0x4d, 0x45, 0x7c, 0x9f, 0x7f, 0x82, 0x56, 0x7f, 0x71, 0x39, 0xc9, 0x8f, 0x2c, 0x78, 0x65,
+	0xfd, 0x90, 0xf8, 0x0e, 0xf3, 0xfb, 0x4d, 0xe9, 0xe0, 0xff, 0xd2, 0x65, 0x47, 0x3f, 0xb0, 0x60,
+	0x71, 0x9b, 0x8a, 0xba, 0xdb, 0x6a, 0x8b, 0x9a, 0xef, 0x0a, 0x97, 0x78, 0x49, 0xde, 0x2a, 0x4d,
+	0xd4, 0xc8, 0xd0, 0xdf, 0xd2, 0xb0, 0x70, 0x5e, 0x09, 0x10, 0x5c, 0xba, 0x47, 0xc5, 0xf7, 0x18,
+	0x7f, 0x57, 0x35, 0x51, 0x8d, 0xff, 0x0d, 0xcd, 0xd9, 0x3b, 0x90, 0x55, 0x3e, 0x61, 0x1a, 0xf1,
+	0x23, 0xf8, 0x94, 0xa1, 0xb7, 0x3f, 0x07, 0x19, 0x69, 0x87, 0xa1, 0x13, 0x3c, 0x6b, 0xa6, 0xfa,
+	0xf1, 0x39, 0x0b, 0x5f, 0x7b, 0x25, 0x54, 0xa3, 0xb6, 0xfe, 0x85, 0x55, 0xfd, 0xf7, 0x0a, 0x35,
+	0xb7, 0xcf, 0x99, 0x60, 0x21, 0xba, 0x76, 0xc6, 0xeb, 0x30, 0xaf, 0xd4, 0xb4, 0xd9, 0x26, 0xae,
+	0x5f, 0x77, 0x3b, 0x6e, 0x98, 0xab, 0x9f, 0x9e, 0x46, 0x01, 0xe4, 0xb7, 0xa9, 0xd0, 0xef, 0x47,
+	0xa7, 0x66, 0x4b, 0x7f, 0x51, 0x95, 0x65, 0xff, 0x65, 0xe9, 0xf4, 0x3c, 0xb5, 0x0e, 0x19, 0xdd,
+	0x38, 0x1f, 0xd3, 0x1a, 0x75, 0xd3, 0xfc, 0x8f, 0x16, 0x14, 0xf4, 0x1b, 0x8c, 0xf8, 0x70, 0xd3,
+	0xf7, 0x83, 0xc5, 0x49, 0x04, 0xdb, 0xfe, 0x15, 0xb0, 0x34, 0xd6, 0x15, 0x20, 0x9d, 0x5a, 0x1e,
+	0xbf, 0x06, 0x7d, 0xfe, 0x06, 0x4e, 0x05, 0xb9, 0xf1, 0xb6, 0x31, 0x14, 0xe4, 0x0e, 0x20, 0x93,
+	0x44, 0x80, 0x95, 0xa8, 0x06, 0x63, 0x5d, 0x00, 0xfd, 0x41, 0x6f, 0x2d, 0x49, 0x84, 0x98, 0xa0,
+	0x81, 0x4d, 0xec, 0x8f, 0x16, 0x1f, 0xcd, 0xc0, 0xfc, 0x7d, 0xe2, 0xb9, 0xd2, 0xba, 0x92, 0x35,
+	0x8b, 0x2e, 0x22, 0xe5, 0xbd, 0x98, 0xfe, 0xc5, 0x1e, 0x14, 0x65, 0xcd, 0x15, 0x2a, 0xaa, 0x34,
+	0xca, 0x3f, 0x30, 0xa2, 0x08, 0xb2, 0x10, 0xdf, 0x27, 0x9c, 0xfa, 0xe6, 0x45, 0xc0, 0xa8, 0x85,
+	0xb8, 0xc6, 0xb0, 0x8f, 0xe0, 0xe5, 0x53, 0x6f, 0xce, 0x55, 0xca, 0x5a, 0x1e, 0x23, 0x3b, 0x3f,
+	0x0b, 0x70, 0xe3, 0xd6, 0xe3, 0x27, 0x95, 0xd4, 0xfb, 0x4f, 0x2a, 0xa9, 0x8f, 0x9f, 0x54, 0xac,
+	0xef, 0x9f, 0x54, 0xac, 0x5f, 0x9f, 0x54, 0xac, 0x47, 0x27, 0x15, 0xeb, 0xf1, 0x49, 0xc5, 0xfa,
+	0xe8, 0xa4, 0x62, 0xfd, 0xf3, 0xa4, 0x92, 0xfa, 0xf8, 0xa4, 0x62, 0xfd, 0xe4, 0x69, 0x25, 0xf5,
+	0xf8, 0x69, 0x25, 0xf5, 0xfe, 0xd3, 0x4a, 0xea, 0x30, 0xab, 0xfe, 0x1f, 0x77, 0xf3, 0x7f, 0x03,
+	0x00, 0xf3, 0x45, 0x63, 0x2d, 0x19, 0x28, 0x00, 0x00,
 }
 
 func (this *Meta) Equal(that interface{}) bool {
@@ -3406,6 +3546,14 @@ func (this *SetResult) Equal(that interface{}) bool {
 	if !bytes.Equal(this.Result, that1.Result) {
 		return false
 	}
+	if len(this.Events) != len(that1.Events) {
+		return false
+	}
+	for i := range this.Events {
+		if !bytes.Equal(this.Events[i], that1.Events[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *Activate) Equal(that interface{}) bool {
@@ -3436,6 +3584,14 @@ func (this *Activate) Equal(that interface{}) bool {
 	if !bytes.Equal(this.Result, that1.Result) {
 		return false
 	}
+	if len(this.Events) != len(that1.Events) {
+		return false
+	}
+	for i := range this.Events {
+		if !bytes.Equal(this.Events[i], that1.Events[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *Deactivate) Equal(that interface{}) bool {
@@ -3466,6 +3622,14 @@ func (this *Deactivate) Equal(that interface{}) bool {
 	if !bytes.Equal(this.Result, that1.Result) {
 		return false
 	}
+	if len(this.Events) != len(that1.Events) {
+		return false
+	}
+	for i := range this.Events {
+		if !bytes.Equal(this.Events[i], that1.Events[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *Update) Equal(that interface{}) bool {
@@ -3496,6 +3660,14 @@ func (this *Update) Equal(that interface{}) bool {
 	if !bytes.Equal(this.Result, that1.Result) {
 		return false
 	}
+	if len(this.Events) != len(that1.Events) {
+		return false
+	}
+	for i := range this.Events {
+		if !bytes.Equal(this.Events[i], that1.Events[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *GetFilament) Equal(that interface{}) bool {
@@ -3669,6 +3841,9 @@ func (this *GotHotConfirmation) Equal(that interface{}) bool {
 	if this.Split != that1.Split {
 		return false
 	}
+	if this.Merge != that1.Merge {
+		return false
+	}
 	return true
 }
 func (this *ResultInfo) Equal(that interface{}) bool {
@@ -4503,6 +4678,84 @@ func (this *Pulse) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *GetState) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GetState)
+	if !ok {
+		that2, ok := that.(GetState)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Polymorph != that1.Polymorph {
+		return false
+	}
+	if !this.ObjectID.Equal(that1.ObjectID) {
+		return false
+	}
+	if !this.StateID.Equal(that1.StateID) {
+		return false
+	}
+	return true
+}
+func (this *ValidateRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ValidateRequest)
+	if !ok {
+		that2, ok := that.(ValidateRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Polymorph != that1.Polymorph {
+		return false
+	}
+	if !this.ObjectRef.Equal(that1.ObjectRef) {
+		return false
+	}
+	if !this.RequestRef.Equal(that1.RequestRef) {
+		return false
+	}
+	if that1.PrevStateID == nil {
+		if this.PrevStateID != nil {
+			return false
+		}
+	} else if !this.PrevStateID.Equal(*that1.PrevStateID) {
+		return false
+	}
+	if !this.Parent.Equal(that1.Parent) {
+		return false
+	}
+	if len(this.OutgoingRequestRefs) != len(that1.OutgoingRequestRefs) {
+		return false
+	}
+	for i := range this.OutgoingRequestRefs {
+		if !this.OutgoingRequestRefs[i].Equal(that1.OutgoingRequestRefs[i]) {
+			return false
+		}
+	}
+	return true
+}
 func (this *Meta) GoString() string {
 	if this == nil {
 		return "nil"
@@ -4708,10 +4961,11 @@ func (this *SetResult) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 6)
+	s := make([]string, 0, 7)
 	s = append(s, "&payload.SetResult{")
 	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
 	s = append(s, "Result: "+fmt.Sprintf("%#v", this.Result)+",\n")
+	s = append(s, "Events: "+fmt.Sprintf("%#v", this.Events)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -4719,11 +4973,12 @@ func (this *Activate) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 7)
+	s := make([]string, 0, 8)
 	s = append(s, "&payload.Activate{")
 	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
 	s = append(s, "Record: "+fmt.Sprintf("%#v", this.Record)+",\n")
 	s = append(s, "Result: "+fmt.Sprintf("%#v", this.Result)+",\n")
+	s = append(s, "Events: "+fmt.Sprintf("%#v", this.Events)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -4731,11 +4986,12 @@ func (this *Deactivate) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 7)
+	s := make([]string, 0, 8)
 	s = append(s, "&payload.Deactivate{")
 	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
 	s = append(s, "Record: "+fmt.Sprintf("%#v", this.Record)+",\n")
 	s = append(s, "Result: "+fmt.Sprintf("%#v", this.Result)+",\n")
+	s = append(s, "Events: "+fmt.Sprintf("%#v", this.Events)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -4743,11 +4999,12 @@ func (this *Update) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 7)
+	s := make([]string, 0, 8)
 	s = append(s, "&payload.Update{")
 	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
 	s = append(s, "Record: "+fmt.Sprintf("%#v", this.Record)+",\n")
 	s = append(s, "Result: "+fmt.Sprintf("%#v", this.Result)+",\n")
+	s = append(s, "Events: "+fmt.Sprintf("%#v", this.Events)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -4814,12 +5071,13 @@ func (this *GotHotConfirmation) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 8)
+	s := make([]string, 0, 9)
 	s = append(s, "&payload.GotHotConfirmation{")
 	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
 	s = append(s, "JetID: "+fmt.Sprintf("%#v", this.JetID)+",\n")
 	s = append(s, "Pulse: "+fmt.Sprintf("%#v", this.Pulse)+",\n")
 	s = append(s, "Split: "+fmt.Sprintf("%#v", this.Split)+",\n")
+	s = append(s, "Merge: "+fmt.Sprintf("%#v", this.Merge)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -5173,6 +5431,33 @@ func (this *Pulse) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *GetState) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&payload.GetState{")
+	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
+	s = append(s, "ObjectID: "+fmt.Sprintf("%#v", this.ObjectID)+",\n")
+	s = append(s, "StateID: "+fmt.Sprintf("%#v", this.StateID)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ValidateRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&payload.ValidateRequest{")
+	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
+	s = append(s, "ObjectRef: "+fmt.Sprintf("%#v", this.ObjectRef)+",\n")
+	s = append(s, "RequestRef: "+fmt.Sprintf("%#v", this.RequestRef)+",\n")
+	s = append(s, "PrevStateID: "+fmt.Sprintf("%#v", this.PrevStateID)+",\n")
+	s = append(s, "Parent: "+fmt.Sprintf("%#v", this.Parent)+",\n")
+	s = append(s, "OutgoingRequestRefs: "+fmt.Sprintf("%#v", this.OutgoingRequestRefs)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func valueToGoStringPayload(v interface{}, typ string) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -5915,6 +6200,16 @@ func (m *SetResult) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintPayload(dAtA, i, uint64(len(m.Result)))
 		i += copy(dAtA[i:], m.Result)
 	}
+	if len(m.Events) > 0 {
+		for _, b := range m.Events {
+			dAtA[i] = 0xaa
+			i++
+			dAtA[i] = 0x1
+			i++
+			i = encodeVarintPayload(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
+	}
 	return i, nil
 }
 
@@ -5956,6 +6251,16 @@ func (m *Activate) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintPayload(dAtA, i, uint64(len(m.Result)))
 		i += copy(dAtA[i:], m.Result)
 	}
+	if len(m.Events) > 0 {
+		for _, b := range m.Events {
+			dAtA[i] = 0xb2
+			i++
+			dAtA[i] = 0x1
+			i++
+			i = encodeVarintPayload(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
+	}
 	return i, nil
 }
 
@@ -5997,6 +6302,16 @@ func (m *Deactivate) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintPayload(dAtA, i, uint64(len(m.Result)))
 		i += copy(dAtA[i:], m.Result)
 	}
+	if len(m.Events) > 0 {
+		for _, b := range m.Events {
+			dAtA[i] = 0xb2
+			i++
+			dAtA[i] = 0x1
+			i++
+			i = encodeVarintPayload(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
+	}
 	return i, nil
 }
 
@@ -6038,6 +6353,16 @@ func (m *Update) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintPayload(dAtA, i, uint64(len(m.Result)))
 		i += copy(dAtA[i:], m.Result)
 	}
+	if len(m.Events) > 0 {
+		for _, b := range m.Events {
+			dAtA[i] = 0xb2
+			i++
+			dAtA[i] = 0x1
+			i++
+			i = encodeVarintPayload(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
+	}
 	return i, nil
 }
 
@@ -6327,6 +6652,18 @@ func (m *GotHotConfirmation) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i++
 	}
+	if m.Merge {
+		dAtA[i] = 0xb8
+		i++
+		dAtA[i] = 0x1
+		i++
+		if m.Merge {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
 	return i, nil
 }
 
@@ -7605,18 +7942,144 @@ func (m *Pulse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func encodeVarintPayload(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *GetState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+	return dAtA[:n], nil
 }
-func (m *Meta) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *GetState) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Polymorph != 0 {
+		dAtA[i] = 0x80
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPayload(dAtA, i, uint64(m.Polymorph))
+	}
+	dAtA[i] = 0xa2
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintPayload(dAtA, i, uint64(m.ObjectID.Size()))
+	n68, err := m.ObjectID.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n68
+	dAtA[i] = 0xaa
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintPayload(dAtA, i, uint64(m.StateID.Size()))
+	n69, err := m.StateID.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n69
+	return i, nil
+}
+
+func (m *ValidateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidateRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Polymorph != 0 {
+		dAtA[i] = 0x80
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPayload(dAtA, i, uint64(m.Polymorph))
+	}
+	dAtA[i] = 0xa2
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintPayload(dAtA, i, uint64(m.ObjectRef.Size()))
+	n70, err := m.ObjectRef.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n70
+	dAtA[i] = 0xaa
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintPayload(dAtA, i, uint64(m.RequestRef.Size()))
+	n71, err := m.RequestRef.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n71
+	if m.PrevStateID != nil {
+		dAtA[i] = 0xb2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPayload(dAtA, i, uint64(m.PrevStateID.Size()))
+		n72, err := m.PrevStateID.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n72
+	}
+	dAtA[i] = 0xba
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintPayload(dAtA, i, uint64(m.Parent.Size()))
+	n73, err := m.Parent.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n73
+	if len(m.OutgoingRequestRefs) > 0 {
+		for _, msg := range m.OutgoingRequestRefs {
+			dAtA[i] = 0xc2
+			i++
+			dAtA[i] = 0x1
+			i++
+			i = encodeVarintPayload(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func encodeVarintPayload(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *Meta) Size() (n int) {
+	if m == nil {
+		return 0
 	}
 	var l int
 	_ = l
@@ -7921,6 +8384,12 @@ func (m *SetResult) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovPayload(uint64(l))
 	}
+	if len(m.Events) > 0 {
+		for _, b := range m.Events {
+			l = len(b)
+			n += 2 + l + sovPayload(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -7941,6 +8410,12 @@ func (m *Activate) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovPayload(uint64(l))
 	}
+	if len(m.Events) > 0 {
+		for _, b := range m.Events {
+			l = len(b)
+			n += 2 + l + sovPayload(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -7961,6 +8436,12 @@ func (m *Deactivate) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovPayload(uint64(l))
 	}
+	if len(m.Events) > 0 {
+		for _, b := range m.Events {
+			l = len(b)
+			n += 2 + l + sovPayload(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -7981,6 +8462,12 @@ func (m *Update) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovPayload(uint64(l))
 	}
+	if len(m.Events) > 0 {
+		for _, b := range m.Events {
+			l = len(b)
+			n += 2 + l + sovPayload(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -8083,6 +8570,9 @@ func (m *GotHotConfirmation) Size() (n int) {
 	if m.Split {
 		n += 3
 	}
+	if m.Merge {
+		n += 3
+	}
 	return n
 }
 
@@ -8573,6 +9063,50 @@ func (m *Pulse) Size() (n int) {
 	return n
 }
 
+func (m *GetState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Polymorph != 0 {
+		n += 2 + sovPayload(uint64(m.Polymorph))
+	}
+	l = m.ObjectID.Size()
+	n += 2 + l + sovPayload(uint64(l))
+	l = m.StateID.Size()
+	n += 2 + l + sovPayload(uint64(l))
+	return n
+}
+
+func (m *ValidateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Polymorph != 0 {
+		n += 2 + sovPayload(uint64(m.Polymorph))
+	}
+	l = m.ObjectRef.Size()
+	n += 2 + l + sovPayload(uint64(l))
+	l = m.RequestRef.Size()
+	n += 2 + l + sovPayload(uint64(l))
+	if m.PrevStateID != nil {
+		l = m.PrevStateID.Size()
+		n += 2 + l + sovPayload(uint64(l))
+	}
+	l = m.Parent.Size()
+	n += 2 + l + sovPayload(uint64(l))
+	if len(m.OutgoingRequestRefs) > 0 {
+		for _, e := range m.OutgoingRequestRefs {
+			l = e.Size()
+			n += 2 + l + sovPayload(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovPayload(x uint64) (n int) {
 	for {
 		n++
@@ -8792,6 +9326,7 @@ func (this *SetResult) String() string {
 	s := strings.Join([]string{`&SetResult{`,
 		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
 		`Result:` + fmt.Sprintf("%v", this.Result) + `,`,
+		`Events:` + fmt.Sprintf("%v", this.Events) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -8804,6 +9339,7 @@ func (this *Activate) String() string {
 		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
 		`Record:` + fmt.Sprintf("%v", this.Record) + `,`,
 		`Result:` + fmt.Sprintf("%v", this.Result) + `,`,
+		`Events:` + fmt.Sprintf("%v", this.Events) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -8816,6 +9352,7 @@ func (this *Deactivate) String() string {
 		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
 		`Record:` + fmt.Sprintf("%v", this.Record) + `,`,
 		`Result:` + fmt.Sprintf("%v", this.Result) + `,`,
+		`Events:` + fmt.Sprintf("%v", this.Events) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -8828,6 +9365,7 @@ func (this *Update) String() string {
 		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
 		`Record:` + fmt.Sprintf("%v", this.Record) + `,`,
 		`Result:` + fmt.Sprintf("%v", this.Result) + `,`,
+		`Events:` + fmt.Sprintf("%v", this.Events) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -8894,6 +9432,7 @@ func (this *GotHotConfirmation) String() string {
 		`JetID:` + fmt.Sprintf("%v", this.JetID) + `,`,
 		`Pulse:` + fmt.Sprintf("%v", this.Pulse) + `,`,
 		`Split:` + fmt.Sprintf("%v", this.Split) + `,`,
+		`Merge:` + fmt.Sprintf("%v", this.Merge) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -9212,6 +9751,33 @@ func (this *Pulse) String() string {
 	}, "")
 	return s
 }
+func (this *GetState) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetState{`,
+		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
+		`ObjectID:` + fmt.Sprintf("%v", this.ObjectID) + `,`,
+		`StateID:` + fmt.Sprintf("%v", this.StateID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ValidateRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ValidateRequest{`,
+		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
+		`ObjectRef:` + fmt.Sprintf("%v", this.ObjectRef) + `,`,
+		`RequestRef:` + fmt.Sprintf("%v", this.RequestRef) + `,`,
+		`PrevStateID:` + fmt.Sprintf("%v", this.PrevStateID) + `,`,
+		`Parent:` + fmt.Sprintf("%v", this.Parent) + `,`,
+		`OutgoingRequestRefs:` + fmt.Sprintf("%v", this.OutgoingRequestRefs) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringPayload(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -11473,6 +12039,38 @@ func (m *SetResult) Unmarshal(dAtA []byte) error {
 				m.Result = []byte{}
 			}
 			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Events = append(m.Events, make([]byte, postIndex-iNdEx))
+			copy(m.Events[len(m.Events)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPayload(dAtA[iNdEx:])
@@ -11613,6 +12211,38 @@ func (m *Activate) Unmarshal(dAtA []byte) error {
 				m.Result = []byte{}
 			}
 			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Events = append(m.Events, make([]byte, postIndex-iNdEx))
+			copy(m.Events[len(m.Events)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPayload(dAtA[iNdEx:])
@@ -11753,24 +12383,56 @@ func (m *Deactivate) Unmarshal(dAtA []byte) error {
 				m.Result = []byte{}
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPayload(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPayload
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthPayload
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Events = append(m.Events, make([]byte, postIndex-iNdEx))
+			copy(m.Events[len(m.Events)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPayload(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
@@ -11893,6 +12555,38 @@ func (m *Update) Unmarshal(dAtA []byte) error {
 				m.Result = []byte{}
 			}
 			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Events = append(m.Events, make([]byte, postIndex-iNdEx))
+			copy(m.Events[len(m.Events)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPayload(dAtA[iNdEx:])
@@ -12758,6 +13452,26 @@ func (m *GotHotConfirmation) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Split = bool(v != 0)
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Merge", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Merge = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPayload(dAtA[iNdEx:])
@@ -16564,6 +17278,385 @@ func (m *Pulse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *GetState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPayload
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Polymorph", wireType)
+			}
+			m.Polymorph = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Polymorph |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StateID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPayload(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPayload
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Polymorph", wireType)
+			}
+			m.Polymorph = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Polymorph |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectRef", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestRef", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RequestRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevStateID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_insolar_insolar_insolar.ID
+			m.PrevStateID = &v
+			if err := m.PrevStateID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Parent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutgoingRequestRefs", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_insolar_insolar_insolar.Reference
+			m.OutgoingRequestRefs = append(m.OutgoingRequestRefs, v)
+			if err := m.OutgoingRequestRefs[len(m.OutgoingRequestRefs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPayload(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipPayload(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -43,7 +43,7 @@ func (entropy *Entropy) Unmarshal(data []byte) error {
 	return nil
 }
 func (entropy *Entropy) UnmarshalJSON(data []byte) error {
-	return json.Unmarshal(data, entropy)
+	return json.Unmarshal(data, (*[EntropySize]byte)(entropy))
 }
 func (entropy Entropy) Size() int { return EntropySize }
 func (entropy Entropy) Compare(other Entropy) int {
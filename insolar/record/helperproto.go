@@ -65,6 +65,12 @@ func Wrap(record Record) Virtual {
 				PendingFilament: generic,
 			},
 		}
+	case *Event:
+		return Virtual{
+			Union: &Virtual_Event{
+				Event: generic,
+			},
+		}
 	default:
 		panic(fmt.Sprintf("%T record is not registered", generic))
 	}
@@ -93,6 +99,8 @@ func Unwrap(v *Virtual) Record {
 		return r.Deactivate
 	case *Virtual_PendingFilament:
 		return r.PendingFilament
+	case *Virtual_Event:
+		return r.Event
 	case nil:
 		return nil
 	default:
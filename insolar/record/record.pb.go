@@ -451,6 +451,48 @@ func (m *PendingFilament) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PendingFilament proto.InternalMessageInfo
 
+type Event struct {
+	Polymorph int32                                        `protobuf:"varint,16,opt,name=polymorph,proto3" json:"polymorph,omitempty"`
+	Object    github_com_insolar_insolar_insolar.ID        `protobuf:"bytes,20,opt,name=Object,proto3,customtype=github.com/insolar/insolar/insolar.ID" json:"Object"`
+	Request   github_com_insolar_insolar_insolar.Reference `protobuf:"bytes,21,opt,name=Request,proto3,customtype=github.com/insolar/insolar/insolar.Reference" json:"Request"`
+	Prototype github_com_insolar_insolar_insolar.Reference `protobuf:"bytes,22,opt,name=Prototype,proto3,customtype=github.com/insolar/insolar/insolar.Reference" json:"Prototype"`
+	Topic     string                                       `protobuf:"bytes,23,opt,name=Topic,proto3" json:"Topic,omitempty"`
+	Payload   []byte                                       `protobuf:"bytes,24,opt,name=Payload,proto3" json:"Payload,omitempty"`
+	Index     uint32                                       `protobuf:"varint,25,opt,name=Index,proto3" json:"Index,omitempty"`
+}
+
+func (m *Event) Reset()      { *m = Event{} }
+func (*Event) ProtoMessage() {}
+func (*Event) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c86cc3f6f53fe45, []int{9}
+}
+func (m *Event) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Event) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Event.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Event) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Event.Merge(m, src)
+}
+func (m *Event) XXX_Size() int {
+	return m.Size()
+}
+func (m *Event) XXX_DiscardUnknown() {
+	xxx_messageInfo_Event.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Event proto.InternalMessageInfo
+
 type Lifeline struct {
 	Polymorph   int32                                        `protobuf:"varint,16,opt,name=Polymorph,proto3" json:"Polymorph,omitempty"`
 	LatestState *github_com_insolar_insolar_insolar.ID       `protobuf:"bytes,20,opt,name=LatestState,proto3,customtype=github.com/insolar/insolar/insolar.ID" json:"LatestState,omitempty"`
@@ -467,7 +509,7 @@ type Lifeline struct {
 func (m *Lifeline) Reset()      { *m = Lifeline{} }
 func (*Lifeline) ProtoMessage() {}
 func (*Lifeline) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c86cc3f6f53fe45, []int{9}
+	return fileDescriptor_0c86cc3f6f53fe45, []int{10}
 }
 func (m *Lifeline) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -507,7 +549,7 @@ type Index struct {
 func (m *Index) Reset()      { *m = Index{} }
 func (*Index) ProtoMessage() {}
 func (*Index) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c86cc3f6f53fe45, []int{10}
+	return fileDescriptor_0c86cc3f6f53fe45, []int{11}
 }
 func (m *Index) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -548,6 +590,7 @@ type Virtual struct {
 	//	*Virtual_Amend
 	//	*Virtual_Deactivate
 	//	*Virtual_PendingFilament
+	//	*Virtual_Event
 	Union     isVirtual_Union `protobuf_oneof:"union"`
 	Signature []byte          `protobuf:"bytes,200,opt,name=Signature,proto3" json:"Signature,omitempty"`
 }
@@ -555,7 +598,7 @@ type Virtual struct {
 func (m *Virtual) Reset()      { *m = Virtual{} }
 func (*Virtual) ProtoMessage() {}
 func (*Virtual) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c86cc3f6f53fe45, []int{11}
+	return fileDescriptor_0c86cc3f6f53fe45, []int{12}
 }
 func (m *Virtual) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -618,6 +661,9 @@ type Virtual_Deactivate struct {
 type Virtual_PendingFilament struct {
 	PendingFilament *PendingFilament `protobuf:"bytes,109,opt,name=PendingFilament,proto3,oneof"`
 }
+type Virtual_Event struct {
+	Event *Event `protobuf:"bytes,110,opt,name=Event,proto3,oneof"`
+}
 
 func (*Virtual_Genesis) isVirtual_Union()         {}
 func (*Virtual_IncomingRequest) isVirtual_Union() {}
@@ -628,6 +674,7 @@ func (*Virtual_Activate) isVirtual_Union()        {}
 func (*Virtual_Amend) isVirtual_Union()           {}
 func (*Virtual_Deactivate) isVirtual_Union()      {}
 func (*Virtual_PendingFilament) isVirtual_Union() {}
+func (*Virtual_Event) isVirtual_Union()           {}
 
 func (m *Virtual) GetUnion() isVirtual_Union {
 	if m != nil {
@@ -699,6 +746,13 @@ func (m *Virtual) GetPendingFilament() *PendingFilament {
 	return nil
 }
 
+func (m *Virtual) GetEvent() *Event {
+	if x, ok := m.GetUnion().(*Virtual_Event); ok {
+		return x.Event
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*Virtual) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _Virtual_OneofMarshaler, _Virtual_OneofUnmarshaler, _Virtual_OneofSizer, []interface{}{
@@ -711,6 +765,7 @@ func (*Virtual) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error
 		(*Virtual_Amend)(nil),
 		(*Virtual_Deactivate)(nil),
 		(*Virtual_PendingFilament)(nil),
+		(*Virtual_Event)(nil),
 	}
 }
 
@@ -763,6 +818,11 @@ func _Virtual_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		if err := b.EncodeMessage(x.PendingFilament); err != nil {
 			return err
 		}
+	case *Virtual_Event:
+		_ = b.EncodeVarint(110<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Event); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("Virtual.Union has unexpected type %T", x)
@@ -845,6 +905,14 @@ func _Virtual_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer
 		err := b.DecodeMessage(msg)
 		m.Union = &Virtual_PendingFilament{msg}
 		return true, err
+	case 110: // union.Event
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Event)
+		err := b.DecodeMessage(msg)
+		m.Union = &Virtual_Event{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -899,6 +967,11 @@ func _Virtual_OneofSizer(msg proto.Message) (n int) {
 		n += 2 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *Virtual_Event:
+		s := proto.Size(x.Event)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -918,7 +991,7 @@ type Material struct {
 func (m *Material) Reset()      { *m = Material{} }
 func (*Material) ProtoMessage() {}
 func (*Material) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c86cc3f6f53fe45, []int{12}
+	return fileDescriptor_0c86cc3f6f53fe45, []int{13}
 }
 func (m *Material) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -958,7 +1031,7 @@ type CompositeFilamentRecord struct {
 func (m *CompositeFilamentRecord) Reset()      { *m = CompositeFilamentRecord{} }
 func (*CompositeFilamentRecord) ProtoMessage() {}
 func (*CompositeFilamentRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c86cc3f6f53fe45, []int{13}
+	return fileDescriptor_0c86cc3f6f53fe45, []int{14}
 }
 func (m *CompositeFilamentRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -999,6 +1072,7 @@ func init() {
 	proto.RegisterType((*Amend)(nil), "record.Amend")
 	proto.RegisterType((*Deactivate)(nil), "record.Deactivate")
 	proto.RegisterType((*PendingFilament)(nil), "record.PendingFilament")
+	proto.RegisterType((*Event)(nil), "record.Event")
 	proto.RegisterType((*Lifeline)(nil), "record.Lifeline")
 	proto.RegisterType((*Index)(nil), "record.Index")
 	proto.RegisterType((*Virtual)(nil), "record.Virtual")
@@ -1009,92 +1083,94 @@ func init() {
 func init() { proto.RegisterFile("insolar/record/record.proto", fileDescriptor_0c86cc3f6f53fe45) }
 
 var fileDescriptor_0c86cc3f6f53fe45 = []byte{
-	// 1346 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xdf, 0x75, 0xed, 0xd8, 0x79, 0x89, 0x13, 0x77, 0x48, 0x9b, 0xa5, 0x7f, 0x36, 0xc6, 0xa8,
-	0x92, 0x29, 0xad, 0x53, 0x95, 0xaa, 0x42, 0x48, 0x1c, 0xfc, 0xa7, 0xc5, 0x5b, 0xf2, 0xc7, 0x4c,
-	0x52, 0xc4, 0x09, 0x69, 0x6c, 0x4f, 0xec, 0x2d, 0xeb, 0x5d, 0xb3, 0x3b, 0x5b, 0x91, 0x1b, 0x1f,
-	0x81, 0x8f, 0xc0, 0x05, 0xd4, 0x6f, 0x42, 0x84, 0x38, 0xb4, 0xb7, 0x82, 0x44, 0x21, 0xee, 0xa5,
-	0xc7, 0x7e, 0x04, 0xb4, 0x33, 0xb3, 0x7f, 0xe2, 0x00, 0x4e, 0xec, 0xaa, 0x1c, 0xe8, 0x69, 0x67,
-	0xde, 0xcc, 0xfb, 0xcd, 0xbc, 0x37, 0xef, 0xf7, 0xde, 0xcc, 0xc2, 0x45, 0xd3, 0xf6, 0x1c, 0x8b,
-	0xb8, 0xeb, 0x2e, 0xed, 0x38, 0x6e, 0x57, 0x7e, 0x2a, 0x43, 0xd7, 0x61, 0x0e, 0x9a, 0x13, 0xbd,
-	0x0b, 0xd7, 0x7b, 0x26, 0xeb, 0xfb, 0xed, 0x4a, 0xc7, 0x19, 0xac, 0xf7, 0x9c, 0x9e, 0xb3, 0xce,
-	0x87, 0xdb, 0xfe, 0x1e, 0xef, 0xf1, 0x0e, 0x6f, 0x09, 0xb5, 0x52, 0x15, 0xb2, 0x9f, 0x50, 0x9b,
-	0x7a, 0xa6, 0x87, 0x2e, 0xc1, 0xfc, 0xd0, 0xb1, 0xf6, 0x07, 0x8e, 0x3b, 0xec, 0x6b, 0x85, 0xa2,
-	0x5a, 0xce, 0xe0, 0x58, 0x80, 0x10, 0xa4, 0x9b, 0xc4, 0xeb, 0x6b, 0x2b, 0x45, 0xb5, 0xbc, 0x88,
-	0x79, 0xfb, 0xa3, 0xf4, 0xa3, 0xef, 0xd7, 0xd4, 0xd2, 0x93, 0x39, 0x58, 0x36, 0xec, 0x8e, 0x33,
-	0x30, 0xed, 0x1e, 0xa6, 0x5f, 0xfb, 0xd4, 0x63, 0x13, 0xb0, 0xae, 0x41, 0xae, 0x4e, 0x2c, 0x6b,
-	0x77, 0x7f, 0x48, 0x39, 0xde, 0xd2, 0xcd, 0x42, 0x45, 0x1a, 0x13, 0xca, 0x71, 0x34, 0x03, 0x6d,
-	0xc0, 0x5c, 0xd0, 0xa6, 0xae, 0x76, 0x2e, 0x58, 0xbb, 0x76, 0xeb, 0xe0, 0xd9, 0x9a, 0xf2, 0xdb,
-	0xb3, 0xb5, 0x6b, 0x09, 0x4b, 0x43, 0xcf, 0x8c, 0x7d, 0x2b, 0x98, 0xee, 0x51, 0x97, 0xda, 0x1d,
-	0x8a, 0x25, 0x06, 0xfa, 0x12, 0x96, 0x45, 0xab, 0x15, 0xd8, 0xcf, 0x82, 0x2d, 0x9c, 0x9f, 0x01,
-	0x76, 0x1c, 0x0c, 0xad, 0x40, 0x66, 0xcb, 0xb1, 0x3b, 0x54, 0x5b, 0x2d, 0xaa, 0xe5, 0x34, 0x16,
-	0x1d, 0x74, 0x13, 0x00, 0x53, 0xe6, 0xbb, 0xf6, 0xa6, 0xd3, 0xa5, 0xda, 0xdb, 0xdc, 0x66, 0x14,
-	0xda, 0x1c, 0x8f, 0xe0, 0xc4, 0xac, 0xc0, 0x87, 0xc6, 0x60, 0xe0, 0x33, 0xd2, 0xb6, 0xa8, 0x76,
-	0xa1, 0xa8, 0x96, 0x73, 0x38, 0x16, 0xa0, 0x06, 0xa4, 0x6b, 0xc4, 0xa3, 0xda, 0x45, 0xbe, 0xf9,
-	0x1b, 0xa7, 0xde, 0x38, 0xd7, 0x46, 0x4d, 0x98, 0xdb, 0x6e, 0x3f, 0xa0, 0x1d, 0xa6, 0x5d, 0x9a,
-	0x12, 0x47, 0xea, 0xa3, 0x2d, 0x98, 0x8f, 0x3d, 0x7a, 0x79, 0x4a, 0xb0, 0x18, 0x02, 0x9d, 0x87,
-	0xb9, 0x4d, 0xca, 0xfa, 0x4e, 0x57, 0xd3, 0x8b, 0x6a, 0x79, 0x1e, 0xcb, 0x5e, 0xe0, 0x95, 0xaa,
-	0xdb, 0xf3, 0x07, 0xd4, 0x66, 0x9e, 0xb6, 0xc6, 0x83, 0x31, 0x16, 0xa0, 0x12, 0x2c, 0x56, 0x5b,
-	0x86, 0x8c, 0x42, 0xa3, 0xa1, 0xbd, 0xc3, 0x75, 0x8f, 0xc8, 0x82, 0x78, 0xc2, 0x94, 0x78, 0x8e,
-	0xad, 0x95, 0x66, 0x89, 0x27, 0x81, 0x81, 0xb6, 0x20, 0x5b, 0x6d, 0x19, 0x5b, 0xc1, 0xb1, 0xbe,
-	0x3b, 0x03, 0x5c, 0x08, 0x92, 0xe0, 0xd4, 0xb6, 0xcf, 0x7a, 0xce, 0x1b, 0x4e, 0xbd, 0xe1, 0xd4,
-	0x1b, 0x4e, 0xbd, 0x12, 0x4e, 0xfd, 0xae, 0x06, 0x9b, 0xf4, 0x7c, 0x6b, 0x12, 0x95, 0xee, 0x44,
-	0x07, 0xc8, 0x8b, 0x5d, 0xed, 0xba, 0x5c, 0xfd, 0xca, 0x09, 0x56, 0x37, 0x1a, 0x89, 0xd3, 0xcb,
-	0x4a, 0x07, 0xcd, 0x44, 0xb2, 0x10, 0x04, 0x69, 0x90, 0x6d, 0x91, 0x7d, 0xcb, 0x21, 0x5d, 0xc1,
-	0x2e, 0x1c, 0x76, 0xa5, 0x7d, 0x2f, 0x54, 0x48, 0xd7, 0x65, 0x90, 0xff, 0x8b, 0x75, 0x89, 0x6d,
-	0xad, 0xbc, 0x8a, 0x6d, 0x21, 0xb1, 0xaa, 0xb0, 0x11, 0x8b, 0x1d, 0x7c, 0x01, 0x0b, 0x9b, 0xa4,
-	0xd3, 0x37, 0x6d, 0xba, 0x1b, 0x26, 0x83, 0x7c, 0xed, 0xb6, 0x5c, 0xa7, 0x72, 0x82, 0x75, 0x12,
-	0xda, 0x38, 0x09, 0x25, 0x4d, 0xfd, 0x25, 0x05, 0xb9, 0x6a, 0x87, 0x99, 0x0f, 0x09, 0x7b, 0xdd,
-	0xe6, 0x72, 0x0e, 0x0d, 0x1c, 0x77, 0x5f, 0x1a, 0x2c, 0x7b, 0xe8, 0x1e, 0x64, 0x8c, 0x01, 0xe9,
-	0xcd, 0x96, 0xf9, 0x04, 0x04, 0x2a, 0xc2, 0x82, 0xe1, 0xc5, 0xcc, 0x5f, 0xe5, 0x79, 0x2a, 0x29,
-	0x0a, 0xf8, 0xd6, 0x22, 0x2e, 0xb5, 0x99, 0xa6, 0xcd, 0xc2, 0x37, 0x81, 0x51, 0x3a, 0x48, 0x41,
-	0xa6, 0x3a, 0xa0, 0x76, 0xf7, 0x7f, 0xe9, 0xcb, 0x4f, 0x83, 0x2c, 0x4b, 0x1f, 0xee, 0x30, 0xc2,
-	0xa8, 0x74, 0xe7, 0x29, 0x19, 0x1f, 0xeb, 0x97, 0x7e, 0x52, 0x01, 0x1a, 0x94, 0xfc, 0x37, 0xb1,
-	0x79, 0xc4, 0x92, 0x73, 0x33, 0x5a, 0xf2, 0x44, 0x85, 0xe5, 0x16, 0xb5, 0xbb, 0xa6, 0xdd, 0xbb,
-	0x6b, 0x5a, 0x24, 0xa8, 0x05, 0x13, 0xcc, 0x31, 0x20, 0x87, 0x79, 0xf5, 0x35, 0x1a, 0xd3, 0x65,
-	0xce, 0x48, 0x1d, 0xdd, 0x87, 0xa5, 0x60, 0x27, 0xa6, 0xe3, 0x7b, 0x42, 0x96, 0x30, 0x47, 0x3d,
-	0x39, 0xe0, 0x18, 0x48, 0xe9, 0x8f, 0x33, 0x90, 0xdb, 0x30, 0xf7, 0xa8, 0x65, 0xda, 0xfc, 0x6c,
-	0x5a, 0xe3, 0xc6, 0x44, 0x02, 0xb4, 0x0d, 0x0b, 0x1b, 0x84, 0x51, 0x8f, 0x09, 0x6f, 0xae, 0x4c,
-	0xb3, 0x7c, 0x12, 0x01, 0xbd, 0x07, 0x59, 0xde, 0x30, 0x1a, 0xdc, 0x96, 0x7c, 0x6d, 0x59, 0x3a,
-	0x27, 0x14, 0xe3, 0xb0, 0x91, 0x60, 0xf7, 0xf9, 0xd9, 0xd9, 0x8d, 0x76, 0x20, 0x2f, 0xf6, 0x11,
-	0xc6, 0xda, 0xea, 0x34, 0xb6, 0x1c, 0xc5, 0x40, 0x7d, 0x78, 0xeb, 0x0e, 0x71, 0x2d, 0x93, 0x7a,
-	0x6c, 0x7b, 0x48, 0xed, 0x10, 0x5a, 0xd0, 0xe7, 0xb6, 0x84, 0x3e, 0x49, 0xa6, 0x6f, 0xf9, 0x96,
-	0x47, 0xb7, 0xfc, 0x41, 0x9b, 0xba, 0xf8, 0xef, 0x20, 0xd1, 0x35, 0x38, 0x9b, 0xe8, 0x7a, 0x75,
-	0xc7, 0xb7, 0x19, 0xbf, 0xed, 0xe5, 0xf1, 0xf1, 0x81, 0xd2, 0xaf, 0x29, 0xc8, 0x18, 0x76, 0x97,
-	0x7e, 0x33, 0xe1, 0x78, 0xeb, 0x90, 0xd9, 0x6e, 0x3f, 0x98, 0x36, 0x50, 0x85, 0x2e, 0xba, 0x19,
-	0x47, 0x13, 0x3f, 0xd3, 0x85, 0xf8, 0xce, 0x1d, 0xca, 0x6b, 0xe9, 0x00, 0x19, 0xc7, 0x51, 0xd7,
-	0x86, 0x42, 0xd8, 0xde, 0x20, 0x1e, 0xbb, 0xef, 0xd1, 0xee, 0x14, 0xf5, 0x31, 0xe9, 0xb5, 0x63,
-	0x78, 0x9c, 0x3d, 0x82, 0xb9, 0x22, 0xee, 0x3d, 0x6d, 0xb5, 0x78, 0xe6, 0xf4, 0x56, 0x8e, 0x81,
-	0x94, 0x7e, 0x4c, 0x43, 0xf6, 0x73, 0xd3, 0x65, 0x3e, 0xb1, 0x26, 0x64, 0x82, 0xf7, 0xa3, 0xbf,
-	0x0a, 0x1a, 0xe5, 0x7e, 0x59, 0x0e, 0xfd, 0x22, 0xc5, 0x4d, 0x05, 0x47, 0xff, 0x1d, 0xea, 0xc7,
-	0x7e, 0x1f, 0x68, 0x7b, 0x5c, 0x69, 0x35, 0x54, 0x1a, 0x1b, 0x6e, 0x2a, 0xf8, 0xd8, 0x0f, 0x87,
-	0xfa, 0xb1, 0xf7, 0x92, 0xd6, 0x3b, 0x0a, 0x32, 0x36, 0x1c, 0x80, 0x8c, 0xbf, 0xb0, 0xca, 0xe1,
-	0x05, 0x51, 0xeb, 0x73, 0xdd, 0xa5, 0xf8, 0x35, 0x11, 0x48, 0x9b, 0x0a, 0x0e, 0x2f, 0x90, 0x25,
-	0x79, 0xe9, 0x31, 0xf9, 0xbc, 0xc5, 0xe8, 0xa5, 0xe5, 0x74, 0x69, 0x53, 0x91, 0x97, 0xa0, 0x4a,
-	0x7c, 0x47, 0xd1, 0x1e, 0x1c, 0x8d, 0x8e, 0x50, 0xde, 0x54, 0x70, 0x7c, 0x8f, 0xb9, 0x22, 0x8b,
-	0xb0, 0xf6, 0x15, 0x9f, 0x9c, 0x8f, 0x26, 0x07, 0xc2, 0xa6, 0x82, 0x65, 0x89, 0xbe, 0x95, 0x2c,
-	0x30, 0x9a, 0xc5, 0xe7, 0x46, 0xcf, 0x9e, 0x78, 0xa4, 0xa9, 0xe0, 0x64, 0x21, 0xaa, 0x1f, 0x4b,
-	0xe6, 0xda, 0xe0, 0xa8, 0x7f, 0xc6, 0x86, 0x03, 0xff, 0x8c, 0xa7, 0xff, 0xcb, 0x30, 0xbf, 0x63,
-	0xf6, 0x6c, 0xc2, 0x7c, 0x97, 0x6a, 0x07, 0xaa, 0x78, 0x28, 0x44, 0x92, 0x5a, 0x16, 0x32, 0xbe,
-	0x6d, 0x3a, 0x76, 0xe9, 0xe7, 0x14, 0xe4, 0x36, 0x09, 0xa3, 0xae, 0x39, 0x31, 0x52, 0xd6, 0xa3,
-	0x90, 0xe2, 0x4c, 0x4c, 0x44, 0x8a, 0x14, 0x4b, 0x02, 0x45, 0x81, 0xf7, 0x31, 0xa4, 0x64, 0x06,
-	0x3d, 0x75, 0x3c, 0xa7, 0x8c, 0x46, 0x50, 0xa3, 0xc4, 0xf5, 0xdc, 0x68, 0xc8, 0xe4, 0x7a, 0xda,
-	0x1a, 0x15, 0xaa, 0xa3, 0xbb, 0x90, 0xb9, 0x47, 0x03, 0x1c, 0x91, 0x4f, 0x6f, 0x48, 0x9c, 0xf2,
-	0x09, 0x70, 0xb8, 0x1e, 0x16, 0xea, 0x13, 0xbc, 0x5a, 0xfa, 0x21, 0x05, 0xab, 0x75, 0x67, 0x30,
-	0x74, 0x3c, 0x93, 0xd1, 0xf0, 0x28, 0x04, 0x25, 0x5f, 0x5f, 0x3d, 0xae, 0x04, 0xcc, 0x88, 0xea,
-	0x70, 0x22, 0x92, 0xc3, 0x63, 0x96, 0xc7, 0x24, 0x67, 0x05, 0x4f, 0xa8, 0x4d, 0xca, 0xc8, 0xb4,
-	0x4e, 0x96, 0xca, 0xe8, 0x2a, 0xa4, 0x83, 0x16, 0xf7, 0xf0, 0x3f, 0x2f, 0xca, 0xe7, 0x5c, 0xfd,
-	0x2c, 0xfe, 0x01, 0x82, 0x16, 0x21, 0x57, 0xdf, 0x15, 0x8f, 0xdb, 0x82, 0x82, 0xce, 0x42, 0xbe,
-	0xbe, 0xbb, 0x43, 0x1e, 0xd2, 0xaa, 0x57, 0xef, 0x9b, 0x56, 0xb7, 0xa0, 0xa2, 0x3c, 0xcc, 0xd7,
-	0x77, 0x65, 0x02, 0x2a, 0xa4, 0xd0, 0x39, 0x38, 0x5b, 0xdf, 0x6d, 0xd0, 0xa1, 0xe5, 0xec, 0x47,
-	0xf7, 0xc2, 0xc2, 0x99, 0xab, 0x95, 0xe4, 0x1f, 0x06, 0x54, 0x80, 0x45, 0xd1, 0x13, 0x39, 0xa0,
-	0xa0, 0xa0, 0xa5, 0x70, 0x7c, 0x87, 0xf4, 0x48, 0x41, 0xad, 0x7d, 0x78, 0x70, 0xa8, 0x2b, 0x8f,
-	0x0f, 0x75, 0xe5, 0xe9, 0xa1, 0xae, 0xbc, 0x3c, 0xd4, 0xd5, 0x6f, 0x47, 0xba, 0xfa, 0x68, 0xa4,
-	0xab, 0x07, 0x23, 0x5d, 0x7d, 0x3c, 0xd2, 0xd5, 0x3f, 0x47, 0xba, 0xfa, 0x62, 0xa4, 0x2b, 0x2f,
-	0x47, 0xba, 0xfa, 0xdd, 0x73, 0x5d, 0x79, 0xfc, 0x5c, 0x57, 0x9e, 0x3e, 0xd7, 0x95, 0xf6, 0x1c,
-	0xff, 0x1b, 0xfb, 0xc1, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xd9, 0x59, 0xbb, 0xb8, 0xe3, 0x15,
-	0x00, 0x00,
+	// 1392 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0x5b, 0x6f, 0x1b, 0x45,
+	0x14, 0xde, 0x75, 0xed, 0xc4, 0x3e, 0x89, 0x13, 0x77, 0x48, 0xe3, 0xa1, 0x97, 0x8d, 0x31, 0xaa,
+	0x64, 0x4a, 0xeb, 0x54, 0xa5, 0xaa, 0x10, 0x12, 0x0f, 0xbe, 0xb4, 0x78, 0x4b, 0x2e, 0x66, 0xe2,
+	0x22, 0x9e, 0x90, 0xd6, 0xf6, 0xc4, 0xde, 0xb2, 0xde, 0x35, 0x7b, 0x89, 0xc8, 0x1b, 0x3f, 0x81,
+	0x9f, 0xc0, 0x0b, 0x52, 0xff, 0x09, 0x11, 0x02, 0xa9, 0x7d, 0x41, 0x05, 0x89, 0x42, 0xdc, 0x97,
+	0x3e, 0xf6, 0x27, 0xa0, 0x9d, 0x99, 0xbd, 0xd8, 0x41, 0x75, 0x6a, 0x57, 0x45, 0x88, 0x3e, 0xed,
+	0xcc, 0x99, 0x39, 0xdf, 0xcc, 0xb9, 0x9f, 0x1d, 0xb8, 0xa0, 0x9b, 0x8e, 0x65, 0x68, 0xf6, 0xa6,
+	0x4d, 0x3b, 0x96, 0xdd, 0x15, 0x9f, 0xf2, 0xd0, 0xb6, 0x5c, 0x0b, 0x2d, 0xf0, 0xd9, 0xf9, 0x6b,
+	0x3d, 0xdd, 0xed, 0x7b, 0xed, 0x72, 0xc7, 0x1a, 0x6c, 0xf6, 0xac, 0x9e, 0xb5, 0xc9, 0x96, 0xdb,
+	0xde, 0x3e, 0x9b, 0xb1, 0x09, 0x1b, 0x71, 0xb6, 0x62, 0x05, 0x16, 0x3f, 0xa1, 0x26, 0x75, 0x74,
+	0x07, 0x5d, 0x84, 0xcc, 0xd0, 0x32, 0x0e, 0x07, 0x96, 0x3d, 0xec, 0xe3, 0x5c, 0x41, 0x2e, 0xa5,
+	0x48, 0x44, 0x40, 0x08, 0x92, 0x0d, 0xcd, 0xe9, 0xe3, 0xb5, 0x82, 0x5c, 0x5a, 0x26, 0x6c, 0xfc,
+	0x51, 0xf2, 0xc1, 0xf7, 0x1b, 0x72, 0xf1, 0xd1, 0x02, 0xac, 0xaa, 0x66, 0xc7, 0x1a, 0xe8, 0x66,
+	0x8f, 0xd0, 0xaf, 0x3d, 0xea, 0xb8, 0x53, 0xb0, 0xae, 0x42, 0xba, 0xa6, 0x19, 0x46, 0xeb, 0x70,
+	0x48, 0x19, 0xde, 0xca, 0x8d, 0x5c, 0x59, 0x08, 0x13, 0xd0, 0x49, 0xb8, 0x03, 0x6d, 0xc1, 0x82,
+	0x3f, 0xa6, 0x36, 0x3e, 0xe7, 0x9f, 0x5d, 0xbd, 0x79, 0xf4, 0x64, 0x43, 0xfa, 0xfd, 0xc9, 0xc6,
+	0xd5, 0x98, 0xa4, 0x81, 0x66, 0x26, 0xbe, 0x65, 0x42, 0xf7, 0xa9, 0x4d, 0xcd, 0x0e, 0x25, 0x02,
+	0x03, 0x7d, 0x09, 0xab, 0x7c, 0xd4, 0xf4, 0xe5, 0x77, 0xfd, 0x2b, 0xac, 0xcf, 0x01, 0x3b, 0x09,
+	0x86, 0xd6, 0x20, 0xb5, 0x63, 0x99, 0x1d, 0x8a, 0xf3, 0x05, 0xb9, 0x94, 0x24, 0x7c, 0x82, 0x6e,
+	0x00, 0x10, 0xea, 0x7a, 0xb6, 0xb9, 0x6d, 0x75, 0x29, 0x7e, 0x9b, 0xc9, 0x8c, 0x02, 0x99, 0xa3,
+	0x15, 0x12, 0xdb, 0xe5, 0xeb, 0x50, 0x1d, 0x0c, 0x3c, 0x57, 0x6b, 0x1b, 0x14, 0x9f, 0x2f, 0xc8,
+	0xa5, 0x34, 0x89, 0x08, 0xa8, 0x0e, 0xc9, 0xaa, 0xe6, 0x50, 0x7c, 0x81, 0x5d, 0xfe, 0xfa, 0x4b,
+	0x5f, 0x9c, 0x71, 0xa3, 0x06, 0x2c, 0xec, 0xb6, 0xef, 0xd3, 0x8e, 0x8b, 0x2f, 0xce, 0x88, 0x23,
+	0xf8, 0xd1, 0x0e, 0x64, 0x22, 0x8d, 0x5e, 0x9a, 0x11, 0x2c, 0x82, 0x40, 0xeb, 0xb0, 0xb0, 0x4d,
+	0xdd, 0xbe, 0xd5, 0xc5, 0x4a, 0x41, 0x2e, 0x65, 0x88, 0x98, 0xf9, 0x5a, 0xa9, 0xd8, 0x3d, 0x6f,
+	0x40, 0x4d, 0xd7, 0xc1, 0x1b, 0xcc, 0x19, 0x23, 0x02, 0x2a, 0xc2, 0x72, 0xa5, 0xa9, 0x0a, 0x2f,
+	0x54, 0xeb, 0xf8, 0x1d, 0xc6, 0x3b, 0x46, 0xf3, 0xfd, 0x89, 0x50, 0xcd, 0xb1, 0x4c, 0x5c, 0x9c,
+	0xc7, 0x9f, 0x38, 0x06, 0xda, 0x81, 0xc5, 0x4a, 0x53, 0xdd, 0xf1, 0xcd, 0xfa, 0xee, 0x1c, 0x70,
+	0x01, 0x48, 0x2c, 0xa6, 0x76, 0x3d, 0xb7, 0x67, 0xbd, 0x89, 0xa9, 0x37, 0x31, 0xf5, 0x26, 0xa6,
+	0x5e, 0x49, 0x4c, 0xfd, 0x21, 0xfb, 0x97, 0x74, 0x3c, 0x63, 0x5a, 0x28, 0xdd, 0x0e, 0x0d, 0xc8,
+	0x8a, 0x5d, 0xf5, 0x9a, 0x38, 0xfd, 0xf2, 0x29, 0x4e, 0x57, 0xeb, 0x31, 0xeb, 0x2d, 0x0a, 0x05,
+	0xcd, 0x15, 0x64, 0x01, 0x08, 0xc2, 0xb0, 0xd8, 0xd4, 0x0e, 0x0d, 0x4b, 0xeb, 0xf2, 0xe8, 0x22,
+	0xc1, 0x54, 0xc8, 0xf7, 0x4c, 0x86, 0x64, 0x4d, 0x38, 0xf9, 0x0b, 0xa4, 0x8b, 0x5d, 0x6b, 0xed,
+	0x55, 0x5c, 0x0b, 0xf1, 0x53, 0xb9, 0x8c, 0x84, 0xdf, 0xe0, 0x0b, 0x58, 0xda, 0xd6, 0x3a, 0x7d,
+	0xdd, 0xa4, 0xad, 0x20, 0x19, 0x64, 0xab, 0xb7, 0xc4, 0x39, 0xe5, 0x53, 0x9c, 0x13, 0xe3, 0x26,
+	0x71, 0x28, 0x21, 0xea, 0xcf, 0x09, 0x48, 0x57, 0x3a, 0xae, 0x7e, 0xa0, 0xb9, 0xaf, 0x5b, 0x5c,
+	0x16, 0x43, 0x03, 0xcb, 0x3e, 0x14, 0x02, 0x8b, 0x19, 0xba, 0x0b, 0x29, 0x75, 0xa0, 0xf5, 0xe6,
+	0xcb, 0x7c, 0x1c, 0x02, 0x15, 0x60, 0x49, 0x75, 0xa2, 0xc8, 0xcf, 0xb3, 0x3c, 0x15, 0x27, 0xf9,
+	0xf1, 0xd6, 0xd4, 0x6c, 0x6a, 0xba, 0x18, 0xcf, 0x13, 0x6f, 0x1c, 0xa3, 0x78, 0x94, 0x80, 0x54,
+	0x65, 0x40, 0xcd, 0xee, 0xff, 0x52, 0x97, 0x9f, 0xfa, 0x59, 0x96, 0x1e, 0xec, 0xb9, 0x9a, 0x4b,
+	0x31, 0x9e, 0x25, 0xe2, 0x23, 0xfe, 0xe2, 0x8f, 0x32, 0x40, 0x9d, 0x6a, 0xff, 0x8e, 0x6f, 0x8e,
+	0x49, 0x72, 0x6e, 0x4e, 0x49, 0x1e, 0xc9, 0xb0, 0xda, 0xa4, 0x66, 0x57, 0x37, 0x7b, 0x77, 0x74,
+	0x43, 0xf3, 0x6b, 0xc1, 0x14, 0x71, 0x54, 0x48, 0x13, 0x56, 0x7d, 0xd5, 0xfa, 0x6c, 0x99, 0x33,
+	0x64, 0x47, 0xf7, 0x60, 0xc5, 0xbf, 0x89, 0x6e, 0x79, 0x0e, 0xa7, 0xc5, 0xc4, 0x91, 0x4f, 0x0f,
+	0x38, 0x01, 0x52, 0xfc, 0x35, 0x01, 0xa9, 0xdb, 0x07, 0xd4, 0xfc, 0x8f, 0x56, 0x00, 0x12, 0xef,
+	0x07, 0xe6, 0x89, 0x8d, 0xcc, 0x58, 0x6f, 0xd5, 0xb2, 0x86, 0x7a, 0x87, 0x45, 0x46, 0x86, 0xf0,
+	0x49, 0xbc, 0xd6, 0xe0, 0xb1, 0x5a, 0xe3, 0xef, 0x57, 0xcd, 0x2e, 0xfd, 0x86, 0x35, 0x5c, 0x59,
+	0xc2, 0x27, 0xc5, 0x3f, 0xcf, 0x40, 0x7a, 0x4b, 0xdf, 0xa7, 0x86, 0x6e, 0x32, 0xa7, 0x6f, 0x4e,
+	0xea, 0x36, 0x24, 0xa0, 0x5d, 0x58, 0xda, 0xd2, 0x5c, 0xea, 0xb8, 0xdc, 0x4d, 0xd7, 0x66, 0xb1,
+	0x6b, 0x1c, 0x01, 0xbd, 0x07, 0x8b, 0x6c, 0xa0, 0xd6, 0x99, 0x96, 0xb3, 0xd5, 0x55, 0xa1, 0x93,
+	0x80, 0x4c, 0x82, 0x41, 0x2c, 0x6d, 0xae, 0xcf, 0x9f, 0x36, 0xd1, 0x1e, 0x64, 0xf9, 0x3d, 0x02,
+	0x23, 0xe7, 0x67, 0x91, 0x65, 0x1c, 0x03, 0xf5, 0xe1, 0xad, 0xdb, 0x9a, 0x6d, 0xe8, 0xd4, 0x71,
+	0x77, 0x87, 0xd4, 0x0c, 0xa0, 0x79, 0x5e, 0xba, 0x25, 0xa0, 0x4f, 0x53, 0x42, 0x9b, 0x9e, 0xe1,
+	0xd0, 0x1d, 0x6f, 0xd0, 0xa6, 0x36, 0xf9, 0x27, 0x48, 0x74, 0x15, 0xce, 0xc6, 0xa6, 0x4e, 0xcd,
+	0xf2, 0x4c, 0x57, 0x58, 0xf5, 0xe4, 0x42, 0xf1, 0xb7, 0x84, 0x30, 0xfc, 0x14, 0xf3, 0xd6, 0x20,
+	0xb5, 0xdb, 0xbe, 0x3f, 0x6b, 0x06, 0xe0, 0xbc, 0xe8, 0x46, 0xe4, 0x4d, 0xcc, 0xa6, 0x4b, 0xd1,
+	0xcf, 0x4c, 0x40, 0xaf, 0x26, 0x7d, 0x64, 0x12, 0x79, 0x5d, 0x1b, 0x72, 0xc1, 0x78, 0x4b, 0x73,
+	0xdc, 0x7b, 0x0e, 0xed, 0xce, 0xd0, 0x78, 0xc4, 0xb5, 0x76, 0x02, 0x8f, 0xa5, 0x25, 0x9e, 0x12,
+	0x79, 0x42, 0x71, 0x70, 0xbe, 0x70, 0xe6, 0xe5, 0xa5, 0x9c, 0x00, 0x29, 0xfe, 0x92, 0x84, 0xc5,
+	0xcf, 0x75, 0xdb, 0xf5, 0x34, 0x63, 0x4a, 0x62, 0x7a, 0x3f, 0x7c, 0xae, 0xc1, 0x94, 0xe9, 0x65,
+	0x35, 0xd0, 0x8b, 0x20, 0x37, 0x24, 0x12, 0xec, 0x40, 0xb5, 0x13, 0xef, 0x32, 0x78, 0x9f, 0x31,
+	0xe5, 0x03, 0xa6, 0x89, 0xe5, 0x86, 0x44, 0x26, 0x39, 0x50, 0xed, 0xc4, 0x8f, 0x28, 0xee, 0x8d,
+	0x83, 0x4c, 0x2c, 0xfb, 0x20, 0x13, 0x24, 0x54, 0x0a, 0x3a, 0x6f, 0xdc, 0x67, 0xbc, 0x2b, 0xd1,
+	0x6f, 0x9a, 0x4f, 0x6d, 0x48, 0x44, 0xac, 0xa3, 0xa2, 0xe8, 0x26, 0x75, 0xb6, 0x6f, 0x39, 0xfc,
+	0x85, 0xb5, 0xba, 0xb4, 0x21, 0x89, 0xee, 0xb2, 0x1c, 0x35, 0x7f, 0xf8, 0xfe, 0xb8, 0x77, 0x04,
+	0xf4, 0x86, 0x44, 0xc2, 0x3d, 0xe8, 0xb2, 0xe8, 0x6e, 0xf0, 0x57, 0x6c, 0x73, 0x36, 0xdc, 0xec,
+	0x13, 0x1b, 0x12, 0xe1, 0xab, 0xe8, 0x66, 0xbc, 0x72, 0x63, 0x83, 0xed, 0x0d, 0xff, 0x27, 0xa3,
+	0x95, 0x86, 0x44, 0xe2, 0x15, 0xbe, 0x76, 0xa2, 0x4a, 0xe2, 0xc1, 0xb8, 0x7e, 0x26, 0x96, 0x7d,
+	0xfd, 0x4c, 0x90, 0xd0, 0x65, 0x51, 0x96, 0xb0, 0x39, 0x7e, 0x43, 0x46, 0xf4, 0x6f, 0xc8, 0x06,
+	0xe8, 0x12, 0x64, 0xf6, 0xf4, 0x9e, 0xa9, 0xb9, 0x9e, 0x4d, 0xf1, 0x91, 0xcc, 0x7f, 0xd4, 0x42,
+	0x4a, 0x75, 0x11, 0x52, 0x9e, 0xa9, 0x5b, 0x66, 0xf1, 0xa7, 0x04, 0xa4, 0xb7, 0x35, 0x97, 0xda,
+	0xfa, 0x54, 0x87, 0xda, 0x0c, 0x3d, 0x0f, 0xaf, 0x8d, 0x3b, 0x94, 0x20, 0x8b, 0x38, 0x0b, 0xfd,
+	0xf3, 0x63, 0x48, 0x88, 0x44, 0xfb, 0xd2, 0x6e, 0x9f, 0x50, 0xeb, 0x7e, 0x8f, 0xc0, 0x8b, 0xa3,
+	0x5a, 0xc7, 0xeb, 0xb3, 0x80, 0x84, 0xec, 0xe8, 0x0e, 0xa4, 0xee, 0x52, 0x1f, 0x87, 0xa7, 0xdd,
+	0xeb, 0x02, 0xa7, 0x74, 0x0a, 0x1c, 0xc6, 0x47, 0x38, 0xfb, 0x14, 0xad, 0x16, 0x7f, 0x48, 0x40,
+	0xbe, 0x66, 0x0d, 0x86, 0x96, 0xa3, 0xbb, 0x34, 0xb0, 0x18, 0x8f, 0xdc, 0xd7, 0xd7, 0x0f, 0x95,
+	0x61, 0x81, 0x8f, 0x27, 0xd3, 0x61, 0x60, 0x66, 0x61, 0x26, 0xb1, 0xcb, 0x6f, 0x60, 0xb6, 0xa9,
+	0xab, 0xcd, 0xaa, 0x64, 0xc1, 0x8c, 0xae, 0x40, 0xd2, 0x1f, 0xe1, 0xfc, 0x0b, 0x0f, 0x65, 0x7b,
+	0xae, 0x7c, 0x16, 0x3d, 0x40, 0xa1, 0x65, 0x48, 0xd7, 0x5a, 0xfc, 0x71, 0x21, 0x27, 0xa1, 0xb3,
+	0x90, 0xad, 0xb5, 0xf6, 0xb4, 0x03, 0x5a, 0x71, 0x6a, 0x7d, 0xdd, 0xe8, 0xe6, 0x64, 0x94, 0x85,
+	0x4c, 0xad, 0x25, 0xf2, 0x54, 0x2e, 0x81, 0xce, 0xc1, 0xd9, 0x5a, 0xab, 0x4e, 0x87, 0x86, 0x75,
+	0x18, 0x76, 0x26, 0xb9, 0x33, 0x57, 0xca, 0xf1, 0x17, 0x1e, 0x94, 0x83, 0x65, 0x3e, 0xe3, 0xa9,
+	0x22, 0x27, 0xa1, 0x95, 0x60, 0x7d, 0x4f, 0xeb, 0x69, 0x39, 0xb9, 0xfa, 0xe1, 0xd1, 0xb1, 0x22,
+	0x3d, 0x3c, 0x56, 0xa4, 0xc7, 0xc7, 0x8a, 0xf4, 0xfc, 0x58, 0x91, 0xbf, 0x1d, 0x29, 0xf2, 0x83,
+	0x91, 0x22, 0x1f, 0x8d, 0x14, 0xf9, 0xe1, 0x48, 0x91, 0xff, 0x1a, 0x29, 0xf2, 0xb3, 0x91, 0x22,
+	0x3d, 0x1f, 0x29, 0xf2, 0x77, 0x4f, 0x15, 0xe9, 0xe1, 0x53, 0x45, 0x7a, 0xfc, 0x54, 0x91, 0xda,
+	0x0b, 0xec, 0x35, 0xfc, 0x83, 0xbf, 0x07, 0x00, 0x37, 0xcc, 0x86, 0x22, 0x63, 0x17, 0x00, 0x00,
 }
 
 func (x CallType) String() string {
@@ -1502,6 +1578,48 @@ func (this *PendingFilament) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *Event) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Event)
+	if !ok {
+		that2, ok := that.(Event)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Polymorph != that1.Polymorph {
+		return false
+	}
+	if !this.Object.Equal(that1.Object) {
+		return false
+	}
+	if !this.Request.Equal(that1.Request) {
+		return false
+	}
+	if !this.Prototype.Equal(that1.Prototype) {
+		return false
+	}
+	if this.Topic != that1.Topic {
+		return false
+	}
+	if !bytes.Equal(this.Payload, that1.Payload) {
+		return false
+	}
+	if this.Index != that1.Index {
+		return false
+	}
+	return true
+}
 func (this *Lifeline) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -1849,6 +1967,30 @@ func (this *Virtual_PendingFilament) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *Virtual_Event) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Virtual_Event)
+	if !ok {
+		that2, ok := that.(Virtual_Event)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Event.Equal(that1.Event) {
+		return false
+	}
+	return true
+}
 func (this *Material) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -2389,6 +2531,22 @@ func (this *PendingFilament) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *Event) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 11)
+	s = append(s, "&record.Event{")
+	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
+	s = append(s, "Object: "+fmt.Sprintf("%#v", this.Object)+",\n")
+	s = append(s, "Request: "+fmt.Sprintf("%#v", this.Request)+",\n")
+	s = append(s, "Prototype: "+fmt.Sprintf("%#v", this.Prototype)+",\n")
+	s = append(s, "Topic: "+fmt.Sprintf("%#v", this.Topic)+",\n")
+	s = append(s, "Payload: "+fmt.Sprintf("%#v", this.Payload)+",\n")
+	s = append(s, "Index: "+fmt.Sprintf("%#v", this.Index)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func (this *Lifeline) GoString() string {
 	if this == nil {
 		return "nil"
@@ -2423,7 +2581,7 @@ func (this *Virtual) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 15)
+	s := make([]string, 0, 16)
 	s = append(s, "&record.Virtual{")
 	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
 	if this.Union != nil {
@@ -2505,6 +2663,14 @@ func (this *Virtual_PendingFilament) GoString() string {
 		`PendingFilament:` + fmt.Sprintf("%#v", this.PendingFilament) + `}`}, ", ")
 	return s
 }
+func (this *Virtual_Event) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&record.Virtual_Event{` +
+		`Event:` + fmt.Sprintf("%#v", this.Event) + `}`}, ", ")
+	return s
+}
 func (this *Material) GoString() string {
 	if this == nil {
 		return "nil"
@@ -3236,6 +3402,84 @@ func (m *PendingFilament) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *Event) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Event) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Polymorph != 0 {
+		dAtA[i] = 0x80
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintRecord(dAtA, i, uint64(m.Polymorph))
+	}
+	dAtA[i] = 0xa2
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintRecord(dAtA, i, uint64(m.Object.Size()))
+	n28, err := m.Object.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n28
+	dAtA[i] = 0xaa
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintRecord(dAtA, i, uint64(m.Request.Size()))
+	n29, err := m.Request.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n29
+	dAtA[i] = 0xb2
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintRecord(dAtA, i, uint64(m.Prototype.Size()))
+	n30, err := m.Prototype.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n30
+	if len(m.Topic) > 0 {
+		dAtA[i] = 0xba
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintRecord(dAtA, i, uint64(len(m.Topic)))
+		i += copy(dAtA[i:], m.Topic)
+	}
+	if len(m.Payload) > 0 {
+		dAtA[i] = 0xc2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintRecord(dAtA, i, uint64(len(m.Payload)))
+		i += copy(dAtA[i:], m.Payload)
+	}
+	if m.Index != 0 {
+		dAtA[i] = 0xc8
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintRecord(dAtA, i, uint64(m.Index))
+	}
+	return i, nil
+}
+
 func (m *Lifeline) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3264,11 +3508,11 @@ func (m *Lifeline) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintRecord(dAtA, i, uint64(m.LatestState.Size()))
-		n28, err := m.LatestState.MarshalTo(dAtA[i:])
+		n31, err := m.LatestState.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n28
+		i += n31
 	}
 	if m.StateID != 0 {
 		dAtA[i] = 0xa8
@@ -3282,22 +3526,22 @@ func (m *Lifeline) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintRecord(dAtA, i, uint64(m.Parent.Size()))
-	n29, err := m.Parent.MarshalTo(dAtA[i:])
+	n32, err := m.Parent.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n29
+	i += n32
 	if m.LatestRequest != nil {
 		dAtA[i] = 0xba
 		i++
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintRecord(dAtA, i, uint64(m.LatestRequest.Size()))
-		n30, err := m.LatestRequest.MarshalTo(dAtA[i:])
+		n33, err := m.LatestRequest.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n30
+		i += n33
 	}
 	if m.EarliestOpenRequest != nil {
 		dAtA[i] = 0xc2
@@ -3305,11 +3549,11 @@ func (m *Lifeline) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintRecord(dAtA, i, uint64(m.EarliestOpenRequest.Size()))
-		n31, err := m.EarliestOpenRequest.MarshalTo(dAtA[i:])
+		n34, err := m.EarliestOpenRequest.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n31
+		i += n34
 	}
 	if m.OpenRequestsCount != 0 {
 		dAtA[i] = 0xc8
@@ -3348,21 +3592,21 @@ func (m *Index) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintRecord(dAtA, i, uint64(m.ObjID.Size()))
-	n32, err := m.ObjID.MarshalTo(dAtA[i:])
+	n35, err := m.ObjID.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n32
+	i += n35
 	dAtA[i] = 0xaa
 	i++
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintRecord(dAtA, i, uint64(m.Lifeline.Size()))
-	n33, err := m.Lifeline.MarshalTo(dAtA[i:])
+	n36, err := m.Lifeline.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n33
+	i += n36
 	if m.LifelineLastUsed != 0 {
 		dAtA[i] = 0xb0
 		i++
@@ -3410,11 +3654,11 @@ func (m *Virtual) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintRecord(dAtA, i, uint64(m.Polymorph))
 	}
 	if m.Union != nil {
-		nn34, err := m.Union.MarshalTo(dAtA[i:])
+		nn37, err := m.Union.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += nn34
+		i += nn37
 	}
 	if len(m.Signature) > 0 {
 		dAtA[i] = 0xc2
@@ -3435,11 +3679,11 @@ func (m *Virtual_Genesis) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintRecord(dAtA, i, uint64(m.Genesis.Size()))
-		n35, err := m.Genesis.MarshalTo(dAtA[i:])
+		n38, err := m.Genesis.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n35
+		i += n38
 	}
 	return i, nil
 }
@@ -3451,11 +3695,11 @@ func (m *Virtual_IncomingRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintRecord(dAtA, i, uint64(m.IncomingRequest.Size()))
-		n36, err := m.IncomingRequest.MarshalTo(dAtA[i:])
+		n39, err := m.IncomingRequest.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n36
+		i += n39
 	}
 	return i, nil
 }
@@ -3467,11 +3711,11 @@ func (m *Virtual_OutgoingRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintRecord(dAtA, i, uint64(m.OutgoingRequest.Size()))
-		n37, err := m.OutgoingRequest.MarshalTo(dAtA[i:])
+		n40, err := m.OutgoingRequest.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n37
+		i += n40
 	}
 	return i, nil
 }
@@ -3483,11 +3727,11 @@ func (m *Virtual_Result) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintRecord(dAtA, i, uint64(m.Result.Size()))
-		n38, err := m.Result.MarshalTo(dAtA[i:])
+		n41, err := m.Result.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n38
+		i += n41
 	}
 	return i, nil
 }
@@ -3499,11 +3743,11 @@ func (m *Virtual_Code) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintRecord(dAtA, i, uint64(m.Code.Size()))
-		n39, err := m.Code.MarshalTo(dAtA[i:])
+		n42, err := m.Code.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n39
+		i += n42
 	}
 	return i, nil
 }
@@ -3515,11 +3759,11 @@ func (m *Virtual_Activate) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintRecord(dAtA, i, uint64(m.Activate.Size()))
-		n40, err := m.Activate.MarshalTo(dAtA[i:])
+		n43, err := m.Activate.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n40
+		i += n43
 	}
 	return i, nil
 }
@@ -3531,11 +3775,11 @@ func (m *Virtual_Amend) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintRecord(dAtA, i, uint64(m.Amend.Size()))
-		n41, err := m.Amend.MarshalTo(dAtA[i:])
+		n44, err := m.Amend.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n41
+		i += n44
 	}
 	return i, nil
 }
@@ -3547,11 +3791,11 @@ func (m *Virtual_Deactivate) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintRecord(dAtA, i, uint64(m.Deactivate.Size()))
-		n42, err := m.Deactivate.MarshalTo(dAtA[i:])
+		n45, err := m.Deactivate.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n42
+		i += n45
 	}
 	return i, nil
 }
@@ -3563,11 +3807,27 @@ func (m *Virtual_PendingFilament) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintRecord(dAtA, i, uint64(m.PendingFilament.Size()))
-		n43, err := m.PendingFilament.MarshalTo(dAtA[i:])
+		n46, err := m.PendingFilament.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n43
+		i += n46
+	}
+	return i, nil
+}
+func (m *Virtual_Event) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.Event != nil {
+		dAtA[i] = 0xf2
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintRecord(dAtA, i, uint64(m.Event.Size()))
+		n47, err := m.Event.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n47
 	}
 	return i, nil
 }
@@ -3598,41 +3858,41 @@ func (m *Material) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintRecord(dAtA, i, uint64(m.Virtual.Size()))
-	n44, err := m.Virtual.MarshalTo(dAtA[i:])
+	n48, err := m.Virtual.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n44
+	i += n48
 	dAtA[i] = 0xaa
 	i++
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintRecord(dAtA, i, uint64(m.ID.Size()))
-	n45, err := m.ID.MarshalTo(dAtA[i:])
+	n49, err := m.ID.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n45
+	i += n49
 	dAtA[i] = 0xb2
 	i++
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintRecord(dAtA, i, uint64(m.ObjectID.Size()))
-	n46, err := m.ObjectID.MarshalTo(dAtA[i:])
+	n50, err := m.ObjectID.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n46
+	i += n50
 	dAtA[i] = 0xba
 	i++
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintRecord(dAtA, i, uint64(m.JetID.Size()))
-	n47, err := m.JetID.MarshalTo(dAtA[i:])
+	n51, err := m.JetID.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n47
+	i += n51
 	if len(m.Signature) > 0 {
 		dAtA[i] = 0xc2
 		i++
@@ -3671,41 +3931,41 @@ func (m *CompositeFilamentRecord) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintRecord(dAtA, i, uint64(m.RecordID.Size()))
-	n48, err := m.RecordID.MarshalTo(dAtA[i:])
+	n52, err := m.RecordID.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n48
+	i += n52
 	dAtA[i] = 0xaa
 	i++
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintRecord(dAtA, i, uint64(m.Record.Size()))
-	n49, err := m.Record.MarshalTo(dAtA[i:])
+	n53, err := m.Record.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n49
+	i += n53
 	dAtA[i] = 0xb2
 	i++
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintRecord(dAtA, i, uint64(m.MetaID.Size()))
-	n50, err := m.MetaID.MarshalTo(dAtA[i:])
+	n54, err := m.MetaID.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n50
+	i += n54
 	dAtA[i] = 0xba
 	i++
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintRecord(dAtA, i, uint64(m.Meta.Size()))
-	n51, err := m.Meta.MarshalTo(dAtA[i:])
+	n55, err := m.Meta.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n51
+	i += n55
 	return i, nil
 }
 
@@ -3971,6 +4231,35 @@ func (m *PendingFilament) Size() (n int) {
 	return n
 }
 
+func (m *Event) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Polymorph != 0 {
+		n += 2 + sovRecord(uint64(m.Polymorph))
+	}
+	l = m.Object.Size()
+	n += 2 + l + sovRecord(uint64(l))
+	l = m.Request.Size()
+	n += 2 + l + sovRecord(uint64(l))
+	l = m.Prototype.Size()
+	n += 2 + l + sovRecord(uint64(l))
+	l = len(m.Topic)
+	if l > 0 {
+		n += 2 + l + sovRecord(uint64(l))
+	}
+	l = len(m.Payload)
+	if l > 0 {
+		n += 2 + l + sovRecord(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 2 + sovRecord(uint64(m.Index))
+	}
+	return n
+}
+
 func (m *Lifeline) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4155,6 +4444,18 @@ func (m *Virtual_PendingFilament) Size() (n int) {
 	}
 	return n
 }
+func (m *Virtual_Event) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Event != nil {
+		l = m.Event.Size()
+		n += 2 + l + sovRecord(uint64(l))
+	}
+	return n
+}
 func (m *Material) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4351,6 +4652,22 @@ func (this *PendingFilament) String() string {
 	}, "")
 	return s
 }
+func (this *Event) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Event{`,
+		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
+		`Object:` + fmt.Sprintf("%v", this.Object) + `,`,
+		`Request:` + fmt.Sprintf("%v", this.Request) + `,`,
+		`Prototype:` + fmt.Sprintf("%v", this.Prototype) + `,`,
+		`Topic:` + fmt.Sprintf("%v", this.Topic) + `,`,
+		`Payload:` + fmt.Sprintf("%v", this.Payload) + `,`,
+		`Index:` + fmt.Sprintf("%v", this.Index) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Lifeline) String() string {
 	if this == nil {
 		return "nil"
@@ -4483,6 +4800,16 @@ func (this *Virtual_PendingFilament) String() string {
 	}, "")
 	return s
 }
+func (this *Virtual_Event) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Virtual_Event{`,
+		`Event:` + strings.Replace(fmt.Sprintf("%v", this.Event), "Event", "Event", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Material) String() string {
 	if this == nil {
 		return "nil"
@@ -6652,6 +6979,262 @@ func (m *PendingFilament) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Event) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRecord
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Event: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Event: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Polymorph", wireType)
+			}
+			m.Polymorph = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Polymorph |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Object", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Object.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prototype", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Prototype.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Topic = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRecord(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Lifeline) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -7455,6 +8038,41 @@ func (m *Virtual) Unmarshal(dAtA []byte) error {
 			}
 			m.Union = &Virtual_PendingFilament{v}
 			iNdEx = postIndex
+		case 110:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Event{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Union = &Virtual_Event{v}
+			iNdEx = postIndex
 		case 200:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
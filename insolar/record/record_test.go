@@ -205,6 +205,31 @@ func TestMarshalUnmarshalRecord(t *testing.T) {
 			}
 		}
 	})
+
+	t.Run("EventRecordTest", func(t *testing.T) {
+		f := fuzzer()
+		a := assert.New(t)
+		t.Parallel()
+		var record Event
+
+		for i := 0; i < 10; i++ {
+			f.Fuzz(&record)
+
+			bin, err := record.Marshal()
+			a.NoError(err)
+			for i := 0; i < 2; i++ {
+				binNew, err := record.Marshal()
+				a.NoError(err)
+				a.Equal(bin, binNew)
+
+				var recordNew Event
+				err = recordNew.Unmarshal(binNew)
+				require.NoError(t, err)
+
+				a.Equal(&record, &recordNew)
+			}
+		}
+	})
 }
 
 func TestRequestInterface_IncomingRequest(t *testing.T) {
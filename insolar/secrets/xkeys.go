@@ -8,6 +8,7 @@ package secrets
 import (
 	"bytes"
 	"crypto"
+	stdx509 "crypto/x509"
 	"encoding/json"
 	"encoding/pem"
 	"fmt"
@@ -20,6 +21,7 @@ import (
 	"github.com/insolar/x-crypto/x509"
 
 	"github.com/pkg/errors"
+	"golang.org/x/crypto/ed25519"
 )
 
 // KeyPairXCrypto holds private/public keys pair from x-crypto package.
@@ -84,8 +86,13 @@ func ReadXCryptoKeys(r io.Reader, publicOnly bool) (*KeyPairXCrypto, error) {
 }
 
 func ExportPublicKeyPEM(publicKey crypto.PublicKey) ([]byte, error) {
-	ecdsaPublicKey := MustConvertPublicKeyToEcdsa(publicKey)
-	x509EncodedPub, err := x509.MarshalPKIXPublicKey(ecdsaPublicKey)
+	var x509EncodedPub []byte
+	var err error
+	if ed25519PublicKey, ok := publicKey.(ed25519.PublicKey); ok {
+		x509EncodedPub, err = stdx509.MarshalPKIXPublicKey(ed25519PublicKey)
+	} else {
+		x509EncodedPub, err = x509.MarshalPKIXPublicKey(MustConvertPublicKeyToEcdsa(publicKey))
+	}
 	if err != nil {
 		return nil, errors.Wrap(err, "[ ExportPublicKey ]")
 	}
@@ -94,8 +101,13 @@ func ExportPublicKeyPEM(publicKey crypto.PublicKey) ([]byte, error) {
 }
 
 func ExportPrivateKeyPEM(privateKey crypto.PrivateKey) ([]byte, error) {
-	ecdsaPrivateKey := MustConvertPrivateKeyToEcdsa(privateKey)
-	x509Encoded, err := x509.MarshalPKCS8PrivateKey(ecdsaPrivateKey)
+	var x509Encoded []byte
+	var err error
+	if ed25519PrivateKey, ok := privateKey.(ed25519.PrivateKey); ok {
+		x509Encoded, err = stdx509.MarshalPKCS8PrivateKey(ed25519PrivateKey)
+	} else {
+		x509Encoded, err = x509.MarshalPKCS8PrivateKey(MustConvertPrivateKeyToEcdsa(privateKey))
+	}
 	if err != nil {
 		return nil, errors.Wrap(err, "[ ExportPrivateKey ]")
 	}
@@ -127,7 +139,11 @@ func ImportPublicKeyPEM(pemEncoded []byte) (crypto.PublicKey, error) {
 	x509EncodedPub := blockPub.Bytes
 	publicKey, err := x509.ParsePKIXPublicKey(x509EncodedPub)
 	if err != nil {
-		return nil, fmt.Errorf("[ ImportPublicKey ] Problems with parsing. Key - %v", pemEncoded)
+		// x-crypto doesn't know Ed25519 keys
+		publicKey, err = stdx509.ParsePKIXPublicKey(x509EncodedPub)
+		if _, ok := publicKey.(ed25519.PublicKey); err != nil || !ok {
+			return nil, fmt.Errorf("[ ImportPublicKey ] Problems with parsing. Key - %v", pemEncoded)
+		}
 	}
 	return publicKey, nil
 }
@@ -140,6 +156,12 @@ func ImportPrivateKeyPEM(pemEncoded []byte) (crypto.PrivateKey, error) {
 	x509Encoded := block.Bytes
 	privateKey, err := x509.ParsePKCS8PrivateKey(x509Encoded)
 	if err != nil {
+		// x-crypto doesn't know Ed25519 keys
+		if stdKey, stdErr := stdx509.ParsePKCS8PrivateKey(x509Encoded); stdErr == nil {
+			if ed25519Key, ok := stdKey.(ed25519.PrivateKey); ok {
+				return ed25519Key, nil
+			}
+		}
 		// try to read old version marshalled with x509.MarshalECPrivateKey()
 		privateKey, err = x509.ParseECPrivateKey(x509Encoded)
 		if err != nil {
@@ -151,6 +173,9 @@ func ImportPrivateKeyPEM(pemEncoded []byte) (crypto.PrivateKey, error) {
 }
 
 func ExtractPublicKey(privateKey crypto.PrivateKey) crypto.PublicKey {
+	if ed25519PrivateKey, ok := privateKey.(ed25519.PrivateKey); ok {
+		return ed25519PrivateKey.Public()
+	}
 	ecdsaPrivateKey := MustConvertPrivateKeyToEcdsa(privateKey)
 	publicKey := ecdsaPrivateKey.PublicKey
 	return &publicKey
@@ -159,3 +184,9 @@ func ExtractPublicKey(privateKey crypto.PrivateKey) crypto.PublicKey {
 func GeneratePrivateKeyEthereum() (crypto.PrivateKey, error) {
 	return ecdsa.GenerateKey(elliptic.P256K(), rand.Reader)
 }
+
+// GeneratePrivateKeyEd25519 generates Ed25519 private key for member.
+func GeneratePrivateKeyEd25519() (crypto.PrivateKey, error) {
+	_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	return privateKey, err
+}
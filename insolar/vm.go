@@ -7,6 +7,7 @@ package insolar
 
 import (
 	"context"
+	"time"
 )
 
 // MachineType is a type of virtual machine
@@ -129,3 +130,32 @@ const (
 func (s PendingState) Equal(other PendingState) bool {
 	return s == other
 }
+
+// ValidationStatus is an outcome of validation of the request.
+type ValidationStatus string
+
+const (
+	// ValidationValid means that validator got the same result and state as executor.
+	ValidationValid ValidationStatus = "valid"
+	// ValidationMismatch means that result or state of validator differs from the registered by executor.
+	ValidationMismatch ValidationStatus = "mismatch"
+	// ValidationFailed means that validator wasn't able to re-execute the request.
+	ValidationFailed ValidationStatus = "failed"
+)
+
+// ValidationResult is a result of re-execution of the request by validator.
+type ValidationResult struct {
+	Time     time.Time        `json:"time"`
+	Pulse    PulseNumber      `json:"pulse"`
+	Object   string           `json:"object"`
+	Request  string           `json:"request"`
+	Executor string           `json:"executor"`
+	Status   ValidationStatus `json:"status"`
+	Details  string           `json:"details,omitempty"`
+}
+
+// ValidationJournal keeps results of the latest validations made by the node.
+type ValidationJournal interface {
+	// ValidationResults returns results of the latest validations, the newest go first.
+	ValidationResults() []ValidationResult
+}
@@ -25,6 +25,43 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+// FaultAction is an action of fault injection rule.
+type FaultAction int32
+
+const (
+	// Drop drops matched message.
+	FaultAction_Drop FaultAction = 0
+	// Delay publishes matched message after DelayMs plus random jitter up to JitterMs.
+	FaultAction_Delay FaultAction = 1
+	// Duplicate publishes matched message Count+1 times.
+	FaultAction_Duplicate FaultAction = 2
+	// Reorder holds matched message until the next matched message and publishes it after the next one.
+	// Message is held not longer than DelayMs (1 second if not set).
+	FaultAction_Reorder FaultAction = 3
+)
+
+var FaultAction_name = map[int32]string{
+	0: "Drop",
+	1: "Delay",
+	2: "Duplicate",
+	3: "Reorder",
+}
+
+var FaultAction_value = map[string]int32{
+	"Drop":      0,
+	"Delay":     1,
+	"Duplicate": 2,
+	"Reorder":   3,
+}
+
+func (x FaultAction) String() string {
+	return proto.EnumName(FaultAction_name, int32(x))
+}
+
+func (FaultAction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_38901606595998ea, []int{0}
+}
+
 // EmptyArgs is just a stub for grpc methods without arguments.
 type EmptyArgs struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -289,13 +326,265 @@ func (m *AllMessageStatByType) GetCounters() []*MessageStatByType {
 	return nil
 }
 
+// FaultRule matches incoming messages and applies fault action to them.
+// Empty matcher fields match any message, the first matched rule is applied.
+type FaultRule struct {
+	Name string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	// Type is a message payload type.
+	Type string `protobuf:"bytes,2,opt,name=Type,proto3" json:"Type,omitempty"`
+	// Role is a role of the target node (virtual, light_material or heavy_material).
+	Role string `protobuf:"bytes,3,opt,name=Role,proto3" json:"Role,omitempty"`
+	// Sender is a reference of the sender node.
+	Sender string      `protobuf:"bytes,4,opt,name=Sender,proto3" json:"Sender,omitempty"`
+	Action FaultAction `protobuf:"varint,5,opt,name=Action,proto3,enum=introproto.FaultAction" json:"Action,omitempty"`
+	// Probability of applying action to matched message, 1 if not set.
+	Probability          float64  `protobuf:"fixed64,6,opt,name=Probability,proto3" json:"Probability,omitempty"`
+	DelayMs              int64    `protobuf:"varint,7,opt,name=DelayMs,proto3" json:"DelayMs,omitempty"`
+	JitterMs             int64    `protobuf:"varint,8,opt,name=JitterMs,proto3" json:"JitterMs,omitempty"`
+	Count                int32    `protobuf:"varint,9,opt,name=Count,proto3" json:"Count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FaultRule) Reset()         { *m = FaultRule{} }
+func (m *FaultRule) String() string { return proto.CompactTextString(m) }
+func (*FaultRule) ProtoMessage()    {}
+func (*FaultRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_38901606595998ea, []int{6}
+}
+
+func (m *FaultRule) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FaultRule.Unmarshal(m, b)
+}
+func (m *FaultRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FaultRule.Marshal(b, m, deterministic)
+}
+func (m *FaultRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FaultRule.Merge(m, src)
+}
+func (m *FaultRule) XXX_Size() int {
+	return xxx_messageInfo_FaultRule.Size(m)
+}
+func (m *FaultRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_FaultRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FaultRule proto.InternalMessageInfo
+
+func (m *FaultRule) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *FaultRule) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *FaultRule) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+func (m *FaultRule) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *FaultRule) GetAction() FaultAction {
+	if m != nil {
+		return m.Action
+	}
+	return FaultAction_Drop
+}
+
+func (m *FaultRule) GetProbability() float64 {
+	if m != nil {
+		return m.Probability
+	}
+	return 0
+}
+
+func (m *FaultRule) GetDelayMs() int64 {
+	if m != nil {
+		return m.DelayMs
+	}
+	return 0
+}
+
+func (m *FaultRule) GetJitterMs() int64 {
+	if m != nil {
+		return m.JitterMs
+	}
+	return 0
+}
+
+func (m *FaultRule) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+// FaultRuleName identifies fault injection rule.
+type FaultRuleName struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FaultRuleName) Reset()         { *m = FaultRuleName{} }
+func (m *FaultRuleName) String() string { return proto.CompactTextString(m) }
+func (*FaultRuleName) ProtoMessage()    {}
+func (*FaultRuleName) Descriptor() ([]byte, []int) {
+	return fileDescriptor_38901606595998ea, []int{7}
+}
+
+func (m *FaultRuleName) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FaultRuleName.Unmarshal(m, b)
+}
+func (m *FaultRuleName) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FaultRuleName.Marshal(b, m, deterministic)
+}
+func (m *FaultRuleName) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FaultRuleName.Merge(m, src)
+}
+func (m *FaultRuleName) XXX_Size() int {
+	return xxx_messageInfo_FaultRuleName.Size(m)
+}
+func (m *FaultRuleName) XXX_DiscardUnknown() {
+	xxx_messageInfo_FaultRuleName.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FaultRuleName proto.InternalMessageInfo
+
+func (m *FaultRuleName) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+// FaultRuleWithStat represents fault injection rule and its hit statistic.
+type FaultRuleWithStat struct {
+	Rule *FaultRule `protobuf:"bytes,1,opt,name=Rule,proto3" json:"Rule,omitempty"`
+	// Matched is a count of messages matched by rule.
+	Matched int64 `protobuf:"varint,2,opt,name=Matched,proto3" json:"Matched,omitempty"`
+	// Applied is a count of messages action was applied to.
+	Applied              int64    `protobuf:"varint,3,opt,name=Applied,proto3" json:"Applied,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FaultRuleWithStat) Reset()         { *m = FaultRuleWithStat{} }
+func (m *FaultRuleWithStat) String() string { return proto.CompactTextString(m) }
+func (*FaultRuleWithStat) ProtoMessage()    {}
+func (*FaultRuleWithStat) Descriptor() ([]byte, []int) {
+	return fileDescriptor_38901606595998ea, []int{8}
+}
+
+func (m *FaultRuleWithStat) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FaultRuleWithStat.Unmarshal(m, b)
+}
+func (m *FaultRuleWithStat) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FaultRuleWithStat.Marshal(b, m, deterministic)
+}
+func (m *FaultRuleWithStat) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FaultRuleWithStat.Merge(m, src)
+}
+func (m *FaultRuleWithStat) XXX_Size() int {
+	return xxx_messageInfo_FaultRuleWithStat.Size(m)
+}
+func (m *FaultRuleWithStat) XXX_DiscardUnknown() {
+	xxx_messageInfo_FaultRuleWithStat.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FaultRuleWithStat proto.InternalMessageInfo
+
+func (m *FaultRuleWithStat) GetRule() *FaultRule {
+	if m != nil {
+		return m.Rule
+	}
+	return nil
+}
+
+func (m *FaultRuleWithStat) GetMatched() int64 {
+	if m != nil {
+		return m.Matched
+	}
+	return 0
+}
+
+func (m *FaultRuleWithStat) GetApplied() int64 {
+	if m != nil {
+		return m.Applied
+	}
+	return 0
+}
+
+// AllFaultRules is a list of fault injection rules in order of matching.
+type AllFaultRules struct {
+	Rules                []*FaultRuleWithStat `protobuf:"bytes,1,rep,name=Rules,proto3" json:"Rules,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *AllFaultRules) Reset()         { *m = AllFaultRules{} }
+func (m *AllFaultRules) String() string { return proto.CompactTextString(m) }
+func (*AllFaultRules) ProtoMessage()    {}
+func (*AllFaultRules) Descriptor() ([]byte, []int) {
+	return fileDescriptor_38901606595998ea, []int{9}
+}
+
+func (m *AllFaultRules) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AllFaultRules.Unmarshal(m, b)
+}
+func (m *AllFaultRules) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AllFaultRules.Marshal(b, m, deterministic)
+}
+func (m *AllFaultRules) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllFaultRules.Merge(m, src)
+}
+func (m *AllFaultRules) XXX_Size() int {
+	return xxx_messageInfo_AllFaultRules.Size(m)
+}
+func (m *AllFaultRules) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllFaultRules.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AllFaultRules proto.InternalMessageInfo
+
+func (m *AllFaultRules) GetRules() []*FaultRuleWithStat {
+	if m != nil {
+		return m.Rules
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterEnum("introproto.FaultAction", FaultAction_name, FaultAction_value)
 	proto.RegisterType((*EmptyArgs)(nil), "introproto.EmptyArgs")
 	proto.RegisterType((*AllMessageFilterStats)(nil), "introproto.AllMessageFilterStats")
 	proto.RegisterType((*MessageFilterByType)(nil), "introproto.MessageFilterByType")
 	proto.RegisterType((*MessageFilterWithStat)(nil), "introproto.MessageFilterWithStat")
 	proto.RegisterType((*MessageStatByType)(nil), "introproto.MessageStatByType")
 	proto.RegisterType((*AllMessageStatByType)(nil), "introproto.AllMessageStatByType")
+	proto.RegisterType((*FaultRule)(nil), "introproto.FaultRule")
+	proto.RegisterType((*FaultRuleName)(nil), "introproto.FaultRuleName")
+	proto.RegisterType((*FaultRuleWithStat)(nil), "introproto.FaultRuleWithStat")
+	proto.RegisterType((*AllFaultRules)(nil), "introproto.AllFaultRules")
 }
 
 func init() {
@@ -303,32 +592,51 @@ func init() {
 }
 
 var fileDescriptor_38901606595998ea = []byte{
-	// 393 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xc1, 0x4e, 0xea, 0x40,
-	0x18, 0x85, 0x53, 0x7a, 0x2f, 0x17, 0x7e, 0x16, 0xf7, 0x32, 0xd0, 0x6b, 0x53, 0x45, 0xeb, 0xac,
-	0x1a, 0x16, 0x34, 0xc1, 0x85, 0x11, 0xe3, 0x02, 0x0d, 0xba, 0xd2, 0x68, 0x21, 0x71, 0x69, 0x8a,
-	0x4e, 0x4a, 0x93, 0x32, 0xd3, 0x74, 0x86, 0x05, 0x5b, 0x5f, 0xc1, 0x47, 0x73, 0xe7, 0xda, 0x07,
-	0x31, 0x9d, 0x01, 0x5a, 0xb0, 0x68, 0xdc, 0xf5, 0x74, 0xce, 0x39, 0xdf, 0xe4, 0x9f, 0x1f, 0x8e,
-	0x43, 0xca, 0x45, 0x32, 0x9b, 0x12, 0x2a, 0x7c, 0x11, 0x32, 0xea, 0x86, 0x54, 0x24, 0x8c, 0xc7,
-	0xe4, 0x51, 0xb0, 0x44, 0x89, 0x38, 0x61, 0x82, 0xb9, 0xf1, 0x6c, 0x1c, 0x85, 0x7c, 0x42, 0x92,
-	0x8e, 0xd4, 0x08, 0xb2, 0x33, 0x6b, 0x2f, 0x60, 0x2c, 0x88, 0x88, 0xeb, 0xc7, 0xa1, 0xeb, 0x53,
-	0xca, 0x54, 0x15, 0x57, 0x4e, 0x5c, 0x83, 0xea, 0x60, 0x1a, 0x8b, 0x79, 0x3f, 0x09, 0x38, 0x1e,
-	0x81, 0xd1, 0x8f, 0xa2, 0x6b, 0xc2, 0xb9, 0x1f, 0x90, 0xcb, 0x30, 0x12, 0x24, 0x19, 0x0a, 0x5f,
-	0x70, 0x74, 0x0a, 0x7f, 0x94, 0xe4, 0xa6, 0x66, 0xeb, 0x4e, 0xad, 0x7b, 0xd8, 0xc9, 0x08, 0x9d,
-	0xb5, 0xc0, 0x7d, 0x28, 0x26, 0x69, 0xc8, 0x5b, 0x26, 0x70, 0x1f, 0x1a, 0x6b, 0x8e, 0xf3, 0xf9,
-	0x68, 0x1e, 0x13, 0x84, 0xe0, 0xd7, 0x8d, 0x3f, 0x25, 0xa6, 0x66, 0x6b, 0x4e, 0xd5, 0x93, 0xdf,
-	0xe8, 0x3f, 0x94, 0x07, 0xd4, 0x1f, 0x47, 0xc4, 0x2c, 0xd9, 0x9a, 0x53, 0xf1, 0x16, 0x0a, 0x3f,
-	0x80, 0x51, 0x08, 0xf9, 0x49, 0x09, 0xb2, 0xa0, 0xa2, 0xd2, 0xe4, 0xc9, 0xd4, 0x6d, 0xcd, 0xd1,
-	0xbd, 0x95, 0xc6, 0x67, 0x50, 0x5f, 0x00, 0xd2, 0xda, 0x2f, 0x6e, 0xd8, 0x84, 0xdf, 0x17, 0x6c,
-	0x46, 0x85, 0xec, 0xd6, 0x3d, 0x25, 0xf0, 0x1d, 0x34, 0xb3, 0xc1, 0xe5, 0x1a, 0x4e, 0xa0, 0x22,
-	0x0d, 0xd9, 0xe0, 0x5a, 0x05, 0x83, 0xcb, 0x02, 0xde, 0xca, 0xde, 0x7d, 0x2b, 0x41, 0xf5, 0x76,
-	0xf9, 0xac, 0x48, 0x40, 0x7d, 0x48, 0xc4, 0xc2, 0xcf, 0xd5, 0xb5, 0xd1, 0xc1, 0xd6, 0x47, 0x50,
-	0x6d, 0xd6, 0x77, 0x06, 0xdc, 0x7a, 0x7e, 0x7d, 0x7f, 0x29, 0xed, 0x60, 0xe4, 0xf2, 0xcd, 0xf6,
-	0x9e, 0xd6, 0x46, 0x14, 0xd0, 0xd5, 0xe6, 0x7f, 0x8e, 0x8c, 0x7c, 0xeb, 0x6a, 0x79, 0xac, 0xb5,
-	0x95, 0x28, 0x5c, 0x23, 0xbc, 0x2f, 0x71, 0x26, 0x6e, 0xb8, 0xc1, 0xa7, 0xda, 0x94, 0x17, 0xc0,
-	0xdf, 0x1c, 0x4f, 0x3e, 0xf0, 0x16, 0x98, 0x5d, 0x0c, 0xcb, 0x26, 0x89, 0x77, 0x25, 0xcb, 0xc0,
-	0xff, 0xf2, 0xac, 0xf4, 0xbc, 0xa7, 0xb5, 0xc7, 0x65, 0x19, 0x3c, 0xfa, 0x08, 0x00, 0x00, 0xff,
-	0xff, 0x9b, 0x1a, 0x8f, 0x6a, 0x61, 0x03, 0x00, 0x00,
+	// 702 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xdd, 0x6e, 0x13, 0x3b,
+	0x10, 0x3e, 0xdb, 0xfc, 0xee, 0xe4, 0xa4, 0x4d, 0xdd, 0xe6, 0x74, 0x9b, 0x73, 0x7a, 0x58, 0xcc,
+	0x4d, 0xe8, 0x45, 0x57, 0x4a, 0x2f, 0x10, 0x45, 0x20, 0x05, 0xd2, 0x56, 0x42, 0x0a, 0x2a, 0x4e,
+	0x05, 0x37, 0x48, 0x68, 0x93, 0x58, 0xe9, 0x4a, 0xce, 0x7a, 0x65, 0x3b, 0x48, 0xb9, 0xe5, 0x15,
+	0x78, 0x34, 0x5e, 0x81, 0x67, 0xe0, 0x12, 0x21, 0x7b, 0xb3, 0x3f, 0x49, 0x37, 0x20, 0xee, 0xfc,
+	0x8d, 0x67, 0xbe, 0x99, 0xf9, 0x66, 0x6c, 0x78, 0x12, 0x84, 0x52, 0x89, 0xc5, 0x9c, 0x86, 0xca,
+	0x57, 0x01, 0x0f, 0xbd, 0x20, 0x54, 0x82, 0xcb, 0x88, 0x4e, 0x14, 0x17, 0x31, 0x88, 0x04, 0x57,
+	0xdc, 0x8b, 0x16, 0x63, 0x16, 0xc8, 0x3b, 0x2a, 0xce, 0x0c, 0x46, 0x90, 0xdd, 0x75, 0xfe, 0x9b,
+	0x71, 0x3e, 0x63, 0xd4, 0xf3, 0xa3, 0xc0, 0xf3, 0xc3, 0x90, 0xc7, 0x54, 0x32, 0xf6, 0xc4, 0x0d,
+	0xb0, 0x2f, 0xe7, 0x91, 0x5a, 0xf6, 0xc5, 0x4c, 0xe2, 0x5b, 0x68, 0xf7, 0x19, 0x1b, 0x52, 0x29,
+	0xfd, 0x19, 0xbd, 0x0a, 0x98, 0xa2, 0x62, 0xa4, 0x7c, 0x25, 0xd1, 0x33, 0xa8, 0xc5, 0x50, 0x3a,
+	0x96, 0x5b, 0xea, 0x36, 0x7a, 0x0f, 0xcf, 0xb2, 0x0c, 0x67, 0x6b, 0x01, 0xef, 0x03, 0x75, 0xa7,
+	0x83, 0x48, 0x12, 0x81, 0xfb, 0x70, 0xb0, 0xe6, 0xf1, 0x72, 0x79, 0xbb, 0x8c, 0x28, 0x42, 0x50,
+	0x7e, 0xe3, 0xcf, 0xa9, 0x63, 0xb9, 0x56, 0xd7, 0x26, 0xe6, 0x8c, 0xfe, 0x81, 0xea, 0x65, 0xe8,
+	0x8f, 0x19, 0x75, 0x76, 0x5c, 0xab, 0x5b, 0x27, 0x2b, 0x84, 0x3f, 0x42, 0xbb, 0x30, 0xc9, 0x9f,
+	0x90, 0xa0, 0x0e, 0xd4, 0xe3, 0x68, 0x3a, 0x75, 0x4a, 0xae, 0xd5, 0x2d, 0x91, 0x14, 0xe3, 0xe7,
+	0xb0, 0xbf, 0x4a, 0xa0, 0x69, 0x7f, 0x51, 0xe1, 0x21, 0x54, 0x5e, 0xf1, 0x45, 0xa8, 0x0c, 0x77,
+	0x89, 0xc4, 0x00, 0xbf, 0x85, 0xc3, 0x4c, 0xb8, 0x1c, 0xc3, 0x53, 0xa8, 0x1b, 0x87, 0x4c, 0xb8,
+	0x93, 0x02, 0xe1, 0xb2, 0x00, 0x92, 0xba, 0xe3, 0x1f, 0x16, 0xd8, 0x57, 0xfe, 0x82, 0x29, 0xb2,
+	0x60, 0xc5, 0xa5, 0x20, 0x28, 0xeb, 0x18, 0x53, 0x89, 0x4d, 0xca, 0x49, 0xc9, 0x84, 0x33, 0x6a,
+	0xfa, 0xb3, 0x89, 0x39, 0x6b, 0x3d, 0x46, 0x34, 0x9c, 0x52, 0xe1, 0x94, 0x8d, 0x75, 0x85, 0x90,
+	0x07, 0xd5, 0xfe, 0x44, 0xef, 0x82, 0x53, 0x71, 0xad, 0xee, 0x6e, 0xef, 0x28, 0x5f, 0x9a, 0x49,
+	0x1d, 0x5f, 0x93, 0x95, 0x1b, 0x72, 0xa1, 0x71, 0x23, 0xf8, 0xd8, 0x1f, 0x07, 0x2c, 0x50, 0x4b,
+	0xa7, 0xea, 0x5a, 0x5d, 0x8b, 0xe4, 0x4d, 0xc8, 0x81, 0xda, 0x80, 0x32, 0x7f, 0x39, 0x94, 0x4e,
+	0xcd, 0xe8, 0x93, 0x40, 0x2d, 0xfe, 0xeb, 0x40, 0x29, 0x2a, 0x86, 0xd2, 0xa9, 0xc7, 0xe2, 0x27,
+	0x38, 0xd3, 0xd4, 0x76, 0xad, 0x6e, 0x25, 0xd1, 0xf4, 0x11, 0x34, 0xd3, 0xfe, 0x93, 0x7e, 0x37,
+	0x35, 0xc0, 0x02, 0xf6, 0x53, 0xa7, 0x74, 0x29, 0x1e, 0x43, 0x59, 0x63, 0xe3, 0xd8, 0xe8, 0xb5,
+	0xef, 0xb5, 0xa5, 0x2f, 0x89, 0x71, 0xd1, 0x05, 0x0f, 0x7d, 0x35, 0xb9, 0xa3, 0xd3, 0xd5, 0x40,
+	0x13, 0xa8, 0x6f, 0xfa, 0x51, 0xc4, 0x82, 0x74, 0x59, 0x12, 0x88, 0x07, 0xd0, 0xec, 0x33, 0x96,
+	0x32, 0x49, 0x74, 0x0e, 0x15, 0x73, 0x28, 0x1a, 0xf1, 0xbd, 0xea, 0x48, 0xec, 0x7b, 0xfa, 0x02,
+	0x1a, 0x39, 0x8d, 0x51, 0x1d, 0xca, 0x03, 0xc1, 0xa3, 0xd6, 0x5f, 0xc8, 0x86, 0x8a, 0x11, 0xad,
+	0x65, 0xa1, 0x26, 0xd8, 0x83, 0x45, 0xc4, 0x82, 0x89, 0xaf, 0x68, 0x6b, 0x07, 0x35, 0xa0, 0x46,
+	0x28, 0x17, 0x53, 0x2a, 0x5a, 0xa5, 0xde, 0xf7, 0x32, 0xd8, 0x37, 0xc9, 0xb3, 0x47, 0x0a, 0xf6,
+	0x47, 0x54, 0xad, 0xf6, 0x49, 0xc6, 0x6b, 0x8d, 0x1e, 0x6c, 0x7d, 0xa4, 0xf1, 0xb6, 0x75, 0x7e,
+	0xe7, 0x80, 0x4f, 0x3e, 0x7f, 0xfd, 0xf6, 0x65, 0xe7, 0x08, 0x23, 0x4f, 0x6e, 0xb2, 0x5f, 0x58,
+	0xa7, 0x28, 0x04, 0x74, 0xbd, 0x69, 0x97, 0x68, 0x4d, 0xf0, 0xf4, 0x73, 0xe9, 0xac, 0x7d, 0x19,
+	0x85, 0xdf, 0x0c, 0xfe, 0xdf, 0xa4, 0x73, 0xf0, 0x81, 0x37, 0xbb, 0x47, 0xab, 0xf3, 0xbd, 0x83,
+	0xbf, 0x47, 0x54, 0x65, 0xaf, 0xa2, 0x78, 0xb4, 0x9d, 0x62, 0x33, 0x76, 0x0c, 0x3b, 0xc2, 0x4d,
+	0xdd, 0x4c, 0x6a, 0xd6, 0xbc, 0x53, 0xd8, 0x23, 0x74, 0xce, 0x3f, 0xd1, 0x8c, 0xfa, 0xb8, 0x90,
+	0x43, 0xef, 0x5c, 0x67, 0xfb, 0x15, 0xfe, 0xd7, 0xa4, 0x68, 0xe3, 0x96, 0x27, 0xd6, 0xf9, 0x74,
+	0x96, 0x0f, 0xd0, 0xbc, 0xce, 0x25, 0xde, 0x2a, 0xd4, 0xf1, 0x86, 0x50, 0x59, 0x04, 0x3e, 0x36,
+	0xfc, 0x07, 0x78, 0x57, 0x0b, 0x94, 0xd9, 0x35, 0xfb, 0x0c, 0xf6, 0x72, 0xb3, 0x30, 0xef, 0x60,
+	0x0b, 0xbf, 0x5b, 0x3c, 0x88, 0xec, 0x17, 0xca, 0xb5, 0x31, 0x5b, 0xa7, 0xbc, 0xb0, 0x4e, 0xc7,
+	0x55, 0x13, 0x78, 0xfe, 0x73, 0x00, 0xe8, 0x64, 0xe3, 0x92, 0x9d, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -347,6 +655,12 @@ type PublisherClient interface {
 	SetMessagesFilter(ctx context.Context, in *MessageFilterByType, opts ...grpc.CallOption) (*MessageFilterByType, error)
 	// GetMessagesFilters returns map with filter state for every message type.
 	GetMessagesFilters(ctx context.Context, in *EmptyArgs, opts ...grpc.CallOption) (*AllMessageFilterStats, error)
+	// SetFaultRule adds fault injection rule or replaces rule with the same name.
+	SetFaultRule(ctx context.Context, in *FaultRule, opts ...grpc.CallOption) (*FaultRule, error)
+	// RemoveFaultRule removes fault injection rule by name.
+	RemoveFaultRule(ctx context.Context, in *FaultRuleName, opts ...grpc.CallOption) (*FaultRuleName, error)
+	// GetFaultRules returns fault injection rules with hit statistic.
+	GetFaultRules(ctx context.Context, in *EmptyArgs, opts ...grpc.CallOption) (*AllFaultRules, error)
 	// GetMessagesStat returns statistic for published messages by type.
 	GetMessagesStat(ctx context.Context, in *EmptyArgs, opts ...grpc.CallOption) (*AllMessageStatByType, error)
 }
@@ -377,6 +691,33 @@ func (c *publisherClient) GetMessagesFilters(ctx context.Context, in *EmptyArgs,
 	return out, nil
 }
 
+func (c *publisherClient) SetFaultRule(ctx context.Context, in *FaultRule, opts ...grpc.CallOption) (*FaultRule, error) {
+	out := new(FaultRule)
+	err := c.cc.Invoke(ctx, "/introproto.Publisher/SetFaultRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publisherClient) RemoveFaultRule(ctx context.Context, in *FaultRuleName, opts ...grpc.CallOption) (*FaultRuleName, error) {
+	out := new(FaultRuleName)
+	err := c.cc.Invoke(ctx, "/introproto.Publisher/RemoveFaultRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publisherClient) GetFaultRules(ctx context.Context, in *EmptyArgs, opts ...grpc.CallOption) (*AllFaultRules, error) {
+	out := new(AllFaultRules)
+	err := c.cc.Invoke(ctx, "/introproto.Publisher/GetFaultRules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *publisherClient) GetMessagesStat(ctx context.Context, in *EmptyArgs, opts ...grpc.CallOption) (*AllMessageStatByType, error) {
 	out := new(AllMessageStatByType)
 	err := c.cc.Invoke(ctx, "/introproto.Publisher/GetMessagesStat", in, out, opts...)
@@ -392,6 +733,12 @@ type PublisherServer interface {
 	SetMessagesFilter(context.Context, *MessageFilterByType) (*MessageFilterByType, error)
 	// GetMessagesFilters returns map with filter state for every message type.
 	GetMessagesFilters(context.Context, *EmptyArgs) (*AllMessageFilterStats, error)
+	// SetFaultRule adds fault injection rule or replaces rule with the same name.
+	SetFaultRule(context.Context, *FaultRule) (*FaultRule, error)
+	// RemoveFaultRule removes fault injection rule by name.
+	RemoveFaultRule(context.Context, *FaultRuleName) (*FaultRuleName, error)
+	// GetFaultRules returns fault injection rules with hit statistic.
+	GetFaultRules(context.Context, *EmptyArgs) (*AllFaultRules, error)
 	// GetMessagesStat returns statistic for published messages by type.
 	GetMessagesStat(context.Context, *EmptyArgs) (*AllMessageStatByType, error)
 }
@@ -406,6 +753,15 @@ func (*UnimplementedPublisherServer) SetMessagesFilter(ctx context.Context, req
 func (*UnimplementedPublisherServer) GetMessagesFilters(ctx context.Context, req *EmptyArgs) (*AllMessageFilterStats, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMessagesFilters not implemented")
 }
+func (*UnimplementedPublisherServer) SetFaultRule(ctx context.Context, req *FaultRule) (*FaultRule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFaultRule not implemented")
+}
+func (*UnimplementedPublisherServer) RemoveFaultRule(ctx context.Context, req *FaultRuleName) (*FaultRuleName, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFaultRule not implemented")
+}
+func (*UnimplementedPublisherServer) GetFaultRules(ctx context.Context, req *EmptyArgs) (*AllFaultRules, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFaultRules not implemented")
+}
 func (*UnimplementedPublisherServer) GetMessagesStat(ctx context.Context, req *EmptyArgs) (*AllMessageStatByType, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMessagesStat not implemented")
 }
@@ -450,6 +806,60 @@ func _Publisher_GetMessagesFilters_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Publisher_SetFaultRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FaultRule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PublisherServer).SetFaultRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/introproto.Publisher/SetFaultRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublisherServer).SetFaultRule(ctx, req.(*FaultRule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Publisher_RemoveFaultRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FaultRuleName)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PublisherServer).RemoveFaultRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/introproto.Publisher/RemoveFaultRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublisherServer).RemoveFaultRule(ctx, req.(*FaultRuleName))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Publisher_GetFaultRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PublisherServer).GetFaultRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/introproto.Publisher/GetFaultRules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublisherServer).GetFaultRules(ctx, req.(*EmptyArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Publisher_GetMessagesStat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(EmptyArgs)
 	if err := dec(in); err != nil {
@@ -480,6 +890,18 @@ var _Publisher_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetMessagesFilters",
 			Handler:    _Publisher_GetMessagesFilters_Handler,
 		},
+		{
+			MethodName: "SetFaultRule",
+			Handler:    _Publisher_SetFaultRule_Handler,
+		},
+		{
+			MethodName: "RemoveFaultRule",
+			Handler:    _Publisher_RemoveFaultRule_Handler,
+		},
+		{
+			MethodName: "GetFaultRules",
+			Handler:    _Publisher_GetFaultRules_Handler,
+		},
 		{
 			MethodName: "GetMessagesStat",
 			Handler:    _Publisher_GetMessagesStat_Handler,
@@ -17,6 +17,12 @@ import (
 type PublisherServerMock struct {
 	t minimock.Tester
 
+	funcGetFaultRules          func(ctx context.Context, ep1 *mm_introproto.EmptyArgs) (ap1 *mm_introproto.AllFaultRules, err error)
+	inspectFuncGetFaultRules   func(ctx context.Context, ep1 *mm_introproto.EmptyArgs)
+	afterGetFaultRulesCounter  uint64
+	beforeGetFaultRulesCounter uint64
+	GetFaultRulesMock          mPublisherServerMockGetFaultRules
+
 	funcGetMessagesFilters          func(ctx context.Context, ep1 *mm_introproto.EmptyArgs) (ap1 *mm_introproto.AllMessageFilterStats, err error)
 	inspectFuncGetMessagesFilters   func(ctx context.Context, ep1 *mm_introproto.EmptyArgs)
 	afterGetMessagesFiltersCounter  uint64
@@ -29,6 +35,18 @@ type PublisherServerMock struct {
 	beforeGetMessagesStatCounter uint64
 	GetMessagesStatMock          mPublisherServerMockGetMessagesStat
 
+	funcRemoveFaultRule          func(ctx context.Context, fp1 *mm_introproto.FaultRuleName) (fp2 *mm_introproto.FaultRuleName, err error)
+	inspectFuncRemoveFaultRule   func(ctx context.Context, fp1 *mm_introproto.FaultRuleName)
+	afterRemoveFaultRuleCounter  uint64
+	beforeRemoveFaultRuleCounter uint64
+	RemoveFaultRuleMock          mPublisherServerMockRemoveFaultRule
+
+	funcSetFaultRule          func(ctx context.Context, fp1 *mm_introproto.FaultRule) (fp2 *mm_introproto.FaultRule, err error)
+	inspectFuncSetFaultRule   func(ctx context.Context, fp1 *mm_introproto.FaultRule)
+	afterSetFaultRuleCounter  uint64
+	beforeSetFaultRuleCounter uint64
+	SetFaultRuleMock          mPublisherServerMockSetFaultRule
+
 	funcSetMessagesFilter          func(ctx context.Context, mp1 *mm_introproto.MessageFilterByType) (mp2 *mm_introproto.MessageFilterByType, err error)
 	inspectFuncSetMessagesFilter   func(ctx context.Context, mp1 *mm_introproto.MessageFilterByType)
 	afterSetMessagesFilterCounter  uint64
@@ -43,18 +61,244 @@ func NewPublisherServerMock(t minimock.Tester) *PublisherServerMock {
 		controller.RegisterMocker(m)
 	}
 
+	m.GetFaultRulesMock = mPublisherServerMockGetFaultRules{mock: m}
+	m.GetFaultRulesMock.callArgs = []*PublisherServerMockGetFaultRulesParams{}
+
 	m.GetMessagesFiltersMock = mPublisherServerMockGetMessagesFilters{mock: m}
 	m.GetMessagesFiltersMock.callArgs = []*PublisherServerMockGetMessagesFiltersParams{}
 
 	m.GetMessagesStatMock = mPublisherServerMockGetMessagesStat{mock: m}
 	m.GetMessagesStatMock.callArgs = []*PublisherServerMockGetMessagesStatParams{}
 
+	m.RemoveFaultRuleMock = mPublisherServerMockRemoveFaultRule{mock: m}
+	m.RemoveFaultRuleMock.callArgs = []*PublisherServerMockRemoveFaultRuleParams{}
+
+	m.SetFaultRuleMock = mPublisherServerMockSetFaultRule{mock: m}
+	m.SetFaultRuleMock.callArgs = []*PublisherServerMockSetFaultRuleParams{}
+
 	m.SetMessagesFilterMock = mPublisherServerMockSetMessagesFilter{mock: m}
 	m.SetMessagesFilterMock.callArgs = []*PublisherServerMockSetMessagesFilterParams{}
 
 	return m
 }
 
+type mPublisherServerMockGetFaultRules struct {
+	mock               *PublisherServerMock
+	defaultExpectation *PublisherServerMockGetFaultRulesExpectation
+	expectations       []*PublisherServerMockGetFaultRulesExpectation
+
+	callArgs []*PublisherServerMockGetFaultRulesParams
+	mutex    sync.RWMutex
+}
+
+// PublisherServerMockGetFaultRulesExpectation specifies expectation struct of the PublisherServer.GetFaultRules
+type PublisherServerMockGetFaultRulesExpectation struct {
+	mock    *PublisherServerMock
+	params  *PublisherServerMockGetFaultRulesParams
+	results *PublisherServerMockGetFaultRulesResults
+	Counter uint64
+}
+
+// PublisherServerMockGetFaultRulesParams contains parameters of the PublisherServer.GetFaultRules
+type PublisherServerMockGetFaultRulesParams struct {
+	ctx context.Context
+	ep1 *mm_introproto.EmptyArgs
+}
+
+// PublisherServerMockGetFaultRulesResults contains results of the PublisherServer.GetFaultRules
+type PublisherServerMockGetFaultRulesResults struct {
+	ap1 *mm_introproto.AllFaultRules
+	err error
+}
+
+// Expect sets up expected params for PublisherServer.GetFaultRules
+func (mmGetFaultRules *mPublisherServerMockGetFaultRules) Expect(ctx context.Context, ep1 *mm_introproto.EmptyArgs) *mPublisherServerMockGetFaultRules {
+	if mmGetFaultRules.mock.funcGetFaultRules != nil {
+		mmGetFaultRules.mock.t.Fatalf("PublisherServerMock.GetFaultRules mock is already set by Set")
+	}
+
+	if mmGetFaultRules.defaultExpectation == nil {
+		mmGetFaultRules.defaultExpectation = &PublisherServerMockGetFaultRulesExpectation{}
+	}
+
+	mmGetFaultRules.defaultExpectation.params = &PublisherServerMockGetFaultRulesParams{ctx, ep1}
+	for _, e := range mmGetFaultRules.expectations {
+		if minimock.Equal(e.params, mmGetFaultRules.defaultExpectation.params) {
+			mmGetFaultRules.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetFaultRules.defaultExpectation.params)
+		}
+	}
+
+	return mmGetFaultRules
+}
+
+// Inspect accepts an inspector function that has same arguments as the PublisherServer.GetFaultRules
+func (mmGetFaultRules *mPublisherServerMockGetFaultRules) Inspect(f func(ctx context.Context, ep1 *mm_introproto.EmptyArgs)) *mPublisherServerMockGetFaultRules {
+	if mmGetFaultRules.mock.inspectFuncGetFaultRules != nil {
+		mmGetFaultRules.mock.t.Fatalf("Inspect function is already set for PublisherServerMock.GetFaultRules")
+	}
+
+	mmGetFaultRules.mock.inspectFuncGetFaultRules = f
+
+	return mmGetFaultRules
+}
+
+// Return sets up results that will be returned by PublisherServer.GetFaultRules
+func (mmGetFaultRules *mPublisherServerMockGetFaultRules) Return(ap1 *mm_introproto.AllFaultRules, err error) *PublisherServerMock {
+	if mmGetFaultRules.mock.funcGetFaultRules != nil {
+		mmGetFaultRules.mock.t.Fatalf("PublisherServerMock.GetFaultRules mock is already set by Set")
+	}
+
+	if mmGetFaultRules.defaultExpectation == nil {
+		mmGetFaultRules.defaultExpectation = &PublisherServerMockGetFaultRulesExpectation{mock: mmGetFaultRules.mock}
+	}
+	mmGetFaultRules.defaultExpectation.results = &PublisherServerMockGetFaultRulesResults{ap1, err}
+	return mmGetFaultRules.mock
+}
+
+// Set uses given function f to mock the PublisherServer.GetFaultRules method
+func (mmGetFaultRules *mPublisherServerMockGetFaultRules) Set(f func(ctx context.Context, ep1 *mm_introproto.EmptyArgs) (ap1 *mm_introproto.AllFaultRules, err error)) *PublisherServerMock {
+	if mmGetFaultRules.defaultExpectation != nil {
+		mmGetFaultRules.mock.t.Fatalf("Default expectation is already set for the PublisherServer.GetFaultRules method")
+	}
+
+	if len(mmGetFaultRules.expectations) > 0 {
+		mmGetFaultRules.mock.t.Fatalf("Some expectations are already set for the PublisherServer.GetFaultRules method")
+	}
+
+	mmGetFaultRules.mock.funcGetFaultRules = f
+	return mmGetFaultRules.mock
+}
+
+// When sets expectation for the PublisherServer.GetFaultRules which will trigger the result defined by the following
+// Then helper
+func (mmGetFaultRules *mPublisherServerMockGetFaultRules) When(ctx context.Context, ep1 *mm_introproto.EmptyArgs) *PublisherServerMockGetFaultRulesExpectation {
+	if mmGetFaultRules.mock.funcGetFaultRules != nil {
+		mmGetFaultRules.mock.t.Fatalf("PublisherServerMock.GetFaultRules mock is already set by Set")
+	}
+
+	expectation := &PublisherServerMockGetFaultRulesExpectation{
+		mock:   mmGetFaultRules.mock,
+		params: &PublisherServerMockGetFaultRulesParams{ctx, ep1},
+	}
+	mmGetFaultRules.expectations = append(mmGetFaultRules.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PublisherServer.GetFaultRules return parameters for the expectation previously defined by the When method
+func (e *PublisherServerMockGetFaultRulesExpectation) Then(ap1 *mm_introproto.AllFaultRules, err error) *PublisherServerMock {
+	e.results = &PublisherServerMockGetFaultRulesResults{ap1, err}
+	return e.mock
+}
+
+// GetFaultRules implements introproto.PublisherServer
+func (mmGetFaultRules *PublisherServerMock) GetFaultRules(ctx context.Context, ep1 *mm_introproto.EmptyArgs) (ap1 *mm_introproto.AllFaultRules, err error) {
+	mm_atomic.AddUint64(&mmGetFaultRules.beforeGetFaultRulesCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetFaultRules.afterGetFaultRulesCounter, 1)
+
+	if mmGetFaultRules.inspectFuncGetFaultRules != nil {
+		mmGetFaultRules.inspectFuncGetFaultRules(ctx, ep1)
+	}
+
+	mm_params := &PublisherServerMockGetFaultRulesParams{ctx, ep1}
+
+	// Record call args
+	mmGetFaultRules.GetFaultRulesMock.mutex.Lock()
+	mmGetFaultRules.GetFaultRulesMock.callArgs = append(mmGetFaultRules.GetFaultRulesMock.callArgs, mm_params)
+	mmGetFaultRules.GetFaultRulesMock.mutex.Unlock()
+
+	for _, e := range mmGetFaultRules.GetFaultRulesMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ap1, e.results.err
+		}
+	}
+
+	if mmGetFaultRules.GetFaultRulesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetFaultRules.GetFaultRulesMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetFaultRules.GetFaultRulesMock.defaultExpectation.params
+		mm_got := PublisherServerMockGetFaultRulesParams{ctx, ep1}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetFaultRules.t.Errorf("PublisherServerMock.GetFaultRules got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetFaultRules.GetFaultRulesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetFaultRules.t.Fatal("No results are set for the PublisherServerMock.GetFaultRules")
+		}
+		return (*mm_results).ap1, (*mm_results).err
+	}
+	if mmGetFaultRules.funcGetFaultRules != nil {
+		return mmGetFaultRules.funcGetFaultRules(ctx, ep1)
+	}
+	mmGetFaultRules.t.Fatalf("Unexpected call to PublisherServerMock.GetFaultRules. %v %v", ctx, ep1)
+	return
+}
+
+// GetFaultRulesAfterCounter returns a count of finished PublisherServerMock.GetFaultRules invocations
+func (mmGetFaultRules *PublisherServerMock) GetFaultRulesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetFaultRules.afterGetFaultRulesCounter)
+}
+
+// GetFaultRulesBeforeCounter returns a count of PublisherServerMock.GetFaultRules invocations
+func (mmGetFaultRules *PublisherServerMock) GetFaultRulesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetFaultRules.beforeGetFaultRulesCounter)
+}
+
+// Calls returns a list of arguments used in each call to PublisherServerMock.GetFaultRules.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetFaultRules *mPublisherServerMockGetFaultRules) Calls() []*PublisherServerMockGetFaultRulesParams {
+	mmGetFaultRules.mutex.RLock()
+
+	argCopy := make([]*PublisherServerMockGetFaultRulesParams, len(mmGetFaultRules.callArgs))
+	copy(argCopy, mmGetFaultRules.callArgs)
+
+	mmGetFaultRules.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetFaultRulesDone returns true if the count of the GetFaultRules invocations corresponds
+// the number of defined expectations
+func (m *PublisherServerMock) MinimockGetFaultRulesDone() bool {
+	for _, e := range m.GetFaultRulesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetFaultRulesMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetFaultRulesCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetFaultRules != nil && mm_atomic.LoadUint64(&m.afterGetFaultRulesCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockGetFaultRulesInspect logs each unmet expectation
+func (m *PublisherServerMock) MinimockGetFaultRulesInspect() {
+	for _, e := range m.GetFaultRulesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PublisherServerMock.GetFaultRules with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetFaultRulesMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetFaultRulesCounter) < 1 {
+		if m.GetFaultRulesMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to PublisherServerMock.GetFaultRules")
+		} else {
+			m.t.Errorf("Expected call to PublisherServerMock.GetFaultRules with params: %#v", *m.GetFaultRulesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetFaultRules != nil && mm_atomic.LoadUint64(&m.afterGetFaultRulesCounter) < 1 {
+		m.t.Error("Expected call to PublisherServerMock.GetFaultRules")
+	}
+}
+
 type mPublisherServerMockGetMessagesFilters struct {
 	mock               *PublisherServerMock
 	defaultExpectation *PublisherServerMockGetMessagesFiltersExpectation
@@ -128,7 +372,7 @@ func (mmGetMessagesFilters *mPublisherServerMockGetMessagesFilters) Return(ap1 *
 	return mmGetMessagesFilters.mock
 }
 
-//Set uses given function f to mock the PublisherServer.GetMessagesFilters method
+// Set uses given function f to mock the PublisherServer.GetMessagesFilters method
 func (mmGetMessagesFilters *mPublisherServerMockGetMessagesFilters) Set(f func(ctx context.Context, ep1 *mm_introproto.EmptyArgs) (ap1 *mm_introproto.AllMessageFilterStats, err error)) *PublisherServerMock {
 	if mmGetMessagesFilters.defaultExpectation != nil {
 		mmGetMessagesFilters.mock.t.Fatalf("Default expectation is already set for the PublisherServer.GetMessagesFilters method")
@@ -345,7 +589,7 @@ func (mmGetMessagesStat *mPublisherServerMockGetMessagesStat) Return(ap1 *mm_int
 	return mmGetMessagesStat.mock
 }
 
-//Set uses given function f to mock the PublisherServer.GetMessagesStat method
+// Set uses given function f to mock the PublisherServer.GetMessagesStat method
 func (mmGetMessagesStat *mPublisherServerMockGetMessagesStat) Set(f func(ctx context.Context, ep1 *mm_introproto.EmptyArgs) (ap1 *mm_introproto.AllMessageStatByType, err error)) *PublisherServerMock {
 	if mmGetMessagesStat.defaultExpectation != nil {
 		mmGetMessagesStat.mock.t.Fatalf("Default expectation is already set for the PublisherServer.GetMessagesStat method")
@@ -489,6 +733,440 @@ func (m *PublisherServerMock) MinimockGetMessagesStatInspect() {
 	}
 }
 
+type mPublisherServerMockRemoveFaultRule struct {
+	mock               *PublisherServerMock
+	defaultExpectation *PublisherServerMockRemoveFaultRuleExpectation
+	expectations       []*PublisherServerMockRemoveFaultRuleExpectation
+
+	callArgs []*PublisherServerMockRemoveFaultRuleParams
+	mutex    sync.RWMutex
+}
+
+// PublisherServerMockRemoveFaultRuleExpectation specifies expectation struct of the PublisherServer.RemoveFaultRule
+type PublisherServerMockRemoveFaultRuleExpectation struct {
+	mock    *PublisherServerMock
+	params  *PublisherServerMockRemoveFaultRuleParams
+	results *PublisherServerMockRemoveFaultRuleResults
+	Counter uint64
+}
+
+// PublisherServerMockRemoveFaultRuleParams contains parameters of the PublisherServer.RemoveFaultRule
+type PublisherServerMockRemoveFaultRuleParams struct {
+	ctx context.Context
+	fp1 *mm_introproto.FaultRuleName
+}
+
+// PublisherServerMockRemoveFaultRuleResults contains results of the PublisherServer.RemoveFaultRule
+type PublisherServerMockRemoveFaultRuleResults struct {
+	fp2 *mm_introproto.FaultRuleName
+	err error
+}
+
+// Expect sets up expected params for PublisherServer.RemoveFaultRule
+func (mmRemoveFaultRule *mPublisherServerMockRemoveFaultRule) Expect(ctx context.Context, fp1 *mm_introproto.FaultRuleName) *mPublisherServerMockRemoveFaultRule {
+	if mmRemoveFaultRule.mock.funcRemoveFaultRule != nil {
+		mmRemoveFaultRule.mock.t.Fatalf("PublisherServerMock.RemoveFaultRule mock is already set by Set")
+	}
+
+	if mmRemoveFaultRule.defaultExpectation == nil {
+		mmRemoveFaultRule.defaultExpectation = &PublisherServerMockRemoveFaultRuleExpectation{}
+	}
+
+	mmRemoveFaultRule.defaultExpectation.params = &PublisherServerMockRemoveFaultRuleParams{ctx, fp1}
+	for _, e := range mmRemoveFaultRule.expectations {
+		if minimock.Equal(e.params, mmRemoveFaultRule.defaultExpectation.params) {
+			mmRemoveFaultRule.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRemoveFaultRule.defaultExpectation.params)
+		}
+	}
+
+	return mmRemoveFaultRule
+}
+
+// Inspect accepts an inspector function that has same arguments as the PublisherServer.RemoveFaultRule
+func (mmRemoveFaultRule *mPublisherServerMockRemoveFaultRule) Inspect(f func(ctx context.Context, fp1 *mm_introproto.FaultRuleName)) *mPublisherServerMockRemoveFaultRule {
+	if mmRemoveFaultRule.mock.inspectFuncRemoveFaultRule != nil {
+		mmRemoveFaultRule.mock.t.Fatalf("Inspect function is already set for PublisherServerMock.RemoveFaultRule")
+	}
+
+	mmRemoveFaultRule.mock.inspectFuncRemoveFaultRule = f
+
+	return mmRemoveFaultRule
+}
+
+// Return sets up results that will be returned by PublisherServer.RemoveFaultRule
+func (mmRemoveFaultRule *mPublisherServerMockRemoveFaultRule) Return(fp2 *mm_introproto.FaultRuleName, err error) *PublisherServerMock {
+	if mmRemoveFaultRule.mock.funcRemoveFaultRule != nil {
+		mmRemoveFaultRule.mock.t.Fatalf("PublisherServerMock.RemoveFaultRule mock is already set by Set")
+	}
+
+	if mmRemoveFaultRule.defaultExpectation == nil {
+		mmRemoveFaultRule.defaultExpectation = &PublisherServerMockRemoveFaultRuleExpectation{mock: mmRemoveFaultRule.mock}
+	}
+	mmRemoveFaultRule.defaultExpectation.results = &PublisherServerMockRemoveFaultRuleResults{fp2, err}
+	return mmRemoveFaultRule.mock
+}
+
+// Set uses given function f to mock the PublisherServer.RemoveFaultRule method
+func (mmRemoveFaultRule *mPublisherServerMockRemoveFaultRule) Set(f func(ctx context.Context, fp1 *mm_introproto.FaultRuleName) (fp2 *mm_introproto.FaultRuleName, err error)) *PublisherServerMock {
+	if mmRemoveFaultRule.defaultExpectation != nil {
+		mmRemoveFaultRule.mock.t.Fatalf("Default expectation is already set for the PublisherServer.RemoveFaultRule method")
+	}
+
+	if len(mmRemoveFaultRule.expectations) > 0 {
+		mmRemoveFaultRule.mock.t.Fatalf("Some expectations are already set for the PublisherServer.RemoveFaultRule method")
+	}
+
+	mmRemoveFaultRule.mock.funcRemoveFaultRule = f
+	return mmRemoveFaultRule.mock
+}
+
+// When sets expectation for the PublisherServer.RemoveFaultRule which will trigger the result defined by the following
+// Then helper
+func (mmRemoveFaultRule *mPublisherServerMockRemoveFaultRule) When(ctx context.Context, fp1 *mm_introproto.FaultRuleName) *PublisherServerMockRemoveFaultRuleExpectation {
+	if mmRemoveFaultRule.mock.funcRemoveFaultRule != nil {
+		mmRemoveFaultRule.mock.t.Fatalf("PublisherServerMock.RemoveFaultRule mock is already set by Set")
+	}
+
+	expectation := &PublisherServerMockRemoveFaultRuleExpectation{
+		mock:   mmRemoveFaultRule.mock,
+		params: &PublisherServerMockRemoveFaultRuleParams{ctx, fp1},
+	}
+	mmRemoveFaultRule.expectations = append(mmRemoveFaultRule.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PublisherServer.RemoveFaultRule return parameters for the expectation previously defined by the When method
+func (e *PublisherServerMockRemoveFaultRuleExpectation) Then(fp2 *mm_introproto.FaultRuleName, err error) *PublisherServerMock {
+	e.results = &PublisherServerMockRemoveFaultRuleResults{fp2, err}
+	return e.mock
+}
+
+// RemoveFaultRule implements introproto.PublisherServer
+func (mmRemoveFaultRule *PublisherServerMock) RemoveFaultRule(ctx context.Context, fp1 *mm_introproto.FaultRuleName) (fp2 *mm_introproto.FaultRuleName, err error) {
+	mm_atomic.AddUint64(&mmRemoveFaultRule.beforeRemoveFaultRuleCounter, 1)
+	defer mm_atomic.AddUint64(&mmRemoveFaultRule.afterRemoveFaultRuleCounter, 1)
+
+	if mmRemoveFaultRule.inspectFuncRemoveFaultRule != nil {
+		mmRemoveFaultRule.inspectFuncRemoveFaultRule(ctx, fp1)
+	}
+
+	mm_params := &PublisherServerMockRemoveFaultRuleParams{ctx, fp1}
+
+	// Record call args
+	mmRemoveFaultRule.RemoveFaultRuleMock.mutex.Lock()
+	mmRemoveFaultRule.RemoveFaultRuleMock.callArgs = append(mmRemoveFaultRule.RemoveFaultRuleMock.callArgs, mm_params)
+	mmRemoveFaultRule.RemoveFaultRuleMock.mutex.Unlock()
+
+	for _, e := range mmRemoveFaultRule.RemoveFaultRuleMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.fp2, e.results.err
+		}
+	}
+
+	if mmRemoveFaultRule.RemoveFaultRuleMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRemoveFaultRule.RemoveFaultRuleMock.defaultExpectation.Counter, 1)
+		mm_want := mmRemoveFaultRule.RemoveFaultRuleMock.defaultExpectation.params
+		mm_got := PublisherServerMockRemoveFaultRuleParams{ctx, fp1}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRemoveFaultRule.t.Errorf("PublisherServerMock.RemoveFaultRule got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRemoveFaultRule.RemoveFaultRuleMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRemoveFaultRule.t.Fatal("No results are set for the PublisherServerMock.RemoveFaultRule")
+		}
+		return (*mm_results).fp2, (*mm_results).err
+	}
+	if mmRemoveFaultRule.funcRemoveFaultRule != nil {
+		return mmRemoveFaultRule.funcRemoveFaultRule(ctx, fp1)
+	}
+	mmRemoveFaultRule.t.Fatalf("Unexpected call to PublisherServerMock.RemoveFaultRule. %v %v", ctx, fp1)
+	return
+}
+
+// RemoveFaultRuleAfterCounter returns a count of finished PublisherServerMock.RemoveFaultRule invocations
+func (mmRemoveFaultRule *PublisherServerMock) RemoveFaultRuleAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemoveFaultRule.afterRemoveFaultRuleCounter)
+}
+
+// RemoveFaultRuleBeforeCounter returns a count of PublisherServerMock.RemoveFaultRule invocations
+func (mmRemoveFaultRule *PublisherServerMock) RemoveFaultRuleBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemoveFaultRule.beforeRemoveFaultRuleCounter)
+}
+
+// Calls returns a list of arguments used in each call to PublisherServerMock.RemoveFaultRule.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRemoveFaultRule *mPublisherServerMockRemoveFaultRule) Calls() []*PublisherServerMockRemoveFaultRuleParams {
+	mmRemoveFaultRule.mutex.RLock()
+
+	argCopy := make([]*PublisherServerMockRemoveFaultRuleParams, len(mmRemoveFaultRule.callArgs))
+	copy(argCopy, mmRemoveFaultRule.callArgs)
+
+	mmRemoveFaultRule.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRemoveFaultRuleDone returns true if the count of the RemoveFaultRule invocations corresponds
+// the number of defined expectations
+func (m *PublisherServerMock) MinimockRemoveFaultRuleDone() bool {
+	for _, e := range m.RemoveFaultRuleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RemoveFaultRuleMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterRemoveFaultRuleCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRemoveFaultRule != nil && mm_atomic.LoadUint64(&m.afterRemoveFaultRuleCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockRemoveFaultRuleInspect logs each unmet expectation
+func (m *PublisherServerMock) MinimockRemoveFaultRuleInspect() {
+	for _, e := range m.RemoveFaultRuleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PublisherServerMock.RemoveFaultRule with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RemoveFaultRuleMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterRemoveFaultRuleCounter) < 1 {
+		if m.RemoveFaultRuleMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to PublisherServerMock.RemoveFaultRule")
+		} else {
+			m.t.Errorf("Expected call to PublisherServerMock.RemoveFaultRule with params: %#v", *m.RemoveFaultRuleMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRemoveFaultRule != nil && mm_atomic.LoadUint64(&m.afterRemoveFaultRuleCounter) < 1 {
+		m.t.Error("Expected call to PublisherServerMock.RemoveFaultRule")
+	}
+}
+
+type mPublisherServerMockSetFaultRule struct {
+	mock               *PublisherServerMock
+	defaultExpectation *PublisherServerMockSetFaultRuleExpectation
+	expectations       []*PublisherServerMockSetFaultRuleExpectation
+
+	callArgs []*PublisherServerMockSetFaultRuleParams
+	mutex    sync.RWMutex
+}
+
+// PublisherServerMockSetFaultRuleExpectation specifies expectation struct of the PublisherServer.SetFaultRule
+type PublisherServerMockSetFaultRuleExpectation struct {
+	mock    *PublisherServerMock
+	params  *PublisherServerMockSetFaultRuleParams
+	results *PublisherServerMockSetFaultRuleResults
+	Counter uint64
+}
+
+// PublisherServerMockSetFaultRuleParams contains parameters of the PublisherServer.SetFaultRule
+type PublisherServerMockSetFaultRuleParams struct {
+	ctx context.Context
+	fp1 *mm_introproto.FaultRule
+}
+
+// PublisherServerMockSetFaultRuleResults contains results of the PublisherServer.SetFaultRule
+type PublisherServerMockSetFaultRuleResults struct {
+	fp2 *mm_introproto.FaultRule
+	err error
+}
+
+// Expect sets up expected params for PublisherServer.SetFaultRule
+func (mmSetFaultRule *mPublisherServerMockSetFaultRule) Expect(ctx context.Context, fp1 *mm_introproto.FaultRule) *mPublisherServerMockSetFaultRule {
+	if mmSetFaultRule.mock.funcSetFaultRule != nil {
+		mmSetFaultRule.mock.t.Fatalf("PublisherServerMock.SetFaultRule mock is already set by Set")
+	}
+
+	if mmSetFaultRule.defaultExpectation == nil {
+		mmSetFaultRule.defaultExpectation = &PublisherServerMockSetFaultRuleExpectation{}
+	}
+
+	mmSetFaultRule.defaultExpectation.params = &PublisherServerMockSetFaultRuleParams{ctx, fp1}
+	for _, e := range mmSetFaultRule.expectations {
+		if minimock.Equal(e.params, mmSetFaultRule.defaultExpectation.params) {
+			mmSetFaultRule.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSetFaultRule.defaultExpectation.params)
+		}
+	}
+
+	return mmSetFaultRule
+}
+
+// Inspect accepts an inspector function that has same arguments as the PublisherServer.SetFaultRule
+func (mmSetFaultRule *mPublisherServerMockSetFaultRule) Inspect(f func(ctx context.Context, fp1 *mm_introproto.FaultRule)) *mPublisherServerMockSetFaultRule {
+	if mmSetFaultRule.mock.inspectFuncSetFaultRule != nil {
+		mmSetFaultRule.mock.t.Fatalf("Inspect function is already set for PublisherServerMock.SetFaultRule")
+	}
+
+	mmSetFaultRule.mock.inspectFuncSetFaultRule = f
+
+	return mmSetFaultRule
+}
+
+// Return sets up results that will be returned by PublisherServer.SetFaultRule
+func (mmSetFaultRule *mPublisherServerMockSetFaultRule) Return(fp2 *mm_introproto.FaultRule, err error) *PublisherServerMock {
+	if mmSetFaultRule.mock.funcSetFaultRule != nil {
+		mmSetFaultRule.mock.t.Fatalf("PublisherServerMock.SetFaultRule mock is already set by Set")
+	}
+
+	if mmSetFaultRule.defaultExpectation == nil {
+		mmSetFaultRule.defaultExpectation = &PublisherServerMockSetFaultRuleExpectation{mock: mmSetFaultRule.mock}
+	}
+	mmSetFaultRule.defaultExpectation.results = &PublisherServerMockSetFaultRuleResults{fp2, err}
+	return mmSetFaultRule.mock
+}
+
+// Set uses given function f to mock the PublisherServer.SetFaultRule method
+func (mmSetFaultRule *mPublisherServerMockSetFaultRule) Set(f func(ctx context.Context, fp1 *mm_introproto.FaultRule) (fp2 *mm_introproto.FaultRule, err error)) *PublisherServerMock {
+	if mmSetFaultRule.defaultExpectation != nil {
+		mmSetFaultRule.mock.t.Fatalf("Default expectation is already set for the PublisherServer.SetFaultRule method")
+	}
+
+	if len(mmSetFaultRule.expectations) > 0 {
+		mmSetFaultRule.mock.t.Fatalf("Some expectations are already set for the PublisherServer.SetFaultRule method")
+	}
+
+	mmSetFaultRule.mock.funcSetFaultRule = f
+	return mmSetFaultRule.mock
+}
+
+// When sets expectation for the PublisherServer.SetFaultRule which will trigger the result defined by the following
+// Then helper
+func (mmSetFaultRule *mPublisherServerMockSetFaultRule) When(ctx context.Context, fp1 *mm_introproto.FaultRule) *PublisherServerMockSetFaultRuleExpectation {
+	if mmSetFaultRule.mock.funcSetFaultRule != nil {
+		mmSetFaultRule.mock.t.Fatalf("PublisherServerMock.SetFaultRule mock is already set by Set")
+	}
+
+	expectation := &PublisherServerMockSetFaultRuleExpectation{
+		mock:   mmSetFaultRule.mock,
+		params: &PublisherServerMockSetFaultRuleParams{ctx, fp1},
+	}
+	mmSetFaultRule.expectations = append(mmSetFaultRule.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PublisherServer.SetFaultRule return parameters for the expectation previously defined by the When method
+func (e *PublisherServerMockSetFaultRuleExpectation) Then(fp2 *mm_introproto.FaultRule, err error) *PublisherServerMock {
+	e.results = &PublisherServerMockSetFaultRuleResults{fp2, err}
+	return e.mock
+}
+
+// SetFaultRule implements introproto.PublisherServer
+func (mmSetFaultRule *PublisherServerMock) SetFaultRule(ctx context.Context, fp1 *mm_introproto.FaultRule) (fp2 *mm_introproto.FaultRule, err error) {
+	mm_atomic.AddUint64(&mmSetFaultRule.beforeSetFaultRuleCounter, 1)
+	defer mm_atomic.AddUint64(&mmSetFaultRule.afterSetFaultRuleCounter, 1)
+
+	if mmSetFaultRule.inspectFuncSetFaultRule != nil {
+		mmSetFaultRule.inspectFuncSetFaultRule(ctx, fp1)
+	}
+
+	mm_params := &PublisherServerMockSetFaultRuleParams{ctx, fp1}
+
+	// Record call args
+	mmSetFaultRule.SetFaultRuleMock.mutex.Lock()
+	mmSetFaultRule.SetFaultRuleMock.callArgs = append(mmSetFaultRule.SetFaultRuleMock.callArgs, mm_params)
+	mmSetFaultRule.SetFaultRuleMock.mutex.Unlock()
+
+	for _, e := range mmSetFaultRule.SetFaultRuleMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.fp2, e.results.err
+		}
+	}
+
+	if mmSetFaultRule.SetFaultRuleMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSetFaultRule.SetFaultRuleMock.defaultExpectation.Counter, 1)
+		mm_want := mmSetFaultRule.SetFaultRuleMock.defaultExpectation.params
+		mm_got := PublisherServerMockSetFaultRuleParams{ctx, fp1}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSetFaultRule.t.Errorf("PublisherServerMock.SetFaultRule got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSetFaultRule.SetFaultRuleMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSetFaultRule.t.Fatal("No results are set for the PublisherServerMock.SetFaultRule")
+		}
+		return (*mm_results).fp2, (*mm_results).err
+	}
+	if mmSetFaultRule.funcSetFaultRule != nil {
+		return mmSetFaultRule.funcSetFaultRule(ctx, fp1)
+	}
+	mmSetFaultRule.t.Fatalf("Unexpected call to PublisherServerMock.SetFaultRule. %v %v", ctx, fp1)
+	return
+}
+
+// SetFaultRuleAfterCounter returns a count of finished PublisherServerMock.SetFaultRule invocations
+func (mmSetFaultRule *PublisherServerMock) SetFaultRuleAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetFaultRule.afterSetFaultRuleCounter)
+}
+
+// SetFaultRuleBeforeCounter returns a count of PublisherServerMock.SetFaultRule invocations
+func (mmSetFaultRule *PublisherServerMock) SetFaultRuleBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetFaultRule.beforeSetFaultRuleCounter)
+}
+
+// Calls returns a list of arguments used in each call to PublisherServerMock.SetFaultRule.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSetFaultRule *mPublisherServerMockSetFaultRule) Calls() []*PublisherServerMockSetFaultRuleParams {
+	mmSetFaultRule.mutex.RLock()
+
+	argCopy := make([]*PublisherServerMockSetFaultRuleParams, len(mmSetFaultRule.callArgs))
+	copy(argCopy, mmSetFaultRule.callArgs)
+
+	mmSetFaultRule.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSetFaultRuleDone returns true if the count of the SetFaultRule invocations corresponds
+// the number of defined expectations
+func (m *PublisherServerMock) MinimockSetFaultRuleDone() bool {
+	for _, e := range m.SetFaultRuleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SetFaultRuleMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSetFaultRuleCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSetFaultRule != nil && mm_atomic.LoadUint64(&m.afterSetFaultRuleCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockSetFaultRuleInspect logs each unmet expectation
+func (m *PublisherServerMock) MinimockSetFaultRuleInspect() {
+	for _, e := range m.SetFaultRuleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PublisherServerMock.SetFaultRule with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SetFaultRuleMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSetFaultRuleCounter) < 1 {
+		if m.SetFaultRuleMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to PublisherServerMock.SetFaultRule")
+		} else {
+			m.t.Errorf("Expected call to PublisherServerMock.SetFaultRule with params: %#v", *m.SetFaultRuleMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSetFaultRule != nil && mm_atomic.LoadUint64(&m.afterSetFaultRuleCounter) < 1 {
+		m.t.Error("Expected call to PublisherServerMock.SetFaultRule")
+	}
+}
+
 type mPublisherServerMockSetMessagesFilter struct {
 	mock               *PublisherServerMock
 	defaultExpectation *PublisherServerMockSetMessagesFilterExpectation
@@ -562,7 +1240,7 @@ func (mmSetMessagesFilter *mPublisherServerMockSetMessagesFilter) Return(mp2 *mm
 	return mmSetMessagesFilter.mock
 }
 
-//Set uses given function f to mock the PublisherServer.SetMessagesFilter method
+// Set uses given function f to mock the PublisherServer.SetMessagesFilter method
 func (mmSetMessagesFilter *mPublisherServerMockSetMessagesFilter) Set(f func(ctx context.Context, mp1 *mm_introproto.MessageFilterByType) (mp2 *mm_introproto.MessageFilterByType, err error)) *PublisherServerMock {
 	if mmSetMessagesFilter.defaultExpectation != nil {
 		mmSetMessagesFilter.mock.t.Fatalf("Default expectation is already set for the PublisherServer.SetMessagesFilter method")
@@ -709,10 +1387,16 @@ func (m *PublisherServerMock) MinimockSetMessagesFilterInspect() {
 // MinimockFinish checks that all mocked methods have been called the expected number of times
 func (m *PublisherServerMock) MinimockFinish() {
 	if !m.minimockDone() {
+		m.MinimockGetFaultRulesInspect()
+
 		m.MinimockGetMessagesFiltersInspect()
 
 		m.MinimockGetMessagesStatInspect()
 
+		m.MinimockRemoveFaultRuleInspect()
+
+		m.MinimockSetFaultRuleInspect()
+
 		m.MinimockSetMessagesFilterInspect()
 		m.t.FailNow()
 	}
@@ -737,7 +1421,10 @@ func (m *PublisherServerMock) MinimockWait(timeout mm_time.Duration) {
 func (m *PublisherServerMock) minimockDone() bool {
 	done := true
 	return done &&
+		m.MinimockGetFaultRulesDone() &&
 		m.MinimockGetMessagesFiltersDone() &&
 		m.MinimockGetMessagesStatDone() &&
+		m.MinimockRemoveFaultRuleDone() &&
+		m.MinimockSetFaultRuleDone() &&
 		m.MinimockSetMessagesFilterDone()
 }
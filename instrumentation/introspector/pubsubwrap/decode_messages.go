@@ -25,10 +25,16 @@ func (de decodeError) Error() string {
 // decodeType tries to decode message.Message as protobuf, return annotated error with type of legacy message.
 // ignore protobuf decoding errors, it will happen until legacy messages exist
 func decodeType(m *message.Message) (payload.Type, error) {
+	_, typ, err := decodeMeta(m)
+	return typ, err
+}
+
+// decodeMeta decodes meta and payload type of message.Message.
+func decodeMeta(m *message.Message) (payload.Meta, payload.Type, error) {
 	var meta payload.Meta
 	err := meta.Unmarshal(m.Payload)
 	if err != nil {
-		return payload.TypeUnknown, decodeError{
+		return meta, payload.TypeUnknown, decodeError{
 			metadataType: m.Metadata["type"],
 			err:          err,
 		}
@@ -36,11 +42,11 @@ func decodeType(m *message.Message) (payload.Type, error) {
 
 	typ, err := payload.UnmarshalType(meta.Payload)
 	if err != nil {
-		return payload.TypeUnknown, decodeError{
+		return meta, payload.TypeUnknown, decodeError{
 			metadataType: m.Metadata["type"],
 			err:          err,
 		}
 	}
 
-	return typ, nil
+	return meta, typ, nil
 }
@@ -0,0 +1,279 @@
+// Copyright 2020 Insolar Network Ltd.
+// All rights reserved.
+// This material is licensed under the Insolar License version 1.0,
+// available at https://github.com/insolar/insolar/blob/master/LICENSE.md.
+
+package pubsubwrap
+
+import (
+	"context"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/payload"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/instrumentation/introspector/introproto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// defaultReorderHold is a max time of holding message by reorder rule without DelayMs.
+const defaultReorderHold = time.Second
+
+type faultRule struct {
+	rule introproto.FaultRule
+
+	typ    payload.Type
+	role   insolar.StaticRole
+	sender *insolar.Reference
+
+	matched int64
+	applied int64
+
+	held        *message.Message
+	heldPublish PublishFunc
+}
+
+func (r *faultRule) match(role insolar.StaticRole, meta payload.Meta, typ payload.Type) bool {
+	if r.typ != payload.TypeUnknown && r.typ != typ {
+		return false
+	}
+	if r.role != insolar.StaticRoleUnknown && r.role != role {
+		return false
+	}
+	if r.sender != nil && !r.sender.Equal(meta.Sender) {
+		return false
+	}
+	return true
+}
+
+// FaultInjector drops, delays, duplicates or reorders incoming messages by fault injection rules.
+type FaultInjector struct {
+	sync.Mutex
+	// role of the node, it is the target role of all messages passed through the injector
+	role  insolar.StaticRole
+	rules []*faultRule
+	rand  *rand.Rand
+	log   insolar.Logger
+}
+
+// NewFaultInjector is a constructor for FaultInjector.
+func NewFaultInjector(ctx context.Context, role insolar.StaticRole) *FaultInjector {
+	return &FaultInjector{
+		role: role,
+		rand: rand.New(rand.NewSource(time.Now().UnixNano())),
+		log:  inslogger.FromContext(ctx),
+	}
+}
+
+// Inject applies the first matched rule to message.
+func (fi *FaultInjector) Inject(m *message.Message, publish PublishFunc) []*message.Message {
+	meta, typ, err := decodeMeta(m)
+	if err != nil {
+		return []*message.Message{m}
+	}
+
+	fi.Lock()
+	var rule *faultRule
+	for _, r := range fi.rules {
+		if r.match(fi.role, meta, typ) {
+			rule = r
+			break
+		}
+	}
+	if rule == nil {
+		fi.Unlock()
+		return []*message.Message{m}
+	}
+	rule.matched++
+	probability := rule.rule.Probability
+	if probability > 0 && fi.rand.Float64() >= probability {
+		fi.Unlock()
+		return []*message.Message{m}
+	}
+	rule.applied++
+	fi.log.Debugf("FaultInjector rule '%v' applies %v to '%v'", rule.rule.Name, rule.rule.Action, typ.String())
+
+	switch rule.rule.Action {
+	case introproto.FaultAction_Delay:
+		delay := time.Duration(rule.rule.DelayMs) * time.Millisecond
+		if rule.rule.JitterMs > 0 {
+			delay += time.Duration(fi.rand.Int63n(rule.rule.JitterMs)) * time.Millisecond
+		}
+		fi.Unlock()
+		time.AfterFunc(delay, func() {
+			fi.publish(publish, m)
+		})
+		return nil
+	case introproto.FaultAction_Duplicate:
+		fi.Unlock()
+		count := int(rule.rule.Count)
+		if count == 0 {
+			count = 1
+		}
+		out := []*message.Message{m}
+		for i := 0; i < count; i++ {
+			out = append(out, m.Copy())
+		}
+		return out
+	case introproto.FaultAction_Reorder:
+		if rule.held == nil {
+			rule.held, rule.heldPublish = m, publish
+			hold := time.Duration(rule.rule.DelayMs) * time.Millisecond
+			if hold == 0 {
+				hold = defaultReorderHold
+			}
+			name := rule.rule.Name
+			fi.Unlock()
+			time.AfterFunc(hold, func() {
+				fi.release(name, m)
+			})
+			return nil
+		}
+		held, heldPublish := rule.held, rule.heldPublish
+		rule.held, rule.heldPublish = nil, nil
+		fi.Unlock()
+		// held message goes after the current one
+		fi.publish(publish, m)
+		fi.publish(heldPublish, held)
+		return nil
+	default:
+		fi.Unlock()
+		return nil
+	}
+}
+
+// release publishes message held by reorder rule, if it is still held. Rule is looked up by name,
+// because held message is handed over to the rule, that replaces the one that held it.
+func (fi *FaultInjector) release(name string, m *message.Message) {
+	fi.Lock()
+	var rule *faultRule
+	for _, r := range fi.rules {
+		if r.rule.Name == name {
+			rule = r
+			break
+		}
+	}
+	if rule == nil || rule.held != m {
+		fi.Unlock()
+		return
+	}
+	publish := rule.heldPublish
+	rule.held, rule.heldPublish = nil, nil
+	fi.Unlock()
+
+	fi.publish(publish, m)
+}
+
+func (fi *FaultInjector) publish(publish PublishFunc, m *message.Message) {
+	err := publish(m)
+	if err != nil {
+		fi.log.Errorf("FaultInjector failed to publish message: %v", err)
+	}
+}
+
+// SetFaultRule adds fault injection rule or replaces rule with the same name. Statistic of replaced rule is reset.
+func (fi *FaultInjector) SetFaultRule(ctx context.Context, in *introproto.FaultRule) (*introproto.FaultRule, error) {
+	rule, err := newFaultRule(in)
+	if err != nil {
+		return nil, err
+	}
+
+	fi.Lock()
+	defer fi.Unlock()
+
+	for i, r := range fi.rules {
+		if r.rule.Name == in.Name {
+			rule.held, rule.heldPublish = r.held, r.heldPublish
+			r.held, r.heldPublish = nil, nil
+			fi.rules[i] = rule
+			return in, nil
+		}
+	}
+	fi.rules = append(fi.rules, rule)
+	return in, nil
+}
+
+func newFaultRule(in *introproto.FaultRule) (*faultRule, error) {
+	if in.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name shouldn't be empty")
+	}
+	if _, ok := introproto.FaultAction_name[int32(in.Action)]; !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "'%v' unknown fault action", in.Action)
+	}
+	if in.Probability < 0 || in.Probability > 1 {
+		return nil, status.Errorf(codes.InvalidArgument, "probability %v should be in range [0, 1]", in.Probability)
+	}
+	if in.DelayMs < 0 || in.JitterMs < 0 || in.Count < 0 {
+		return nil, status.Error(codes.InvalidArgument, "delay, jitter and count shouldn't be negative")
+	}
+
+	rule := &faultRule{
+		rule: *in,
+	}
+	if in.Type != "" {
+		typ, ok := payload.TypesMap[in.Type]
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "'%v' unknown message payload type", in.Type)
+		}
+		rule.typ = typ
+	}
+	if in.Role != "" {
+		rule.role = insolar.GetStaticRoleFromString(in.Role)
+		if rule.role == insolar.StaticRoleUnknown {
+			return nil, status.Errorf(codes.InvalidArgument, "'%v' unknown node role", in.Role)
+		}
+	}
+	if in.Sender != "" {
+		sender, err := insolar.NewReferenceFromString(in.Sender)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "'%v' bad sender reference: %v", in.Sender, err)
+		}
+		rule.sender = sender
+	}
+	return rule, nil
+}
+
+// RemoveFaultRule removes fault injection rule by name. Message held by the rule is published.
+func (fi *FaultInjector) RemoveFaultRule(ctx context.Context, in *introproto.FaultRuleName) (*introproto.FaultRuleName, error) {
+	fi.Lock()
+	for i, r := range fi.rules {
+		if r.rule.Name != in.Name {
+			continue
+		}
+		fi.rules = append(fi.rules[:i], fi.rules[i+1:]...)
+		held, heldPublish := r.held, r.heldPublish
+		r.held, r.heldPublish = nil, nil
+		fi.Unlock()
+
+		if held != nil {
+			fi.publish(heldPublish, held)
+		}
+		return in, nil
+	}
+	fi.Unlock()
+
+	return nil, status.Errorf(codes.NotFound, "'%v' fault rule not found", in.Name)
+}
+
+// GetFaultRules returns fault injection rules in order of matching with hit statistic.
+func (fi *FaultInjector) GetFaultRules(ctx context.Context, in *introproto.EmptyArgs) (*introproto.AllFaultRules, error) {
+	fi.Lock()
+	defer fi.Unlock()
+
+	rules := make([]*introproto.FaultRuleWithStat, 0, len(fi.rules))
+	for _, r := range fi.rules {
+		rule := r.rule
+		rules = append(rules, &introproto.FaultRuleWithStat{
+			Rule:    &rule,
+			Matched: r.matched,
+			Applied: r.applied,
+		})
+	}
+	return &introproto.AllFaultRules{
+		Rules: rules,
+	}, nil
+}
@@ -0,0 +1,233 @@
+// Copyright 2020 Insolar Network Ltd.
+// All rights reserved.
+// This material is licensed under the Insolar License version 1.0,
+// available at https://github.com/insolar/insolar/blob/master/LICENSE.md.
+
+package pubsubwrap
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/insolar/payload"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/instrumentation/introspector/introproto"
+	"github.com/stretchr/testify/require"
+)
+
+type published struct {
+	sync.Mutex
+	messages []*message.Message
+}
+
+func (p *published) publish(messages ...*message.Message) error {
+	p.Lock()
+	defer p.Unlock()
+	p.messages = append(p.messages, messages...)
+	return nil
+}
+
+func (p *published) get() []*message.Message {
+	p.Lock()
+	defer p.Unlock()
+	return append([]*message.Message(nil), p.messages...)
+}
+
+func faultMessage(t *testing.T, uuid string, sender insolar.Reference, pl payload.Payload) *message.Message {
+	b, err := pl.Marshal()
+	require.NoError(t, err, "payload should be marshaled w/o errors")
+	meta := payload.Meta{
+		Payload: b,
+		Sender:  sender,
+	}
+	metaBytes, err := meta.Marshal()
+	require.NoError(t, err, "meta should be marshaled w/o errors")
+	return message.NewMessage(uuid, metaBytes)
+}
+
+func TestFaultInjector_Match(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	fi := NewFaultInjector(ctx, insolar.StaticRoleLightMaterial)
+	sender := gen.Reference()
+
+	_, err := fi.SetFaultRule(ctx, &introproto.FaultRule{
+		Name:   "by type",
+		Type:   payload.TypeGetObject.String(),
+		Action: introproto.FaultAction_Drop,
+	})
+	require.NoError(t, err)
+	_, err = fi.SetFaultRule(ctx, &introproto.FaultRule{
+		Name:   "by sender",
+		Sender: sender.String(),
+		Action: introproto.FaultAction_Drop,
+	})
+	require.NoError(t, err)
+	_, err = fi.SetFaultRule(ctx, &introproto.FaultRule{
+		Name:   "by other role",
+		Role:   insolar.StaticRoleVirtual.String(),
+		Action: introproto.FaultAction_Drop,
+	})
+	require.NoError(t, err)
+
+	pub := &published{}
+	getObject := &payload.GetObject{Polymorph: uint32(payload.TypeGetObject)}
+	getCode := &payload.GetCode{Polymorph: uint32(payload.TypeGetCode)}
+
+	out := fi.Inject(faultMessage(t, "1", gen.Reference(), getObject), pub.publish)
+	require.Empty(t, out, "message dropped by type")
+	out = fi.Inject(faultMessage(t, "2", sender, getCode), pub.publish)
+	require.Empty(t, out, "message dropped by sender")
+	out = fi.Inject(faultMessage(t, "3", gen.Reference(), getCode), pub.publish)
+	require.Len(t, out, 1, "message for light isn't matched by virtual role")
+	out = fi.Inject(&message.Message{UUID: "4"}, pub.publish)
+	require.Len(t, out, 1, "message without meta passes injector")
+
+	rules, err := fi.GetFaultRules(ctx, nil)
+	require.NoError(t, err)
+	require.Len(t, rules.Rules, 3)
+	for i, expected := range []int64{1, 1, 0} {
+		require.Equal(t, expected, rules.Rules[i].Matched, rules.Rules[i].Rule.Name)
+		require.Equal(t, expected, rules.Rules[i].Applied, rules.Rules[i].Rule.Name)
+	}
+
+	_, err = fi.RemoveFaultRule(ctx, &introproto.FaultRuleName{Name: "by type"})
+	require.NoError(t, err)
+	out = fi.Inject(faultMessage(t, "5", gen.Reference(), getObject), pub.publish)
+	require.Len(t, out, 1, "message isn't matched by removed rule")
+
+	_, err = fi.RemoveFaultRule(ctx, &introproto.FaultRuleName{Name: "by type"})
+	require.Error(t, err)
+}
+
+func TestFaultInjector_Actions(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	getObject := &payload.GetObject{Polymorph: uint32(payload.TypeGetObject)}
+
+	t.Run("duplicate", func(t *testing.T) {
+		fi := NewFaultInjector(ctx, insolar.StaticRoleVirtual)
+		_, err := fi.SetFaultRule(ctx, &introproto.FaultRule{
+			Name:   "duplicate",
+			Action: introproto.FaultAction_Duplicate,
+			Count:  2,
+		})
+		require.NoError(t, err)
+
+		out := fi.Inject(faultMessage(t, "1", gen.Reference(), getObject), (&published{}).publish)
+		require.Len(t, out, 3)
+		for _, m := range out {
+			require.Equal(t, "1", m.UUID)
+		}
+	})
+
+	t.Run("delay", func(t *testing.T) {
+		fi := NewFaultInjector(ctx, insolar.StaticRoleVirtual)
+		_, err := fi.SetFaultRule(ctx, &introproto.FaultRule{
+			Name:     "delay",
+			Action:   introproto.FaultAction_Delay,
+			DelayMs:  10,
+			JitterMs: 10,
+		})
+		require.NoError(t, err)
+
+		pub := &published{}
+		out := fi.Inject(faultMessage(t, "1", gen.Reference(), getObject), pub.publish)
+		require.Empty(t, out)
+		require.Eventually(t, func() bool {
+			return len(pub.get()) == 1
+		}, time.Second, 5*time.Millisecond)
+	})
+
+	t.Run("reorder", func(t *testing.T) {
+		fi := NewFaultInjector(ctx, insolar.StaticRoleVirtual)
+		_, err := fi.SetFaultRule(ctx, &introproto.FaultRule{
+			Name:    "reorder",
+			Action:  introproto.FaultAction_Reorder,
+			DelayMs: int64(time.Minute / time.Millisecond),
+		})
+		require.NoError(t, err)
+
+		pub := &published{}
+		require.Empty(t, fi.Inject(faultMessage(t, "1", gen.Reference(), getObject), pub.publish))
+		require.Empty(t, fi.Inject(faultMessage(t, "2", gen.Reference(), getObject), pub.publish))
+		messages := pub.get()
+		require.Len(t, messages, 2)
+		require.Equal(t, "2", messages[0].UUID)
+		require.Equal(t, "1", messages[1].UUID)
+
+		// held message is released on rule removal
+		require.Empty(t, fi.Inject(faultMessage(t, "3", gen.Reference(), getObject), pub.publish))
+		_, err = fi.RemoveFaultRule(ctx, &introproto.FaultRuleName{Name: "reorder"})
+		require.NoError(t, err)
+		require.Len(t, pub.get(), 3)
+	})
+
+	t.Run("reorder rule replaced while holding message", func(t *testing.T) {
+		fi := NewFaultInjector(ctx, insolar.StaticRoleVirtual)
+		rule := &introproto.FaultRule{
+			Name:    "reorder",
+			Action:  introproto.FaultAction_Reorder,
+			DelayMs: 10,
+		}
+		_, err := fi.SetFaultRule(ctx, rule)
+		require.NoError(t, err)
+
+		pub := &published{}
+		require.Empty(t, fi.Inject(faultMessage(t, "1", gen.Reference(), getObject), pub.publish))
+		rule.DelayMs = int64(time.Minute / time.Millisecond)
+		_, err = fi.SetFaultRule(ctx, rule)
+		require.NoError(t, err)
+
+		// held message is released once by the timer of the replaced rule
+		require.Eventually(t, func() bool {
+			return len(pub.get()) == 1
+		}, time.Second, 5*time.Millisecond)
+		require.Empty(t, fi.Inject(faultMessage(t, "2", gen.Reference(), getObject), pub.publish))
+		_, err = fi.RemoveFaultRule(ctx, &introproto.FaultRuleName{Name: "reorder"})
+		require.NoError(t, err)
+		messages := pub.get()
+		require.Len(t, messages, 2)
+		require.Equal(t, "1", messages[0].UUID)
+		require.Equal(t, "2", messages[1].UUID)
+	})
+
+	t.Run("probability", func(t *testing.T) {
+		fi := NewFaultInjector(ctx, insolar.StaticRoleVirtual)
+		_, err := fi.SetFaultRule(ctx, &introproto.FaultRule{
+			Name:        "drop",
+			Action:      introproto.FaultAction_Drop,
+			Probability: 0.5,
+		})
+		require.NoError(t, err)
+
+		total := 1000
+		for i := 0; i < total; i++ {
+			fi.Inject(faultMessage(t, "1", gen.Reference(), getObject), (&published{}).publish)
+		}
+		rules, err := fi.GetFaultRules(ctx, nil)
+		require.NoError(t, err)
+		require.Equal(t, int64(total), rules.Rules[0].Matched)
+		require.InDelta(t, total/2, rules.Rules[0].Applied, float64(total/5))
+	})
+}
+
+func TestFaultInjector_SetFaultRule_Invalid(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	fi := NewFaultInjector(ctx, insolar.StaticRoleVirtual)
+
+	for _, rule := range []*introproto.FaultRule{
+		{},
+		{Name: "type", Type: "TypeUnknownMessage"},
+		{Name: "role", Role: "pulsar"},
+		{Name: "sender", Sender: "bad reference"},
+		{Name: "probability", Probability: 1.5},
+		{Name: "delay", DelayMs: -1},
+		{Name: "action", Action: introproto.FaultAction(42)},
+	} {
+		_, err := fi.SetFaultRule(ctx, rule)
+		require.Error(t, err, rule.Name)
+	}
+}
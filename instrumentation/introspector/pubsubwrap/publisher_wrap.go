@@ -18,11 +18,21 @@ type FilterMiddleware interface {
 	Filter(m *message.Message) (*message.Message, error)
 }
 
+// PublishFunc publishes messages to the topic of injected message.
+type PublishFunc func(messages ...*message.Message) error
+
+// InjectorMiddleware an interface for fault injection into messages passed all filters.
+type InjectorMiddleware interface {
+	// Inject returns messages to publish instead of provided one, publish is used to publish messages later.
+	Inject(m *message.Message, publish PublishFunc) []*message.Message
+}
+
 // PublisherWrapper wraps message Publisher.
 type PublisherWrapper struct {
 	pub message.Publisher
 
-	filters []FilterMiddleware
+	filters  []FilterMiddleware
+	injector InjectorMiddleware
 }
 
 // NewPublisherWrapper creates new message.Publisher wrapper.
@@ -37,6 +47,11 @@ func (p *PublisherWrapper) Middleware(fm ...FilterMiddleware) {
 	p.filters = append(p.filters, fm...)
 }
 
+// Injector sets fault injector, it is applied after all middleware filters.
+func (p *PublisherWrapper) Injector(im InjectorMiddleware) {
+	p.injector = im
+}
+
 // Publish wraps message.Publish method, i.e. applies all middleware filters for every message.
 func (p *PublisherWrapper) Publish(topic string, messages ...*message.Message) error {
 	if topic == bus.TopicOutgoing {
@@ -64,13 +79,24 @@ func (p *PublisherWrapper) Publish(topic string, messages ...*message.Message) e
 				break FiltersLoop
 			}
 		}
-		if m != nil {
-			out = append(out, m)
+		if m == nil {
+			continue
 		}
+		if p.injector != nil {
+			out = append(out, p.injector.Inject(m, p.publishFunc(topic))...)
+			continue
+		}
+		out = append(out, m)
 	}
 	return p.pub.Publish(topic, out...)
 }
 
+func (p *PublisherWrapper) publishFunc(topic string) PublishFunc {
+	return func(messages ...*message.Message) error {
+		return p.pub.Publish(topic, messages...)
+	}
+}
+
 // Close wraps message.Close method.
 func (p *PublisherWrapper) Close() error {
 	return p.pub.Close()
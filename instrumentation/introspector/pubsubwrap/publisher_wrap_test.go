@@ -28,6 +28,22 @@ func TestWrapper(t *testing.T) {
 	require.Equal(t, int(expectAll/2), pubMock.published, "expect half of messages are passed wrapper")
 }
 
+func TestWrapper_Injector(t *testing.T) {
+	pubMock := &pubMock{}
+	pw := NewPublisherWrapper(pubMock)
+	pw.Injector(injector{})
+
+	err := pw.Publish("", genMessages(3)...)
+	require.NoError(t, err, "should no error on publish messages")
+	require.Equal(t, 6, pubMock.published, "expect injector duplicates every message")
+}
+
+type injector struct{}
+
+func (injector) Inject(m *message.Message, _ PublishFunc) []*message.Message {
+	return []*message.Message{m, m.Copy()}
+}
+
 type middleware map[string]int
 
 func (mi middleware) counter() int {
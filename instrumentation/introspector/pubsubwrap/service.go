@@ -13,15 +13,17 @@ import (
 type PublisherService struct {
 	*MessageLockerByType
 	*MessageStatByType
+	*FaultInjector
 }
 
 // programming and compile time check
 var _ introproto.PublisherServer = PublisherService{}
 
 // NewPublisherService creates PublisherService.
-func NewPublisherService(ml *MessageLockerByType, ms *MessageStatByType) PublisherService {
+func NewPublisherService(ml *MessageLockerByType, ms *MessageStatByType, fi *FaultInjector) PublisherService {
 	return PublisherService{
 		MessageLockerByType: ml,
 		MessageStatByType:   ms,
+		FaultInjector:       fi,
 	}
 }
@@ -23,6 +23,33 @@ publisherSwagger = `{
     "application/json"
   ],
   "paths": {
+    "/getFaultRules": {
+      "post": {
+        "summary": "GetFaultRules returns fault injection rules with hit statistic.",
+        "operationId": "GetFaultRules",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/introprotoAllFaultRules"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/introprotoEmptyArgs"
+            }
+          }
+        ],
+        "tags": [
+          "Publisher"
+        ]
+      }
+    },
     "/getMessagesFilters": {
       "post": {
         "summary": "GetMessagesFilters returns map with filter state for every message type.",
@@ -77,6 +104,60 @@ publisherSwagger = `{
         ]
       }
     },
+    "/removeFaultRule": {
+      "post": {
+        "summary": "RemoveFaultRule removes fault injection rule by name.",
+        "operationId": "RemoveFaultRule",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/introprotoFaultRuleName"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/introprotoFaultRuleName"
+            }
+          }
+        ],
+        "tags": [
+          "Publisher"
+        ]
+      }
+    },
+    "/setFaultRule": {
+      "post": {
+        "summary": "SetFaultRule adds fault injection rule or replaces rule with the same name.",
+        "operationId": "SetFaultRule",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/introprotoFaultRule"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/introprotoFaultRule"
+            }
+          }
+        ],
+        "tags": [
+          "Publisher"
+        ]
+      }
+    },
     "/setMessagesFilter": {
       "post": {
         "summary": "SetMessagesFilter enables/disables messages publishing by type.",
@@ -106,6 +187,18 @@ publisherSwagger = `{
     }
   },
   "definitions": {
+    "introprotoAllFaultRules": {
+      "type": "object",
+      "properties": {
+        "Rules": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/introprotoFaultRuleWithStat"
+          }
+        }
+      },
+      "description": "AllFaultRules is a list of fault injection rules in order of matching."
+    },
     "introprotoAllMessageFilterStats": {
       "type": "object",
       "properties": {
@@ -134,6 +227,86 @@ publisherSwagger = `{
       "type": "object",
       "description": "EmptyArgs is just a stub for grpc methods without arguments."
     },
+    "introprotoFaultAction": {
+      "type": "string",
+      "enum": [
+        "Drop",
+        "Delay",
+        "Duplicate",
+        "Reorder"
+      ],
+      "default": "Drop",
+      "description": "FaultAction is an action of fault injection rule.\n\n - Drop: Drop drops matched message.\n - Delay: Delay publishes matched message after DelayMs plus random jitter up to JitterMs.\n - Duplicate: Duplicate publishes matched message Count+1 times.\n - Reorder: Reorder holds matched message until the next matched message and publishes it after the next one.\nMessage is held not longer than DelayMs (1 second if not set)."
+    },
+    "introprotoFaultRule": {
+      "type": "object",
+      "properties": {
+        "Name": {
+          "type": "string"
+        },
+        "Type": {
+          "type": "string",
+          "description": "Type is a message payload type."
+        },
+        "Role": {
+          "type": "string",
+          "description": "Role is a role of the target node (virtual, light_material or heavy_material)."
+        },
+        "Sender": {
+          "type": "string",
+          "description": "Sender is a reference of the sender node."
+        },
+        "Action": {
+          "$ref": "#/definitions/introprotoFaultAction"
+        },
+        "Probability": {
+          "type": "number",
+          "format": "double",
+          "description": "Probability of applying action to matched message, 1 if not set."
+        },
+        "DelayMs": {
+          "type": "string",
+          "format": "int64"
+        },
+        "JitterMs": {
+          "type": "string",
+          "format": "int64"
+        },
+        "Count": {
+          "type": "integer",
+          "format": "int32"
+        }
+      },
+      "description": "FaultRule matches incoming messages and applies fault action to them.\nEmpty matcher fields match any message, the first matched rule is applied."
+    },
+    "introprotoFaultRuleName": {
+      "type": "object",
+      "properties": {
+        "Name": {
+          "type": "string"
+        }
+      },
+      "description": "FaultRuleName identifies fault injection rule."
+    },
+    "introprotoFaultRuleWithStat": {
+      "type": "object",
+      "properties": {
+        "Rule": {
+          "$ref": "#/definitions/introprotoFaultRule"
+        },
+        "Matched": {
+          "type": "string",
+          "format": "int64",
+          "description": "Matched is a count of messages matched by rule."
+        },
+        "Applied": {
+          "type": "string",
+          "format": "int64",
+          "description": "Applied is a count of messages action was applied to."
+        }
+      },
+      "description": "FaultRuleWithStat represents fault injection rule and its hit statistic."
+    },
     "introprotoMessageFilterByType": {
       "type": "object",
       "properties": {
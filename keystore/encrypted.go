@@ -0,0 +1,60 @@
+// Copyright 2020 Insolar Network Ltd.
+// All rights reserved.
+// This material is licensed under the Insolar License version 1.0,
+// available at https://github.com/insolar/insolar/blob/master/LICENSE.md.
+
+package keystore
+
+import (
+	"os"
+
+	"github.com/insolar/insolar/keystore/internal/privatekey"
+)
+
+const (
+	// PassphraseEnv is an environment variable with passphrase of encrypted keys file.
+	PassphraseEnv = "INSOLAR_KEYSTORE_PASSPHRASE"
+	// PassphraseFileEnv is an environment variable with path to the file with passphrase of encrypted keys file.
+	PassphraseFileEnv = "INSOLAR_KEYSTORE_PASSPHRASE_FILE"
+)
+
+const (
+	KDFArgon2id = privatekey.KDFArgon2id
+	KDFScrypt   = privatekey.KDFScrypt
+)
+
+var (
+	ErrNoPassphrase    = privatekey.ErrNoPassphrase
+	ErrWrongPassphrase = privatekey.ErrWrongPassphrase
+)
+
+type (
+	// KeysFile is a JSON file with node keys.
+	KeysFile = privatekey.KeysFile
+	// EncryptedKey is a private key encrypted with a key derived from passphrase.
+	EncryptedKey = privatekey.EncryptedKey
+	// Passphrase returns passphrase for encrypted private key.
+	Passphrase = privatekey.Passphrase
+)
+
+var (
+	PassphraseFromEnv    = privatekey.PassphraseFromEnv
+	PassphraseFromFile   = privatekey.PassphraseFromFile
+	PassphraseFromPrompt = privatekey.PassphraseFromPrompt
+	FirstPassphrase      = privatekey.FirstPassphrase
+)
+
+// DefaultPassphrase takes passphrase from PassphraseEnv, then from the file in PassphraseFileEnv,
+// then asks it in the terminal.
+func DefaultPassphrase(confirm bool) Passphrase {
+	return FirstPassphrase(
+		PassphraseFromEnv(PassphraseEnv),
+		PassphraseFromFile(os.Getenv(PassphraseFileEnv)),
+		PassphraseFromPrompt("Enter passphrase for keys file: ", confirm),
+	)
+}
+
+// EncryptPrivateKey encrypts PEM encoded private key with AES-256-GCM and a key derived from passphrase by kdf.
+func EncryptPrivateKey(privateKeyPEM []byte, passphrase []byte, kdf string) (*EncryptedKey, error) {
+	return privatekey.Encrypt(privateKeyPEM, passphrase, kdf)
+}
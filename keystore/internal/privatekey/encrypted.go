@@ -0,0 +1,173 @@
+// Copyright 2020 Insolar Network Ltd.
+// All rights reserved.
+// This material is licensed under the Insolar License version 1.0,
+// available at https://github.com/insolar/insolar/blob/master/LICENSE.md.
+
+package privatekey
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/hex"
+	"io"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	KDFArgon2id = "argon2id"
+	KDFScrypt   = "scrypt"
+
+	CipherAES256GCM = "aes-256-gcm"
+)
+
+const (
+	saltSize = 32
+	keySize  = 32
+
+	argon2Time    = 3
+	argon2Memory  = 64 * 1024
+	argon2Threads = 4
+
+	scryptN = 1 << 18
+	scryptR = 8
+	scryptP = 1
+)
+
+// ErrWrongPassphrase is returned when encrypted key can't be decrypted with provided passphrase.
+var ErrWrongPassphrase = errors.New("wrong passphrase or corrupted key")
+
+// KDFParams holds parameters of key derivation function. Only parameters of used function are set.
+type KDFParams struct {
+	Salt   string `json:"salt"`
+	KeyLen uint32 `json:"keylen"`
+
+	// argon2id
+	Time    uint32 `json:"time,omitempty"`
+	Memory  uint32 `json:"memory,omitempty"`
+	Threads uint8  `json:"threads,omitempty"`
+
+	// scrypt
+	N int `json:"n,omitempty"`
+	R int `json:"r,omitempty"`
+	P int `json:"p,omitempty"`
+}
+
+// EncryptedKey is a private key encrypted with a key derived from passphrase.
+type EncryptedKey struct {
+	KDF        string    `json:"kdf"`
+	KDFParams  KDFParams `json:"kdfparams"`
+	Cipher     string    `json:"cipher"`
+	Nonce      string    `json:"nonce"`
+	CipherText string    `json:"ciphertext"`
+}
+
+// Encrypt encrypts PEM encoded private key with AES-256-GCM and a key derived from passphrase by provided function.
+func Encrypt(key []byte, passphrase []byte, kdf string) (*EncryptedKey, error) {
+	salt := make([]byte, saltSize)
+	if _, err := io.ReadFull(rand.Reader, salt); err != nil {
+		return nil, errors.Wrap(err, "[ Encrypt ] failed to generate salt")
+	}
+
+	encrypted := &EncryptedKey{
+		KDF:    kdf,
+		Cipher: CipherAES256GCM,
+	}
+	encrypted.KDFParams = KDFParams{
+		Salt:   hex.EncodeToString(salt),
+		KeyLen: keySize,
+	}
+	switch kdf {
+	case KDFArgon2id:
+		encrypted.KDFParams.Time = argon2Time
+		encrypted.KDFParams.Memory = argon2Memory
+		encrypted.KDFParams.Threads = argon2Threads
+	case KDFScrypt:
+		encrypted.KDFParams.N = scryptN
+		encrypted.KDFParams.R = scryptR
+		encrypted.KDFParams.P = scryptP
+	default:
+		return nil, errors.Errorf("[ Encrypt ] unknown kdf %q", kdf)
+	}
+
+	derived, err := encrypted.deriveKey(passphrase)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ Encrypt ]")
+	}
+	aead, err := newAEAD(derived)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ Encrypt ]")
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, errors.Wrap(err, "[ Encrypt ] failed to generate nonce")
+	}
+
+	encrypted.Nonce = hex.EncodeToString(nonce)
+	encrypted.CipherText = hex.EncodeToString(aead.Seal(nil, nonce, key, nil))
+	return encrypted, nil
+}
+
+// Decrypt returns PEM encoded private key.
+func (k *EncryptedKey) Decrypt(passphrase []byte) ([]byte, error) {
+	if k.Cipher != CipherAES256GCM {
+		return nil, errors.Errorf("[ Decrypt ] unknown cipher %q", k.Cipher)
+	}
+	nonce, err := hex.DecodeString(k.Nonce)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ Decrypt ] failed to decode nonce")
+	}
+	cipherText, err := hex.DecodeString(k.CipherText)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ Decrypt ] failed to decode ciphertext")
+	}
+
+	derived, err := k.deriveKey(passphrase)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ Decrypt ]")
+	}
+	aead, err := newAEAD(derived)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ Decrypt ]")
+	}
+	if len(nonce) != aead.NonceSize() {
+		return nil, errors.New("[ Decrypt ] wrong nonce size")
+	}
+
+	key, err := aead.Open(nil, nonce, cipherText, nil)
+	if err != nil {
+		return nil, ErrWrongPassphrase
+	}
+	return key, nil
+}
+
+func (k *EncryptedKey) deriveKey(passphrase []byte) ([]byte, error) {
+	params := k.KDFParams
+	salt, err := hex.DecodeString(params.Salt)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode salt")
+	}
+	if params.KeyLen != keySize {
+		return nil, errors.Errorf("unsupported key length %d", params.KeyLen)
+	}
+
+	switch k.KDF {
+	case KDFArgon2id:
+		return argon2.IDKey(passphrase, salt, params.Time, params.Memory, params.Threads, params.KeyLen), nil
+	case KDFScrypt:
+		return scrypt.Key(passphrase, salt, params.N, params.R, params.P, int(params.KeyLen))
+	default:
+		return nil, errors.Errorf("unknown kdf %q", k.KDF)
+	}
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create cipher")
+	}
+	return cipher.NewGCM(block)
+}
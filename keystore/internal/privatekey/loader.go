@@ -14,49 +14,82 @@ import (
 	"path/filepath"
 
 	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/keystore/signer"
 )
 
+// KeysFile is a JSON file with node keys. Private key is stored either in plain PEM, encrypted,
+// or isn't stored at all, when external signer is used.
+type KeysFile struct {
+	PrivateKey          string        `json:"private_key,omitempty"`
+	EncryptedPrivateKey *EncryptedKey `json:"encrypted_private_key,omitempty"`
+	Signer              string        `json:"signer,omitempty"`
+	PublicKey           string        `json:"public_key"`
+}
+
 type keyLoader struct {
-	parseFunc func(key []byte) (crypto.PrivateKey, error)
+	parseFunc  func(key []byte) (crypto.PrivateKey, error)
+	passphrase Passphrase
 }
 
-func NewLoader() Loader {
+func NewLoader(passphrase Passphrase) Loader {
 	return &keyLoader{
-		parseFunc: pemParse,
+		parseFunc:  pemParse,
+		passphrase: passphrase,
 	}
 }
 
 func (p *keyLoader) Load(file string) (crypto.PrivateKey, error) {
-	key, err := readJSON(file)
+	keys, err := readJSON(file)
 	if err != nil {
 		return nil, errors.Wrap(err, "[ Load ] Could't read private key")
 	}
 
-	signer, err := p.parseFunc(key)
+	var key []byte
+	switch {
+	case keys.Signer != "":
+		client, err := signer.Dial(keys.Signer)
+		if err != nil {
+			return nil, errors.Wrap(err, "[ Load ] Could't connect to external signer")
+		}
+		return client, nil
+	case keys.EncryptedPrivateKey != nil:
+		passphrase, err := p.passphrase()
+		if err != nil {
+			return nil, errors.Wrap(err, "[ Load ] Could't get passphrase for encrypted private key")
+		}
+		key, err = keys.EncryptedPrivateKey.Decrypt(passphrase)
+		if err != nil {
+			return nil, errors.Wrap(err, "[ Load ] Could't decrypt private key")
+		}
+	default:
+		key = []byte(keys.PrivateKey)
+	}
+
+	privateKey, err := p.parseFunc(key)
 	if err != nil {
 		return nil, errors.Wrap(err, "[ Load ] Could't parse private key")
 	}
-	return signer, nil
+	return privateKey, nil
 }
 
 // deprecated, todo: use PEM format
-func readJSON(path string) ([]byte, error) {
+func readJSON(path string) (*KeysFile, error) {
 	data, err := ioutil.ReadFile(filepath.Clean(path))
 	if err != nil {
 		return nil, errors.Wrap(err, "[ read ] couldn't read keys from: "+path)
 	}
-	var keys map[string]string
-	err = json.Unmarshal(data, &keys)
+	keys := &KeysFile{}
+	err = json.Unmarshal(data, keys)
 	if err != nil {
 		return nil, errors.Wrap(err, "[ read ] failed to parse json.")
 	}
 
-	key, ok := keys["private_key"]
-	if !ok {
+	if keys.PrivateKey == "" && keys.EncryptedPrivateKey == nil && keys.Signer == "" {
 		return nil, errors.Errorf("[ read ] couldn't read keys from: %s", path)
 	}
 
-	return []byte(key), nil
+	return keys, nil
 }
 
 func pemParse(key []byte) (crypto.PrivateKey, error) {
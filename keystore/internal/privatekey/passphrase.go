@@ -0,0 +1,98 @@
+// Copyright 2020 Insolar Network Ltd.
+// All rights reserved.
+// This material is licensed under the Insolar License version 1.0,
+// available at https://github.com/insolar/insolar/blob/master/LICENSE.md.
+
+package privatekey
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/ssh/terminal"
+)
+
+// ErrNoPassphrase is returned by passphrase source, that can't provide passphrase.
+var ErrNoPassphrase = errors.New("passphrase is not provided")
+
+// Passphrase returns passphrase for encrypted private key.
+type Passphrase func() ([]byte, error)
+
+// PassphraseFromEnv reads passphrase from environment variable.
+func PassphraseFromEnv(name string) Passphrase {
+	return func() ([]byte, error) {
+		value, ok := os.LookupEnv(name)
+		if !ok {
+			return nil, ErrNoPassphrase
+		}
+		return []byte(value), nil
+	}
+}
+
+// PassphraseFromFile reads passphrase from the file. Trailing newline is trimmed.
+func PassphraseFromFile(path string) Passphrase {
+	return func() ([]byte, error) {
+		if path == "" {
+			return nil, ErrNoPassphrase
+		}
+		data, err := ioutil.ReadFile(filepath.Clean(path))
+		if err != nil {
+			return nil, errors.Wrapf(err, "[ PassphraseFromFile ] couldn't read passphrase from: %s", path)
+		}
+		return bytes.TrimRight(data, "\r\n"), nil
+	}
+}
+
+// PassphraseFromPrompt asks passphrase in the terminal. If confirm is set, passphrase is asked twice.
+func PassphraseFromPrompt(prompt string, confirm bool) Passphrase {
+	return func() ([]byte, error) {
+		fd := int(os.Stdin.Fd())
+		if !terminal.IsTerminal(fd) {
+			return nil, ErrNoPassphrase
+		}
+
+		passphrase, err := readPassword(fd, prompt)
+		if err != nil {
+			return nil, err
+		}
+		if !confirm {
+			return passphrase, nil
+		}
+		repeated, err := readPassword(fd, "Repeat passphrase: ")
+		if err != nil {
+			return nil, err
+		}
+		if !bytes.Equal(passphrase, repeated) {
+			return nil, errors.New("[ PassphraseFromPrompt ] passphrases do not match")
+		}
+		return passphrase, nil
+	}
+}
+
+func readPassword(fd int, prompt string) ([]byte, error) {
+	fmt.Fprint(os.Stderr, prompt)
+	passphrase, err := terminal.ReadPassword(fd)
+	fmt.Fprintln(os.Stderr)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ PassphraseFromPrompt ] couldn't read passphrase")
+	}
+	return passphrase, nil
+}
+
+// FirstPassphrase returns passphrase from the first source, that provides it.
+func FirstPassphrase(sources ...Passphrase) Passphrase {
+	return func() ([]byte, error) {
+		for _, source := range sources {
+			passphrase, err := source()
+			if err == ErrNoPassphrase {
+				continue
+			}
+			return passphrase, err
+		}
+		return nil, ErrNoPassphrase
+	}
+}
@@ -12,6 +12,7 @@ import (
 	component "github.com/insolar/component-manager"
 	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/keystore/internal/privatekey"
+	"github.com/insolar/insolar/keystore/signer"
 	"github.com/pkg/errors"
 )
 
@@ -71,7 +72,13 @@ func (ks *cachedKeyStore) Start(ctx context.Context) error {
 	return nil
 }
 
+// NewKeyStore creates key store for keys file. Encrypted keys file is unlocked with DefaultPassphrase.
 func NewKeyStore(path string) (insolar.KeyStore, error) {
+	return NewKeyStoreWithPassphrase(path, DefaultPassphrase(false))
+}
+
+// NewKeyStoreWithPassphrase creates key store for keys file, encrypted keys file is unlocked with provided passphrase.
+func NewKeyStoreWithPassphrase(path string, passphrase Passphrase) (insolar.KeyStore, error) {
 	keyStore := &keyStore{
 		file: path,
 	}
@@ -84,11 +91,11 @@ func NewKeyStore(path string) (insolar.KeyStore, error) {
 	manager.Inject(
 		cachedKeyStore,
 		keyStore,
-		privatekey.NewLoader(),
+		privatekey.NewLoader(passphrase),
 	)
 
 	if err := manager.Start(context.Background()); err != nil {
-		return nil, errors.Wrap(err, "[ NewKeyStore ] Failed to create keyStore")
+		return nil, errors.Wrap(err, "[ NewKeyStoreWithPassphrase ] Failed to create keyStore")
 	}
 
 	return cachedKeyStore, nil
@@ -105,3 +112,13 @@ func (ipks *inPlaceKeyStore) GetPrivateKey(string) (crypto.PrivateKey, error) {
 func NewInplaceKeyStore(privateKey crypto.PrivateKey) insolar.KeyStore {
 	return &inPlaceKeyStore{privateKey: privateKey}
 }
+
+// NewExternalSignerKeyStore creates key store, that delegates signing to external signer on unix socket.
+// Private key of the store is a crypto.Signer, it never leaves the signer.
+func NewExternalSignerKeyStore(addr string) (insolar.KeyStore, error) {
+	client, err := signer.Dial(addr)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ NewExternalSignerKeyStore ] Failed to connect to external signer")
+	}
+	return &inPlaceKeyStore{privateKey: client}, nil
+}
@@ -6,10 +6,20 @@
 package keystore
 
 import (
+	"crypto"
 	"crypto/ecdsa"
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/keystore/signer"
+	"github.com/insolar/insolar/platformpolicy"
 )
 
 const (
@@ -50,3 +60,150 @@ func TestKeyStore_GetPrivateKeyReturnsECDSA(t *testing.T) {
 	require.NotNil(t, ecdsaPK)
 	require.True(t, ok)
 }
+
+const (
+	testEncryptedKeysArgon2id = "testdata/encrypted_keys_argon2id.json"
+	testEncryptedKeysScrypt   = "testdata/encrypted_keys_scrypt.json"
+	testPassphrase            = "test passphrase"
+)
+
+func staticPassphrase(passphrase string) Passphrase {
+	return func() ([]byte, error) {
+		return []byte(passphrase), nil
+	}
+}
+
+func TestNewKeyStoreWithPassphrase(t *testing.T) {
+	expected, err := NewKeyStore(testKeys)
+	require.NoError(t, err)
+	expectedPK, err := expected.GetPrivateKey("")
+	require.NoError(t, err)
+
+	for _, file := range []string{testEncryptedKeysArgon2id, testEncryptedKeysScrypt} {
+		t.Run(file, func(t *testing.T) {
+			ks, err := NewKeyStoreWithPassphrase(file, staticPassphrase(testPassphrase))
+			require.NoError(t, err)
+
+			pk, err := ks.GetPrivateKey("")
+			require.NoError(t, err)
+			require.Equal(t, expectedPK, pk)
+		})
+	}
+}
+
+func TestNewKeyStoreWithPassphrase_Fails(t *testing.T) {
+	t.Run("wrong passphrase", func(t *testing.T) {
+		ks, err := NewKeyStoreWithPassphrase(testEncryptedKeysArgon2id, staticPassphrase("wrong"))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), ErrWrongPassphrase.Error())
+		require.Nil(t, ks)
+	})
+
+	t.Run("no passphrase", func(t *testing.T) {
+		ks, err := NewKeyStoreWithPassphrase(testEncryptedKeysArgon2id, FirstPassphrase())
+		require.Error(t, err)
+		require.Contains(t, err.Error(), ErrNoPassphrase.Error())
+		require.Nil(t, ks)
+	})
+}
+
+func TestDefaultPassphrase(t *testing.T) {
+	t.Run("from env", func(t *testing.T) {
+		defer os.Unsetenv(PassphraseEnv)
+		require.NoError(t, os.Setenv(PassphraseEnv, testPassphrase))
+
+		ks, err := NewKeyStore(testEncryptedKeysScrypt)
+		require.NoError(t, err)
+		require.NotNil(t, ks)
+	})
+
+	t.Run("from file", func(t *testing.T) {
+		file, err := ioutil.TempFile("", "passphrase-")
+		require.NoError(t, err)
+		defer os.Remove(file.Name())
+		_, err = file.WriteString(testPassphrase + "\n")
+		require.NoError(t, err)
+		require.NoError(t, file.Close())
+
+		defer os.Unsetenv(PassphraseFileEnv)
+		require.NoError(t, os.Setenv(PassphraseFileEnv, file.Name()))
+
+		ks, err := NewKeyStore(testEncryptedKeysArgon2id)
+		require.NoError(t, err)
+		require.NotNil(t, ks)
+	})
+}
+
+func TestEncryptPrivateKey(t *testing.T) {
+	data, err := ioutil.ReadFile(testKeys)
+	require.NoError(t, err)
+	keys := KeysFile{}
+	require.NoError(t, json.Unmarshal(data, &keys))
+
+	encrypted, err := EncryptPrivateKey([]byte(keys.PrivateKey), []byte(testPassphrase), KDFArgon2id)
+	require.NoError(t, err)
+	require.NotContains(t, encrypted.CipherText, keys.PrivateKey)
+
+	decrypted, err := encrypted.Decrypt([]byte(testPassphrase))
+	require.NoError(t, err)
+	require.Equal(t, keys.PrivateKey, string(decrypted))
+
+	_, err = EncryptPrivateKey([]byte(keys.PrivateKey), []byte(testPassphrase), "md5")
+	require.Error(t, err)
+}
+
+func TestNewExternalSignerKeyStore(t *testing.T) {
+	local, err := NewKeyStore(testKeys)
+	require.NoError(t, err)
+	localPK, err := local.GetPrivateKey("")
+	require.NoError(t, err)
+
+	dir, err := ioutil.TempDir("", "signer-")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	addr := filepath.Join(dir, "signer.sock")
+
+	l, err := net.Listen("unix", addr)
+	require.NoError(t, err)
+	defer l.Close()
+	go signer.Serve(l, localPK.(crypto.Signer))
+
+	scheme := platformpolicy.NewPlatformCryptographyScheme()
+	kp := platformpolicy.NewKeyProcessor()
+	payload := []byte("payload")
+
+	check := func(t *testing.T, ks insolar.KeyStore) {
+		pk, err := ks.GetPrivateKey("")
+		require.NoError(t, err)
+		_, isECDSA := pk.(*ecdsa.PrivateKey)
+		require.False(t, isECDSA)
+		require.Equal(t, kp.ExtractPublicKey(localPK), kp.ExtractPublicKey(pk))
+
+		signature, err := scheme.DataSigner(pk, scheme.IntegrityHasher()).Sign(payload)
+		require.NoError(t, err)
+		require.True(t, scheme.DataVerifier(kp.ExtractPublicKey(localPK), scheme.IntegrityHasher()).Verify(*signature, payload))
+	}
+
+	t.Run("by address", func(t *testing.T) {
+		ks, err := NewExternalSignerKeyStore(addr)
+		require.NoError(t, err)
+		check(t, ks)
+	})
+
+	t.Run("by keys file", func(t *testing.T) {
+		keysFile := filepath.Join(dir, "keys.json")
+		data, err := json.Marshal(KeysFile{Signer: addr})
+		require.NoError(t, err)
+		require.NoError(t, ioutil.WriteFile(keysFile, data, 0600))
+
+		ks, err := NewKeyStore(keysFile)
+		require.NoError(t, err)
+		check(t, ks)
+	})
+
+	t.Run("signer is not available", func(t *testing.T) {
+		ks, err := NewExternalSignerKeyStore(filepath.Join(dir, "none.sock"))
+		require.Error(t, err)
+		require.Nil(t, ks)
+	})
+}
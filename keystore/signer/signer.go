@@ -0,0 +1,178 @@
+// Copyright 2020 Insolar Network Ltd.
+// All rights reserved.
+// This material is licensed under the Insolar License version 1.0,
+// available at https://github.com/insolar/insolar/blob/master/LICENSE.md.
+
+// Package signer implements protocol of external signer, that keeps node private key out of the node process.
+//
+// Signer is a JSON-RPC 1.0 service named "Signer", that listens on a local unix socket and has two methods:
+//
+//	Signer.Public(PublicArgs) PublicReply  returns PEM encoded public key of the signer
+//	Signer.Sign(SignArgs) SignReply        signs digest and returns ASN.1 DER encoded ECDSA signature
+//	                                       or raw Ed25519 signature
+//
+// Signer may be implemented in any language, Serve runs it for crypto.Signer.
+package signer
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"io"
+	"net"
+	"net/rpc"
+	"net/rpc/jsonrpc"
+	"sync"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/ed25519"
+)
+
+const serviceName = "Signer"
+
+type PublicArgs struct{}
+
+type PublicReply struct {
+	PublicKey string
+}
+
+type SignArgs struct {
+	Digest []byte
+}
+
+type SignReply struct {
+	Signature []byte
+}
+
+// Client is a crypto.Signer, that delegates signing to external signer.
+// Connection is restored on the next call, if signer is restarted.
+type Client struct {
+	addr   string
+	public crypto.PublicKey
+
+	lock   sync.Mutex
+	client *rpc.Client
+}
+
+// Dial connects to external signer on unix socket and requests its public key.
+func Dial(addr string) (*Client, error) {
+	c := &Client{addr: addr}
+
+	reply := PublicReply{}
+	err := c.call("Public", PublicArgs{}, &reply)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ Dial ] failed to request public key")
+	}
+	block, _ := pem.Decode([]byte(reply.PublicKey))
+	if block == nil {
+		return nil, errors.New("[ Dial ] problems with decoding public key PEM")
+	}
+	c.public, err = x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ Dial ] problems with parsing public key")
+	}
+	return c, nil
+}
+
+// Public returns public key of external signer.
+func (c *Client) Public() crypto.PublicKey {
+	return c.public
+}
+
+// Sign signs digest by external signer. Digest is expected to be hashed already, so opts are ignored.
+func (c *Client) Sign(_ io.Reader, digest []byte, _ crypto.SignerOpts) ([]byte, error) {
+	reply := SignReply{}
+	err := c.call("Sign", SignArgs{Digest: digest}, &reply)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ Sign ] external signer failed")
+	}
+	return reply.Signature, nil
+}
+
+// Close closes connection to external signer.
+func (c *Client) Close() error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.client == nil {
+		return nil
+	}
+	err := c.client.Close()
+	c.client = nil
+	return err
+}
+
+func (c *Client) call(method string, args interface{}, reply interface{}) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	for attempt := 0; ; attempt++ {
+		if c.client == nil {
+			conn, err := net.Dial("unix", c.addr)
+			if err != nil {
+				return errors.Wrapf(err, "failed to connect to signer %s", c.addr)
+			}
+			c.client = jsonrpc.NewClient(conn)
+		}
+
+		err := c.client.Call(serviceName+"."+method, args, reply)
+		if err != rpc.ErrShutdown || attempt > 0 {
+			return err
+		}
+		// Signer was restarted, connection is restored once.
+		c.client = nil
+	}
+}
+
+type service struct {
+	signer    crypto.Signer
+	opts      crypto.SignerOpts
+	publicKey string
+}
+
+func (s *service) Public(_ PublicArgs, reply *PublicReply) error {
+	reply.PublicKey = s.publicKey
+	return nil
+}
+
+func (s *service) Sign(args SignArgs, reply *SignReply) error {
+	signature, err := s.signer.Sign(rand.Reader, args.Digest, s.opts)
+	if err != nil {
+		return err
+	}
+	reply.Signature = signature
+	return nil
+}
+
+// Serve serves external signer with provided key on the listener. It returns when listener is closed.
+func Serve(l net.Listener, signer crypto.Signer) error {
+	x509EncodedPub, err := x509.MarshalPKIXPublicKey(signer.Public())
+	if err != nil {
+		return errors.Wrap(err, "[ Serve ] failed to marshal public key")
+	}
+
+	// Ed25519 requires explicit zero hash, ECDSA signs digest as is with empty opts.
+	var opts crypto.SignerOpts
+	if _, ok := signer.Public().(ed25519.PublicKey); ok {
+		opts = crypto.Hash(0)
+	}
+
+	server := rpc.NewServer()
+	err = server.RegisterName(serviceName, &service{
+		signer:    signer,
+		opts:      opts,
+		publicKey: string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: x509EncodedPub})),
+	})
+	if err != nil {
+		return errors.Wrap(err, "[ Serve ] failed to register signer")
+	}
+
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			return err
+		}
+		go server.ServeCodec(jsonrpc.NewServerCodec(conn))
+	}
+}
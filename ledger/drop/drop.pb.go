@@ -26,11 +26,13 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 
 type Drop struct {
-	Polymorph              int32                                          `protobuf:"varint,16,opt,name=polymorph,proto3" json:"polymorph,omitempty"`
-	Pulse                  github_com_insolar_insolar_insolar.PulseNumber `protobuf:"bytes,20,opt,name=Pulse,proto3,customtype=github.com/insolar/insolar/insolar.PulseNumber" json:"Pulse"`
-	JetID                  github_com_insolar_insolar_insolar.JetID       `protobuf:"bytes,21,opt,name=JetID,proto3,customtype=github.com/insolar/insolar/insolar.JetID" json:"JetID"`
-	SplitThresholdExceeded int64                                          `protobuf:"varint,22,opt,name=SplitThresholdExceeded,proto3" json:"SplitThresholdExceeded,omitempty"`
-	Split                  bool                                           `protobuf:"varint,23,opt,name=Split,proto3" json:"Split,omitempty"`
+	Polymorph               int32                                          `protobuf:"varint,16,opt,name=polymorph,proto3" json:"polymorph,omitempty"`
+	Pulse                   github_com_insolar_insolar_insolar.PulseNumber `protobuf:"bytes,20,opt,name=Pulse,proto3,customtype=github.com/insolar/insolar/insolar.PulseNumber" json:"Pulse"`
+	JetID                   github_com_insolar_insolar_insolar.JetID       `protobuf:"bytes,21,opt,name=JetID,proto3,customtype=github.com/insolar/insolar/insolar.JetID" json:"JetID"`
+	SplitThresholdExceeded  int64                                          `protobuf:"varint,22,opt,name=SplitThresholdExceeded,proto3" json:"SplitThresholdExceeded,omitempty"`
+	Split                   bool                                           `protobuf:"varint,23,opt,name=Split,proto3" json:"Split,omitempty"`
+	MergeThresholdUnderflow int64                                          `protobuf:"varint,24,opt,name=MergeThresholdUnderflow,proto3" json:"MergeThresholdUnderflow,omitempty"`
+	Merge                   bool                                           `protobuf:"varint,25,opt,name=Merge,proto3" json:"Merge,omitempty"`
 }
 
 func (m *Drop) Reset()      { *m = Drop{} }
@@ -72,26 +74,28 @@ func init() {
 func init() { proto.RegisterFile("ledger/drop/drop.proto", fileDescriptor_f87624f7639ca597) }
 
 var fileDescriptor_f87624f7639ca597 = []byte{
-	// 299 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0xcb, 0x49, 0x4d, 0x49,
-	0x4f, 0x2d, 0xd2, 0x4f, 0x29, 0xca, 0x2f, 0x00, 0x13, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9, 0x42,
-	0x2c, 0x20, 0xb6, 0x94, 0x6e, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x7e,
-	0x7a, 0x7e, 0x7a, 0xbe, 0x3e, 0x58, 0x32, 0xa9, 0x34, 0x0d, 0xcc, 0x03, 0x73, 0xc0, 0x2c, 0x88,
-	0x26, 0xa5, 0x0e, 0x26, 0x2e, 0x16, 0x97, 0xa2, 0xfc, 0x02, 0x21, 0x19, 0x2e, 0xce, 0x82, 0xfc,
-	0x9c, 0xca, 0xdc, 0xfc, 0xa2, 0x82, 0x0c, 0x09, 0x01, 0x05, 0x46, 0x0d, 0xd6, 0x20, 0x84, 0x80,
-	0x90, 0x0f, 0x17, 0x6b, 0x40, 0x69, 0x4e, 0x71, 0xaa, 0x84, 0x88, 0x02, 0xa3, 0x06, 0x8f, 0x93,
-	0xd9, 0x89, 0x7b, 0xf2, 0x0c, 0xb7, 0xee, 0xc9, 0xeb, 0x21, 0x59, 0x96, 0x99, 0x57, 0x9c, 0x9f,
-	0x93, 0x58, 0x84, 0x4e, 0xeb, 0x81, 0xf5, 0xf9, 0x95, 0xe6, 0x26, 0xa5, 0x16, 0x05, 0x41, 0x0c,
-	0x11, 0x72, 0xe3, 0x62, 0xf5, 0x4a, 0x2d, 0xf1, 0x74, 0x91, 0x10, 0x05, 0x9b, 0x66, 0x00, 0x35,
-	0x4d, 0x83, 0x08, 0xd3, 0xc0, 0xfa, 0x82, 0x20, 0xda, 0x85, 0xcc, 0xb8, 0xc4, 0x82, 0x0b, 0x72,
-	0x32, 0x4b, 0x42, 0x32, 0x8a, 0x52, 0x8b, 0x33, 0xf2, 0x73, 0x52, 0x5c, 0x2b, 0x92, 0x53, 0x53,
-	0x53, 0x52, 0x53, 0x24, 0xc4, 0x14, 0x18, 0x35, 0x98, 0x83, 0x70, 0xc8, 0x0a, 0x89, 0x70, 0xb1,
-	0x82, 0x65, 0x24, 0xc4, 0x15, 0x18, 0x35, 0x38, 0x82, 0x20, 0x1c, 0x27, 0x8b, 0x13, 0x0f, 0xe5,
-	0x18, 0x2e, 0x3c, 0x94, 0x63, 0xb8, 0xf1, 0x50, 0x8e, 0xe1, 0xc3, 0x43, 0x39, 0xc6, 0x86, 0x47,
-	0x72, 0x8c, 0x2b, 0x1e, 0xc9, 0x31, 0x9e, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83,
-	0x47, 0x72, 0x8c, 0x2f, 0x1e, 0xc9, 0x31, 0x7c, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3,
-	0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x24, 0xb1, 0x81, 0xc3, 0xd2, 0x18, 0x10, 0x00,
-	0x00, 0xff, 0xff, 0x05, 0xe4, 0x61, 0x80, 0x9a, 0x01, 0x00, 0x00,
+	// 329 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0xc1, 0x4a, 0x2b, 0x31,
+	0x14, 0x86, 0x93, 0x7b, 0x3b, 0x97, 0x7b, 0xc3, 0x5d, 0x48, 0xa8, 0x6d, 0x14, 0x39, 0x1d, 0x5c,
+	0xcd, 0xc6, 0xa9, 0x20, 0x94, 0xae, 0x4b, 0x15, 0x14, 0x15, 0x19, 0xf5, 0x01, 0x9c, 0x4e, 0x3a,
+	0x53, 0x48, 0x9b, 0x21, 0x9d, 0x41, 0xdd, 0xf9, 0x08, 0x3e, 0x86, 0x8f, 0xd2, 0x65, 0x97, 0xc5,
+	0x45, 0x71, 0xd2, 0x8d, 0xcb, 0x3e, 0x82, 0xf4, 0x8c, 0xa8, 0x08, 0x05, 0x37, 0xc9, 0xf9, 0xf3,
+	0xe5, 0xff, 0xb2, 0x08, 0xab, 0x29, 0x19, 0xc5, 0xd2, 0x34, 0x23, 0xa3, 0x53, 0x5c, 0xfc, 0xd4,
+	0xe8, 0x4c, 0xf3, 0xca, 0x6a, 0xde, 0xde, 0x8b, 0x07, 0x59, 0x92, 0x87, 0x7e, 0x4f, 0x0f, 0x9b,
+	0xb1, 0x8e, 0x75, 0x13, 0x61, 0x98, 0xf7, 0x31, 0x61, 0xc0, 0xa9, 0x2c, 0xed, 0xce, 0x7e, 0xb1,
+	0x4a, 0xd7, 0xe8, 0x94, 0xef, 0xb0, 0x7f, 0xa9, 0x56, 0xf7, 0x43, 0x6d, 0xd2, 0x44, 0x6c, 0xb8,
+	0xd4, 0x73, 0x82, 0xcf, 0x03, 0x7e, 0xca, 0x9c, 0x8b, 0x5c, 0x8d, 0xa5, 0xa8, 0xba, 0xd4, 0xfb,
+	0xdf, 0x69, 0x4d, 0xe6, 0x0d, 0xf2, 0x3c, 0x6f, 0xf8, 0x5f, 0x1e, 0x1b, 0x8c, 0xc6, 0x5a, 0xdd,
+	0x98, 0xef, 0xbb, 0x8f, 0xbd, 0xf3, 0x7c, 0x18, 0x4a, 0x13, 0x94, 0x12, 0x7e, 0xc4, 0x9c, 0x13,
+	0x99, 0x1d, 0x77, 0xc5, 0x26, 0xda, 0xf6, 0xdf, 0x6d, 0xde, 0x0f, 0x6c, 0xd8, 0x0b, 0xca, 0x3a,
+	0x6f, 0xb1, 0xda, 0x65, 0xaa, 0x06, 0xd9, 0x55, 0x62, 0xe4, 0x38, 0xd1, 0x2a, 0x3a, 0xbc, 0xeb,
+	0x49, 0x19, 0xc9, 0x48, 0xd4, 0x5c, 0xea, 0xfd, 0x0e, 0xd6, 0x50, 0x5e, 0x65, 0x0e, 0x12, 0x51,
+	0x77, 0xa9, 0xf7, 0x37, 0x28, 0x03, 0x6f, 0xb3, 0xfa, 0x99, 0x34, 0xb1, 0xfc, 0xb8, 0x7f, 0x3d,
+	0x8a, 0xa4, 0xe9, 0x2b, 0x7d, 0x2b, 0x04, 0xea, 0xd6, 0xe1, 0x95, 0x0f, 0x91, 0xd8, 0x2a, 0x7d,
+	0x18, 0x3a, 0xed, 0x49, 0x01, 0x64, 0x5a, 0x00, 0x99, 0x15, 0x40, 0x96, 0x05, 0xd0, 0x07, 0x0b,
+	0xf4, 0xc9, 0x02, 0x9d, 0x58, 0xa0, 0x53, 0x0b, 0xf4, 0xc5, 0x02, 0x7d, 0xb5, 0x40, 0x96, 0x16,
+	0xe8, 0xe3, 0x02, 0xc8, 0x74, 0x01, 0x64, 0xb6, 0x00, 0x12, 0xfe, 0xc1, 0xbf, 0x39, 0x78, 0x1b,
+	0x00, 0x8b, 0x69, 0x16, 0x27, 0xea, 0x01, 0x00, 0x00,
 }
 
 func (this *Drop) Equal(that interface{}) bool {
@@ -128,19 +132,27 @@ func (this *Drop) Equal(that interface{}) bool {
 	if this.Split != that1.Split {
 		return false
 	}
+	if this.MergeThresholdUnderflow != that1.MergeThresholdUnderflow {
+		return false
+	}
+	if this.Merge != that1.Merge {
+		return false
+	}
 	return true
 }
 func (this *Drop) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 9)
+	s := make([]string, 0, 11)
 	s = append(s, "&drop.Drop{")
 	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
 	s = append(s, "Pulse: "+fmt.Sprintf("%#v", this.Pulse)+",\n")
 	s = append(s, "JetID: "+fmt.Sprintf("%#v", this.JetID)+",\n")
 	s = append(s, "SplitThresholdExceeded: "+fmt.Sprintf("%#v", this.SplitThresholdExceeded)+",\n")
 	s = append(s, "Split: "+fmt.Sprintf("%#v", this.Split)+",\n")
+	s = append(s, "MergeThresholdUnderflow: "+fmt.Sprintf("%#v", this.MergeThresholdUnderflow)+",\n")
+	s = append(s, "Merge: "+fmt.Sprintf("%#v", this.Merge)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -213,6 +225,25 @@ func (m *Drop) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i++
 	}
+	if m.MergeThresholdUnderflow != 0 {
+		dAtA[i] = 0xc0
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintDrop(dAtA, i, uint64(m.MergeThresholdUnderflow))
+	}
+	if m.Merge {
+		dAtA[i] = 0xc8
+		i++
+		dAtA[i] = 0x1
+		i++
+		if m.Merge {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
 	return i, nil
 }
 
@@ -244,6 +275,12 @@ func (m *Drop) Size() (n int) {
 	if m.Split {
 		n += 3
 	}
+	if m.MergeThresholdUnderflow != 0 {
+		n += 2 + sovDrop(uint64(m.MergeThresholdUnderflow))
+	}
+	if m.Merge {
+		n += 3
+	}
 	return n
 }
 
@@ -270,6 +307,8 @@ func (this *Drop) String() string {
 		`JetID:` + fmt.Sprintf("%v", this.JetID) + `,`,
 		`SplitThresholdExceeded:` + fmt.Sprintf("%v", this.SplitThresholdExceeded) + `,`,
 		`Split:` + fmt.Sprintf("%v", this.Split) + `,`,
+		`MergeThresholdUnderflow:` + fmt.Sprintf("%v", this.MergeThresholdUnderflow) + `,`,
+		`Merge:` + fmt.Sprintf("%v", this.Merge) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -435,6 +474,45 @@ func (m *Drop) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Split = bool(v != 0)
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MergeThresholdUnderflow", wireType)
+			}
+			m.MergeThresholdUnderflow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MergeThresholdUnderflow |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Merge", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Merge = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDrop(dAtA[iNdEx:])
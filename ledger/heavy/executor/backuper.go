@@ -7,7 +7,10 @@ package executor
 
 import (
 	"context"
+	"encoding/binary"
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -50,9 +53,12 @@ type BackupInfo struct {
 type BackupMakerDefault struct {
 	lock              sync.RWMutex
 	lastBackupedPulse insolar.PulseNumber
-	backuper          store.Backuper
-	config            configuration.Backup
-	db                store.DB
+	// lastBackupedVersion is badger's version of the last confirmed backup.
+	// It's kept in value of backup start key of the pulse, so it's rolled back together with the data.
+	lastBackupedVersion uint64
+	backuper            store.Backuper
+	config              configuration.Backup
+	db                  store.DB
 }
 
 func isPathExists(dirName string) error {
@@ -144,11 +150,22 @@ func NewBackupMaker(ctx context.Context,
 		inslogger.FromContext(ctx).Info("Backup is disabled")
 	}
 
+	var lastBackupedVersion uint64
+	if backupConfig.Enabled && backupConfig.Incremental {
+		var err error
+		lastBackupedVersion, err = backupedVersion(db, lastBackupedPulse)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get last backuped version")
+		}
+		inslogger.FromContext(ctx).Infof("Incremental backup continues from version %d", lastBackupedVersion)
+	}
+
 	return &BackupMakerDefault{
-		backuper:          backuper,
-		config:            backupConfig,
-		lastBackupedPulse: lastBackupedPulse,
-		db:                db,
+		backuper:            backuper,
+		config:              backupConfig,
+		lastBackupedPulse:   lastBackupedPulse,
+		lastBackupedVersion: lastBackupedVersion,
+		db:                  db,
 	}, nil
 }
 
@@ -238,6 +255,96 @@ func (b *BackupMakerDefault) prepareBackup(pulse insolar.PulseNumber) error {
 	return nil
 }
 
+// confirmBackup saves badger's version of confirmed backup of the pulse, so incremental backups continue from it
+// after restart.
+func (b *BackupMakerDefault) confirmBackup(pulse insolar.PulseNumber, version uint64) error {
+	value := make([]byte, 8)
+	binary.BigEndian.PutUint64(value, version)
+	err := b.db.Set(BackupStartKey(pulse), value)
+	if err != nil {
+		return errors.Wrap(err, "Failed to set backuped version")
+	}
+
+	return nil
+}
+
+// backupedVersion returns badger's version of the last backup up to the pulse.
+// Zero version means that the last backup isn't confirmed (e.g. DB is restored from it), so the next backup
+// contains all data.
+func backupedVersion(db store.DB, pulse insolar.PulseNumber) (uint64, error) {
+	it := db.NewIterator(BackupStartKey(pulse), true)
+	defer it.Close()
+
+	if !it.Next() {
+		return 0, nil
+	}
+	value, err := it.Value()
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to get backup start value")
+	}
+	if len(value) == 0 {
+		return 0, nil
+	}
+	return binary.BigEndian.Uint64(value), nil
+}
+
+// makeIncrementalBackup writes data changed since the last confirmed backup and meta info to the temporary directory
+// and moves them to the target directory of the pulse.
+func (b *BackupMakerDefault) makeIncrementalBackup(ctx context.Context, pulse insolar.PulseNumber, targetDir string) (uint64, error) {
+	if b.backuper == nil {
+		return 0, errors.New("backuper is not set")
+	}
+
+	tmpDir := filepath.Join(b.config.TmpDirectory, filepath.Base(targetDir))
+	err := os.RemoveAll(tmpDir)
+	if err != nil {
+		return 0, errors.Wrapf(err, "can't clean tmp dir %s", tmpDir)
+	}
+	err = os.MkdirAll(tmpDir, 0777)
+	if err != nil {
+		return 0, errors.Wrapf(err, "can't create tmp dir %s", tmpDir)
+	}
+
+	file, err := os.Create(filepath.Join(tmpDir, b.config.BackupFile))
+	if err != nil {
+		return 0, errors.Wrap(err, "can't create backup file")
+	}
+	version, err := b.backuper.Backup(file, b.lastBackupedVersion)
+	closeErr := file.Close()
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to write incremental backup")
+	}
+	if closeErr != nil {
+		return 0, errors.Wrap(closeErr, "can't close backup file")
+	}
+
+	info, err := json.MarshalIndent(BackupInfo{
+		Pulse:               pulse,
+		LastBackupedVersion: version,
+		Since:               b.lastBackupedVersion,
+	}, "", "    ")
+	if err != nil {
+		return 0, errors.Wrap(err, "can't marshal backup info")
+	}
+	err = ioutil.WriteFile(filepath.Join(tmpDir, b.config.MetaInfoFile), info, 0600)
+	if err != nil {
+		return 0, errors.Wrap(err, "can't write backup info")
+	}
+
+	// backup of the pulse wasn't confirmed before, so it's replaced
+	err = os.RemoveAll(targetDir)
+	if err != nil {
+		return 0, errors.Wrapf(err, "can't clean target dir %s", targetDir)
+	}
+	err = move(ctx, tmpDir, targetDir)
+	if err != nil {
+		return 0, err
+	}
+
+	inslogger.FromContext(ctx).Infof("incremental backup for pulse %s is written. versions: %d - %d", pulse, b.lastBackupedVersion, version)
+	return version, nil
+}
+
 func (b *BackupMakerDefault) doBackup(ctx context.Context, lastFinalizedPulse insolar.PulseNumber) error {
 
 	err := b.prepareBackup(lastFinalizedPulse)
@@ -248,13 +355,21 @@ func (b *BackupMakerDefault) doBackup(ctx context.Context, lastFinalizedPulse in
 	currentBkpDirName := fmt.Sprintf(b.config.DirNameTemplate, lastFinalizedPulse)
 	currentBkpDirPath := filepath.Join(b.config.TargetDirectory, currentBkpDirName)
 
-	confirmFile := filepath.Join(currentBkpDirPath, b.config.ConfirmFile)
-
-	err = os.MkdirAll(filepath.Dir(confirmFile), 0777)
-	if err != nil {
-		return errors.Wrapf(err, "can't create target dir")
+	var version uint64
+	if b.config.Incremental {
+		version, err = b.makeIncrementalBackup(ctx, lastFinalizedPulse, currentBkpDirPath)
+		if err != nil {
+			return errors.Wrapf(err, "failed to make incremental backup. pulse: %d", lastFinalizedPulse)
+		}
+	} else {
+		err = os.MkdirAll(currentBkpDirPath, 0777)
+		if err != nil {
+			return errors.Wrapf(err, "can't create target dir")
+		}
 	}
 
+	confirmFile := filepath.Join(currentBkpDirPath, b.config.ConfirmFile)
+
 	err = invokeBackupPostProcessCommand(ctx, b.config.PostProcessBackupCmd, currentBkpDirPath)
 	if err != nil {
 		return errors.Wrapf(err, "failed to invoke PostProcessBackupCmd. pulse: %d", lastFinalizedPulse)
@@ -265,6 +380,13 @@ func (b *BackupMakerDefault) doBackup(ctx context.Context, lastFinalizedPulse in
 		return errors.Wrapf(err, "waitForBackup returns error. pulse: %d", lastFinalizedPulse)
 	}
 
+	if b.config.Incremental {
+		err = b.confirmBackup(lastFinalizedPulse, version)
+		if err != nil {
+			return errors.Wrapf(err, "failed to confirm backup. pulse: %d", lastFinalizedPulse)
+		}
+		b.lastBackupedVersion = version
+	}
 	return nil
 }
 
@@ -7,6 +7,7 @@ package executor_test
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"os"
@@ -160,6 +161,71 @@ func TestBackuper_HappyPath(t *testing.T) {
 
 	err = bm.MakeBackup(context.Background(), testPulse+1)
 	require.NoError(t, err)
+
+	// incremental backup is disabled, so post process command backs up data itself
+	_, err = os.Stat(filepath.Join(filepath.Dir(confirmFile), cfg.Backup.BackupFile))
+	require.True(t, os.IsNotExist(err))
+}
+
+func TestBackuper_Incremental(t *testing.T) {
+	testPulse := insolar.GenesisPulse.PulseNumber + 1
+
+	tmpdir, err := ioutil.TempDir("", "bdb-test-")
+	defer os.RemoveAll(tmpdir)
+	require.NoError(t, err)
+
+	cfg, tmpDir := makeBackuperConfig(t, t.Name(), tmpdir)
+	defer clearData(t, tmpDir)
+
+	cfg.Backup.BackupWaitPeriod = 1
+	cfg.Backup.Incremental = true
+	cfg.Backup.PostProcessBackupCmd = []string{"bash", "-c", "touch $INSOLAR_CURRENT_BACKUP_DIR/" + cfg.Backup.ConfirmFile}
+
+	ops := BadgerDefaultOptions(tmpdir)
+	db, err := store.NewBadgerDB(ops)
+	require.NoError(t, err)
+	defer db.Stop(context.Background())
+
+	backupInfo := func(pulse insolar.PulseNumber) executor.BackupInfo {
+		dir := filepath.Join(cfg.Backup.TargetDirectory, fmt.Sprintf(cfg.Backup.DirNameTemplate, pulse))
+		_, err := os.Stat(filepath.Join(dir, cfg.Backup.BackupFile))
+		require.NoError(t, err)
+		data, err := ioutil.ReadFile(filepath.Join(dir, cfg.Backup.MetaInfoFile))
+		require.NoError(t, err)
+		var info executor.BackupInfo
+		require.NoError(t, json.Unmarshal(data, &info))
+		return info
+	}
+
+	bm, err := executor.NewBackupMaker(context.Background(), db, cfg, testPulse, db)
+	require.NoError(t, err)
+	err = bm.MakeBackup(context.Background(), testPulse+1)
+	require.NoError(t, err)
+	first := backupInfo(testPulse + 1)
+	require.Equal(t, uint64(0), first.Since)
+
+	err = bm.MakeBackup(context.Background(), testPulse+2)
+	require.NoError(t, err)
+	second := backupInfo(testPulse + 2)
+	require.Equal(t, first.LastBackupedVersion, second.Since)
+
+	t.Run("backup continues after restart", func(t *testing.T) {
+		bm, err := executor.NewBackupMaker(context.Background(), db, cfg, testPulse+2, db)
+		require.NoError(t, err)
+		err = bm.MakeBackup(context.Background(), testPulse+3)
+		require.NoError(t, err)
+		require.Equal(t, second.LastBackupedVersion, backupInfo(testPulse+3).Since)
+	})
+
+	t.Run("backup after not confirmed one contains all data", func(t *testing.T) {
+		require.NoError(t, db.Set(executor.BackupStartKey(testPulse+4), []byte{}))
+
+		bm, err := executor.NewBackupMaker(context.Background(), db, cfg, testPulse+4, db)
+		require.NoError(t, err)
+		err = bm.MakeBackup(context.Background(), testPulse+5)
+		require.NoError(t, err)
+		require.Equal(t, uint64(0), backupInfo(testPulse+5).Since)
+	})
 }
 
 func TestBackuper_BackupWaitPeriodExpired(t *testing.T) {
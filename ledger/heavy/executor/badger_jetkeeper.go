@@ -39,13 +39,13 @@ func (jk *BadgerDBJetKeeper) Storage() jet.Storage {
 	return jk.jetTrees
 }
 
-func (jk *BadgerDBJetKeeper) AddHotConfirmation(ctx context.Context, pn insolar.PulseNumber, id insolar.JetID, split bool) error {
+func (jk *BadgerDBJetKeeper) AddHotConfirmation(ctx context.Context, pn insolar.PulseNumber, id insolar.JetID, split, merge bool) error {
 	jk.lock.Lock()
 	defer jk.lock.Unlock()
 
 	inslogger.FromContext(ctx).Debug("AddHotConfirmation. pulse: ", pn, ". ID: ", id.DebugString())
 
-	if err := jk.updateHot(ctx, pn, id, split); err != nil {
+	if err := jk.updateHot(ctx, pn, id, split, merge); err != nil {
 		return errors.Wrapf(err, "failed to save updated jets")
 	}
 
@@ -53,13 +53,13 @@ func (jk *BadgerDBJetKeeper) AddHotConfirmation(ctx context.Context, pn insolar.
 }
 
 // AddDropConfirmation performs adding jet to storage and checks pulse completion.
-func (jk *BadgerDBJetKeeper) AddDropConfirmation(ctx context.Context, pn insolar.PulseNumber, id insolar.JetID, split bool) error {
+func (jk *BadgerDBJetKeeper) AddDropConfirmation(ctx context.Context, pn insolar.PulseNumber, id insolar.JetID, split, merge bool) error {
 	jk.lock.Lock()
 	defer jk.lock.Unlock()
 
-	inslogger.FromContext(ctx).Debug("AddDropConfirmation. pulse: ", pn, ". ID: ", id.DebugString(), ", Split: ", split)
+	inslogger.FromContext(ctx).Debug("AddDropConfirmation. pulse: ", pn, ". ID: ", id.DebugString(), ", Split: ", split, ", Merge: ", merge)
 
-	if err := jk.updateDrop(ctx, pn, id, split); err != nil {
+	if err := jk.updateDrop(ctx, pn, id, split, merge); err != nil {
 		return errors.Wrapf(err, "AddDropConfirmation. failed to save updated jets")
 	}
 
@@ -161,18 +161,23 @@ func (jk *BadgerDBJetKeeper) getForJet(ctx context.Context, pulse insolar.PulseN
 	return len(jets) - 1, jets, nil
 }
 
-func (jk *BadgerDBJetKeeper) updateHot(ctx context.Context, pulse insolar.PulseNumber, id insolar.JetID, split bool) error {
+func (jk *BadgerDBJetKeeper) updateHot(ctx context.Context, pulse insolar.PulseNumber, id insolar.JetID, split, merge bool) error {
 	parentID := id
 	if split {
 		parentID = jet.Parent(id)
 	}
+	// Merged jet is confirmed by itself, hot data is received by its parent.
+	hotID := id
+	if merge {
+		hotID = jet.Parent(id)
+	}
 
 	idx, jets, err := jk.getForJet(ctx, pulse, parentID)
 	if err != nil {
 		return errors.Wrap(err, "Can't getForJet")
 	}
 
-	err = jets[idx].addHot(id, parentID, split)
+	err = jets[idx].addHot(hotID, parentID, split, merge)
 	if err != nil {
 		return errors.Wrap(err, "can't addHot")
 	}
@@ -180,13 +185,13 @@ func (jk *BadgerDBJetKeeper) updateHot(ctx context.Context, pulse insolar.PulseN
 	return jk.set(pulse, jets)
 }
 
-func (jk *BadgerDBJetKeeper) updateDrop(ctx context.Context, pulse insolar.PulseNumber, id insolar.JetID, split bool) error {
+func (jk *BadgerDBJetKeeper) updateDrop(ctx context.Context, pulse insolar.PulseNumber, id insolar.JetID, split, merge bool) error {
 	idx, jets, err := jk.getForJet(ctx, pulse, id)
 	if err != nil {
 		return errors.Wrap(err, "Can't getForJet")
 	}
 
-	err = jets[idx].addDrop(id, split)
+	err = jets[idx].addDrop(id, split, merge)
 	if err != nil {
 		return errors.Wrap(err, "can't addHot")
 	}
@@ -229,6 +234,7 @@ func (jk *BadgerDBJetKeeper) getTopSyncJets(ctx context.Context) ([]insolar.JetI
 		return nil, errors.Wrapf(err, "can't getTopSyncJets: %d", top)
 	}
 
+	merged := map[insolar.JetID]struct{}{}
 	for _, ji := range jets {
 		if !ji.IsSplitSet {
 			inslogger.FromContext(ctx).Error("IsSplitJet must be set before calling for isConfirmed")
@@ -237,6 +243,13 @@ func (jk *BadgerDBJetKeeper) getTopSyncJets(ctx context.Context) ([]insolar.JetI
 		if ji.Split {
 			left, right := jet.Siblings(ji.JetID)
 			result = append(result, left, right)
+		} else if ji.Merge {
+			// Both merged siblings result in their parent.
+			parent := jet.Parent(ji.JetID)
+			if _, ok := merged[parent]; !ok {
+				merged[parent] = struct{}{}
+				result = append(result, parent)
+			}
 		} else {
 			result = append(result, ji.JetID)
 		}
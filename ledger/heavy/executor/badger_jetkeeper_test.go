@@ -78,11 +78,11 @@ func TestJetInfoIsConfirmed_OneDropOneHot(t *testing.T) {
 	err := jets.Update(ctx, testPulse, true, testJet)
 	require.NoError(t, err)
 
-	err = ji.AddHotConfirmation(ctx, testPulse, testJet, false)
+	err = ji.AddHotConfirmation(ctx, testPulse, testJet, false, false)
 	require.NoError(t, err)
 	require.Equal(t, insolar.GenesisPulse.PulseNumber, ji.TopSyncPulse())
 
-	err = ji.AddDropConfirmation(ctx, testPulse, testJet, false)
+	err = ji.AddDropConfirmation(ctx, testPulse, testJet, false, false)
 	require.NoError(t, err)
 
 	require.Equal(t, insolar.GenesisPulse.PulseNumber, ji.TopSyncPulse())
@@ -103,23 +103,23 @@ func Test_DifferentSplitFlagsInDropsAndHots(t *testing.T) {
 	testJet := insolar.ZeroJetID
 
 	// AddHotConfirmation: 'true' come first
-	err := ji.AddDropConfirmation(ctx, testPulse, testJet, true)
+	err := ji.AddDropConfirmation(ctx, testPulse, testJet, true, false)
 	require.NoError(t, err)
-	err = ji.AddHotConfirmation(ctx, testPulse, testJet, false)
+	err = ji.AddHotConfirmation(ctx, testPulse, testJet, false, false)
 	require.Contains(t, err.Error(), "try to change split from true to false")
 
 	// AddHotConfirmation: 'false' comes first
 	left, _ := jet.Siblings(testJet)
 	leftLeft, rightLeft := jet.Siblings(left)
-	err = ji.AddHotConfirmation(ctx, testPulse, left, false)
+	err = ji.AddHotConfirmation(ctx, testPulse, left, false, false)
 	require.NoError(t, err)
-	err = ji.AddHotConfirmation(ctx, testPulse, leftLeft, true)
+	err = ji.AddHotConfirmation(ctx, testPulse, leftLeft, true, false)
 	require.Contains(t, err.Error(), "try to change split from false to true")
 
 	// AddDropConfirmation
-	err = ji.AddHotConfirmation(ctx, testPulse, rightLeft, false)
+	err = ji.AddHotConfirmation(ctx, testPulse, rightLeft, false, false)
 	require.NoError(t, err)
-	err = ji.AddDropConfirmation(ctx, testPulse, rightLeft, true)
+	err = ji.AddDropConfirmation(ctx, testPulse, rightLeft, true, false)
 	require.Contains(t, err.Error(), "try to change split from false to true")
 }
 
@@ -137,10 +137,10 @@ func TestJetInfoIsConfirmed_Split(t *testing.T) {
 
 	err := jets.Update(ctx, testPulse, true, testJet)
 	require.NoError(t, err)
-	err = ji.AddHotConfirmation(ctx, testPulse, testJet, false)
+	err = ji.AddHotConfirmation(ctx, testPulse, testJet, false, false)
 	require.NoError(t, err)
 	require.Equal(t, insolar.GenesisPulse.PulseNumber, ji.TopSyncPulse())
-	err = ji.AddDropConfirmation(ctx, testPulse, testJet, false)
+	err = ji.AddDropConfirmation(ctx, testPulse, testJet, false, false)
 	require.NoError(t, err)
 	require.Equal(t, insolar.GenesisPulse.PulseNumber, ji.TopSyncPulse())
 	err = ji.AddBackupConfirmation(ctx, testPulse)
@@ -153,17 +153,79 @@ func TestJetInfoIsConfirmed_Split(t *testing.T) {
 	left, right := jet.Siblings(testJet)
 	err = jets.Update(ctx, nextPulse, true, testJet)
 	require.NoError(t, err)
-	err = ji.AddDropConfirmation(ctx, nextPulse, testJet, true)
+	err = ji.AddDropConfirmation(ctx, nextPulse, testJet, true, false)
 	require.NoError(t, err)
-	err = ji.AddHotConfirmation(ctx, nextPulse, left, true)
+	err = ji.AddHotConfirmation(ctx, nextPulse, left, true, false)
 	require.NoError(t, err)
-	err = ji.AddHotConfirmation(ctx, nextPulse, right, true)
+	err = ji.AddHotConfirmation(ctx, nextPulse, right, true, false)
 	require.NoError(t, err)
 	err = ji.AddBackupConfirmation(ctx, nextPulse)
 	require.NoError(t, err)
 	require.Equal(t, nextPulse, ji.TopSyncPulse())
 }
 
+func TestJetInfoIsConfirmed_Merge(t *testing.T) {
+	t.Parallel()
+	ctx := inslogger.TestContext(t)
+	testPulse := insolar.GenesisPulse.PulseNumber + 10
+	ji, tmpDir, db, jets, pulses := initBadgerDB(t, testPulse)
+	defer os.RemoveAll(tmpDir)
+	defer db.Stop(ctx)
+
+	testJet := insolar.ZeroJetID
+	left, right := jet.Siblings(testJet)
+
+	nextPulse := insolar.GenesisPulse.PulseNumber + 20
+	futurePulse := insolar.GenesisPulse.PulseNumber + 30
+
+	// split
+	err := jets.Update(ctx, testPulse, true, testJet)
+	require.NoError(t, err)
+	err = ji.AddDropConfirmation(ctx, testPulse, testJet, true, false)
+	require.NoError(t, err)
+	err = ji.AddHotConfirmation(ctx, testPulse, left, true, false)
+	require.NoError(t, err)
+	err = ji.AddHotConfirmation(ctx, testPulse, right, true, false)
+	require.NoError(t, err)
+	err = ji.AddBackupConfirmation(ctx, testPulse)
+	require.NoError(t, err)
+	require.Equal(t, testPulse, ji.TopSyncPulse())
+
+	// merge
+	err = pulses.Append(ctx, insolar.Pulse{PulseNumber: nextPulse})
+	require.NoError(t, err)
+	err = jets.Update(ctx, nextPulse, true, left, right)
+	require.NoError(t, err)
+	err = ji.AddDropConfirmation(ctx, nextPulse, left, false, true)
+	require.NoError(t, err)
+	err = ji.AddDropConfirmation(ctx, nextPulse, right, false, true)
+	require.NoError(t, err)
+	err = ji.AddHotConfirmation(ctx, nextPulse, left, false, true)
+	require.NoError(t, err)
+	require.False(t, ji.HasAllJetConfirms(ctx, nextPulse))
+	err = ji.AddHotConfirmation(ctx, nextPulse, right, false, false)
+	require.Contains(t, err.Error(), "try to change merge from true to false")
+	err = ji.AddHotConfirmation(ctx, nextPulse, right, false, true)
+	require.NoError(t, err)
+	require.True(t, ji.HasAllJetConfirms(ctx, nextPulse))
+	err = ji.AddBackupConfirmation(ctx, nextPulse)
+	require.NoError(t, err)
+	require.Equal(t, nextPulse, ji.TopSyncPulse())
+
+	// merged jet
+	err = pulses.Append(ctx, insolar.Pulse{PulseNumber: futurePulse})
+	require.NoError(t, err)
+	err = jets.Update(ctx, futurePulse, true, testJet)
+	require.NoError(t, err)
+	err = ji.AddDropConfirmation(ctx, futurePulse, testJet, false, false)
+	require.NoError(t, err)
+	err = ji.AddHotConfirmation(ctx, futurePulse, testJet, false, false)
+	require.NoError(t, err)
+	err = ji.AddBackupConfirmation(ctx, futurePulse)
+	require.NoError(t, err)
+	require.Equal(t, futurePulse, ji.TopSyncPulse())
+}
+
 func TestJetInfo_BackupConfirmComesFirst(t *testing.T) {
 	t.Parallel()
 	ctx := inslogger.TestContext(t)
@@ -186,10 +248,10 @@ func TestJetInfo_ExistingDrop(t *testing.T) {
 	defer db.Stop(ctx)
 
 	testJet := gen.JetID()
-	err := jetKeeper.AddDropConfirmation(ctx, testPulse, testJet, false)
+	err := jetKeeper.AddDropConfirmation(ctx, testPulse, testJet, false, false)
 	require.NoError(t, err)
 
-	err = jetKeeper.AddDropConfirmation(ctx, testPulse, testJet, false)
+	err = jetKeeper.AddDropConfirmation(ctx, testPulse, testJet, false, false)
 	require.Contains(t, err.Error(), "try to rewrite drop confirmation")
 	require.Equal(t, insolar.GenesisPulse.PulseNumber, jetKeeper.TopSyncPulse())
 }
@@ -203,10 +265,10 @@ func TestJetInfo_ExistingHot(t *testing.T) {
 	defer db.Stop(ctx)
 
 	testJet := gen.JetID()
-	err := jetKeeper.AddHotConfirmation(ctx, testPulse, testJet, false)
+	err := jetKeeper.AddHotConfirmation(ctx, testPulse, testJet, false, false)
 	require.NoError(t, err)
 
-	err = jetKeeper.AddHotConfirmation(ctx, testPulse, testJet, false)
+	err = jetKeeper.AddHotConfirmation(ctx, testPulse, testJet, false, false)
 	require.Contains(t, err.Error(), "try add already existing hot confirmation")
 }
 
@@ -222,13 +284,13 @@ func TestJetInfo_ExceedNumHotConfirmations(t *testing.T) {
 	testJet := gen.JetID()
 	left, right := jet.Siblings(testJet)
 
-	err := jetKeeper.AddHotConfirmation(ctx, testPulse, left, true)
+	err := jetKeeper.AddHotConfirmation(ctx, testPulse, left, true, false)
 	require.NoError(t, err)
 
-	err = jetKeeper.AddHotConfirmation(ctx, testPulse, right, true)
+	err = jetKeeper.AddHotConfirmation(ctx, testPulse, right, true, false)
 	require.NoError(t, err)
 
-	err = jetKeeper.AddHotConfirmation(ctx, testPulse, left, true)
+	err = jetKeeper.AddHotConfirmation(ctx, testPulse, left, true, false)
 	require.Contains(t, err.Error(), "num hot confirmations exceeds")
 	require.Equal(t, insolar.GenesisPulse.PulseNumber, jetKeeper.TopSyncPulse())
 }
@@ -264,10 +326,10 @@ func TestDbJetKeeper_DifferentActualAndExpectedJets(t *testing.T) {
 	err := jets.Update(ctx, testPulse, true, left)
 	require.NoError(t, err)
 
-	err = jetKeeper.AddHotConfirmation(ctx, testPulse, testJet, false)
+	err = jetKeeper.AddHotConfirmation(ctx, testPulse, testJet, false, false)
 	require.NoError(t, err)
 
-	err = jetKeeper.AddDropConfirmation(ctx, testPulse, testJet, false)
+	err = jetKeeper.AddDropConfirmation(ctx, testPulse, testJet, false, false)
 	require.NoError(t, err)
 
 	require.False(t, jetKeeper.HasAllJetConfirms(ctx, testPulse))
@@ -293,16 +355,16 @@ func TestDbJetKeeper_DifferentNumberOfActualAndExpectedJets(t *testing.T) {
 	err := jets.Update(ctx, testPulse, true, testJet)
 	require.NoError(t, err)
 
-	err = jetKeeper.AddHotConfirmation(ctx, testPulse, left, false)
+	err = jetKeeper.AddHotConfirmation(ctx, testPulse, left, false, false)
 	require.NoError(t, err)
 
-	err = jetKeeper.AddHotConfirmation(ctx, testPulse, right, false)
+	err = jetKeeper.AddHotConfirmation(ctx, testPulse, right, false, false)
 	require.NoError(t, err)
 
-	err = jetKeeper.AddDropConfirmation(ctx, testPulse, right, false)
+	err = jetKeeper.AddDropConfirmation(ctx, testPulse, right, false, false)
 	require.NoError(t, err)
 
-	err = jetKeeper.AddDropConfirmation(ctx, testPulse, left, false)
+	err = jetKeeper.AddDropConfirmation(ctx, testPulse, left, false, false)
 	require.NoError(t, err)
 
 	err = jetKeeper.AddBackupConfirmation(ctx, testPulse)
@@ -337,7 +399,7 @@ func TestDbJetKeeper_AddDropConfirmation(t *testing.T) {
 	f := fuzz.New()
 	f.Fuzz(&pulse)
 	f.Fuzz(&jet)
-	err = jetKeeper.AddDropConfirmation(ctx, pulse, jet, false)
+	err = jetKeeper.AddDropConfirmation(ctx, pulse, jet, false, false)
 	require.NoError(t, err)
 }
 
@@ -351,10 +413,10 @@ func TestDbJetKeeper_CheckJetTreeFail(t *testing.T) {
 
 	testJet := insolar.ZeroJetID
 
-	err := ji.AddHotConfirmation(ctx, testPulse, testJet, false)
+	err := ji.AddHotConfirmation(ctx, testPulse, testJet, false, false)
 	require.NoError(t, err)
 	require.Equal(t, insolar.GenesisPulse.PulseNumber, ji.TopSyncPulse())
-	err = ji.AddDropConfirmation(ctx, testPulse, testJet, false)
+	err = ji.AddDropConfirmation(ctx, testPulse, testJet, false, false)
 	require.NoError(t, err)
 	require.Equal(t, insolar.GenesisPulse.PulseNumber, ji.TopSyncPulse())
 	err = ji.AddBackupConfirmation(ctx, testPulse)
@@ -400,12 +462,12 @@ func TestDbJetKeeper_TopSyncPulse(t *testing.T) {
 
 	err = jets.Update(ctx, currentPulse, true, testJet)
 	require.NoError(t, err)
-	err = jetKeeper.AddDropConfirmation(ctx, currentPulse, testJet, false)
+	err = jetKeeper.AddDropConfirmation(ctx, currentPulse, testJet, false, false)
 	require.NoError(t, err)
 	// it's still top confirmed
 	require.Equal(t, insolar.GenesisPulse.PulseNumber, jetKeeper.TopSyncPulse())
 
-	err = jetKeeper.AddHotConfirmation(ctx, currentPulse, testJet, false)
+	err = jetKeeper.AddHotConfirmation(ctx, currentPulse, testJet, false, false)
 	require.NoError(t, err)
 	require.Equal(t, insolar.GenesisPulse.PulseNumber, jetKeeper.TopSyncPulse())
 
@@ -417,13 +479,13 @@ func TestDbJetKeeper_TopSyncPulse(t *testing.T) {
 	require.NoError(t, err)
 	left, right := jet.Siblings(testJet)
 
-	err = jetKeeper.AddDropConfirmation(ctx, nextPulse, testJet, true)
+	err = jetKeeper.AddDropConfirmation(ctx, nextPulse, testJet, true, false)
 	require.NoError(t, err)
 
-	err = jetKeeper.AddHotConfirmation(ctx, nextPulse, right, true)
+	err = jetKeeper.AddHotConfirmation(ctx, nextPulse, right, true, false)
 	require.NoError(t, err)
 	require.Equal(t, currentPulse, jetKeeper.TopSyncPulse())
-	err = jetKeeper.AddHotConfirmation(ctx, nextPulse, left, true)
+	err = jetKeeper.AddHotConfirmation(ctx, nextPulse, left, true, false)
 	require.NoError(t, err)
 	require.Equal(t, currentPulse, jetKeeper.TopSyncPulse())
 
@@ -475,9 +537,9 @@ func TestDbJetKeeper_LostDataOnNextPulseAfterSplit(t *testing.T) {
 
 	// finalize currentPulse
 	{
-		err = jetKeeper.AddHotConfirmation(ctx, currentPulse, testJet, false)
+		err = jetKeeper.AddHotConfirmation(ctx, currentPulse, testJet, false, false)
 		require.NoError(t, err)
-		err = jetKeeper.AddDropConfirmation(ctx, currentPulse, testJet, false)
+		err = jetKeeper.AddDropConfirmation(ctx, currentPulse, testJet, false, false)
 		require.NoError(t, err)
 		require.True(t, jetKeeper.HasAllJetConfirms(ctx, currentPulse))
 		err = jetKeeper.AddBackupConfirmation(ctx, currentPulse)
@@ -489,12 +551,12 @@ func TestDbJetKeeper_LostDataOnNextPulseAfterSplit(t *testing.T) {
 	{
 		err = jets.Update(ctx, nextPulse, true, testJet)
 		require.NoError(t, err)
-		err = jetKeeper.AddDropConfirmation(ctx, nextPulse, testJet, true)
+		err = jetKeeper.AddDropConfirmation(ctx, nextPulse, testJet, true, false)
 		require.NoError(t, err)
-		err = jetKeeper.AddHotConfirmation(ctx, nextPulse, left, true)
+		err = jetKeeper.AddHotConfirmation(ctx, nextPulse, left, true, false)
 		require.NoError(t, err)
 		require.False(t, jetKeeper.HasAllJetConfirms(ctx, nextPulse))
-		err = jetKeeper.AddHotConfirmation(ctx, nextPulse, right, true)
+		err = jetKeeper.AddHotConfirmation(ctx, nextPulse, right, true, false)
 		require.NoError(t, err)
 
 		require.True(t, jetKeeper.HasAllJetConfirms(ctx, currentPulse))
@@ -506,17 +568,17 @@ func TestDbJetKeeper_LostDataOnNextPulseAfterSplit(t *testing.T) {
 
 	err = jets.Update(ctx, futurePulse, true, left)
 	require.NoError(t, err)
-	err = jetKeeper.AddDropConfirmation(ctx, futurePulse, left, false)
+	err = jetKeeper.AddDropConfirmation(ctx, futurePulse, left, false, false)
 	require.NoError(t, err)
-	err = jetKeeper.AddHotConfirmation(ctx, futurePulse, left, false)
+	err = jetKeeper.AddHotConfirmation(ctx, futurePulse, left, false, false)
 	require.NoError(t, err)
 	require.True(t, jetKeeper.HasAllJetConfirms(ctx, currentPulse))
 	require.False(t, jetKeeper.HasAllJetConfirms(ctx, futurePulse))
 
 	err = jets.Update(ctx, futurePulse, true, right)
-	err = jetKeeper.AddDropConfirmation(ctx, futurePulse, right, false)
+	err = jetKeeper.AddDropConfirmation(ctx, futurePulse, right, false, false)
 	require.NoError(t, err)
-	err = jetKeeper.AddHotConfirmation(ctx, futurePulse, right, false)
+	err = jetKeeper.AddHotConfirmation(ctx, futurePulse, right, false, false)
 	require.NoError(t, err)
 
 	require.True(t, jetKeeper.HasAllJetConfirms(ctx, currentPulse))
@@ -68,9 +68,9 @@ func Test_TruncateHead(t *testing.T) {
 
 	err := jets.Update(ctx, testPulse, true, testJet)
 	require.NoError(t, err)
-	err = ji.AddHotConfirmation(ctx, testPulse, testJet, false)
+	err = ji.AddHotConfirmation(ctx, testPulse, testJet, false, false)
 	require.NoError(t, err)
-	err = ji.AddDropConfirmation(ctx, testPulse, testJet, false)
+	err = ji.AddDropConfirmation(ctx, testPulse, testJet, false, false)
 	require.NoError(t, err)
 	err = ji.AddBackupConfirmation(ctx, testPulse)
 	require.NoError(t, err)
@@ -82,9 +82,9 @@ func Test_TruncateHead(t *testing.T) {
 
 	nextPulse := testPulse + 10
 
-	err = ji.AddDropConfirmation(ctx, nextPulse, gen.JetID(), false)
+	err = ji.AddDropConfirmation(ctx, nextPulse, gen.JetID(), false, false)
 	require.NoError(t, err)
-	err = ji.AddHotConfirmation(ctx, nextPulse, gen.JetID(), false)
+	err = ji.AddHotConfirmation(ctx, nextPulse, gen.JetID(), false, false)
 	require.NoError(t, err)
 
 	_, err = db.Get(jetKeeperKey(nextPulse))
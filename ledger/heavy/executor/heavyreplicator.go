@@ -141,7 +141,7 @@ func (h *HeavyReplicatorDefault) sync(ctx context.Context) {
 		logger = logger.WithField("drop_pulse", msg.Drop.Pulse)
 
 		logger.Debug("heavy replicator storing drop confirmation")
-		if err := h.keeper.AddDropConfirmation(ctx, msg.Drop.Pulse, msg.Drop.JetID, msg.Drop.Split); err != nil {
+		if err := h.keeper.AddDropConfirmation(ctx, msg.Drop.Pulse, msg.Drop.JetID, msg.Drop.Split, msg.Drop.Merge); err != nil {
 			logger.Panic(errors.Wrapf(err, "heavy replicator failed to add drop confirmation jet=%v", msg.Drop.JetID.DebugString()))
 		}
 
@@ -339,9 +339,9 @@ func TestBackup_FullCycle(t *testing.T) {
 
 	jetKeeper := executor.NewBadgerJetKeeper(jetsDB, db, pulsesDB)
 
-	err = jetKeeper.AddHotConfirmation(ctx, testPulse, testJet, false)
+	err = jetKeeper.AddHotConfirmation(ctx, testPulse, testJet, false, false)
 	require.NoError(t, err)
-	err = jetKeeper.AddDropConfirmation(ctx, testPulse, testJet, false)
+	err = jetKeeper.AddDropConfirmation(ctx, testPulse, testJet, false, false)
 	require.NoError(t, err)
 
 	require.Equal(t, insolar.GenesisPulse.PulseNumber, jetKeeper.TopSyncPulse())
@@ -405,9 +405,9 @@ func TestBackup_UseMainDBAsBackup(t *testing.T) {
 
 	jetKeeper := executor.NewBadgerJetKeeper(jetsDB, db, pulsesDB)
 
-	err = jetKeeper.AddHotConfirmation(ctx, testPulse, testJet, false)
+	err = jetKeeper.AddHotConfirmation(ctx, testPulse, testJet, false, false)
 	require.NoError(t, err)
-	err = jetKeeper.AddDropConfirmation(ctx, testPulse, testJet, false)
+	err = jetKeeper.AddDropConfirmation(ctx, testPulse, testJet, false, false)
 	require.NoError(t, err)
 
 	require.Equal(t, insolar.GenesisPulse.PulseNumber, jetKeeper.TopSyncPulse())
@@ -438,9 +438,9 @@ func TestBackup_UseMainDBAsBackup(t *testing.T) {
 
 		// -------------------- and prepare next
 		nextPulse := testPulse + 10
-		err = jetKeeper.AddHotConfirmation(ctx, nextPulse, testJet, false)
+		err = jetKeeper.AddHotConfirmation(ctx, nextPulse, testJet, false, false)
 		require.NoError(t, err)
-		err = jetKeeper.AddDropConfirmation(ctx, nextPulse, testJet, false)
+		err = jetKeeper.AddDropConfirmation(ctx, nextPulse, testJet, false, false)
 		require.NoError(t, err)
 		err = jetsDB.Update(ctx, nextPulse, true, testJet)
 		require.NoError(t, err)
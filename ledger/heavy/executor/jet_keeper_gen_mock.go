@@ -23,14 +23,14 @@ type JetKeeperMock struct {
 	beforeAddBackupConfirmationCounter uint64
 	AddBackupConfirmationMock          mJetKeeperMockAddBackupConfirmation
 
-	funcAddDropConfirmation          func(ctx context.Context, pn insolar.PulseNumber, jet insolar.JetID, split bool) (err error)
-	inspectFuncAddDropConfirmation   func(ctx context.Context, pn insolar.PulseNumber, jet insolar.JetID, split bool)
+	funcAddDropConfirmation          func(ctx context.Context, pn insolar.PulseNumber, jet insolar.JetID, split bool, merge bool) (err error)
+	inspectFuncAddDropConfirmation   func(ctx context.Context, pn insolar.PulseNumber, jet insolar.JetID, split bool, merge bool)
 	afterAddDropConfirmationCounter  uint64
 	beforeAddDropConfirmationCounter uint64
 	AddDropConfirmationMock          mJetKeeperMockAddDropConfirmation
 
-	funcAddHotConfirmation          func(ctx context.Context, pn insolar.PulseNumber, jet insolar.JetID, split bool) (err error)
-	inspectFuncAddHotConfirmation   func(ctx context.Context, pn insolar.PulseNumber, jet insolar.JetID, split bool)
+	funcAddHotConfirmation          func(ctx context.Context, pn insolar.PulseNumber, jet insolar.JetID, split bool, merge bool) (err error)
+	inspectFuncAddHotConfirmation   func(ctx context.Context, pn insolar.PulseNumber, jet insolar.JetID, split bool, merge bool)
 	afterAddHotConfirmationCounter  uint64
 	beforeAddHotConfirmationCounter uint64
 	AddHotConfirmationMock          mJetKeeperMockAddHotConfirmation
@@ -152,7 +152,7 @@ func (mmAddBackupConfirmation *mJetKeeperMockAddBackupConfirmation) Return(err e
 	return mmAddBackupConfirmation.mock
 }
 
-//Set uses given function f to mock the JetKeeper.AddBackupConfirmation method
+// Set uses given function f to mock the JetKeeper.AddBackupConfirmation method
 func (mmAddBackupConfirmation *mJetKeeperMockAddBackupConfirmation) Set(f func(ctx context.Context, pn insolar.PulseNumber) (err error)) *JetKeeperMock {
 	if mmAddBackupConfirmation.defaultExpectation != nil {
 		mmAddBackupConfirmation.mock.t.Fatalf("Default expectation is already set for the JetKeeper.AddBackupConfirmation method")
@@ -319,6 +319,7 @@ type JetKeeperMockAddDropConfirmationParams struct {
 	pn    insolar.PulseNumber
 	jet   insolar.JetID
 	split bool
+	merge bool
 }
 
 // JetKeeperMockAddDropConfirmationResults contains results of the JetKeeper.AddDropConfirmation
@@ -327,7 +328,7 @@ type JetKeeperMockAddDropConfirmationResults struct {
 }
 
 // Expect sets up expected params for JetKeeper.AddDropConfirmation
-func (mmAddDropConfirmation *mJetKeeperMockAddDropConfirmation) Expect(ctx context.Context, pn insolar.PulseNumber, jet insolar.JetID, split bool) *mJetKeeperMockAddDropConfirmation {
+func (mmAddDropConfirmation *mJetKeeperMockAddDropConfirmation) Expect(ctx context.Context, pn insolar.PulseNumber, jet insolar.JetID, split bool, merge bool) *mJetKeeperMockAddDropConfirmation {
 	if mmAddDropConfirmation.mock.funcAddDropConfirmation != nil {
 		mmAddDropConfirmation.mock.t.Fatalf("JetKeeperMock.AddDropConfirmation mock is already set by Set")
 	}
@@ -336,7 +337,7 @@ func (mmAddDropConfirmation *mJetKeeperMockAddDropConfirmation) Expect(ctx conte
 		mmAddDropConfirmation.defaultExpectation = &JetKeeperMockAddDropConfirmationExpectation{}
 	}
 
-	mmAddDropConfirmation.defaultExpectation.params = &JetKeeperMockAddDropConfirmationParams{ctx, pn, jet, split}
+	mmAddDropConfirmation.defaultExpectation.params = &JetKeeperMockAddDropConfirmationParams{ctx, pn, jet, split, merge}
 	for _, e := range mmAddDropConfirmation.expectations {
 		if minimock.Equal(e.params, mmAddDropConfirmation.defaultExpectation.params) {
 			mmAddDropConfirmation.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAddDropConfirmation.defaultExpectation.params)
@@ -347,7 +348,7 @@ func (mmAddDropConfirmation *mJetKeeperMockAddDropConfirmation) Expect(ctx conte
 }
 
 // Inspect accepts an inspector function that has same arguments as the JetKeeper.AddDropConfirmation
-func (mmAddDropConfirmation *mJetKeeperMockAddDropConfirmation) Inspect(f func(ctx context.Context, pn insolar.PulseNumber, jet insolar.JetID, split bool)) *mJetKeeperMockAddDropConfirmation {
+func (mmAddDropConfirmation *mJetKeeperMockAddDropConfirmation) Inspect(f func(ctx context.Context, pn insolar.PulseNumber, jet insolar.JetID, split bool, merge bool)) *mJetKeeperMockAddDropConfirmation {
 	if mmAddDropConfirmation.mock.inspectFuncAddDropConfirmation != nil {
 		mmAddDropConfirmation.mock.t.Fatalf("Inspect function is already set for JetKeeperMock.AddDropConfirmation")
 	}
@@ -370,8 +371,8 @@ func (mmAddDropConfirmation *mJetKeeperMockAddDropConfirmation) Return(err error
 	return mmAddDropConfirmation.mock
 }
 
-//Set uses given function f to mock the JetKeeper.AddDropConfirmation method
-func (mmAddDropConfirmation *mJetKeeperMockAddDropConfirmation) Set(f func(ctx context.Context, pn insolar.PulseNumber, jet insolar.JetID, split bool) (err error)) *JetKeeperMock {
+// Set uses given function f to mock the JetKeeper.AddDropConfirmation method
+func (mmAddDropConfirmation *mJetKeeperMockAddDropConfirmation) Set(f func(ctx context.Context, pn insolar.PulseNumber, jet insolar.JetID, split bool, merge bool) (err error)) *JetKeeperMock {
 	if mmAddDropConfirmation.defaultExpectation != nil {
 		mmAddDropConfirmation.mock.t.Fatalf("Default expectation is already set for the JetKeeper.AddDropConfirmation method")
 	}
@@ -386,14 +387,14 @@ func (mmAddDropConfirmation *mJetKeeperMockAddDropConfirmation) Set(f func(ctx c
 
 // When sets expectation for the JetKeeper.AddDropConfirmation which will trigger the result defined by the following
 // Then helper
-func (mmAddDropConfirmation *mJetKeeperMockAddDropConfirmation) When(ctx context.Context, pn insolar.PulseNumber, jet insolar.JetID, split bool) *JetKeeperMockAddDropConfirmationExpectation {
+func (mmAddDropConfirmation *mJetKeeperMockAddDropConfirmation) When(ctx context.Context, pn insolar.PulseNumber, jet insolar.JetID, split bool, merge bool) *JetKeeperMockAddDropConfirmationExpectation {
 	if mmAddDropConfirmation.mock.funcAddDropConfirmation != nil {
 		mmAddDropConfirmation.mock.t.Fatalf("JetKeeperMock.AddDropConfirmation mock is already set by Set")
 	}
 
 	expectation := &JetKeeperMockAddDropConfirmationExpectation{
 		mock:   mmAddDropConfirmation.mock,
-		params: &JetKeeperMockAddDropConfirmationParams{ctx, pn, jet, split},
+		params: &JetKeeperMockAddDropConfirmationParams{ctx, pn, jet, split, merge},
 	}
 	mmAddDropConfirmation.expectations = append(mmAddDropConfirmation.expectations, expectation)
 	return expectation
@@ -406,15 +407,15 @@ func (e *JetKeeperMockAddDropConfirmationExpectation) Then(err error) *JetKeeper
 }
 
 // AddDropConfirmation implements JetKeeper
-func (mmAddDropConfirmation *JetKeeperMock) AddDropConfirmation(ctx context.Context, pn insolar.PulseNumber, jet insolar.JetID, split bool) (err error) {
+func (mmAddDropConfirmation *JetKeeperMock) AddDropConfirmation(ctx context.Context, pn insolar.PulseNumber, jet insolar.JetID, split bool, merge bool) (err error) {
 	mm_atomic.AddUint64(&mmAddDropConfirmation.beforeAddDropConfirmationCounter, 1)
 	defer mm_atomic.AddUint64(&mmAddDropConfirmation.afterAddDropConfirmationCounter, 1)
 
 	if mmAddDropConfirmation.inspectFuncAddDropConfirmation != nil {
-		mmAddDropConfirmation.inspectFuncAddDropConfirmation(ctx, pn, jet, split)
+		mmAddDropConfirmation.inspectFuncAddDropConfirmation(ctx, pn, jet, split, merge)
 	}
 
-	mm_params := &JetKeeperMockAddDropConfirmationParams{ctx, pn, jet, split}
+	mm_params := &JetKeeperMockAddDropConfirmationParams{ctx, pn, jet, split, merge}
 
 	// Record call args
 	mmAddDropConfirmation.AddDropConfirmationMock.mutex.Lock()
@@ -431,7 +432,7 @@ func (mmAddDropConfirmation *JetKeeperMock) AddDropConfirmation(ctx context.Cont
 	if mmAddDropConfirmation.AddDropConfirmationMock.defaultExpectation != nil {
 		mm_atomic.AddUint64(&mmAddDropConfirmation.AddDropConfirmationMock.defaultExpectation.Counter, 1)
 		mm_want := mmAddDropConfirmation.AddDropConfirmationMock.defaultExpectation.params
-		mm_got := JetKeeperMockAddDropConfirmationParams{ctx, pn, jet, split}
+		mm_got := JetKeeperMockAddDropConfirmationParams{ctx, pn, jet, split, merge}
 		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
 			mmAddDropConfirmation.t.Errorf("JetKeeperMock.AddDropConfirmation got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
@@ -443,9 +444,9 @@ func (mmAddDropConfirmation *JetKeeperMock) AddDropConfirmation(ctx context.Cont
 		return (*mm_results).err
 	}
 	if mmAddDropConfirmation.funcAddDropConfirmation != nil {
-		return mmAddDropConfirmation.funcAddDropConfirmation(ctx, pn, jet, split)
+		return mmAddDropConfirmation.funcAddDropConfirmation(ctx, pn, jet, split, merge)
 	}
-	mmAddDropConfirmation.t.Fatalf("Unexpected call to JetKeeperMock.AddDropConfirmation. %v %v %v %v", ctx, pn, jet, split)
+	mmAddDropConfirmation.t.Fatalf("Unexpected call to JetKeeperMock.AddDropConfirmation. %v %v %v %v %v", ctx, pn, jet, split, merge)
 	return
 }
 
@@ -537,6 +538,7 @@ type JetKeeperMockAddHotConfirmationParams struct {
 	pn    insolar.PulseNumber
 	jet   insolar.JetID
 	split bool
+	merge bool
 }
 
 // JetKeeperMockAddHotConfirmationResults contains results of the JetKeeper.AddHotConfirmation
@@ -545,7 +547,7 @@ type JetKeeperMockAddHotConfirmationResults struct {
 }
 
 // Expect sets up expected params for JetKeeper.AddHotConfirmation
-func (mmAddHotConfirmation *mJetKeeperMockAddHotConfirmation) Expect(ctx context.Context, pn insolar.PulseNumber, jet insolar.JetID, split bool) *mJetKeeperMockAddHotConfirmation {
+func (mmAddHotConfirmation *mJetKeeperMockAddHotConfirmation) Expect(ctx context.Context, pn insolar.PulseNumber, jet insolar.JetID, split bool, merge bool) *mJetKeeperMockAddHotConfirmation {
 	if mmAddHotConfirmation.mock.funcAddHotConfirmation != nil {
 		mmAddHotConfirmation.mock.t.Fatalf("JetKeeperMock.AddHotConfirmation mock is already set by Set")
 	}
@@ -554,7 +556,7 @@ func (mmAddHotConfirmation *mJetKeeperMockAddHotConfirmation) Expect(ctx context
 		mmAddHotConfirmation.defaultExpectation = &JetKeeperMockAddHotConfirmationExpectation{}
 	}
 
-	mmAddHotConfirmation.defaultExpectation.params = &JetKeeperMockAddHotConfirmationParams{ctx, pn, jet, split}
+	mmAddHotConfirmation.defaultExpectation.params = &JetKeeperMockAddHotConfirmationParams{ctx, pn, jet, split, merge}
 	for _, e := range mmAddHotConfirmation.expectations {
 		if minimock.Equal(e.params, mmAddHotConfirmation.defaultExpectation.params) {
 			mmAddHotConfirmation.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAddHotConfirmation.defaultExpectation.params)
@@ -565,7 +567,7 @@ func (mmAddHotConfirmation *mJetKeeperMockAddHotConfirmation) Expect(ctx context
 }
 
 // Inspect accepts an inspector function that has same arguments as the JetKeeper.AddHotConfirmation
-func (mmAddHotConfirmation *mJetKeeperMockAddHotConfirmation) Inspect(f func(ctx context.Context, pn insolar.PulseNumber, jet insolar.JetID, split bool)) *mJetKeeperMockAddHotConfirmation {
+func (mmAddHotConfirmation *mJetKeeperMockAddHotConfirmation) Inspect(f func(ctx context.Context, pn insolar.PulseNumber, jet insolar.JetID, split bool, merge bool)) *mJetKeeperMockAddHotConfirmation {
 	if mmAddHotConfirmation.mock.inspectFuncAddHotConfirmation != nil {
 		mmAddHotConfirmation.mock.t.Fatalf("Inspect function is already set for JetKeeperMock.AddHotConfirmation")
 	}
@@ -588,8 +590,8 @@ func (mmAddHotConfirmation *mJetKeeperMockAddHotConfirmation) Return(err error)
 	return mmAddHotConfirmation.mock
 }
 
-//Set uses given function f to mock the JetKeeper.AddHotConfirmation method
-func (mmAddHotConfirmation *mJetKeeperMockAddHotConfirmation) Set(f func(ctx context.Context, pn insolar.PulseNumber, jet insolar.JetID, split bool) (err error)) *JetKeeperMock {
+// Set uses given function f to mock the JetKeeper.AddHotConfirmation method
+func (mmAddHotConfirmation *mJetKeeperMockAddHotConfirmation) Set(f func(ctx context.Context, pn insolar.PulseNumber, jet insolar.JetID, split bool, merge bool) (err error)) *JetKeeperMock {
 	if mmAddHotConfirmation.defaultExpectation != nil {
 		mmAddHotConfirmation.mock.t.Fatalf("Default expectation is already set for the JetKeeper.AddHotConfirmation method")
 	}
@@ -604,14 +606,14 @@ func (mmAddHotConfirmation *mJetKeeperMockAddHotConfirmation) Set(f func(ctx con
 
 // When sets expectation for the JetKeeper.AddHotConfirmation which will trigger the result defined by the following
 // Then helper
-func (mmAddHotConfirmation *mJetKeeperMockAddHotConfirmation) When(ctx context.Context, pn insolar.PulseNumber, jet insolar.JetID, split bool) *JetKeeperMockAddHotConfirmationExpectation {
+func (mmAddHotConfirmation *mJetKeeperMockAddHotConfirmation) When(ctx context.Context, pn insolar.PulseNumber, jet insolar.JetID, split bool, merge bool) *JetKeeperMockAddHotConfirmationExpectation {
 	if mmAddHotConfirmation.mock.funcAddHotConfirmation != nil {
 		mmAddHotConfirmation.mock.t.Fatalf("JetKeeperMock.AddHotConfirmation mock is already set by Set")
 	}
 
 	expectation := &JetKeeperMockAddHotConfirmationExpectation{
 		mock:   mmAddHotConfirmation.mock,
-		params: &JetKeeperMockAddHotConfirmationParams{ctx, pn, jet, split},
+		params: &JetKeeperMockAddHotConfirmationParams{ctx, pn, jet, split, merge},
 	}
 	mmAddHotConfirmation.expectations = append(mmAddHotConfirmation.expectations, expectation)
 	return expectation
@@ -624,15 +626,15 @@ func (e *JetKeeperMockAddHotConfirmationExpectation) Then(err error) *JetKeeperM
 }
 
 // AddHotConfirmation implements JetKeeper
-func (mmAddHotConfirmation *JetKeeperMock) AddHotConfirmation(ctx context.Context, pn insolar.PulseNumber, jet insolar.JetID, split bool) (err error) {
+func (mmAddHotConfirmation *JetKeeperMock) AddHotConfirmation(ctx context.Context, pn insolar.PulseNumber, jet insolar.JetID, split bool, merge bool) (err error) {
 	mm_atomic.AddUint64(&mmAddHotConfirmation.beforeAddHotConfirmationCounter, 1)
 	defer mm_atomic.AddUint64(&mmAddHotConfirmation.afterAddHotConfirmationCounter, 1)
 
 	if mmAddHotConfirmation.inspectFuncAddHotConfirmation != nil {
-		mmAddHotConfirmation.inspectFuncAddHotConfirmation(ctx, pn, jet, split)
+		mmAddHotConfirmation.inspectFuncAddHotConfirmation(ctx, pn, jet, split, merge)
 	}
 
-	mm_params := &JetKeeperMockAddHotConfirmationParams{ctx, pn, jet, split}
+	mm_params := &JetKeeperMockAddHotConfirmationParams{ctx, pn, jet, split, merge}
 
 	// Record call args
 	mmAddHotConfirmation.AddHotConfirmationMock.mutex.Lock()
@@ -649,7 +651,7 @@ func (mmAddHotConfirmation *JetKeeperMock) AddHotConfirmation(ctx context.Contex
 	if mmAddHotConfirmation.AddHotConfirmationMock.defaultExpectation != nil {
 		mm_atomic.AddUint64(&mmAddHotConfirmation.AddHotConfirmationMock.defaultExpectation.Counter, 1)
 		mm_want := mmAddHotConfirmation.AddHotConfirmationMock.defaultExpectation.params
-		mm_got := JetKeeperMockAddHotConfirmationParams{ctx, pn, jet, split}
+		mm_got := JetKeeperMockAddHotConfirmationParams{ctx, pn, jet, split, merge}
 		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
 			mmAddHotConfirmation.t.Errorf("JetKeeperMock.AddHotConfirmation got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
@@ -661,9 +663,9 @@ func (mmAddHotConfirmation *JetKeeperMock) AddHotConfirmation(ctx context.Contex
 		return (*mm_results).err
 	}
 	if mmAddHotConfirmation.funcAddHotConfirmation != nil {
-		return mmAddHotConfirmation.funcAddHotConfirmation(ctx, pn, jet, split)
+		return mmAddHotConfirmation.funcAddHotConfirmation(ctx, pn, jet, split, merge)
 	}
-	mmAddHotConfirmation.t.Fatalf("Unexpected call to JetKeeperMock.AddHotConfirmation. %v %v %v %v", ctx, pn, jet, split)
+	mmAddHotConfirmation.t.Fatalf("Unexpected call to JetKeeperMock.AddHotConfirmation. %v %v %v %v %v", ctx, pn, jet, split, merge)
 	return
 }
 
@@ -804,7 +806,7 @@ func (mmHasAllJetConfirms *mJetKeeperMockHasAllJetConfirms) Return(b1 bool) *Jet
 	return mmHasAllJetConfirms.mock
 }
 
-//Set uses given function f to mock the JetKeeper.HasAllJetConfirms method
+// Set uses given function f to mock the JetKeeper.HasAllJetConfirms method
 func (mmHasAllJetConfirms *mJetKeeperMockHasAllJetConfirms) Set(f func(ctx context.Context, pn insolar.PulseNumber) (b1 bool)) *JetKeeperMock {
 	if mmHasAllJetConfirms.defaultExpectation != nil {
 		mmHasAllJetConfirms.mock.t.Fatalf("Default expectation is already set for the JetKeeper.HasAllJetConfirms method")
@@ -1004,7 +1006,7 @@ func (mmStorage *mJetKeeperMockStorage) Return(s1 jet.Storage) *JetKeeperMock {
 	return mmStorage.mock
 }
 
-//Set uses given function f to mock the JetKeeper.Storage method
+// Set uses given function f to mock the JetKeeper.Storage method
 func (mmStorage *mJetKeeperMockStorage) Set(f func() (s1 jet.Storage)) *JetKeeperMock {
 	if mmStorage.defaultExpectation != nil {
 		mmStorage.mock.t.Fatalf("Default expectation is already set for the JetKeeper.Storage method")
@@ -1147,7 +1149,7 @@ func (mmTopSyncPulse *mJetKeeperMockTopSyncPulse) Return(p1 insolar.PulseNumber)
 	return mmTopSyncPulse.mock
 }
 
-//Set uses given function f to mock the JetKeeper.TopSyncPulse method
+// Set uses given function f to mock the JetKeeper.TopSyncPulse method
 func (mmTopSyncPulse *mJetKeeperMockTopSyncPulse) Set(f func() (p1 insolar.PulseNumber)) *JetKeeperMock {
 	if mmTopSyncPulse.defaultExpectation != nil {
 		mmTopSyncPulse.mock.t.Fatalf("Default expectation is already set for the JetKeeper.TopSyncPulse method")
@@ -15,23 +15,26 @@ import (
 	"github.com/pkg/errors"
 )
 
-func (j *JetInfo) updateSplit(split bool) error {
+func (j *JetInfo) updateSplit(split, merge bool) error {
 	if !j.IsSplitSet {
 		j.Split = split
+		j.Merge = merge
 		j.IsSplitSet = true
 	} else if j.Split != split {
 		return errors.New(fmt.Sprintf("try to change split from %t to %t ", j.Split, split))
+	} else if j.Merge != merge {
+		return errors.New(fmt.Sprintf("try to change merge from %t to %t ", j.Merge, merge))
 	}
 	return nil
 }
 
-func (j *JetInfo) addDrop(newJetID insolar.JetID, split bool) error {
+func (j *JetInfo) addDrop(newJetID insolar.JetID, split, merge bool) error {
 	if j.DropConfirmed {
 		return errors.New("addDrop. try to rewrite drop confirmation. existing: " + j.JetID.DebugString() +
 			", new: " + newJetID.DebugString())
 	}
 
-	if err := j.updateSplit(split); err != nil {
+	if err := j.updateSplit(split, merge); err != nil {
 		return errors.Wrap(err, "updateSplit return error")
 	}
 
@@ -58,7 +61,7 @@ func (j *JetInfo) addBackup() {
 	j.BackupConfirmed = true
 }
 
-func (j *JetInfo) addHot(newJetID insolar.JetID, parentID insolar.JetID, split bool) error {
+func (j *JetInfo) addHot(newJetID insolar.JetID, parentID insolar.JetID, split, merge bool) error {
 	err := j.checkIncomingHot(newJetID)
 	if err != nil {
 		return errors.Wrap(err, "incorrect incoming jet")
@@ -66,7 +69,7 @@ func (j *JetInfo) addHot(newJetID insolar.JetID, parentID insolar.JetID, split b
 
 	j.HotConfirmed = append(j.HotConfirmed, newJetID)
 	j.JetID = parentID
-	if err := j.updateSplit(split); err != nil {
+	if err := j.updateSplit(split, merge); err != nil {
 		return errors.Wrap(err, "updateSplit return error")
 	}
 
@@ -91,6 +94,11 @@ func (j *JetInfo) isConfirmed(ctx context.Context, checkBackup bool) bool {
 		return false
 	}
 
+	if j.Merge {
+		// Merged jet receives hot data as a part of its parent.
+		return j.HotConfirmed[0].Equal(jet.Parent(j.JetID))
+	}
+
 	if !j.Split {
 		return j.HotConfirmed[0].Equal(j.JetID)
 	}
@@ -33,6 +33,7 @@ type JetInfo struct {
 	BackupConfirmed bool                                       `protobuf:"varint,23,opt,name=BackupConfirmed,proto3" json:"BackupConfirmed,omitempty"`
 	Split           bool                                       `protobuf:"varint,24,opt,name=Split,proto3" json:"Split,omitempty"`
 	IsSplitSet      bool                                       `protobuf:"varint,25,opt,name=IsSplitSet,proto3" json:"IsSplitSet,omitempty"`
+	Merge           bool                                       `protobuf:"varint,26,opt,name=Merge,proto3" json:"Merge,omitempty"`
 }
 
 func (m *JetInfo) Reset()      { *m = JetInfo{} }
@@ -114,30 +115,31 @@ func init() {
 }
 
 var fileDescriptor_34fe369629dc8d07 = []byte{
-	// 362 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x91, 0xcd, 0x4e, 0xfa, 0x40,
-	0x14, 0xc5, 0x67, 0xfe, 0xc0, 0x5f, 0x1c, 0x31, 0xea, 0x04, 0xb5, 0x1a, 0x73, 0x69, 0xd0, 0x45,
-	0x13, 0x63, 0x6b, 0x74, 0xe3, 0xba, 0x12, 0x23, 0x2c, 0x8b, 0x3e, 0x00, 0x1f, 0xd3, 0x52, 0x2d,
-	0x9d, 0xa6, 0x4c, 0x8d, 0xec, 0x7c, 0x04, 0x1f, 0xc3, 0x47, 0x21, 0x71, 0xc3, 0x92, 0xb8, 0x20,
-	0xb6, 0x6c, 0x5c, 0xf2, 0x08, 0x86, 0x41, 0xc2, 0xc7, 0xca, 0xb8, 0x9a, 0x7b, 0xcf, 0xfd, 0xdd,
-	0x33, 0xc9, 0x3d, 0xe4, 0xd8, 0x63, 0x4d, 0x87, 0x85, 0x46, 0x8b, 0xd5, 0x9e, 0xba, 0x06, 0x7b,
-	0x66, 0x8d, 0x48, 0xf0, 0xd0, 0x78, 0x60, 0xc2, 0xf5, 0x6d, 0xae, 0x07, 0x21, 0x17, 0x9c, 0x66,
-	0x67, 0xfa, 0xe1, 0x99, 0xe3, 0x8a, 0x56, 0x54, 0xd7, 0x1b, 0xbc, 0x6d, 0x38, 0xdc, 0xe1, 0x86,
-	0x04, 0xea, 0x91, 0x2d, 0x3b, 0xd9, 0xc8, 0x6a, 0xba, 0x58, 0x7c, 0xff, 0x47, 0xd6, 0x2a, 0x4c,
-	0x94, 0x7d, 0x9b, 0xd3, 0x23, 0xb2, 0x1e, 0x70, 0xaf, 0xdb, 0xe6, 0x61, 0xd0, 0x52, 0xb6, 0x55,
-	0xac, 0x65, 0xac, 0xb9, 0x40, 0x6f, 0x48, 0x66, 0x02, 0x96, 0x94, 0xbc, 0x8a, 0xb5, 0x9c, 0x79,
-	0xde, 0x1b, 0x16, 0xd0, 0xc7, 0xb0, 0xa0, 0x2d, 0xfc, 0xe7, 0xfa, 0x1d, 0xee, 0xd5, 0xc2, 0xd5,
-	0x57, 0x97, 0x7b, 0xd6, 0x74, 0x9d, 0xde, 0x91, 0xdc, 0x2d, 0x17, 0xd7, 0xdc, 0xb7, 0xdd, 0xb0,
-	0xcd, 0x9a, 0xca, 0xae, 0x9a, 0xfa, 0x93, 0xdd, 0x92, 0x0b, 0x3d, 0x21, 0x9b, 0xa5, 0x90, 0x07,
-	0x73, 0xdb, 0x3d, 0x15, 0x6b, 0x59, 0x6b, 0x59, 0xa4, 0x1a, 0xd9, 0x32, 0x6b, 0x8d, 0xc7, 0x68,
-	0x81, 0xdb, 0x97, 0xdc, 0xaa, 0x4c, 0xf3, 0x24, 0x53, 0x0d, 0x3c, 0x57, 0x28, 0x8a, 0x9c, 0x4f,
-	0x1b, 0x0a, 0x84, 0x94, 0x3b, 0xb2, 0xac, 0x32, 0xa1, 0x1c, 0xc8, 0xd1, 0x82, 0x52, 0xbc, 0x27,
-	0xd9, 0x0a, 0x13, 0x9d, 0x5f, 0x5c, 0xf3, 0x94, 0xa4, 0x27, 0xa4, 0x92, 0x57, 0x53, 0xda, 0xc6,
-	0xc5, 0x8e, 0x3e, 0xcb, 0x4f, 0xff, 0x09, 0xc3, 0x4c, 0x4f, 0x0e, 0x62, 0x49, 0xc8, 0xbc, 0xea,
-	0xc5, 0x80, 0xfa, 0x31, 0xa0, 0x41, 0x0c, 0x68, 0x1c, 0x03, 0x7e, 0x49, 0x00, 0xbf, 0x25, 0x80,
-	0x7b, 0x09, 0xe0, 0x7e, 0x02, 0xf8, 0x33, 0x01, 0xfc, 0x95, 0x00, 0x1a, 0x27, 0x80, 0x5f, 0x47,
-	0x80, 0xfa, 0x23, 0x40, 0x83, 0x11, 0xa0, 0xfa, 0x7f, 0x99, 0xf2, 0xe5, 0x77, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x7c, 0xc0, 0xe9, 0x7a, 0x45, 0x02, 0x00, 0x00,
+	// 372 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x91, 0xcd, 0x4e, 0xe3, 0x30,
+	0x14, 0x85, 0xed, 0x69, 0x3b, 0xd3, 0xf1, 0x74, 0x34, 0x33, 0x56, 0x67, 0xc6, 0x54, 0xc8, 0x8d,
+	0x0a, 0x8b, 0x48, 0x88, 0x04, 0xc1, 0x86, 0x75, 0xa8, 0x10, 0xad, 0xc4, 0x26, 0x85, 0x07, 0xe8,
+	0x8f, 0x93, 0x06, 0xd2, 0x38, 0x72, 0x1d, 0x44, 0x77, 0x3c, 0x02, 0x8f, 0xc1, 0xa3, 0x74, 0xd9,
+	0x65, 0xc5, 0xa2, 0x22, 0xe9, 0x86, 0x65, 0x1f, 0x01, 0xc5, 0xa1, 0xea, 0xcf, 0x0a, 0xb1, 0xf2,
+	0x3d, 0xe7, 0x7e, 0xe7, 0x5a, 0xba, 0x17, 0xed, 0xf9, 0xac, 0xe7, 0x32, 0x61, 0xf6, 0x59, 0xfb,
+	0x6e, 0x64, 0xb2, 0x7b, 0xd6, 0x8d, 0x24, 0x17, 0xe6, 0x0d, 0x93, 0x5e, 0xe0, 0x70, 0x23, 0x14,
+	0x5c, 0x72, 0x5c, 0x5c, 0xfa, 0x95, 0x43, 0xd7, 0x93, 0xfd, 0xa8, 0x63, 0x74, 0xf9, 0xc0, 0x74,
+	0xb9, 0xcb, 0x4d, 0x05, 0x74, 0x22, 0x47, 0x29, 0x25, 0x54, 0x95, 0x05, 0x6b, 0xf1, 0x17, 0xf4,
+	0xad, 0xc9, 0x64, 0x23, 0x70, 0x38, 0xde, 0x45, 0xdf, 0x43, 0xee, 0x8f, 0x06, 0x5c, 0x84, 0x7d,
+	0xf2, 0x5b, 0x83, 0x7a, 0xc1, 0x5e, 0x19, 0xf8, 0x1c, 0x15, 0x52, 0xb0, 0x4e, 0xca, 0x1a, 0xd4,
+	0x4b, 0xd6, 0xd1, 0x78, 0x56, 0x05, 0xcf, 0xb3, 0xaa, 0xbe, 0xf6, 0x9f, 0x17, 0x0c, 0xb9, 0xdf,
+	0x16, 0xdb, 0xaf, 0xa1, 0x72, 0x76, 0x16, 0xc7, 0x57, 0xa8, 0x74, 0xc1, 0xe5, 0x19, 0x0f, 0x1c,
+	0x4f, 0x0c, 0x58, 0x8f, 0xfc, 0xd5, 0x72, 0x9f, 0x1a, 0xb7, 0x31, 0x05, 0xef, 0xa3, 0x9f, 0x75,
+	0xc1, 0xc3, 0xd5, 0xd8, 0x7f, 0x1a, 0xd4, 0x8b, 0xf6, 0xa6, 0x89, 0x75, 0xf4, 0xcb, 0x6a, 0x77,
+	0x6f, 0xa3, 0x35, 0xee, 0xbf, 0xe2, 0xb6, 0x6d, 0x5c, 0x46, 0x85, 0x56, 0xe8, 0x7b, 0x92, 0x10,
+	0xd5, 0xcf, 0x04, 0xa6, 0x08, 0x35, 0x86, 0xaa, 0x6c, 0x31, 0x49, 0x76, 0x54, 0x6b, 0xcd, 0x49,
+	0x53, 0x97, 0x4c, 0xb8, 0x8c, 0x54, 0xb2, 0x94, 0x12, 0xb5, 0x6b, 0x54, 0x6c, 0x32, 0x39, 0xfc,
+	0xc0, 0x8e, 0x0f, 0x50, 0x3e, 0x25, 0x49, 0x59, 0xcb, 0xe9, 0x3f, 0x8e, 0xff, 0x18, 0xcb, 0xab,
+	0x1a, 0xef, 0x27, 0xb2, 0xf2, 0xe9, 0x9a, 0x6c, 0x05, 0x59, 0xa7, 0xe3, 0x98, 0x82, 0x49, 0x4c,
+	0xc1, 0x34, 0xa6, 0x60, 0x11, 0x53, 0xf8, 0x90, 0x50, 0xf8, 0x94, 0x50, 0x38, 0x4e, 0x28, 0x9c,
+	0x24, 0x14, 0xbe, 0x24, 0x14, 0xbe, 0x26, 0x14, 0x2c, 0x12, 0x0a, 0x1f, 0xe7, 0x14, 0x4c, 0xe6,
+	0x14, 0x4c, 0xe7, 0x14, 0x74, 0xbe, 0xaa, 0xdb, 0x9f, 0xbc, 0x0d, 0x00, 0x9d, 0x46, 0xa5, 0x0d,
+	0x5b, 0x02, 0x00, 0x00,
 }
 
 func (this *JetInfo) Equal(that interface{}) bool {
@@ -185,6 +187,9 @@ func (this *JetInfo) Equal(that interface{}) bool {
 	if this.IsSplitSet != that1.IsSplitSet {
 		return false
 	}
+	if this.Merge != that1.Merge {
+		return false
+	}
 	return true
 }
 func (this *JetsInfo) Equal(that interface{}) bool {
@@ -223,7 +228,7 @@ func (this *JetInfo) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 11)
+	s := make([]string, 0, 12)
 	s = append(s, "&executor.JetInfo{")
 	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
 	s = append(s, "JetID: "+fmt.Sprintf("%#v", this.JetID)+",\n")
@@ -232,6 +237,7 @@ func (this *JetInfo) GoString() string {
 	s = append(s, "BackupConfirmed: "+fmt.Sprintf("%#v", this.BackupConfirmed)+",\n")
 	s = append(s, "Split: "+fmt.Sprintf("%#v", this.Split)+",\n")
 	s = append(s, "IsSplitSet: "+fmt.Sprintf("%#v", this.IsSplitSet)+",\n")
+	s = append(s, "Merge: "+fmt.Sprintf("%#v", this.Merge)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -354,6 +360,18 @@ func (m *JetInfo) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i++
 	}
+	if m.Merge {
+		dAtA[i] = 0xd0
+		i++
+		dAtA[i] = 0x1
+		i++
+		if m.Merge {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
 	return i, nil
 }
 
@@ -434,6 +452,9 @@ func (m *JetInfo) Size() (n int) {
 	if m.IsSplitSet {
 		n += 3
 	}
+	if m.Merge {
+		n += 3
+	}
 	return n
 }
 
@@ -480,6 +501,7 @@ func (this *JetInfo) String() string {
 		`BackupConfirmed:` + fmt.Sprintf("%v", this.BackupConfirmed) + `,`,
 		`Split:` + fmt.Sprintf("%v", this.Split) + `,`,
 		`IsSplitSet:` + fmt.Sprintf("%v", this.IsSplitSet) + `,`,
+		`Merge:` + fmt.Sprintf("%v", this.Merge) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -699,6 +721,26 @@ func (m *JetInfo) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.IsSplitSet = bool(v != 0)
+		case 26:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Merge", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJetinfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Merge = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipJetinfo(dAtA[iNdEx:])
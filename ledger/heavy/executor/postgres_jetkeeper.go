@@ -31,9 +31,10 @@ import (
 // JetKeeper provides a method for adding jet to storage, checking pulse completion and getting access to highest synced pulse.
 type JetKeeper interface {
 	// AddDropConfirmation performs adding jet to storage and checks pulse completion.
-	AddDropConfirmation(ctx context.Context, pn insolar.PulseNumber, jet insolar.JetID, split bool) error
+	AddDropConfirmation(ctx context.Context, pn insolar.PulseNumber, jet insolar.JetID, split, merge bool) error
 	// AddHotConfirmation performs adding hot confirmation to storage and checks pulse completion.
-	AddHotConfirmation(ctx context.Context, pn insolar.PulseNumber, jet insolar.JetID, split bool) error
+	// Merged jet is confirmed by itself, not by its parent.
+	AddHotConfirmation(ctx context.Context, pn insolar.PulseNumber, jet insolar.JetID, split, merge bool) error
 	// AddBackupConfirmation performs adding backup confirmation to storage and checks pulse completion.
 	AddBackupConfirmation(ctx context.Context, pn insolar.PulseNumber) error
 	// TopSyncPulse provides access to highest synced (replicated) pulse.
@@ -63,13 +64,13 @@ func (jk *PostgresDBJetKeeper) Storage() jet.Storage {
 	return jk.jetTrees
 }
 
-func (jk *PostgresDBJetKeeper) AddHotConfirmation(ctx context.Context, pn insolar.PulseNumber, id insolar.JetID, split bool) error {
+func (jk *PostgresDBJetKeeper) AddHotConfirmation(ctx context.Context, pn insolar.PulseNumber, id insolar.JetID, split, merge bool) error {
 	jk.lock.Lock()
 	defer jk.lock.Unlock()
 
 	inslogger.FromContext(ctx).Debug("AddHotConfirmation. pulse: ", pn, ". ID: ", id.DebugString())
 
-	if err := jk.updateHot(ctx, pn, id, split); err != nil {
+	if err := jk.updateHot(ctx, pn, id, split, merge); err != nil {
 		return errors.Wrapf(err, "failed to save updated jets")
 	}
 
@@ -77,13 +78,13 @@ func (jk *PostgresDBJetKeeper) AddHotConfirmation(ctx context.Context, pn insola
 }
 
 // AddDropConfirmation performs adding jet to storage and checks pulse completion.
-func (jk *PostgresDBJetKeeper) AddDropConfirmation(ctx context.Context, pn insolar.PulseNumber, id insolar.JetID, split bool) error {
+func (jk *PostgresDBJetKeeper) AddDropConfirmation(ctx context.Context, pn insolar.PulseNumber, id insolar.JetID, split, merge bool) error {
 	jk.lock.Lock()
 	defer jk.lock.Unlock()
 
-	inslogger.FromContext(ctx).Debug("AddDropConfirmation. pulse: ", pn, ". ID: ", id.DebugString(), ", Split: ", split)
+	inslogger.FromContext(ctx).Debug("AddDropConfirmation. pulse: ", pn, ". ID: ", id.DebugString(), ", Split: ", split, ", Merge: ", merge)
 
-	if err := jk.updateDrop(ctx, pn, id, split); err != nil {
+	if err := jk.updateDrop(ctx, pn, id, split, merge); err != nil {
 		return errors.Wrapf(err, "AddDropConfirmation. failed to save updated jets")
 	}
 
@@ -213,18 +214,23 @@ func (jk *PostgresDBJetKeeper) getForJet(ctx context.Context, pulse insolar.Puls
 	return len(jets) - 1, jets, nil
 }
 
-func (jk *PostgresDBJetKeeper) updateHot(ctx context.Context, pulse insolar.PulseNumber, id insolar.JetID, split bool) error {
+func (jk *PostgresDBJetKeeper) updateHot(ctx context.Context, pulse insolar.PulseNumber, id insolar.JetID, split, merge bool) error {
 	parentID := id
 	if split {
 		parentID = jet.Parent(id)
 	}
+	// Merged jet is confirmed by itself, hot data is received by its parent.
+	hotID := id
+	if merge {
+		hotID = jet.Parent(id)
+	}
 
 	idx, jets, err := jk.getForJet(ctx, pulse, parentID)
 	if err != nil {
 		return errors.Wrap(err, "Can't getForJet")
 	}
 
-	err = jets[idx].addHot(id, parentID, split)
+	err = jets[idx].addHot(hotID, parentID, split, merge)
 	if err != nil {
 		return errors.Wrap(err, "can't addHot")
 	}
@@ -232,13 +238,13 @@ func (jk *PostgresDBJetKeeper) updateHot(ctx context.Context, pulse insolar.Puls
 	return jk.set(pulse, jets)
 }
 
-func (jk *PostgresDBJetKeeper) updateDrop(ctx context.Context, pulse insolar.PulseNumber, id insolar.JetID, split bool) error {
+func (jk *PostgresDBJetKeeper) updateDrop(ctx context.Context, pulse insolar.PulseNumber, id insolar.JetID, split, merge bool) error {
 	idx, jets, err := jk.getForJet(ctx, pulse, id)
 	if err != nil {
 		return errors.Wrap(err, "Can't getForJet")
 	}
 
-	err = jets[idx].addDrop(id, split)
+	err = jets[idx].addDrop(id, split, merge)
 	if err != nil {
 		return errors.Wrap(err, "can't addHot")
 	}
@@ -257,6 +263,7 @@ func (jk *PostgresDBJetKeeper) getTopSyncJets(ctx context.Context) ([]insolar.Je
 		return nil, errors.Wrapf(err, "can't getTopSyncJets: %d", top)
 	}
 
+	merged := map[insolar.JetID]struct{}{}
 	for _, ji := range jets {
 		if !ji.IsSplitSet {
 			inslogger.FromContext(ctx).Error("IsSplitJet must be set before calling for isConfirmed")
@@ -265,6 +272,13 @@ func (jk *PostgresDBJetKeeper) getTopSyncJets(ctx context.Context) ([]insolar.Je
 		if ji.Split {
 			left, right := jet.Siblings(ji.JetID)
 			result = append(result, left, right)
+		} else if ji.Merge {
+			// Both merged siblings result in their parent.
+			parent := jet.Parent(ji.JetID)
+			if _, ok := merged[parent]; !ok {
+				merged[parent] = struct{}{}
+				result = append(result, parent)
+			}
 		} else {
 			result = append(result, ji.JetID)
 		}
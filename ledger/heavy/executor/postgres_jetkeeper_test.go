@@ -142,11 +142,11 @@ func TestJetInfoIsConfirmed_OneDropOneHot_Postgres(t *testing.T) {
 	err := jets.Update(ctx, testPulse, true, testJet)
 	require.NoError(t, err)
 
-	err = ji.AddHotConfirmation(ctx, testPulse, testJet, false)
+	err = ji.AddHotConfirmation(ctx, testPulse, testJet, false, false)
 	require.NoError(t, err)
 	require.Equal(t, insolar.GenesisPulse.PulseNumber, ji.TopSyncPulse())
 
-	err = ji.AddDropConfirmation(ctx, testPulse, testJet, false)
+	err = ji.AddDropConfirmation(ctx, testPulse, testJet, false, false)
 	require.NoError(t, err)
 
 	require.Equal(t, insolar.GenesisPulse.PulseNumber, ji.TopSyncPulse())
@@ -164,23 +164,23 @@ func Test_DifferentSplitFlagsInDropsAndHots_Postgres(t *testing.T) {
 	testJet := insolar.ZeroJetID
 
 	// AddHotConfirmation: 'true' come first
-	err := ji.AddDropConfirmation(ctx, testPulse, testJet, true)
+	err := ji.AddDropConfirmation(ctx, testPulse, testJet, true, false)
 	require.NoError(t, err)
-	err = ji.AddHotConfirmation(ctx, testPulse, testJet, false)
+	err = ji.AddHotConfirmation(ctx, testPulse, testJet, false, false)
 	require.Contains(t, err.Error(), "try to change split from true to false")
 
 	// AddHotConfirmation: 'false' comes first
 	left, _ := jet.Siblings(testJet)
 	leftLeft, rightLeft := jet.Siblings(left)
-	err = ji.AddHotConfirmation(ctx, testPulse, left, false)
+	err = ji.AddHotConfirmation(ctx, testPulse, left, false, false)
 	require.NoError(t, err)
-	err = ji.AddHotConfirmation(ctx, testPulse, leftLeft, true)
+	err = ji.AddHotConfirmation(ctx, testPulse, leftLeft, true, false)
 	require.Contains(t, err.Error(), "try to change split from false to true")
 
 	// AddDropConfirmation
-	err = ji.AddHotConfirmation(ctx, testPulse, rightLeft, false)
+	err = ji.AddHotConfirmation(ctx, testPulse, rightLeft, false, false)
 	require.NoError(t, err)
-	err = ji.AddDropConfirmation(ctx, testPulse, rightLeft, true)
+	err = ji.AddDropConfirmation(ctx, testPulse, rightLeft, true, false)
 	require.Contains(t, err.Error(), "try to change split from false to true")
 }
 
@@ -197,10 +197,10 @@ func TestJetInfoIsConfirmed_Split_Postgres(t *testing.T) {
 
 	err := jets.Update(ctx, testPulse, true, testJet)
 	require.NoError(t, err)
-	err = ji.AddHotConfirmation(ctx, testPulse, testJet, false)
+	err = ji.AddHotConfirmation(ctx, testPulse, testJet, false, false)
 	require.NoError(t, err)
 	require.Equal(t, insolar.GenesisPulse.PulseNumber, ji.TopSyncPulse())
-	err = ji.AddDropConfirmation(ctx, testPulse, testJet, false)
+	err = ji.AddDropConfirmation(ctx, testPulse, testJet, false, false)
 	require.NoError(t, err)
 	require.Equal(t, insolar.GenesisPulse.PulseNumber, ji.TopSyncPulse())
 	err = ji.AddBackupConfirmation(ctx, testPulse)
@@ -213,11 +213,11 @@ func TestJetInfoIsConfirmed_Split_Postgres(t *testing.T) {
 	left, right := jet.Siblings(testJet)
 	err = jets.Update(ctx, nextPulse, true, testJet)
 	require.NoError(t, err)
-	err = ji.AddDropConfirmation(ctx, nextPulse, testJet, true)
+	err = ji.AddDropConfirmation(ctx, nextPulse, testJet, true, false)
 	require.NoError(t, err)
-	err = ji.AddHotConfirmation(ctx, nextPulse, left, true)
+	err = ji.AddHotConfirmation(ctx, nextPulse, left, true, false)
 	require.NoError(t, err)
-	err = ji.AddHotConfirmation(ctx, nextPulse, right, true)
+	err = ji.AddHotConfirmation(ctx, nextPulse, right, true, false)
 	require.NoError(t, err)
 	err = ji.AddBackupConfirmation(ctx, nextPulse)
 	require.NoError(t, err)
@@ -237,10 +237,10 @@ func TestJetInfo_ExistingDrop_Postgres(t *testing.T) {
 	testPulse := gen.PulseNumber()
 	jetKeeper, _, _ := initPostgresDB(t, testPulse)
 	testJet := gen.JetID()
-	err := jetKeeper.AddDropConfirmation(ctx, testPulse, testJet, false)
+	err := jetKeeper.AddDropConfirmation(ctx, testPulse, testJet, false, false)
 	require.NoError(t, err)
 
-	err = jetKeeper.AddDropConfirmation(ctx, testPulse, testJet, false)
+	err = jetKeeper.AddDropConfirmation(ctx, testPulse, testJet, false, false)
 	require.Contains(t, err.Error(), "try to rewrite drop confirmation")
 	require.Equal(t, insolar.GenesisPulse.PulseNumber, jetKeeper.TopSyncPulse())
 }
@@ -251,10 +251,10 @@ func TestJetInfo_ExistingHot_Postgres(t *testing.T) {
 	jetKeeper, _, _ := initPostgresDB(t, testPulse)
 
 	testJet := gen.JetID()
-	err := jetKeeper.AddHotConfirmation(ctx, testPulse, testJet, false)
+	err := jetKeeper.AddHotConfirmation(ctx, testPulse, testJet, false, false)
 	require.NoError(t, err)
 
-	err = jetKeeper.AddHotConfirmation(ctx, testPulse, testJet, false)
+	err = jetKeeper.AddHotConfirmation(ctx, testPulse, testJet, false, false)
 	require.Contains(t, err.Error(), "try add already existing hot confirmation")
 }
 
@@ -267,13 +267,13 @@ func TestJetInfo_ExceedNumHotConfirmations_Postgres(t *testing.T) {
 	testJet := gen.JetID()
 	left, right := jet.Siblings(testJet)
 
-	err := jetKeeper.AddHotConfirmation(ctx, testPulse, left, true)
+	err := jetKeeper.AddHotConfirmation(ctx, testPulse, left, true, false)
 	require.NoError(t, err)
 
-	err = jetKeeper.AddHotConfirmation(ctx, testPulse, right, true)
+	err = jetKeeper.AddHotConfirmation(ctx, testPulse, right, true, false)
 	require.NoError(t, err)
 
-	err = jetKeeper.AddHotConfirmation(ctx, testPulse, left, true)
+	err = jetKeeper.AddHotConfirmation(ctx, testPulse, left, true, false)
 	require.Contains(t, err.Error(), "num hot confirmations exceeds")
 	require.Equal(t, insolar.GenesisPulse.PulseNumber, jetKeeper.TopSyncPulse())
 }
@@ -296,10 +296,10 @@ func TestDbJetKeeper_DifferentActualAndExpectedJets_Postgres(t *testing.T) {
 	err := jets.Update(ctx, testPulse, true, left)
 	require.NoError(t, err)
 
-	err = jetKeeper.AddHotConfirmation(ctx, testPulse, testJet, false)
+	err = jetKeeper.AddHotConfirmation(ctx, testPulse, testJet, false, false)
 	require.NoError(t, err)
 
-	err = jetKeeper.AddDropConfirmation(ctx, testPulse, testJet, false)
+	err = jetKeeper.AddDropConfirmation(ctx, testPulse, testJet, false, false)
 	require.NoError(t, err)
 
 	require.False(t, jetKeeper.HasAllJetConfirms(ctx, testPulse))
@@ -322,16 +322,16 @@ func TestDbJetKeeper_DifferentNumberOfActualAndExpectedJets_Postgres(t *testing.
 	err := jets.Update(ctx, testPulse, true, testJet)
 	require.NoError(t, err)
 
-	err = jetKeeper.AddHotConfirmation(ctx, testPulse, left, false)
+	err = jetKeeper.AddHotConfirmation(ctx, testPulse, left, false, false)
 	require.NoError(t, err)
 
-	err = jetKeeper.AddHotConfirmation(ctx, testPulse, right, false)
+	err = jetKeeper.AddHotConfirmation(ctx, testPulse, right, false, false)
 	require.NoError(t, err)
 
-	err = jetKeeper.AddDropConfirmation(ctx, testPulse, right, false)
+	err = jetKeeper.AddDropConfirmation(ctx, testPulse, right, false, false)
 	require.NoError(t, err)
 
-	err = jetKeeper.AddDropConfirmation(ctx, testPulse, left, false)
+	err = jetKeeper.AddDropConfirmation(ctx, testPulse, left, false, false)
 	require.NoError(t, err)
 
 	err = jetKeeper.AddBackupConfirmation(ctx, testPulse)
@@ -356,7 +356,7 @@ func TestDbJetKeeper_AddDropConfirmation(t *testing.T) {
 	f := fuzz.New()
 	f.Fuzz(&pulse)
 	f.Fuzz(&jet)
-	err := jetKeeper.AddDropConfirmation(ctx, pulse, jet, false)
+	err := jetKeeper.AddDropConfirmation(ctx, pulse, jet, false, false)
 	require.NoError(t, err)
 }
 
@@ -367,10 +367,10 @@ func TestDbJetKeeper_CheckJetTreeFail_Postgres(t *testing.T) {
 
 	testJet := insolar.ZeroJetID
 
-	err := ji.AddHotConfirmation(ctx, testPulse, testJet, false)
+	err := ji.AddHotConfirmation(ctx, testPulse, testJet, false, false)
 	require.NoError(t, err)
 	require.Equal(t, insolar.GenesisPulse.PulseNumber, ji.TopSyncPulse())
-	err = ji.AddDropConfirmation(ctx, testPulse, testJet, false)
+	err = ji.AddDropConfirmation(ctx, testPulse, testJet, false, false)
 	require.NoError(t, err)
 	require.Equal(t, insolar.GenesisPulse.PulseNumber, ji.TopSyncPulse())
 	err = ji.AddBackupConfirmation(ctx, testPulse)
@@ -411,12 +411,12 @@ func TestDbJetKeeper_TopSyncPulse(t *testing.T) {
 
 	err = jets.Update(ctx, currentPulse, true, testJet)
 	require.NoError(t, err)
-	err = jetKeeper.AddDropConfirmation(ctx, currentPulse, testJet, false)
+	err = jetKeeper.AddDropConfirmation(ctx, currentPulse, testJet, false, false)
 	require.NoError(t, err)
 	// it's still top confirmed
 	require.Equal(t, insolar.GenesisPulse.PulseNumber, jetKeeper.TopSyncPulse())
 
-	err = jetKeeper.AddHotConfirmation(ctx, currentPulse, testJet, false)
+	err = jetKeeper.AddHotConfirmation(ctx, currentPulse, testJet, false, false)
 	require.NoError(t, err)
 	require.Equal(t, insolar.GenesisPulse.PulseNumber, jetKeeper.TopSyncPulse())
 
@@ -428,13 +428,13 @@ func TestDbJetKeeper_TopSyncPulse(t *testing.T) {
 	require.NoError(t, err)
 	left, right := jet.Siblings(testJet)
 
-	err = jetKeeper.AddDropConfirmation(ctx, nextPulse, testJet, true)
+	err = jetKeeper.AddDropConfirmation(ctx, nextPulse, testJet, true, false)
 	require.NoError(t, err)
 
-	err = jetKeeper.AddHotConfirmation(ctx, nextPulse, right, true)
+	err = jetKeeper.AddHotConfirmation(ctx, nextPulse, right, true, false)
 	require.NoError(t, err)
 	require.Equal(t, currentPulse, jetKeeper.TopSyncPulse())
-	err = jetKeeper.AddHotConfirmation(ctx, nextPulse, left, true)
+	err = jetKeeper.AddHotConfirmation(ctx, nextPulse, left, true, false)
 	require.NoError(t, err)
 	require.Equal(t, currentPulse, jetKeeper.TopSyncPulse())
 
@@ -487,9 +487,9 @@ func TestDbJetKeeper_LostDataOnNextPulseAfterSplit(t *testing.T) {
 
 	// finalize currentPulse
 	{
-		err = jetKeeper.AddHotConfirmation(ctx, currentPulse, testJet, false)
+		err = jetKeeper.AddHotConfirmation(ctx, currentPulse, testJet, false, false)
 		require.NoError(t, err)
-		err = jetKeeper.AddDropConfirmation(ctx, currentPulse, testJet, false)
+		err = jetKeeper.AddDropConfirmation(ctx, currentPulse, testJet, false, false)
 		require.NoError(t, err)
 		require.True(t, jetKeeper.HasAllJetConfirms(ctx, currentPulse))
 		err = jetKeeper.AddBackupConfirmation(ctx, currentPulse)
@@ -501,12 +501,12 @@ func TestDbJetKeeper_LostDataOnNextPulseAfterSplit(t *testing.T) {
 	{
 		err = jets.Update(ctx, nextPulse, true, testJet)
 		require.NoError(t, err)
-		err = jetKeeper.AddDropConfirmation(ctx, nextPulse, testJet, true)
+		err = jetKeeper.AddDropConfirmation(ctx, nextPulse, testJet, true, false)
 		require.NoError(t, err)
-		err = jetKeeper.AddHotConfirmation(ctx, nextPulse, left, true)
+		err = jetKeeper.AddHotConfirmation(ctx, nextPulse, left, true, false)
 		require.NoError(t, err)
 		require.False(t, jetKeeper.HasAllJetConfirms(ctx, nextPulse))
-		err = jetKeeper.AddHotConfirmation(ctx, nextPulse, right, true)
+		err = jetKeeper.AddHotConfirmation(ctx, nextPulse, right, true, false)
 		require.NoError(t, err)
 
 		require.True(t, jetKeeper.HasAllJetConfirms(ctx, currentPulse))
@@ -518,17 +518,17 @@ func TestDbJetKeeper_LostDataOnNextPulseAfterSplit(t *testing.T) {
 
 	err = jets.Update(ctx, futurePulse, true, left)
 	require.NoError(t, err)
-	err = jetKeeper.AddDropConfirmation(ctx, futurePulse, left, false)
+	err = jetKeeper.AddDropConfirmation(ctx, futurePulse, left, false, false)
 	require.NoError(t, err)
-	err = jetKeeper.AddHotConfirmation(ctx, futurePulse, left, false)
+	err = jetKeeper.AddHotConfirmation(ctx, futurePulse, left, false, false)
 	require.NoError(t, err)
 	require.True(t, jetKeeper.HasAllJetConfirms(ctx, currentPulse))
 	require.False(t, jetKeeper.HasAllJetConfirms(ctx, futurePulse))
 
 	err = jets.Update(ctx, futurePulse, true, right)
-	err = jetKeeper.AddDropConfirmation(ctx, futurePulse, right, false)
+	err = jetKeeper.AddDropConfirmation(ctx, futurePulse, right, false, false)
 	require.NoError(t, err)
-	err = jetKeeper.AddHotConfirmation(ctx, futurePulse, right, false)
+	err = jetKeeper.AddHotConfirmation(ctx, futurePulse, right, false, false)
 	require.NoError(t, err)
 
 	require.True(t, jetKeeper.HasAllJetConfirms(ctx, currentPulse))
@@ -554,9 +554,9 @@ func Test_TruncateHead_Postgres(t *testing.T) {
 
 	err := jets.Update(ctx, testPulse, true, testJet)
 	require.NoError(t, err)
-	err = ji.AddHotConfirmation(ctx, testPulse, testJet, false)
+	err = ji.AddHotConfirmation(ctx, testPulse, testJet, false, false)
 	require.NoError(t, err)
-	err = ji.AddDropConfirmation(ctx, testPulse, testJet, false)
+	err = ji.AddDropConfirmation(ctx, testPulse, testJet, false, false)
 	require.NoError(t, err)
 	err = ji.AddBackupConfirmation(ctx, testPulse)
 	require.NoError(t, err)
@@ -566,9 +566,9 @@ func Test_TruncateHead_Postgres(t *testing.T) {
 	_, err = ji.get(testPulse)
 	require.NoError(t, err)
 
-	err = ji.AddDropConfirmation(ctx, nextPulse, gen.JetID(), false)
+	err = ji.AddDropConfirmation(ctx, nextPulse, gen.JetID(), false, false)
 	require.NoError(t, err)
-	err = ji.AddHotConfirmation(ctx, nextPulse, gen.JetID(), false)
+	err = ji.AddHotConfirmation(ctx, nextPulse, gen.JetID(), false, false)
 	require.NoError(t, err)
 
 	_, err = ji.get(nextPulse)
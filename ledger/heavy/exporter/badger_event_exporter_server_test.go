@@ -0,0 +1,205 @@
+// Copyright 2020 Insolar Network Ltd.
+// All rights reserved.
+// This material is licensed under the Insolar License version 1.0,
+// available at https://github.com/insolar/insolar/blob/master/LICENSE.md.
+
+// +build slowtest
+
+package exporter
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/gen"
+	insolarPulse "github.com/insolar/insolar/insolar/pulse"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/insolar/store"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/ledger/heavy/executor"
+	"github.com/insolar/insolar/ledger/object"
+	"github.com/insolar/insolar/pulse"
+)
+
+type eventStreamMock struct {
+	streamMock
+	checker func(*ContractEvent) error
+}
+
+func (s eventStreamMock) Send(event *ContractEvent) error {
+	return s.checker(event)
+}
+
+func TestEventServer_Export_Badger(t *testing.T) {
+	t.Parallel()
+
+	ctx := inslogger.TestContext(t)
+
+	// Pulses
+	firstPN := insolar.PulseNumber(pulse.MinTimePulse + 100)
+	secondPN := insolar.PulseNumber(firstPN + 10)
+
+	// JetKeeper
+	jetKeeper := executor.NewJetKeeperMock(t)
+	jetKeeper.TopSyncPulseMock.Return(secondPN)
+
+	// Events and Records
+	walletProto := gen.Reference()
+	memberProto := gen.Reference()
+
+	eventRecord := func(pn insolar.PulseNumber, topic string, proto insolar.Reference) record.Material {
+		event := record.Event{
+			Object:    gen.ID(),
+			Request:   gen.RecordReference(),
+			Prototype: proto,
+			Topic:     topic,
+			Payload:   []byte(topic),
+		}
+		return record.Material{
+			Virtual:  record.Wrap(&event),
+			ID:       gen.IDWithPulse(pn),
+			ObjectID: event.Object,
+			JetID:    gen.JetID(),
+		}
+	}
+
+	firstEvent := eventRecord(firstPN, "transfer", walletProto)
+	plainRec := getMaterialRecord()
+	plainRec.ID = gen.IDWithPulse(firstPN)
+	secondEvent := eventRecord(firstPN, "created", memberProto)
+	thirdEvent := eventRecord(secondPN, "transfer", memberProto)
+
+	// TempDB
+	tmpdir, err := ioutil.TempDir("", "bdb-test-")
+	defer os.RemoveAll(tmpdir)
+	require.NoError(t, err)
+
+	ops := BadgerDefaultOptions(tmpdir)
+	db, err := store.NewBadgerDB(ops)
+	require.NoError(t, err)
+	defer db.Stop(context.Background())
+
+	pulseStorage := insolarPulse.NewBadgerDB(db)
+	recordStorage := object.NewBadgerRecordDB(db)
+	recordPosition := object.NewBadgerRecordDB(db)
+
+	for _, rec := range []record.Material{firstEvent, plainRec, secondEvent, thirdEvent} {
+		err = recordStorage.Set(ctx, rec)
+		require.NoError(t, err)
+	}
+
+	err = pulseStorage.Append(ctx, insolar.Pulse{PulseNumber: pulse.MinTimePulse})
+	require.NoError(t, err)
+	err = pulseStorage.Append(ctx, insolar.Pulse{PulseNumber: firstPN})
+	require.NoError(t, err)
+	err = pulseStorage.Append(ctx, insolar.Pulse{PulseNumber: secondPN})
+	require.NoError(t, err)
+
+	eventServer := NewEventServer(pulseStorage, recordPosition, recordStorage, jetKeeper, configuration.Auth{})
+
+	export := func(t *testing.T, req *GetEvents) []*ContractEvent {
+		var events []*ContractEvent
+		stream := eventStreamMock{checker: func(e *ContractEvent) error {
+			events = append(events, e)
+			return nil
+		}}
+		err := eventServer.Export(req, stream)
+		require.NoError(t, err)
+		return events
+	}
+	eventOf := func(rec record.Material) *record.Event {
+		return record.Unwrap(&rec.Virtual).(*record.Event)
+	}
+
+	t.Run("nil count", func(t *testing.T) {
+		err := eventServer.Export(&GetEvents{}, eventStreamMock{})
+		require.Equal(t, ErrNilCount, err)
+	})
+
+	t.Run("all events", func(t *testing.T) {
+		events := export(t, &GetEvents{Count: 10})
+
+		require.Equal(t, 4, len(events))
+		require.Equal(t, firstEvent.ID, events[0].ID)
+		require.Equal(t, eventOf(firstEvent), events[0].Event)
+		require.Equal(t, firstPN, events[0].PulseNumber)
+		require.Equal(t, uint32(1), events[0].RecordNumber)
+		require.Equal(t, eventOf(secondEvent), events[1].Event)
+		require.Equal(t, uint32(3), events[1].RecordNumber)
+		require.Equal(t, eventOf(thirdEvent), events[2].Event)
+		require.Equal(t, secondPN, events[2].PulseNumber)
+
+		require.Nil(t, events[3].Event)
+		require.Equal(t, secondPN, *events[3].ShouldIterateFrom)
+		require.Equal(t, secondPN, events[3].PulseNumber)
+		require.Equal(t, uint32(1), events[3].RecordNumber)
+	})
+
+	t.Run("filter by topic", func(t *testing.T) {
+		events := export(t, &GetEvents{Count: 10, Topics: []string{"transfer"}})
+
+		require.Equal(t, 3, len(events))
+		require.Equal(t, eventOf(firstEvent), events[0].Event)
+		require.Equal(t, eventOf(thirdEvent), events[1].Event)
+		require.Nil(t, events[2].Event)
+	})
+
+	t.Run("filter by topic and prototype", func(t *testing.T) {
+		events := export(t, &GetEvents{
+			Count:      10,
+			Topics:     []string{"transfer"},
+			Prototypes: []insolar.Reference{memberProto},
+		})
+
+		require.Equal(t, 2, len(events))
+		require.Equal(t, eventOf(thirdEvent), events[0].Event)
+		require.Nil(t, events[1].Event)
+	})
+
+	t.Run("continue from position", func(t *testing.T) {
+		events := export(t, &GetEvents{Count: 1, Prototypes: []insolar.Reference{memberProto}})
+		require.Equal(t, 1, len(events))
+		require.Equal(t, eventOf(secondEvent), events[0].Event)
+
+		events = export(t, &GetEvents{
+			Count:        1,
+			Prototypes:   []insolar.Reference{memberProto},
+			PulseNumber:  events[0].PulseNumber,
+			RecordNumber: events[0].RecordNumber,
+		})
+		require.Equal(t, 1, len(events))
+		require.Equal(t, eventOf(thirdEvent), events[0].Event)
+	})
+
+	t.Run("scan limit reached", func(t *testing.T) {
+		limited := NewEventServer(pulseStorage, recordPosition, recordStorage, jetKeeper, configuration.Auth{})
+		limited.scanLimit = 2
+
+		var events []*ContractEvent
+		err := limited.Export(&GetEvents{Count: 10, Prototypes: []insolar.Reference{memberProto}}, eventStreamMock{
+			checker: func(e *ContractEvent) error {
+				events = append(events, e)
+				return nil
+			},
+		})
+		require.NoError(t, err)
+
+		// only first two records are checked, the client should continue from the second one
+		require.Equal(t, 1, len(events))
+		require.Nil(t, events[0].Event)
+		require.Nil(t, events[0].ShouldIterateFrom)
+		require.Equal(t, firstPN, events[0].PulseNumber)
+		require.Equal(t, uint32(2), events[0].RecordNumber)
+	})
+
+	t.Run("not final pulse", func(t *testing.T) {
+		err := eventServer.Export(&GetEvents{Count: 1, PulseNumber: secondPN + 10}, eventStreamMock{})
+		require.Equal(t, ErrNotFinalPulseData, err)
+	})
+}
@@ -822,3 +822,144 @@ func TestRecordServer_Export_ReturnTopPulseWhenNoRecords_Badger_WithAuth(t *test
 		require.NotNil(t, secondPN, *resRecord.ShouldIterateFrom)
 	})
 }
+
+type filteredStreamMock struct {
+	streamMock
+	checker func(*FilteredRecord) error
+}
+
+func (s filteredStreamMock) Send(rec *FilteredRecord) error {
+	return s.checker(rec)
+}
+
+func TestRecordServer_ExportFiltered_Badger(t *testing.T) {
+	t.Parallel()
+
+	ctx := inslogger.TestContext(t)
+
+	// Pulses
+	firstPN := insolar.PulseNumber(pulse.MinTimePulse + 100)
+	secondPN := insolar.PulseNumber(firstPN + 10)
+
+	// JetKeeper
+	jetKeeper := executor.NewJetKeeperMock(t)
+	jetKeeper.TopSyncPulseMock.Return(secondPN)
+
+	// IDs and Records
+	objectID := gen.ID()
+
+	firstRec := getMaterialRecord()
+	firstRec.ID = gen.IDWithPulse(firstPN)
+	firstRec.ObjectID = objectID
+
+	secondRec := getMaterialRecord()
+	secondRec.ID = gen.IDWithPulse(firstPN)
+	secondRec.ObjectID = gen.ID()
+
+	thirdRec := getMaterialRecord()
+	thirdRec.ID = gen.IDWithPulse(secondPN)
+	thirdRec.ObjectID = objectID
+
+	// TempDB
+	tmpdir, err := ioutil.TempDir("", "bdb-test-")
+	defer os.RemoveAll(tmpdir)
+	require.NoError(t, err)
+
+	ops := BadgerDefaultOptions(tmpdir)
+	db, err := store.NewBadgerDB(ops)
+	require.NoError(t, err)
+	defer db.Stop(context.Background())
+
+	pulseStorage := insolarPulse.NewBadgerDB(db)
+	recordStorage := object.NewBadgerRecordDB(db)
+	recordPosition := object.NewBadgerRecordDB(db)
+
+	err = recordStorage.Set(ctx, firstRec)
+	require.NoError(t, err)
+	err = recordStorage.Set(ctx, secondRec)
+	require.NoError(t, err)
+	err = recordStorage.Set(ctx, thirdRec)
+	require.NoError(t, err)
+
+	err = pulseStorage.Append(ctx, insolar.Pulse{PulseNumber: pulse.MinTimePulse})
+	require.NoError(t, err)
+	err = pulseStorage.Append(ctx, insolar.Pulse{PulseNumber: firstPN})
+	require.NoError(t, err)
+	err = pulseStorage.Append(ctx, insolar.Pulse{PulseNumber: secondPN})
+	require.NoError(t, err)
+
+	recordServer := NewRecordServer(pulseStorage, recordPosition, recordStorage, jetKeeper, configuration.Auth{})
+
+	export := func(t *testing.T, req *GetFilteredRecords) []*FilteredRecord {
+		var recs []*FilteredRecord
+		stream := filteredStreamMock{checker: func(i *FilteredRecord) error {
+			recs = append(recs, i)
+			return nil
+		}}
+		err := recordServer.ExportFiltered(req, stream)
+		require.NoError(t, err)
+		return recs
+	}
+
+	t.Run("filter by object", func(t *testing.T) {
+		recs := export(t, &GetFilteredRecords{Count: 10, ObjectIDs: []insolar.ID{objectID}})
+
+		require.Equal(t, 3, len(recs))
+		require.Equal(t, firstRec, *recs[0].Record)
+		require.Equal(t, thirdRec, *recs[1].Record)
+		require.Nil(t, recs[2].Record)
+		require.Equal(t, secondPN, *recs[2].ShouldIterateFrom)
+	})
+
+	t.Run("resume from token", func(t *testing.T) {
+		filter := []insolar.ID{objectID}
+		recs := export(t, &GetFilteredRecords{Count: 1, ObjectIDs: filter})
+		require.Equal(t, 1, len(recs))
+		require.Equal(t, firstRec, *recs[0].Record)
+
+		recs = export(t, &GetFilteredRecords{Count: 1, ObjectIDs: filter, ResumeToken: recs[0].ResumeToken})
+		require.Equal(t, 1, len(recs))
+		require.Equal(t, thirdRec, *recs[0].Record)
+
+		recs = export(t, &GetFilteredRecords{Count: 1, ObjectIDs: filter, ResumeToken: recs[0].ResumeToken})
+		require.Equal(t, 1, len(recs))
+		require.Nil(t, recs[0].Record)
+		require.NotEmpty(t, recs[0].ResumeToken)
+	})
+
+	t.Run("token of another filter", func(t *testing.T) {
+		recs := export(t, &GetFilteredRecords{Count: 1, ObjectIDs: []insolar.ID{objectID}})
+		require.Equal(t, 1, len(recs))
+
+		err := recordServer.ExportFiltered(&GetFilteredRecords{
+			Count:       1,
+			ResumeToken: recs[0].ResumeToken,
+		}, filteredStreamMock{})
+		require.Equal(t, ErrResumeTokenMismatch, err)
+	})
+
+	t.Run("scan limit reached", func(t *testing.T) {
+		limited := NewRecordServer(pulseStorage, recordPosition, recordStorage, jetKeeper, configuration.Auth{})
+		limited.scanLimit = 2
+
+		var recs []*FilteredRecord
+		err := limited.ExportFiltered(&GetFilteredRecords{Count: 10, ObjectIDs: []insolar.ID{objectID}}, filteredStreamMock{
+			checker: func(i *FilteredRecord) error {
+				recs = append(recs, i)
+				return nil
+			},
+		})
+		require.NoError(t, err)
+
+		// the third record isn't checked yet, so the client shouldn't wait for the next pulse
+		require.Equal(t, 2, len(recs))
+		require.Equal(t, firstRec, *recs[0].Record)
+		require.Nil(t, recs[1].Record)
+		require.Nil(t, recs[1].ShouldIterateFrom)
+
+		recs = export(t, &GetFilteredRecords{Count: 10, ObjectIDs: []insolar.ID{objectID}, ResumeToken: recs[1].ResumeToken})
+		require.Equal(t, 2, len(recs))
+		require.Equal(t, thirdRec, *recs[0].Record)
+		require.Equal(t, secondPN, *recs[1].ShouldIterateFrom)
+	})
+}
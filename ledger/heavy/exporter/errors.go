@@ -13,6 +13,11 @@ var (
 	ErrNilCount                = errors.New("count can't be 0")
 	ErrNotFinalPulseData       = errors.New("trying to get a non-finalized pulse data")
 	ErrDeprecatedClientVersion = errors.New("version of the observer is outdated, please upgrade this client")
+	ErrUnknownRecordType       = errors.New("unknown record type in filter")
+	ErrInvalidResumeToken      = errors.New("invalid resume token")
+	ErrResumeTokenMismatch     = errors.New("resume token was issued for another filter")
+	ErrObjectNotFound          = errors.New("object not found")
+	ErrStateOfAnotherObject    = errors.New("state belongs to another object")
 )
 
 var RateLimitExceededMsg = "rate limit exceeded, please retry later"
@@ -0,0 +1,1259 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ledger/heavy/exporter/event_exporter.proto
+
+package exporter
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_insolar_insolar_insolar "github.com/insolar/insolar/insolar"
+	record "github.com/insolar/insolar/insolar/record"
+	grpc "google.golang.org/grpc"
+	io "io"
+	math "math"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+type GetEvents struct {
+	Polymorph    uint32                                         `protobuf:"varint,16,opt,name=Polymorph,proto3" json:"Polymorph,omitempty"`
+	PulseNumber  github_com_insolar_insolar_insolar.PulseNumber `protobuf:"bytes,20,opt,name=PulseNumber,proto3,customtype=github.com/insolar/insolar/insolar.PulseNumber" json:"PulseNumber"`
+	RecordNumber uint32                                         `protobuf:"varint,21,opt,name=RecordNumber,proto3" json:"RecordNumber,omitempty"`
+	Count        uint32                                         `protobuf:"varint,22,opt,name=Count,proto3" json:"Count,omitempty"`
+	Topics       []string                                       `protobuf:"bytes,23,rep,name=Topics,proto3" json:"Topics,omitempty"`
+	Prototypes   []github_com_insolar_insolar_insolar.Reference `protobuf:"bytes,24,rep,name=Prototypes,proto3,customtype=github.com/insolar/insolar/insolar.Reference" json:"Prototypes"`
+}
+
+func (m *GetEvents) Reset()      { *m = GetEvents{} }
+func (*GetEvents) ProtoMessage() {}
+func (*GetEvents) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f7e0d30dd3f8a56, []int{0}
+}
+func (m *GetEvents) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetEvents) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetEvents.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetEvents) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetEvents.Merge(m, src)
+}
+func (m *GetEvents) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetEvents) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetEvents.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetEvents proto.InternalMessageInfo
+
+func (m *GetEvents) GetPolymorph() uint32 {
+	if m != nil {
+		return m.Polymorph
+	}
+	return 0
+}
+
+func (m *GetEvents) GetRecordNumber() uint32 {
+	if m != nil {
+		return m.RecordNumber
+	}
+	return 0
+}
+
+func (m *GetEvents) GetCount() uint32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *GetEvents) GetTopics() []string {
+	if m != nil {
+		return m.Topics
+	}
+	return nil
+}
+
+type ContractEvent struct {
+	Polymorph         uint32                                          `protobuf:"varint,16,opt,name=Polymorph,proto3" json:"Polymorph,omitempty"`
+	PulseNumber       github_com_insolar_insolar_insolar.PulseNumber  `protobuf:"bytes,20,opt,name=PulseNumber,proto3,customtype=github.com/insolar/insolar/insolar.PulseNumber" json:"PulseNumber"`
+	RecordNumber      uint32                                          `protobuf:"varint,21,opt,name=RecordNumber,proto3" json:"RecordNumber,omitempty"`
+	ID                github_com_insolar_insolar_insolar.ID           `protobuf:"bytes,22,opt,name=ID,proto3,customtype=github.com/insolar/insolar/insolar.ID" json:"ID"`
+	Event             *record.Event                                   `protobuf:"bytes,23,opt,name=Event,proto3" json:"Event,omitempty"`
+	ShouldIterateFrom *github_com_insolar_insolar_insolar.PulseNumber `protobuf:"bytes,24,opt,name=ShouldIterateFrom,proto3,customtype=github.com/insolar/insolar/insolar.PulseNumber" json:"ShouldIterateFrom,omitempty"`
+}
+
+func (m *ContractEvent) Reset()      { *m = ContractEvent{} }
+func (*ContractEvent) ProtoMessage() {}
+func (*ContractEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f7e0d30dd3f8a56, []int{1}
+}
+func (m *ContractEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractEvent.Merge(m, src)
+}
+func (m *ContractEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractEvent proto.InternalMessageInfo
+
+func (m *ContractEvent) GetPolymorph() uint32 {
+	if m != nil {
+		return m.Polymorph
+	}
+	return 0
+}
+
+func (m *ContractEvent) GetRecordNumber() uint32 {
+	if m != nil {
+		return m.RecordNumber
+	}
+	return 0
+}
+
+func (m *ContractEvent) GetEvent() *record.Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*GetEvents)(nil), "exporter.GetEvents")
+	proto.RegisterType((*ContractEvent)(nil), "exporter.ContractEvent")
+}
+
+func init() {
+	proto.RegisterFile("ledger/heavy/exporter/event_exporter.proto", fileDescriptor_4f7e0d30dd3f8a56)
+}
+
+var fileDescriptor_4f7e0d30dd3f8a56 = []byte{
+	// 466 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x53, 0x41, 0x6b, 0x13, 0x41,
+	0x14, 0x9e, 0x49, 0x6d, 0x30, 0xd3, 0x04, 0x74, 0xac, 0xcd, 0x10, 0x64, 0xb2, 0x04, 0x84, 0x55,
+	0xec, 0xae, 0xd4, 0xd2, 0x83, 0xe0, 0x65, 0x9b, 0x2a, 0x41, 0x90, 0x30, 0xf6, 0xe0, 0x4d, 0x92,
+	0xcd, 0x6b, 0x12, 0xd8, 0xec, 0x2c, 0xb3, 0xb3, 0xc5, 0xdc, 0xfc, 0x09, 0xfe, 0x0c, 0xc1, 0x3f,
+	0x92, 0x63, 0x8e, 0xc5, 0x43, 0x30, 0x9b, 0x8b, 0xc7, 0xde, 0xbd, 0x48, 0x67, 0xb7, 0xe9, 0x96,
+	0x1e, 0x2c, 0x9e, 0x7a, 0x9a, 0xf9, 0xde, 0xbe, 0xf7, 0xbe, 0xef, 0x7b, 0x3b, 0x8f, 0x3c, 0x0f,
+	0x60, 0x30, 0x04, 0xe5, 0x8e, 0xa0, 0x77, 0x3a, 0x75, 0xe1, 0x4b, 0x24, 0x95, 0x06, 0xe5, 0xc2,
+	0x29, 0x84, 0xfa, 0xf3, 0x25, 0x74, 0x22, 0x25, 0xb5, 0xa4, 0xf7, 0x2f, 0x71, 0x63, 0x77, 0x38,
+	0xd6, 0xa3, 0xa4, 0xef, 0xf8, 0x72, 0xe2, 0x0e, 0xe5, 0x50, 0xba, 0x26, 0xa1, 0x9f, 0x9c, 0x18,
+	0x64, 0x80, 0xb9, 0x65, 0x85, 0x8d, 0x83, 0x42, 0xfa, 0x38, 0x8c, 0x65, 0xd0, 0x53, 0x37, 0x4e,
+	0x05, 0xbe, 0x54, 0x83, 0xfc, 0xc8, 0xea, 0x5a, 0x3f, 0x4a, 0xa4, 0xf2, 0x0e, 0xf4, 0xd1, 0x85,
+	0x98, 0x98, 0x3e, 0x21, 0x95, 0xae, 0x0c, 0xa6, 0x13, 0xa9, 0xa2, 0x11, 0x7b, 0x60, 0x61, 0xbb,
+	0x26, 0xae, 0x02, 0xf4, 0x13, 0xd9, 0xea, 0x26, 0x41, 0x0c, 0x1f, 0x92, 0x49, 0x1f, 0x14, 0xdb,
+	0xb6, 0xb0, 0x5d, 0xf5, 0x0e, 0x66, 0x8b, 0x26, 0xfa, 0xb9, 0x68, 0x3a, 0xff, 0x16, 0xe0, 0x14,
+	0xaa, 0x45, 0xb1, 0x15, 0x6d, 0x91, 0xaa, 0x30, 0xaa, 0xf2, 0xd6, 0x8f, 0x0d, 0xf5, 0xb5, 0x18,
+	0xdd, 0x26, 0x9b, 0x87, 0x32, 0x09, 0x35, 0xdb, 0x31, 0x1f, 0x33, 0x40, 0x77, 0x48, 0xf9, 0x58,
+	0x46, 0x63, 0x3f, 0x66, 0x75, 0x6b, 0xc3, 0xae, 0x88, 0x1c, 0xd1, 0x63, 0x42, 0xba, 0x17, 0x06,
+	0xf5, 0x34, 0x82, 0x98, 0x31, 0x6b, 0xc3, 0xae, 0x7a, 0xfb, 0xb9, 0xd4, 0x17, 0xb7, 0x90, 0x2a,
+	0xe0, 0x04, 0x14, 0x84, 0x3e, 0x88, 0x42, 0x9f, 0xd6, 0x9f, 0x12, 0xa9, 0x1d, 0xca, 0x50, 0xab,
+	0x9e, 0x9f, 0x8d, 0xec, 0x4e, 0x4f, 0xec, 0x0d, 0x29, 0x75, 0xda, 0x66, 0x5c, 0x55, 0x6f, 0x37,
+	0x27, 0x7d, 0x7a, 0x0b, 0xd2, 0x4e, 0x5b, 0x94, 0x3a, 0x6d, 0xfa, 0x8c, 0x6c, 0x1a, 0x8f, 0xac,
+	0x6e, 0x61, 0x7b, 0x6b, 0xaf, 0xe6, 0xe4, 0x0f, 0xc7, 0x04, 0xbd, 0x7b, 0xb3, 0x45, 0x13, 0x8b,
+	0x2c, 0x83, 0x0e, 0xc8, 0xc3, 0x8f, 0x23, 0x99, 0x04, 0x83, 0x8e, 0x06, 0xd5, 0xd3, 0xf0, 0x56,
+	0xc9, 0x09, 0x63, 0x6b, 0xb7, 0xf8, 0x3f, 0xdc, 0xde, 0x6c, 0xb8, 0xf7, 0x9e, 0xd4, 0x0c, 0xdd,
+	0x51, 0xbe, 0x23, 0xf4, 0x35, 0x29, 0x67, 0x77, 0xfa, 0xc8, 0x59, 0x2f, 0xd2, 0xfa, 0x35, 0x37,
+	0xea, 0x57, 0xc1, 0x6b, 0x3f, 0xad, 0x85, 0x5e, 0x62, 0x6f, 0x7f, 0xbe, 0xe4, 0xe8, 0x6c, 0xc9,
+	0xd1, 0xf9, 0x92, 0xe3, 0xaf, 0x29, 0xc7, 0xdf, 0x53, 0x8e, 0x67, 0x29, 0xc7, 0xf3, 0x94, 0xe3,
+	0x5f, 0x29, 0xc7, 0xbf, 0x53, 0x8e, 0xce, 0x53, 0x8e, 0xbf, 0xad, 0x38, 0x9a, 0xaf, 0x38, 0x3a,
+	0x5b, 0x71, 0xd4, 0x2f, 0x9b, 0xad, 0x79, 0xf5, 0x77, 0x00, 0x19, 0xb0, 0xfa, 0xe1, 0xd4, 0x03,
+	0x00, 0x00,
+}
+
+func (this *GetEvents) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GetEvents)
+	if !ok {
+		that2, ok := that.(GetEvents)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Polymorph != that1.Polymorph {
+		return false
+	}
+	if !this.PulseNumber.Equal(that1.PulseNumber) {
+		return false
+	}
+	if this.RecordNumber != that1.RecordNumber {
+		return false
+	}
+	if this.Count != that1.Count {
+		return false
+	}
+	if len(this.Topics) != len(that1.Topics) {
+		return false
+	}
+	for i := range this.Topics {
+		if this.Topics[i] != that1.Topics[i] {
+			return false
+		}
+	}
+	if len(this.Prototypes) != len(that1.Prototypes) {
+		return false
+	}
+	for i := range this.Prototypes {
+		if !this.Prototypes[i].Equal(that1.Prototypes[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *ContractEvent) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ContractEvent)
+	if !ok {
+		that2, ok := that.(ContractEvent)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Polymorph != that1.Polymorph {
+		return false
+	}
+	if !this.PulseNumber.Equal(that1.PulseNumber) {
+		return false
+	}
+	if this.RecordNumber != that1.RecordNumber {
+		return false
+	}
+	if !this.ID.Equal(that1.ID) {
+		return false
+	}
+	if !this.Event.Equal(that1.Event) {
+		return false
+	}
+	if that1.ShouldIterateFrom == nil {
+		if this.ShouldIterateFrom != nil {
+			return false
+		}
+	} else if !this.ShouldIterateFrom.Equal(*that1.ShouldIterateFrom) {
+		return false
+	}
+	return true
+}
+func (this *GetEvents) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&exporter.GetEvents{")
+	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
+	s = append(s, "PulseNumber: "+fmt.Sprintf("%#v", this.PulseNumber)+",\n")
+	s = append(s, "RecordNumber: "+fmt.Sprintf("%#v", this.RecordNumber)+",\n")
+	s = append(s, "Count: "+fmt.Sprintf("%#v", this.Count)+",\n")
+	s = append(s, "Topics: "+fmt.Sprintf("%#v", this.Topics)+",\n")
+	s = append(s, "Prototypes: "+fmt.Sprintf("%#v", this.Prototypes)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ContractEvent) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&exporter.ContractEvent{")
+	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
+	s = append(s, "PulseNumber: "+fmt.Sprintf("%#v", this.PulseNumber)+",\n")
+	s = append(s, "RecordNumber: "+fmt.Sprintf("%#v", this.RecordNumber)+",\n")
+	s = append(s, "ID: "+fmt.Sprintf("%#v", this.ID)+",\n")
+	if this.Event != nil {
+		s = append(s, "Event: "+fmt.Sprintf("%#v", this.Event)+",\n")
+	}
+	s = append(s, "ShouldIterateFrom: "+fmt.Sprintf("%#v", this.ShouldIterateFrom)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringEventExporter(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// EventExporterClient is the client API for EventExporter service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type EventExporterClient interface {
+	Export(ctx context.Context, in *GetEvents, opts ...grpc.CallOption) (EventExporter_ExportClient, error)
+}
+
+type eventExporterClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewEventExporterClient(cc *grpc.ClientConn) EventExporterClient {
+	return &eventExporterClient{cc}
+}
+
+func (c *eventExporterClient) Export(ctx context.Context, in *GetEvents, opts ...grpc.CallOption) (EventExporter_ExportClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_EventExporter_serviceDesc.Streams[0], "/exporter.EventExporter/Export", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &eventExporterExportClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type EventExporter_ExportClient interface {
+	Recv() (*ContractEvent, error)
+	grpc.ClientStream
+}
+
+type eventExporterExportClient struct {
+	grpc.ClientStream
+}
+
+func (x *eventExporterExportClient) Recv() (*ContractEvent, error) {
+	m := new(ContractEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// EventExporterServer is the server API for EventExporter service.
+type EventExporterServer interface {
+	Export(*GetEvents, EventExporter_ExportServer) error
+}
+
+func RegisterEventExporterServer(s *grpc.Server, srv EventExporterServer) {
+	s.RegisterService(&_EventExporter_serviceDesc, srv)
+}
+
+func _EventExporter_Export_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetEvents)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(EventExporterServer).Export(m, &eventExporterExportServer{stream})
+}
+
+type EventExporter_ExportServer interface {
+	Send(*ContractEvent) error
+	grpc.ServerStream
+}
+
+type eventExporterExportServer struct {
+	grpc.ServerStream
+}
+
+func (x *eventExporterExportServer) Send(m *ContractEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _EventExporter_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "exporter.EventExporter",
+	HandlerType: (*EventExporterServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Export",
+			Handler:       _EventExporter_Export_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "ledger/heavy/exporter/event_exporter.proto",
+}
+
+func (m *GetEvents) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetEvents) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Polymorph != 0 {
+		dAtA[i] = 0x80
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintEventExporter(dAtA, i, uint64(m.Polymorph))
+	}
+	dAtA[i] = 0xa2
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintEventExporter(dAtA, i, uint64(m.PulseNumber.Size()))
+	n1, err := m.PulseNumber.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n1
+	if m.RecordNumber != 0 {
+		dAtA[i] = 0xa8
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintEventExporter(dAtA, i, uint64(m.RecordNumber))
+	}
+	if m.Count != 0 {
+		dAtA[i] = 0xb0
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintEventExporter(dAtA, i, uint64(m.Count))
+	}
+	if len(m.Topics) > 0 {
+		for _, s := range m.Topics {
+			dAtA[i] = 0xba
+			i++
+			dAtA[i] = 0x1
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Prototypes) > 0 {
+		for _, msg := range m.Prototypes {
+			dAtA[i] = 0xc2
+			i++
+			dAtA[i] = 0x1
+			i++
+			i = encodeVarintEventExporter(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *ContractEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractEvent) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Polymorph != 0 {
+		dAtA[i] = 0x80
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintEventExporter(dAtA, i, uint64(m.Polymorph))
+	}
+	dAtA[i] = 0xa2
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintEventExporter(dAtA, i, uint64(m.PulseNumber.Size()))
+	n2, err := m.PulseNumber.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n2
+	if m.RecordNumber != 0 {
+		dAtA[i] = 0xa8
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintEventExporter(dAtA, i, uint64(m.RecordNumber))
+	}
+	dAtA[i] = 0xb2
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintEventExporter(dAtA, i, uint64(m.ID.Size()))
+	n3, err := m.ID.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n3
+	if m.Event != nil {
+		dAtA[i] = 0xba
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintEventExporter(dAtA, i, uint64(m.Event.Size()))
+		n4, err := m.Event.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
+	if m.ShouldIterateFrom != nil {
+		dAtA[i] = 0xc2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintEventExporter(dAtA, i, uint64(m.ShouldIterateFrom.Size()))
+		n5, err := m.ShouldIterateFrom.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
+	return i, nil
+}
+
+func encodeVarintEventExporter(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *GetEvents) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Polymorph != 0 {
+		n += 2 + sovEventExporter(uint64(m.Polymorph))
+	}
+	l = m.PulseNumber.Size()
+	n += 2 + l + sovEventExporter(uint64(l))
+	if m.RecordNumber != 0 {
+		n += 2 + sovEventExporter(uint64(m.RecordNumber))
+	}
+	if m.Count != 0 {
+		n += 2 + sovEventExporter(uint64(m.Count))
+	}
+	if len(m.Topics) > 0 {
+		for _, s := range m.Topics {
+			l = len(s)
+			n += 2 + l + sovEventExporter(uint64(l))
+		}
+	}
+	if len(m.Prototypes) > 0 {
+		for _, e := range m.Prototypes {
+			l = e.Size()
+			n += 2 + l + sovEventExporter(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ContractEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Polymorph != 0 {
+		n += 2 + sovEventExporter(uint64(m.Polymorph))
+	}
+	l = m.PulseNumber.Size()
+	n += 2 + l + sovEventExporter(uint64(l))
+	if m.RecordNumber != 0 {
+		n += 2 + sovEventExporter(uint64(m.RecordNumber))
+	}
+	l = m.ID.Size()
+	n += 2 + l + sovEventExporter(uint64(l))
+	if m.Event != nil {
+		l = m.Event.Size()
+		n += 2 + l + sovEventExporter(uint64(l))
+	}
+	if m.ShouldIterateFrom != nil {
+		l = m.ShouldIterateFrom.Size()
+		n += 2 + l + sovEventExporter(uint64(l))
+	}
+	return n
+}
+
+func sovEventExporter(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozEventExporter(x uint64) (n int) {
+	return sovEventExporter(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *GetEvents) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetEvents{`,
+		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
+		`PulseNumber:` + fmt.Sprintf("%v", this.PulseNumber) + `,`,
+		`RecordNumber:` + fmt.Sprintf("%v", this.RecordNumber) + `,`,
+		`Count:` + fmt.Sprintf("%v", this.Count) + `,`,
+		`Topics:` + fmt.Sprintf("%v", this.Topics) + `,`,
+		`Prototypes:` + fmt.Sprintf("%v", this.Prototypes) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ContractEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ContractEvent{`,
+		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
+		`PulseNumber:` + fmt.Sprintf("%v", this.PulseNumber) + `,`,
+		`RecordNumber:` + fmt.Sprintf("%v", this.RecordNumber) + `,`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`Event:` + strings.Replace(fmt.Sprintf("%v", this.Event), "Event", "record.Event", 1) + `,`,
+		`ShouldIterateFrom:` + fmt.Sprintf("%v", this.ShouldIterateFrom) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringEventExporter(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *GetEvents) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEventExporter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetEvents: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetEvents: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Polymorph", wireType)
+			}
+			m.Polymorph = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventExporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Polymorph |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PulseNumber", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventExporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEventExporter
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEventExporter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PulseNumber.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordNumber", wireType)
+			}
+			m.RecordNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventExporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecordNumber |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventExporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventExporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEventExporter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEventExporter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Topics = append(m.Topics, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prototypes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventExporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEventExporter
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEventExporter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_insolar_insolar_insolar.Reference
+			m.Prototypes = append(m.Prototypes, v)
+			if err := m.Prototypes[len(m.Prototypes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEventExporter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEventExporter
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEventExporter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEventExporter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Polymorph", wireType)
+			}
+			m.Polymorph = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventExporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Polymorph |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PulseNumber", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventExporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEventExporter
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEventExporter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PulseNumber.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordNumber", wireType)
+			}
+			m.RecordNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventExporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecordNumber |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventExporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEventExporter
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEventExporter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventExporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEventExporter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEventExporter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Event == nil {
+				m.Event = &record.Event{}
+			}
+			if err := m.Event.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShouldIterateFrom", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventExporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEventExporter
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEventExporter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_insolar_insolar_insolar.PulseNumber
+			m.ShouldIterateFrom = &v
+			if err := m.ShouldIterateFrom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEventExporter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEventExporter
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEventExporter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEventExporter(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEventExporter
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEventExporter
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+			return iNdEx, nil
+		case 1:
+			iNdEx += 8
+			return iNdEx, nil
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEventExporter
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEventExporter
+			}
+			iNdEx += length
+			if iNdEx < 0 {
+				return 0, ErrInvalidLengthEventExporter
+			}
+			return iNdEx, nil
+		case 3:
+			for {
+				var innerWire uint64
+				var start int = iNdEx
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return 0, ErrIntOverflowEventExporter
+					}
+					if iNdEx >= l {
+						return 0, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					innerWire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				innerWireType := int(innerWire & 0x7)
+				if innerWireType == 4 {
+					break
+				}
+				next, err := skipEventExporter(dAtA[start:])
+				if err != nil {
+					return 0, err
+				}
+				iNdEx = start + next
+				if iNdEx < 0 {
+					return 0, ErrInvalidLengthEventExporter
+				}
+			}
+			return iNdEx, nil
+		case 4:
+			return iNdEx, nil
+		case 5:
+			iNdEx += 4
+			return iNdEx, nil
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+	}
+	panic("unreachable")
+}
+
+var (
+	ErrInvalidLengthEventExporter = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEventExporter   = fmt.Errorf("proto: integer overflow")
+)
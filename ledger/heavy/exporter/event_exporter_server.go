@@ -0,0 +1,158 @@
+// Copyright 2020 Insolar Network Ltd.
+// All rights reserved.
+// This material is licensed under the Insolar License version 1.0,
+// available at https://github.com/insolar/insolar/blob/master/LICENSE.md.
+
+package exporter
+
+import (
+	"context"
+	"time"
+
+	"go.opencensus.io/stats"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar"
+	insolarPulse "github.com/insolar/insolar/insolar/pulse"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/ledger/heavy/executor"
+	"github.com/insolar/insolar/ledger/object"
+	"github.com/insolar/insolar/pulse"
+)
+
+// EventServer streams events emitted by contracts.
+type EventServer struct {
+	pulseCalculator insolarPulse.Calculator
+	recordIndex     object.RecordPositionAccessor
+	recordAccessor  object.RecordAccessor
+	jetKeeper       executor.JetKeeper
+	authCfg         configuration.Auth
+
+	scanLimit uint32
+}
+
+func NewEventServer(
+	pulseCalculator insolarPulse.Calculator,
+	recordIndex object.RecordPositionAccessor,
+	recordAccessor object.RecordAccessor,
+	jetKeeper executor.JetKeeper,
+	authCfg configuration.Auth,
+) *EventServer {
+	return &EventServer{
+		pulseCalculator: pulseCalculator,
+		recordIndex:     recordIndex,
+		recordAccessor:  recordAccessor,
+		jetKeeper:       jetKeeper,
+		authCfg:         authCfg,
+		scanLimit:       filteredExportScanLimit,
+	}
+}
+
+// Export streams events in the order they are stored on heavy. Events are filtered by topics
+// and prototypes from the request, empty criteria match any event. Like ExportFiltered it checks
+// at most filteredExportScanLimit records, and if less than Count events are sent, the stream is
+// finished with the position of the last checked record, so the client can continue from it.
+// ShouldIterateFrom is set only if all synced records are checked.
+func (s *EventServer) Export(req *GetEvents, stream EventExporter_ExportServer) error {
+	ctx := stream.Context()
+	read := 0
+	exportStart := time.Now()
+	logger := inslogger.FromContext(ctx)
+	logger.Info("Incoming request: ", req.String())
+
+	defer func(ctx context.Context) {
+		stats.Record(
+			addTagsForExporterMethodTiming(s.authCfg.Required, ctx, "event-export"),
+			HeavyExporterMethodTiming.M(float64(time.Since(exportStart).Nanoseconds())/1e6),
+		)
+		logger.Infof("exported %d event", read)
+	}(ctx)
+
+	if req.Count == 0 {
+		return ErrNilCount
+	}
+
+	startPulse := req.PulseNumber
+	if startPulse != 0 {
+		topPulse := s.jetKeeper.TopSyncPulse()
+		if topPulse < startPulse {
+			return ErrNotFinalPulseData
+		}
+	} else {
+		startPulse = pulse.MinTimePulse
+	}
+
+	topics := make(map[string]struct{}, len(req.Topics))
+	for _, topic := range req.Topics {
+		topics[topic] = struct{}{}
+	}
+	prototypes := make(map[insolar.Reference]struct{}, len(req.Prototypes))
+	for _, proto := range req.Prototypes {
+		prototypes[proto] = struct{}{}
+	}
+
+	iter := newRecordIterator(
+		startPulse,
+		req.RecordNumber,
+		s.scanLimit,
+		s.recordIndex,
+		s.recordAccessor,
+		s.jetKeeper,
+		s.pulseCalculator,
+	)
+
+	lastPulse, lastPosition := startPulse, req.RecordNumber
+	for uint32(read) < req.Count && iter.HasNext(ctx) {
+		rec, err := iter.Next(ctx)
+		if err != nil {
+			logger.Error(err)
+			return err
+		}
+		lastPulse, lastPosition = iter.currentPulse, iter.currentPosition
+
+		event, ok := record.Unwrap(&rec.Record.Virtual).(*record.Event)
+		if !ok {
+			continue
+		}
+		if _, ok := topics[event.Topic]; len(topics) > 0 && !ok {
+			continue
+		}
+		if _, ok := prototypes[event.Prototype]; len(prototypes) > 0 && !ok {
+			continue
+		}
+
+		err = stream.Send(&ContractEvent{
+			PulseNumber:  lastPulse,
+			RecordNumber: rec.RecordNumber,
+			ID:           rec.Record.ID,
+			Event:        event,
+		})
+		if err != nil {
+			if ctx.Err() != context.Canceled {
+				logger.Error(err)
+			}
+
+			return err
+		}
+		read++
+	}
+
+	if uint32(read) < req.Count {
+		end := &ContractEvent{
+			PulseNumber:  lastPulse,
+			RecordNumber: lastPosition,
+		}
+		if !iter.LimitReached() {
+			topPulse := s.jetKeeper.TopSyncPulse()
+			end.ShouldIterateFrom = &topPulse
+		}
+		err := stream.Send(end)
+		if err != nil {
+			logger.Error(err)
+			return err
+		}
+	}
+
+	return nil
+}
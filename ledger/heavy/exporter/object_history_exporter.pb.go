@@ -0,0 +1,1169 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ledger/heavy/exporter/object_history_exporter.proto
+
+package exporter
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_insolar_insolar_insolar "github.com/insolar/insolar/insolar"
+	record "github.com/insolar/insolar/insolar/record"
+	grpc "google.golang.org/grpc"
+	io "io"
+	math "math"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+type GetObjectHistory struct {
+	Polymorph uint32                                       `protobuf:"varint,16,opt,name=Polymorph,proto3" json:"Polymorph,omitempty"`
+	Object    github_com_insolar_insolar_insolar.Reference `protobuf:"bytes,20,opt,name=Object,proto3,customtype=github.com/insolar/insolar/insolar.Reference" json:"Object"`
+	FromState *github_com_insolar_insolar_insolar.ID       `protobuf:"bytes,21,opt,name=FromState,proto3,customtype=github.com/insolar/insolar/insolar.ID" json:"FromState,omitempty"`
+	Count     uint32                                       `protobuf:"varint,22,opt,name=Count,proto3" json:"Count,omitempty"`
+}
+
+func (m *GetObjectHistory) Reset()      { *m = GetObjectHistory{} }
+func (*GetObjectHistory) ProtoMessage() {}
+func (*GetObjectHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf1c0e70b0fff61b, []int{0}
+}
+func (m *GetObjectHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetObjectHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetObjectHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetObjectHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetObjectHistory.Merge(m, src)
+}
+func (m *GetObjectHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetObjectHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetObjectHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetObjectHistory proto.InternalMessageInfo
+
+func (m *GetObjectHistory) GetPolymorph() uint32 {
+	if m != nil {
+		return m.Polymorph
+	}
+	return 0
+}
+
+func (m *GetObjectHistory) GetCount() uint32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type ObjectState struct {
+	Polymorph uint32                                 `protobuf:"varint,16,opt,name=Polymorph,proto3" json:"Polymorph,omitempty"`
+	StateID   github_com_insolar_insolar_insolar.ID  `protobuf:"bytes,20,opt,name=StateID,proto3,customtype=github.com/insolar/insolar/insolar.ID" json:"StateID"`
+	State     record.Material                        `protobuf:"bytes,21,opt,name=State,proto3" json:"State"`
+	Request   *record.Material                       `protobuf:"bytes,22,opt,name=Request,proto3" json:"Request,omitempty"`
+	Result    *record.Material                       `protobuf:"bytes,23,opt,name=Result,proto3" json:"Result,omitempty"`
+	PrevState *github_com_insolar_insolar_insolar.ID `protobuf:"bytes,24,opt,name=PrevState,proto3,customtype=github.com/insolar/insolar/insolar.ID" json:"PrevState,omitempty"`
+}
+
+func (m *ObjectState) Reset()      { *m = ObjectState{} }
+func (*ObjectState) ProtoMessage() {}
+func (*ObjectState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf1c0e70b0fff61b, []int{1}
+}
+func (m *ObjectState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ObjectState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ObjectState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ObjectState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectState.Merge(m, src)
+}
+func (m *ObjectState) XXX_Size() int {
+	return m.Size()
+}
+func (m *ObjectState) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectState proto.InternalMessageInfo
+
+func (m *ObjectState) GetPolymorph() uint32 {
+	if m != nil {
+		return m.Polymorph
+	}
+	return 0
+}
+
+func (m *ObjectState) GetState() record.Material {
+	if m != nil {
+		return m.State
+	}
+	return record.Material{}
+}
+
+func (m *ObjectState) GetRequest() *record.Material {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+func (m *ObjectState) GetResult() *record.Material {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*GetObjectHistory)(nil), "exporter.GetObjectHistory")
+	proto.RegisterType((*ObjectState)(nil), "exporter.ObjectState")
+}
+
+func init() {
+	proto.RegisterFile("ledger/heavy/exporter/object_history_exporter.proto", fileDescriptor_bf1c0e70b0fff61b)
+}
+
+var fileDescriptor_bf1c0e70b0fff61b = []byte{
+	// 444 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0xc1, 0x6e, 0xd3, 0x30,
+	0x18, 0xc7, 0x6d, 0xc4, 0x3a, 0xe6, 0x81, 0x54, 0x59, 0x2d, 0x44, 0x15, 0x72, 0xa6, 0x4a, 0x48,
+	0x3b, 0x6c, 0xc9, 0xb4, 0x4d, 0x5c, 0x91, 0xca, 0x60, 0x4c, 0x80, 0x98, 0xcc, 0x85, 0xdb, 0x94,
+	0x64, 0xdf, 0x92, 0xa0, 0xb4, 0x2e, 0x8e, 0x33, 0xd1, 0x1b, 0x8f, 0xc0, 0x63, 0xf0, 0x28, 0x3d,
+	0x96, 0xdb, 0xb4, 0x43, 0x44, 0xd2, 0x0b, 0xc7, 0x3d, 0x02, 0x9a, 0x9d, 0x34, 0x03, 0xc4, 0x98,
+	0x76, 0xb2, 0x3f, 0xe7, 0xf7, 0xff, 0xf2, 0xf7, 0xe7, 0x3f, 0xd9, 0x49, 0xe0, 0x38, 0x04, 0xe9,
+	0x46, 0xe0, 0x9d, 0x4e, 0x5c, 0xf8, 0x3c, 0x16, 0x52, 0x81, 0x74, 0x85, 0xff, 0x11, 0x02, 0x75,
+	0x14, 0xc5, 0xa9, 0x12, 0x72, 0x72, 0x54, 0x9f, 0x3b, 0x63, 0x29, 0x94, 0xa0, 0xf7, 0xea, 0xba,
+	0xb7, 0x19, 0xc6, 0x2a, 0xca, 0x7c, 0x27, 0x10, 0x43, 0x37, 0x14, 0xa1, 0x70, 0x35, 0xe0, 0x67,
+	0x27, 0xba, 0xd2, 0x85, 0xde, 0x19, 0x61, 0xef, 0xe9, 0x15, 0x3c, 0x1e, 0xa5, 0x22, 0xf1, 0xe4,
+	0x5f, 0xab, 0x84, 0x40, 0xc8, 0xe3, 0x6a, 0x31, 0xba, 0x7e, 0x81, 0x49, 0x7b, 0x1f, 0xd4, 0x3b,
+	0xed, 0xea, 0x95, 0x31, 0x45, 0x1f, 0x93, 0x95, 0x43, 0x91, 0x4c, 0x86, 0x42, 0x8e, 0x23, 0xab,
+	0xbd, 0x86, 0xd7, 0x1f, 0xf0, 0xe6, 0x80, 0xbe, 0x21, 0x2d, 0x83, 0x5b, 0x9d, 0x35, 0xbc, 0x7e,
+	0x7f, 0xb0, 0x3b, 0xcd, 0x6d, 0x74, 0x9e, 0xdb, 0x1b, 0xff, 0xb7, 0xe0, 0x70, 0x38, 0x01, 0x09,
+	0xa3, 0x00, 0x78, 0xd5, 0x83, 0xbe, 0x26, 0x2b, 0x2f, 0xa5, 0x18, 0xbe, 0x57, 0x9e, 0x02, 0xab,
+	0xab, 0x1b, 0x6e, 0x4e, 0x73, 0x1b, 0x9f, 0xe7, 0xf6, 0x93, 0x1b, 0x34, 0x3c, 0xd8, 0xe3, 0x8d,
+	0x9e, 0x76, 0xc8, 0xd2, 0x73, 0x91, 0x8d, 0x94, 0xf5, 0x50, 0x9b, 0x36, 0x45, 0xff, 0xfb, 0x1d,
+	0xb2, 0x6a, 0xfe, 0x66, 0xa8, 0xeb, 0xaf, 0xb7, 0x4f, 0x96, 0x35, 0x76, 0xb0, 0x67, 0x75, 0x16,
+	0x76, 0xd0, 0xcd, 0xed, 0xd4, 0x6a, 0xba, 0x41, 0x96, 0x9a, 0x5b, 0xad, 0x6e, 0xb7, 0x9d, 0x6a,
+	0xf0, 0x6f, 0x3d, 0x05, 0x32, 0xf6, 0x92, 0xc1, 0xdd, 0xcb, 0xc6, 0xdc, 0x40, 0x74, 0x8b, 0x2c,
+	0x73, 0xf8, 0x94, 0x41, 0x6a, 0xcc, 0xff, 0x8b, 0xc7, 0xbc, 0xc6, 0xa8, 0x43, 0x5a, 0x1c, 0xd2,
+	0x2c, 0x51, 0xd6, 0xa3, 0x6b, 0x05, 0x15, 0x75, 0x39, 0xe9, 0x43, 0x09, 0xa7, 0xc6, 0x93, 0x75,
+	0xab, 0x49, 0x2f, 0xf4, 0xdb, 0x1f, 0x48, 0xf7, 0xb7, 0xcc, 0xbc, 0xa8, 0x72, 0x4b, 0x9f, 0x91,
+	0x96, 0xd9, 0xd3, 0x9e, 0xb3, 0x08, 0xf7, 0x9f, 0x09, 0xeb, 0x75, 0x9b, 0x6f, 0x57, 0x5e, 0xa6,
+	0x8f, 0xb6, 0xf0, 0x60, 0x77, 0x56, 0x30, 0x74, 0x56, 0x30, 0x74, 0x51, 0x30, 0xfc, 0xa5, 0x64,
+	0xf8, 0x5b, 0xc9, 0xf0, 0xb4, 0x64, 0x78, 0x56, 0x32, 0xfc, 0xa3, 0x64, 0xf8, 0x67, 0xc9, 0xd0,
+	0x45, 0xc9, 0xf0, 0xd7, 0x39, 0x43, 0xb3, 0x39, 0x43, 0x67, 0x73, 0x86, 0xfc, 0x96, 0x8e, 0xf3,
+	0xce, 0xaf, 0x01, 0x00, 0xc0, 0x60, 0xc6, 0xdc, 0x76, 0x03, 0x00, 0x00,
+}
+
+func (this *GetObjectHistory) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GetObjectHistory)
+	if !ok {
+		that2, ok := that.(GetObjectHistory)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Polymorph != that1.Polymorph {
+		return false
+	}
+	if !this.Object.Equal(that1.Object) {
+		return false
+	}
+	if that1.FromState == nil {
+		if this.FromState != nil {
+			return false
+		}
+	} else if !this.FromState.Equal(*that1.FromState) {
+		return false
+	}
+	if this.Count != that1.Count {
+		return false
+	}
+	return true
+}
+func (this *ObjectState) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ObjectState)
+	if !ok {
+		that2, ok := that.(ObjectState)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Polymorph != that1.Polymorph {
+		return false
+	}
+	if !this.StateID.Equal(that1.StateID) {
+		return false
+	}
+	if !this.State.Equal(&that1.State) {
+		return false
+	}
+	if !this.Request.Equal(that1.Request) {
+		return false
+	}
+	if !this.Result.Equal(that1.Result) {
+		return false
+	}
+	if that1.PrevState == nil {
+		if this.PrevState != nil {
+			return false
+		}
+	} else if !this.PrevState.Equal(*that1.PrevState) {
+		return false
+	}
+	return true
+}
+func (this *GetObjectHistory) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&exporter.GetObjectHistory{")
+	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
+	s = append(s, "Object: "+fmt.Sprintf("%#v", this.Object)+",\n")
+	s = append(s, "FromState: "+fmt.Sprintf("%#v", this.FromState)+",\n")
+	s = append(s, "Count: "+fmt.Sprintf("%#v", this.Count)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ObjectState) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&exporter.ObjectState{")
+	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
+	s = append(s, "StateID: "+fmt.Sprintf("%#v", this.StateID)+",\n")
+	s = append(s, "State: "+strings.Replace(this.State.GoString(), `&`, ``, 1)+",\n")
+	if this.Request != nil {
+		s = append(s, "Request: "+fmt.Sprintf("%#v", this.Request)+",\n")
+	}
+	if this.Result != nil {
+		s = append(s, "Result: "+fmt.Sprintf("%#v", this.Result)+",\n")
+	}
+	s = append(s, "PrevState: "+fmt.Sprintf("%#v", this.PrevState)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringObjectHistoryExporter(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ObjectHistoryExporterClient is the client API for ObjectHistoryExporter service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ObjectHistoryExporterClient interface {
+	Export(ctx context.Context, in *GetObjectHistory, opts ...grpc.CallOption) (ObjectHistoryExporter_ExportClient, error)
+}
+
+type objectHistoryExporterClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewObjectHistoryExporterClient(cc *grpc.ClientConn) ObjectHistoryExporterClient {
+	return &objectHistoryExporterClient{cc}
+}
+
+func (c *objectHistoryExporterClient) Export(ctx context.Context, in *GetObjectHistory, opts ...grpc.CallOption) (ObjectHistoryExporter_ExportClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ObjectHistoryExporter_serviceDesc.Streams[0], "/exporter.ObjectHistoryExporter/Export", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &objectHistoryExporterExportClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ObjectHistoryExporter_ExportClient interface {
+	Recv() (*ObjectState, error)
+	grpc.ClientStream
+}
+
+type objectHistoryExporterExportClient struct {
+	grpc.ClientStream
+}
+
+func (x *objectHistoryExporterExportClient) Recv() (*ObjectState, error) {
+	m := new(ObjectState)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// ObjectHistoryExporterServer is the server API for ObjectHistoryExporter service.
+type ObjectHistoryExporterServer interface {
+	Export(*GetObjectHistory, ObjectHistoryExporter_ExportServer) error
+}
+
+func RegisterObjectHistoryExporterServer(s *grpc.Server, srv ObjectHistoryExporterServer) {
+	s.RegisterService(&_ObjectHistoryExporter_serviceDesc, srv)
+}
+
+func _ObjectHistoryExporter_Export_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetObjectHistory)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ObjectHistoryExporterServer).Export(m, &objectHistoryExporterExportServer{stream})
+}
+
+type ObjectHistoryExporter_ExportServer interface {
+	Send(*ObjectState) error
+	grpc.ServerStream
+}
+
+type objectHistoryExporterExportServer struct {
+	grpc.ServerStream
+}
+
+func (x *objectHistoryExporterExportServer) Send(m *ObjectState) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _ObjectHistoryExporter_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "exporter.ObjectHistoryExporter",
+	HandlerType: (*ObjectHistoryExporterServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Export",
+			Handler:       _ObjectHistoryExporter_Export_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "ledger/heavy/exporter/object_history_exporter.proto",
+}
+
+func (m *GetObjectHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetObjectHistory) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Polymorph != 0 {
+		dAtA[i] = 0x80
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintObjectHistoryExporter(dAtA, i, uint64(m.Polymorph))
+	}
+	dAtA[i] = 0xa2
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintObjectHistoryExporter(dAtA, i, uint64(m.Object.Size()))
+	n1, err := m.Object.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n1
+	if m.FromState != nil {
+		dAtA[i] = 0xaa
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintObjectHistoryExporter(dAtA, i, uint64(m.FromState.Size()))
+		n2, err := m.FromState.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	if m.Count != 0 {
+		dAtA[i] = 0xb0
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintObjectHistoryExporter(dAtA, i, uint64(m.Count))
+	}
+	return i, nil
+}
+
+func (m *ObjectState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ObjectState) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Polymorph != 0 {
+		dAtA[i] = 0x80
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintObjectHistoryExporter(dAtA, i, uint64(m.Polymorph))
+	}
+	dAtA[i] = 0xa2
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintObjectHistoryExporter(dAtA, i, uint64(m.StateID.Size()))
+	n3, err := m.StateID.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n3
+	dAtA[i] = 0xaa
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintObjectHistoryExporter(dAtA, i, uint64(m.State.Size()))
+	n4, err := m.State.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n4
+	if m.Request != nil {
+		dAtA[i] = 0xb2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintObjectHistoryExporter(dAtA, i, uint64(m.Request.Size()))
+		n5, err := m.Request.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
+	if m.Result != nil {
+		dAtA[i] = 0xba
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintObjectHistoryExporter(dAtA, i, uint64(m.Result.Size()))
+		n6, err := m.Result.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n6
+	}
+	if m.PrevState != nil {
+		dAtA[i] = 0xc2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintObjectHistoryExporter(dAtA, i, uint64(m.PrevState.Size()))
+		n7, err := m.PrevState.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n7
+	}
+	return i, nil
+}
+
+func encodeVarintObjectHistoryExporter(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *GetObjectHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Polymorph != 0 {
+		n += 2 + sovObjectHistoryExporter(uint64(m.Polymorph))
+	}
+	l = m.Object.Size()
+	n += 2 + l + sovObjectHistoryExporter(uint64(l))
+	if m.FromState != nil {
+		l = m.FromState.Size()
+		n += 2 + l + sovObjectHistoryExporter(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 2 + sovObjectHistoryExporter(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *ObjectState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Polymorph != 0 {
+		n += 2 + sovObjectHistoryExporter(uint64(m.Polymorph))
+	}
+	l = m.StateID.Size()
+	n += 2 + l + sovObjectHistoryExporter(uint64(l))
+	l = m.State.Size()
+	n += 2 + l + sovObjectHistoryExporter(uint64(l))
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovObjectHistoryExporter(uint64(l))
+	}
+	if m.Result != nil {
+		l = m.Result.Size()
+		n += 2 + l + sovObjectHistoryExporter(uint64(l))
+	}
+	if m.PrevState != nil {
+		l = m.PrevState.Size()
+		n += 2 + l + sovObjectHistoryExporter(uint64(l))
+	}
+	return n
+}
+
+func sovObjectHistoryExporter(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozObjectHistoryExporter(x uint64) (n int) {
+	return sovObjectHistoryExporter(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *GetObjectHistory) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetObjectHistory{`,
+		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
+		`Object:` + fmt.Sprintf("%v", this.Object) + `,`,
+		`FromState:` + fmt.Sprintf("%v", this.FromState) + `,`,
+		`Count:` + fmt.Sprintf("%v", this.Count) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ObjectState) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ObjectState{`,
+		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
+		`StateID:` + fmt.Sprintf("%v", this.StateID) + `,`,
+		`State:` + strings.Replace(strings.Replace(this.State.String(), "Material", "record.Material", 1), `&`, ``, 1) + `,`,
+		`Request:` + strings.Replace(fmt.Sprintf("%v", this.Request), "Material", "record.Material", 1) + `,`,
+		`Result:` + strings.Replace(fmt.Sprintf("%v", this.Result), "Material", "record.Material", 1) + `,`,
+		`PrevState:` + fmt.Sprintf("%v", this.PrevState) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringObjectHistoryExporter(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *GetObjectHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowObjectHistoryExporter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetObjectHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetObjectHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Polymorph", wireType)
+			}
+			m.Polymorph = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjectHistoryExporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Polymorph |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Object", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjectHistoryExporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthObjectHistoryExporter
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthObjectHistoryExporter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Object.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromState", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjectHistoryExporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthObjectHistoryExporter
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthObjectHistoryExporter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_insolar_insolar_insolar.ID
+			m.FromState = &v
+			if err := m.FromState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjectHistoryExporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipObjectHistoryExporter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthObjectHistoryExporter
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthObjectHistoryExporter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ObjectState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowObjectHistoryExporter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ObjectState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ObjectState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Polymorph", wireType)
+			}
+			m.Polymorph = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjectHistoryExporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Polymorph |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjectHistoryExporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthObjectHistoryExporter
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthObjectHistoryExporter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StateID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjectHistoryExporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthObjectHistoryExporter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthObjectHistoryExporter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjectHistoryExporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthObjectHistoryExporter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthObjectHistoryExporter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &record.Material{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjectHistoryExporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthObjectHistoryExporter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthObjectHistoryExporter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Result == nil {
+				m.Result = &record.Material{}
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevState", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjectHistoryExporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthObjectHistoryExporter
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthObjectHistoryExporter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_insolar_insolar_insolar.ID
+			m.PrevState = &v
+			if err := m.PrevState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipObjectHistoryExporter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthObjectHistoryExporter
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthObjectHistoryExporter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipObjectHistoryExporter(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowObjectHistoryExporter
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowObjectHistoryExporter
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+			return iNdEx, nil
+		case 1:
+			iNdEx += 8
+			return iNdEx, nil
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowObjectHistoryExporter
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthObjectHistoryExporter
+			}
+			iNdEx += length
+			if iNdEx < 0 {
+				return 0, ErrInvalidLengthObjectHistoryExporter
+			}
+			return iNdEx, nil
+		case 3:
+			for {
+				var innerWire uint64
+				var start int = iNdEx
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return 0, ErrIntOverflowObjectHistoryExporter
+					}
+					if iNdEx >= l {
+						return 0, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					innerWire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				innerWireType := int(innerWire & 0x7)
+				if innerWireType == 4 {
+					break
+				}
+				next, err := skipObjectHistoryExporter(dAtA[start:])
+				if err != nil {
+					return 0, err
+				}
+				iNdEx = start + next
+				if iNdEx < 0 {
+					return 0, ErrInvalidLengthObjectHistoryExporter
+				}
+			}
+			return iNdEx, nil
+		case 4:
+			return iNdEx, nil
+		case 5:
+			iNdEx += 4
+			return iNdEx, nil
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+	}
+	panic("unreachable")
+}
+
+var (
+	ErrInvalidLengthObjectHistoryExporter = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowObjectHistoryExporter   = fmt.Errorf("proto: integer overflow")
+)
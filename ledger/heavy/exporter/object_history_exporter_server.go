@@ -0,0 +1,213 @@
+// Copyright 2020 Insolar Network Ltd.
+// All rights reserved.
+// This material is licensed under the Insolar License version 1.0,
+// available at https://github.com/insolar/insolar/blob/master/LICENSE.md.
+
+package exporter
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.opencensus.io/stats"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/ledger/heavy/executor"
+	"github.com/insolar/insolar/ledger/object"
+)
+
+type ObjectHistoryServer struct {
+	indexes   object.IndexAccessor
+	records   object.RecordAccessor
+	jetKeeper executor.JetKeeper
+	authCfg   configuration.Auth
+}
+
+func NewObjectHistoryServer(
+	indexes object.IndexAccessor,
+	records object.RecordAccessor,
+	jetKeeper executor.JetKeeper,
+	authCfg configuration.Auth,
+) *ObjectHistoryServer {
+	return &ObjectHistoryServer{
+		indexes:   indexes,
+		records:   records,
+		jetKeeper: jetKeeper,
+		authCfg:   authCfg,
+	}
+}
+
+// Export streams a page of the object states in causal order (from the oldest to the newest one).
+// The page contains up to Count states ending with FromState (or the latest finalized state if it's not set).
+// PrevState of the first sent state is a FromState for the next (older) page. It's nil when the page
+// starts with the activation of the object.
+func (h *ObjectHistoryServer) Export(req *GetObjectHistory, stream ObjectHistoryExporter_ExportServer) error {
+	ctx := stream.Context()
+	exportStart := time.Now()
+	logger := inslogger.FromContext(ctx)
+	logger.Info("Incoming request: ", req.String())
+
+	defer func(ctx context.Context) {
+		stats.Record(
+			addTagsForExporterMethodTiming(h.authCfg.Required, ctx, "object-history-export"),
+			HeavyExporterMethodTiming.M(float64(time.Since(exportStart).Nanoseconds())/1e6),
+		)
+	}(ctx)
+
+	if req.Count == 0 {
+		return ErrNilCount
+	}
+
+	objectID := *req.Object.GetLocal()
+	idx, err := h.indexes.LastKnownForID(ctx, objectID)
+	if err != nil {
+		if err == object.ErrIndexNotFound {
+			return ErrObjectNotFound
+		}
+		logger.Error(err)
+		return errors.Wrap(err, "failed to fetch object index")
+	}
+
+	from := idx.Lifeline.LatestState
+	if req.FromState != nil {
+		from = req.FromState
+	}
+
+	states, err := h.collectStates(ctx, objectID, from, req.Count)
+	if err != nil {
+		logger.Error(err)
+		return err
+	}
+
+	err = h.attachRequests(ctx, idx.Lifeline.LatestRequest, states)
+	if err != nil {
+		logger.Error(err)
+		return err
+	}
+
+	for i := len(states) - 1; i >= 0; i-- {
+		err := stream.Send(states[i])
+		if err != nil {
+			if ctx.Err() != context.Canceled {
+				logger.Error(err)
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// collectStates walks back through PrevState links and returns states from the newest to the oldest one.
+// States from not finalized pulses are skipped.
+func (h *ObjectHistoryServer) collectStates(
+	ctx context.Context,
+	objectID insolar.ID,
+	from *insolar.ID,
+	count uint32,
+) ([]*ObjectState, error) {
+	topPulse := h.jetKeeper.TopSyncPulse()
+
+	var states []*ObjectState
+	for current := from; current != nil && uint32(len(states)) < count; {
+		rec, err := h.records.ForID(ctx, *current)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to fetch state %s", current.DebugString())
+		}
+		if !rec.ObjectID.IsEmpty() && rec.ObjectID != objectID {
+			return nil, ErrStateOfAnotherObject
+		}
+
+		state, ok := record.Unwrap(&rec.Virtual).(record.State)
+		if !ok {
+			return nil, fmt.Errorf("unexpected state record %T", record.Unwrap(&rec.Virtual))
+		}
+		prev := state.PrevStateID()
+
+		if current.Pulse() <= topPulse {
+			states = append(states, &ObjectState{
+				StateID:   *current,
+				State:     rec,
+				PrevState: prev,
+			})
+		}
+		current = prev
+	}
+
+	return states, nil
+}
+
+// attachRequests fills requests and results that produced the states.
+// Results are searched in the pending filament of the object. The filament is walked back only until
+// all the requests of the states are met, because a result is always stored after its request.
+func (h *ObjectHistoryServer) attachRequests(
+	ctx context.Context,
+	latestFilament *insolar.ID,
+	states []*ObjectState,
+) error {
+	pending := map[insolar.ID]*ObjectState{}
+	for _, s := range states {
+		reqRef := stateRequest(&s.State)
+		if reqRef == nil || reqRef.IsEmpty() {
+			continue
+		}
+		reqID := *reqRef.GetLocal()
+
+		req, err := h.records.ForID(ctx, reqID)
+		if err != nil {
+			if err == object.ErrNotFound {
+				continue
+			}
+			return errors.Wrapf(err, "failed to fetch request %s", reqID.DebugString())
+		}
+		s.Request = &req
+		pending[reqID] = s
+	}
+
+	results := map[insolar.ID]*record.Material{}
+	for current := latestFilament; current != nil && len(pending) > 0; {
+		meta, err := h.records.ForID(ctx, *current)
+		if err != nil {
+			return errors.Wrapf(err, "failed to fetch filament record %s", current.DebugString())
+		}
+		filament, ok := record.Unwrap(&meta.Virtual).(*record.PendingFilament)
+		if !ok {
+			return fmt.Errorf("unexpected filament record %T", record.Unwrap(&meta.Virtual))
+		}
+		current = filament.PreviousRecord
+
+		if s, ok := pending[filament.RecordID]; ok {
+			s.Result = results[filament.RecordID]
+			delete(pending, filament.RecordID)
+			continue
+		}
+
+		rec, err := h.records.ForID(ctx, filament.RecordID)
+		if err != nil {
+			return errors.Wrapf(err, "failed to fetch filament record %s", filament.RecordID.DebugString())
+		}
+		if res, ok := record.Unwrap(&rec.Virtual).(*record.Result); ok {
+			results[*res.Request.GetLocal()] = &rec
+		}
+	}
+
+	return nil
+}
+
+func stateRequest(rec *record.Material) *insolar.Reference {
+	switch s := record.Unwrap(&rec.Virtual).(type) {
+	case *record.Activate:
+		return &s.Request
+	case *record.Amend:
+		return &s.Request
+	case *record.Deactivate:
+		return &s.Request
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,166 @@
+// Copyright 2020 Insolar Network Ltd.
+// All rights reserved.
+// This material is licensed under the Insolar License version 1.0,
+// available at https://github.com/insolar/insolar/blob/master/LICENSE.md.
+
+package exporter
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/ledger/heavy/executor"
+	"github.com/insolar/insolar/ledger/object"
+	"github.com/insolar/insolar/pulse"
+)
+
+type historyStreamMock struct {
+	grpc.ServerStream
+	states []*ObjectState
+}
+
+func (s *historyStreamMock) Send(state *ObjectState) error {
+	s.states = append(s.states, state)
+	return nil
+}
+
+func (s *historyStreamMock) Context() context.Context {
+	return context.Background()
+}
+
+func TestObjectHistoryServer_Export(t *testing.T) {
+	firstPN := insolar.PulseNumber(pulse.MinTimePulse + 100)
+	secondPN := firstPN + 10
+
+	objectID := gen.IDWithPulse(firstPN)
+	objectRef := insolar.NewReference(objectID)
+
+	records := map[insolar.ID]record.Material{}
+	put := func(id insolar.ID, rec record.Record) {
+		records[id] = record.Material{Virtual: record.Wrap(rec), ID: id, ObjectID: objectID}
+	}
+
+	// Activation: request, result and state.
+	activateReqID := objectID
+	activateResID := gen.IDWithPulse(firstPN)
+	activateID := gen.IDWithPulse(firstPN)
+	put(activateReqID, &record.IncomingRequest{Method: "New"})
+	put(activateID, &record.Activate{Request: *insolar.NewReference(activateReqID)})
+	put(activateResID, &record.Result{Object: objectID, Request: *insolar.NewReference(activateReqID)})
+
+	// Amend: request, result and state.
+	amendReqID := gen.IDWithPulse(secondPN)
+	amendResID := gen.IDWithPulse(secondPN)
+	amendID := gen.IDWithPulse(secondPN)
+	put(amendReqID, &record.IncomingRequest{Method: "Update"})
+	put(amendID, &record.Amend{Request: *insolar.NewReference(amendReqID), PrevState: activateID})
+	put(amendResID, &record.Result{Object: objectID, Request: *insolar.NewReference(amendReqID)})
+
+	// Filament: activateReq <- activateRes <- amendReq <- amendRes.
+	var prev *insolar.ID
+	for _, id := range []insolar.ID{activateReqID, activateResID, amendReqID, amendResID} {
+		metaID := gen.IDWithPulse(id.Pulse())
+		put(metaID, &record.PendingFilament{RecordID: id, PreviousRecord: prev})
+		prev = &metaID
+	}
+	latestFilament := *prev
+
+	recordAccessor := object.NewRecordAccessorMock(t)
+	recordAccessor.ForIDMock.Set(func(_ context.Context, id insolar.ID) (record.Material, error) {
+		rec, ok := records[id]
+		if !ok {
+			return record.Material{}, object.ErrNotFound
+		}
+		return rec, nil
+	})
+
+	indexAccessor := object.NewIndexAccessorMock(t)
+	indexAccessor.LastKnownForIDMock.Set(func(_ context.Context, id insolar.ID) (record.Index, error) {
+		if id != objectID {
+			return record.Index{}, object.ErrIndexNotFound
+		}
+		return record.Index{
+			ObjID: objectID,
+			Lifeline: record.Lifeline{
+				LatestState:   &amendID,
+				LatestRequest: &latestFilament,
+			},
+		}, nil
+	})
+
+	newServer := func(topPulse insolar.PulseNumber) *ObjectHistoryServer {
+		jetKeeper := executor.NewJetKeeperMock(t)
+		jetKeeper.TopSyncPulseMock.Return(topPulse)
+		return NewObjectHistoryServer(indexAccessor, recordAccessor, jetKeeper, configuration.Auth{})
+	}
+
+	t.Run("count is 0", func(t *testing.T) {
+		err := newServer(secondPN).Export(&GetObjectHistory{Object: *objectRef}, &historyStreamMock{})
+		require.Equal(t, ErrNilCount, err)
+	})
+
+	t.Run("unknown object", func(t *testing.T) {
+		err := newServer(secondPN).Export(&GetObjectHistory{Object: gen.Reference(), Count: 1}, &historyStreamMock{})
+		require.Equal(t, ErrObjectNotFound, err)
+	})
+
+	t.Run("full history in causal order", func(t *testing.T) {
+		stream := &historyStreamMock{}
+		err := newServer(secondPN).Export(&GetObjectHistory{Object: *objectRef, Count: 10}, stream)
+		require.NoError(t, err)
+		require.Equal(t, 2, len(stream.states))
+
+		first, second := stream.states[0], stream.states[1]
+		require.Equal(t, activateID, first.StateID)
+		require.Nil(t, first.PrevState)
+		require.Equal(t, activateReqID, first.Request.ID)
+		require.Equal(t, activateResID, first.Result.ID)
+
+		require.Equal(t, amendID, second.StateID)
+		require.Equal(t, activateID, *second.PrevState)
+		require.Equal(t, amendReqID, second.Request.ID)
+		require.Equal(t, amendResID, second.Result.ID)
+	})
+
+	t.Run("paging", func(t *testing.T) {
+		stream := &historyStreamMock{}
+		err := newServer(secondPN).Export(&GetObjectHistory{Object: *objectRef, Count: 1}, stream)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(stream.states))
+		require.Equal(t, amendID, stream.states[0].StateID)
+
+		next := stream.states[0].PrevState
+		stream = &historyStreamMock{}
+		err = newServer(secondPN).Export(&GetObjectHistory{Object: *objectRef, Count: 1, FromState: next}, stream)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(stream.states))
+		require.Equal(t, activateID, stream.states[0].StateID)
+		require.Equal(t, activateResID, stream.states[0].Result.ID)
+	})
+
+	t.Run("not finalized states are skipped", func(t *testing.T) {
+		stream := &historyStreamMock{}
+		err := newServer(firstPN).Export(&GetObjectHistory{Object: *objectRef, Count: 10}, stream)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(stream.states))
+		require.Equal(t, activateID, stream.states[0].StateID)
+	})
+
+	t.Run("state of another object", func(t *testing.T) {
+		foreignID := gen.IDWithPulse(firstPN)
+		records[foreignID] = record.Material{
+			Virtual:  record.Wrap(&record.Activate{}),
+			ObjectID: gen.ID(),
+		}
+
+		err := newServer(secondPN).Export(&GetObjectHistory{Object: *objectRef, Count: 1, FromState: &foreignID}, &historyStreamMock{})
+		require.Equal(t, ErrStateOfAnotherObject, err)
+	})
+}
@@ -924,3 +924,74 @@ func TestRecordServer_Export_ReturnTopPulseWhenNoRecords_WithAuth(t *testing.T)
 		require.NotNil(t, secondPN, *resRecord.ShouldIterateFrom)
 	})
 }
+
+func TestRecordServer_ExportFiltered(t *testing.T) {
+	defer cleanupDatabase()
+
+	ctx := inslogger.TestContext(t)
+
+	// Pulses
+	firstPN := insolar.PulseNumber(pulse.MinTimePulse + 100)
+	secondPN := insolar.PulseNumber(firstPN + 10)
+
+	// JetKeeper
+	jetKeeper := executor.NewJetKeeperMock(t)
+	jetKeeper.TopSyncPulseMock.Return(secondPN)
+
+	// IDs and Records
+	firstRec := getMaterialRecord()
+	firstRec.ID = gen.IDWithPulse(firstPN)
+	firstRec.Virtual = record.Wrap(&record.Activate{})
+
+	secondRec := getMaterialRecord()
+	secondRec.ID = gen.IDWithPulse(firstPN)
+
+	thirdRec := getMaterialRecord()
+	thirdRec.ID = gen.IDWithPulse(secondPN)
+	thirdRec.Virtual = record.Wrap(&record.Deactivate{})
+
+	pulseStorage := insolarPulse.NewPostgresDB(getPool())
+	recordStorage := object.NewPostgresRecordDB(getPool())
+	recordPosition := object.NewPostgresRecordDB(getPool())
+
+	err := recordStorage.Set(ctx, firstRec)
+	require.NoError(t, err)
+	err = recordStorage.Set(ctx, secondRec)
+	require.NoError(t, err)
+	err = recordStorage.Set(ctx, thirdRec)
+	require.NoError(t, err)
+
+	err = pulseStorage.Append(ctx, insolar.Pulse{PulseNumber: pulse.MinTimePulse})
+	require.NoError(t, err)
+	err = pulseStorage.Append(ctx, insolar.Pulse{PulseNumber: firstPN})
+	require.NoError(t, err)
+	err = pulseStorage.Append(ctx, insolar.Pulse{PulseNumber: secondPN})
+	require.NoError(t, err)
+
+	recordServer := NewRecordServer(pulseStorage, recordPosition, recordStorage, jetKeeper, configuration.Auth{})
+
+	t.Run("filter by record type", func(t *testing.T) {
+		var recs []*FilteredRecord
+		stream := filteredStreamMock{checker: func(i *FilteredRecord) error {
+			recs = append(recs, i)
+			return nil
+		}}
+
+		err := recordServer.ExportFiltered(&GetFilteredRecords{
+			Count:       2,
+			RecordTypes: []string{"Activate", "Deactivate"},
+		}, stream)
+		require.NoError(t, err)
+		require.Equal(t, 2, len(recs))
+		require.Equal(t, firstRec, *recs[0].Record)
+		require.Equal(t, thirdRec, *recs[1].Record)
+	})
+
+	t.Run("unknown record type", func(t *testing.T) {
+		err := recordServer.ExportFiltered(&GetFilteredRecords{
+			Count:       1,
+			RecordTypes: []string{"Unknown"},
+		}, filteredStreamMock{})
+		require.Equal(t, ErrUnknownRecordType, err)
+	})
+}
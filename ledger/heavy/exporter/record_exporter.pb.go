@@ -4,6 +4,7 @@
 package exporter
 
 import (
+	bytes "bytes"
 	context "context"
 	fmt "fmt"
 	_ "github.com/gogo/protobuf/gogoproto"
@@ -148,9 +149,157 @@ func (m *Record) GetRecord() record.Material {
 	return record.Material{}
 }
 
+type GetFilteredRecords struct {
+	Polymorph    uint32                                         `protobuf:"varint,16,opt,name=Polymorph,proto3" json:"Polymorph,omitempty"`
+	PulseNumber  github_com_insolar_insolar_insolar.PulseNumber `protobuf:"bytes,20,opt,name=PulseNumber,proto3,customtype=github.com/insolar/insolar/insolar.PulseNumber" json:"PulseNumber"`
+	RecordNumber uint32                                         `protobuf:"varint,21,opt,name=RecordNumber,proto3" json:"RecordNumber,omitempty"`
+	Count        uint32                                         `protobuf:"varint,22,opt,name=Count,proto3" json:"Count,omitempty"`
+	ResumeToken  []byte                                         `protobuf:"bytes,23,opt,name=ResumeToken,proto3" json:"ResumeToken,omitempty"`
+	ObjectIDs    []github_com_insolar_insolar_insolar.ID        `protobuf:"bytes,24,rep,name=ObjectIDs,proto3,customtype=github.com/insolar/insolar/insolar.ID" json:"ObjectIDs"`
+	RecordTypes  []string                                       `protobuf:"bytes,25,rep,name=RecordTypes,proto3" json:"RecordTypes,omitempty"`
+	JetIDs       []github_com_insolar_insolar_insolar.JetID     `protobuf:"bytes,26,rep,name=JetIDs,proto3,customtype=github.com/insolar/insolar/insolar.JetID" json:"JetIDs"`
+}
+
+func (m *GetFilteredRecords) Reset()      { *m = GetFilteredRecords{} }
+func (*GetFilteredRecords) ProtoMessage() {}
+func (*GetFilteredRecords) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dfb4fbd68f50939d, []int{2}
+}
+func (m *GetFilteredRecords) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetFilteredRecords) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetFilteredRecords.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetFilteredRecords) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetFilteredRecords.Merge(m, src)
+}
+func (m *GetFilteredRecords) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetFilteredRecords) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetFilteredRecords.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetFilteredRecords proto.InternalMessageInfo
+
+func (m *GetFilteredRecords) GetPolymorph() uint32 {
+	if m != nil {
+		return m.Polymorph
+	}
+	return 0
+}
+
+func (m *GetFilteredRecords) GetRecordNumber() uint32 {
+	if m != nil {
+		return m.RecordNumber
+	}
+	return 0
+}
+
+func (m *GetFilteredRecords) GetCount() uint32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *GetFilteredRecords) GetResumeToken() []byte {
+	if m != nil {
+		return m.ResumeToken
+	}
+	return nil
+}
+
+func (m *GetFilteredRecords) GetRecordTypes() []string {
+	if m != nil {
+		return m.RecordTypes
+	}
+	return nil
+}
+
+type FilteredRecord struct {
+	Polymorph         uint32                                          `protobuf:"varint,16,opt,name=Polymorph,proto3" json:"Polymorph,omitempty"`
+	RecordNumber      uint32                                          `protobuf:"varint,20,opt,name=RecordNumber,proto3" json:"RecordNumber,omitempty"`
+	Record            *record.Material                                `protobuf:"bytes,21,opt,name=Record,proto3" json:"Record,omitempty"`
+	ShouldIterateFrom *github_com_insolar_insolar_insolar.PulseNumber `protobuf:"bytes,22,opt,name=ShouldIterateFrom,proto3,customtype=github.com/insolar/insolar/insolar.PulseNumber" json:"ShouldIterateFrom,omitempty"`
+	ResumeToken       []byte                                          `protobuf:"bytes,23,opt,name=ResumeToken,proto3" json:"ResumeToken,omitempty"`
+}
+
+func (m *FilteredRecord) Reset()      { *m = FilteredRecord{} }
+func (*FilteredRecord) ProtoMessage() {}
+func (*FilteredRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dfb4fbd68f50939d, []int{3}
+}
+func (m *FilteredRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FilteredRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FilteredRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FilteredRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FilteredRecord.Merge(m, src)
+}
+func (m *FilteredRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *FilteredRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_FilteredRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FilteredRecord proto.InternalMessageInfo
+
+func (m *FilteredRecord) GetPolymorph() uint32 {
+	if m != nil {
+		return m.Polymorph
+	}
+	return 0
+}
+
+func (m *FilteredRecord) GetRecordNumber() uint32 {
+	if m != nil {
+		return m.RecordNumber
+	}
+	return 0
+}
+
+func (m *FilteredRecord) GetRecord() *record.Material {
+	if m != nil {
+		return m.Record
+	}
+	return nil
+}
+
+func (m *FilteredRecord) GetResumeToken() []byte {
+	if m != nil {
+		return m.ResumeToken
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GetRecords)(nil), "exporter.GetRecords")
 	proto.RegisterType((*Record)(nil), "exporter.Record")
+	proto.RegisterType((*GetFilteredRecords)(nil), "exporter.GetFilteredRecords")
+	proto.RegisterType((*FilteredRecord)(nil), "exporter.FilteredRecord")
 }
 
 func init() {
@@ -158,31 +307,41 @@ func init() {
 }
 
 var fileDescriptor_dfb4fbd68f50939d = []byte{
-	// 383 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x52, 0xc1, 0x4e, 0xea, 0x40,
-	0x14, 0x9d, 0x49, 0xde, 0x23, 0x3a, 0xa0, 0xc1, 0xa6, 0x1a, 0x42, 0xcc, 0x40, 0xba, 0x22, 0x31,
-	0xb6, 0x06, 0x09, 0x1f, 0x80, 0x11, 0xe3, 0x42, 0x43, 0xea, 0xc6, 0x9d, 0x69, 0xe9, 0xd8, 0x92,
-	0xb4, 0x0c, 0x19, 0xa6, 0x46, 0x76, 0x7e, 0x82, 0x9f, 0xe1, 0x57, 0xb8, 0x66, 0xc9, 0x92, 0xb8,
-	0x20, 0x30, 0x6c, 0x5c, 0xf2, 0x09, 0xc6, 0x99, 0x56, 0x50, 0x4c, 0x48, 0x5c, 0xcd, 0x9c, 0xd3,
-	0x7b, 0xcf, 0x39, 0xbd, 0x73, 0xd1, 0x51, 0x48, 0x3c, 0x9f, 0x30, 0x2b, 0x20, 0xce, 0xc3, 0xc0,
-	0x22, 0x8f, 0x3d, 0xca, 0x38, 0x61, 0x16, 0x23, 0x6d, 0xca, 0xbc, 0xbb, 0x14, 0x9b, 0x3d, 0x46,
-	0x39, 0xd5, 0xb6, 0x52, 0x5c, 0x3c, 0xf6, 0x3b, 0x3c, 0x88, 0x5d, 0xb3, 0x4d, 0x23, 0xcb, 0xa7,
-	0x3e, 0xb5, 0x64, 0x81, 0x1b, 0xdf, 0x4b, 0x24, 0x81, 0xbc, 0xa9, 0xc6, 0x62, 0x7d, 0xa5, 0xbc,
-	0xd3, 0xed, 0xd3, 0xd0, 0x61, 0x6b, 0xa7, 0xb2, 0x4c, 0x0e, 0xd5, 0x67, 0xbc, 0x42, 0x84, 0x2e,
-	0x08, 0xb7, 0x25, 0xd7, 0xd7, 0x0e, 0xd1, 0x76, 0x8b, 0x86, 0x83, 0x88, 0xb2, 0x5e, 0x50, 0xc8,
-	0x97, 0x61, 0x65, 0xc7, 0x5e, 0x12, 0xda, 0x2d, 0xca, 0xb6, 0xe2, 0xb0, 0x4f, 0xae, 0xe3, 0xc8,
-	0x25, 0xac, 0xa0, 0x97, 0x61, 0x25, 0xd7, 0xa8, 0x0f, 0x27, 0x25, 0xf0, 0x36, 0x29, 0x99, 0x9b,
-	0x13, 0x98, 0x2b, 0xdd, 0xf6, 0xaa, 0x94, 0x66, 0xa0, 0x9c, 0x8a, 0x90, 0x48, 0xef, 0x4b, 0xeb,
-	0x6f, 0x9c, 0xa6, 0xa3, 0xff, 0x67, 0x34, 0xee, 0xf2, 0xc2, 0x81, 0xfc, 0xa8, 0x80, 0x31, 0x85,
-	0x28, 0xa3, 0xca, 0x36, 0x84, 0xff, 0x69, 0xa1, 0xff, 0x62, 0x61, 0xa6, 0x5a, 0x32, 0x40, 0xb6,
-	0x9a, 0x37, 0x93, 0x61, 0x5d, 0x39, 0x9c, 0xb0, 0x8e, 0x13, 0x36, 0xfe, 0x7d, 0xfe, 0xad, 0x9d,
-	0x3a, 0x7a, 0x68, 0xef, 0x26, 0xa0, 0x71, 0xe8, 0x5d, 0x72, 0xc2, 0x1c, 0x4e, 0x9a, 0x8c, 0x46,
-	0x32, 0x9e, 0x1a, 0x0b, 0xfc, 0xc3, 0x58, 0xd6, 0x05, 0xab, 0x4d, 0xb4, 0xab, 0xfc, 0xce, 0x93,
-	0xe5, 0xd0, 0x6a, 0x28, 0xa3, 0xee, 0x9a, 0x6e, 0x7e, 0x6d, 0xd0, 0xf2, 0x19, 0x8b, 0xf9, 0x25,
-	0xab, 0x28, 0x03, 0x9c, 0xc0, 0x46, 0x6d, 0x34, 0xc3, 0x60, 0x3c, 0xc3, 0x60, 0x31, 0xc3, 0xf0,
-	0x49, 0x60, 0xf8, 0x22, 0x30, 0x1c, 0x0a, 0x0c, 0x47, 0x02, 0xc3, 0xa9, 0xc0, 0xf0, 0x5d, 0x60,
-	0xb0, 0x10, 0x18, 0x3e, 0xcf, 0x31, 0x18, 0xcd, 0x31, 0x18, 0xcf, 0x31, 0x70, 0x33, 0x72, 0x51,
-	0x4e, 0x3f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x29, 0x5d, 0xda, 0xd8, 0xc8, 0x02, 0x00, 0x00,
+	// 529 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x54, 0x3f, 0x6f, 0xd3, 0x40,
+	0x14, 0xf7, 0x11, 0x88, 0xc8, 0x25, 0x44, 0xe1, 0x14, 0x8a, 0x89, 0xaa, 0x6b, 0x64, 0x09, 0x29,
+	0x12, 0xaa, 0x5d, 0x95, 0xaa, 0x1f, 0x20, 0x94, 0x96, 0xf0, 0xb7, 0x32, 0x1d, 0xd8, 0x90, 0x1d,
+	0x3f, 0x92, 0x80, 0x9d, 0x8b, 0xce, 0x67, 0x44, 0x36, 0x66, 0x26, 0x06, 0xbe, 0x00, 0x1b, 0x9f,
+	0x82, 0xb9, 0x63, 0xc6, 0x8a, 0xa1, 0x6a, 0x9c, 0x85, 0xb1, 0x1f, 0x01, 0xe5, 0xce, 0xae, 0x9d,
+	0x16, 0x29, 0x15, 0x43, 0x25, 0xa6, 0xbb, 0xf7, 0xbb, 0xfb, 0xbd, 0xdf, 0xcf, 0xef, 0x9d, 0x1f,
+	0x7e, 0xe0, 0x83, 0xd7, 0x03, 0x6e, 0xf5, 0xc1, 0xf9, 0x38, 0xb6, 0xe0, 0xd3, 0x88, 0x71, 0x01,
+	0xdc, 0xe2, 0xd0, 0x65, 0xdc, 0x7b, 0x9b, 0xc6, 0xe6, 0x88, 0x33, 0xc1, 0xc8, 0xcd, 0x34, 0x6e,
+	0xac, 0xf7, 0x06, 0xa2, 0x1f, 0xb9, 0x66, 0x97, 0x05, 0x56, 0x8f, 0xf5, 0x98, 0x25, 0x2f, 0xb8,
+	0xd1, 0x3b, 0x19, 0xc9, 0x40, 0xee, 0x14, 0xb1, 0xb1, 0x9d, 0xbb, 0x3e, 0x18, 0x86, 0xcc, 0x77,
+	0xf8, 0x85, 0x55, 0x49, 0x26, 0x8b, 0xe2, 0x19, 0x3f, 0x11, 0xc6, 0x7b, 0x20, 0x6c, 0x89, 0x85,
+	0x64, 0x15, 0x97, 0xf6, 0x99, 0x3f, 0x0e, 0x18, 0x1f, 0xf5, 0xf5, 0x5a, 0x13, 0xb5, 0x6e, 0xd9,
+	0x19, 0x40, 0xde, 0xe0, 0xf2, 0x7e, 0xe4, 0x87, 0xf0, 0x32, 0x0a, 0x5c, 0xe0, 0x7a, 0xbd, 0x89,
+	0x5a, 0x95, 0xf6, 0xf6, 0xe1, 0xf1, 0x9a, 0xf6, 0xeb, 0x78, 0xcd, 0x5c, 0xee, 0xc0, 0xcc, 0xb1,
+	0xed, 0x7c, 0x2a, 0x62, 0xe0, 0x8a, 0xb2, 0x90, 0xa4, 0xbe, 0x23, 0xa5, 0x17, 0x30, 0x52, 0xc7,
+	0x37, 0x1e, 0xb1, 0x68, 0x28, 0xf4, 0x15, 0x79, 0xa8, 0x02, 0xe3, 0x04, 0xe1, 0xa2, 0xba, 0xb6,
+	0xc4, 0xfc, 0x79, 0x89, 0xfa, 0x5f, 0x24, 0xcc, 0x34, 0x97, 0x34, 0x50, 0xde, 0xac, 0x99, 0x49,
+	0xb1, 0x5e, 0x38, 0x02, 0xf8, 0xc0, 0xf1, 0xdb, 0xd7, 0xe7, 0x5f, 0x6b, 0xa7, 0x8a, 0x1e, 0xbe,
+	0xfd, 0xba, 0xcf, 0x22, 0xdf, 0xeb, 0x08, 0xe0, 0x8e, 0x80, 0x5d, 0xce, 0x02, 0x7d, 0xe5, 0xac,
+	0x2c, 0xe8, 0x1f, 0xca, 0x72, 0x31, 0xa1, 0xf1, 0xbd, 0x80, 0xc9, 0x1e, 0x88, 0xdd, 0x81, 0x2f,
+	0x80, 0x83, 0xf7, 0xdf, 0xf6, 0x8a, 0x34, 0x71, 0xd9, 0x86, 0x30, 0x0a, 0xe0, 0x80, 0x7d, 0x80,
+	0xa1, 0x7e, 0x77, 0xee, 0xc9, 0xce, 0x43, 0xe4, 0x19, 0x2e, 0xbd, 0x72, 0xdf, 0x43, 0x57, 0x74,
+	0x76, 0x42, 0x5d, 0x6f, 0x16, 0x5a, 0x95, 0xf6, 0x7a, 0xe2, 0xf9, 0xfe, 0x25, 0x3c, 0x77, 0x76,
+	0xec, 0x8c, 0xaf, 0xe4, 0xe6, 0xa6, 0x0e, 0xc6, 0x23, 0x08, 0xf5, 0x7b, 0xcd, 0x42, 0xab, 0x64,
+	0xe7, 0x21, 0xf2, 0x04, 0x17, 0x9f, 0x82, 0xd4, 0x6a, 0x48, 0xad, 0x8d, 0x44, 0xab, 0x75, 0x09,
+	0x2d, 0x49, 0xb4, 0x13, 0xbe, 0xf1, 0xe5, 0x1a, 0xae, 0x2e, 0x36, 0xe8, 0xca, 0x9e, 0x23, 0xba,
+	0xda, 0xe7, 0xb8, 0xbc, 0x8b, 0x9b, 0xdf, 0x10, 0xae, 0x2a, 0x4b, 0x8f, 0x93, 0x71, 0x46, 0xb6,
+	0x70, 0x51, 0xed, 0x49, 0xdd, 0x3c, 0x9b, 0x79, 0xd9, 0xe0, 0x69, 0xd4, 0x32, 0x54, 0x41, 0x86,
+	0xb6, 0x81, 0xc8, 0x73, 0x5c, 0x55, 0xac, 0xb4, 0xb4, 0x64, 0x75, 0x81, 0x7d, 0xee, 0x97, 0x68,
+	0xe8, 0xd9, 0xe9, 0xe2, 0xd1, 0x3c, 0x5b, 0x7b, 0x6b, 0x32, 0xa5, 0xda, 0xd1, 0x94, 0x6a, 0xa7,
+	0x53, 0x8a, 0x3e, 0xc7, 0x14, 0xfd, 0x88, 0x29, 0x3a, 0x8c, 0x29, 0x9a, 0xc4, 0x14, 0x9d, 0xc4,
+	0x14, 0xfd, 0x8e, 0xa9, 0x76, 0x1a, 0x53, 0xf4, 0x75, 0x46, 0xb5, 0xc9, 0x8c, 0x6a, 0x47, 0x33,
+	0xaa, 0xb9, 0x45, 0x39, 0x28, 0x1f, 0xfe, 0x19, 0x00, 0x91, 0x44, 0x8d, 0x39, 0xc8, 0x05, 0x00,
+	0x00,
 }
 
 func (this *GetRecords) Equal(that interface{}) bool {
@@ -255,6 +414,106 @@ func (this *Record) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *GetFilteredRecords) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GetFilteredRecords)
+	if !ok {
+		that2, ok := that.(GetFilteredRecords)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Polymorph != that1.Polymorph {
+		return false
+	}
+	if !this.PulseNumber.Equal(that1.PulseNumber) {
+		return false
+	}
+	if this.RecordNumber != that1.RecordNumber {
+		return false
+	}
+	if this.Count != that1.Count {
+		return false
+	}
+	if !bytes.Equal(this.ResumeToken, that1.ResumeToken) {
+		return false
+	}
+	if len(this.ObjectIDs) != len(that1.ObjectIDs) {
+		return false
+	}
+	for i := range this.ObjectIDs {
+		if !this.ObjectIDs[i].Equal(that1.ObjectIDs[i]) {
+			return false
+		}
+	}
+	if len(this.RecordTypes) != len(that1.RecordTypes) {
+		return false
+	}
+	for i := range this.RecordTypes {
+		if this.RecordTypes[i] != that1.RecordTypes[i] {
+			return false
+		}
+	}
+	if len(this.JetIDs) != len(that1.JetIDs) {
+		return false
+	}
+	for i := range this.JetIDs {
+		if !this.JetIDs[i].Equal(that1.JetIDs[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *FilteredRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FilteredRecord)
+	if !ok {
+		that2, ok := that.(FilteredRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Polymorph != that1.Polymorph {
+		return false
+	}
+	if this.RecordNumber != that1.RecordNumber {
+		return false
+	}
+	if !this.Record.Equal(that1.Record) {
+		return false
+	}
+	if that1.ShouldIterateFrom == nil {
+		if this.ShouldIterateFrom != nil {
+			return false
+		}
+	} else if !this.ShouldIterateFrom.Equal(*that1.ShouldIterateFrom) {
+		return false
+	}
+	if !bytes.Equal(this.ResumeToken, that1.ResumeToken) {
+		return false
+	}
+	return true
+}
 func (this *GetRecords) GoString() string {
 	if this == nil {
 		return "nil"
@@ -281,6 +540,39 @@ func (this *Record) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *GetFilteredRecords) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 12)
+	s = append(s, "&exporter.GetFilteredRecords{")
+	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
+	s = append(s, "PulseNumber: "+fmt.Sprintf("%#v", this.PulseNumber)+",\n")
+	s = append(s, "RecordNumber: "+fmt.Sprintf("%#v", this.RecordNumber)+",\n")
+	s = append(s, "Count: "+fmt.Sprintf("%#v", this.Count)+",\n")
+	s = append(s, "ResumeToken: "+fmt.Sprintf("%#v", this.ResumeToken)+",\n")
+	s = append(s, "ObjectIDs: "+fmt.Sprintf("%#v", this.ObjectIDs)+",\n")
+	s = append(s, "RecordTypes: "+fmt.Sprintf("%#v", this.RecordTypes)+",\n")
+	s = append(s, "JetIDs: "+fmt.Sprintf("%#v", this.JetIDs)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *FilteredRecord) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&exporter.FilteredRecord{")
+	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
+	s = append(s, "RecordNumber: "+fmt.Sprintf("%#v", this.RecordNumber)+",\n")
+	if this.Record != nil {
+		s = append(s, "Record: "+fmt.Sprintf("%#v", this.Record)+",\n")
+	}
+	s = append(s, "ShouldIterateFrom: "+fmt.Sprintf("%#v", this.ShouldIterateFrom)+",\n")
+	s = append(s, "ResumeToken: "+fmt.Sprintf("%#v", this.ResumeToken)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func valueToGoStringRecordExporter(v interface{}, typ string) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -303,6 +595,7 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type RecordExporterClient interface {
 	Export(ctx context.Context, in *GetRecords, opts ...grpc.CallOption) (RecordExporter_ExportClient, error)
+	ExportFiltered(ctx context.Context, in *GetFilteredRecords, opts ...grpc.CallOption) (RecordExporter_ExportFilteredClient, error)
 }
 
 type recordExporterClient struct {
@@ -345,9 +638,42 @@ func (x *recordExporterExportClient) Recv() (*Record, error) {
 	return m, nil
 }
 
+func (c *recordExporterClient) ExportFiltered(ctx context.Context, in *GetFilteredRecords, opts ...grpc.CallOption) (RecordExporter_ExportFilteredClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_RecordExporter_serviceDesc.Streams[1], "/exporter.RecordExporter/ExportFiltered", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &recordExporterExportFilteredClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type RecordExporter_ExportFilteredClient interface {
+	Recv() (*FilteredRecord, error)
+	grpc.ClientStream
+}
+
+type recordExporterExportFilteredClient struct {
+	grpc.ClientStream
+}
+
+func (x *recordExporterExportFilteredClient) Recv() (*FilteredRecord, error) {
+	m := new(FilteredRecord)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // RecordExporterServer is the server API for RecordExporter service.
 type RecordExporterServer interface {
 	Export(*GetRecords, RecordExporter_ExportServer) error
+	ExportFiltered(*GetFilteredRecords, RecordExporter_ExportFilteredServer) error
 }
 
 func RegisterRecordExporterServer(s *grpc.Server, srv RecordExporterServer) {
@@ -375,6 +701,27 @@ func (x *recordExporterExportServer) Send(m *Record) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _RecordExporter_ExportFiltered_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetFilteredRecords)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RecordExporterServer).ExportFiltered(m, &recordExporterExportFilteredServer{stream})
+}
+
+type RecordExporter_ExportFilteredServer interface {
+	Send(*FilteredRecord) error
+	grpc.ServerStream
+}
+
+type recordExporterExportFilteredServer struct {
+	grpc.ServerStream
+}
+
+func (x *recordExporterExportFilteredServer) Send(m *FilteredRecord) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _RecordExporter_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "exporter.RecordExporter",
 	HandlerType: (*RecordExporterServer)(nil),
@@ -385,6 +732,11 @@ var _RecordExporter_serviceDesc = grpc.ServiceDesc{
 			Handler:       _RecordExporter_Export_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ExportFiltered",
+			Handler:       _RecordExporter_ExportFiltered_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "ledger/heavy/exporter/record_exporter.proto",
 }
@@ -492,14 +844,180 @@ func (m *Record) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func encodeVarintRecordExporter(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *GetFilteredRecords) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+	return dAtA[:n], nil
+}
+
+func (m *GetFilteredRecords) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Polymorph != 0 {
+		dAtA[i] = 0x80
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintRecordExporter(dAtA, i, uint64(m.Polymorph))
+	}
+	dAtA[i] = 0xa2
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintRecordExporter(dAtA, i, uint64(m.PulseNumber.Size()))
+	n4, err := m.PulseNumber.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n4
+	if m.RecordNumber != 0 {
+		dAtA[i] = 0xa8
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintRecordExporter(dAtA, i, uint64(m.RecordNumber))
+	}
+	if m.Count != 0 {
+		dAtA[i] = 0xb0
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintRecordExporter(dAtA, i, uint64(m.Count))
+	}
+	if len(m.ResumeToken) > 0 {
+		dAtA[i] = 0xba
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintRecordExporter(dAtA, i, uint64(len(m.ResumeToken)))
+		i += copy(dAtA[i:], m.ResumeToken)
+	}
+	if len(m.ObjectIDs) > 0 {
+		for _, msg := range m.ObjectIDs {
+			dAtA[i] = 0xc2
+			i++
+			dAtA[i] = 0x1
+			i++
+			i = encodeVarintRecordExporter(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.RecordTypes) > 0 {
+		for _, s := range m.RecordTypes {
+			dAtA[i] = 0xca
+			i++
+			dAtA[i] = 0x1
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.JetIDs) > 0 {
+		for _, msg := range m.JetIDs {
+			dAtA[i] = 0xd2
+			i++
+			dAtA[i] = 0x1
+			i++
+			i = encodeVarintRecordExporter(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *FilteredRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FilteredRecord) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Polymorph != 0 {
+		dAtA[i] = 0x80
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintRecordExporter(dAtA, i, uint64(m.Polymorph))
+	}
+	if m.RecordNumber != 0 {
+		dAtA[i] = 0xa0
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintRecordExporter(dAtA, i, uint64(m.RecordNumber))
+	}
+	if m.Record != nil {
+		dAtA[i] = 0xaa
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintRecordExporter(dAtA, i, uint64(m.Record.Size()))
+		n5, err := m.Record.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
+	if m.ShouldIterateFrom != nil {
+		dAtA[i] = 0xb2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintRecordExporter(dAtA, i, uint64(m.ShouldIterateFrom.Size()))
+		n6, err := m.ShouldIterateFrom.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n6
+	}
+	if len(m.ResumeToken) > 0 {
+		dAtA[i] = 0xba
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintRecordExporter(dAtA, i, uint64(len(m.ResumeToken)))
+		i += copy(dAtA[i:], m.ResumeToken)
+	}
+	return i, nil
+}
+
+func encodeVarintRecordExporter(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
 }
 func (m *GetRecords) Size() (n int) {
 	if m == nil {
@@ -542,6 +1060,75 @@ func (m *Record) Size() (n int) {
 	return n
 }
 
+func (m *GetFilteredRecords) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Polymorph != 0 {
+		n += 2 + sovRecordExporter(uint64(m.Polymorph))
+	}
+	l = m.PulseNumber.Size()
+	n += 2 + l + sovRecordExporter(uint64(l))
+	if m.RecordNumber != 0 {
+		n += 2 + sovRecordExporter(uint64(m.RecordNumber))
+	}
+	if m.Count != 0 {
+		n += 2 + sovRecordExporter(uint64(m.Count))
+	}
+	l = len(m.ResumeToken)
+	if l > 0 {
+		n += 2 + l + sovRecordExporter(uint64(l))
+	}
+	if len(m.ObjectIDs) > 0 {
+		for _, e := range m.ObjectIDs {
+			l = e.Size()
+			n += 2 + l + sovRecordExporter(uint64(l))
+		}
+	}
+	if len(m.RecordTypes) > 0 {
+		for _, s := range m.RecordTypes {
+			l = len(s)
+			n += 2 + l + sovRecordExporter(uint64(l))
+		}
+	}
+	if len(m.JetIDs) > 0 {
+		for _, e := range m.JetIDs {
+			l = e.Size()
+			n += 2 + l + sovRecordExporter(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FilteredRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Polymorph != 0 {
+		n += 2 + sovRecordExporter(uint64(m.Polymorph))
+	}
+	if m.RecordNumber != 0 {
+		n += 2 + sovRecordExporter(uint64(m.RecordNumber))
+	}
+	if m.Record != nil {
+		l = m.Record.Size()
+		n += 2 + l + sovRecordExporter(uint64(l))
+	}
+	if m.ShouldIterateFrom != nil {
+		l = m.ShouldIterateFrom.Size()
+		n += 2 + l + sovRecordExporter(uint64(l))
+	}
+	l = len(m.ResumeToken)
+	if l > 0 {
+		n += 2 + l + sovRecordExporter(uint64(l))
+	}
+	return n
+}
+
 func sovRecordExporter(x uint64) (n int) {
 	for {
 		n++
@@ -581,6 +1168,37 @@ func (this *Record) String() string {
 	}, "")
 	return s
 }
+func (this *GetFilteredRecords) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetFilteredRecords{`,
+		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
+		`PulseNumber:` + fmt.Sprintf("%v", this.PulseNumber) + `,`,
+		`RecordNumber:` + fmt.Sprintf("%v", this.RecordNumber) + `,`,
+		`Count:` + fmt.Sprintf("%v", this.Count) + `,`,
+		`ResumeToken:` + fmt.Sprintf("%v", this.ResumeToken) + `,`,
+		`ObjectIDs:` + fmt.Sprintf("%v", this.ObjectIDs) + `,`,
+		`RecordTypes:` + fmt.Sprintf("%v", this.RecordTypes) + `,`,
+		`JetIDs:` + fmt.Sprintf("%v", this.JetIDs) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *FilteredRecord) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&FilteredRecord{`,
+		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
+		`RecordNumber:` + fmt.Sprintf("%v", this.RecordNumber) + `,`,
+		`Record:` + strings.Replace(fmt.Sprintf("%v", this.Record), "Material", "record.Material", 1) + `,`,
+		`ShouldIterateFrom:` + fmt.Sprintf("%v", this.ShouldIterateFrom) + `,`,
+		`ResumeToken:` + fmt.Sprintf("%v", this.ResumeToken) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringRecordExporter(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -891,6 +1509,481 @@ func (m *Record) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *GetFilteredRecords) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRecordExporter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetFilteredRecords: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetFilteredRecords: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Polymorph", wireType)
+			}
+			m.Polymorph = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecordExporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Polymorph |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PulseNumber", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecordExporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRecordExporter
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecordExporter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PulseNumber.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordNumber", wireType)
+			}
+			m.RecordNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecordExporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecordNumber |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecordExporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResumeToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecordExporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRecordExporter
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecordExporter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResumeToken = append(m.ResumeToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.ResumeToken == nil {
+				m.ResumeToken = []byte{}
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectIDs", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecordExporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRecordExporter
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecordExporter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_insolar_insolar_insolar.ID
+			m.ObjectIDs = append(m.ObjectIDs, v)
+			if err := m.ObjectIDs[len(m.ObjectIDs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecordExporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecordExporter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecordExporter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordTypes = append(m.RecordTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JetIDs", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecordExporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRecordExporter
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecordExporter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_insolar_insolar_insolar.JetID
+			m.JetIDs = append(m.JetIDs, v)
+			if err := m.JetIDs[len(m.JetIDs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRecordExporter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRecordExporter
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRecordExporter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FilteredRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRecordExporter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FilteredRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FilteredRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Polymorph", wireType)
+			}
+			m.Polymorph = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecordExporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Polymorph |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordNumber", wireType)
+			}
+			m.RecordNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecordExporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecordNumber |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecordExporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRecordExporter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecordExporter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Record == nil {
+				m.Record = &record.Material{}
+			}
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShouldIterateFrom", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecordExporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRecordExporter
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecordExporter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_insolar_insolar_insolar.PulseNumber
+			m.ShouldIterateFrom = &v
+			if err := m.ShouldIterateFrom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResumeToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecordExporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRecordExporter
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecordExporter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResumeToken = append(m.ResumeToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.ResumeToken == nil {
+				m.ResumeToken = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRecordExporter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRecordExporter
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRecordExporter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipRecordExporter(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -28,6 +28,8 @@ type RecordServer struct {
 	recordAccessor  object.RecordAccessor
 	jetKeeper       executor.JetKeeper
 	authCfg         configuration.Auth
+
+	scanLimit uint32
 }
 
 func NewRecordServer(
@@ -43,6 +45,7 @@ func NewRecordServer(
 		recordAccessor:  recordAccessor,
 		jetKeeper:       jetKeeper,
 		authCfg:         authCfg,
+		scanLimit:       filteredExportScanLimit,
 	}
 }
 
@@ -116,6 +119,116 @@ func (r *RecordServer) Export(getRecords *GetRecords, stream RecordExporter_Expo
 	return nil
 }
 
+// filteredExportScanLimit limits the number of records checked by the filter during a single call.
+// When the limit is reached, the stream is finished with a resume token and without ShouldIterateFrom,
+// so the client can continue right away.
+const filteredExportScanLimit = 100000
+
+// ExportFiltered streams records satisfying the filter from the request.
+// Every sent record carries a resume token. The token of the last received message can be passed
+// in a new request with the same filter to continue the export right after it.
+func (r *RecordServer) ExportFiltered(req *GetFilteredRecords, stream RecordExporter_ExportFilteredServer) error {
+	ctx := stream.Context()
+	read := 0
+	exportStart := time.Now()
+	logger := inslogger.FromContext(ctx)
+	logger.Info("Incoming request: ", req.String())
+
+	defer func(ctx context.Context) {
+		stats.Record(
+			addTagsForExporterMethodTiming(r.authCfg.Required, ctx, "record-export-filtered"),
+			HeavyExporterMethodTiming.M(float64(time.Since(exportStart).Nanoseconds())/1e6),
+		)
+		logger.Infof("exported %d filtered record", read)
+	}(ctx)
+
+	if req.Count == 0 {
+		return ErrNilCount
+	}
+
+	filter, err := newRecordFilter(req)
+	if err != nil {
+		return err
+	}
+
+	startPulse, startPosition := req.PulseNumber, req.RecordNumber
+	if len(req.ResumeToken) > 0 {
+		token, err := parseResumeToken(req.ResumeToken)
+		if err != nil {
+			return err
+		}
+		if token.checksum != filter.checksum {
+			return ErrResumeTokenMismatch
+		}
+		startPulse, startPosition = token.pulse, token.position
+	}
+
+	if startPulse != 0 {
+		topPulse := r.jetKeeper.TopSyncPulse()
+		if topPulse < startPulse {
+			return ErrNotFinalPulseData
+		}
+	} else {
+		startPulse = pulse.MinTimePulse
+	}
+
+	iter := newRecordIterator(
+		startPulse,
+		startPosition,
+		r.scanLimit,
+		r.recordIndex,
+		r.recordAccessor,
+		r.jetKeeper,
+		r.pulseCalculator,
+	)
+
+	last := resumeToken{pulse: startPulse, position: startPosition, checksum: filter.checksum}
+	for uint32(read) < req.Count && iter.HasNext(ctx) {
+		rec, err := iter.Next(ctx)
+		if err != nil {
+			logger.Error(err)
+			return err
+		}
+		last.pulse, last.position = iter.currentPulse, iter.currentPosition
+
+		if !filter.Match(&rec.Record) {
+			continue
+		}
+
+		err = stream.Send(&FilteredRecord{
+			RecordNumber: rec.RecordNumber,
+			Record:       &rec.Record,
+			ResumeToken:  last.Bytes(),
+		})
+		if err != nil {
+			if ctx.Err() != context.Canceled {
+				logger.Error(err)
+			}
+
+			return err
+		}
+		read++
+	}
+
+	if uint32(read) < req.Count {
+		// Nothing more to send in this call. Returning the position of the last checked record,
+		// so the client doesn't have to filter the same records again. If the scan limit is reached,
+		// there are unchecked records left, so the client shouldn't wait for the next pulse.
+		end := &FilteredRecord{ResumeToken: last.Bytes()}
+		if !iter.LimitReached() {
+			topPulse := r.jetKeeper.TopSyncPulse()
+			end.ShouldIterateFrom = &topPulse
+		}
+		err := stream.Send(end)
+		if err != nil {
+			logger.Error(err)
+			return err
+		}
+	}
+
+	return nil
+}
+
 type recordIterator struct {
 	currentPosition uint32
 	currentPulse    insolar.PulseNumber
@@ -166,6 +279,12 @@ func (r *recordIterator) HasNext(ctx context.Context) bool {
 	return true
 }
 
+// LimitReached returns true if the iterator has stopped because of takeCount,
+// not because there are no more synced records.
+func (r *recordIterator) LimitReached() bool {
+	return r.read >= r.needToRead
+}
+
 func (r *recordIterator) checkNextPulse(ctx context.Context) bool {
 	currentPulse := r.currentPulse
 
@@ -0,0 +1,195 @@
+// Copyright 2020 Insolar Network Ltd.
+// All rights reserved.
+// This material is licensed under the Insolar License version 1.0,
+// available at https://github.com/insolar/insolar/blob/master/LICENSE.md.
+
+package exporter
+
+import (
+	"encoding/binary"
+	"hash/crc32"
+	"sort"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/jet"
+	"github.com/insolar/insolar/insolar/record"
+)
+
+const (
+	resumeTokenVersion = 1
+	resumeTokenSize    = 1 + 4 + 4 + 4
+)
+
+// RecordTypeName returns a name of the concrete record wrapped into the virtual record.
+// The names are the same as the names of the union fields of record.Virtual.
+func RecordTypeName(v *record.Virtual) string {
+	switch v.Union.(type) {
+	case *record.Virtual_Genesis:
+		return "Genesis"
+	case *record.Virtual_IncomingRequest:
+		return "IncomingRequest"
+	case *record.Virtual_OutgoingRequest:
+		return "OutgoingRequest"
+	case *record.Virtual_Result:
+		return "Result"
+	case *record.Virtual_Code:
+		return "Code"
+	case *record.Virtual_Activate:
+		return "Activate"
+	case *record.Virtual_Amend:
+		return "Amend"
+	case *record.Virtual_Deactivate:
+		return "Deactivate"
+	case *record.Virtual_PendingFilament:
+		return "PendingFilament"
+	case *record.Virtual_Event:
+		return "Event"
+	default:
+		return ""
+	}
+}
+
+var knownRecordTypes = map[string]struct{}{
+	"Genesis":         {},
+	"IncomingRequest": {},
+	"OutgoingRequest": {},
+	"Result":          {},
+	"Code":            {},
+	"Activate":        {},
+	"Amend":           {},
+	"Deactivate":      {},
+	"PendingFilament": {},
+	"Event":           {},
+}
+
+// recordFilter selects records for the filtered export. Empty criteria match any record.
+type recordFilter struct {
+	objects map[insolar.ID]struct{}
+	types   map[string]struct{}
+	jets    []insolar.JetID
+
+	checksum uint32
+}
+
+func newRecordFilter(req *GetFilteredRecords) (*recordFilter, error) {
+	f := &recordFilter{}
+
+	if len(req.ObjectIDs) > 0 {
+		f.objects = make(map[insolar.ID]struct{}, len(req.ObjectIDs))
+		for _, id := range req.ObjectIDs {
+			f.objects[id] = struct{}{}
+		}
+	}
+
+	if len(req.RecordTypes) > 0 {
+		f.types = make(map[string]struct{}, len(req.RecordTypes))
+		for _, t := range req.RecordTypes {
+			if _, ok := knownRecordTypes[t]; !ok {
+				return nil, ErrUnknownRecordType
+			}
+			f.types[t] = struct{}{}
+		}
+	}
+
+	f.jets = append(f.jets, req.JetIDs...)
+	f.checksum = filterChecksum(req)
+
+	return f, nil
+}
+
+// Match checks if the record satisfies all the criteria of the filter.
+func (f *recordFilter) Match(rec *record.Material) bool {
+	if f.objects != nil {
+		if _, ok := f.objects[rec.ObjectID]; !ok {
+			return false
+		}
+	}
+
+	if f.types != nil {
+		if _, ok := f.types[RecordTypeName(&rec.Virtual)]; !ok {
+			return false
+		}
+	}
+
+	if len(f.jets) > 0 {
+		matched := false
+		for _, jetID := range f.jets {
+			if isJetOrDescendant(rec.JetID, jetID) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+
+	return true
+}
+
+// isJetOrDescendant checks if the jet is the ancestor jet itself or one of the jets it was split into.
+func isJetOrDescendant(id, ancestor insolar.JetID) bool {
+	for {
+		if id.Equal(ancestor) {
+			return true
+		}
+		if id.Depth() <= ancestor.Depth() {
+			return false
+		}
+		id = jet.Parent(id)
+	}
+}
+
+// filterChecksum calculates order-independent checksum of the filter criteria.
+// It's stored in a resume token to protect from resuming with another filter.
+func filterChecksum(req *GetFilteredRecords) uint32 {
+	var parts []string
+	for _, id := range req.ObjectIDs {
+		parts = append(parts, "o"+string(id.Bytes()))
+	}
+	for _, t := range req.RecordTypes {
+		parts = append(parts, "t"+t)
+	}
+	for _, jetID := range req.JetIDs {
+		parts = append(parts, "j"+string(insolar.ID(jetID).Bytes()))
+	}
+	sort.Strings(parts)
+
+	h := crc32.NewIEEE()
+	for _, p := range parts {
+		_, _ = h.Write([]byte(p))
+		_, _ = h.Write([]byte{0})
+	}
+	return h.Sum32()
+}
+
+// resumeToken is a position of the last record checked by the filtered export.
+type resumeToken struct {
+	pulse    insolar.PulseNumber
+	position uint32
+	checksum uint32
+}
+
+func (t resumeToken) Bytes() []byte {
+	buf := make([]byte, resumeTokenSize)
+	buf[0] = resumeTokenVersion
+	binary.BigEndian.PutUint32(buf[1:], uint32(t.pulse))
+	binary.BigEndian.PutUint32(buf[5:], t.position)
+	binary.BigEndian.PutUint32(buf[9:], t.checksum)
+	return buf
+}
+
+func parseResumeToken(buf []byte) (resumeToken, error) {
+	if len(buf) != resumeTokenSize || buf[0] != resumeTokenVersion {
+		return resumeToken{}, ErrInvalidResumeToken
+	}
+	t := resumeToken{
+		pulse:    insolar.PulseNumber(binary.BigEndian.Uint32(buf[1:])),
+		position: binary.BigEndian.Uint32(buf[5:]),
+		checksum: binary.BigEndian.Uint32(buf[9:]),
+	}
+	if !t.pulse.IsTimePulse() {
+		return resumeToken{}, ErrInvalidResumeToken
+	}
+	return t, nil
+}
@@ -0,0 +1,120 @@
+// Copyright 2020 Insolar Network Ltd.
+// All rights reserved.
+// This material is licensed under the Insolar License version 1.0,
+// available at https://github.com/insolar/insolar/blob/master/LICENSE.md.
+
+package exporter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/insolar/jet"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/pulse"
+)
+
+func TestRecordFilter_Match(t *testing.T) {
+	objectID := gen.ID()
+	rec := record.Material{
+		Virtual:  record.Wrap(&record.Amend{}),
+		ObjectID: objectID,
+		JetID:    jet.NewIDFromString("101"),
+	}
+
+	t.Run("empty filter matches everything", func(t *testing.T) {
+		f, err := newRecordFilter(&GetFilteredRecords{})
+		require.NoError(t, err)
+		require.True(t, f.Match(&rec))
+	})
+
+	t.Run("object", func(t *testing.T) {
+		f, err := newRecordFilter(&GetFilteredRecords{ObjectIDs: []insolar.ID{gen.ID(), objectID}})
+		require.NoError(t, err)
+		require.True(t, f.Match(&rec))
+
+		f, err = newRecordFilter(&GetFilteredRecords{ObjectIDs: []insolar.ID{gen.ID()}})
+		require.NoError(t, err)
+		require.False(t, f.Match(&rec))
+	})
+
+	t.Run("record type", func(t *testing.T) {
+		f, err := newRecordFilter(&GetFilteredRecords{RecordTypes: []string{"Activate", "Amend"}})
+		require.NoError(t, err)
+		require.True(t, f.Match(&rec))
+
+		f, err = newRecordFilter(&GetFilteredRecords{RecordTypes: []string{"Deactivate"}})
+		require.NoError(t, err)
+		require.False(t, f.Match(&rec))
+	})
+
+	t.Run("unknown record type", func(t *testing.T) {
+		_, err := newRecordFilter(&GetFilteredRecords{RecordTypes: []string{"Unknown"}})
+		require.Equal(t, ErrUnknownRecordType, err)
+	})
+
+	t.Run("jet", func(t *testing.T) {
+		f, err := newRecordFilter(&GetFilteredRecords{JetIDs: []insolar.JetID{jet.NewIDFromString("101")}})
+		require.NoError(t, err)
+		require.True(t, f.Match(&rec))
+
+		f, err = newRecordFilter(&GetFilteredRecords{JetIDs: []insolar.JetID{jet.NewIDFromString("10")}})
+		require.NoError(t, err)
+		require.True(t, f.Match(&rec), "parent jet should match records of the splitted jets")
+
+		f, err = newRecordFilter(&GetFilteredRecords{JetIDs: []insolar.JetID{jet.NewIDFromString("11")}})
+		require.NoError(t, err)
+		require.False(t, f.Match(&rec))
+
+		f, err = newRecordFilter(&GetFilteredRecords{JetIDs: []insolar.JetID{jet.NewIDFromString("1010")}})
+		require.NoError(t, err)
+		require.False(t, f.Match(&rec))
+	})
+
+	t.Run("all criteria", func(t *testing.T) {
+		f, err := newRecordFilter(&GetFilteredRecords{
+			ObjectIDs:   []insolar.ID{objectID},
+			RecordTypes: []string{"Activate"},
+			JetIDs:      []insolar.JetID{jet.NewIDFromString("1")},
+		})
+		require.NoError(t, err)
+		require.False(t, f.Match(&rec))
+	})
+}
+
+func TestFilterChecksum(t *testing.T) {
+	first, second := gen.ID(), gen.ID()
+
+	a := filterChecksum(&GetFilteredRecords{ObjectIDs: []insolar.ID{first, second}, RecordTypes: []string{"Amend"}})
+	b := filterChecksum(&GetFilteredRecords{ObjectIDs: []insolar.ID{second, first}, RecordTypes: []string{"Amend"}})
+	c := filterChecksum(&GetFilteredRecords{ObjectIDs: []insolar.ID{first, second}})
+
+	require.Equal(t, a, b)
+	require.NotEqual(t, a, c)
+}
+
+func TestResumeToken(t *testing.T) {
+	t.Run("roundtrip", func(t *testing.T) {
+		token := resumeToken{pulse: pulse.MinTimePulse + 10, position: 42, checksum: 7}
+
+		parsed, err := parseResumeToken(token.Bytes())
+		require.NoError(t, err)
+		require.Equal(t, token, parsed)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := parseResumeToken([]byte{1, 2, 3})
+		require.Equal(t, ErrInvalidResumeToken, err)
+
+		buf := resumeToken{pulse: pulse.MinTimePulse}.Bytes()
+		buf[0] = 0
+		_, err = parseResumeToken(buf)
+		require.Equal(t, ErrInvalidResumeToken, err)
+
+		_, err = parseResumeToken(resumeToken{pulse: 1}.Bytes())
+		require.Equal(t, ErrInvalidResumeToken, err)
+	})
+}
@@ -0,0 +1,1185 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ledger/heavy/exporter/replication_exporter.proto
+
+package exporter
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_insolar_insolar_insolar "github.com/insolar/insolar/insolar"
+	insolar "github.com/insolar/insolar/insolar"
+	pulse "github.com/insolar/insolar/insolar/pulse"
+	record "github.com/insolar/insolar/insolar/record"
+	drop "github.com/insolar/insolar/ledger/drop"
+	grpc "google.golang.org/grpc"
+	io "io"
+	math "math"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+type GetReplication struct {
+	Polymorph   uint32                                         `protobuf:"varint,16,opt,name=Polymorph,proto3" json:"Polymorph,omitempty"`
+	PulseNumber github_com_insolar_insolar_insolar.PulseNumber `protobuf:"bytes,20,opt,name=PulseNumber,proto3,customtype=github.com/insolar/insolar/insolar.PulseNumber" json:"PulseNumber"`
+}
+
+func (m *GetReplication) Reset()      { *m = GetReplication{} }
+func (*GetReplication) ProtoMessage() {}
+func (*GetReplication) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98dd75fe5a4be890, []int{0}
+}
+func (m *GetReplication) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetReplication) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetReplication.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetReplication) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetReplication.Merge(m, src)
+}
+func (m *GetReplication) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetReplication) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetReplication.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetReplication proto.InternalMessageInfo
+
+func (m *GetReplication) GetPolymorph() uint32 {
+	if m != nil {
+		return m.Polymorph
+	}
+	return 0
+}
+
+type ReplicatedPulse struct {
+	Polymorph uint32            `protobuf:"varint,16,opt,name=Polymorph,proto3" json:"Polymorph,omitempty"`
+	Pulse     pulse.PulseProto  `protobuf:"bytes,20,opt,name=Pulse,proto3" json:"Pulse"`
+	Nodes     []insolar.Node    `protobuf:"bytes,21,rep,name=Nodes,proto3" json:"Nodes"`
+	Drops     []drop.Drop       `protobuf:"bytes,22,rep,name=Drops,proto3" json:"Drops"`
+	Records   []record.Material `protobuf:"bytes,23,rep,name=Records,proto3" json:"Records"`
+	Indexes   []record.Index    `protobuf:"bytes,24,rep,name=Indexes,proto3" json:"Indexes"`
+	Complete  bool              `protobuf:"varint,25,opt,name=Complete,proto3" json:"Complete,omitempty"`
+}
+
+func (m *ReplicatedPulse) Reset()      { *m = ReplicatedPulse{} }
+func (*ReplicatedPulse) ProtoMessage() {}
+func (*ReplicatedPulse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98dd75fe5a4be890, []int{1}
+}
+func (m *ReplicatedPulse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReplicatedPulse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReplicatedPulse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReplicatedPulse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplicatedPulse.Merge(m, src)
+}
+func (m *ReplicatedPulse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReplicatedPulse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplicatedPulse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplicatedPulse proto.InternalMessageInfo
+
+func (m *ReplicatedPulse) GetPolymorph() uint32 {
+	if m != nil {
+		return m.Polymorph
+	}
+	return 0
+}
+
+func (m *ReplicatedPulse) GetPulse() pulse.PulseProto {
+	if m != nil {
+		return m.Pulse
+	}
+	return pulse.PulseProto{}
+}
+
+func (m *ReplicatedPulse) GetNodes() []insolar.Node {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+func (m *ReplicatedPulse) GetDrops() []drop.Drop {
+	if m != nil {
+		return m.Drops
+	}
+	return nil
+}
+
+func (m *ReplicatedPulse) GetRecords() []record.Material {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+func (m *ReplicatedPulse) GetIndexes() []record.Index {
+	if m != nil {
+		return m.Indexes
+	}
+	return nil
+}
+
+func (m *ReplicatedPulse) GetComplete() bool {
+	if m != nil {
+		return m.Complete
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*GetReplication)(nil), "exporter.GetReplication")
+	proto.RegisterType((*ReplicatedPulse)(nil), "exporter.ReplicatedPulse")
+}
+
+func init() {
+	proto.RegisterFile("ledger/heavy/exporter/replication_exporter.proto", fileDescriptor_98dd75fe5a4be890)
+}
+
+var fileDescriptor_98dd75fe5a4be890 = []byte{
+	// 468 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xbf, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0x7d, 0x40, 0xda, 0x70, 0xa1, 0x50, 0x8e, 0x5f, 0xd7, 0x08, 0x5d, 0xa3, 0x0c, 0x28,
+	0x0c, 0x39, 0x87, 0x50, 0x75, 0x27, 0x80, 0x10, 0x03, 0x55, 0xe4, 0xa9, 0x1b, 0x72, 0xe2, 0x47,
+	0x62, 0xc9, 0xc9, 0xb3, 0xce, 0x0e, 0x6a, 0x37, 0x46, 0x46, 0xfe, 0x0c, 0x26, 0xfe, 0x8e, 0x8e,
+	0x19, 0x2b, 0x86, 0x8a, 0x38, 0x0b, 0x63, 0xff, 0x04, 0xe4, 0xe7, 0x73, 0x93, 0x82, 0x44, 0xbb,
+	0xdc, 0xdd, 0xfb, 0xde, 0xe7, 0x6b, 0x7d, 0xfd, 0xde, 0xf1, 0x4e, 0x04, 0xc1, 0x08, 0x8c, 0x3b,
+	0x06, 0xff, 0xf3, 0xb1, 0x0b, 0x47, 0x31, 0x9a, 0x14, 0x8c, 0x6b, 0x20, 0x8e, 0xc2, 0xa1, 0x9f,
+	0x86, 0x38, 0xfd, 0x58, 0x8a, 0x3a, 0x36, 0x98, 0xa2, 0xa8, 0x96, 0x75, 0xbd, 0x3d, 0x0a, 0xd3,
+	0xf1, 0x6c, 0xa0, 0x87, 0x38, 0x71, 0x47, 0x38, 0x42, 0x97, 0x80, 0xc1, 0xec, 0x13, 0x55, 0x54,
+	0xd0, 0xa9, 0x30, 0x5e, 0xc2, 0xc3, 0x69, 0x82, 0x91, 0x6f, 0xfe, 0xd9, 0xa7, 0x18, 0x80, 0xc5,
+	0xf7, 0xae, 0x81, 0xc7, 0xb3, 0x28, 0x81, 0x62, 0xb5, 0xae, 0xfd, 0x6b, 0xb8, 0x0c, 0x0c, 0xd1,
+	0x04, 0x76, 0xb3, 0xbe, 0x17, 0xff, 0xf1, 0xd9, 0x16, 0x05, 0x06, 0x63, 0x5a, 0x0a, 0x4b, 0xf3,
+	0x2b, 0xe3, 0x77, 0xdf, 0x41, 0xea, 0xad, 0x5a, 0x25, 0x9e, 0xf2, 0xdb, 0x7d, 0x8c, 0x8e, 0x27,
+	0x68, 0xe2, 0xb1, 0xdc, 0x6e, 0xb0, 0xd6, 0x96, 0xb7, 0x12, 0xc4, 0x21, 0xaf, 0xf5, 0xf3, 0xa8,
+	0x07, 0xb3, 0xc9, 0x00, 0x8c, 0x7c, 0xd8, 0x60, 0xad, 0x3b, 0xbd, 0xfd, 0x93, 0xb3, 0x5d, 0xe7,
+	0xe7, 0xd9, 0xae, 0xbe, 0x3a, 0xb8, 0x5e, 0x73, 0x7b, 0xeb, 0x9f, 0x6a, 0xfe, 0xb8, 0xc1, 0xef,
+	0x95, 0x39, 0x20, 0xa0, 0x9b, 0x2b, 0xb2, 0xb4, 0x79, 0x85, 0x30, 0x4a, 0x51, 0xeb, 0xde, 0xd7,
+	0x45, 0x13, 0x49, 0xeb, 0xe7, 0xbf, 0xd7, 0xbb, 0x95, 0x07, 0xf3, 0x0a, 0x4a, 0x3c, 0xe7, 0x95,
+	0x03, 0x0c, 0x20, 0x91, 0x8f, 0x1a, 0x37, 0x5b, 0xb5, 0xee, 0x96, 0x2e, 0x23, 0xe5, 0x6a, 0x89,
+	0x12, 0x21, 0x9e, 0xf1, 0xca, 0x1b, 0x83, 0x71, 0x22, 0x1f, 0x13, 0xca, 0x35, 0xb5, 0x2c, 0x97,
+	0x4a, 0x8e, 0xae, 0x45, 0x87, 0x6f, 0x7a, 0x34, 0x81, 0x44, 0x3e, 0x21, 0x72, 0x5b, 0xdb, 0x89,
+	0x7c, 0xf0, 0x53, 0x30, 0xa1, 0x1f, 0x59, 0xbe, 0xc4, 0x44, 0x9b, 0x6f, 0xbe, 0x9f, 0x06, 0x70,
+	0x04, 0x89, 0x94, 0x36, 0x86, 0x75, 0x90, 0x5c, 0xe2, 0x96, 0x11, 0x75, 0x5e, 0x7d, 0x8d, 0x93,
+	0x38, 0x82, 0x14, 0xe4, 0x4e, 0x83, 0xb5, 0xaa, 0xde, 0x45, 0xdd, 0x3d, 0xe4, 0x0f, 0xd6, 0xe6,
+	0xf6, 0xd6, 0xbe, 0x68, 0xf1, 0x8a, 0x6f, 0x14, 0x67, 0x21, 0xf5, 0xc5, 0xb3, 0xbf, 0x3c, 0xe3,
+	0xfa, 0xce, 0xea, 0xe6, 0xaf, 0x96, 0x37, 0x9d, 0x0e, 0xeb, 0xed, 0xcd, 0x17, 0xca, 0x39, 0x5d,
+	0x28, 0xe7, 0x7c, 0xa1, 0xd8, 0x97, 0x4c, 0xb1, 0xef, 0x99, 0x62, 0x27, 0x99, 0x62, 0xf3, 0x4c,
+	0xb1, 0x5f, 0x99, 0x62, 0xbf, 0x33, 0xe5, 0x9c, 0x67, 0x8a, 0x7d, 0x5b, 0x2a, 0x67, 0xbe, 0x54,
+	0xce, 0xe9, 0x52, 0x39, 0x83, 0x0d, 0x7a, 0x52, 0x2f, 0xff, 0x0c, 0x00, 0x2a, 0x77, 0x58, 0x06,
+	0x8f, 0x03, 0x00, 0x00,
+}
+
+func (this *GetReplication) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GetReplication)
+	if !ok {
+		that2, ok := that.(GetReplication)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Polymorph != that1.Polymorph {
+		return false
+	}
+	if !this.PulseNumber.Equal(that1.PulseNumber) {
+		return false
+	}
+	return true
+}
+func (this *ReplicatedPulse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ReplicatedPulse)
+	if !ok {
+		that2, ok := that.(ReplicatedPulse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Polymorph != that1.Polymorph {
+		return false
+	}
+	if !this.Pulse.Equal(&that1.Pulse) {
+		return false
+	}
+	if len(this.Nodes) != len(that1.Nodes) {
+		return false
+	}
+	for i := range this.Nodes {
+		if !this.Nodes[i].Equal(&that1.Nodes[i]) {
+			return false
+		}
+	}
+	if len(this.Drops) != len(that1.Drops) {
+		return false
+	}
+	for i := range this.Drops {
+		if !this.Drops[i].Equal(&that1.Drops[i]) {
+			return false
+		}
+	}
+	if len(this.Records) != len(that1.Records) {
+		return false
+	}
+	for i := range this.Records {
+		if !this.Records[i].Equal(&that1.Records[i]) {
+			return false
+		}
+	}
+	if len(this.Indexes) != len(that1.Indexes) {
+		return false
+	}
+	for i := range this.Indexes {
+		if !this.Indexes[i].Equal(&that1.Indexes[i]) {
+			return false
+		}
+	}
+	if this.Complete != that1.Complete {
+		return false
+	}
+	return true
+}
+func (this *GetReplication) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&exporter.GetReplication{")
+	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
+	s = append(s, "PulseNumber: "+fmt.Sprintf("%#v", this.PulseNumber)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ReplicatedPulse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 11)
+	s = append(s, "&exporter.ReplicatedPulse{")
+	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
+	s = append(s, "Pulse: "+strings.Replace(this.Pulse.GoString(), `&`, ``, 1)+",\n")
+	if this.Nodes != nil {
+		vs := make([]*insolar.Node, len(this.Nodes))
+		for i := range vs {
+			vs[i] = &this.Nodes[i]
+		}
+		s = append(s, "Nodes: "+fmt.Sprintf("%#v", vs)+",\n")
+	}
+	if this.Drops != nil {
+		vs := make([]*drop.Drop, len(this.Drops))
+		for i := range vs {
+			vs[i] = &this.Drops[i]
+		}
+		s = append(s, "Drops: "+fmt.Sprintf("%#v", vs)+",\n")
+	}
+	if this.Records != nil {
+		vs := make([]*record.Material, len(this.Records))
+		for i := range vs {
+			vs[i] = &this.Records[i]
+		}
+		s = append(s, "Records: "+fmt.Sprintf("%#v", vs)+",\n")
+	}
+	if this.Indexes != nil {
+		vs := make([]*record.Index, len(this.Indexes))
+		for i := range vs {
+			vs[i] = &this.Indexes[i]
+		}
+		s = append(s, "Indexes: "+fmt.Sprintf("%#v", vs)+",\n")
+	}
+	s = append(s, "Complete: "+fmt.Sprintf("%#v", this.Complete)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringReplicationExporter(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ReplicationExporterClient is the client API for ReplicationExporter service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ReplicationExporterClient interface {
+	Export(ctx context.Context, in *GetReplication, opts ...grpc.CallOption) (ReplicationExporter_ExportClient, error)
+}
+
+type replicationExporterClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewReplicationExporterClient(cc *grpc.ClientConn) ReplicationExporterClient {
+	return &replicationExporterClient{cc}
+}
+
+func (c *replicationExporterClient) Export(ctx context.Context, in *GetReplication, opts ...grpc.CallOption) (ReplicationExporter_ExportClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ReplicationExporter_serviceDesc.Streams[0], "/exporter.ReplicationExporter/Export", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &replicationExporterExportClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ReplicationExporter_ExportClient interface {
+	Recv() (*ReplicatedPulse, error)
+	grpc.ClientStream
+}
+
+type replicationExporterExportClient struct {
+	grpc.ClientStream
+}
+
+func (x *replicationExporterExportClient) Recv() (*ReplicatedPulse, error) {
+	m := new(ReplicatedPulse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// ReplicationExporterServer is the server API for ReplicationExporter service.
+type ReplicationExporterServer interface {
+	Export(*GetReplication, ReplicationExporter_ExportServer) error
+}
+
+func RegisterReplicationExporterServer(s *grpc.Server, srv ReplicationExporterServer) {
+	s.RegisterService(&_ReplicationExporter_serviceDesc, srv)
+}
+
+func _ReplicationExporter_Export_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetReplication)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ReplicationExporterServer).Export(m, &replicationExporterExportServer{stream})
+}
+
+type ReplicationExporter_ExportServer interface {
+	Send(*ReplicatedPulse) error
+	grpc.ServerStream
+}
+
+type replicationExporterExportServer struct {
+	grpc.ServerStream
+}
+
+func (x *replicationExporterExportServer) Send(m *ReplicatedPulse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _ReplicationExporter_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "exporter.ReplicationExporter",
+	HandlerType: (*ReplicationExporterServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Export",
+			Handler:       _ReplicationExporter_Export_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "ledger/heavy/exporter/replication_exporter.proto",
+}
+
+func (m *GetReplication) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetReplication) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Polymorph != 0 {
+		dAtA[i] = 0x80
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintReplicationExporter(dAtA, i, uint64(m.Polymorph))
+	}
+	dAtA[i] = 0xa2
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintReplicationExporter(dAtA, i, uint64(m.PulseNumber.Size()))
+	n1, err := m.PulseNumber.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n1
+	return i, nil
+}
+
+func (m *ReplicatedPulse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReplicatedPulse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Polymorph != 0 {
+		dAtA[i] = 0x80
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintReplicationExporter(dAtA, i, uint64(m.Polymorph))
+	}
+	dAtA[i] = 0xa2
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintReplicationExporter(dAtA, i, uint64(m.Pulse.Size()))
+	n2, err := m.Pulse.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n2
+	if len(m.Nodes) > 0 {
+		for _, msg := range m.Nodes {
+			dAtA[i] = 0xaa
+			i++
+			dAtA[i] = 0x1
+			i++
+			i = encodeVarintReplicationExporter(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Drops) > 0 {
+		for _, msg := range m.Drops {
+			dAtA[i] = 0xb2
+			i++
+			dAtA[i] = 0x1
+			i++
+			i = encodeVarintReplicationExporter(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Records) > 0 {
+		for _, msg := range m.Records {
+			dAtA[i] = 0xba
+			i++
+			dAtA[i] = 0x1
+			i++
+			i = encodeVarintReplicationExporter(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Indexes) > 0 {
+		for _, msg := range m.Indexes {
+			dAtA[i] = 0xc2
+			i++
+			dAtA[i] = 0x1
+			i++
+			i = encodeVarintReplicationExporter(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.Complete {
+		dAtA[i] = 0xc8
+		i++
+		dAtA[i] = 0x1
+		i++
+		if m.Complete {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func encodeVarintReplicationExporter(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *GetReplication) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Polymorph != 0 {
+		n += 2 + sovReplicationExporter(uint64(m.Polymorph))
+	}
+	l = m.PulseNumber.Size()
+	n += 2 + l + sovReplicationExporter(uint64(l))
+	return n
+}
+
+func (m *ReplicatedPulse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Polymorph != 0 {
+		n += 2 + sovReplicationExporter(uint64(m.Polymorph))
+	}
+	l = m.Pulse.Size()
+	n += 2 + l + sovReplicationExporter(uint64(l))
+	if len(m.Nodes) > 0 {
+		for _, e := range m.Nodes {
+			l = e.Size()
+			n += 2 + l + sovReplicationExporter(uint64(l))
+		}
+	}
+	if len(m.Drops) > 0 {
+		for _, e := range m.Drops {
+			l = e.Size()
+			n += 2 + l + sovReplicationExporter(uint64(l))
+		}
+	}
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 2 + l + sovReplicationExporter(uint64(l))
+		}
+	}
+	if len(m.Indexes) > 0 {
+		for _, e := range m.Indexes {
+			l = e.Size()
+			n += 2 + l + sovReplicationExporter(uint64(l))
+		}
+	}
+	if m.Complete {
+		n += 3
+	}
+	return n
+}
+
+func sovReplicationExporter(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozReplicationExporter(x uint64) (n int) {
+	return sovReplicationExporter(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *GetReplication) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetReplication{`,
+		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
+		`PulseNumber:` + fmt.Sprintf("%v", this.PulseNumber) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReplicatedPulse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReplicatedPulse{`,
+		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
+		`Pulse:` + strings.Replace(strings.Replace(this.Pulse.String(), "PulseProto", "pulse.PulseProto", 1), `&`, ``, 1) + `,`,
+		`Nodes:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Nodes), "Node", "insolar.Node", 1), `&`, ``, 1) + `,`,
+		`Drops:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Drops), "Drop", "drop.Drop", 1), `&`, ``, 1) + `,`,
+		`Records:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Records), "Material", "record.Material", 1), `&`, ``, 1) + `,`,
+		`Indexes:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Indexes), "Index", "record.Index", 1), `&`, ``, 1) + `,`,
+		`Complete:` + fmt.Sprintf("%v", this.Complete) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringReplicationExporter(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *GetReplication) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReplicationExporter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetReplication: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetReplication: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Polymorph", wireType)
+			}
+			m.Polymorph = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplicationExporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Polymorph |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PulseNumber", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplicationExporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthReplicationExporter
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplicationExporter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PulseNumber.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReplicationExporter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthReplicationExporter
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthReplicationExporter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReplicatedPulse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReplicationExporter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReplicatedPulse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReplicatedPulse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Polymorph", wireType)
+			}
+			m.Polymorph = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplicationExporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Polymorph |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pulse", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplicationExporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReplicationExporter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplicationExporter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pulse.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplicationExporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReplicationExporter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplicationExporter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nodes = append(m.Nodes, insolar.Node{})
+			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Drops", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplicationExporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReplicationExporter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplicationExporter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Drops = append(m.Drops, drop.Drop{})
+			if err := m.Drops[len(m.Drops)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplicationExporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReplicationExporter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplicationExporter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, record.Material{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Indexes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplicationExporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReplicationExporter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplicationExporter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Indexes = append(m.Indexes, record.Index{})
+			if err := m.Indexes[len(m.Indexes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Complete", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplicationExporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Complete = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReplicationExporter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthReplicationExporter
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthReplicationExporter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipReplicationExporter(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowReplicationExporter
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowReplicationExporter
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+			return iNdEx, nil
+		case 1:
+			iNdEx += 8
+			return iNdEx, nil
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowReplicationExporter
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthReplicationExporter
+			}
+			iNdEx += length
+			if iNdEx < 0 {
+				return 0, ErrInvalidLengthReplicationExporter
+			}
+			return iNdEx, nil
+		case 3:
+			for {
+				var innerWire uint64
+				var start int = iNdEx
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return 0, ErrIntOverflowReplicationExporter
+					}
+					if iNdEx >= l {
+						return 0, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					innerWire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				innerWireType := int(innerWire & 0x7)
+				if innerWireType == 4 {
+					break
+				}
+				next, err := skipReplicationExporter(dAtA[start:])
+				if err != nil {
+					return 0, err
+				}
+				iNdEx = start + next
+				if iNdEx < 0 {
+					return 0, ErrInvalidLengthReplicationExporter
+				}
+			}
+			return iNdEx, nil
+		case 4:
+			return iNdEx, nil
+		case 5:
+			iNdEx += 4
+			return iNdEx, nil
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+	}
+	panic("unreachable")
+}
+
+var (
+	ErrInvalidLengthReplicationExporter = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowReplicationExporter   = fmt.Errorf("proto: integer overflow")
+)
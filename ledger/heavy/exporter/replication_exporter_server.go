@@ -0,0 +1,194 @@
+// Copyright 2020 Insolar Network Ltd.
+// All rights reserved.
+// This material is licensed under the Insolar License version 1.0,
+// available at https://github.com/insolar/insolar/blob/master/LICENSE.md.
+
+package exporter
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.opencensus.io/stats"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/node"
+	insolarPulse "github.com/insolar/insolar/insolar/pulse"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/ledger/drop"
+	"github.com/insolar/insolar/ledger/heavy/executor"
+	"github.com/insolar/insolar/ledger/object"
+	"github.com/insolar/insolar/pulse"
+)
+
+// ReplicationServer streams finalized pulses to heavy replicas.
+type ReplicationServer struct {
+	pulses      insolarPulse.Calculator
+	jetKeeper   executor.JetKeeper
+	nodes       node.Accessor
+	drops       drop.Accessor
+	recordIndex object.RecordPositionAccessor
+	records     object.RecordAccessor
+	indexes     object.IndexAccessor
+	chunkSize   int
+	authCfg     configuration.Auth
+}
+
+// defaultReplicationChunkSize is used, if chunk size is not configured.
+const defaultReplicationChunkSize = 1 << 20
+
+func NewReplicationServer(
+	pulses insolarPulse.Calculator,
+	jetKeeper executor.JetKeeper,
+	nodes node.Accessor,
+	drops drop.Accessor,
+	recordIndex object.RecordPositionAccessor,
+	records object.RecordAccessor,
+	indexes object.IndexAccessor,
+	chunkSize int,
+	authCfg configuration.Auth,
+) *ReplicationServer {
+	if chunkSize <= 0 {
+		chunkSize = defaultReplicationChunkSize
+	}
+	return &ReplicationServer{
+		pulses:      pulses,
+		jetKeeper:   jetKeeper,
+		nodes:       nodes,
+		drops:       drops,
+		recordIndex: recordIndex,
+		records:     records,
+		indexes:     indexes,
+		chunkSize:   chunkSize,
+		authCfg:     authCfg,
+	}
+}
+
+// Export sends all data of finalized pulses after the provided one, pulse by pulse.
+// Data of a pulse is split into chunks of bounded size, the last chunk of the pulse is marked as complete.
+// Zero pulse number means that replica is empty, so the genesis pulse is sent too.
+// The stream is finished, when top sync pulse of the primary is reached.
+func (r *ReplicationServer) Export(getReplication *GetReplication, stream ReplicationExporter_ExportServer) error {
+	ctx := stream.Context()
+	sent := 0
+	exportStart := time.Now()
+	logger := inslogger.FromContext(ctx)
+	logger.Info("Incoming request: ", getReplication.String())
+
+	defer func(ctx context.Context) {
+		stats.Record(
+			addTagsForExporterMethodTiming(r.authCfg.Required, ctx, "replication-export"),
+			HeavyExporterMethodTiming.M(float64(time.Since(exportStart).Nanoseconds())/1e6),
+		)
+		logger.Infof("replicated %d pulses", sent)
+	}(ctx)
+
+	var (
+		current insolar.Pulse
+		err     error
+	)
+	if getReplication.PulseNumber == 0 {
+		current, err = r.pulses.Forwards(ctx, pulse.MinTimePulse, 0)
+	} else {
+		current, err = r.pulses.Forwards(ctx, getReplication.PulseNumber, 1)
+	}
+	for {
+		if err == insolarPulse.ErrNotFound {
+			return nil
+		}
+		if err != nil {
+			logger.Error(err)
+			return err
+		}
+		if current.PulseNumber > r.jetKeeper.TopSyncPulse() {
+			return nil
+		}
+
+		err = r.sendPulse(ctx, current, stream)
+		if err != nil {
+			if ctx.Err() != context.Canceled {
+				logger.Error(err)
+			}
+			return err
+		}
+		sent++
+
+		current, err = r.pulses.Forwards(ctx, current.PulseNumber, 1)
+	}
+}
+
+// sendPulse sends data of the pulse in chunks. The first chunk contains nodes and drops,
+// records and indexes are added to chunks until chunk size is reached. A record or an index,
+// that is bigger than chunk size, is sent in a chunk alone.
+func (r *ReplicationServer) sendPulse(ctx context.Context, p insolar.Pulse, stream ReplicationExporter_ExportServer) error {
+	pn := p.PulseNumber
+	chunk := &ReplicatedPulse{Pulse: *insolarPulse.ToProto(&p)}
+	emptySize := chunk.Size()
+
+	nodes, err := r.nodes.All(pn)
+	if err != nil && err != node.ErrNoNodes {
+		return errors.Wrapf(err, "failed to fetch nodes for pulse %s", pn)
+	}
+	chunk.Nodes = nodes
+
+	for _, jetID := range r.jetKeeper.Storage().All(ctx, pn) {
+		dr, err := r.drops.ForPulse(ctx, jetID, pn)
+		if err != nil {
+			return errors.Wrapf(err, "failed to fetch drop for jet %s and pulse %s", jetID.DebugString(), pn)
+		}
+		chunk.Drops = append(chunk.Drops, dr)
+	}
+
+	size := chunk.Size()
+	reserve := func(dataSize int) error {
+		if size+dataSize <= r.chunkSize || size == emptySize {
+			size += dataSize
+			return nil
+		}
+		err := stream.Send(chunk)
+		if err != nil {
+			return errors.Wrapf(err, "failed to send chunk of pulse %s", pn)
+		}
+		chunk = &ReplicatedPulse{Pulse: chunk.Pulse}
+		size = emptySize + dataSize
+		return nil
+	}
+
+	// Records are sent in the order of their positions, so replica assigns them the same record numbers.
+	last, err := r.recordIndex.LastKnownPosition(pn)
+	if err != nil && err != object.ErrNotFound {
+		return errors.Wrapf(err, "failed to fetch last record position for pulse %s", pn)
+	}
+	for position := uint32(1); position <= last; position++ {
+		id, err := r.recordIndex.AtPosition(pn, position)
+		if err != nil {
+			return errors.Wrapf(err, "failed to fetch record at position %d for pulse %s", position, pn)
+		}
+		rec, err := r.records.ForID(ctx, id)
+		if err != nil {
+			return errors.Wrapf(err, "failed to fetch record %s", id.DebugString())
+		}
+		err = reserve(rec.Size())
+		if err != nil {
+			return err
+		}
+		chunk.Records = append(chunk.Records, rec)
+	}
+
+	indexes, err := r.indexes.ForPulse(ctx, pn)
+	if err != nil && err != object.ErrIndexNotFound {
+		return errors.Wrapf(err, "failed to fetch indexes for pulse %s", pn)
+	}
+	for _, idx := range indexes {
+		err = reserve(idx.Size())
+		if err != nil {
+			return err
+		}
+		chunk.Indexes = append(chunk.Indexes, idx)
+	}
+
+	chunk.Complete = true
+	return errors.Wrapf(stream.Send(chunk), "failed to send chunk of pulse %s", pn)
+}
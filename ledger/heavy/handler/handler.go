@@ -82,6 +82,9 @@ func New(lightChainLimit int, gcRunner executor.GCRunInfo) *Handler {
 		SendRequest: func(p *proc.SendRequest) {
 			p.Dep(h.RecordAccessor, h.Sender)
 		},
+		SendState: func(p *proc.SendState) {
+			p.Dep(h.RecordAccessor, h.Sender)
+		},
 		Replication: func(p *proc.Replication) {
 			p.Dep(
 				h.Replicator,
@@ -178,6 +181,10 @@ func (h *Handler) handle(ctx context.Context, meta payload.Meta) error {
 		p := proc.NewSendRequest(meta)
 		h.dep.SendRequest(p)
 		err = p.Proceed(ctx)
+	case payload.TypeGetState:
+		p := proc.NewSendState(meta)
+		h.dep.SendState(p)
+		err = p.Proceed(ctx)
 	case payload.TypeGetFilament:
 		p := proc.NewSendRequests(meta)
 		h.dep.SendRequests(p)
@@ -271,6 +278,10 @@ func (h *Handler) handlePass(ctx context.Context, meta payload.Meta) error {
 		p := proc.NewSendRequest(originMeta)
 		h.dep.SendRequest(p)
 		err = p.Proceed(ctx)
+	case payload.TypeGetState:
+		p := proc.NewSendState(originMeta)
+		h.dep.SendState(p)
+		err = p.Proceed(ctx)
 	default:
 		err = fmt.Errorf("no pass handler for message type %s", payloadType.String())
 	}
@@ -295,13 +306,13 @@ func (h *Handler) handleGotHotConfirmation(ctx context.Context, meta payload.Met
 		return
 	}
 
-	logger.Info("handleGotHotConfirmation. pulse: ", confirm.Pulse, ". jet: ", confirm.JetID.DebugString(), ". Split: ", confirm.Split)
+	logger.Info("handleGotHotConfirmation. pulse: ", confirm.Pulse, ". jet: ", confirm.JetID.DebugString(), ". Split: ", confirm.Split, ". Merge: ", confirm.Merge)
 
-	err = h.JetKeeper.AddHotConfirmation(ctx, confirm.Pulse, confirm.JetID, confirm.Split)
+	err = h.JetKeeper.AddHotConfirmation(ctx, confirm.Pulse, confirm.JetID, confirm.Split, confirm.Merge)
 	if err != nil {
 		logger.Fatalf("failed to add hot confirmation jet=%v: %v", confirm.String(), err.Error())
 	}
 
 	executor.FinalizePulse(ctx, h.PulseCalculator, h.BackupMaker, h.JetKeeper, h.IndexModifier, confirm.Pulse, h.gcRunner)
-	logger.Info("handleGotHotConfirmation finish. pulse: ", confirm.Pulse, ". jet: ", confirm.JetID.DebugString(), ". Split: ", confirm.Split)
+	logger.Info("handleGotHotConfirmation finish. pulse: ", confirm.Pulse, ". jet: ", confirm.JetID.DebugString(), ". Split: ", confirm.Split, ". Merge: ", confirm.Merge)
 }
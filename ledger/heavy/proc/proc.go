@@ -10,6 +10,7 @@ type Dependencies struct {
 	SendCode         func(*SendCode)
 	SendRequests     func(*SendRequests)
 	SendRequest      func(*SendRequest)
+	SendState        func(*SendState)
 	Replication      func(*Replication)
 	SendJet          func(*SendJet)
 	SendIndex        func(*SendIndex)
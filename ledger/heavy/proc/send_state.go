@@ -0,0 +1,85 @@
+// Copyright 2020 Insolar Network Ltd.
+// All rights reserved.
+// This material is licensed under the Insolar License version 1.0,
+// available at https://github.com/insolar/insolar/blob/master/LICENSE.md.
+
+package proc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/insolar/insolar/insolar/bus"
+	"github.com/insolar/insolar/insolar/payload"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/ledger/object"
+	"github.com/pkg/errors"
+)
+
+// SendState replies with the exact state or event record of the object.
+type SendState struct {
+	meta payload.Meta
+
+	dep struct {
+		records object.RecordAccessor
+		sender  bus.Sender
+	}
+}
+
+func NewSendState(meta payload.Meta) *SendState {
+	return &SendState{
+		meta: meta,
+	}
+}
+
+func (p *SendState) Dep(records object.RecordAccessor, sender bus.Sender) {
+	p.dep.records = records
+	p.dep.sender = sender
+}
+
+func (p *SendState) Proceed(ctx context.Context) error {
+	msg := payload.GetState{}
+	err := msg.Unmarshal(p.meta.Payload)
+	if err != nil {
+		return errors.Wrap(err, "failed to decode GetState payload")
+	}
+
+	rec, err := p.dep.records.ForID(ctx, msg.StateID)
+	if err == object.ErrNotFound {
+		msg, err := payload.NewMessage(&payload.Error{
+			Text: "state not found",
+			Code: payload.CodeNotFound,
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to create reply")
+		}
+
+		p.dep.sender.Reply(ctx, p.meta, msg)
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed to find a state")
+	}
+
+	switch record.Unwrap(&rec.Virtual).(type) {
+	case record.State, *record.Event:
+	default:
+		return fmt.Errorf("unexpected state type")
+	}
+	if rec.ObjectID != msg.ObjectID {
+		return fmt.Errorf("state belongs to another object")
+	}
+
+	buf, err := rec.Marshal()
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal state record")
+	}
+	rep, err := payload.NewMessage(&payload.State{
+		Record: buf,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to create a State message")
+	}
+	p.dep.sender.Reply(ctx, p.meta, rep)
+	return nil
+}
@@ -0,0 +1,143 @@
+// Copyright 2020 Insolar Network Ltd.
+// All rights reserved.
+// This material is licensed under the Insolar License version 1.0,
+// available at https://github.com/insolar/insolar/blob/master/LICENSE.md.
+
+package proc_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/gojuno/minimock/v3"
+	"github.com/insolar/insolar/insolar/bus"
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/insolar/payload"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/ledger/heavy/proc"
+	"github.com/insolar/insolar/ledger/object"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSendState_Proceed(t *testing.T) {
+	mc := minimock.NewController(t)
+	ctx := inslogger.TestContext(t)
+
+	var (
+		sender  *bus.SenderMock
+		records *object.RecordAccessorMock
+	)
+
+	resetComponents := func() {
+		sender = bus.NewSenderMock(mc)
+		records = object.NewRecordAccessorMock(mc)
+	}
+
+	newProc := func(msg payload.Meta) *proc.SendState {
+		p := proc.NewSendState(msg)
+		p.Dep(records, sender)
+		return p
+	}
+
+	objectID := gen.ID()
+	stateID := gen.ID()
+	buf, err := (&payload.GetState{ObjectID: objectID, StateID: stateID}).Marshal()
+	require.NoError(t, err)
+	receivedMeta := payload.Meta{Payload: buf}
+
+	resetComponents()
+	t.Run("state does not exist", func(t *testing.T) {
+		sender.ReplyMock.Set(func(_ context.Context, _ payload.Meta, msg *message.Message) {
+			rep := payload.Error{}
+			err := rep.Unmarshal(msg.Payload)
+			require.NoError(t, err)
+			require.Equal(t, payload.CodeNotFound, rep.Code)
+		})
+		records.ForIDMock.Return(record.Material{}, object.ErrNotFound)
+
+		err := newProc(receivedMeta).Proceed(ctx)
+		require.NoError(t, err)
+
+		mc.Finish()
+	})
+
+	resetComponents()
+	t.Run("record is not a state", func(t *testing.T) {
+		records.ForIDMock.Return(record.Material{
+			Virtual:  record.Wrap(&record.IncomingRequest{}),
+			ObjectID: objectID,
+		}, nil)
+
+		err := newProc(receivedMeta).Proceed(ctx)
+		require.Error(t, err)
+
+		mc.Finish()
+	})
+
+	resetComponents()
+	t.Run("state of another object", func(t *testing.T) {
+		records.ForIDMock.Return(record.Material{
+			Virtual:  record.Wrap(&record.Amend{}),
+			ObjectID: gen.ID(),
+		}, nil)
+
+		err := newProc(receivedMeta).Proceed(ctx)
+		require.Error(t, err)
+
+		mc.Finish()
+	})
+
+	resetComponents()
+	t.Run("happy basic", func(t *testing.T) {
+		state := record.Material{
+			Virtual:  record.Wrap(&record.Amend{Memory: []byte{1, 2, 3}}),
+			ID:       stateID,
+			ObjectID: objectID,
+		}
+		records.ForIDMock.Expect(ctx, stateID).Return(state, nil)
+		sender.ReplyMock.Set(func(_ context.Context, origin payload.Meta, rep *message.Message) {
+			require.Equal(t, receivedMeta, origin)
+
+			resp, err := payload.Unmarshal(rep.Payload)
+			require.NoError(t, err)
+
+			res, ok := resp.(*payload.State)
+			require.True(t, ok)
+			received := record.Material{}
+			require.NoError(t, received.Unmarshal(res.Record))
+			require.Equal(t, state, received)
+		})
+
+		err := newProc(receivedMeta).Proceed(ctx)
+		require.NoError(t, err)
+
+		mc.Finish()
+	})
+
+	resetComponents()
+	t.Run("happy event", func(t *testing.T) {
+		event := record.Material{
+			Virtual:  record.Wrap(&record.Event{Object: objectID, Topic: "transfer"}),
+			ID:       stateID,
+			ObjectID: objectID,
+		}
+		records.ForIDMock.Expect(ctx, stateID).Return(event, nil)
+		sender.ReplyMock.Set(func(_ context.Context, origin payload.Meta, rep *message.Message) {
+			resp, err := payload.Unmarshal(rep.Payload)
+			require.NoError(t, err)
+
+			res, ok := resp.(*payload.State)
+			require.True(t, ok)
+			received := record.Material{}
+			require.NoError(t, received.Unmarshal(res.Record))
+			require.Equal(t, event, received)
+		})
+
+		err := newProc(receivedMeta).Proceed(ctx)
+		require.NoError(t, err)
+
+		mc.Finish()
+	})
+}
@@ -0,0 +1,329 @@
+// Copyright 2020 Insolar Network Ltd.
+// All rights reserved.
+// This material is licensed under the Insolar License version 1.0,
+// available at https://github.com/insolar/insolar/blob/master/LICENSE.md.
+
+package replica
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/jet"
+	"github.com/insolar/insolar/insolar/node"
+	insolarPulse "github.com/insolar/insolar/insolar/pulse"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/ledger/drop"
+	"github.com/insolar/insolar/ledger/heavy/executor"
+	"github.com/insolar/insolar/ledger/heavy/exporter"
+	"github.com/insolar/insolar/ledger/object"
+	"github.com/insolar/insolar/pulse"
+)
+
+// PulseStorage is a pulse storage of the replica.
+type PulseStorage interface {
+	insolarPulse.Accessor
+	insolarPulse.Appender
+	insolarPulse.Calculator
+}
+
+// Rollback removes data, that is stored after top sync pulse.
+type Rollback interface {
+	Start(ctx context.Context) error
+}
+
+// Follower keeps replica in sync with the primary heavy. It receives finalized pulses from the primary
+// and finalizes every pulse on the replica only after all its data is stored, so exporters of the replica
+// never see partial pulses.
+type Follower struct {
+	cfg    configuration.Replica
+	client exporter.ReplicationExporterClient
+
+	pcs      insolar.PlatformCryptographyScheme
+	pulses   PulseStorage
+	nodes    node.Modifier
+	drops    drop.Modifier
+	records  object.RecordModifier
+	indexes  object.IndexModifier
+	jets     jet.Modifier
+	keeper   executor.JetKeeper
+	gcRunner executor.GCRunInfo
+	rollback Rollback
+
+	cancel  context.CancelFunc
+	stopped chan struct{}
+}
+
+// NewFollower creates follower, that receives pulses with provided client.
+func NewFollower(
+	cfg configuration.Replica,
+	client exporter.ReplicationExporterClient,
+	pcs insolar.PlatformCryptographyScheme,
+	pulses PulseStorage,
+	nodes node.Modifier,
+	drops drop.Modifier,
+	records object.RecordModifier,
+	indexes object.IndexModifier,
+	jets jet.Modifier,
+	keeper executor.JetKeeper,
+	gcRunner executor.GCRunInfo,
+	rollback Rollback,
+) *Follower {
+	return &Follower{
+		cfg:      cfg,
+		client:   client,
+		pcs:      pcs,
+		pulses:   pulses,
+		nodes:    nodes,
+		drops:    drops,
+		records:  records,
+		indexes:  indexes,
+		jets:     jets,
+		keeper:   keeper,
+		gcRunner: gcRunner,
+		rollback: rollback,
+	}
+}
+
+// Dial connects to the primary heavy exporter.
+func Dial(cfg configuration.Replica) (*grpc.ClientConn, error) {
+	if cfg.PrimaryAddr == "" {
+		return nil, errors.New("replica.primaryaddr is required for heavy replica")
+	}
+	conn, err := grpc.Dial(
+		cfg.PrimaryAddr,
+		grpc.WithInsecure(),
+		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(cfg.MaxRecvMsgSize)),
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to connect to primary heavy %s", cfg.PrimaryAddr)
+	}
+	return conn, nil
+}
+
+// Start starts following the primary.
+func (f *Follower) Start(ctx context.Context) error {
+	ctx, f.cancel = context.WithCancel(ctx)
+	f.stopped = make(chan struct{})
+	go f.follow(ctx)
+	return nil
+}
+
+// Stop stops following the primary and waits for the stored pulse to be finalized.
+func (f *Follower) Stop(ctx context.Context) error {
+	if f.cancel == nil {
+		return nil
+	}
+	f.cancel()
+	<-f.stopped
+	return nil
+}
+
+func (f *Follower) follow(ctx context.Context) {
+	defer close(f.stopped)
+
+	logger := inslogger.FromContext(ctx)
+	for {
+		err := f.Sync(ctx)
+		if err != nil && ctx.Err() == nil {
+			logger.Error(errors.Wrap(err, "replica failed to sync with primary heavy"))
+		}
+
+		select {
+		case <-ctx.Done():
+			logger.Info("replica follower stopped")
+			return
+		case <-time.After(f.cfg.PollInterval):
+		}
+	}
+}
+
+// Sync requests pulses, that are finalized on the primary after top sync pulse of the replica, and stores them.
+// Every pulse is received in chunks and finalized after its last chunk is stored. If the pulse can't be stored
+// completely, its partial data is rolled back, so the pulse is requested again on the next sync.
+func (f *Follower) Sync(ctx context.Context) error {
+	var from insolar.PulseNumber
+	_, err := f.pulses.Latest(ctx)
+	switch err {
+	case nil:
+		from = f.keeper.TopSyncPulse()
+	case insolarPulse.ErrNotFound:
+		// Empty replica, genesis pulse is requested too.
+	default:
+		return errors.Wrap(err, "failed to fetch latest pulse")
+	}
+
+	stream, err := f.client.Export(f.withMetadata(ctx), &exporter.GetReplication{PulseNumber: from})
+	if err != nil {
+		return errors.Wrap(err, "failed to request replication")
+	}
+
+	var (
+		current insolar.PulseNumber
+		drops   []drop.Drop
+	)
+	for {
+		chunk, err := stream.Recv()
+		if err == io.EOF {
+			if current != 0 {
+				return f.rollbackPulse(ctx, fmt.Errorf("replication is finished before pulse %s is complete", current))
+			}
+			return nil
+		}
+		if err != nil {
+			return f.rollbackPulse(ctx, errors.Wrap(err, "failed to receive replicated pulse"))
+		}
+
+		pn := chunk.Pulse.PulseNumber
+		if current != 0 && current != pn {
+			return f.rollbackPulse(ctx, fmt.Errorf("pulse %s is not complete, but chunk of pulse %s is received", current, pn))
+		}
+		current = pn
+		drops = append(drops, chunk.Drops...)
+
+		err = f.store(ctx, chunk)
+		if err != nil {
+			return f.rollbackPulse(ctx, errors.Wrapf(err, "replica failed to store pulse %s", pn))
+		}
+		if !chunk.Complete {
+			continue
+		}
+
+		err = f.finalize(ctx, *insolarPulse.FromProto(&chunk.Pulse), drops)
+		if err != nil {
+			return f.rollbackPulse(ctx, errors.Wrapf(err, "replica failed to finalize pulse %s", pn))
+		}
+		current, drops = 0, nil
+	}
+}
+
+// rollbackPulse removes partially stored data of the pulse, that is not finalized.
+func (f *Follower) rollbackPulse(ctx context.Context, cause error) error {
+	err := f.rollback.Start(ctx)
+	if err != nil {
+		return errors.Wrapf(cause, "failed to rollback not finalized data (%s)", err)
+	}
+	return cause
+}
+
+func (f *Follower) withMetadata(ctx context.Context) context.Context {
+	md := metadata.Pairs(
+		exporter.KeyClientType, exporter.ValidateHeavyVersion.String(),
+		exporter.KeyClientVersionHeavy, strconv.Itoa(exporter.AllowedOnHeavyVersion),
+	)
+	if f.cfg.Token != "" {
+		md.Set("authorization", "Bearer "+f.cfg.Token)
+	}
+	return metadata.NewOutgoingContext(ctx, md)
+}
+
+func (f *Follower) store(ctx context.Context, chunk *exporter.ReplicatedPulse) error {
+	pn := chunk.Pulse.PulseNumber
+
+	// Genesis records are not addressed by hashes.
+	if pn != pulse.MinTimePulse {
+		err := f.checkRecords(pn, chunk.Records)
+		if err != nil {
+			return err
+		}
+	}
+	err := f.records.BatchSet(ctx, chunk.Records)
+	if err != nil {
+		return errors.Wrap(err, "failed to store records")
+	}
+	for _, idx := range chunk.Indexes {
+		err := f.indexes.SetIndex(ctx, pn, idx)
+		if err != nil {
+			return errors.Wrapf(err, "failed to store index of %s", idx.ObjID.DebugString())
+		}
+	}
+	for _, dr := range chunk.Drops {
+		err := f.drops.Set(ctx, dr)
+		if err != nil {
+			return errors.Wrapf(err, "failed to store drop of jet %s", dr.JetID.DebugString())
+		}
+		err = f.jets.Update(ctx, pn, true, dr.JetID)
+		if err != nil {
+			return errors.Wrapf(err, "failed to update jet %s", dr.JetID.DebugString())
+		}
+	}
+	if len(chunk.Nodes) > 0 {
+		err := f.nodes.Set(pn, chunk.Nodes)
+		if err != nil {
+			return errors.Wrap(err, "failed to store nodes")
+		}
+	}
+	return nil
+}
+
+func (f *Follower) finalize(ctx context.Context, p insolar.Pulse, drops []drop.Drop) error {
+	pn := p.PulseNumber
+	err := f.pulses.Append(ctx, p)
+	if err != nil {
+		return errors.Wrap(err, "failed to append pulse")
+	}
+
+	if pn == pulse.MinTimePulse {
+		// Genesis pulse is finalized by default.
+		return errors.Wrap(f.indexes.UpdateLastKnownPulse(ctx, pn), "failed to update indexes")
+	}
+
+	// Confirmations are the same, that primary received from light nodes.
+	for _, dr := range drops {
+		err := f.confirm(ctx, pn, dr)
+		if err != nil {
+			return errors.Wrapf(err, "failed to confirm drop of jet %s", dr.JetID.DebugString())
+		}
+	}
+	executor.FinalizePulse(ctx, f.pulses, nil, f.keeper, f.indexes, pn, f.gcRunner)
+	if top := f.keeper.TopSyncPulse(); top != pn {
+		return fmt.Errorf("pulse is not finalized, top sync pulse is %s", top)
+	}
+
+	inslogger.FromContext(ctx).WithField("pulse", pn).Info("replica finalized pulse")
+	return nil
+}
+
+func (f *Follower) checkRecords(pn insolar.PulseNumber, records []record.Material) error {
+	for _, rec := range records {
+		hash := record.HashVirtual(f.pcs.ReferenceHasher(), rec.Virtual)
+		id := *insolar.NewID(pn, hash)
+		if rec.ID != id {
+			return fmt.Errorf(
+				"record id does not match (calculated: %s, received: %s)",
+				id.DebugString(),
+				rec.ID.DebugString(),
+			)
+		}
+	}
+	return nil
+}
+
+func (f *Follower) confirm(ctx context.Context, pn insolar.PulseNumber, dr drop.Drop) error {
+	err := f.keeper.AddDropConfirmation(ctx, pn, dr.JetID, dr.Split, dr.Merge)
+	if err != nil {
+		return err
+	}
+	switch {
+	case dr.Split:
+		// Hot data of split jet is confirmed by both of its children.
+		left, right := jet.Siblings(dr.JetID)
+		err = f.keeper.AddHotConfirmation(ctx, pn, left, true, false)
+		if err != nil {
+			return err
+		}
+		return f.keeper.AddHotConfirmation(ctx, pn, right, true, false)
+	default:
+		return f.keeper.AddHotConfirmation(ctx, pn, dr.JetID, false, dr.Merge)
+	}
+}
@@ -0,0 +1,379 @@
+// Copyright 2020 Insolar Network Ltd.
+// All rights reserved.
+// This material is licensed under the Insolar License version 1.0,
+// available at https://github.com/insolar/insolar/blob/master/LICENSE.md.
+
+// +build slowtest
+
+package replica
+
+import (
+	"context"
+	"io/ioutil"
+	"net"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/dgraph-io/badger"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/insolar/jet"
+	"github.com/insolar/insolar/insolar/node"
+	insolarPulse "github.com/insolar/insolar/insolar/pulse"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/insolar/store"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/ledger/drop"
+	"github.com/insolar/insolar/ledger/heavy/executor"
+	"github.com/insolar/insolar/ledger/heavy/exporter"
+	"github.com/insolar/insolar/ledger/object"
+	"github.com/insolar/insolar/platformpolicy"
+	"github.com/insolar/insolar/pulse"
+)
+
+type heavyDB struct {
+	db       *store.BadgerDB
+	pulses   *insolarPulse.BadgerDB
+	nodes    *node.BadgerStorageDB
+	jets     *jet.BadgerDBStore
+	records  *object.BadgerRecordDB
+	indexes  *object.BadgerIndexDB
+	drops    *drop.BadgerDB
+	keeper   *executor.BadgerDBJetKeeper
+	gcRunner *executor.BadgerGCRunInfo
+	rollback *executor.DBRollback
+}
+
+func newHeavyDB(t *testing.T) (*heavyDB, func()) {
+	tmpdir, err := ioutil.TempDir("", "bdb-test-")
+	require.NoError(t, err)
+
+	ops := badger.DefaultOptions(tmpdir)
+	ops.CompactL0OnClose = false
+	ops.SyncWrites = false
+	db, err := store.NewBadgerDB(ops)
+	require.NoError(t, err)
+
+	h := &heavyDB{db: db}
+	h.pulses = insolarPulse.NewBadgerDB(db)
+	h.nodes = node.NewBadgerStorageDB(db)
+	h.jets = jet.NewBadgerDBStore(db)
+	h.records = object.NewBadgerRecordDB(db)
+	h.indexes = object.NewBadgerIndexDB(db, h.records)
+	h.drops = drop.NewBadgerDB(db)
+	h.keeper = executor.NewBadgerJetKeeper(h.jets, db, h.pulses)
+	h.gcRunner = executor.NewBadgerGCRunInfo(db, 0)
+	h.rollback = executor.NewDBRollback(h.keeper, h.drops, h.records, h.indexes, h.jets, h.pulses, h.keeper, h.nodes)
+
+	return h, func() {
+		db.Stop(context.Background())
+		os.RemoveAll(tmpdir)
+	}
+}
+
+// storeGenesis stores genesis pulse the same way genesis does it.
+func (h *heavyDB) storeGenesis(ctx context.Context, t *testing.T) {
+	rec := record.Material{
+		ID:      gen.IDWithPulse(pulse.MinTimePulse),
+		Virtual: record.Wrap(&record.Genesis{Hash: []byte{0xAC}}),
+	}
+	require.NoError(t, h.pulses.Append(ctx, *insolar.GenesisPulse))
+	require.NoError(t, h.drops.Set(ctx, drop.Drop{Pulse: pulse.MinTimePulse, JetID: insolar.ZeroJetID}))
+	require.NoError(t, h.records.BatchSet(ctx, []record.Material{rec}))
+	require.NoError(t, h.indexes.UpdateLastKnownPulse(ctx, pulse.MinTimePulse))
+}
+
+// storePulse stores pulse with random records and finalizes it, like heavy does for data from light.
+func (h *heavyDB) storePulse(ctx context.Context, t *testing.T, pn, prev insolar.PulseNumber) {
+	pcs := platformpolicy.NewPlatformCryptographyScheme()
+	var records []record.Material
+	for i := 0; i < 5; i++ {
+		obj := gen.Reference()
+		virtual := record.Wrap(&record.IncomingRequest{Object: &obj})
+		records = append(records, record.Material{
+			ID:      *insolar.NewID(pn, record.HashVirtual(pcs.ReferenceHasher(), virtual)),
+			Virtual: virtual,
+			JetID:   insolar.ZeroJetID,
+		})
+	}
+	require.NoError(t, h.records.BatchSet(ctx, records))
+
+	idx := record.Index{
+		ObjID:            gen.IDWithPulse(pn),
+		LifelineLastUsed: pn,
+		Lifeline:         record.Lifeline{LatestState: &records[0].ID},
+	}
+	require.NoError(t, h.indexes.SetIndex(ctx, pn, idx))
+
+	require.NoError(t, h.drops.Set(ctx, drop.Drop{Pulse: pn, JetID: insolar.ZeroJetID}))
+	require.NoError(t, h.jets.Update(ctx, pn, true, insolar.ZeroJetID))
+	require.NoError(t, h.nodes.Set(pn, []insolar.Node{{ID: gen.Reference(), Role: insolar.StaticRoleLightMaterial}}))
+	require.NoError(t, h.pulses.Append(ctx, insolar.Pulse{PulseNumber: pn, PrevPulseNumber: prev, NextPulseNumber: pn + 10}))
+
+	require.NoError(t, h.keeper.AddDropConfirmation(ctx, pn, insolar.ZeroJetID, false, false))
+	require.NoError(t, h.keeper.AddHotConfirmation(ctx, pn, insolar.ZeroJetID, false, false))
+	executor.FinalizePulse(ctx, h.pulses, nil, h.keeper, h.indexes, pn, h.gcRunner)
+	require.Equal(t, pn, h.keeper.TopSyncPulse())
+}
+
+// serveReplication serves replication from the primary. Small chunk size makes primary send every record
+// in a separate chunk.
+func serveReplication(t *testing.T, h *heavyDB, chunkSize int) (*grpc.ClientConn, func()) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	server := grpc.NewServer()
+	exporter.RegisterReplicationExporterServer(server, exporter.NewReplicationServer(
+		h.pulses, h.keeper, h.nodes, h.drops, h.records, h.records, h.indexes, chunkSize, configuration.Auth{},
+	))
+	go server.Serve(lis)
+
+	cfg := configuration.NewReplica()
+	cfg.PrimaryAddr = lis.Addr().String()
+	conn, err := Dial(cfg)
+	require.NoError(t, err)
+
+	return conn, func() {
+		conn.Close()
+		server.Stop()
+	}
+}
+
+func newTestFollower(conn *grpc.ClientConn, h *heavyDB) *Follower {
+	cfg := configuration.NewReplica()
+	cfg.PollInterval = 10 * time.Millisecond
+	return NewFollower(
+		cfg,
+		exporter.NewReplicationExporterClient(conn),
+		platformpolicy.NewPlatformCryptographyScheme(),
+		h.pulses,
+		h.nodes,
+		h.drops,
+		h.records,
+		h.indexes,
+		h.jets,
+		h.keeper,
+		h.gcRunner,
+		h.rollback,
+	)
+}
+
+func requireSamePulse(ctx context.Context, t *testing.T, primary, replica *heavyDB, pn insolar.PulseNumber) {
+	expectedPulse, err := primary.pulses.ForPulseNumber(ctx, pn)
+	require.NoError(t, err)
+	actualPulse, err := replica.pulses.ForPulseNumber(ctx, pn)
+	require.NoError(t, err)
+	require.Equal(t, insolarPulse.ToProto(&expectedPulse), insolarPulse.ToProto(&actualPulse))
+
+	expectedLast, err := primary.records.LastKnownPosition(pn)
+	require.NoError(t, err)
+	actualLast, err := replica.records.LastKnownPosition(pn)
+	require.NoError(t, err)
+	require.Equal(t, expectedLast, actualLast)
+	for position := uint32(1); position <= expectedLast; position++ {
+		expectedID, err := primary.records.AtPosition(pn, position)
+		require.NoError(t, err)
+		actualID, err := replica.records.AtPosition(pn, position)
+		require.NoError(t, err)
+		require.Equal(t, expectedID, actualID)
+	}
+
+	if pn == pulse.MinTimePulse {
+		return
+	}
+
+	expectedIndexes, err := primary.indexes.ForPulse(ctx, pn)
+	require.NoError(t, err)
+	actualIndexes, err := replica.indexes.ForPulse(ctx, pn)
+	require.NoError(t, err)
+	require.Equal(t, expectedIndexes, actualIndexes)
+
+	expectedDrop, err := primary.drops.ForPulse(ctx, insolar.ZeroJetID, pn)
+	require.NoError(t, err)
+	actualDrop, err := replica.drops.ForPulse(ctx, insolar.ZeroJetID, pn)
+	require.NoError(t, err)
+	require.Equal(t, expectedDrop, actualDrop)
+
+	expectedNodes, err := primary.nodes.All(pn)
+	require.NoError(t, err)
+	actualNodes, err := replica.nodes.All(pn)
+	require.NoError(t, err)
+	require.Equal(t, expectedNodes, actualNodes)
+}
+
+func TestFollower_Sync(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+
+	primary, closePrimary := newHeavyDB(t)
+	defer closePrimary()
+	replica, closeReplica := newHeavyDB(t)
+	defer closeReplica()
+
+	conn, stop := serveReplication(t, primary, 1)
+	defer stop()
+	follower := newTestFollower(conn, replica)
+
+	primary.storeGenesis(ctx, t)
+	pulses := []insolar.PulseNumber{pulse.MinTimePulse + 10, pulse.MinTimePulse + 20}
+	prev := pulse.MinTimePulse
+	for _, pn := range pulses {
+		primary.storePulse(ctx, t, pn, insolar.PulseNumber(prev))
+		prev = int(pn)
+	}
+
+	t.Run("empty replica receives genesis and finalized pulses", func(t *testing.T) {
+		err := follower.Sync(ctx)
+		require.NoError(t, err)
+
+		require.Equal(t, primary.keeper.TopSyncPulse(), replica.keeper.TopSyncPulse())
+		requireSamePulse(ctx, t, primary, replica, pulse.MinTimePulse)
+		for _, pn := range pulses {
+			requireSamePulse(ctx, t, primary, replica, pn)
+		}
+	})
+
+	t.Run("replica receives only new pulses", func(t *testing.T) {
+		pn := pulse.MinTimePulse + 30
+		primary.storePulse(ctx, t, insolar.PulseNumber(pn), insolar.PulseNumber(prev))
+
+		err := follower.Sync(ctx)
+		require.NoError(t, err)
+
+		require.Equal(t, insolar.PulseNumber(pn), replica.keeper.TopSyncPulse())
+		requireSamePulse(ctx, t, primary, replica, insolar.PulseNumber(pn))
+	})
+
+	t.Run("not finalized pulse is not replicated", func(t *testing.T) {
+		pn := insolar.PulseNumber(pulse.MinTimePulse + 40)
+		require.NoError(t, primary.pulses.Append(ctx, insolar.Pulse{PulseNumber: pn, PrevPulseNumber: pn - 10, NextPulseNumber: pn + 10}))
+
+		err := follower.Sync(ctx)
+		require.NoError(t, err)
+
+		require.Equal(t, pn-10, replica.keeper.TopSyncPulse())
+		_, err = replica.pulses.ForPulseNumber(ctx, pn)
+		require.Equal(t, insolarPulse.ErrNotFound, err)
+	})
+
+	t.Run("follower component follows the primary", func(t *testing.T) {
+		require.NoError(t, primary.jets.Update(ctx, pulse.MinTimePulse+40, true, insolar.ZeroJetID))
+		require.NoError(t, primary.drops.Set(ctx, drop.Drop{Pulse: pulse.MinTimePulse + 40, JetID: insolar.ZeroJetID}))
+		require.NoError(t, primary.keeper.AddDropConfirmation(ctx, pulse.MinTimePulse+40, insolar.ZeroJetID, false, false))
+		require.NoError(t, primary.keeper.AddHotConfirmation(ctx, pulse.MinTimePulse+40, insolar.ZeroJetID, false, false))
+		executor.FinalizePulse(ctx, primary.pulses, nil, primary.keeper, primary.indexes, pulse.MinTimePulse+40, primary.gcRunner)
+
+		require.NoError(t, follower.Start(ctx))
+		require.Eventually(t, func() bool {
+			return replica.keeper.TopSyncPulse() == pulse.MinTimePulse+40
+		}, 10*time.Second, 10*time.Millisecond)
+		require.NoError(t, follower.Stop(ctx))
+	})
+}
+
+type replicationStreamMock struct {
+	grpc.ServerStream
+	ctx    context.Context
+	chunks []*exporter.ReplicatedPulse
+}
+
+func (s *replicationStreamMock) Context() context.Context {
+	return s.ctx
+}
+
+func (s *replicationStreamMock) Send(chunk *exporter.ReplicatedPulse) error {
+	s.chunks = append(s.chunks, chunk)
+	return nil
+}
+
+func TestReplicationServer_Export_Chunks(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+
+	primary, closePrimary := newHeavyDB(t)
+	defer closePrimary()
+	primary.storeGenesis(ctx, t)
+	pn := insolar.PulseNumber(pulse.MinTimePulse + 10)
+	primary.storePulse(ctx, t, pn, pulse.MinTimePulse)
+
+	export := func(t *testing.T, chunkSize int) []*exporter.ReplicatedPulse {
+		server := exporter.NewReplicationServer(
+			primary.pulses, primary.keeper, primary.nodes, primary.drops, primary.records, primary.records, primary.indexes,
+			chunkSize, configuration.Auth{},
+		)
+		stream := &replicationStreamMock{ctx: ctx}
+		err := server.Export(&exporter.GetReplication{PulseNumber: pulse.MinTimePulse}, stream)
+		require.NoError(t, err)
+		return stream.chunks
+	}
+
+	t.Run("pulse fits one chunk", func(t *testing.T) {
+		chunks := export(t, 1<<20)
+
+		require.Equal(t, 1, len(chunks))
+		require.True(t, chunks[0].Complete)
+		require.Equal(t, pn, chunks[0].Pulse.PulseNumber)
+		require.Equal(t, 5, len(chunks[0].Records))
+		require.Equal(t, 1, len(chunks[0].Indexes))
+	})
+
+	t.Run("records bigger than chunk are sent separately", func(t *testing.T) {
+		chunks := export(t, 1)
+
+		// Nodes and drops, five records and index.
+		require.Equal(t, 7, len(chunks))
+		require.NotEmpty(t, chunks[0].Nodes)
+		require.NotEmpty(t, chunks[0].Drops)
+		require.Empty(t, chunks[0].Records)
+		for i, chunk := range chunks {
+			require.Equal(t, pn, chunk.Pulse.PulseNumber)
+			require.Equal(t, i == len(chunks)-1, chunk.Complete)
+		}
+		for _, chunk := range chunks[1:6] {
+			require.Equal(t, 1, len(chunk.Records))
+		}
+		require.Equal(t, 1, len(chunks[6].Indexes))
+	})
+}
+
+func TestFollower_Sync_RollbackNotFinalized(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+
+	primary, closePrimary := newHeavyDB(t)
+	defer closePrimary()
+	replica, closeReplica := newHeavyDB(t)
+	defer closeReplica()
+
+	conn, stop := serveReplication(t, primary, 1)
+	defer stop()
+	follower := newTestFollower(conn, replica)
+
+	primary.storeGenesis(ctx, t)
+	require.NoError(t, follower.Sync(ctx))
+
+	pn := insolar.PulseNumber(pulse.MinTimePulse + 10)
+	primary.storePulse(ctx, t, pn, pulse.MinTimePulse)
+
+	// Replica already has the last record of the pulse, so storing of the pulse fails after previous chunks are stored.
+	last, err := primary.records.LastKnownPosition(pn)
+	require.NoError(t, err)
+	id, err := primary.records.AtPosition(pn, last)
+	require.NoError(t, err)
+	rec, err := primary.records.ForID(ctx, id)
+	require.NoError(t, err)
+	require.NoError(t, replica.records.BatchSet(ctx, []record.Material{rec}))
+
+	err = follower.Sync(ctx)
+	require.Error(t, err)
+	require.Equal(t, insolar.PulseNumber(pulse.MinTimePulse), replica.keeper.TopSyncPulse())
+	_, err = replica.records.LastKnownPosition(pn)
+	require.Equal(t, object.ErrNotFound, err)
+
+	err = follower.Sync(ctx)
+	require.NoError(t, err)
+	require.Equal(t, pn, replica.keeper.TopSyncPulse())
+	requireSamePulse(ctx, t, primary, replica, pn)
+}
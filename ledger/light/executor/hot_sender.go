@@ -118,7 +118,7 @@ func (m *HotSenderDefault) SendHot(
 		jetID := id
 		logger := logger.WithField("jetID", jetID.DebugString())
 
-		block, err := m.findDrop(ctx, currentPulse, jetID)
+		blocks, err := m.findDrops(ctx, currentPulse, jetID)
 		if err != nil {
 			err = errors.Wrapf(err, "get drop for pulse %v and jet %v failed", currentPulse, jetID.DebugString())
 			instracer.AddError(span, err)
@@ -126,15 +126,21 @@ func (m *HotSenderDefault) SendHot(
 		}
 		logger.Infof("save drop for pulse %v", currentPulse)
 
-		// send data for every jet asynchronously
-		go func() {
-			err := m.sendForJet(ctx, jetID, newPulse, idxByJet[jetID], block)
-			if err != nil {
-				logger.WithField("error", err.Error()).Error("hot sender: sendForJet failed")
-			} else {
-				logger.Info("hot sender: sendForJet OK")
-			}
-		}()
+		// Merged jet is sent as two parts with drops of both merged jets.
+		// Receiver waits for both of them, so all indexes are sent with the first one.
+		indexes := idxByJet[jetID]
+		for _, block := range blocks {
+			// send data for every jet asynchronously
+			go func(block drop.Drop, indexes []record.Index) {
+				err := m.sendForJet(ctx, jetID, newPulse, indexes, block)
+				if err != nil {
+					logger.WithField("error", err.Error()).Error("hot sender: sendForJet failed")
+				} else {
+					logger.Info("hot sender: sendForJet OK")
+				}
+			}(block, indexes)
+			indexes = nil
+		}
 	}
 	return nil
 }
@@ -167,19 +173,35 @@ func (m *HotSenderDefault) sendForJet(
 	return nil
 }
 
-// findDrop try to get drop for provided jet and if not found tries
+// findDrops try to get drop for provided jet and if not found tries
 // to find Parent's jet (if jet have been split and we have no previous drop for it by this reason)
-func (m *HotSenderDefault) findDrop(
+// or drops of both children (if jet have been merged from them).
+func (m *HotSenderDefault) findDrops(
 	ctx context.Context, pn insolar.PulseNumber, jetID insolar.JetID,
-) (drop.Drop, error) {
+) ([]drop.Drop, error) {
 	block, err := m.dropAccessor.ForPulse(ctx, jetID, pn)
-	if err == drop.ErrNotFound {
-		jetID = jet.Parent(jetID)
-		// try to get parent's drop
-		block, err = m.dropAccessor.ForPulse(ctx, jetID, pn)
-		if err == drop.ErrNotFound {
-			err = errors.Wrap(err, "drop for parent jet not found too")
+	if err != drop.ErrNotFound {
+		return []drop.Drop{block}, err
+	}
+
+	// try to get parent's drop
+	block, err = m.dropAccessor.ForPulse(ctx, jet.Parent(jetID), pn)
+	if err != drop.ErrNotFound {
+		return []drop.Drop{block}, err
+	}
+
+	// try to get children's drops
+	left, right := jet.Siblings(jetID)
+	leftBlock, err := m.dropAccessor.ForPulse(ctx, left, pn)
+	if err == nil {
+		var rightBlock drop.Drop
+		rightBlock, err = m.dropAccessor.ForPulse(ctx, right, pn)
+		if err == nil && leftBlock.Merge && rightBlock.Merge {
+			return []drop.Drop{leftBlock, rightBlock}, nil
 		}
 	}
-	return block, err
+	if err == nil || err == drop.ErrNotFound {
+		err = errors.Wrap(drop.ErrNotFound, "drop for parent jet not found too")
+	}
+	return nil, err
 }
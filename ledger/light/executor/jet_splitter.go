@@ -99,22 +99,52 @@ func (js *JetSplitterDefault) Do(
 	}
 
 	inslog.Debugf("my jets: %s", insolar.JetIDCollection(jets).DebugString())
-	result := make([]insolar.JetID, 0, len(jets)*2)
+	drops := make(map[insolar.JetID]drop.Drop, len(jets))
 	for _, jetID := range jets {
-		var endedDrop drop.Drop
 		if createDrops {
-			endedDrop = js.createDrop(ctx, jetID, endedPulse)
-			err := js.dropModifier.Set(ctx, endedDrop)
+			drops[jetID] = js.createDrop(ctx, jetID, endedPulse)
+			continue
+		}
+		dr, err := js.dropAccessor.ForPulse(ctx, jetID, endedPulse)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to fetch drop for split. jetID: %s, pulse: %d", jetID.DebugString(), endedPulse)
+		}
+		drops[jetID] = dr
+	}
+
+	if createDrops {
+		js.markMerges(drops)
+		for _, jetID := range jets {
+			err := js.dropModifier.Set(ctx, drops[jetID])
 			if err != nil {
 				return nil, errors.Wrap(err, "failed to create drop")
 			}
 			inslog.Debugf("created drop for pulse %s jet %s", endedPulse.String(), jetID.DebugString())
-		} else {
-			dr, err := js.dropAccessor.ForPulse(ctx, jetID, endedPulse)
+		}
+	}
+
+	result := make([]insolar.JetID, 0, len(jets)*2)
+	for _, jetID := range jets {
+		endedDrop := drops[jetID]
+
+		if endedDrop.Merge {
+			parentID := jet.Parent(jetID)
+			// sibling is already merged
+			if contains(result, parentID) {
+				continue
+			}
+
+			// merge jet with sibling for new pulse
+			_, err := js.jetModifier.Merge(ctx, newPulse, jetID)
 			if err != nil {
-				return nil, errors.Wrapf(err, "failed to fetch drop for split. jetID: %s, pulse: %d", jetID.DebugString(), endedPulse)
+				return nil, errors.Wrap(err, "failed to merge jet tree")
 			}
-			endedDrop = dr
+			result = append(result, parentID)
+
+			inslog.WithField("jet_parent", parentID.DebugString()).Info("jet merge performed")
+			stats.Record(ctx, statJetMerges.M(1))
+
+			continue
 		}
 
 		if !endedDrop.Split {
@@ -143,6 +173,35 @@ func (js *JetSplitterDefault) Do(
 	return result, nil
 }
 
+// markMerges marks drops of sibling jets for merge, if both of them stayed below merge threshold long enough.
+// Merge is decided only if both siblings are executed by this node, so decision can't differ between nodes.
+func (js *JetSplitterDefault) markMerges(drops map[insolar.JetID]drop.Drop) {
+	for jetID, dr := range drops {
+		if jetID.Depth() == 0 || dr.Split || dr.MergeThresholdUnderflow <= int64(js.cfg.MergeUnderflowCount) {
+			continue
+		}
+		left, right := jet.Siblings(jet.Parent(jetID))
+		sibling, ok := drops[right]
+		if jetID != left {
+			sibling, ok = drops[left]
+		}
+		if !ok || sibling.Split || sibling.MergeThresholdUnderflow <= int64(js.cfg.MergeUnderflowCount) {
+			continue
+		}
+		dr.Merge = true
+		drops[jetID] = dr
+	}
+}
+
+func contains(jets []insolar.JetID, id insolar.JetID) bool {
+	for _, j := range jets {
+		if j == id {
+			return true
+		}
+	}
+	return false
+}
+
 func (js *JetSplitterDefault) createDrop(
 	ctx context.Context,
 	jetID insolar.JetID,
@@ -159,6 +218,11 @@ func (js *JetSplitterDefault) createDrop(
 		statDropRecords.M(int64(recordsCount)),
 	)
 
+	// count pulses in row with few records for merge, root jet has nothing to merge with.
+	if jetID.Depth() > 0 && recordsCount < js.cfg.MergeThresholdRecordsCount {
+		block.MergeThresholdUnderflow = js.getPreviousDropMergeThreshold(ctx, jetID, pn) + 1
+	}
+
 	// skip any thresholds calculation for split if jet depth for jetID reached limit.
 	if jetID.Depth() >= js.cfg.DepthLimit {
 		return block
@@ -196,6 +260,29 @@ func (js *JetSplitterDefault) getPreviousDropThreshold(
 	return js.getDropThreshold(ctx, jetID, prevPulse.PulseNumber)
 }
 
+func (js *JetSplitterDefault) getPreviousDropMergeThreshold(
+	ctx context.Context,
+	jetID insolar.JetID,
+	pn insolar.PulseNumber,
+) int64 {
+	prevPulse, err := js.pulseCalculator.Backwards(ctx, pn, 1)
+	if err != nil {
+		if err == pulse.ErrNotFound {
+			return 0
+		}
+		inslogger.FromContext(ctx).Panic("failed to fetch previous pulse")
+	}
+	block, err := js.dropAccessor.ForPulse(ctx, jetID, prevPulse.PulseNumber)
+	if err != nil {
+		if err == drop.ErrNotFound {
+			// jet was split or merged in the previous pulse, or it's the first pulse after (re)start.
+			return 0
+		}
+		inslogger.FromContext(ctx).Panic(errors.Wrapf(err, "failed to get drop for pulse=%v and jetID=%v", prevPulse.PulseNumber, jetID.DebugString()))
+	}
+	return block.MergeThresholdUnderflow
+}
+
 func (js *JetSplitterDefault) getDropThreshold(
 	ctx context.Context,
 	jetID insolar.JetID,
@@ -242,3 +242,77 @@ func jsort(jets []insolar.JetID) []string {
 	}
 	return result
 }
+
+func TestJetSplitter_Merge(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+
+	jetStore := jet.NewStore()
+	db := drop.NewStorageMemory()
+	jetCalc := NewJetCalculatorMock(t)
+	collectionAccessor := object.NewRecordCollectionAccessorMock(t)
+	pulseCalc := pulse.NewCalculatorMock(t)
+
+	splitter := NewJetSplitter(
+		configuration.JetSplit{
+			ThresholdRecordsCount:      100,
+			ThresholdOverflowCount:     0,
+			DepthLimit:                 defaultDepthLimit,
+			MergeThresholdRecordsCount: 2,
+			MergeUnderflowCount:        1,
+		},
+		jetCalc, jetStore, jetStore,
+		db, db,
+		pulseCalc, collectionAccessor,
+	)
+	collectionAccessor.ForPulseMock.Return(make([]record.Material, 1))
+
+	jet1 := jet.NewIDFromString("1")
+	var initialPulse insolar.PulseNumber = 60000
+	err := jetStore.Update(ctx, initialPulse, true, jet0, jet10, jet11)
+	require.NoError(t, err)
+
+	expected := []struct {
+		jets      []insolar.JetID
+		underflow map[insolar.JetID]int64
+		merged    []insolar.JetID
+	}{
+		{
+			jets:      []insolar.JetID{jet0, jet10, jet11},
+			underflow: map[insolar.JetID]int64{jet0: 1, jet10: 1, jet11: 1},
+		},
+		// Siblings are merged, jet0 has no leaf sibling.
+		{
+			jets:      []insolar.JetID{jet0, jet1},
+			underflow: map[insolar.JetID]int64{jet0: 2, jet10: 2, jet11: 2},
+			merged:    []insolar.JetID{jet10, jet11},
+		},
+		// Merged jet starts counting from the beginning.
+		{
+			jets:      []insolar.JetID{jet0, jet1},
+			underflow: map[insolar.JetID]int64{jet0: 3, jet1: 1},
+		},
+		{
+			jets:      []insolar.JetID{insolar.ZeroJetID},
+			underflow: map[insolar.JetID]int64{jet0: 4, jet1: 2},
+			merged:    []insolar.JetID{jet0, jet1},
+		},
+	}
+	for i, exp := range expected {
+		previous := initialPulse + insolar.PulseNumber(i) - 1
+		ended := previous + 1
+		newPulse := ended + 1
+		pulseCalc.BackwardsMock.Return(insolar.Pulse{PulseNumber: previous}, nil)
+
+		gotJets, err := splitter.Do(ctx, ended, newPulse, jetStore.All(ctx, ended), true)
+		require.NoError(t, err)
+		require.Equal(t, jsort(exp.jets), jsort(gotJets), "jets on +%v pulse", i)
+		require.Equal(t, jsort(exp.jets), jsort(jetStore.All(ctx, newPulse)), "jet tree on +%v pulse", i)
+
+		for jetID, underflow := range exp.underflow {
+			block, err := db.ForPulse(ctx, jetID, ended)
+			require.NoError(t, err)
+			assert.Equal(t, underflow, block.MergeThresholdUnderflow, "underflow of %v on +%v pulse", jetID.DebugString(), i)
+			assert.Equal(t, jetInList(exp.merged, jetID), block.Merge, "merge of %v on +%v pulse", jetID.DebugString(), i)
+		}
+	}
+}
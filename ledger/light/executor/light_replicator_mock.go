@@ -0,0 +1,401 @@
+package executor
+
+// Code generated by http://github.com/gojuno/minimock (dev). DO NOT EDIT.
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/insolar/insolar/insolar"
+)
+
+// LightReplicatorMock implements LightReplicator
+type LightReplicatorMock struct {
+	t minimock.Tester
+
+	funcNotifyAboutPulse          func(ctx context.Context, pn insolar.PulseNumber)
+	inspectFuncNotifyAboutPulse   func(ctx context.Context, pn insolar.PulseNumber)
+	afterNotifyAboutPulseCounter  uint64
+	beforeNotifyAboutPulseCounter uint64
+	NotifyAboutPulseMock          mLightReplicatorMockNotifyAboutPulse
+
+	funcStop          func()
+	inspectFuncStop   func()
+	afterStopCounter  uint64
+	beforeStopCounter uint64
+	StopMock          mLightReplicatorMockStop
+}
+
+// NewLightReplicatorMock returns a mock for LightReplicator
+func NewLightReplicatorMock(t minimock.Tester) *LightReplicatorMock {
+	m := &LightReplicatorMock{t: t}
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.NotifyAboutPulseMock = mLightReplicatorMockNotifyAboutPulse{mock: m}
+	m.NotifyAboutPulseMock.callArgs = []*LightReplicatorMockNotifyAboutPulseParams{}
+
+	m.StopMock = mLightReplicatorMockStop{mock: m}
+
+	return m
+}
+
+type mLightReplicatorMockNotifyAboutPulse struct {
+	mock               *LightReplicatorMock
+	defaultExpectation *LightReplicatorMockNotifyAboutPulseExpectation
+	expectations       []*LightReplicatorMockNotifyAboutPulseExpectation
+
+	callArgs []*LightReplicatorMockNotifyAboutPulseParams
+	mutex    sync.RWMutex
+}
+
+// LightReplicatorMockNotifyAboutPulseExpectation specifies expectation struct of the LightReplicator.NotifyAboutPulse
+type LightReplicatorMockNotifyAboutPulseExpectation struct {
+	mock   *LightReplicatorMock
+	params *LightReplicatorMockNotifyAboutPulseParams
+
+	Counter uint64
+}
+
+// LightReplicatorMockNotifyAboutPulseParams contains parameters of the LightReplicator.NotifyAboutPulse
+type LightReplicatorMockNotifyAboutPulseParams struct {
+	ctx context.Context
+	pn  insolar.PulseNumber
+}
+
+// Expect sets up expected params for LightReplicator.NotifyAboutPulse
+func (mmNotifyAboutPulse *mLightReplicatorMockNotifyAboutPulse) Expect(ctx context.Context, pn insolar.PulseNumber) *mLightReplicatorMockNotifyAboutPulse {
+	if mmNotifyAboutPulse.mock.funcNotifyAboutPulse != nil {
+		mmNotifyAboutPulse.mock.t.Fatalf("LightReplicatorMock.NotifyAboutPulse mock is already set by Set")
+	}
+
+	if mmNotifyAboutPulse.defaultExpectation == nil {
+		mmNotifyAboutPulse.defaultExpectation = &LightReplicatorMockNotifyAboutPulseExpectation{}
+	}
+
+	mmNotifyAboutPulse.defaultExpectation.params = &LightReplicatorMockNotifyAboutPulseParams{ctx, pn}
+	for _, e := range mmNotifyAboutPulse.expectations {
+		if minimock.Equal(e.params, mmNotifyAboutPulse.defaultExpectation.params) {
+			mmNotifyAboutPulse.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmNotifyAboutPulse.defaultExpectation.params)
+		}
+	}
+
+	return mmNotifyAboutPulse
+}
+
+// Inspect accepts an inspector function that has same arguments as the LightReplicator.NotifyAboutPulse
+func (mmNotifyAboutPulse *mLightReplicatorMockNotifyAboutPulse) Inspect(f func(ctx context.Context, pn insolar.PulseNumber)) *mLightReplicatorMockNotifyAboutPulse {
+	if mmNotifyAboutPulse.mock.inspectFuncNotifyAboutPulse != nil {
+		mmNotifyAboutPulse.mock.t.Fatalf("Inspect function is already set for LightReplicatorMock.NotifyAboutPulse")
+	}
+
+	mmNotifyAboutPulse.mock.inspectFuncNotifyAboutPulse = f
+
+	return mmNotifyAboutPulse
+}
+
+// Return sets up results that will be returned by LightReplicator.NotifyAboutPulse
+func (mmNotifyAboutPulse *mLightReplicatorMockNotifyAboutPulse) Return() *LightReplicatorMock {
+	if mmNotifyAboutPulse.mock.funcNotifyAboutPulse != nil {
+		mmNotifyAboutPulse.mock.t.Fatalf("LightReplicatorMock.NotifyAboutPulse mock is already set by Set")
+	}
+
+	if mmNotifyAboutPulse.defaultExpectation == nil {
+		mmNotifyAboutPulse.defaultExpectation = &LightReplicatorMockNotifyAboutPulseExpectation{mock: mmNotifyAboutPulse.mock}
+	}
+
+	return mmNotifyAboutPulse.mock
+}
+
+// Set uses given function f to mock the LightReplicator.NotifyAboutPulse method
+func (mmNotifyAboutPulse *mLightReplicatorMockNotifyAboutPulse) Set(f func(ctx context.Context, pn insolar.PulseNumber)) *LightReplicatorMock {
+	if mmNotifyAboutPulse.defaultExpectation != nil {
+		mmNotifyAboutPulse.mock.t.Fatalf("Default expectation is already set for the LightReplicator.NotifyAboutPulse method")
+	}
+
+	if len(mmNotifyAboutPulse.expectations) > 0 {
+		mmNotifyAboutPulse.mock.t.Fatalf("Some expectations are already set for the LightReplicator.NotifyAboutPulse method")
+	}
+
+	mmNotifyAboutPulse.mock.funcNotifyAboutPulse = f
+	return mmNotifyAboutPulse.mock
+}
+
+// NotifyAboutPulse implements LightReplicator
+func (mmNotifyAboutPulse *LightReplicatorMock) NotifyAboutPulse(ctx context.Context, pn insolar.PulseNumber) {
+	mm_atomic.AddUint64(&mmNotifyAboutPulse.beforeNotifyAboutPulseCounter, 1)
+	defer mm_atomic.AddUint64(&mmNotifyAboutPulse.afterNotifyAboutPulseCounter, 1)
+
+	if mmNotifyAboutPulse.inspectFuncNotifyAboutPulse != nil {
+		mmNotifyAboutPulse.inspectFuncNotifyAboutPulse(ctx, pn)
+	}
+
+	mm_params := &LightReplicatorMockNotifyAboutPulseParams{ctx, pn}
+
+	// Record call args
+	mmNotifyAboutPulse.NotifyAboutPulseMock.mutex.Lock()
+	mmNotifyAboutPulse.NotifyAboutPulseMock.callArgs = append(mmNotifyAboutPulse.NotifyAboutPulseMock.callArgs, mm_params)
+	mmNotifyAboutPulse.NotifyAboutPulseMock.mutex.Unlock()
+
+	for _, e := range mmNotifyAboutPulse.NotifyAboutPulseMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return
+		}
+	}
+
+	if mmNotifyAboutPulse.NotifyAboutPulseMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmNotifyAboutPulse.NotifyAboutPulseMock.defaultExpectation.Counter, 1)
+		mm_want := mmNotifyAboutPulse.NotifyAboutPulseMock.defaultExpectation.params
+		mm_got := LightReplicatorMockNotifyAboutPulseParams{ctx, pn}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmNotifyAboutPulse.t.Errorf("LightReplicatorMock.NotifyAboutPulse got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		return
+
+	}
+	if mmNotifyAboutPulse.funcNotifyAboutPulse != nil {
+		mmNotifyAboutPulse.funcNotifyAboutPulse(ctx, pn)
+		return
+	}
+	mmNotifyAboutPulse.t.Fatalf("Unexpected call to LightReplicatorMock.NotifyAboutPulse. %v %v", ctx, pn)
+
+}
+
+// NotifyAboutPulseAfterCounter returns a count of finished LightReplicatorMock.NotifyAboutPulse invocations
+func (mmNotifyAboutPulse *LightReplicatorMock) NotifyAboutPulseAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmNotifyAboutPulse.afterNotifyAboutPulseCounter)
+}
+
+// NotifyAboutPulseBeforeCounter returns a count of LightReplicatorMock.NotifyAboutPulse invocations
+func (mmNotifyAboutPulse *LightReplicatorMock) NotifyAboutPulseBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmNotifyAboutPulse.beforeNotifyAboutPulseCounter)
+}
+
+// Calls returns a list of arguments used in each call to LightReplicatorMock.NotifyAboutPulse.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmNotifyAboutPulse *mLightReplicatorMockNotifyAboutPulse) Calls() []*LightReplicatorMockNotifyAboutPulseParams {
+	mmNotifyAboutPulse.mutex.RLock()
+
+	argCopy := make([]*LightReplicatorMockNotifyAboutPulseParams, len(mmNotifyAboutPulse.callArgs))
+	copy(argCopy, mmNotifyAboutPulse.callArgs)
+
+	mmNotifyAboutPulse.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockNotifyAboutPulseDone returns true if the count of the NotifyAboutPulse invocations corresponds
+// the number of defined expectations
+func (m *LightReplicatorMock) MinimockNotifyAboutPulseDone() bool {
+	for _, e := range m.NotifyAboutPulseMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.NotifyAboutPulseMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterNotifyAboutPulseCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcNotifyAboutPulse != nil && mm_atomic.LoadUint64(&m.afterNotifyAboutPulseCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockNotifyAboutPulseInspect logs each unmet expectation
+func (m *LightReplicatorMock) MinimockNotifyAboutPulseInspect() {
+	for _, e := range m.NotifyAboutPulseMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to LightReplicatorMock.NotifyAboutPulse with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.NotifyAboutPulseMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterNotifyAboutPulseCounter) < 1 {
+		if m.NotifyAboutPulseMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to LightReplicatorMock.NotifyAboutPulse")
+		} else {
+			m.t.Errorf("Expected call to LightReplicatorMock.NotifyAboutPulse with params: %#v", *m.NotifyAboutPulseMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcNotifyAboutPulse != nil && mm_atomic.LoadUint64(&m.afterNotifyAboutPulseCounter) < 1 {
+		m.t.Error("Expected call to LightReplicatorMock.NotifyAboutPulse")
+	}
+}
+
+type mLightReplicatorMockStop struct {
+	mock               *LightReplicatorMock
+	defaultExpectation *LightReplicatorMockStopExpectation
+	expectations       []*LightReplicatorMockStopExpectation
+}
+
+// LightReplicatorMockStopExpectation specifies expectation struct of the LightReplicator.Stop
+type LightReplicatorMockStopExpectation struct {
+	mock *LightReplicatorMock
+
+	Counter uint64
+}
+
+// Expect sets up expected params for LightReplicator.Stop
+func (mmStop *mLightReplicatorMockStop) Expect() *mLightReplicatorMockStop {
+	if mmStop.mock.funcStop != nil {
+		mmStop.mock.t.Fatalf("LightReplicatorMock.Stop mock is already set by Set")
+	}
+
+	if mmStop.defaultExpectation == nil {
+		mmStop.defaultExpectation = &LightReplicatorMockStopExpectation{}
+	}
+
+	return mmStop
+}
+
+// Inspect accepts an inspector function that has same arguments as the LightReplicator.Stop
+func (mmStop *mLightReplicatorMockStop) Inspect(f func()) *mLightReplicatorMockStop {
+	if mmStop.mock.inspectFuncStop != nil {
+		mmStop.mock.t.Fatalf("Inspect function is already set for LightReplicatorMock.Stop")
+	}
+
+	mmStop.mock.inspectFuncStop = f
+
+	return mmStop
+}
+
+// Return sets up results that will be returned by LightReplicator.Stop
+func (mmStop *mLightReplicatorMockStop) Return() *LightReplicatorMock {
+	if mmStop.mock.funcStop != nil {
+		mmStop.mock.t.Fatalf("LightReplicatorMock.Stop mock is already set by Set")
+	}
+
+	if mmStop.defaultExpectation == nil {
+		mmStop.defaultExpectation = &LightReplicatorMockStopExpectation{mock: mmStop.mock}
+	}
+
+	return mmStop.mock
+}
+
+// Set uses given function f to mock the LightReplicator.Stop method
+func (mmStop *mLightReplicatorMockStop) Set(f func()) *LightReplicatorMock {
+	if mmStop.defaultExpectation != nil {
+		mmStop.mock.t.Fatalf("Default expectation is already set for the LightReplicator.Stop method")
+	}
+
+	if len(mmStop.expectations) > 0 {
+		mmStop.mock.t.Fatalf("Some expectations are already set for the LightReplicator.Stop method")
+	}
+
+	mmStop.mock.funcStop = f
+	return mmStop.mock
+}
+
+// Stop implements LightReplicator
+func (mmStop *LightReplicatorMock) Stop() {
+	mm_atomic.AddUint64(&mmStop.beforeStopCounter, 1)
+	defer mm_atomic.AddUint64(&mmStop.afterStopCounter, 1)
+
+	if mmStop.inspectFuncStop != nil {
+		mmStop.inspectFuncStop()
+	}
+
+	if mmStop.StopMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmStop.StopMock.defaultExpectation.Counter, 1)
+
+		return
+
+	}
+	if mmStop.funcStop != nil {
+		mmStop.funcStop()
+		return
+	}
+	mmStop.t.Fatalf("Unexpected call to LightReplicatorMock.Stop.")
+
+}
+
+// StopAfterCounter returns a count of finished LightReplicatorMock.Stop invocations
+func (mmStop *LightReplicatorMock) StopAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmStop.afterStopCounter)
+}
+
+// StopBeforeCounter returns a count of LightReplicatorMock.Stop invocations
+func (mmStop *LightReplicatorMock) StopBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmStop.beforeStopCounter)
+}
+
+// MinimockStopDone returns true if the count of the Stop invocations corresponds
+// the number of defined expectations
+func (m *LightReplicatorMock) MinimockStopDone() bool {
+	for _, e := range m.StopMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.StopMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterStopCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcStop != nil && mm_atomic.LoadUint64(&m.afterStopCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockStopInspect logs each unmet expectation
+func (m *LightReplicatorMock) MinimockStopInspect() {
+	for _, e := range m.StopMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Error("Expected call to LightReplicatorMock.Stop")
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.StopMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterStopCounter) < 1 {
+		m.t.Error("Expected call to LightReplicatorMock.Stop")
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcStop != nil && mm_atomic.LoadUint64(&m.afterStopCounter) < 1 {
+		m.t.Error("Expected call to LightReplicatorMock.Stop")
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *LightReplicatorMock) MinimockFinish() {
+	if !m.minimockDone() {
+		m.MinimockNotifyAboutPulseInspect()
+
+		m.MinimockStopInspect()
+		m.t.FailNow()
+	}
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *LightReplicatorMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *LightReplicatorMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockNotifyAboutPulseDone() &&
+		m.MinimockStopDone()
+}
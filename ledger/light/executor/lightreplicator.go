@@ -7,6 +7,7 @@ package executor
 
 import (
 	"context"
+	"fmt"
 	"sync"
 
 	"github.com/insolar/insolar/insolar"
@@ -24,6 +25,8 @@ import (
 	"go.opencensus.io/stats"
 )
 
+//go:generate minimock -i github.com/insolar/insolar/ledger/light/executor.LightReplicator -o ./ -s _mock.go -g
+
 // LightReplicator is a base interface for a sync component
 type LightReplicator interface {
 	// NotifyAboutPulse is method for notifying a sync component about new pulse
@@ -47,8 +50,13 @@ type LightReplicatorDefault struct {
 	recsAccessor object.RecordCollectionAccessor
 	idxAccessor  object.MemoryIndexAccessor
 	jetAccessor  jet.Accessor
+	wal          WriteAheadLog
 
 	syncWaitingPulses chan insolar.PulseNumber
+
+	pulseLock       sync.Mutex
+	notifiedPulse   insolar.PulseNumber
+	replicatedPulse insolar.PulseNumber
 }
 
 // NewReplicatorDefault creates new instance of LightReplicator
@@ -61,6 +69,7 @@ func NewReplicatorDefault(
 	recsAccessor object.RecordCollectionAccessor,
 	idxAccessor object.MemoryIndexAccessor,
 	jetAccessor jet.Accessor,
+	wal WriteAheadLog,
 ) *LightReplicatorDefault {
 	return &LightReplicatorDefault{
 		jetCalculator:   jetCalculator,
@@ -72,6 +81,7 @@ func NewReplicatorDefault(
 		recsAccessor: recsAccessor,
 		idxAccessor:  idxAccessor,
 		jetAccessor:  jetAccessor,
+		wal:          wal,
 
 		syncWaitingPulses: make(chan insolar.PulseNumber),
 		done:              make(chan struct{}),
@@ -99,9 +109,23 @@ func (lr *LightReplicatorDefault) NotifyAboutPulse(ctx context.Context, pn insol
 	}
 
 	logger.Debugf("[Replicator][NotifyAboutPulse] start replication, pulse - %v", prevPN.PulseNumber)
+	lr.pulseLock.Lock()
+	lr.notifiedPulse = prevPN.PulseNumber
+	lr.pulseLock.Unlock()
 	lr.syncWaitingPulses <- prevPN.PulseNumber
 }
 
+// PendingWork implements insolar.Drainer. Data of notified pulse is pending until it is replicated to heavy.
+func (lr *LightReplicatorDefault) PendingWork(ctx context.Context) string {
+	lr.pulseLock.Lock()
+	defer lr.pulseLock.Unlock()
+
+	if lr.notifiedPulse > lr.replicatedPulse {
+		return fmt.Sprintf("light replicator hasn't replicated pulse %d", lr.notifiedPulse)
+	}
+	return ""
+}
+
 func (lr *LightReplicatorDefault) Stop() {
 	close(lr.done)
 }
@@ -141,8 +165,16 @@ func (lr *LightReplicatorDefault) sync(ctx context.Context) {
 				logger.Debugf("[Replicator][sync] Data has been sent to a heavy. pn - %v, jetID - %v", msg.Pulse, msg.JetID.DebugString())
 			}
 		}
+		err = lr.wal.Replicated(ctx, pn)
+		if err != nil {
+			logger.Error(errors.Wrap(err, "failed to mark pulse as replicated in WAL"))
+		}
 		go lr.cleaner.NotifyAboutPulse(ctx, pn)
 
+		lr.pulseLock.Lock()
+		lr.replicatedPulse = pn
+		lr.pulseLock.Unlock()
+
 		stats.Record(ctx, statLastReplicatedPulse.M(int64(pn)))
 	}
 
@@ -102,10 +102,19 @@ func Test_NotifyAboutPulse(t *testing.T) {
 		recordAccessor,
 		indexAccessor,
 		jetAccessor,
+		NewWriteAheadLogMock(mc).ReplicatedMock.Set(func(_ context.Context, pn insolar.PulseNumber) error {
+			require.Equal(t, expectPN, pn)
+			return nil
+		}),
 	)
 	defer close(r.syncWaitingPulses)
+	require.Empty(t, r.PendingWork(ctx))
 
 	r.NotifyAboutPulse(ctx, expectPN+1)
 	mc.Wait(time.Minute)
 	mc.Finish()
+
+	require.Eventually(t, func() bool {
+		return r.PendingWork(ctx) == ""
+	}, time.Minute, time.Millisecond, "replicated pulse isn't pending")
 }
@@ -29,6 +29,7 @@ var (
 
 	statJets      = stats.Int64("jets", "jets counter", stats.UnitDimensionless)
 	statJetSplits = stats.Int64("jet_splits", "jet splits counter", stats.UnitDimensionless)
+	statJetMerges = stats.Int64("jet_merges", "jet merges counter", stats.UnitDimensionless)
 
 	statDrop        = stats.Int64("drops", "How many drop records have created", stats.UnitDimensionless)
 	statDropRecords = stats.Int64("drop_records", "Amount of records in drop", stats.UnitDimensionless)
@@ -92,6 +93,12 @@ func init() {
 			Measure:     statJetSplits,
 			Aggregation: view.Sum(),
 		},
+		&view.View{
+			Name:        "jet_merges_total",
+			Description: "how many jet merges performed",
+			Measure:     statJetMerges,
+			Aggregation: view.Sum(),
+		},
 
 		&view.View{
 			Name:        "drops_total",
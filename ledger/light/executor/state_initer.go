@@ -36,6 +36,21 @@ type StateIniter interface {
 	PrepareState(ctx context.Context, pulse insolar.PulseNumber) (justJoined bool, jets []insolar.JetID, err error)
 }
 
+//go:generate minimock -i github.com/insolar/insolar/ledger/light/executor.WriteAheadLog -o ./ -s _mock.go -g
+
+// WriteAheadLog keeps light data on disk until it's cleaned, so light can continue its chain after restart.
+type WriteAheadLog interface {
+	// LatestPulse returns the latest pulse written to the log. It returns insolarPulse.ErrNotFound for empty log.
+	LatestPulse(ctx context.Context) (insolar.Pulse, error)
+	// Discard removes data of the log, that can't be restored.
+	Discard(ctx context.Context) error
+	// Restore fills storages with data, that was written before restart.
+	// It returns restored pulses in ascending order and the latest pulse, that was replicated to heavy.
+	Restore(ctx context.Context) (pulses []insolar.PulseNumber, replicated insolar.PulseNumber, err error)
+	// Replicated marks data of the pulse as replicated to heavy.
+	Replicated(ctx context.Context, pn insolar.PulseNumber) error
+}
+
 const timeout = 10 * time.Second
 
 // NewStateIniter creates StateIniterDefault with all required components.
@@ -50,6 +65,8 @@ func NewStateIniter(
 	pulseAccessor insolarPulse.Accessor,
 	calc JetCalculator,
 	indexes object.MemoryIndexModifier,
+	wal WriteAheadLog,
+	replicator LightReplicator,
 ) *StateIniterDefault {
 	return &StateIniterDefault{
 		lightChainLimit: lightChainLimit,
@@ -62,6 +79,8 @@ func NewStateIniter(
 		pulseAccessor:   pulseAccessor,
 		jetCalculator:   calc,
 		indexes:         indexes,
+		wal:             wal,
+		replicator:      replicator,
 		backoff: backoff.Backoff{
 			Factor: 2,
 			Jitter: true,
@@ -84,6 +103,8 @@ type StateIniterDefault struct {
 	jetCalculator   JetCalculator
 	backoff         backoff.Backoff
 	indexes         object.MemoryIndexModifier
+	wal             WriteAheadLog
+	replicator      LightReplicator
 }
 
 func (s *StateIniterDefault) PrepareState(
@@ -107,6 +128,14 @@ func (s *StateIniterDefault) PrepareState(
 		return false, nil, errors.Wrap(err, "failed to fetch latest pulse")
 	}
 
+	myJets, restored, err := s.restore(ctx, forPulse)
+	if err != nil {
+		return false, nil, errors.Wrap(err, "failed to restore state from WAL")
+	}
+	if restored {
+		return false, myJets, nil
+	}
+
 	heavy, err := s.heavy(forPulse)
 	if err != nil {
 		return false, nil, err
@@ -136,6 +165,69 @@ func (s *StateIniterDefault) PrepareState(
 	return true, jets, nil
 }
 
+// restore continues the light chain, that was written to WAL before restart. Hot data of the latest restored pulse
+// is already in storages, so its jets are unlocked. Replication of ended pulses starts from the first one,
+// that wasn't replicated, the latest restored pulse is replicated on pulse change as usual.
+//
+// The chain can be continued only if the latest pulse of WAL directly precedes forPulse. Otherwise other lights
+// have already continued it, so WAL is discarded and light starts as a just joined one.
+func (s *StateIniterDefault) restore(ctx context.Context, forPulse insolar.PulseNumber) ([]insolar.JetID, bool, error) {
+	logger := inslogger.FromContext(ctx)
+
+	latestPulse, err := s.wal.LatestPulse(ctx)
+	if err == insolarPulse.ErrNotFound {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, errors.Wrap(err, "failed to fetch latest pulse")
+	}
+	if latestPulse.NextPulseNumber != forPulse {
+		logger.WithFields(map[string]interface{}{
+			"latest_pulse": latestPulse.PulseNumber,
+			"next_pulse":   latestPulse.NextPulseNumber,
+			"for_pulse":    forPulse,
+		}).Warn("WAL doesn't precede current pulse, it's discarded")
+		return nil, false, errors.Wrap(s.wal.Discard(ctx), "failed to discard WAL")
+	}
+
+	pulses, replicated, err := s.wal.Restore(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(pulses) == 0 {
+		return nil, false, nil
+	}
+
+	latest := pulses[len(pulses)-1]
+	myJets, err := s.jetCalculator.MineForPulse(ctx, latest)
+	if err != nil {
+		return nil, false, errors.Wrap(err, "failed to calculate my jets")
+	}
+	for _, jetID := range myJets {
+		err = s.jetReleaser.Unlock(ctx, latest, jetID)
+		if err != nil {
+			return nil, false, errors.Wrap(err, "failed to unlock jet")
+		}
+	}
+
+	logger.WithFields(map[string]interface{}{
+		"latest_pulse":     latest,
+		"replicated_pulse": replicated,
+		"jets":             insolar.JetIDCollection(myJets).DebugString(),
+	}).Info("light state is restored from WAL")
+
+	// Replicator replicates the pulse before the notified one. It waits for replication of the previous pulse
+	// on notification, so pulses are notified in background to not block pulse change.
+	go func() {
+		for i := 1; i < len(pulses); i++ {
+			if pulses[i-1] > replicated {
+				s.replicator.NotifyAboutPulse(ctx, pulses[i])
+			}
+		}
+	}()
+	return myJets, true, nil
+}
+
 func (s *StateIniterDefault) heavy(pn insolar.PulseNumber) (insolar.Reference, error) {
 	candidates, err := s.nodes.InRole(pn, insolar.StaticRoleHeavyMaterial)
 	if err != nil {
@@ -196,6 +288,11 @@ func (s *StateIniterDefault) loadStateRetry(
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to append pulse")
 	}
+	// Data of the pulse is received from heavy, so it mustn't be replicated after restart.
+	err = s.wal.Replicated(ctx, prevPulse.PulseNumber)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to mark pulse as replicated")
+	}
 
 	inslogger.FromContext(ctx).WithFields(map[string]interface{}{
 		"jets":          insolar.JetIDCollection(state.JetIDs).DebugString(),
@@ -236,7 +333,10 @@ func (s *StateIniterDefault) loadStateRetry(
 	}
 
 	for _, idx := range state.Indexes {
-		s.indexes.Set(ctx, pn, idx)
+		err = s.indexes.Set(ctx, pn, idx)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to set index")
+		}
 		stats.Record(ctx, StatRequestsOpened.M(int64(idx.Lifeline.OpenRequestsCount)))
 	}
 
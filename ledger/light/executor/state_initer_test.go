@@ -6,6 +6,8 @@
 package executor_test
 
 import (
+	"context"
+	"sync"
 	"testing"
 
 	"github.com/ThreeDotsLabs/watermill/message"
@@ -41,6 +43,8 @@ func TestStateIniterDefault_PrepareState(t *testing.T) {
 		pulseAccessor *insolarPulse.AccessorMock
 		jetCalculator *executor.JetCalculatorMock
 		indexes       *object.MemoryIndexModifierMock
+		wal           *executor.WriteAheadLogMock
+		replicator    *executor.LightReplicatorMock
 	)
 
 	setup := func() {
@@ -53,6 +57,8 @@ func TestStateIniterDefault_PrepareState(t *testing.T) {
 		pulseAccessor = insolarPulse.NewAccessorMock(mc)
 		jetCalculator = executor.NewJetCalculatorMock(mc)
 		indexes = object.NewMemoryIndexModifierMock(mc)
+		wal = executor.NewWriteAheadLogMock(mc)
+		replicator = executor.NewLightReplicatorMock(mc)
 	}
 
 	t.Run("wrong pulse", func(t *testing.T) {
@@ -70,6 +76,8 @@ func TestStateIniterDefault_PrepareState(t *testing.T) {
 			pulseAccessor,
 			jetCalculator,
 			indexes,
+			wal,
+			replicator,
 		)
 
 		_, _, err := s.PrepareState(ctx, pulse.MinTimePulse/2)
@@ -92,6 +100,8 @@ func TestStateIniterDefault_PrepareState(t *testing.T) {
 			pulseAccessor.LatestMock.Return(insolar.Pulse{}, insolarPulse.ErrNotFound),
 			jetCalculator,
 			indexes,
+			wal.LatestPulseMock.Return(insolar.Pulse{}, insolarPulse.ErrNotFound),
+			replicator,
 		)
 
 		justAdded, jetsReturned, err := s.PrepareState(ctx, pulse.MinTimePulse)
@@ -116,6 +126,8 @@ func TestStateIniterDefault_PrepareState(t *testing.T) {
 			pulseAccessor.LatestMock.Return(insolar.Pulse{PulseNumber: pulse.MinTimePulse + 10}, nil),
 			jetCalculator.MineForPulseMock.Return(jets, nil),
 			indexes,
+			wal,
+			replicator,
 		)
 
 		justAdded, jetsReturned, err := s.PrepareState(ctx, pulse.MinTimePulse)
@@ -148,6 +160,8 @@ func TestStateIniterDefault_PrepareState(t *testing.T) {
 			pulseAccessor.LatestMock.Return(insolar.Pulse{}, insolarPulse.ErrNotFound),
 			jetCalculator,
 			indexes,
+			wal.LatestPulseMock.Return(insolar.Pulse{}, insolarPulse.ErrNotFound),
+			replicator,
 		)
 
 		justAdded, jetsReturned, err := s.PrepareState(ctx, pulse.MinTimePulse)
@@ -180,6 +194,8 @@ func TestStateIniterDefault_PrepareState(t *testing.T) {
 			pulseAccessor.LatestMock.Return(insolar.Pulse{}, insolarPulse.ErrNotFound),
 			jetCalculator,
 			indexes,
+			wal.LatestPulseMock.Return(insolar.Pulse{}, insolarPulse.ErrNotFound),
+			replicator,
 		)
 
 		// configuration mismatch: LightChainLimit: from heavy 10, from light 5
@@ -224,6 +240,8 @@ func TestStateIniterDefault_PrepareState(t *testing.T) {
 			pulseAccessor.LatestMock.Return(insolar.Pulse{}, insolarPulse.ErrNotFound),
 			jetCalculator,
 			indexes,
+			wal.LatestPulseMock.Return(insolar.Pulse{}, insolarPulse.ErrNotFound).ReplicatedMock.Return(nil),
+			replicator,
 		)
 
 		justAdded, jetsReturned, err := s.PrepareState(ctx, pulse.MinTimePulse+10)
@@ -231,4 +249,74 @@ func TestStateIniterDefault_PrepareState(t *testing.T) {
 		assert.Equal(t, jets, jetsReturned)
 		assert.True(t, justAdded)
 	})
+
+	t.Run("restoring from WAL", func(t *testing.T) {
+		setup()
+		defer mc.Finish()
+
+		pulses := []insolar.PulseNumber{pulse.MinTimePulse, pulse.MinTimePulse + 10, pulse.MinTimePulse + 20, pulse.MinTimePulse + 30}
+		jets := []insolar.JetID{gen.JetID(), gen.JetID()}
+
+		var notified sync.WaitGroup
+		notified.Add(2)
+		s := executor.NewStateIniter(
+			0,
+			jetModifier,
+			jetReleaser.UnlockMock.Set(func(_ context.Context, pn insolar.PulseNumber, _ insolar.JetID) error {
+				require.Equal(t, pulses[3], pn)
+				return nil
+			}),
+			drops,
+			nodes,
+			sender,
+			pulseAppender,
+			pulseAccessor.LatestMock.Return(insolar.Pulse{}, insolarPulse.ErrNotFound),
+			jetCalculator.MineForPulseMock.Expect(ctx, pulses[3]).Return(jets, nil),
+			indexes,
+			wal.LatestPulseMock.Return(insolar.Pulse{PulseNumber: pulses[3], NextPulseNumber: pulses[3] + 10}, nil).
+				RestoreMock.Return(pulses, pulses[0], nil),
+			replicator.NotifyAboutPulseMock.Set(func(_ context.Context, pn insolar.PulseNumber) {
+				// pulses[0] is replicated, pulses[3] is replicated on the next pulse change
+				require.Contains(t, pulses[2:4], pn)
+				notified.Done()
+			}),
+		)
+
+		justAdded, jetsReturned, err := s.PrepareState(ctx, pulses[3]+10)
+		require.NoError(t, err)
+		assert.Equal(t, jets, jetsReturned)
+		assert.False(t, justAdded)
+		assert.Equal(t, uint64(2), jetReleaser.UnlockAfterCounter())
+
+		notified.Wait()
+		assert.Equal(t, uint64(2), replicator.NotifyAboutPulseAfterCounter())
+	})
+
+	t.Run("WAL doesn't precede current pulse", func(t *testing.T) {
+		setup()
+		defer mc.Finish()
+
+		pn := insolar.PulseNumber(pulse.MinTimePulse + 30)
+		s := executor.NewStateIniter(
+			0,
+			jetModifier,
+			jetReleaser,
+			drops,
+			nodes.InRoleMock.Return(nil, nil),
+			sender,
+			pulseAppender,
+			pulseAccessor.LatestMock.Return(insolar.Pulse{}, insolarPulse.ErrNotFound),
+			jetCalculator,
+			indexes,
+			wal.LatestPulseMock.Return(insolar.Pulse{PulseNumber: pn - 20, NextPulseNumber: pn - 10}, nil).
+				DiscardMock.Return(nil),
+			replicator,
+		)
+
+		// WAL is discarded and light fetches its state from heavy
+		_, _, err := s.PrepareState(ctx, pn)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to calculate heavy node for pulse")
+		assert.Equal(t, uint64(1), wal.DiscardAfterCounter())
+	})
 }
@@ -0,0 +1,967 @@
+package executor
+
+// Code generated by http://github.com/gojuno/minimock (dev). DO NOT EDIT.
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/insolar/insolar/insolar"
+)
+
+// WriteAheadLogMock implements WriteAheadLog
+type WriteAheadLogMock struct {
+	t minimock.Tester
+
+	funcDiscard          func(ctx context.Context) (err error)
+	inspectFuncDiscard   func(ctx context.Context)
+	afterDiscardCounter  uint64
+	beforeDiscardCounter uint64
+	DiscardMock          mWriteAheadLogMockDiscard
+
+	funcLatestPulse          func(ctx context.Context) (p1 insolar.Pulse, err error)
+	inspectFuncLatestPulse   func(ctx context.Context)
+	afterLatestPulseCounter  uint64
+	beforeLatestPulseCounter uint64
+	LatestPulseMock          mWriteAheadLogMockLatestPulse
+
+	funcReplicated          func(ctx context.Context, pn insolar.PulseNumber) (err error)
+	inspectFuncReplicated   func(ctx context.Context, pn insolar.PulseNumber)
+	afterReplicatedCounter  uint64
+	beforeReplicatedCounter uint64
+	ReplicatedMock          mWriteAheadLogMockReplicated
+
+	funcRestore          func(ctx context.Context) (pulses []insolar.PulseNumber, replicated insolar.PulseNumber, err error)
+	inspectFuncRestore   func(ctx context.Context)
+	afterRestoreCounter  uint64
+	beforeRestoreCounter uint64
+	RestoreMock          mWriteAheadLogMockRestore
+}
+
+// NewWriteAheadLogMock returns a mock for WriteAheadLog
+func NewWriteAheadLogMock(t minimock.Tester) *WriteAheadLogMock {
+	m := &WriteAheadLogMock{t: t}
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.DiscardMock = mWriteAheadLogMockDiscard{mock: m}
+	m.DiscardMock.callArgs = []*WriteAheadLogMockDiscardParams{}
+
+	m.LatestPulseMock = mWriteAheadLogMockLatestPulse{mock: m}
+	m.LatestPulseMock.callArgs = []*WriteAheadLogMockLatestPulseParams{}
+
+	m.ReplicatedMock = mWriteAheadLogMockReplicated{mock: m}
+	m.ReplicatedMock.callArgs = []*WriteAheadLogMockReplicatedParams{}
+
+	m.RestoreMock = mWriteAheadLogMockRestore{mock: m}
+	m.RestoreMock.callArgs = []*WriteAheadLogMockRestoreParams{}
+
+	return m
+}
+
+type mWriteAheadLogMockDiscard struct {
+	mock               *WriteAheadLogMock
+	defaultExpectation *WriteAheadLogMockDiscardExpectation
+	expectations       []*WriteAheadLogMockDiscardExpectation
+
+	callArgs []*WriteAheadLogMockDiscardParams
+	mutex    sync.RWMutex
+}
+
+// WriteAheadLogMockDiscardExpectation specifies expectation struct of the WriteAheadLog.Discard
+type WriteAheadLogMockDiscardExpectation struct {
+	mock    *WriteAheadLogMock
+	params  *WriteAheadLogMockDiscardParams
+	results *WriteAheadLogMockDiscardResults
+	Counter uint64
+}
+
+// WriteAheadLogMockDiscardParams contains parameters of the WriteAheadLog.Discard
+type WriteAheadLogMockDiscardParams struct {
+	ctx context.Context
+}
+
+// WriteAheadLogMockDiscardResults contains results of the WriteAheadLog.Discard
+type WriteAheadLogMockDiscardResults struct {
+	err error
+}
+
+// Expect sets up expected params for WriteAheadLog.Discard
+func (mmDiscard *mWriteAheadLogMockDiscard) Expect(ctx context.Context) *mWriteAheadLogMockDiscard {
+	if mmDiscard.mock.funcDiscard != nil {
+		mmDiscard.mock.t.Fatalf("WriteAheadLogMock.Discard mock is already set by Set")
+	}
+
+	if mmDiscard.defaultExpectation == nil {
+		mmDiscard.defaultExpectation = &WriteAheadLogMockDiscardExpectation{}
+	}
+
+	mmDiscard.defaultExpectation.params = &WriteAheadLogMockDiscardParams{ctx}
+	for _, e := range mmDiscard.expectations {
+		if minimock.Equal(e.params, mmDiscard.defaultExpectation.params) {
+			mmDiscard.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDiscard.defaultExpectation.params)
+		}
+	}
+
+	return mmDiscard
+}
+
+// Inspect accepts an inspector function that has same arguments as the WriteAheadLog.Discard
+func (mmDiscard *mWriteAheadLogMockDiscard) Inspect(f func(ctx context.Context)) *mWriteAheadLogMockDiscard {
+	if mmDiscard.mock.inspectFuncDiscard != nil {
+		mmDiscard.mock.t.Fatalf("Inspect function is already set for WriteAheadLogMock.Discard")
+	}
+
+	mmDiscard.mock.inspectFuncDiscard = f
+
+	return mmDiscard
+}
+
+// Return sets up results that will be returned by WriteAheadLog.Discard
+func (mmDiscard *mWriteAheadLogMockDiscard) Return(err error) *WriteAheadLogMock {
+	if mmDiscard.mock.funcDiscard != nil {
+		mmDiscard.mock.t.Fatalf("WriteAheadLogMock.Discard mock is already set by Set")
+	}
+
+	if mmDiscard.defaultExpectation == nil {
+		mmDiscard.defaultExpectation = &WriteAheadLogMockDiscardExpectation{mock: mmDiscard.mock}
+	}
+	mmDiscard.defaultExpectation.results = &WriteAheadLogMockDiscardResults{err}
+	return mmDiscard.mock
+}
+
+// Set uses given function f to mock the WriteAheadLog.Discard method
+func (mmDiscard *mWriteAheadLogMockDiscard) Set(f func(ctx context.Context) (err error)) *WriteAheadLogMock {
+	if mmDiscard.defaultExpectation != nil {
+		mmDiscard.mock.t.Fatalf("Default expectation is already set for the WriteAheadLog.Discard method")
+	}
+
+	if len(mmDiscard.expectations) > 0 {
+		mmDiscard.mock.t.Fatalf("Some expectations are already set for the WriteAheadLog.Discard method")
+	}
+
+	mmDiscard.mock.funcDiscard = f
+	return mmDiscard.mock
+}
+
+// When sets expectation for the WriteAheadLog.Discard which will trigger the result defined by the following
+// Then helper
+func (mmDiscard *mWriteAheadLogMockDiscard) When(ctx context.Context) *WriteAheadLogMockDiscardExpectation {
+	if mmDiscard.mock.funcDiscard != nil {
+		mmDiscard.mock.t.Fatalf("WriteAheadLogMock.Discard mock is already set by Set")
+	}
+
+	expectation := &WriteAheadLogMockDiscardExpectation{
+		mock:   mmDiscard.mock,
+		params: &WriteAheadLogMockDiscardParams{ctx},
+	}
+	mmDiscard.expectations = append(mmDiscard.expectations, expectation)
+	return expectation
+}
+
+// Then sets up WriteAheadLog.Discard return parameters for the expectation previously defined by the When method
+func (e *WriteAheadLogMockDiscardExpectation) Then(err error) *WriteAheadLogMock {
+	e.results = &WriteAheadLogMockDiscardResults{err}
+	return e.mock
+}
+
+// Discard implements WriteAheadLog
+func (mmDiscard *WriteAheadLogMock) Discard(ctx context.Context) (err error) {
+	mm_atomic.AddUint64(&mmDiscard.beforeDiscardCounter, 1)
+	defer mm_atomic.AddUint64(&mmDiscard.afterDiscardCounter, 1)
+
+	if mmDiscard.inspectFuncDiscard != nil {
+		mmDiscard.inspectFuncDiscard(ctx)
+	}
+
+	mm_params := &WriteAheadLogMockDiscardParams{ctx}
+
+	// Record call args
+	mmDiscard.DiscardMock.mutex.Lock()
+	mmDiscard.DiscardMock.callArgs = append(mmDiscard.DiscardMock.callArgs, mm_params)
+	mmDiscard.DiscardMock.mutex.Unlock()
+
+	for _, e := range mmDiscard.DiscardMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDiscard.DiscardMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDiscard.DiscardMock.defaultExpectation.Counter, 1)
+		mm_want := mmDiscard.DiscardMock.defaultExpectation.params
+		mm_got := WriteAheadLogMockDiscardParams{ctx}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDiscard.t.Errorf("WriteAheadLogMock.Discard got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDiscard.DiscardMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDiscard.t.Fatal("No results are set for the WriteAheadLogMock.Discard")
+		}
+		return (*mm_results).err
+	}
+	if mmDiscard.funcDiscard != nil {
+		return mmDiscard.funcDiscard(ctx)
+	}
+	mmDiscard.t.Fatalf("Unexpected call to WriteAheadLogMock.Discard. %v", ctx)
+	return
+}
+
+// DiscardAfterCounter returns a count of finished WriteAheadLogMock.Discard invocations
+func (mmDiscard *WriteAheadLogMock) DiscardAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDiscard.afterDiscardCounter)
+}
+
+// DiscardBeforeCounter returns a count of WriteAheadLogMock.Discard invocations
+func (mmDiscard *WriteAheadLogMock) DiscardBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDiscard.beforeDiscardCounter)
+}
+
+// Calls returns a list of arguments used in each call to WriteAheadLogMock.Discard.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDiscard *mWriteAheadLogMockDiscard) Calls() []*WriteAheadLogMockDiscardParams {
+	mmDiscard.mutex.RLock()
+
+	argCopy := make([]*WriteAheadLogMockDiscardParams, len(mmDiscard.callArgs))
+	copy(argCopy, mmDiscard.callArgs)
+
+	mmDiscard.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDiscardDone returns true if the count of the Discard invocations corresponds
+// the number of defined expectations
+func (m *WriteAheadLogMock) MinimockDiscardDone() bool {
+	for _, e := range m.DiscardMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DiscardMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterDiscardCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDiscard != nil && mm_atomic.LoadUint64(&m.afterDiscardCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockDiscardInspect logs each unmet expectation
+func (m *WriteAheadLogMock) MinimockDiscardInspect() {
+	for _, e := range m.DiscardMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to WriteAheadLogMock.Discard with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DiscardMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterDiscardCounter) < 1 {
+		if m.DiscardMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to WriteAheadLogMock.Discard")
+		} else {
+			m.t.Errorf("Expected call to WriteAheadLogMock.Discard with params: %#v", *m.DiscardMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDiscard != nil && mm_atomic.LoadUint64(&m.afterDiscardCounter) < 1 {
+		m.t.Error("Expected call to WriteAheadLogMock.Discard")
+	}
+}
+
+type mWriteAheadLogMockLatestPulse struct {
+	mock               *WriteAheadLogMock
+	defaultExpectation *WriteAheadLogMockLatestPulseExpectation
+	expectations       []*WriteAheadLogMockLatestPulseExpectation
+
+	callArgs []*WriteAheadLogMockLatestPulseParams
+	mutex    sync.RWMutex
+}
+
+// WriteAheadLogMockLatestPulseExpectation specifies expectation struct of the WriteAheadLog.LatestPulse
+type WriteAheadLogMockLatestPulseExpectation struct {
+	mock    *WriteAheadLogMock
+	params  *WriteAheadLogMockLatestPulseParams
+	results *WriteAheadLogMockLatestPulseResults
+	Counter uint64
+}
+
+// WriteAheadLogMockLatestPulseParams contains parameters of the WriteAheadLog.LatestPulse
+type WriteAheadLogMockLatestPulseParams struct {
+	ctx context.Context
+}
+
+// WriteAheadLogMockLatestPulseResults contains results of the WriteAheadLog.LatestPulse
+type WriteAheadLogMockLatestPulseResults struct {
+	p1  insolar.Pulse
+	err error
+}
+
+// Expect sets up expected params for WriteAheadLog.LatestPulse
+func (mmLatestPulse *mWriteAheadLogMockLatestPulse) Expect(ctx context.Context) *mWriteAheadLogMockLatestPulse {
+	if mmLatestPulse.mock.funcLatestPulse != nil {
+		mmLatestPulse.mock.t.Fatalf("WriteAheadLogMock.LatestPulse mock is already set by Set")
+	}
+
+	if mmLatestPulse.defaultExpectation == nil {
+		mmLatestPulse.defaultExpectation = &WriteAheadLogMockLatestPulseExpectation{}
+	}
+
+	mmLatestPulse.defaultExpectation.params = &WriteAheadLogMockLatestPulseParams{ctx}
+	for _, e := range mmLatestPulse.expectations {
+		if minimock.Equal(e.params, mmLatestPulse.defaultExpectation.params) {
+			mmLatestPulse.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmLatestPulse.defaultExpectation.params)
+		}
+	}
+
+	return mmLatestPulse
+}
+
+// Inspect accepts an inspector function that has same arguments as the WriteAheadLog.LatestPulse
+func (mmLatestPulse *mWriteAheadLogMockLatestPulse) Inspect(f func(ctx context.Context)) *mWriteAheadLogMockLatestPulse {
+	if mmLatestPulse.mock.inspectFuncLatestPulse != nil {
+		mmLatestPulse.mock.t.Fatalf("Inspect function is already set for WriteAheadLogMock.LatestPulse")
+	}
+
+	mmLatestPulse.mock.inspectFuncLatestPulse = f
+
+	return mmLatestPulse
+}
+
+// Return sets up results that will be returned by WriteAheadLog.LatestPulse
+func (mmLatestPulse *mWriteAheadLogMockLatestPulse) Return(p1 insolar.Pulse, err error) *WriteAheadLogMock {
+	if mmLatestPulse.mock.funcLatestPulse != nil {
+		mmLatestPulse.mock.t.Fatalf("WriteAheadLogMock.LatestPulse mock is already set by Set")
+	}
+
+	if mmLatestPulse.defaultExpectation == nil {
+		mmLatestPulse.defaultExpectation = &WriteAheadLogMockLatestPulseExpectation{mock: mmLatestPulse.mock}
+	}
+	mmLatestPulse.defaultExpectation.results = &WriteAheadLogMockLatestPulseResults{p1, err}
+	return mmLatestPulse.mock
+}
+
+// Set uses given function f to mock the WriteAheadLog.LatestPulse method
+func (mmLatestPulse *mWriteAheadLogMockLatestPulse) Set(f func(ctx context.Context) (p1 insolar.Pulse, err error)) *WriteAheadLogMock {
+	if mmLatestPulse.defaultExpectation != nil {
+		mmLatestPulse.mock.t.Fatalf("Default expectation is already set for the WriteAheadLog.LatestPulse method")
+	}
+
+	if len(mmLatestPulse.expectations) > 0 {
+		mmLatestPulse.mock.t.Fatalf("Some expectations are already set for the WriteAheadLog.LatestPulse method")
+	}
+
+	mmLatestPulse.mock.funcLatestPulse = f
+	return mmLatestPulse.mock
+}
+
+// When sets expectation for the WriteAheadLog.LatestPulse which will trigger the result defined by the following
+// Then helper
+func (mmLatestPulse *mWriteAheadLogMockLatestPulse) When(ctx context.Context) *WriteAheadLogMockLatestPulseExpectation {
+	if mmLatestPulse.mock.funcLatestPulse != nil {
+		mmLatestPulse.mock.t.Fatalf("WriteAheadLogMock.LatestPulse mock is already set by Set")
+	}
+
+	expectation := &WriteAheadLogMockLatestPulseExpectation{
+		mock:   mmLatestPulse.mock,
+		params: &WriteAheadLogMockLatestPulseParams{ctx},
+	}
+	mmLatestPulse.expectations = append(mmLatestPulse.expectations, expectation)
+	return expectation
+}
+
+// Then sets up WriteAheadLog.LatestPulse return parameters for the expectation previously defined by the When method
+func (e *WriteAheadLogMockLatestPulseExpectation) Then(p1 insolar.Pulse, err error) *WriteAheadLogMock {
+	e.results = &WriteAheadLogMockLatestPulseResults{p1, err}
+	return e.mock
+}
+
+// LatestPulse implements WriteAheadLog
+func (mmLatestPulse *WriteAheadLogMock) LatestPulse(ctx context.Context) (p1 insolar.Pulse, err error) {
+	mm_atomic.AddUint64(&mmLatestPulse.beforeLatestPulseCounter, 1)
+	defer mm_atomic.AddUint64(&mmLatestPulse.afterLatestPulseCounter, 1)
+
+	if mmLatestPulse.inspectFuncLatestPulse != nil {
+		mmLatestPulse.inspectFuncLatestPulse(ctx)
+	}
+
+	mm_params := &WriteAheadLogMockLatestPulseParams{ctx}
+
+	// Record call args
+	mmLatestPulse.LatestPulseMock.mutex.Lock()
+	mmLatestPulse.LatestPulseMock.callArgs = append(mmLatestPulse.LatestPulseMock.callArgs, mm_params)
+	mmLatestPulse.LatestPulseMock.mutex.Unlock()
+
+	for _, e := range mmLatestPulse.LatestPulseMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.p1, e.results.err
+		}
+	}
+
+	if mmLatestPulse.LatestPulseMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmLatestPulse.LatestPulseMock.defaultExpectation.Counter, 1)
+		mm_want := mmLatestPulse.LatestPulseMock.defaultExpectation.params
+		mm_got := WriteAheadLogMockLatestPulseParams{ctx}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmLatestPulse.t.Errorf("WriteAheadLogMock.LatestPulse got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmLatestPulse.LatestPulseMock.defaultExpectation.results
+		if mm_results == nil {
+			mmLatestPulse.t.Fatal("No results are set for the WriteAheadLogMock.LatestPulse")
+		}
+		return (*mm_results).p1, (*mm_results).err
+	}
+	if mmLatestPulse.funcLatestPulse != nil {
+		return mmLatestPulse.funcLatestPulse(ctx)
+	}
+	mmLatestPulse.t.Fatalf("Unexpected call to WriteAheadLogMock.LatestPulse. %v", ctx)
+	return
+}
+
+// LatestPulseAfterCounter returns a count of finished WriteAheadLogMock.LatestPulse invocations
+func (mmLatestPulse *WriteAheadLogMock) LatestPulseAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLatestPulse.afterLatestPulseCounter)
+}
+
+// LatestPulseBeforeCounter returns a count of WriteAheadLogMock.LatestPulse invocations
+func (mmLatestPulse *WriteAheadLogMock) LatestPulseBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLatestPulse.beforeLatestPulseCounter)
+}
+
+// Calls returns a list of arguments used in each call to WriteAheadLogMock.LatestPulse.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmLatestPulse *mWriteAheadLogMockLatestPulse) Calls() []*WriteAheadLogMockLatestPulseParams {
+	mmLatestPulse.mutex.RLock()
+
+	argCopy := make([]*WriteAheadLogMockLatestPulseParams, len(mmLatestPulse.callArgs))
+	copy(argCopy, mmLatestPulse.callArgs)
+
+	mmLatestPulse.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockLatestPulseDone returns true if the count of the LatestPulse invocations corresponds
+// the number of defined expectations
+func (m *WriteAheadLogMock) MinimockLatestPulseDone() bool {
+	for _, e := range m.LatestPulseMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.LatestPulseMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterLatestPulseCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcLatestPulse != nil && mm_atomic.LoadUint64(&m.afterLatestPulseCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockLatestPulseInspect logs each unmet expectation
+func (m *WriteAheadLogMock) MinimockLatestPulseInspect() {
+	for _, e := range m.LatestPulseMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to WriteAheadLogMock.LatestPulse with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.LatestPulseMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterLatestPulseCounter) < 1 {
+		if m.LatestPulseMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to WriteAheadLogMock.LatestPulse")
+		} else {
+			m.t.Errorf("Expected call to WriteAheadLogMock.LatestPulse with params: %#v", *m.LatestPulseMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcLatestPulse != nil && mm_atomic.LoadUint64(&m.afterLatestPulseCounter) < 1 {
+		m.t.Error("Expected call to WriteAheadLogMock.LatestPulse")
+	}
+}
+
+type mWriteAheadLogMockReplicated struct {
+	mock               *WriteAheadLogMock
+	defaultExpectation *WriteAheadLogMockReplicatedExpectation
+	expectations       []*WriteAheadLogMockReplicatedExpectation
+
+	callArgs []*WriteAheadLogMockReplicatedParams
+	mutex    sync.RWMutex
+}
+
+// WriteAheadLogMockReplicatedExpectation specifies expectation struct of the WriteAheadLog.Replicated
+type WriteAheadLogMockReplicatedExpectation struct {
+	mock    *WriteAheadLogMock
+	params  *WriteAheadLogMockReplicatedParams
+	results *WriteAheadLogMockReplicatedResults
+	Counter uint64
+}
+
+// WriteAheadLogMockReplicatedParams contains parameters of the WriteAheadLog.Replicated
+type WriteAheadLogMockReplicatedParams struct {
+	ctx context.Context
+	pn  insolar.PulseNumber
+}
+
+// WriteAheadLogMockReplicatedResults contains results of the WriteAheadLog.Replicated
+type WriteAheadLogMockReplicatedResults struct {
+	err error
+}
+
+// Expect sets up expected params for WriteAheadLog.Replicated
+func (mmReplicated *mWriteAheadLogMockReplicated) Expect(ctx context.Context, pn insolar.PulseNumber) *mWriteAheadLogMockReplicated {
+	if mmReplicated.mock.funcReplicated != nil {
+		mmReplicated.mock.t.Fatalf("WriteAheadLogMock.Replicated mock is already set by Set")
+	}
+
+	if mmReplicated.defaultExpectation == nil {
+		mmReplicated.defaultExpectation = &WriteAheadLogMockReplicatedExpectation{}
+	}
+
+	mmReplicated.defaultExpectation.params = &WriteAheadLogMockReplicatedParams{ctx, pn}
+	for _, e := range mmReplicated.expectations {
+		if minimock.Equal(e.params, mmReplicated.defaultExpectation.params) {
+			mmReplicated.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmReplicated.defaultExpectation.params)
+		}
+	}
+
+	return mmReplicated
+}
+
+// Inspect accepts an inspector function that has same arguments as the WriteAheadLog.Replicated
+func (mmReplicated *mWriteAheadLogMockReplicated) Inspect(f func(ctx context.Context, pn insolar.PulseNumber)) *mWriteAheadLogMockReplicated {
+	if mmReplicated.mock.inspectFuncReplicated != nil {
+		mmReplicated.mock.t.Fatalf("Inspect function is already set for WriteAheadLogMock.Replicated")
+	}
+
+	mmReplicated.mock.inspectFuncReplicated = f
+
+	return mmReplicated
+}
+
+// Return sets up results that will be returned by WriteAheadLog.Replicated
+func (mmReplicated *mWriteAheadLogMockReplicated) Return(err error) *WriteAheadLogMock {
+	if mmReplicated.mock.funcReplicated != nil {
+		mmReplicated.mock.t.Fatalf("WriteAheadLogMock.Replicated mock is already set by Set")
+	}
+
+	if mmReplicated.defaultExpectation == nil {
+		mmReplicated.defaultExpectation = &WriteAheadLogMockReplicatedExpectation{mock: mmReplicated.mock}
+	}
+	mmReplicated.defaultExpectation.results = &WriteAheadLogMockReplicatedResults{err}
+	return mmReplicated.mock
+}
+
+// Set uses given function f to mock the WriteAheadLog.Replicated method
+func (mmReplicated *mWriteAheadLogMockReplicated) Set(f func(ctx context.Context, pn insolar.PulseNumber) (err error)) *WriteAheadLogMock {
+	if mmReplicated.defaultExpectation != nil {
+		mmReplicated.mock.t.Fatalf("Default expectation is already set for the WriteAheadLog.Replicated method")
+	}
+
+	if len(mmReplicated.expectations) > 0 {
+		mmReplicated.mock.t.Fatalf("Some expectations are already set for the WriteAheadLog.Replicated method")
+	}
+
+	mmReplicated.mock.funcReplicated = f
+	return mmReplicated.mock
+}
+
+// When sets expectation for the WriteAheadLog.Replicated which will trigger the result defined by the following
+// Then helper
+func (mmReplicated *mWriteAheadLogMockReplicated) When(ctx context.Context, pn insolar.PulseNumber) *WriteAheadLogMockReplicatedExpectation {
+	if mmReplicated.mock.funcReplicated != nil {
+		mmReplicated.mock.t.Fatalf("WriteAheadLogMock.Replicated mock is already set by Set")
+	}
+
+	expectation := &WriteAheadLogMockReplicatedExpectation{
+		mock:   mmReplicated.mock,
+		params: &WriteAheadLogMockReplicatedParams{ctx, pn},
+	}
+	mmReplicated.expectations = append(mmReplicated.expectations, expectation)
+	return expectation
+}
+
+// Then sets up WriteAheadLog.Replicated return parameters for the expectation previously defined by the When method
+func (e *WriteAheadLogMockReplicatedExpectation) Then(err error) *WriteAheadLogMock {
+	e.results = &WriteAheadLogMockReplicatedResults{err}
+	return e.mock
+}
+
+// Replicated implements WriteAheadLog
+func (mmReplicated *WriteAheadLogMock) Replicated(ctx context.Context, pn insolar.PulseNumber) (err error) {
+	mm_atomic.AddUint64(&mmReplicated.beforeReplicatedCounter, 1)
+	defer mm_atomic.AddUint64(&mmReplicated.afterReplicatedCounter, 1)
+
+	if mmReplicated.inspectFuncReplicated != nil {
+		mmReplicated.inspectFuncReplicated(ctx, pn)
+	}
+
+	mm_params := &WriteAheadLogMockReplicatedParams{ctx, pn}
+
+	// Record call args
+	mmReplicated.ReplicatedMock.mutex.Lock()
+	mmReplicated.ReplicatedMock.callArgs = append(mmReplicated.ReplicatedMock.callArgs, mm_params)
+	mmReplicated.ReplicatedMock.mutex.Unlock()
+
+	for _, e := range mmReplicated.ReplicatedMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmReplicated.ReplicatedMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmReplicated.ReplicatedMock.defaultExpectation.Counter, 1)
+		mm_want := mmReplicated.ReplicatedMock.defaultExpectation.params
+		mm_got := WriteAheadLogMockReplicatedParams{ctx, pn}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmReplicated.t.Errorf("WriteAheadLogMock.Replicated got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmReplicated.ReplicatedMock.defaultExpectation.results
+		if mm_results == nil {
+			mmReplicated.t.Fatal("No results are set for the WriteAheadLogMock.Replicated")
+		}
+		return (*mm_results).err
+	}
+	if mmReplicated.funcReplicated != nil {
+		return mmReplicated.funcReplicated(ctx, pn)
+	}
+	mmReplicated.t.Fatalf("Unexpected call to WriteAheadLogMock.Replicated. %v %v", ctx, pn)
+	return
+}
+
+// ReplicatedAfterCounter returns a count of finished WriteAheadLogMock.Replicated invocations
+func (mmReplicated *WriteAheadLogMock) ReplicatedAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReplicated.afterReplicatedCounter)
+}
+
+// ReplicatedBeforeCounter returns a count of WriteAheadLogMock.Replicated invocations
+func (mmReplicated *WriteAheadLogMock) ReplicatedBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReplicated.beforeReplicatedCounter)
+}
+
+// Calls returns a list of arguments used in each call to WriteAheadLogMock.Replicated.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmReplicated *mWriteAheadLogMockReplicated) Calls() []*WriteAheadLogMockReplicatedParams {
+	mmReplicated.mutex.RLock()
+
+	argCopy := make([]*WriteAheadLogMockReplicatedParams, len(mmReplicated.callArgs))
+	copy(argCopy, mmReplicated.callArgs)
+
+	mmReplicated.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockReplicatedDone returns true if the count of the Replicated invocations corresponds
+// the number of defined expectations
+func (m *WriteAheadLogMock) MinimockReplicatedDone() bool {
+	for _, e := range m.ReplicatedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ReplicatedMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterReplicatedCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcReplicated != nil && mm_atomic.LoadUint64(&m.afterReplicatedCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockReplicatedInspect logs each unmet expectation
+func (m *WriteAheadLogMock) MinimockReplicatedInspect() {
+	for _, e := range m.ReplicatedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to WriteAheadLogMock.Replicated with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ReplicatedMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterReplicatedCounter) < 1 {
+		if m.ReplicatedMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to WriteAheadLogMock.Replicated")
+		} else {
+			m.t.Errorf("Expected call to WriteAheadLogMock.Replicated with params: %#v", *m.ReplicatedMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcReplicated != nil && mm_atomic.LoadUint64(&m.afterReplicatedCounter) < 1 {
+		m.t.Error("Expected call to WriteAheadLogMock.Replicated")
+	}
+}
+
+type mWriteAheadLogMockRestore struct {
+	mock               *WriteAheadLogMock
+	defaultExpectation *WriteAheadLogMockRestoreExpectation
+	expectations       []*WriteAheadLogMockRestoreExpectation
+
+	callArgs []*WriteAheadLogMockRestoreParams
+	mutex    sync.RWMutex
+}
+
+// WriteAheadLogMockRestoreExpectation specifies expectation struct of the WriteAheadLog.Restore
+type WriteAheadLogMockRestoreExpectation struct {
+	mock    *WriteAheadLogMock
+	params  *WriteAheadLogMockRestoreParams
+	results *WriteAheadLogMockRestoreResults
+	Counter uint64
+}
+
+// WriteAheadLogMockRestoreParams contains parameters of the WriteAheadLog.Restore
+type WriteAheadLogMockRestoreParams struct {
+	ctx context.Context
+}
+
+// WriteAheadLogMockRestoreResults contains results of the WriteAheadLog.Restore
+type WriteAheadLogMockRestoreResults struct {
+	pulses     []insolar.PulseNumber
+	replicated insolar.PulseNumber
+	err        error
+}
+
+// Expect sets up expected params for WriteAheadLog.Restore
+func (mmRestore *mWriteAheadLogMockRestore) Expect(ctx context.Context) *mWriteAheadLogMockRestore {
+	if mmRestore.mock.funcRestore != nil {
+		mmRestore.mock.t.Fatalf("WriteAheadLogMock.Restore mock is already set by Set")
+	}
+
+	if mmRestore.defaultExpectation == nil {
+		mmRestore.defaultExpectation = &WriteAheadLogMockRestoreExpectation{}
+	}
+
+	mmRestore.defaultExpectation.params = &WriteAheadLogMockRestoreParams{ctx}
+	for _, e := range mmRestore.expectations {
+		if minimock.Equal(e.params, mmRestore.defaultExpectation.params) {
+			mmRestore.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRestore.defaultExpectation.params)
+		}
+	}
+
+	return mmRestore
+}
+
+// Inspect accepts an inspector function that has same arguments as the WriteAheadLog.Restore
+func (mmRestore *mWriteAheadLogMockRestore) Inspect(f func(ctx context.Context)) *mWriteAheadLogMockRestore {
+	if mmRestore.mock.inspectFuncRestore != nil {
+		mmRestore.mock.t.Fatalf("Inspect function is already set for WriteAheadLogMock.Restore")
+	}
+
+	mmRestore.mock.inspectFuncRestore = f
+
+	return mmRestore
+}
+
+// Return sets up results that will be returned by WriteAheadLog.Restore
+func (mmRestore *mWriteAheadLogMockRestore) Return(pulses []insolar.PulseNumber, replicated insolar.PulseNumber, err error) *WriteAheadLogMock {
+	if mmRestore.mock.funcRestore != nil {
+		mmRestore.mock.t.Fatalf("WriteAheadLogMock.Restore mock is already set by Set")
+	}
+
+	if mmRestore.defaultExpectation == nil {
+		mmRestore.defaultExpectation = &WriteAheadLogMockRestoreExpectation{mock: mmRestore.mock}
+	}
+	mmRestore.defaultExpectation.results = &WriteAheadLogMockRestoreResults{pulses, replicated, err}
+	return mmRestore.mock
+}
+
+// Set uses given function f to mock the WriteAheadLog.Restore method
+func (mmRestore *mWriteAheadLogMockRestore) Set(f func(ctx context.Context) (pulses []insolar.PulseNumber, replicated insolar.PulseNumber, err error)) *WriteAheadLogMock {
+	if mmRestore.defaultExpectation != nil {
+		mmRestore.mock.t.Fatalf("Default expectation is already set for the WriteAheadLog.Restore method")
+	}
+
+	if len(mmRestore.expectations) > 0 {
+		mmRestore.mock.t.Fatalf("Some expectations are already set for the WriteAheadLog.Restore method")
+	}
+
+	mmRestore.mock.funcRestore = f
+	return mmRestore.mock
+}
+
+// When sets expectation for the WriteAheadLog.Restore which will trigger the result defined by the following
+// Then helper
+func (mmRestore *mWriteAheadLogMockRestore) When(ctx context.Context) *WriteAheadLogMockRestoreExpectation {
+	if mmRestore.mock.funcRestore != nil {
+		mmRestore.mock.t.Fatalf("WriteAheadLogMock.Restore mock is already set by Set")
+	}
+
+	expectation := &WriteAheadLogMockRestoreExpectation{
+		mock:   mmRestore.mock,
+		params: &WriteAheadLogMockRestoreParams{ctx},
+	}
+	mmRestore.expectations = append(mmRestore.expectations, expectation)
+	return expectation
+}
+
+// Then sets up WriteAheadLog.Restore return parameters for the expectation previously defined by the When method
+func (e *WriteAheadLogMockRestoreExpectation) Then(pulses []insolar.PulseNumber, replicated insolar.PulseNumber, err error) *WriteAheadLogMock {
+	e.results = &WriteAheadLogMockRestoreResults{pulses, replicated, err}
+	return e.mock
+}
+
+// Restore implements WriteAheadLog
+func (mmRestore *WriteAheadLogMock) Restore(ctx context.Context) (pulses []insolar.PulseNumber, replicated insolar.PulseNumber, err error) {
+	mm_atomic.AddUint64(&mmRestore.beforeRestoreCounter, 1)
+	defer mm_atomic.AddUint64(&mmRestore.afterRestoreCounter, 1)
+
+	if mmRestore.inspectFuncRestore != nil {
+		mmRestore.inspectFuncRestore(ctx)
+	}
+
+	mm_params := &WriteAheadLogMockRestoreParams{ctx}
+
+	// Record call args
+	mmRestore.RestoreMock.mutex.Lock()
+	mmRestore.RestoreMock.callArgs = append(mmRestore.RestoreMock.callArgs, mm_params)
+	mmRestore.RestoreMock.mutex.Unlock()
+
+	for _, e := range mmRestore.RestoreMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.pulses, e.results.replicated, e.results.err
+		}
+	}
+
+	if mmRestore.RestoreMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRestore.RestoreMock.defaultExpectation.Counter, 1)
+		mm_want := mmRestore.RestoreMock.defaultExpectation.params
+		mm_got := WriteAheadLogMockRestoreParams{ctx}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRestore.t.Errorf("WriteAheadLogMock.Restore got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRestore.RestoreMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRestore.t.Fatal("No results are set for the WriteAheadLogMock.Restore")
+		}
+		return (*mm_results).pulses, (*mm_results).replicated, (*mm_results).err
+	}
+	if mmRestore.funcRestore != nil {
+		return mmRestore.funcRestore(ctx)
+	}
+	mmRestore.t.Fatalf("Unexpected call to WriteAheadLogMock.Restore. %v", ctx)
+	return
+}
+
+// RestoreAfterCounter returns a count of finished WriteAheadLogMock.Restore invocations
+func (mmRestore *WriteAheadLogMock) RestoreAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRestore.afterRestoreCounter)
+}
+
+// RestoreBeforeCounter returns a count of WriteAheadLogMock.Restore invocations
+func (mmRestore *WriteAheadLogMock) RestoreBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRestore.beforeRestoreCounter)
+}
+
+// Calls returns a list of arguments used in each call to WriteAheadLogMock.Restore.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRestore *mWriteAheadLogMockRestore) Calls() []*WriteAheadLogMockRestoreParams {
+	mmRestore.mutex.RLock()
+
+	argCopy := make([]*WriteAheadLogMockRestoreParams, len(mmRestore.callArgs))
+	copy(argCopy, mmRestore.callArgs)
+
+	mmRestore.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRestoreDone returns true if the count of the Restore invocations corresponds
+// the number of defined expectations
+func (m *WriteAheadLogMock) MinimockRestoreDone() bool {
+	for _, e := range m.RestoreMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RestoreMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterRestoreCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRestore != nil && mm_atomic.LoadUint64(&m.afterRestoreCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockRestoreInspect logs each unmet expectation
+func (m *WriteAheadLogMock) MinimockRestoreInspect() {
+	for _, e := range m.RestoreMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to WriteAheadLogMock.Restore with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RestoreMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterRestoreCounter) < 1 {
+		if m.RestoreMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to WriteAheadLogMock.Restore")
+		} else {
+			m.t.Errorf("Expected call to WriteAheadLogMock.Restore with params: %#v", *m.RestoreMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRestore != nil && mm_atomic.LoadUint64(&m.afterRestoreCounter) < 1 {
+		m.t.Error("Expected call to WriteAheadLogMock.Restore")
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *WriteAheadLogMock) MinimockFinish() {
+	if !m.minimockDone() {
+		m.MinimockDiscardInspect()
+
+		m.MinimockLatestPulseInspect()
+
+		m.MinimockReplicatedInspect()
+
+		m.MinimockRestoreInspect()
+		m.t.FailNow()
+	}
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *WriteAheadLogMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *WriteAheadLogMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockDiscardDone() &&
+		m.MinimockLatestPulseDone() &&
+		m.MinimockReplicatedDone() &&
+		m.MinimockRestoreDone()
+}
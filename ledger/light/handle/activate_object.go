@@ -69,6 +69,11 @@ func (s *ActivateObject) Present(ctx context.Context, f flow.Flow) error {
 		return fmt.Errorf("wrong result record type: %T", res)
 	}
 
+	events, err := unmarshalEvents(msg.Events, result)
+	if err != nil {
+		return errors.Wrap(err, "failed to unmarshal events")
+	}
+
 	passIfNotExecutor := !s.passed
 	jet := proc.NewFetchJet(*activate.Request.GetLocal(), flow.Pulse(ctx), s.message, passIfNotExecutor)
 	s.dep.FetchJet(jet)
@@ -86,7 +91,7 @@ func (s *ActivateObject) Present(ctx context.Context, f flow.Flow) error {
 		return err
 	}
 
-	setResult := proc.NewSetResult(s.message, objJetID, *result, activate)
+	setResult := proc.NewSetResult(s.message, objJetID, *result, activate, events)
 	s.dep.SetResult(setResult)
 	return f.Procedure(ctx, setResult, false)
 }
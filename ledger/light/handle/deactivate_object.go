@@ -70,6 +70,11 @@ func (s *DeactivateObject) Present(ctx context.Context, f flow.Flow) error {
 		return errors.New("object is nil")
 	}
 
+	events, err := unmarshalEvents(msg.Events, result)
+	if err != nil {
+		return errors.Wrap(err, "failed to unmarshal events")
+	}
+
 	passIfNotExecutor := !s.passed
 	jet := proc.NewFetchJet(obj, flow.Pulse(ctx), s.message, passIfNotExecutor)
 	s.dep.FetchJet(jet)
@@ -95,7 +100,7 @@ func (s *DeactivateObject) Present(ctx context.Context, f flow.Flow) error {
 		return err
 	}
 
-	setResult := proc.NewSetResult(s.message, objJetID, *result, deactivate)
+	setResult := proc.NewSetResult(s.message, objJetID, *result, deactivate, events)
 	s.dep.SetResult(setResult)
 	return f.Procedure(ctx, setResult, false)
 }
@@ -0,0 +1,47 @@
+// Copyright 2020 Insolar Network Ltd.
+// All rights reserved.
+// This material is licensed under the Insolar License version 1.0,
+// available at https://github.com/insolar/insolar/blob/master/LICENSE.md.
+
+package handle
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/insolar/flow"
+	"github.com/insolar/insolar/insolar/payload"
+	"github.com/insolar/insolar/ledger/light/proc"
+)
+
+type GetState struct {
+	dep     *proc.Dependencies
+	message payload.Meta
+	passed  bool
+}
+
+func NewGetState(dep *proc.Dependencies, msg payload.Meta, passed bool) *GetState {
+	return &GetState{
+		dep:     dep,
+		message: msg,
+		passed:  passed,
+	}
+}
+
+func (s *GetState) Present(ctx context.Context, f flow.Flow) error {
+	pl, err := payload.Unmarshal(s.message.Payload)
+	if err != nil {
+		return errors.Wrap(err, "failed to unmarshal GetState message")
+	}
+	msg, ok := pl.(*payload.GetState)
+	if !ok {
+		return fmt.Errorf("wrong request type: %T", pl)
+	}
+
+	passIfNotFound := !s.passed
+	state := proc.NewSendState(s.message, msg.ObjectID, msg.StateID, passIfNotFound)
+	s.dep.SendState(state)
+	return f.Procedure(ctx, state, false)
+}
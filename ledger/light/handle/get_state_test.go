@@ -0,0 +1,60 @@
+// Copyright 2020 Insolar Network Ltd.
+// All rights reserved.
+// This material is licensed under the Insolar License version 1.0,
+// available at https://github.com/insolar/insolar/blob/master/LICENSE.md.
+
+package handle_test
+
+import (
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/insolar/flow"
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/insolar/payload"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/ledger/light/handle"
+	"github.com/insolar/insolar/ledger/light/proc"
+)
+
+func TestGetState_Present(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	meta := payload.Meta{
+		Polymorph: uint32(payload.TypeMeta),
+		Payload: payload.MustMarshal(&payload.GetState{
+			Polymorph: uint32(payload.TypeGetState),
+			ObjectID:  gen.ID(),
+			StateID:   gen.ID(),
+		}),
+	}
+
+	handler := handle.NewGetState(proc.NewDependenciesMock(), meta, false)
+	flowMock := flow.NewFlowMock(mc).ProcedureMock.Return(nil)
+	err := handler.Present(ctx, flowMock)
+	assert.NoError(t, err)
+}
+
+func TestGetState_IncorrectTypeMsgPayload(t *testing.T) {
+	t.Parallel()
+
+	ctx := inslogger.TestContext(t)
+
+	meta := payload.Meta{
+		Polymorph: uint32(payload.TypeMeta),
+		// Incorrect type (GetRequest instead of GetState).
+		Payload: payload.MustMarshal(&payload.GetRequest{
+			Polymorph: uint32(payload.TypeGetRequest),
+		}),
+	}
+
+	handler := handle.NewGetState(proc.NewDependenciesMock(), meta, false)
+
+	err := handler.Present(ctx, flow.NewFlowMock(t))
+	require.Error(t, err)
+}
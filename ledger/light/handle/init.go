@@ -76,6 +76,9 @@ func (s *Init) handle(ctx context.Context, f flow.Flow) error {
 	case payload.TypeGetRequest:
 		h := NewGetRequest(s.dep, meta, false)
 		err = f.Handle(ctx, h.Present)
+	case payload.TypeGetState:
+		h := NewGetState(s.dep, meta, false)
+		err = f.Handle(ctx, h.Present)
 	case payload.TypeGetRequestInfo:
 		h := NewGetRequestInfo(s.dep, meta)
 		err = f.Handle(ctx, h.Present)
@@ -224,6 +227,9 @@ func (s *Init) handlePass(ctx context.Context, f flow.Flow, meta payload.Meta) e
 	case payload.TypeGetRequest:
 		h := NewGetRequest(s.dep, originMeta, true)
 		err = f.Handle(ctx, h.Present)
+	case payload.TypeGetState:
+		h := NewGetState(s.dep, originMeta, true)
+		err = f.Handle(ctx, h.Present)
 	default:
 		err = fmt.Errorf("no handler for message type %s", payloadType.String())
 	}
@@ -284,6 +290,7 @@ func (s *Init) Past(ctx context.Context, f flow.Flow) error {
 		payload.TypeHasPendings,
 		payload.TypeGetJet,
 		payload.TypeGetRequest,
+		payload.TypeGetState,
 		payload.TypePassState,
 		payload.TypeGetRequestInfo,
 		payload.TypeGetFilament,
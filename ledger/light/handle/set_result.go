@@ -56,6 +56,11 @@ func (s *SetResult) Present(ctx context.Context, f flow.Flow) error {
 		return errors.New("object is nil")
 	}
 
+	events, err := unmarshalEvents(msg.Events, result)
+	if err != nil {
+		return errors.Wrap(err, "failed to unmarshal events")
+	}
+
 	passIfNotExecutor := !s.passed
 	jet := proc.NewFetchJet(result.Object, flow.Pulse(ctx), s.message, passIfNotExecutor)
 	s.dep.FetchJet(jet)
@@ -81,7 +86,34 @@ func (s *SetResult) Present(ctx context.Context, f flow.Flow) error {
 		return errors.Wrap(err, "can't get index")
 	}
 
-	setResult := proc.NewSetResult(s.message, jetID, *result, nil)
+	setResult := proc.NewSetResult(s.message, jetID, *result, nil, events)
 	s.dep.SetResult(setResult)
 	return f.Procedure(ctx, setResult, false)
 }
+
+// unmarshalEvents unmarshals event records registered together with the result. Events must be emitted
+// by the object and the request of the result.
+func unmarshalEvents(buf [][]byte, result *record.Result) ([]record.Event, error) {
+	events := make([]record.Event, 0, len(buf))
+	for _, b := range buf {
+		virtual := record.Virtual{}
+		err := virtual.Unmarshal(b)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal Event record")
+		}
+
+		rec := record.Unwrap(&virtual)
+		event, ok := rec.(*record.Event)
+		if !ok {
+			return nil, fmt.Errorf("wrong event type: %T", rec)
+		}
+		if event.Object != result.Object || !event.Request.Equal(result.Request) {
+			return nil, errors.New("event doesn't belong to the result")
+		}
+		if event.Topic == "" {
+			return nil, errors.New("event topic is empty")
+		}
+		events = append(events, *event)
+	}
+	return events, nil
+}
@@ -77,6 +77,48 @@ func TestSetResult_IncorrectTypeMsgPayload(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestSetResult_ForeignEvent(t *testing.T) {
+	t.Parallel()
+
+	ctx := inslogger.TestContext(t)
+	f := flow.NewFlowMock(t)
+
+	res := record.Wrap(&record.Result{
+		Object:  gen.ID(),
+		Request: gen.RecordReference(),
+	})
+	resBuf, err := res.Marshal()
+	require.NoError(t, err)
+
+	// Event of another request.
+	event := record.Wrap(&record.Event{
+		Object:  gen.ID(),
+		Request: gen.RecordReference(),
+		Topic:   "created",
+	})
+	eventBuf, err := event.Marshal()
+	require.NoError(t, err)
+
+	result := payload.SetResult{
+		Polymorph: uint32(payload.TypeSetResult),
+		Result:    resBuf,
+		Events:    [][]byte{eventBuf},
+	}
+	buf, err := result.Marshal()
+	require.NoError(t, err)
+
+	msg := payload.Meta{
+		Polymorph: uint32(payload.TypeMeta),
+		Payload:   buf,
+	}
+
+	handler := handle.NewSetResult(proc.NewDependenciesMock(), msg, false)
+
+	err = handler.Present(ctx, f)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "event doesn't belong to the result")
+}
+
 func TestSetResult_BadWrappedVirtualRecord(t *testing.T) {
 	t.Parallel()
 
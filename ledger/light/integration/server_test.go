@@ -40,6 +40,7 @@ import (
 	"github.com/insolar/insolar/ledger/light/executor"
 	"github.com/insolar/insolar/ledger/light/handle"
 	"github.com/insolar/insolar/ledger/light/proc"
+	"github.com/insolar/insolar/ledger/light/wal"
 	"github.com/insolar/insolar/ledger/object"
 	"github.com/insolar/insolar/log/logwatermill"
 	"github.com/insolar/insolar/metrics"
@@ -222,9 +223,22 @@ func NewServer(
 	{
 		conf := cfg.Ledger
 		idLocker := object.NewIndexLocker()
-		drops := drop.NewStorageMemory()
-		records := object.NewRecordMemory()
-		indexes := object.NewIndexStorageMemory()
+		writeAheadLog, err := wal.NewLog(
+			conf.WAL,
+			drop.NewStorageMemory(),
+			object.NewRecordMemory(),
+			object.NewIndexStorageMemory(),
+			Pulses,
+			Nodes,
+			Jets,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to open WAL")
+		}
+		drops := writeAheadLog.Drops()
+		records := writeAheadLog.Records()
+		indexes := writeAheadLog.Indexes()
+		pulseAppender := writeAheadLog.Pulses()
 		writeController := executor.NewWriteController()
 		hotWaitReleaser := executor.NewChannelWaiter()
 
@@ -272,6 +286,7 @@ func NewServer(
 			records,
 			indexes,
 			Jets,
+			writeAheadLog,
 		)
 		Replicator = lthSyncer
 
@@ -287,7 +302,8 @@ func NewServer(
 		)
 
 		stateIniter := executor.NewStateIniter(
-			cfg.LightChainLimit, Jets, hotWaitReleaser, drops, Nodes, ServerBus, Pulses, Pulses, jetCalculator, indexes,
+			cfg.LightChainLimit, Jets, hotWaitReleaser, drops, Nodes, ServerBus, pulseAppender, Pulses, jetCalculator, indexes,
+			writeAheadLog, lthSyncer,
 		)
 
 		metricsRegistry := executor.NewMetricsRegistry()
@@ -299,6 +315,7 @@ func NewServer(
 			Pulses,
 			ServerBus,
 			drops,
+			drops,
 			idLocker,
 			records,
 			indexes,
@@ -333,7 +350,7 @@ func NewServer(
 			[]dispatcher.Dispatcher{FlowDispatcher},
 			Nodes,
 			Pulses,
-			Pulses,
+			pulseAppender,
 			hotWaitReleaser,
 			jetSplitter,
 			lthSyncer,
@@ -116,12 +116,15 @@ func (p *EnsureIndex) Proceed(ctx context.Context) error {
 		}
 		defer done()
 
-		p.dep.indexes.SetIfNone(ctx, p.pulse, record.Index{
+		err = p.dep.indexes.SetIfNone(ctx, p.pulse, record.Index{
 			LifelineLastUsed: p.pulse,
 			Lifeline:         idx,
 			PendingRecords:   []insolar.ID{},
 			ObjID:            p.object,
 		})
+		if err != nil {
+			return errors.Wrap(err, "EnsureIndex: failed to set index")
+		}
 		return nil
 	case *payload.Error:
 		return &payload.CodedError{
@@ -105,7 +105,7 @@ func TestEnsureIndex_Proceed(t *testing.T) {
 		})
 		sender.SendTargetMock.Return(reps, func() {})
 		writeAccessor.BeginMock.Return(func() {}, nil)
-		indexes.SetIfNoneMock.Return()
+		indexes.SetIfNoneMock.Return(nil)
 
 		p := proc.NewEnsureIndex(gen.ID(), gen.JetID(), payload.Meta{}, pulse.MinTimePulse)
 		p.Dep(indexes, cord, sender, writeAccessor)
@@ -39,6 +39,7 @@ type HotObjects struct {
 
 	dep struct {
 		drops       drop.Modifier
+		dropAccess  drop.Accessor
 		indexes     object.MemoryIndexModifier
 		jetStorage  jet.Storage
 		jetFetcher  executor.JetFetcher
@@ -70,6 +71,7 @@ func NewHotObjects(
 
 func (p *HotObjects) Dep(
 	drops drop.Modifier,
+	dropAccess drop.Accessor,
 	indexes object.MemoryIndexModifier,
 	jStore jet.Storage,
 	jFetcher executor.JetFetcher,
@@ -80,6 +82,7 @@ func (p *HotObjects) Dep(
 	registry executor.MetricsRegistry,
 ) {
 	p.dep.drops = drops
+	p.dep.dropAccess = dropAccess
 	p.dep.indexes = indexes
 	p.dep.jetStorage = jStore
 	p.dep.jetFetcher = jFetcher
@@ -128,7 +131,7 @@ func (p *HotObjects) Proceed(ctx context.Context) error {
 			continue
 		}
 
-		p.dep.indexes.Set(
+		err = p.dep.indexes.Set(
 			ctx,
 			p.pulse,
 			record.Index{
@@ -138,34 +141,84 @@ func (p *HotObjects) Proceed(ctx context.Context) error {
 				PendingRecords:   []insolar.ID{},
 			},
 		)
+		if err != nil {
+			return errors.Wrap(err, "failed to set index")
+		}
 		logger.Debugf("[handleHotRecords] lifeline with id - %v saved", idx.ObjID.DebugString())
 
 		p.notifyPending(ctx, idx.ObjID, idx.Lifeline, pendingNotifyPulse.PulseNumber)
 	}
 
+	if p.drop.Merge {
+		received, err := p.siblingReceived(ctx)
+		if err != nil {
+			return err
+		}
+		if !received {
+			// Merged jet is released by the last received part.
+			logger.Infof("waiting for the second part of merged jet %s and pulse %s", p.jetID.DebugString(), p.pulse)
+			p.sendConfirmationToHeavy(ctx, p.drop.JetID, p.drop.Pulse, p.drop.Split, p.drop.Merge)
+			return nil
+		}
+	}
+
 	logger.Infof("before releasing jetFetcher for jet %s and pulse %s", p.jetID.DebugString(), p.pulse)
-	p.dep.jetFetcher.Release(ctx, p.jetID, p.pulse)
+	if p.drop.Merge {
+		// Fetching could wait on the merged jets in the past tree.
+		left, right := jet.Siblings(p.jetID)
+		p.dep.jetFetcher.Release(ctx, left, p.pulse)
+		p.dep.jetFetcher.Release(ctx, right, p.pulse)
+	} else {
+		p.dep.jetFetcher.Release(ctx, p.jetID, p.pulse)
+	}
 
 	logger.Infof("before unlocking jetReleaser for jet %s and pulse %s", p.jetID.DebugString(), p.pulse)
 	err = p.dep.jetReleaser.Unlock(ctx, p.pulse, p.jetID)
+	// Both parts of merged jet could see each other, if they are received at the same time.
+	if err == executor.ErrWaiterNotLocked && p.drop.Merge {
+		err = nil
+	}
 	if err != nil {
 		return errors.Wrap(err, "failed to release jets")
 	}
 
 	logger.Infof("jetFetcher and jetReleaser for jet %s and pulse %s are released", p.jetID.DebugString(), p.pulse)
 
-	p.sendConfirmationToHeavy(ctx, p.jetID, p.drop.Pulse, p.drop.Split)
+	confirmedJetID := p.jetID
+	if p.drop.Merge {
+		// Heavy expects confirmation for every merged jet.
+		confirmedJetID = p.drop.JetID
+	}
+	p.sendConfirmationToHeavy(ctx, confirmedJetID, p.drop.Pulse, p.drop.Split, p.drop.Merge)
 
 	logger.Infof("finish hotObjects processing")
 	return nil
 }
 
-func (p *HotObjects) sendConfirmationToHeavy(ctx context.Context, jetID insolar.JetID, pn insolar.PulseNumber, split bool) {
+// siblingReceived checks if the other part of merged jet is already received.
+func (p *HotObjects) siblingReceived(ctx context.Context) (bool, error) {
+	left, right := jet.Siblings(p.jetID)
+	sibling := left
+	if p.drop.JetID == left {
+		sibling = right
+	}
+	_, err := p.dep.dropAccess.ForPulse(ctx, sibling, p.drop.Pulse)
+	if err == drop.ErrNotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to fetch drop of merged jet %s", sibling.DebugString())
+	}
+	return true, nil
+}
+
+func (p *HotObjects) sendConfirmationToHeavy(ctx context.Context, jetID insolar.JetID, pn insolar.PulseNumber, split, merge bool) {
 	logger := inslogger.FromContext(ctx)
 	msg, err := payload.NewMessage(&payload.GotHotConfirmation{
 		JetID: jetID,
 		Pulse: pn,
 		Split: split,
+		Merge: merge,
 	})
 
 	if err != nil {
@@ -35,6 +35,7 @@ func TestHotObjects_Proceed(t *testing.T) {
 
 	var (
 		drops       *drop.ModifierMock
+		dropAccess  *drop.AccessorMock
 		indexes     *object.MemoryIndexModifierMock
 		jetStorage  *jet.StorageMock
 		jetFetcher  *executor.JetFetcherMock
@@ -47,6 +48,7 @@ func TestHotObjects_Proceed(t *testing.T) {
 
 	setup := func(mc minimock.MockController) {
 		drops = drop.NewModifierMock(mc)
+		dropAccess = drop.NewAccessorMock(mc)
 		indexes = object.NewMemoryIndexModifierMock(mc)
 		jetStorage = jet.NewStorageMock(mc)
 		jetFetcher = executor.NewJetFetcherMock(mc)
@@ -95,7 +97,7 @@ func TestHotObjects_Proceed(t *testing.T) {
 
 		indexes.SetMock.Inspect(func(ctx context.Context, pn insolar.PulseNumber, index record.Index) {
 			assert.Equal(t, idxs[0], index)
-		}).Return()
+		}).Return(nil)
 
 		jetFetcher.ReleaseMock.Inspect(func(ctx context.Context, jetID insolar.JetID, pulse insolar.PulseNumber) {
 			assert.Equal(t, expectedJetID, jetID)
@@ -116,7 +118,7 @@ func TestHotObjects_Proceed(t *testing.T) {
 
 		// start test
 		p := proc.NewHotObjects(meta, expectedPulse.PulseNumber, expectedJetID, expectedDrop, idxs, 10)
-		p.Dep(drops, indexes, jetStorage, jetFetcher, jetReleaser, coordinator, calculator, sender, registry)
+		p.Dep(drops, dropAccess, indexes, jetStorage, jetFetcher, jetReleaser, coordinator, calculator, sender, registry)
 
 		err := p.Proceed(ctx)
 		assert.NoError(t, err)
@@ -170,7 +172,7 @@ func TestHotObjects_Proceed(t *testing.T) {
 
 		indexes.SetMock.Inspect(func(ctx context.Context, pn insolar.PulseNumber, index record.Index) {
 			assert.Equal(t, idxs[0], index)
-		}).Return()
+		}).Return(nil)
 
 		jetFetcher.ReleaseMock.Inspect(func(ctx context.Context, jetID insolar.JetID, pulse insolar.PulseNumber) {
 			assert.Equal(t, expectedJetID, jetID)
@@ -204,7 +206,7 @@ func TestHotObjects_Proceed(t *testing.T) {
 
 		// start test
 		p := proc.NewHotObjects(meta, currentPulse.PulseNumber, expectedJetID, expectedDrop, idxs, 10)
-		p.Dep(drops, indexes, jetStorage, jetFetcher, jetReleaser, coordinator, calculator, sender, registry)
+		p.Dep(drops, dropAccess, indexes, jetStorage, jetFetcher, jetReleaser, coordinator, calculator, sender, registry)
 
 		err := p.Proceed(ctx)
 		assert.NoError(t, err)
@@ -258,7 +260,7 @@ func TestHotObjects_Proceed(t *testing.T) {
 
 		indexes.SetMock.Inspect(func(ctx context.Context, pn insolar.PulseNumber, index record.Index) {
 			assert.Equal(t, idxs[0], index)
-		}).Return()
+		}).Return(nil)
 
 		jetFetcher.ReleaseMock.Inspect(func(ctx context.Context, jetID insolar.JetID, pulse insolar.PulseNumber) {
 			assert.Equal(t, expectedJetID, jetID)
@@ -280,9 +282,91 @@ func TestHotObjects_Proceed(t *testing.T) {
 
 		// start test
 		p := proc.NewHotObjects(meta, currentPulse.PulseNumber, expectedJetID, expectedDrop, idxs, 0)
-		p.Dep(drops, indexes, jetStorage, jetFetcher, jetReleaser, coordinator, calculator, sender, registry)
+		p.Dep(drops, dropAccess, indexes, jetStorage, jetFetcher, jetReleaser, coordinator, calculator, sender, registry)
+
+		err := p.Proceed(ctx)
+		assert.NoError(t, err)
+	})
+
+	t.Run("merged jet waits for sibling", func(t *testing.T) {
+		setup(mc)
+		defer mc.Finish()
+
+		currentPulse := insolar.PulseNumber(pulse.MinTimePulse + 100)
+		mergedJetID := gen.JetID()
+		left, right := jet.Siblings(mergedJetID)
+		expectedDrop := drop.Drop{
+			Pulse: currentPulse - 10,
+			JetID: left,
+			Merge: true,
+		}
+
+		drops.SetMock.Return(nil)
+		jetStorage.UpdateMock.Inspect(func(ctx context.Context, pulse insolar.PulseNumber, actual bool, ids ...insolar.JetID) {
+			assert.Equal(t, mergedJetID, ids[0], "wrong jetID received")
+		}).Return(nil)
+		calculator.BackwardsMock.Return(insolar.Pulse{}, insolarPulse.ErrNotFound)
+		dropAccess.ForPulseMock.Inspect(func(ctx context.Context, jetID insolar.JetID, pulse insolar.PulseNumber) {
+			assert.Equal(t, right, jetID)
+			assert.Equal(t, expectedDrop.Pulse, pulse)
+		}).Return(drop.Drop{}, drop.ErrNotFound)
+
+		expectedToHeavyMsg, _ := payload.NewMessage(&payload.GotHotConfirmation{
+			JetID: left,
+			Pulse: expectedDrop.Pulse,
+			Merge: true,
+		})
+		sender.SendRoleMock.Inspect(func(ctx context.Context, msg *message.Message, role insolar.DynamicRole, object insolar.Reference) {
+			assert.Equal(t, expectedToHeavyMsg.Payload, msg.Payload)
+		}).Return(make(chan *message.Message), func() {})
+
+		p := proc.NewHotObjects(payload.Meta{}, currentPulse, mergedJetID, expectedDrop, nil, 10)
+		p.Dep(drops, dropAccess, indexes, jetStorage, jetFetcher, jetReleaser, coordinator, calculator, sender, registry)
+
+		err := p.Proceed(ctx)
+		assert.NoError(t, err)
+	})
+
+	t.Run("merged jet is released by the second part", func(t *testing.T) {
+		setup(mc)
+		defer mc.Finish()
+
+		currentPulse := insolar.PulseNumber(pulse.MinTimePulse + 100)
+		mergedJetID := gen.JetID()
+		left, right := jet.Siblings(mergedJetID)
+		expectedDrop := drop.Drop{
+			Pulse: currentPulse - 10,
+			JetID: right,
+			Merge: true,
+		}
+
+		drops.SetMock.Return(nil)
+		jetStorage.UpdateMock.Return(nil)
+		calculator.BackwardsMock.Return(insolar.Pulse{}, insolarPulse.ErrNotFound)
+		dropAccess.ForPulseMock.Inspect(func(ctx context.Context, jetID insolar.JetID, pulse insolar.PulseNumber) {
+			assert.Equal(t, left, jetID)
+		}).Return(drop.Drop{JetID: left, Merge: true}, nil)
+
+		jetFetcher.ReleaseMock.Return()
+		// The first part could be released at the same time.
+		jetReleaser.UnlockMock.Inspect(func(ctx context.Context, pulse insolar.PulseNumber, jetID insolar.JetID) {
+			assert.Equal(t, mergedJetID, jetID)
+		}).Return(executor.ErrWaiterNotLocked)
+
+		expectedToHeavyMsg, _ := payload.NewMessage(&payload.GotHotConfirmation{
+			JetID: right,
+			Pulse: expectedDrop.Pulse,
+			Merge: true,
+		})
+		sender.SendRoleMock.Inspect(func(ctx context.Context, msg *message.Message, role insolar.DynamicRole, object insolar.Reference) {
+			assert.Equal(t, expectedToHeavyMsg.Payload, msg.Payload)
+		}).Return(make(chan *message.Message), func() {})
+
+		p := proc.NewHotObjects(payload.Meta{}, currentPulse, mergedJetID, expectedDrop, nil, 10)
+		p.Dep(drops, dropAccess, indexes, jetStorage, jetFetcher, jetReleaser, coordinator, calculator, sender, registry)
 
 		err := p.Proceed(ctx)
 		assert.NoError(t, err)
+		assert.Equal(t, uint64(2), jetFetcher.ReleaseAfterCounter())
 	})
 }
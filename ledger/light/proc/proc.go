@@ -23,6 +23,7 @@ type Dependencies struct {
 	SendObject     func(*SendObject)
 	GetCode        func(*GetCode)
 	GetRequest     func(*GetRequest)
+	SendState      func(*SendState)
 	GetRequestInfo func(*SendRequestInfo)
 	SetRequest     func(*SetRequest)
 	SetResult      func(*SetResult)
@@ -48,6 +49,7 @@ func NewDependencies(
 
 	// Ledger components.
 	dropModifier drop.Modifier,
+	dropAccessor drop.Accessor,
 	indexLocker object.IndexLocker,
 	recordStorage object.AtomicRecordStorage,
 	indexStorage object.MemoryIndexStorage,
@@ -144,6 +146,14 @@ func NewDependencies(
 				jetFetcher,
 			)
 		},
+		SendState: func(p *SendState) {
+			p.Dep(
+				recordStorage,
+				sender,
+				jetCoordinator,
+				jetFetcher,
+			)
+		},
 		GetRequestInfo: func(p *SendRequestInfo) {
 			p.Dep(
 				filaments,
@@ -172,6 +182,7 @@ func NewDependencies(
 		HotObjects: func(p *HotObjects) {
 			p.Dep(
 				dropModifier,
+				dropAccessor,
 				indexStorage,
 				jetStorage,
 				jetFetcher,
@@ -224,6 +235,7 @@ func NewDependenciesMock() *Dependencies {
 		GetCode:        func(*GetCode) {},
 		SetRequest:     func(*SetRequest) {},
 		GetRequest:     func(*GetRequest) {},
+		SendState:      func(*SendState) {},
 		SetResult:      func(*SetResult) {},
 		GetPendings:    func(*GetPendings) {},
 		GetJet:         func(*GetJet) {},
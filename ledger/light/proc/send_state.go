@@ -0,0 +1,143 @@
+// Copyright 2020 Insolar Network Ltd.
+// All rights reserved.
+// This material is licensed under the Insolar License version 1.0,
+// available at https://github.com/insolar/insolar/blob/master/LICENSE.md.
+
+package proc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/bus"
+	"github.com/insolar/insolar/insolar/jet"
+	"github.com/insolar/insolar/insolar/payload"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/ledger/light/executor"
+	"github.com/insolar/insolar/ledger/object"
+)
+
+// SendState replies with the exact state of the object. Unlike SendObject it doesn't look for the latest state, so
+// historical states can be fetched (e.g. by validators). Event records of the object are sent the same way.
+type SendState struct {
+	message           payload.Meta
+	objectID, stateID insolar.ID
+	pass              bool
+
+	dep struct {
+		records     object.RecordAccessor
+		sender      bus.Sender
+		coordinator jet.Coordinator
+		fetcher     executor.JetFetcher
+	}
+}
+
+func NewSendState(msg payload.Meta, objectID, stateID insolar.ID, pass bool) *SendState {
+	return &SendState{
+		message:  msg,
+		objectID: objectID,
+		stateID:  stateID,
+		pass:     pass,
+	}
+}
+
+func (p *SendState) Dep(
+	records object.RecordAccessor,
+	sender bus.Sender,
+	coordinator jet.Coordinator,
+	fetcher executor.JetFetcher,
+) {
+	p.dep.records = records
+	p.dep.sender = sender
+	p.dep.coordinator = coordinator
+	p.dep.fetcher = fetcher
+}
+
+func (p *SendState) Proceed(ctx context.Context) error {
+	sendState := func(rec record.Material) error {
+		switch record.Unwrap(&rec.Virtual).(type) {
+		case record.State, *record.Event:
+		default:
+			return fmt.Errorf("unexpected state type")
+		}
+		if rec.ObjectID != p.objectID {
+			return fmt.Errorf("state belongs to another object")
+		}
+
+		buf, err := rec.Marshal()
+		if err != nil {
+			return errors.Wrap(err, "failed to marshal state record")
+		}
+		msg, err := payload.NewMessage(&payload.State{
+			Record: buf,
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to create reply")
+		}
+
+		p.dep.sender.Reply(ctx, p.message, msg)
+		return nil
+	}
+
+	sendPassState := func() error {
+		buf, err := p.message.Marshal()
+		if err != nil {
+			return errors.Wrap(err, "failed to marshal origin meta message")
+		}
+		msg, err := payload.NewMessage(&payload.Pass{
+			Origin: buf,
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to create reply")
+		}
+
+		onHeavy, err := p.dep.coordinator.IsBeyondLimit(ctx, p.stateID.Pulse())
+		if err != nil {
+			return errors.Wrap(err, "failed to calculate pulse")
+		}
+		var node insolar.Reference
+		if onHeavy {
+			h, err := p.dep.coordinator.Heavy(ctx)
+			if err != nil {
+				return errors.Wrap(err, "failed to calculate heavy")
+			}
+			node = *h
+		} else {
+			jetID, err := p.dep.fetcher.Fetch(ctx, p.objectID, p.stateID.Pulse())
+			if err != nil {
+				return errors.Wrap(err, "failed to fetch jet")
+			}
+			l, err := p.dep.coordinator.LightExecutorForJet(ctx, *jetID, p.stateID.Pulse())
+			if err != nil {
+				return errors.Wrap(err, "failed to calculate role")
+			}
+			node = *l
+		}
+
+		_, done := p.dep.sender.SendTarget(ctx, msg, node)
+		done()
+		return nil
+	}
+
+	rec, err := p.dep.records.ForID(ctx, p.stateID)
+	switch err {
+	case nil:
+		return sendState(rec)
+
+	case object.ErrNotFound:
+		if p.pass {
+			return sendPassState()
+		}
+
+		return &payload.CodedError{
+			Text: "state not found",
+			Code: payload.CodeNotFound,
+		}
+
+	default:
+		return errors.Wrap(err, "failed to fetch record")
+	}
+}
@@ -0,0 +1,166 @@
+// Copyright 2020 Insolar Network Ltd.
+// All rights reserved.
+// This material is licensed under the Insolar License version 1.0,
+// available at https://github.com/insolar/insolar/blob/master/LICENSE.md.
+
+package proc_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/gojuno/minimock/v3"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/bus"
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/insolar/jet"
+	"github.com/insolar/insolar/insolar/payload"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/ledger/light/executor"
+	"github.com/insolar/insolar/ledger/light/proc"
+	"github.com/insolar/insolar/ledger/object"
+)
+
+func TestSendState_Proceed(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	mc := minimock.NewController(t)
+
+	var (
+		records     *object.RecordAccessorMock
+		sender      *bus.SenderMock
+		coordinator *jet.CoordinatorMock
+		fetcher     *executor.JetFetcherMock
+	)
+
+	setup := func() {
+		records = object.NewRecordAccessorMock(mc)
+		sender = bus.NewSenderMock(mc)
+		coordinator = jet.NewCoordinatorMock(mc)
+		fetcher = executor.NewJetFetcherMock(mc)
+	}
+
+	t.Run("Passing state on heavy", func(t *testing.T) {
+		setup()
+		defer mc.Finish()
+
+		records.ForIDMock.Return(record.Material{}, object.ErrNotFound)
+
+		expectedTarget := insolar.NewReference(gen.ID())
+
+		coordinator.IsBeyondLimitMock.Return(true, nil)
+		coordinator.HeavyMock.Return(expectedTarget, nil)
+
+		meta := payload.Meta{}
+		buf, _ := meta.Marshal()
+		expectedPass, _ := payload.NewMessage(&payload.Pass{
+			Origin: buf,
+		})
+
+		sender.SendTargetMock.Inspect(func(ctx context.Context, msg *message.Message, target insolar.Reference) {
+			assert.Equal(t, expectedPass.Payload, msg.Payload)
+			assert.Equal(t, expectedTarget, &target)
+		}).Return(make(chan *message.Message), func() {})
+
+		p := proc.NewSendState(meta, gen.ID(), gen.ID(), true)
+		p.Dep(records, sender, coordinator, fetcher)
+
+		err := p.Proceed(ctx)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Not passing, returns error", func(t *testing.T) {
+		setup()
+		defer mc.Finish()
+
+		records.ForIDMock.Return(record.Material{}, object.ErrNotFound)
+
+		p := proc.NewSendState(payload.Meta{}, gen.ID(), gen.ID(), false)
+		p.Dep(records, sender, coordinator, fetcher)
+
+		err := p.Proceed(ctx)
+		assert.Error(t, err)
+		insError, ok := errors.Cause(err).(*payload.CodedError)
+		assert.True(t, ok)
+		assert.Equal(t, payload.CodeNotFound, insError.GetCode())
+	})
+
+	t.Run("State of another object", func(t *testing.T) {
+		setup()
+		defer mc.Finish()
+
+		records.ForIDMock.Return(record.Material{
+			Virtual:  record.Wrap(&record.Amend{}),
+			ID:       gen.ID(),
+			ObjectID: gen.ID(),
+		}, nil)
+
+		p := proc.NewSendState(payload.Meta{}, gen.ID(), gen.ID(), true)
+		p.Dep(records, sender, coordinator, fetcher)
+
+		err := p.Proceed(ctx)
+		assert.Error(t, err)
+	})
+
+	t.Run("Simple success", func(t *testing.T) {
+		setup()
+		defer mc.Finish()
+
+		objectID := gen.ID()
+		stateID := gen.ID()
+		rec := record.Material{
+			Virtual:  record.Wrap(&record.Amend{Memory: []byte{1, 2, 3}}),
+			ID:       stateID,
+			ObjectID: objectID,
+		}
+		records.ForIDMock.Expect(ctx, stateID).Return(rec, nil)
+
+		buf, _ := rec.Marshal()
+		expectedMsg, _ := payload.NewMessage(&payload.State{
+			Record: buf,
+		})
+
+		sender.ReplyMock.Inspect(func(ctx context.Context, origin payload.Meta, reply *message.Message) {
+			assert.Equal(t, expectedMsg.Payload, reply.Payload)
+		}).Return()
+
+		p := proc.NewSendState(payload.Meta{}, objectID, stateID, true)
+		p.Dep(records, sender, coordinator, fetcher)
+
+		err := p.Proceed(ctx)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Event success", func(t *testing.T) {
+		setup()
+		defer mc.Finish()
+
+		objectID := gen.ID()
+		eventID := gen.ID()
+		rec := record.Material{
+			Virtual:  record.Wrap(&record.Event{Object: objectID, Topic: "transfer"}),
+			ID:       eventID,
+			ObjectID: objectID,
+		}
+		records.ForIDMock.Expect(ctx, eventID).Return(rec, nil)
+
+		buf, _ := rec.Marshal()
+		expectedMsg, _ := payload.NewMessage(&payload.State{
+			Record: buf,
+		})
+
+		sender.ReplyMock.Inspect(func(ctx context.Context, origin payload.Meta, reply *message.Message) {
+			assert.Equal(t, expectedMsg.Payload, reply.Payload)
+		}).Return()
+
+		p := proc.NewSendState(payload.Meta{}, objectID, eventID, true)
+		p.Dep(records, sender, coordinator, fetcher)
+
+		err := p.Proceed(ctx)
+		assert.NoError(t, err)
+	})
+}
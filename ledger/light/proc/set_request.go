@@ -276,7 +276,10 @@ func (p *SetRequest) Proceed(ctx context.Context) error {
 		pn := p.requestID.Pulse()
 		index.Lifeline.EarliestOpenRequest = &pn
 	}
-	p.dep.indexes.Set(ctx, p.requestID.Pulse(), index)
+	err = p.dep.indexes.Set(ctx, p.requestID.Pulse(), index)
+	if err != nil {
+		return errors.Wrap(err, "failed to update index")
+	}
 
 	msg, err := payload.NewMessage(&payload.RequestInfo{
 		ObjectID:  objectID,
@@ -93,7 +93,7 @@ func TestSetRequest_Proceed(t *testing.T) {
 				StateID: record.StateActivation,
 			},
 		}, nil)
-		idxStorage.SetMock.Set(func(_ context.Context, pn insolar.PulseNumber, idx record.Index) {
+		idxStorage.SetMock.Set(func(_ context.Context, pn insolar.PulseNumber, idx record.Index) error {
 			require.Equal(t, requestID.Pulse(), pn)
 
 			virtual = record.Wrap(&record.PendingFilament{
@@ -112,6 +112,7 @@ func TestSetRequest_Proceed(t *testing.T) {
 				},
 			}
 			require.Equal(t, expectedIndex, idx)
+			return nil
 		})
 
 		writeAccessor.BeginMock.Return(func() {}, nil)
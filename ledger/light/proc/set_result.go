@@ -29,6 +29,7 @@ type SetResult struct {
 	result     record.Result
 	jetID      insolar.JetID
 	sideEffect record.State
+	events     []record.Event
 
 	dep struct {
 		writer           executor.WriteAccessor
@@ -47,12 +48,14 @@ func NewSetResult(
 	jetID insolar.JetID,
 	result record.Result,
 	sideEffect record.State,
+	events []record.Event,
 ) *SetResult {
 	return &SetResult{
 		message:    msg,
 		result:     result,
 		jetID:      jetID,
 		sideEffect: sideEffect,
+		events:     events,
 	}
 }
 
@@ -251,6 +254,19 @@ func (p *SetResult) Proceed(ctx context.Context) error {
 			}
 		}
 
+		// Create event records.
+		for i := range p.events {
+			virtual := record.Wrap(&p.events[i])
+			hash := record.HashVirtual(p.dep.pcs.ReferenceHasher(), virtual)
+			id := *insolar.NewID(resultID.Pulse(), hash)
+			toSave = append(toSave, record.Material{
+				Virtual:  virtual,
+				ID:       id,
+				ObjectID: objectID,
+				JetID:    p.jetID,
+			})
+		}
+
 		// Save all records.
 		err = p.dep.records.SetAtomic(ctx, toSave...)
 		if err != nil {
@@ -262,7 +278,10 @@ func (p *SetResult) Proceed(ctx context.Context) error {
 		index.Lifeline.LatestRequest = &Filament.ID
 		index.Lifeline.EarliestOpenRequest = earliestPending
 		index.Lifeline.OpenRequestsCount--
-		p.dep.indexes.Set(ctx, resultID.Pulse(), index)
+		err = p.dep.indexes.Set(ctx, resultID.Pulse(), index)
+		if err != nil {
+			return errors.Wrap(err, "failed to update index")
+		}
 		return nil
 	}()
 	if err != nil {
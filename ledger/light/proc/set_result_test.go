@@ -106,7 +106,17 @@ func TestSetResult_Proceed(t *testing.T) {
 	earliestID := gen.ID()
 	earliestPulse := earliestID.Pulse()
 
-	indexes.SetMock.Set(func(_ context.Context, pn insolar.PulseNumber, idx record.Index) {
+	events := []record.Event{{
+		Object:    objectID,
+		Request:   *insolar.NewReference(requestID),
+		Prototype: gen.Reference(),
+		Topic:     "created",
+		Payload:   []byte{1},
+	}}
+	hash = record.HashVirtual(pcs.ReferenceHasher(), record.Wrap(&events[0]))
+	expectedEventID := *insolar.NewID(resultID.Pulse(), hash)
+
+	indexes.SetMock.Set(func(_ context.Context, pn insolar.PulseNumber, idx record.Index) error {
 		require.Equal(t, resultID.Pulse(), pn)
 		expectedIndex := record.Index{
 			LifelineLastUsed: resultID.Pulse(),
@@ -119,15 +129,17 @@ func TestSetResult_Proceed(t *testing.T) {
 			},
 		}
 		require.Equal(t, expectedIndex, idx)
+		return nil
 	})
 
 	records := object.NewAtomicRecordModifierMock(mc)
 	records.SetAtomicMock.Set(func(_ context.Context, recs ...record.Material) (r error) {
-		require.Equal(t, 3, len(recs))
+		require.Equal(t, 4, len(recs))
 
 		result := recs[0]
 		filament := recs[1]
 		sideEffect := recs[2]
+		event := recs[3]
 		require.Equal(t, resultID, result.ID)
 		require.Equal(t, resultRecord, record.Unwrap(&result.Virtual))
 
@@ -135,6 +147,10 @@ func TestSetResult_Proceed(t *testing.T) {
 		require.Equal(t, &expectedFilament, record.Unwrap(&filament.Virtual))
 
 		require.Equal(t, &sideEffects, record.Unwrap(&sideEffect.Virtual))
+
+		require.Equal(t, expectedEventID, event.ID)
+		require.Equal(t, objectID, event.ObjectID)
+		require.Equal(t, &events[0], record.Unwrap(&event.Virtual))
 		return nil
 	})
 
@@ -178,7 +194,7 @@ func TestSetResult_Proceed(t *testing.T) {
 		require.Equal(t, opened, openedRequests)
 	}).Return()
 
-	setResultProc := proc.NewSetResult(msg, jetID, *resultRecord, &sideEffects)
+	setResultProc := proc.NewSetResult(msg, jetID, *resultRecord, &sideEffects, events)
 	setResultProc.Dep(writeAccessor, sender, object.NewIndexLocker(), filaments, records, indexes, pcs, detachedNotifier)
 
 	err = setResultProc.Proceed(ctx)
@@ -255,7 +271,7 @@ func TestSetResult_Proceed_ResultDuplicated(t *testing.T) {
 		require.Equal(t, virtual, receivedResult.Virtual)
 	})
 
-	setResultProc := proc.NewSetResult(msg, jetID, *res, nil)
+	setResultProc := proc.NewSetResult(msg, jetID, *res, nil, nil)
 	setResultProc.Dep(writeAccessor, sender, object.NewIndexLocker(), filaments, records, indexes, pcs, detachedNotifier)
 	err = setResultProc.Proceed(ctx)
 	require.NoError(t, err)
@@ -361,7 +377,7 @@ func TestSetResult_Proceed_ImmutableRequest_Error(t *testing.T) {
 		return opened, nil
 	})
 
-	setResultProc := proc.NewSetResult(msg, jetID, *resultRecord, &sideEffects)
+	setResultProc := proc.NewSetResult(msg, jetID, *resultRecord, &sideEffects, nil)
 	setResultProc.Dep(writeAccessor, sender, object.NewIndexLocker(), filaments, records, indexes, pcs, detachedNotifier)
 
 	err = setResultProc.Proceed(ctx)
@@ -469,7 +485,7 @@ func TestSetResult_Proceed_OutgoingRequest_Error(t *testing.T) {
 		return opened, nil
 	})
 
-	setResultProc := proc.NewSetResult(msg, jetID, *resultRecord, &sideEffects)
+	setResultProc := proc.NewSetResult(msg, jetID, *resultRecord, &sideEffects, nil)
 	setResultProc.Dep(writeAccessor, sender, object.NewIndexLocker(), filaments, records, indexes, pcs, detachedNotifier)
 
 	err = setResultProc.Proceed(ctx)
@@ -586,7 +602,7 @@ func TestSetResult_Proceed_NotFoundInOpened_Error(t *testing.T) {
 		return opened, nil
 	})
 
-	setResultProc := proc.NewSetResult(msg, jetID, *resultRecord, &sideEffects)
+	setResultProc := proc.NewSetResult(msg, jetID, *resultRecord, &sideEffects, nil)
 	setResultProc.Dep(writeAccessor, sender, object.NewIndexLocker(), filaments, records, indexes, pcs, detachedNotifier)
 
 	err = setResultProc.Proceed(ctx)
@@ -702,7 +718,7 @@ func TestSetResult_Proceed_HasOpenedOutgoing_Error(t *testing.T) {
 		return opened, nil
 	})
 
-	setResultProc := proc.NewSetResult(msg, jetID, *resultRecord, &sideEffects)
+	setResultProc := proc.NewSetResult(msg, jetID, *resultRecord, &sideEffects, nil)
 	setResultProc.Dep(writeAccessor, sender, object.NewIndexLocker(), filaments, records, indexes, pcs, detachedNotifier)
 
 	err = setResultProc.Proceed(ctx)
@@ -816,7 +832,7 @@ func TestSetResult_Proceed_OldestMutableRequest(t *testing.T) {
 		return opened, nil
 	})
 
-	setResultProc := proc.NewSetResult(msg, jetID, *resultRecord, nil)
+	setResultProc := proc.NewSetResult(msg, jetID, *resultRecord, nil, nil)
 	setResultProc.Dep(writeAccessor, sender, object.NewIndexLocker(), filaments, records, indexes, pcs, detachedNotifier)
 
 	err = setResultProc.Proceed(ctx)
@@ -898,7 +914,7 @@ func TestSetResult_Proceed_CloseImmutOutWhenOpenedMutableInFilaments(t *testing.
 	earliestID := gen.ID()
 	earliestPulse := earliestID.Pulse()
 
-	indexes.SetMock.Set(func(_ context.Context, pn insolar.PulseNumber, idx record.Index) {
+	indexes.SetMock.Set(func(_ context.Context, pn insolar.PulseNumber, idx record.Index) error {
 		require.Equal(t, resultID.Pulse(), pn)
 		expectedIndex := record.Index{
 			LifelineLastUsed: resultID.Pulse(),
@@ -910,6 +926,7 @@ func TestSetResult_Proceed_CloseImmutOutWhenOpenedMutableInFilaments(t *testing.
 			},
 		}
 		require.Equal(t, expectedIndex, idx)
+		return nil
 	})
 
 	records := object.NewAtomicRecordModifierMock(mc)
@@ -963,7 +980,7 @@ func TestSetResult_Proceed_CloseImmutOutWhenOpenedMutableInFilaments(t *testing.
 		return opened, nil
 	})
 
-	setResultProc := proc.NewSetResult(msg, jetID, *resultRecord, nil)
+	setResultProc := proc.NewSetResult(msg, jetID, *resultRecord, nil, nil)
 	setResultProc.Dep(writeAccessor, sender, object.NewIndexLocker(), filaments, records, indexes, pcs, detachedNotifier)
 
 	err = setResultProc.Proceed(ctx)
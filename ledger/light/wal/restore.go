@@ -0,0 +1,257 @@
+// Copyright 2020 Insolar Network Ltd.
+// All rights reserved.
+// This material is licensed under the Insolar License version 1.0,
+// available at https://github.com/insolar/insolar/blob/master/LICENSE.md.
+
+package wal
+
+import (
+	"context"
+	"encoding/binary"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/insolar"
+	insolarPulse "github.com/insolar/insolar/insolar/pulse"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/ledger/drop"
+)
+
+// Restore fills memory storages, pulses, nodes and jets with data, that was written to the log before restart.
+// It returns restored pulses in ascending order and the latest pulse, that was replicated to heavy.
+func (l *Log) Restore(ctx context.Context) ([]insolar.PulseNumber, insolar.PulseNumber, error) {
+	if !l.enabled() {
+		return nil, 0, nil
+	}
+
+	segments, err := l.listSegments()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var (
+		pulses     []insolar.PulseNumber
+		replicated insolar.PulseNumber
+	)
+	for _, pn := range segments {
+		entries, err := l.readSegment(ctx, pn)
+		if err != nil {
+			return nil, 0, err
+		}
+		for _, e := range entries {
+			err = l.restoreEntry(ctx, pn, e)
+			if err != nil {
+				return nil, 0, errors.Wrapf(err, "failed to restore WAL segment for pulse %s", pn)
+			}
+			switch e.kind {
+			case kindPulse:
+				pulses = append(pulses, pn)
+			case kindReplicated:
+				replicated = pn
+			}
+		}
+	}
+
+	inslogger.FromContext(ctx).WithFields(map[string]interface{}{
+		"segments":   len(segments),
+		"pulses":     len(pulses),
+		"replicated": replicated,
+	}).Info("light storages are restored from WAL")
+	return pulses, replicated, nil
+}
+
+// LatestPulse returns the latest pulse written to the log without restoring any data.
+// It returns insolarPulse.ErrNotFound if there are no pulses in the log.
+func (l *Log) LatestPulse(ctx context.Context) (insolar.Pulse, error) {
+	if !l.enabled() {
+		return insolar.Pulse{}, insolarPulse.ErrNotFound
+	}
+
+	segments, err := l.listSegments()
+	if err != nil {
+		return insolar.Pulse{}, err
+	}
+	for i := len(segments) - 1; i >= 0; i-- {
+		entries, err := l.readSegment(ctx, segments[i])
+		if err != nil {
+			return insolar.Pulse{}, err
+		}
+		for _, e := range entries {
+			if e.kind != kindPulse {
+				continue
+			}
+			pulse, _, _, err := unmarshalPulseEntry(e.payload)
+			if err != nil {
+				return insolar.Pulse{}, errors.Wrapf(err, "failed to read WAL segment for pulse %s", segments[i])
+			}
+			return pulse, nil
+		}
+	}
+	return insolar.Pulse{}, insolarPulse.ErrNotFound
+}
+
+// Discard removes all segments of the log. It's used, when data of the log can't continue the light chain.
+func (l *Log) Discard(ctx context.Context) error {
+	if !l.enabled() {
+		return nil
+	}
+
+	segments, err := l.listSegments()
+	if err != nil {
+		return err
+	}
+	for _, pn := range segments {
+		l.delete(ctx, pn)
+	}
+	inslogger.FromContext(ctx).Infof("%d WAL segments are discarded", len(segments))
+	return nil
+}
+
+func (l *Log) restoreEntry(ctx context.Context, pn insolar.PulseNumber, e entry) error {
+	switch e.kind {
+	case kindPulse:
+		pulse, nodes, leaves, err := unmarshalPulseEntry(e.payload)
+		if err != nil {
+			return err
+		}
+		if len(nodes) > 0 {
+			err = l.nodes.Set(pn, nodes)
+			if err != nil {
+				return errors.Wrap(err, "failed to set nodes")
+			}
+		}
+		for _, leaf := range leaves {
+			err = l.jets.Update(ctx, pn, leaf.actual, leaf.id)
+			if err != nil {
+				return errors.Wrap(err, "failed to update jets")
+			}
+		}
+		return errors.Wrap(l.pulses.Append(ctx, pulse), "failed to append pulse")
+	case kindDrop:
+		var dr drop.Drop
+		err := dr.Unmarshal(e.payload)
+		if err != nil {
+			return errors.Wrap(err, "failed to unmarshal drop")
+		}
+		return errors.Wrap(l.drops.Set(ctx, dr), "failed to set drop")
+	case kindRecords:
+		var recs []record.Material
+		err := readAllBytes(e.payload, func(buf []byte) error {
+			var rec record.Material
+			err := rec.Unmarshal(buf)
+			recs = append(recs, rec)
+			return err
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to unmarshal records")
+		}
+		return errors.Wrap(l.records.SetAtomic(ctx, recs...), "failed to set records")
+	case kindIndex:
+		var idx record.Index
+		err := idx.Unmarshal(e.payload)
+		if err != nil {
+			return errors.Wrap(err, "failed to unmarshal index")
+		}
+		return errors.Wrap(l.indexes.Set(ctx, pn, idx), "failed to set index")
+	case kindReplicated:
+		return nil
+	default:
+		return errors.Errorf("unknown entry kind %d", e.kind)
+	}
+}
+
+// pulse | nodes | jet leaves, every part is prefixed with its length.
+func marshalPulseEntry(pulse insolar.Pulse, nodes []insolar.Node, leaves []jetLeaf) ([]byte, error) {
+	pulseBuf, err := insolarPulse.ToProto(&pulse).Marshal()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal pulse")
+	}
+	nodeList := insolar.NodeList{Nodes: nodes}
+	nodesBuf, err := nodeList.Marshal()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal nodes")
+	}
+	var jetsBuf []byte
+	for _, leaf := range leaves {
+		id, err := leaf.id.Marshal()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to marshal jet")
+		}
+		if leaf.actual {
+			id = append(id, 1)
+		} else {
+			id = append(id, 0)
+		}
+		jetsBuf = appendBytes(jetsBuf, id)
+	}
+
+	var payload []byte
+	payload = appendBytes(payload, pulseBuf)
+	payload = appendBytes(payload, nodesBuf)
+	payload = appendBytes(payload, jetsBuf)
+	return payload, nil
+}
+
+func unmarshalPulseEntry(payload []byte) (insolar.Pulse, []insolar.Node, []jetLeaf, error) {
+	var parts [][]byte
+	err := readAllBytes(payload, func(buf []byte) error {
+		parts = append(parts, buf)
+		return nil
+	})
+	if err != nil || len(parts) != 3 {
+		return insolar.Pulse{}, nil, nil, errors.New("wrong pulse entry")
+	}
+
+	var pulseProto insolarPulse.PulseProto
+	err = pulseProto.Unmarshal(parts[0])
+	if err != nil {
+		return insolar.Pulse{}, nil, nil, errors.Wrap(err, "failed to unmarshal pulse")
+	}
+	var nodeList insolar.NodeList
+	err = nodeList.Unmarshal(parts[1])
+	if err != nil {
+		return insolar.Pulse{}, nil, nil, errors.Wrap(err, "failed to unmarshal nodes")
+	}
+	var leaves []jetLeaf
+	err = readAllBytes(parts[2], func(buf []byte) error {
+		if len(buf) < 1 {
+			return errors.New("empty jet")
+		}
+		var leaf jetLeaf
+		leaf.actual = buf[len(buf)-1] == 1
+		err := leaf.id.Unmarshal(buf[:len(buf)-1])
+		leaves = append(leaves, leaf)
+		return err
+	})
+	if err != nil {
+		return insolar.Pulse{}, nil, nil, errors.Wrap(err, "failed to unmarshal jets")
+	}
+	return *insolarPulse.FromProto(&pulseProto), nodeList.Nodes, leaves, nil
+}
+
+func appendBytes(buf []byte, data []byte) []byte {
+	size := make([]byte, 4)
+	binary.BigEndian.PutUint32(size, uint32(len(data)))
+	buf = append(buf, size...)
+	return append(buf, data...)
+}
+
+func readAllBytes(buf []byte, fn func([]byte) error) error {
+	for len(buf) > 0 {
+		if len(buf) < 4 {
+			return errors.New("incomplete length")
+		}
+		size := binary.BigEndian.Uint32(buf)
+		buf = buf[4:]
+		if uint32(len(buf)) < size {
+			return errors.New("incomplete data")
+		}
+		err := fn(buf[:size])
+		if err != nil {
+			return err
+		}
+		buf = buf[size:]
+	}
+	return nil
+}
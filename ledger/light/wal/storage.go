@@ -0,0 +1,206 @@
+// Copyright 2020 Insolar Network Ltd.
+// All rights reserved.
+// This material is licensed under the Insolar License version 1.0,
+// available at https://github.com/insolar/insolar/blob/master/LICENSE.md.
+
+package wal
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/node"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/ledger/drop"
+)
+
+// Drops is a drop storage, that writes drops to the log after they are saved to memory storage.
+type Drops struct {
+	log *Log
+	dropStorage
+}
+
+// Drops returns drop storage, that writes to the log.
+func (l *Log) Drops() *Drops {
+	return &Drops{log: l, dropStorage: l.drops}
+}
+
+// Set saves drop to memory and writes it to the log.
+func (d *Drops) Set(ctx context.Context, dr drop.Drop) error {
+	err := d.dropStorage.Set(ctx, dr)
+	if err != nil {
+		return err
+	}
+	payload, err := dr.Marshal()
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal drop")
+	}
+	return d.log.write(dr.Pulse, kindDrop, payload)
+}
+
+// DeleteForPN removes drops of the pulse from memory and removes segment of the pulse.
+func (d *Drops) DeleteForPN(ctx context.Context, pn insolar.PulseNumber) {
+	d.dropStorage.DeleteForPN(ctx, pn)
+	d.log.delete(ctx, pn)
+}
+
+// Records is a record storage, that writes records to the log after they are saved to memory storage.
+type Records struct {
+	log *Log
+	recordStorage
+}
+
+// Records returns record storage, that writes to the log.
+func (l *Log) Records() *Records {
+	return &Records{log: l, recordStorage: l.records}
+}
+
+// SetAtomic saves records to memory and writes them to the log. Records of the same pulse are written
+// as a single entry, so they are restored together.
+func (r *Records) SetAtomic(ctx context.Context, recs ...record.Material) error {
+	err := r.recordStorage.SetAtomic(ctx, recs...)
+	if err != nil {
+		return err
+	}
+
+	byPulse := map[insolar.PulseNumber][]record.Material{}
+	var pulses []insolar.PulseNumber
+	for _, rec := range recs {
+		pn := rec.ID.Pulse()
+		if _, ok := byPulse[pn]; !ok {
+			pulses = append(pulses, pn)
+		}
+		byPulse[pn] = append(byPulse[pn], rec)
+	}
+	for _, pn := range pulses {
+		var payload []byte
+		for _, rec := range byPulse[pn] {
+			buf, err := rec.Marshal()
+			if err != nil {
+				return errors.Wrap(err, "failed to marshal record")
+			}
+			payload = appendBytes(payload, buf)
+		}
+		err = r.log.write(pn, kindRecords, payload)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DeleteForPN removes records of the pulse from memory and removes segment of the pulse.
+func (r *Records) DeleteForPN(ctx context.Context, pn insolar.PulseNumber) {
+	r.recordStorage.DeleteForPN(ctx, pn)
+	r.log.delete(ctx, pn)
+}
+
+// Indexes is an index storage, that writes indexes to the log after they are saved to memory storage.
+type Indexes struct {
+	log *Log
+	indexStorage
+}
+
+// Indexes returns index storage, that writes to the log.
+func (l *Log) Indexes() *Indexes {
+	return &Indexes{log: l, indexStorage: l.indexes}
+}
+
+// Set saves index to memory and writes it to the log.
+func (i *Indexes) Set(ctx context.Context, pn insolar.PulseNumber, index record.Index) error {
+	if !i.log.enabled() {
+		return i.indexStorage.Set(ctx, pn, index)
+	}
+
+	i.log.indexLocker.Lock(index.ObjID)
+	defer i.log.indexLocker.Unlock(index.ObjID)
+
+	err := i.indexStorage.Set(ctx, pn, index)
+	if err != nil {
+		return err
+	}
+	return i.write(pn, index)
+}
+
+// SetIfNone saves index to memory and writes it to the log, if there is no index of the object in the pulse.
+func (i *Indexes) SetIfNone(ctx context.Context, pn insolar.PulseNumber, index record.Index) error {
+	if !i.log.enabled() {
+		return i.indexStorage.SetIfNone(ctx, pn, index)
+	}
+
+	i.log.indexLocker.Lock(index.ObjID)
+	defer i.log.indexLocker.Unlock(index.ObjID)
+
+	if _, err := i.indexStorage.ForID(ctx, pn, index.ObjID); err == nil {
+		return nil
+	}
+	err := i.indexStorage.Set(ctx, pn, index)
+	if err != nil {
+		return err
+	}
+	return i.write(pn, index)
+}
+
+func (i *Indexes) write(pn insolar.PulseNumber, index record.Index) error {
+	payload, err := index.Marshal()
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal index")
+	}
+	return errors.Wrapf(i.log.write(pn, kindIndex, payload), "failed to write index of %s to WAL", index.ObjID.DebugString())
+}
+
+// DeleteForPN removes indexes of the pulse from memory and removes segment of the pulse.
+func (i *Indexes) DeleteForPN(ctx context.Context, pn insolar.PulseNumber) {
+	i.indexStorage.DeleteForPN(ctx, pn)
+	i.log.delete(ctx, pn)
+}
+
+// Pulses appends pulses to pulse storage and writes them to the log with active nodes and jet tree of the pulse.
+type Pulses struct {
+	log *Log
+}
+
+// Pulses returns pulse appender, that writes to the log.
+func (l *Log) Pulses() *Pulses {
+	return &Pulses{log: l}
+}
+
+// Append appends pulse to the storage and writes it to the log. Nodes and jets of the pulse must be set before.
+func (p *Pulses) Append(ctx context.Context, pulse insolar.Pulse) error {
+	err := p.log.pulses.Append(ctx, pulse)
+	if err != nil {
+		return err
+	}
+	if !p.log.enabled() {
+		return nil
+	}
+
+	pn := pulse.PulseNumber
+	nodes, err := p.log.nodes.All(pn)
+	if err != nil && err != node.ErrNoNodes {
+		return errors.Wrap(err, "failed to get nodes")
+	}
+	payload, err := marshalPulseEntry(pulse, nodes, p.log.jetLeaves(ctx, pn))
+	if err != nil {
+		return err
+	}
+	return p.log.write(pn, kindPulse, payload)
+}
+
+type jetLeaf struct {
+	id     insolar.JetID
+	actual bool
+}
+
+func (l *Log) jetLeaves(ctx context.Context, pn insolar.PulseNumber) []jetLeaf {
+	ids := l.jets.All(ctx, pn)
+	leaves := make([]jetLeaf, 0, len(ids))
+	for _, id := range ids {
+		// Any record with jet prefix belongs to the jet itself.
+		_, actual := l.jets.ForID(ctx, pn, *insolar.NewID(pn, id.Prefix()))
+		leaves = append(leaves, jetLeaf{id: id, actual: actual})
+	}
+	return leaves
+}
@@ -0,0 +1,312 @@
+// Copyright 2020 Insolar Network Ltd.
+// All rights reserved.
+// This material is licensed under the Insolar License version 1.0,
+// available at https://github.com/insolar/insolar/blob/master/LICENSE.md.
+
+package wal
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/jet"
+	"github.com/insolar/insolar/insolar/node"
+	insolarPulse "github.com/insolar/insolar/insolar/pulse"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/ledger/drop"
+	"github.com/insolar/insolar/ledger/object"
+)
+
+const segmentExt = ".wal"
+
+type entryKind byte
+
+const (
+	kindPulse entryKind = iota + 1
+	kindDrop
+	kindRecords
+	kindIndex
+	kindReplicated
+)
+
+// kind | payload length | payload | crc32 of kind and payload
+const (
+	frameHeaderSize = 1 + 4
+	frameCRCSize    = 4
+	// maxPayloadSize protects from reading a huge length of a corrupted frame.
+	maxPayloadSize = 1 << 28
+)
+
+type dropStorage interface {
+	drop.Accessor
+	drop.Modifier
+	drop.Cleaner
+}
+
+type recordStorage interface {
+	object.AtomicRecordStorage
+	object.RecordCollectionAccessor
+	object.RecordCleaner
+}
+
+type indexStorage interface {
+	object.MemoryIndexStorage
+	object.IndexCleaner
+}
+
+type nodeStorage interface {
+	node.Accessor
+	node.Modifier
+}
+
+// Log is a write-ahead log of light storages. Data of every pulse is written to its own segment file,
+// that is removed, when data of the pulse is cleaned from storages.
+//
+// Log with empty directory is disabled, so storages work as plain memory storages.
+type Log struct {
+	dir        string
+	syncWrites bool
+
+	lock     sync.Mutex
+	segments map[insolar.PulseNumber]*os.File
+
+	drops   dropStorage
+	records recordStorage
+	indexes indexStorage
+	pulses  insolarPulse.Appender
+	nodes   nodeStorage
+	jets    jet.Storage
+
+	// indexLocker keeps order of updates of an object index in the log the same as in the storage.
+	indexLocker object.IndexLocker
+}
+
+// NewLog creates log for provided memory storages. Data is restored to them by Restore.
+func NewLog(
+	cfg configuration.WAL,
+	drops dropStorage,
+	records recordStorage,
+	indexes indexStorage,
+	pulses insolarPulse.Appender,
+	nodes nodeStorage,
+	jets jet.Storage,
+) (*Log, error) {
+	if cfg.Directory != "" {
+		err := os.MkdirAll(cfg.Directory, 0700)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create WAL directory")
+		}
+	}
+	return &Log{
+		dir:        cfg.Directory,
+		syncWrites: cfg.SyncWrites,
+		segments:   map[insolar.PulseNumber]*os.File{},
+		drops:      drops,
+		records:    records,
+		indexes:    indexes,
+		pulses:     pulses,
+		nodes:      nodes,
+		jets:       jets,
+
+		indexLocker: object.NewIndexLocker(),
+	}, nil
+}
+
+func (l *Log) enabled() bool {
+	return l.dir != ""
+}
+
+func (l *Log) segmentPath(pn insolar.PulseNumber) string {
+	return filepath.Join(l.dir, strconv.FormatUint(uint64(pn), 10)+segmentExt)
+}
+
+func (l *Log) write(pn insolar.PulseNumber, kind entryKind, payload []byte) error {
+	if !l.enabled() {
+		return nil
+	}
+
+	frame := make([]byte, frameHeaderSize, frameHeaderSize+len(payload)+frameCRCSize)
+	frame[0] = byte(kind)
+	binary.BigEndian.PutUint32(frame[1:], uint32(len(payload)))
+	frame = append(frame, payload...)
+	frame = frame[:len(frame)+frameCRCSize]
+	binary.BigEndian.PutUint32(frame[len(frame)-frameCRCSize:], frameCRC(kind, payload))
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	f, ok := l.segments[pn]
+	if !ok {
+		var err error
+		f, err = os.OpenFile(l.segmentPath(pn), os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+		if err != nil {
+			return errors.Wrapf(err, "failed to open WAL segment for pulse %s", pn)
+		}
+		l.segments[pn] = f
+	}
+	_, err := f.Write(frame)
+	if err != nil {
+		return errors.Wrapf(err, "failed to write WAL segment for pulse %s", pn)
+	}
+	if l.syncWrites {
+		err = f.Sync()
+		if err != nil {
+			return errors.Wrapf(err, "failed to sync WAL segment for pulse %s", pn)
+		}
+	}
+	return nil
+}
+
+// delete removes segment of the pulse. It's called by every storage, so it's fine if segment doesn't exist.
+func (l *Log) delete(ctx context.Context, pn insolar.PulseNumber) {
+	if !l.enabled() {
+		return
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	logger := inslogger.FromContext(ctx)
+	if f, ok := l.segments[pn]; ok {
+		delete(l.segments, pn)
+		if err := f.Close(); err != nil {
+			logger.Error(errors.Wrapf(err, "failed to close WAL segment for pulse %s", pn))
+		}
+	}
+	err := os.Remove(l.segmentPath(pn))
+	if err != nil && !os.IsNotExist(err) {
+		logger.Error(errors.Wrapf(err, "failed to remove WAL segment for pulse %s", pn))
+	}
+}
+
+// Replicated marks data of the pulse as replicated to heavy.
+func (l *Log) Replicated(ctx context.Context, pn insolar.PulseNumber) error {
+	return l.write(pn, kindReplicated, nil)
+}
+
+// Close closes segment files.
+func (l *Log) Close() error {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	var result error
+	for pn, f := range l.segments {
+		if err := f.Close(); err != nil {
+			result = errors.Wrapf(err, "failed to close WAL segment for pulse %s", pn)
+		}
+		delete(l.segments, pn)
+	}
+	return result
+}
+
+func frameCRC(kind entryKind, payload []byte) uint32 {
+	crc := crc32.ChecksumIEEE([]byte{byte(kind)})
+	return crc32.Update(crc, crc32.IEEETable, payload)
+}
+
+type entry struct {
+	kind    entryKind
+	payload []byte
+}
+
+// listSegments returns pulses of segments in ascending order.
+func (l *Log) listSegments() ([]insolar.PulseNumber, error) {
+	files, err := ioutil.ReadDir(l.dir)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read WAL directory")
+	}
+	var pulses []insolar.PulseNumber
+	for _, f := range files {
+		name := f.Name()
+		if f.IsDir() || !strings.HasSuffix(name, segmentExt) {
+			continue
+		}
+		pn, err := strconv.ParseUint(strings.TrimSuffix(name, segmentExt), 10, 32)
+		if err != nil {
+			continue
+		}
+		pulses = append(pulses, insolar.PulseNumber(pn))
+	}
+	sort.Slice(pulses, func(i, j int) bool { return pulses[i] < pulses[j] })
+	return pulses, nil
+}
+
+// readSegment returns entries of the segment. Incomplete or corrupted tail of the segment is left
+// by interrupted write. It's cut off, so new entries are appended after the last complete one.
+func (l *Log) readSegment(ctx context.Context, pn insolar.PulseNumber) ([]entry, error) {
+	path := l.segmentPath(pn)
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to open WAL segment for pulse %s", pn)
+	}
+	defer f.Close()
+
+	var (
+		entries []entry
+		size    int64
+		tailErr error
+	)
+	r := bufio.NewReader(f)
+	for {
+		e, n, err := readFrame(r)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			tailErr = err
+			break
+		}
+		entries = append(entries, e)
+		size += n
+	}
+
+	if tailErr != nil {
+		inslogger.FromContext(ctx).Warnf("WAL segment for pulse %s is truncated at %d: %s", pn, size, tailErr)
+		err = os.Truncate(path, size)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to truncate WAL segment for pulse %s", pn)
+		}
+	}
+	return entries, nil
+}
+
+func readFrame(r io.Reader) (entry, int64, error) {
+	header := make([]byte, frameHeaderSize)
+	n, err := io.ReadFull(r, header)
+	if err == io.EOF {
+		return entry{}, 0, io.EOF
+	}
+	if err != nil {
+		return entry{}, 0, fmt.Errorf("incomplete header of %d bytes", n)
+	}
+	e := entry{kind: entryKind(header[0])}
+	size := binary.BigEndian.Uint32(header[1:])
+	if size > maxPayloadSize {
+		return entry{}, 0, fmt.Errorf("wrong entry size %d", size)
+	}
+	rest := make([]byte, int(size)+frameCRCSize)
+	_, err = io.ReadFull(r, rest)
+	if err != nil {
+		return entry{}, 0, errors.New("incomplete entry")
+	}
+	e.payload = rest[:len(rest)-frameCRCSize]
+	if binary.BigEndian.Uint32(rest[len(e.payload):]) != frameCRC(e.kind, e.payload) {
+		return entry{}, 0, errors.New("wrong checksum")
+	}
+	return e, int64(frameHeaderSize + len(rest)), nil
+}
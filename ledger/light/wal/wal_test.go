@@ -0,0 +1,338 @@
+// Copyright 2020 Insolar Network Ltd.
+// All rights reserved.
+// This material is licensed under the Insolar License version 1.0,
+// available at https://github.com/insolar/insolar/blob/master/LICENSE.md.
+
+package wal
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/insolar/jet"
+	"github.com/insolar/insolar/insolar/node"
+	insolarPulse "github.com/insolar/insolar/insolar/pulse"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/ledger/drop"
+	"github.com/insolar/insolar/ledger/object"
+	"github.com/insolar/insolar/pulse"
+)
+
+type testStorages struct {
+	log     *Log
+	pulses  *insolarPulse.StorageMem
+	nodes   *node.Storage
+	jets    *jet.Store
+	drops   drop.Accessor
+	records *object.RecordMemory
+	indexes *object.IndexStorageMemory
+}
+
+func newTestStorages(t *testing.T, dir string) *testStorages {
+	s := &testStorages{
+		pulses:  insolarPulse.NewStorageMem(),
+		nodes:   node.NewStorage(),
+		jets:    jet.NewStore(),
+		records: object.NewRecordMemory(),
+		indexes: object.NewIndexStorageMemory(),
+	}
+	drops := drop.NewStorageMemory()
+	s.drops = drops
+
+	var err error
+	s.log, err = NewLog(
+		configuration.WAL{Directory: dir, SyncWrites: true},
+		drops, s.records, s.indexes, s.pulses, s.nodes, s.jets,
+	)
+	require.NoError(t, err)
+	return s
+}
+
+func tempDir(t *testing.T) (string, func()) {
+	dir, err := ioutil.TempDir("", "light-wal-")
+	require.NoError(t, err)
+	return dir, func() { os.RemoveAll(dir) }
+}
+
+func testPulse(pn insolar.PulseNumber) insolar.Pulse {
+	return insolar.Pulse{
+		PulseNumber:     pn,
+		PrevPulseNumber: pn - 10,
+		NextPulseNumber: pn + 10,
+		Entropy:         insolar.Entropy{byte(pn)},
+		Signs:           map[string]insolar.PulseSenderConfirmation{},
+	}
+}
+
+func testRecord(pn insolar.PulseNumber, jetID insolar.JetID) record.Material {
+	return record.Material{
+		ID:      gen.IDWithPulse(pn),
+		JetID:   jetID,
+		Virtual: record.Wrap(&record.IncomingRequest{Method: "test"}),
+	}
+}
+
+// fill writes two pulses to the log: the first one is ended and replicated, the second one is current.
+func fill(ctx context.Context, t *testing.T, s *testStorages) ([]insolar.PulseNumber, []record.Material, record.Index) {
+	pulses := []insolar.PulseNumber{pulse.MinTimePulse, pulse.MinTimePulse + 10}
+	nodes := []insolar.Node{{ID: gen.Reference(), Role: insolar.StaticRoleLightMaterial}}
+	left, right := jet.Siblings(insolar.ZeroJetID)
+
+	var recs []record.Material
+	for _, pn := range pulses {
+		require.NoError(t, s.nodes.Set(pn, nodes))
+		require.NoError(t, s.jets.Update(ctx, pn, true, left))
+		require.NoError(t, s.jets.Update(ctx, pn, false, right))
+		require.NoError(t, s.log.Pulses().Append(ctx, testPulse(pn)))
+
+		rec := testRecord(pn, left)
+		require.NoError(t, s.log.Records().SetAtomic(ctx, rec))
+		recs = append(recs, rec)
+	}
+	require.NoError(t, s.log.Drops().Set(ctx, drop.Drop{Pulse: pulses[0], JetID: left, Split: true}))
+	require.NoError(t, s.log.Replicated(ctx, pulses[0]))
+
+	objID := gen.ID()
+	idx := record.Index{ObjID: objID, LifelineLastUsed: pulses[1]}
+	require.NoError(t, s.log.Indexes().SetIfNone(ctx, pulses[1], record.Index{ObjID: objID, LifelineLastUsed: pulses[0]}))
+	require.NoError(t, s.log.Indexes().Set(ctx, pulses[1], idx))
+	// Existing index isn't overwritten.
+	require.NoError(t, s.log.Indexes().SetIfNone(ctx, pulses[1], record.Index{ObjID: objID, LifelineLastUsed: pulses[0]}))
+	return pulses, recs, idx
+}
+
+func TestLog_Restore(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	dir, cleanup := tempDir(t)
+	defer cleanup()
+
+	s := newTestStorages(t, dir)
+	pulses, recs, idx := fill(ctx, t, s)
+	require.NoError(t, s.log.Close())
+
+	restored := newTestStorages(t, dir)
+	restoredPulses, replicated, err := restored.log.Restore(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, pulses, restoredPulses)
+	assert.Equal(t, pulses[0], replicated)
+
+	for _, pn := range pulses {
+		p, err := restored.pulses.ForPulseNumber(ctx, pn)
+		require.NoError(t, err)
+		assert.Equal(t, testPulse(pn), p)
+
+		expectedNodes, _ := s.nodes.All(pn)
+		nodes, err := restored.nodes.All(pn)
+		require.NoError(t, err)
+		assert.Equal(t, expectedNodes, nodes)
+
+		assert.ElementsMatch(t, s.jets.All(ctx, pn), restored.jets.All(ctx, pn))
+		for _, jetID := range s.jets.All(ctx, pn) {
+			_, expected := s.jets.ForID(ctx, pn, *insolar.NewID(pn, jetID.Prefix()))
+			_, actual := restored.jets.ForID(ctx, pn, *insolar.NewID(pn, jetID.Prefix()))
+			assert.Equal(t, expected, actual)
+		}
+	}
+	prev, err := restored.pulses.Backwards(ctx, pulses[1], 1)
+	require.NoError(t, err)
+	assert.Equal(t, pulses[0], prev.PulseNumber)
+
+	for _, rec := range recs {
+		restoredRec, err := restored.records.ForID(ctx, rec.ID)
+		require.NoError(t, err)
+		assert.Equal(t, rec, restoredRec)
+	}
+
+	expectedDrop, err := s.drops.ForPulse(ctx, recs[0].JetID, pulses[0])
+	require.NoError(t, err)
+	restoredDrop, err := restored.drops.ForPulse(ctx, recs[0].JetID, pulses[0])
+	require.NoError(t, err)
+	assert.Equal(t, expectedDrop, restoredDrop)
+
+	restoredIdx, err := restored.indexes.ForID(ctx, pulses[1], idx.ObjID)
+	require.NoError(t, err)
+	assert.Equal(t, idx.LifelineLastUsed, restoredIdx.LifelineLastUsed)
+}
+
+func TestLog_LatestPulse(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	dir, cleanup := tempDir(t)
+	defer cleanup()
+
+	s := newTestStorages(t, dir)
+	_, err := s.log.LatestPulse(ctx)
+	assert.Equal(t, insolarPulse.ErrNotFound, err)
+
+	pulses, _, _ := fill(ctx, t, s)
+	// Segment of the next pulse without pulse entry.
+	require.NoError(t, s.log.Replicated(ctx, pulses[1]+10))
+	require.NoError(t, s.log.Close())
+
+	restored := newTestStorages(t, dir)
+	latest, err := restored.log.LatestPulse(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, testPulse(pulses[1]), latest)
+	// Storages are filled by Restore only.
+	_, err = restored.pulses.Latest(ctx)
+	assert.Equal(t, insolarPulse.ErrNotFound, err)
+}
+
+func TestLog_Discard(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	dir, cleanup := tempDir(t)
+	defer cleanup()
+
+	s := newTestStorages(t, dir)
+	fill(ctx, t, s)
+	require.NoError(t, s.log.Discard(ctx))
+	files, err := ioutil.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Empty(t, files)
+
+	restored := newTestStorages(t, dir)
+	restoredPulses, _, err := restored.log.Restore(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, restoredPulses)
+}
+
+func TestIndexes_WriteError(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	dir, cleanup := tempDir(t)
+	defer cleanup()
+
+	s := newTestStorages(t, dir)
+	pn := insolar.PulseNumber(pulse.MinTimePulse)
+	// Segment can't be created in place of a directory.
+	require.NoError(t, os.Mkdir(s.log.segmentPath(pn), 0700))
+
+	err := s.log.Indexes().Set(ctx, pn, record.Index{ObjID: gen.ID()})
+	assert.Error(t, err)
+}
+
+func TestLog_DeleteForPN(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	dir, cleanup := tempDir(t)
+	defer cleanup()
+
+	s := newTestStorages(t, dir)
+	pulses, _, _ := fill(ctx, t, s)
+
+	s.log.Drops().DeleteForPN(ctx, pulses[0])
+	s.log.Records().DeleteForPN(ctx, pulses[0])
+	s.log.Indexes().DeleteForPN(ctx, pulses[0])
+	_, err := os.Stat(s.log.segmentPath(pulses[0]))
+	assert.True(t, os.IsNotExist(err))
+	require.NoError(t, s.log.Close())
+
+	restored := newTestStorages(t, dir)
+	restoredPulses, replicated, err := restored.log.Restore(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, pulses[1:], restoredPulses)
+	assert.Equal(t, insolar.PulseNumber(0), replicated)
+}
+
+func TestLog_RestoreTruncatedSegment(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	dir, cleanup := tempDir(t)
+	defer cleanup()
+
+	s := newTestStorages(t, dir)
+	pn := insolar.PulseNumber(pulse.MinTimePulse)
+	rec := testRecord(pn, insolar.ZeroJetID)
+	require.NoError(t, s.log.Records().SetAtomic(ctx, rec))
+	require.NoError(t, s.log.Close())
+
+	path := s.log.segmentPath(pn)
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0600)
+	require.NoError(t, err)
+	// Interrupted write of the next entry.
+	_, err = f.Write([]byte{byte(kindRecords), 0, 0, 1})
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	restored := newTestStorages(t, dir)
+	_, _, err = restored.log.Restore(ctx)
+	require.NoError(t, err)
+	_, err = restored.records.ForID(ctx, rec.ID)
+	require.NoError(t, err)
+
+	truncated, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, info.Size(), truncated.Size())
+
+	// New entries are appended after the last complete one.
+	next := testRecord(pn, insolar.ZeroJetID)
+	require.NoError(t, restored.log.Records().SetAtomic(ctx, next))
+	require.NoError(t, restored.log.Close())
+
+	again := newTestStorages(t, dir)
+	_, _, err = again.log.Restore(ctx)
+	require.NoError(t, err)
+	assert.Len(t, again.records.ForPulse(ctx, insolar.ZeroJetID, pn), 2)
+}
+
+func TestLog_Disabled(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+
+	s := newTestStorages(t, "")
+	filled, _, idx := fill(ctx, t, s)
+	stored, err := s.indexes.ForID(ctx, filled[1], idx.ObjID)
+	require.NoError(t, err)
+	assert.Equal(t, idx, stored)
+
+	pulses, replicated, err := s.log.Restore(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, pulses)
+	assert.Equal(t, insolar.PulseNumber(0), replicated)
+}
+
+func TestIndexes_ConcurrentObjects(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	dir, cleanup := tempDir(t)
+	defer cleanup()
+
+	s := newTestStorages(t, dir)
+	pn := insolar.PulseNumber(pulse.MinTimePulse)
+	require.NoError(t, s.log.Pulses().Append(ctx, testPulse(pn)))
+
+	const objects, updates = 10, 20
+	ids := make([]insolar.ID, objects)
+	var wg sync.WaitGroup
+	wg.Add(objects * updates)
+	for i := range ids {
+		ids[i] = gen.ID()
+		for j := 1; j <= updates; j++ {
+			go func(id insolar.ID, count uint32) {
+				defer wg.Done()
+				idx := record.Index{ObjID: id, Lifeline: record.Lifeline{OpenRequestsCount: count}}
+				assert.NoError(t, s.log.Indexes().Set(ctx, pn, idx))
+			}(ids[i], uint32(j))
+		}
+	}
+	wg.Wait()
+	require.NoError(t, s.log.Close())
+
+	restored := newTestStorages(t, dir)
+	_, _, err := restored.log.Restore(ctx)
+	require.NoError(t, err)
+	for _, id := range ids {
+		// Log keeps the order of updates of the object, so the last one in memory is restored.
+		expected, err := s.indexes.ForID(ctx, pn, id)
+		require.NoError(t, err)
+		actual, err := restored.indexes.ForID(ctx, pn, id)
+		require.NoError(t, err)
+		assert.Equal(t, expected, actual)
+	}
+}
@@ -39,8 +39,8 @@ type IndexAccessor interface {
 
 // MemoryIndexModifier writes index to in-memory storage.
 type MemoryIndexModifier interface {
-	Set(ctx context.Context, pn insolar.PulseNumber, index record.Index)
-	SetIfNone(ctx context.Context, pn insolar.PulseNumber, index record.Index)
+	Set(ctx context.Context, pn insolar.PulseNumber, index record.Index) error
+	SetIfNone(ctx context.Context, pn insolar.PulseNumber, index record.Index) error
 }
 
 //go:generate minimock -i github.com/insolar/insolar/ledger/object.MemoryIndexAccessor -o ./ -s _mock.go -g
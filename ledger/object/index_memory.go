@@ -62,7 +62,7 @@ func (i *IndexStorageMemory) ForPulse(ctx context.Context, pn insolar.PulseNumbe
 	return res, nil
 }
 
-func (i *IndexStorageMemory) Set(ctx context.Context, pn insolar.PulseNumber, bucket record.Index) {
+func (i *IndexStorageMemory) Set(ctx context.Context, pn insolar.PulseNumber, bucket record.Index) error {
 	i.bucketsLock.Lock()
 	defer i.bucketsLock.Unlock()
 
@@ -71,9 +71,10 @@ func (i *IndexStorageMemory) Set(ctx context.Context, pn insolar.PulseNumber, bu
 		i.buckets[pn] = map[insolar.ID]*record.Index{}
 	}
 	i.set(ctx, pn, bucket)
+	return nil
 }
 
-func (i *IndexStorageMemory) SetIfNone(ctx context.Context, pn insolar.PulseNumber, bucket record.Index) {
+func (i *IndexStorageMemory) SetIfNone(ctx context.Context, pn insolar.PulseNumber, bucket record.Index) error {
 	i.bucketsLock.Lock()
 	defer i.bucketsLock.Unlock()
 
@@ -82,9 +83,10 @@ func (i *IndexStorageMemory) SetIfNone(ctx context.Context, pn insolar.PulseNumb
 		i.buckets[pn] = map[insolar.ID]*record.Index{}
 	}
 	if _, ok := i.buckets[pn][bucket.ObjID]; ok {
-		return
+		return nil
 	}
 	i.set(ctx, pn, bucket)
+	return nil
 }
 
 func (i *IndexStorageMemory) set(ctx context.Context, pn insolar.PulseNumber, bucket record.Index) {
@@ -19,13 +19,13 @@ import (
 type MemoryIndexModifierMock struct {
 	t minimock.Tester
 
-	funcSet          func(ctx context.Context, pn insolar.PulseNumber, index record.Index)
+	funcSet          func(ctx context.Context, pn insolar.PulseNumber, index record.Index) (err error)
 	inspectFuncSet   func(ctx context.Context, pn insolar.PulseNumber, index record.Index)
 	afterSetCounter  uint64
 	beforeSetCounter uint64
 	SetMock          mMemoryIndexModifierMockSet
 
-	funcSetIfNone          func(ctx context.Context, pn insolar.PulseNumber, index record.Index)
+	funcSetIfNone          func(ctx context.Context, pn insolar.PulseNumber, index record.Index) (err error)
 	inspectFuncSetIfNone   func(ctx context.Context, pn insolar.PulseNumber, index record.Index)
 	afterSetIfNoneCounter  uint64
 	beforeSetIfNoneCounter uint64
@@ -59,9 +59,9 @@ type mMemoryIndexModifierMockSet struct {
 
 // MemoryIndexModifierMockSetExpectation specifies expectation struct of the MemoryIndexModifier.Set
 type MemoryIndexModifierMockSetExpectation struct {
-	mock   *MemoryIndexModifierMock
-	params *MemoryIndexModifierMockSetParams
-
+	mock    *MemoryIndexModifierMock
+	params  *MemoryIndexModifierMockSetParams
+	results *MemoryIndexModifierMockSetResults
 	Counter uint64
 }
 
@@ -72,6 +72,11 @@ type MemoryIndexModifierMockSetParams struct {
 	index record.Index
 }
 
+// MemoryIndexModifierMockSetResults contains results of the MemoryIndexModifier.Set
+type MemoryIndexModifierMockSetResults struct {
+	err error
+}
+
 // Expect sets up expected params for MemoryIndexModifier.Set
 func (mmSet *mMemoryIndexModifierMockSet) Expect(ctx context.Context, pn insolar.PulseNumber, index record.Index) *mMemoryIndexModifierMockSet {
 	if mmSet.mock.funcSet != nil {
@@ -104,7 +109,7 @@ func (mmSet *mMemoryIndexModifierMockSet) Inspect(f func(ctx context.Context, pn
 }
 
 // Return sets up results that will be returned by MemoryIndexModifier.Set
-func (mmSet *mMemoryIndexModifierMockSet) Return() *MemoryIndexModifierMock {
+func (mmSet *mMemoryIndexModifierMockSet) Return(err error) *MemoryIndexModifierMock {
 	if mmSet.mock.funcSet != nil {
 		mmSet.mock.t.Fatalf("MemoryIndexModifierMock.Set mock is already set by Set")
 	}
@@ -112,12 +117,12 @@ func (mmSet *mMemoryIndexModifierMockSet) Return() *MemoryIndexModifierMock {
 	if mmSet.defaultExpectation == nil {
 		mmSet.defaultExpectation = &MemoryIndexModifierMockSetExpectation{mock: mmSet.mock}
 	}
-
+	mmSet.defaultExpectation.results = &MemoryIndexModifierMockSetResults{err}
 	return mmSet.mock
 }
 
-//Set uses given function f to mock the MemoryIndexModifier.Set method
-func (mmSet *mMemoryIndexModifierMockSet) Set(f func(ctx context.Context, pn insolar.PulseNumber, index record.Index)) *MemoryIndexModifierMock {
+// Set uses given function f to mock the MemoryIndexModifier.Set method
+func (mmSet *mMemoryIndexModifierMockSet) Set(f func(ctx context.Context, pn insolar.PulseNumber, index record.Index) (err error)) *MemoryIndexModifierMock {
 	if mmSet.defaultExpectation != nil {
 		mmSet.mock.t.Fatalf("Default expectation is already set for the MemoryIndexModifier.Set method")
 	}
@@ -130,8 +135,29 @@ func (mmSet *mMemoryIndexModifierMockSet) Set(f func(ctx context.Context, pn ins
 	return mmSet.mock
 }
 
+// When sets expectation for the MemoryIndexModifier.Set which will trigger the result defined by the following
+// Then helper
+func (mmSet *mMemoryIndexModifierMockSet) When(ctx context.Context, pn insolar.PulseNumber, index record.Index) *MemoryIndexModifierMockSetExpectation {
+	if mmSet.mock.funcSet != nil {
+		mmSet.mock.t.Fatalf("MemoryIndexModifierMock.Set mock is already set by Set")
+	}
+
+	expectation := &MemoryIndexModifierMockSetExpectation{
+		mock:   mmSet.mock,
+		params: &MemoryIndexModifierMockSetParams{ctx, pn, index},
+	}
+	mmSet.expectations = append(mmSet.expectations, expectation)
+	return expectation
+}
+
+// Then sets up MemoryIndexModifier.Set return parameters for the expectation previously defined by the When method
+func (e *MemoryIndexModifierMockSetExpectation) Then(err error) *MemoryIndexModifierMock {
+	e.results = &MemoryIndexModifierMockSetResults{err}
+	return e.mock
+}
+
 // Set implements MemoryIndexModifier
-func (mmSet *MemoryIndexModifierMock) Set(ctx context.Context, pn insolar.PulseNumber, index record.Index) {
+func (mmSet *MemoryIndexModifierMock) Set(ctx context.Context, pn insolar.PulseNumber, index record.Index) (err error) {
 	mm_atomic.AddUint64(&mmSet.beforeSetCounter, 1)
 	defer mm_atomic.AddUint64(&mmSet.afterSetCounter, 1)
 
@@ -149,7 +175,7 @@ func (mmSet *MemoryIndexModifierMock) Set(ctx context.Context, pn insolar.PulseN
 	for _, e := range mmSet.SetMock.expectations {
 		if minimock.Equal(e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return
+			return e.results.err
 		}
 	}
 
@@ -161,15 +187,17 @@ func (mmSet *MemoryIndexModifierMock) Set(ctx context.Context, pn insolar.PulseN
 			mmSet.t.Errorf("MemoryIndexModifierMock.Set got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		return
-
+		mm_results := mmSet.SetMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSet.t.Fatal("No results are set for the MemoryIndexModifierMock.Set")
+		}
+		return (*mm_results).err
 	}
 	if mmSet.funcSet != nil {
-		mmSet.funcSet(ctx, pn, index)
-		return
+		return mmSet.funcSet(ctx, pn, index)
 	}
 	mmSet.t.Fatalf("Unexpected call to MemoryIndexModifierMock.Set. %v %v %v", ctx, pn, index)
-
+	return
 }
 
 // SetAfterCounter returns a count of finished MemoryIndexModifierMock.Set invocations
@@ -248,9 +276,9 @@ type mMemoryIndexModifierMockSetIfNone struct {
 
 // MemoryIndexModifierMockSetIfNoneExpectation specifies expectation struct of the MemoryIndexModifier.SetIfNone
 type MemoryIndexModifierMockSetIfNoneExpectation struct {
-	mock   *MemoryIndexModifierMock
-	params *MemoryIndexModifierMockSetIfNoneParams
-
+	mock    *MemoryIndexModifierMock
+	params  *MemoryIndexModifierMockSetIfNoneParams
+	results *MemoryIndexModifierMockSetIfNoneResults
 	Counter uint64
 }
 
@@ -261,6 +289,11 @@ type MemoryIndexModifierMockSetIfNoneParams struct {
 	index record.Index
 }
 
+// MemoryIndexModifierMockSetIfNoneResults contains results of the MemoryIndexModifier.SetIfNone
+type MemoryIndexModifierMockSetIfNoneResults struct {
+	err error
+}
+
 // Expect sets up expected params for MemoryIndexModifier.SetIfNone
 func (mmSetIfNone *mMemoryIndexModifierMockSetIfNone) Expect(ctx context.Context, pn insolar.PulseNumber, index record.Index) *mMemoryIndexModifierMockSetIfNone {
 	if mmSetIfNone.mock.funcSetIfNone != nil {
@@ -293,7 +326,7 @@ func (mmSetIfNone *mMemoryIndexModifierMockSetIfNone) Inspect(f func(ctx context
 }
 
 // Return sets up results that will be returned by MemoryIndexModifier.SetIfNone
-func (mmSetIfNone *mMemoryIndexModifierMockSetIfNone) Return() *MemoryIndexModifierMock {
+func (mmSetIfNone *mMemoryIndexModifierMockSetIfNone) Return(err error) *MemoryIndexModifierMock {
 	if mmSetIfNone.mock.funcSetIfNone != nil {
 		mmSetIfNone.mock.t.Fatalf("MemoryIndexModifierMock.SetIfNone mock is already set by Set")
 	}
@@ -301,12 +334,12 @@ func (mmSetIfNone *mMemoryIndexModifierMockSetIfNone) Return() *MemoryIndexModif
 	if mmSetIfNone.defaultExpectation == nil {
 		mmSetIfNone.defaultExpectation = &MemoryIndexModifierMockSetIfNoneExpectation{mock: mmSetIfNone.mock}
 	}
-
+	mmSetIfNone.defaultExpectation.results = &MemoryIndexModifierMockSetIfNoneResults{err}
 	return mmSetIfNone.mock
 }
 
-//Set uses given function f to mock the MemoryIndexModifier.SetIfNone method
-func (mmSetIfNone *mMemoryIndexModifierMockSetIfNone) Set(f func(ctx context.Context, pn insolar.PulseNumber, index record.Index)) *MemoryIndexModifierMock {
+// Set uses given function f to mock the MemoryIndexModifier.SetIfNone method
+func (mmSetIfNone *mMemoryIndexModifierMockSetIfNone) Set(f func(ctx context.Context, pn insolar.PulseNumber, index record.Index) (err error)) *MemoryIndexModifierMock {
 	if mmSetIfNone.defaultExpectation != nil {
 		mmSetIfNone.mock.t.Fatalf("Default expectation is already set for the MemoryIndexModifier.SetIfNone method")
 	}
@@ -319,8 +352,29 @@ func (mmSetIfNone *mMemoryIndexModifierMockSetIfNone) Set(f func(ctx context.Con
 	return mmSetIfNone.mock
 }
 
+// When sets expectation for the MemoryIndexModifier.SetIfNone which will trigger the result defined by the following
+// Then helper
+func (mmSetIfNone *mMemoryIndexModifierMockSetIfNone) When(ctx context.Context, pn insolar.PulseNumber, index record.Index) *MemoryIndexModifierMockSetIfNoneExpectation {
+	if mmSetIfNone.mock.funcSetIfNone != nil {
+		mmSetIfNone.mock.t.Fatalf("MemoryIndexModifierMock.SetIfNone mock is already set by Set")
+	}
+
+	expectation := &MemoryIndexModifierMockSetIfNoneExpectation{
+		mock:   mmSetIfNone.mock,
+		params: &MemoryIndexModifierMockSetIfNoneParams{ctx, pn, index},
+	}
+	mmSetIfNone.expectations = append(mmSetIfNone.expectations, expectation)
+	return expectation
+}
+
+// Then sets up MemoryIndexModifier.SetIfNone return parameters for the expectation previously defined by the When method
+func (e *MemoryIndexModifierMockSetIfNoneExpectation) Then(err error) *MemoryIndexModifierMock {
+	e.results = &MemoryIndexModifierMockSetIfNoneResults{err}
+	return e.mock
+}
+
 // SetIfNone implements MemoryIndexModifier
-func (mmSetIfNone *MemoryIndexModifierMock) SetIfNone(ctx context.Context, pn insolar.PulseNumber, index record.Index) {
+func (mmSetIfNone *MemoryIndexModifierMock) SetIfNone(ctx context.Context, pn insolar.PulseNumber, index record.Index) (err error) {
 	mm_atomic.AddUint64(&mmSetIfNone.beforeSetIfNoneCounter, 1)
 	defer mm_atomic.AddUint64(&mmSetIfNone.afterSetIfNoneCounter, 1)
 
@@ -338,7 +392,7 @@ func (mmSetIfNone *MemoryIndexModifierMock) SetIfNone(ctx context.Context, pn in
 	for _, e := range mmSetIfNone.SetIfNoneMock.expectations {
 		if minimock.Equal(e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return
+			return e.results.err
 		}
 	}
 
@@ -350,15 +404,17 @@ func (mmSetIfNone *MemoryIndexModifierMock) SetIfNone(ctx context.Context, pn in
 			mmSetIfNone.t.Errorf("MemoryIndexModifierMock.SetIfNone got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		return
-
+		mm_results := mmSetIfNone.SetIfNoneMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSetIfNone.t.Fatal("No results are set for the MemoryIndexModifierMock.SetIfNone")
+		}
+		return (*mm_results).err
 	}
 	if mmSetIfNone.funcSetIfNone != nil {
-		mmSetIfNone.funcSetIfNone(ctx, pn, index)
-		return
+		return mmSetIfNone.funcSetIfNone(ctx, pn, index)
 	}
 	mmSetIfNone.t.Fatalf("Unexpected call to MemoryIndexModifierMock.SetIfNone. %v %v %v", ctx, pn, index)
-
+	return
 }
 
 // SetIfNoneAfterCounter returns a count of finished MemoryIndexModifierMock.SetIfNone invocations
@@ -29,13 +29,13 @@ type MemoryIndexStorageMock struct {
 	beforeForPulseCounter uint64
 	ForPulseMock          mMemoryIndexStorageMockForPulse
 
-	funcSet          func(ctx context.Context, pn insolar.PulseNumber, index record.Index)
+	funcSet          func(ctx context.Context, pn insolar.PulseNumber, index record.Index) (err error)
 	inspectFuncSet   func(ctx context.Context, pn insolar.PulseNumber, index record.Index)
 	afterSetCounter  uint64
 	beforeSetCounter uint64
 	SetMock          mMemoryIndexStorageMockSet
 
-	funcSetIfNone          func(ctx context.Context, pn insolar.PulseNumber, index record.Index)
+	funcSetIfNone          func(ctx context.Context, pn insolar.PulseNumber, index record.Index) (err error)
 	inspectFuncSetIfNone   func(ctx context.Context, pn insolar.PulseNumber, index record.Index)
 	afterSetIfNoneCounter  uint64
 	beforeSetIfNoneCounter uint64
@@ -138,7 +138,7 @@ func (mmForID *mMemoryIndexStorageMockForID) Return(i1 record.Index, err error)
 	return mmForID.mock
 }
 
-//Set uses given function f to mock the MemoryIndexStorage.ForID method
+// Set uses given function f to mock the MemoryIndexStorage.ForID method
 func (mmForID *mMemoryIndexStorageMockForID) Set(f func(ctx context.Context, pn insolar.PulseNumber, objID insolar.ID) (i1 record.Index, err error)) *MemoryIndexStorageMock {
 	if mmForID.defaultExpectation != nil {
 		mmForID.mock.t.Fatalf("Default expectation is already set for the MemoryIndexStorage.ForID method")
@@ -355,7 +355,7 @@ func (mmForPulse *mMemoryIndexStorageMockForPulse) Return(ia1 []record.Index, er
 	return mmForPulse.mock
 }
 
-//Set uses given function f to mock the MemoryIndexStorage.ForPulse method
+// Set uses given function f to mock the MemoryIndexStorage.ForPulse method
 func (mmForPulse *mMemoryIndexStorageMockForPulse) Set(f func(ctx context.Context, pn insolar.PulseNumber) (ia1 []record.Index, err error)) *MemoryIndexStorageMock {
 	if mmForPulse.defaultExpectation != nil {
 		mmForPulse.mock.t.Fatalf("Default expectation is already set for the MemoryIndexStorage.ForPulse method")
@@ -510,9 +510,9 @@ type mMemoryIndexStorageMockSet struct {
 
 // MemoryIndexStorageMockSetExpectation specifies expectation struct of the MemoryIndexStorage.Set
 type MemoryIndexStorageMockSetExpectation struct {
-	mock   *MemoryIndexStorageMock
-	params *MemoryIndexStorageMockSetParams
-
+	mock    *MemoryIndexStorageMock
+	params  *MemoryIndexStorageMockSetParams
+	results *MemoryIndexStorageMockSetResults
 	Counter uint64
 }
 
@@ -523,6 +523,11 @@ type MemoryIndexStorageMockSetParams struct {
 	index record.Index
 }
 
+// MemoryIndexStorageMockSetResults contains results of the MemoryIndexStorage.Set
+type MemoryIndexStorageMockSetResults struct {
+	err error
+}
+
 // Expect sets up expected params for MemoryIndexStorage.Set
 func (mmSet *mMemoryIndexStorageMockSet) Expect(ctx context.Context, pn insolar.PulseNumber, index record.Index) *mMemoryIndexStorageMockSet {
 	if mmSet.mock.funcSet != nil {
@@ -555,7 +560,7 @@ func (mmSet *mMemoryIndexStorageMockSet) Inspect(f func(ctx context.Context, pn
 }
 
 // Return sets up results that will be returned by MemoryIndexStorage.Set
-func (mmSet *mMemoryIndexStorageMockSet) Return() *MemoryIndexStorageMock {
+func (mmSet *mMemoryIndexStorageMockSet) Return(err error) *MemoryIndexStorageMock {
 	if mmSet.mock.funcSet != nil {
 		mmSet.mock.t.Fatalf("MemoryIndexStorageMock.Set mock is already set by Set")
 	}
@@ -563,12 +568,12 @@ func (mmSet *mMemoryIndexStorageMockSet) Return() *MemoryIndexStorageMock {
 	if mmSet.defaultExpectation == nil {
 		mmSet.defaultExpectation = &MemoryIndexStorageMockSetExpectation{mock: mmSet.mock}
 	}
-
+	mmSet.defaultExpectation.results = &MemoryIndexStorageMockSetResults{err}
 	return mmSet.mock
 }
 
-//Set uses given function f to mock the MemoryIndexStorage.Set method
-func (mmSet *mMemoryIndexStorageMockSet) Set(f func(ctx context.Context, pn insolar.PulseNumber, index record.Index)) *MemoryIndexStorageMock {
+// Set uses given function f to mock the MemoryIndexStorage.Set method
+func (mmSet *mMemoryIndexStorageMockSet) Set(f func(ctx context.Context, pn insolar.PulseNumber, index record.Index) (err error)) *MemoryIndexStorageMock {
 	if mmSet.defaultExpectation != nil {
 		mmSet.mock.t.Fatalf("Default expectation is already set for the MemoryIndexStorage.Set method")
 	}
@@ -581,8 +586,29 @@ func (mmSet *mMemoryIndexStorageMockSet) Set(f func(ctx context.Context, pn inso
 	return mmSet.mock
 }
 
+// When sets expectation for the MemoryIndexStorage.Set which will trigger the result defined by the following
+// Then helper
+func (mmSet *mMemoryIndexStorageMockSet) When(ctx context.Context, pn insolar.PulseNumber, index record.Index) *MemoryIndexStorageMockSetExpectation {
+	if mmSet.mock.funcSet != nil {
+		mmSet.mock.t.Fatalf("MemoryIndexStorageMock.Set mock is already set by Set")
+	}
+
+	expectation := &MemoryIndexStorageMockSetExpectation{
+		mock:   mmSet.mock,
+		params: &MemoryIndexStorageMockSetParams{ctx, pn, index},
+	}
+	mmSet.expectations = append(mmSet.expectations, expectation)
+	return expectation
+}
+
+// Then sets up MemoryIndexStorage.Set return parameters for the expectation previously defined by the When method
+func (e *MemoryIndexStorageMockSetExpectation) Then(err error) *MemoryIndexStorageMock {
+	e.results = &MemoryIndexStorageMockSetResults{err}
+	return e.mock
+}
+
 // Set implements MemoryIndexStorage
-func (mmSet *MemoryIndexStorageMock) Set(ctx context.Context, pn insolar.PulseNumber, index record.Index) {
+func (mmSet *MemoryIndexStorageMock) Set(ctx context.Context, pn insolar.PulseNumber, index record.Index) (err error) {
 	mm_atomic.AddUint64(&mmSet.beforeSetCounter, 1)
 	defer mm_atomic.AddUint64(&mmSet.afterSetCounter, 1)
 
@@ -600,7 +626,7 @@ func (mmSet *MemoryIndexStorageMock) Set(ctx context.Context, pn insolar.PulseNu
 	for _, e := range mmSet.SetMock.expectations {
 		if minimock.Equal(e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return
+			return e.results.err
 		}
 	}
 
@@ -612,15 +638,17 @@ func (mmSet *MemoryIndexStorageMock) Set(ctx context.Context, pn insolar.PulseNu
 			mmSet.t.Errorf("MemoryIndexStorageMock.Set got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		return
-
+		mm_results := mmSet.SetMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSet.t.Fatal("No results are set for the MemoryIndexStorageMock.Set")
+		}
+		return (*mm_results).err
 	}
 	if mmSet.funcSet != nil {
-		mmSet.funcSet(ctx, pn, index)
-		return
+		return mmSet.funcSet(ctx, pn, index)
 	}
 	mmSet.t.Fatalf("Unexpected call to MemoryIndexStorageMock.Set. %v %v %v", ctx, pn, index)
-
+	return
 }
 
 // SetAfterCounter returns a count of finished MemoryIndexStorageMock.Set invocations
@@ -699,9 +727,9 @@ type mMemoryIndexStorageMockSetIfNone struct {
 
 // MemoryIndexStorageMockSetIfNoneExpectation specifies expectation struct of the MemoryIndexStorage.SetIfNone
 type MemoryIndexStorageMockSetIfNoneExpectation struct {
-	mock   *MemoryIndexStorageMock
-	params *MemoryIndexStorageMockSetIfNoneParams
-
+	mock    *MemoryIndexStorageMock
+	params  *MemoryIndexStorageMockSetIfNoneParams
+	results *MemoryIndexStorageMockSetIfNoneResults
 	Counter uint64
 }
 
@@ -712,6 +740,11 @@ type MemoryIndexStorageMockSetIfNoneParams struct {
 	index record.Index
 }
 
+// MemoryIndexStorageMockSetIfNoneResults contains results of the MemoryIndexStorage.SetIfNone
+type MemoryIndexStorageMockSetIfNoneResults struct {
+	err error
+}
+
 // Expect sets up expected params for MemoryIndexStorage.SetIfNone
 func (mmSetIfNone *mMemoryIndexStorageMockSetIfNone) Expect(ctx context.Context, pn insolar.PulseNumber, index record.Index) *mMemoryIndexStorageMockSetIfNone {
 	if mmSetIfNone.mock.funcSetIfNone != nil {
@@ -744,7 +777,7 @@ func (mmSetIfNone *mMemoryIndexStorageMockSetIfNone) Inspect(f func(ctx context.
 }
 
 // Return sets up results that will be returned by MemoryIndexStorage.SetIfNone
-func (mmSetIfNone *mMemoryIndexStorageMockSetIfNone) Return() *MemoryIndexStorageMock {
+func (mmSetIfNone *mMemoryIndexStorageMockSetIfNone) Return(err error) *MemoryIndexStorageMock {
 	if mmSetIfNone.mock.funcSetIfNone != nil {
 		mmSetIfNone.mock.t.Fatalf("MemoryIndexStorageMock.SetIfNone mock is already set by Set")
 	}
@@ -752,12 +785,12 @@ func (mmSetIfNone *mMemoryIndexStorageMockSetIfNone) Return() *MemoryIndexStorag
 	if mmSetIfNone.defaultExpectation == nil {
 		mmSetIfNone.defaultExpectation = &MemoryIndexStorageMockSetIfNoneExpectation{mock: mmSetIfNone.mock}
 	}
-
+	mmSetIfNone.defaultExpectation.results = &MemoryIndexStorageMockSetIfNoneResults{err}
 	return mmSetIfNone.mock
 }
 
-//Set uses given function f to mock the MemoryIndexStorage.SetIfNone method
-func (mmSetIfNone *mMemoryIndexStorageMockSetIfNone) Set(f func(ctx context.Context, pn insolar.PulseNumber, index record.Index)) *MemoryIndexStorageMock {
+// Set uses given function f to mock the MemoryIndexStorage.SetIfNone method
+func (mmSetIfNone *mMemoryIndexStorageMockSetIfNone) Set(f func(ctx context.Context, pn insolar.PulseNumber, index record.Index) (err error)) *MemoryIndexStorageMock {
 	if mmSetIfNone.defaultExpectation != nil {
 		mmSetIfNone.mock.t.Fatalf("Default expectation is already set for the MemoryIndexStorage.SetIfNone method")
 	}
@@ -770,8 +803,29 @@ func (mmSetIfNone *mMemoryIndexStorageMockSetIfNone) Set(f func(ctx context.Cont
 	return mmSetIfNone.mock
 }
 
+// When sets expectation for the MemoryIndexStorage.SetIfNone which will trigger the result defined by the following
+// Then helper
+func (mmSetIfNone *mMemoryIndexStorageMockSetIfNone) When(ctx context.Context, pn insolar.PulseNumber, index record.Index) *MemoryIndexStorageMockSetIfNoneExpectation {
+	if mmSetIfNone.mock.funcSetIfNone != nil {
+		mmSetIfNone.mock.t.Fatalf("MemoryIndexStorageMock.SetIfNone mock is already set by Set")
+	}
+
+	expectation := &MemoryIndexStorageMockSetIfNoneExpectation{
+		mock:   mmSetIfNone.mock,
+		params: &MemoryIndexStorageMockSetIfNoneParams{ctx, pn, index},
+	}
+	mmSetIfNone.expectations = append(mmSetIfNone.expectations, expectation)
+	return expectation
+}
+
+// Then sets up MemoryIndexStorage.SetIfNone return parameters for the expectation previously defined by the When method
+func (e *MemoryIndexStorageMockSetIfNoneExpectation) Then(err error) *MemoryIndexStorageMock {
+	e.results = &MemoryIndexStorageMockSetIfNoneResults{err}
+	return e.mock
+}
+
 // SetIfNone implements MemoryIndexStorage
-func (mmSetIfNone *MemoryIndexStorageMock) SetIfNone(ctx context.Context, pn insolar.PulseNumber, index record.Index) {
+func (mmSetIfNone *MemoryIndexStorageMock) SetIfNone(ctx context.Context, pn insolar.PulseNumber, index record.Index) (err error) {
 	mm_atomic.AddUint64(&mmSetIfNone.beforeSetIfNoneCounter, 1)
 	defer mm_atomic.AddUint64(&mmSetIfNone.afterSetIfNoneCounter, 1)
 
@@ -789,7 +843,7 @@ func (mmSetIfNone *MemoryIndexStorageMock) SetIfNone(ctx context.Context, pn ins
 	for _, e := range mmSetIfNone.SetIfNoneMock.expectations {
 		if minimock.Equal(e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return
+			return e.results.err
 		}
 	}
 
@@ -801,15 +855,17 @@ func (mmSetIfNone *MemoryIndexStorageMock) SetIfNone(ctx context.Context, pn ins
 			mmSetIfNone.t.Errorf("MemoryIndexStorageMock.SetIfNone got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		return
-
+		mm_results := mmSetIfNone.SetIfNoneMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSetIfNone.t.Fatal("No results are set for the MemoryIndexStorageMock.SetIfNone")
+		}
+		return (*mm_results).err
 	}
 	if mmSetIfNone.funcSetIfNone != nil {
-		mmSetIfNone.funcSetIfNone(ctx, pn, index)
-		return
+		return mmSetIfNone.funcSetIfNone(ctx, pn, index)
 	}
 	mmSetIfNone.t.Fatalf("Unexpected call to MemoryIndexStorageMock.SetIfNone. %v %v %v", ctx, pn, index)
-
+	return
 }
 
 // SetIfNoneAfterCounter returns a count of finished MemoryIndexStorageMock.SetIfNone invocations
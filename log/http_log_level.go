@@ -8,8 +8,10 @@ package log
 import (
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/log/logscope"
 )
 
 // ServeHTTP is an HTTP handler that changes the global minimum log level
@@ -45,3 +47,74 @@ func (h *loglevelChangeHandler) ServeHTTP(w http.ResponseWriter, r *http.Request
 	w.WriteHeader(500)
 	_, _ = fmt.Fprintf(w, "Logger doesn't support global log level(s): %v\n", err)
 }
+
+// NewScopeLevelHandler is an HTTP handler that sets, lists and resets log levels of logger scopes.
+//
+//	?scope=ledger/light/proc&level=debug&ttl=10m  sets level of the scope, ttl is optional
+//	?scope=ledger/light/proc&level=reset          resets level of the scope
+//	?level=reset                                  resets levels of all scopes
+//	without parameters                            lists levels of scopes
+func NewScopeLevelHandler() http.Handler {
+	return &scopeLevelHandler{}
+}
+
+type scopeLevelHandler struct {
+}
+
+func (h *scopeLevelHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	values := r.URL.Query()
+	scope := values.Get("scope")
+	levelStr := values.Get("level")
+
+	switch {
+	case levelStr == "" && scope == "":
+		w.WriteHeader(200)
+		for _, sl := range logscope.Levels() {
+			expires := "never"
+			if !sl.Expires.IsZero() {
+				expires = sl.Expires.Format(time.RFC3339)
+			}
+			_, _ = fmt.Fprintf(w, "%v: '%v', expires: %v\n", sl.Scope, sl.Level, expires)
+		}
+		return
+	case levelStr == "reset" && scope == "":
+		logscope.ResetAll()
+		w.WriteHeader(200)
+		_, _ = fmt.Fprintf(w, "Log levels of all scopes are reset\n")
+		return
+	case levelStr == "reset":
+		if !logscope.ResetLevel(scope) {
+			w.WriteHeader(404)
+			_, _ = fmt.Fprintf(w, "Scope '%v' has no log level\n", scope)
+			return
+		}
+		w.WriteHeader(200)
+		_, _ = fmt.Fprintf(w, "Log level of scope '%v' is reset\n", scope)
+		return
+	}
+
+	level, err := insolar.ParseLevel(levelStr)
+	if err != nil {
+		w.WriteHeader(500)
+		_, _ = fmt.Fprintf(w, "Invalid level '%v': %v\n", levelStr, err)
+		return
+	}
+	var ttl time.Duration
+	if ttlStr := values.Get("ttl"); ttlStr != "" {
+		ttl, err = time.ParseDuration(ttlStr)
+		if err != nil || ttl < 0 {
+			w.WriteHeader(500)
+			_, _ = fmt.Fprintf(w, "Invalid ttl '%v'\n", ttlStr)
+			return
+		}
+	}
+
+	err = logscope.SetLevel(scope, level, ttl)
+	if err != nil {
+		w.WriteHeader(500)
+		_, _ = fmt.Fprintf(w, "Failed to set log level of scope: %v\n", err)
+		return
+	}
+	w.WriteHeader(200)
+	_, _ = fmt.Fprintf(w, "New log level of scope '%v': '%v'\n", scope, levelStr)
+}
@@ -0,0 +1,53 @@
+// Copyright 2020 Insolar Network Ltd.
+// All rights reserved.
+// This material is licensed under the Insolar License version 1.0,
+// available at https://github.com/insolar/insolar/blob/master/LICENSE.md.
+
+package log
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/log/logscope"
+)
+
+func TestScopeLevelHandler(t *testing.T) {
+	defer logscope.ResetAll()
+
+	handler := NewScopeLevelHandler()
+	call := func(query string) (int, string) {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug/loglevel/scope?"+query, nil))
+		return rec.Code, rec.Body.String()
+	}
+
+	code, body := call("scope=ledger/light/proc&level=debug")
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, "New log level of scope 'ledger/light/proc': 'debug'\n", body)
+	code, _ = call("scope=consensus&level=warn&ttl=1h")
+	require.Equal(t, http.StatusOK, code)
+
+	code, body = call("")
+	require.Equal(t, http.StatusOK, code)
+	require.Contains(t, body, "consensus: 'warn', expires: ")
+	require.Contains(t, body, "ledger/light/proc: 'debug', expires: never\n")
+
+	for _, query := range []string{"scope=consensus&level=ololo", "scope=consensus&level=debug&ttl=ololo", "level=debug"} {
+		code, _ = call(query)
+		require.Equal(t, http.StatusInternalServerError, code, query)
+	}
+
+	code, _ = call("scope=consensus&level=reset")
+	require.Equal(t, http.StatusOK, code)
+	code, _ = call("scope=consensus&level=reset")
+	require.Equal(t, http.StatusNotFound, code)
+	require.Len(t, logscope.Levels(), 1)
+
+	code, _ = call("level=reset")
+	require.Equal(t, http.StatusOK, code)
+	require.Empty(t, logscope.Levels())
+}
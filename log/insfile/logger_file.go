@@ -0,0 +1,354 @@
+// Copyright 2020 Insolar Network Ltd.
+// All rights reserved.
+// This material is licensed under the Insolar License version 1.0,
+// available at https://github.com/insolar/insolar/blob/master/LICENSE.md.
+
+package insfile
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	backupTimeFormat = "2006-01-02T15-04-05.000"
+	compressSuffix   = ".gz"
+	megabyte         = 1024 * 1024
+)
+
+// Options of file rotation. Zero values disable the corresponding rotation.
+type Options struct {
+	// MaxSize is a size of the file in bytes, the file is rotated on.
+	MaxSize int64
+	// Interval is a max time between rotations.
+	Interval time.Duration
+	// MaxBackups is a number of rotated files to keep, all rotated files are kept on 0.
+	MaxBackups int
+	// Compress enables gzip compression of rotated files.
+	Compress bool
+}
+
+// ParseParam parses output parameter of file output.
+//
+// Parameter is a file path with optional rotation options in query:
+//
+//	/var/log/insolard.log?maxsize=100&interval=24h&maxbackups=10&compress=false
+//
+// maxsize is in megabytes, interval is a duration, compress is enabled by default.
+func ParseParam(outputParam string) (string, Options, error) {
+	opts := Options{Compress: true}
+
+	path := outputParam
+	var query string
+	if i := strings.LastIndex(outputParam, "?"); i >= 0 {
+		path, query = outputParam[:i], outputParam[i+1:]
+	}
+	if len(path) == 0 {
+		return "", opts, errors.New("file path is not set for file output")
+	}
+
+	values, err := url.ParseQuery(query)
+	if err != nil {
+		return "", opts, errors.Wrap(err, "failed to parse file output options")
+	}
+	for key := range values {
+		value := values.Get(key)
+		switch strings.ToLower(key) {
+		case "maxsize":
+			size, err := strconv.ParseInt(value, 10, 64)
+			if err != nil || size < 0 {
+				return "", opts, errors.Errorf("invalid maxsize '%s'", value)
+			}
+			opts.MaxSize = size * megabyte
+		case "interval":
+			opts.Interval, err = time.ParseDuration(value)
+			if err != nil || opts.Interval < 0 {
+				return "", opts, errors.Errorf("invalid interval '%s'", value)
+			}
+		case "maxbackups":
+			opts.MaxBackups, err = strconv.Atoi(value)
+			if err != nil || opts.MaxBackups < 0 {
+				return "", opts, errors.Errorf("invalid maxbackups '%s'", value)
+			}
+		case "compress":
+			opts.Compress, err = strconv.ParseBool(value)
+			if err != nil {
+				return "", opts, errors.Errorf("invalid compress '%s'", value)
+			}
+		default:
+			return "", opts, errors.Errorf("unknown file output option '%s'", key)
+		}
+	}
+	return path, opts, nil
+}
+
+// OpenFileByParam opens file writer by output parameter. The file is reopened on SIGHUP.
+func OpenFileByParam(outputParam string) (*FileWriter, error) {
+	path, opts, err := ParseParam(outputParam)
+	if err != nil {
+		return nil, err
+	}
+	w, err := OpenFile(path, opts)
+	if err != nil {
+		return nil, err
+	}
+	w.reopenOnSignal(syscall.SIGHUP)
+	return w, nil
+}
+
+// FileWriter writes to the file and rotates it by size and time.
+//
+// Rotated file is renamed with timestamp suffix, then it is compressed and old backups are
+// removed in background, so rotation doesn't delay writes for longer than rename of the file.
+type FileWriter struct {
+	path string
+	opts Options
+	now  func() time.Time
+
+	mutex    sync.Mutex
+	file     *os.File
+	size     int64
+	openedAt time.Time
+
+	background sync.WaitGroup
+	signals    chan os.Signal
+}
+
+// OpenFile opens file writer, records are appended to existing file.
+func OpenFile(path string, opts Options) (*FileWriter, error) {
+	w := &FileWriter{
+		path: filepath.Clean(path),
+		opts: opts,
+		now:  time.Now,
+	}
+	err := w.open()
+	if err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+func (w *FileWriter) open() error {
+	err := os.MkdirAll(filepath.Dir(w.path), 0750)
+	if err != nil {
+		return errors.Wrap(err, "failed to create log directory")
+	}
+	file, err := os.OpenFile(w.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0640)
+	if err != nil {
+		return errors.Wrap(err, "failed to open log file")
+	}
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return errors.Wrap(err, "failed to stat log file")
+	}
+	w.file = file
+	w.size = info.Size()
+	w.openedAt = w.now()
+	return nil
+}
+
+func (w *FileWriter) Write(p []byte) (int, error) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	if w.file == nil {
+		return 0, os.ErrClosed
+	}
+	if w.needsRotation(len(p)) {
+		err := w.rotate()
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	n, err := w.file.Write(p)
+	w.size += int64(n)
+	return n, err
+}
+
+func (w *FileWriter) needsRotation(nextWrite int) bool {
+	if w.size == 0 {
+		return false
+	}
+	if w.opts.MaxSize > 0 && w.size+int64(nextWrite) > w.opts.MaxSize {
+		return true
+	}
+	return w.opts.Interval > 0 && w.now().Sub(w.openedAt) >= w.opts.Interval
+}
+
+func (w *FileWriter) rotate() error {
+	err := w.file.Close()
+	w.file = nil
+	if err != nil {
+		return errors.Wrap(err, "failed to close log file")
+	}
+
+	backup := w.path + "." + w.now().UTC().Format(backupTimeFormat)
+	err = os.Rename(w.path, backup)
+	if err != nil {
+		return errors.Wrap(err, "failed to rename log file")
+	}
+
+	err = w.open()
+	if err != nil {
+		return err
+	}
+
+	w.background.Add(1)
+	go func() {
+		defer w.background.Done()
+		w.processBackup(backup)
+	}()
+	return nil
+}
+
+// processBackup compresses rotated file and removes old backups. Errors are reported to stderr,
+// as logger can't write about its own failures.
+func (w *FileWriter) processBackup(backup string) {
+	if w.opts.Compress {
+		err := compressFile(backup)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "failed to compress log file %s: %v\n", backup, err)
+		}
+	}
+	err := w.removeOldBackups()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to remove old log files: %v\n", err)
+	}
+}
+
+func compressFile(path string) error {
+	src, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	dst, err := os.OpenFile(path+compressSuffix, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0640)
+	if err != nil {
+		return err
+	}
+	gz := gzip.NewWriter(dst)
+	_, err = io.Copy(gz, src)
+	if err == nil {
+		err = gz.Close()
+	}
+	if closeErr := dst.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(path + compressSuffix)
+		return err
+	}
+	return os.Remove(path)
+}
+
+// Backups returns rotated files from the oldest to the newest.
+func (w *FileWriter) Backups() ([]string, error) {
+	matches, err := filepath.Glob(w.path + ".*")
+	if err != nil {
+		return nil, err
+	}
+	backups := matches[:0]
+	for _, name := range matches {
+		stamp := strings.TrimSuffix(strings.TrimPrefix(name, w.path+"."), compressSuffix)
+		if _, err := time.Parse(backupTimeFormat, stamp); err == nil {
+			backups = append(backups, name)
+		}
+	}
+	// timestamp format is sortable as string
+	sort.Strings(backups)
+	return backups, nil
+}
+
+func (w *FileWriter) removeOldBackups() error {
+	if w.opts.MaxBackups == 0 {
+		return nil
+	}
+	backups, err := w.Backups()
+	if err != nil {
+		return err
+	}
+	for len(backups) > w.opts.MaxBackups {
+		err = os.Remove(backups[0])
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		backups = backups[1:]
+	}
+	return nil
+}
+
+// Reopen closes and opens the file by its path. It is used after the file was moved by external tool.
+func (w *FileWriter) Reopen() error {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	if w.file == nil {
+		return os.ErrClosed
+	}
+	err := w.file.Close()
+	w.file = nil
+	if err != nil {
+		return errors.Wrap(err, "failed to close log file")
+	}
+	return w.open()
+}
+
+func (w *FileWriter) reopenOnSignal(sig os.Signal) {
+	w.signals = make(chan os.Signal, 1)
+	signal.Notify(w.signals, sig)
+	go func() {
+		for range w.signals {
+			err := w.Reopen()
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "failed to reopen log file %s: %v\n", w.path, err)
+			}
+		}
+	}()
+}
+
+// Flush commits written data to disk.
+func (w *FileWriter) Flush() error {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	if w.file == nil {
+		return nil
+	}
+	return w.file.Sync()
+}
+
+// Close closes the file and waits for compression of rotated files.
+func (w *FileWriter) Close() error {
+	if w.signals != nil {
+		signal.Stop(w.signals)
+		close(w.signals)
+		w.signals = nil
+	}
+
+	w.mutex.Lock()
+	var err error
+	if w.file != nil {
+		err = w.file.Close()
+		w.file = nil
+	}
+	w.mutex.Unlock()
+
+	w.background.Wait()
+	return err
+}
@@ -0,0 +1,151 @@
+// Copyright 2020 Insolar Network Ltd.
+// All rights reserved.
+// This material is licensed under the Insolar License version 1.0,
+// available at https://github.com/insolar/insolar/blob/master/LICENSE.md.
+
+package insfile
+
+import (
+	"compress/gzip"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseParam(t *testing.T) {
+	path, opts, err := ParseParam("/var/log/insolard.log")
+	require.NoError(t, err)
+	require.Equal(t, "/var/log/insolard.log", path)
+	require.Equal(t, Options{Compress: true}, opts)
+
+	path, opts, err = ParseParam("insolard.log?maxsize=10&interval=1h&maxbackups=3&compress=false")
+	require.NoError(t, err)
+	require.Equal(t, "insolard.log", path)
+	require.Equal(t, Options{MaxSize: 10 * megabyte, Interval: time.Hour, MaxBackups: 3}, opts)
+
+	for _, param := range []string{
+		"",
+		"?maxsize=10",
+		"insolard.log?maxsize=ten",
+		"insolard.log?interval=-1h",
+		"insolard.log?maxbackups=-1",
+		"insolard.log?compress=maybe",
+		"insolard.log?unknown=1",
+	} {
+		_, _, err := ParseParam(param)
+		require.Error(t, err, param)
+	}
+}
+
+func tempDir(t *testing.T) (string, func()) {
+	dir, err := ioutil.TempDir("", "insfile-")
+	require.NoError(t, err)
+	return dir, func() { _ = os.RemoveAll(dir) }
+}
+
+func TestFileWriter_RotateBySize(t *testing.T) {
+	dir, cleanup := tempDir(t)
+	defer cleanup()
+
+	path := filepath.Join(dir, "insolard.log")
+	w, err := OpenFile(path, Options{MaxSize: 10, MaxBackups: 2, Compress: true})
+	require.NoError(t, err)
+
+	clock := time.Now()
+	w.now = func() time.Time {
+		// every rotation gets its own backup name
+		clock = clock.Add(time.Second)
+		return clock
+	}
+
+	for _, line := range []string{"first\n", "second\n", "third\n", "fourth\n"} {
+		_, err := w.Write([]byte(line))
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.Close())
+
+	data, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, "fourth\n", string(data))
+
+	backups, err := w.Backups()
+	require.NoError(t, err)
+	require.Len(t, backups, 2, "the oldest backup is removed")
+	for i, expected := range []string{"second\n", "third\n"} {
+		require.True(t, strings.HasSuffix(backups[i], compressSuffix), backups[i])
+
+		f, err := os.Open(backups[i])
+		require.NoError(t, err)
+		gz, err := gzip.NewReader(f)
+		require.NoError(t, err)
+		data, err := ioutil.ReadAll(gz)
+		require.NoError(t, err)
+		require.NoError(t, f.Close())
+		require.Equal(t, expected, string(data))
+	}
+}
+
+func TestFileWriter_RotateByInterval(t *testing.T) {
+	dir, cleanup := tempDir(t)
+	defer cleanup()
+
+	path := filepath.Join(dir, "insolard.log")
+	w, err := OpenFile(path, Options{Interval: time.Hour})
+	require.NoError(t, err)
+
+	clock := time.Now()
+	w.now = func() time.Time { return clock }
+	_, err = w.Write([]byte("first\n"))
+	require.NoError(t, err)
+	_, err = w.Write([]byte("second\n"))
+	require.NoError(t, err)
+
+	clock = clock.Add(time.Hour)
+	_, err = w.Write([]byte("third\n"))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	data, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, "third\n", string(data))
+
+	backups, err := w.Backups()
+	require.NoError(t, err)
+	require.Len(t, backups, 1)
+	data, err = ioutil.ReadFile(backups[0])
+	require.NoError(t, err)
+	require.Equal(t, "first\nsecond\n", string(data), "backup isn't compressed")
+}
+
+func TestFileWriter_Reopen(t *testing.T) {
+	dir, cleanup := tempDir(t)
+	defer cleanup()
+
+	path := filepath.Join(dir, "insolard.log")
+	w, err := OpenFile(path, Options{})
+	require.NoError(t, err)
+
+	_, err = w.Write([]byte("first\n"))
+	require.NoError(t, err)
+	// external rotation moves the file
+	require.NoError(t, os.Rename(path, path+".old"))
+	require.NoError(t, w.Reopen())
+	_, err = w.Write([]byte("second\n"))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	data, err := ioutil.ReadFile(path + ".old")
+	require.NoError(t, err)
+	require.Equal(t, "first\n", string(data))
+	data, err = ioutil.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, "second\n", string(data))
+
+	_, err = w.Write([]byte("closed\n"))
+	require.Error(t, err)
+}
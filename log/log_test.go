@@ -8,9 +8,12 @@ package log
 import (
 	"bytes"
 	"io"
+	"io/ioutil"
 	"os"
+	"path/filepath"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/assert"
@@ -100,6 +103,7 @@ func TestLog_NewLog_Config(t *testing.T) {
 		"InvalidAdapter":   configuration.Log{Level: "Debug", Adapter: "invalid", Formatter: "text"},
 		"InvalidLevel":     configuration.Log{Level: "Invalid", Adapter: "zerolog", Formatter: "text"},
 		"InvalidFormatter": configuration.Log{Level: "Debug", Adapter: "zerolog", Formatter: "invalid"},
+		"InvalidFileParam": configuration.Log{Level: "Debug", Adapter: "zerolog", Formatter: "text", OutputType: "file"},
 	}
 
 	for name, test := range invalidtests {
@@ -246,6 +250,25 @@ func TestLog_Timestamp(t *testing.T) {
 	}
 }
 
+func TestLog_FileOutput(t *testing.T) {
+	dir, err := ioutil.TempDir("", "log-file-")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "insolard.log")
+	logger, err := NewLog(configuration.Log{
+		Level: "info", Adapter: "zerolog", Formatter: "json", OutputType: "file", OutputParams: path + "?maxsize=1",
+	})
+	require.NoError(t, err)
+
+	logger.Info("HelloWorld")
+
+	require.Eventually(t, func() bool {
+		data, err := ioutil.ReadFile(path)
+		return err == nil && bytes.Contains(data, []byte("HelloWorld"))
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
 func TestLog_WriteDuration(t *testing.T) {
 	for _, adapter := range adapters {
 		adapter := adapter
@@ -29,6 +29,9 @@ type ParsedLogConfig struct {
 
 const defaultLowLatencyBufferSize = 100
 
+// defaultFileBufferSize is used for file output without BufferSize, to keep slow disks off the callers
+const defaultFileBufferSize = 1000
+
 func DefaultLoggerSettings() ParsedLogConfig {
 	r := ParsedLogConfig{}
 	r.Instruments.MetricsMode = insolar.LogMetricsEventCount | insolar.LogMetricsWriteDelayReport | insolar.LogMetricsWriteDelayField
@@ -88,5 +91,12 @@ func ParseLogConfigWithDefaults(cfg configuration.Log, defaults ParsedLogConfig)
 	}
 	plc.Output.EnableRegularBuffer = cfg.BufferSize > 0
 
+	if plc.OutputType == insolar.FileOutput && cfg.BufferSize == 0 {
+		if plc.Output.BufferSize < defaultFileBufferSize {
+			plc.Output.BufferSize = defaultFileBufferSize
+		}
+		plc.Output.EnableRegularBuffer = true
+	}
+
 	return plc, nil
 }
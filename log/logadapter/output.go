@@ -12,6 +12,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/log/insfile"
 	"github.com/insolar/insolar/log/inssyslog"
 )
 
@@ -35,6 +36,16 @@ func OpenLogBareOutput(output insolar.LogOutput, param string) (BareOutput, erro
 			FlushFn:        w.Flush,
 			ProtectedClose: false,
 		}, nil
+	case insolar.FileOutput:
+		w, err := insfile.OpenFileByParam(param)
+		if err != nil {
+			return BareOutput{}, err
+		}
+		return BareOutput{
+			Writer:         w,
+			FlushFn:        w.Flush,
+			ProtectedClose: false,
+		}, nil
 	default:
 		return BareOutput{}, errors.New("unknown output " + output.String())
 	}
@@ -0,0 +1,205 @@
+// Copyright 2020 Insolar Network Ltd.
+// All rights reserved.
+// This material is licensed under the Insolar License version 1.0,
+// available at https://github.com/insolar/insolar/blob/master/LICENSE.md.
+
+// Package logscope keeps log levels of named logger scopes, that override level of loggers at runtime.
+//
+// Scope is either a value of "component" field of a logger (e.g. "consensus", "badger"), or a package
+// path of the caller relative to insolar module (e.g. "ledger/light/proc"). Package scope also covers
+// all nested packages. Global level filter still applies to scoped loggers.
+package logscope
+
+import (
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/insolar"
+)
+
+// ComponentField is a logger field, its value is used as a scope of the logger.
+const ComponentField = "component"
+
+const insolarPrefix = "github.com/insolar/insolar/"
+
+// Skipped packages of logger implementation, the first frame outside them is the caller.
+var loggerPackages = []string{
+	insolarPrefix + "log",
+	insolarPrefix + "instrumentation/inslogger",
+	"github.com/rs/zerolog",
+}
+
+// ScopeLevel is a level of the scope.
+type ScopeLevel struct {
+	Scope string
+	Level insolar.LogLevel
+	// Expires is a time of automatic reset of the level, zero for permanent level.
+	Expires time.Time
+}
+
+type scopeLevel struct {
+	ScopeLevel
+	timer *time.Timer
+}
+
+var registry = struct {
+	mutex sync.RWMutex
+	// count is used to skip lookup without scopes
+	count  int32
+	levels map[string]*scopeLevel
+}{
+	levels: map[string]*scopeLevel{},
+}
+
+// SetLevel sets level of the scope. Level is reset after ttl, if it is positive.
+func SetLevel(scope string, level insolar.LogLevel, ttl time.Duration) error {
+	scope = strings.Trim(scope, "/")
+	if scope == "" {
+		return errors.New("scope shouldn't be empty")
+	}
+	if level == insolar.NoLevel {
+		return errors.New("level should be set")
+	}
+
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+
+	if prev, ok := registry.levels[scope]; ok && prev.timer != nil {
+		prev.timer.Stop()
+	}
+	sl := &scopeLevel{ScopeLevel: ScopeLevel{Scope: scope, Level: level}}
+	if ttl > 0 {
+		sl.Expires = time.Now().Add(ttl)
+		sl.timer = time.AfterFunc(ttl, func() {
+			registry.mutex.Lock()
+			defer registry.mutex.Unlock()
+			// level could be replaced before expiration
+			if registry.levels[scope] == sl {
+				remove(scope)
+			}
+		})
+	}
+	registry.levels[scope] = sl
+	atomic.StoreInt32(&registry.count, int32(len(registry.levels)))
+	return nil
+}
+
+// ResetLevel removes level of the scope. It returns false if the scope has no level.
+func ResetLevel(scope string) bool {
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+
+	return remove(strings.Trim(scope, "/"))
+}
+
+// ResetAll removes levels of all scopes.
+func ResetAll() {
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+
+	for scope := range registry.levels {
+		remove(scope)
+	}
+}
+
+func remove(scope string) bool {
+	sl, ok := registry.levels[scope]
+	if !ok {
+		return false
+	}
+	if sl.timer != nil {
+		sl.timer.Stop()
+	}
+	delete(registry.levels, scope)
+	atomic.StoreInt32(&registry.count, int32(len(registry.levels)))
+	return true
+}
+
+// Levels returns levels of all scopes sorted by scope.
+func Levels() []ScopeLevel {
+	registry.mutex.RLock()
+	defer registry.mutex.RUnlock()
+
+	levels := make([]ScopeLevel, 0, len(registry.levels))
+	for _, sl := range registry.levels {
+		levels = append(levels, sl.ScopeLevel)
+	}
+	sort.Slice(levels, func(i, j int) bool {
+		return levels[i].Scope < levels[j].Scope
+	})
+	return levels
+}
+
+// Lookup returns level of logger with the component for the current caller. Component level has priority
+// over package level, level of the most nested package is used.
+func Lookup(component string) (insolar.LogLevel, bool) {
+	if atomic.LoadInt32(&registry.count) == 0 {
+		return insolar.NoLevel, false
+	}
+
+	registry.mutex.RLock()
+	defer registry.mutex.RUnlock()
+
+	if component != "" {
+		if sl, ok := registry.levels[component]; ok {
+			return sl.Level, true
+		}
+	}
+
+	for pkg := callerPackage(); pkg != ""; pkg = parentPackage(pkg) {
+		if sl, ok := registry.levels[pkg]; ok {
+			return sl.Level, true
+		}
+	}
+	return insolar.NoLevel, false
+}
+
+func parentPackage(pkg string) string {
+	idx := strings.LastIndex(pkg, "/")
+	if idx < 0 {
+		return ""
+	}
+	return pkg[:idx]
+}
+
+// callerPackage returns package of the first caller outside of logger packages.
+func callerPackage() string {
+	pcs := make([]uintptr, 16)
+	n := runtime.Callers(3, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+	for {
+		frame, more := frames.Next()
+		pkg := functionPackage(frame.Function)
+		if pkg != "" && !isLoggerPackage(pkg) {
+			return strings.TrimPrefix(pkg, insolarPrefix)
+		}
+		if !more {
+			return ""
+		}
+	}
+}
+
+// functionPackage extracts package path from full function name, e.g. "github.com/a/b.(*T).M" is in "github.com/a/b".
+func functionPackage(function string) string {
+	slash := strings.LastIndex(function, "/")
+	dot := strings.Index(function[slash+1:], ".")
+	if dot < 0 {
+		return function
+	}
+	return function[:slash+1+dot]
+}
+
+func isLoggerPackage(pkg string) bool {
+	for _, lp := range loggerPackages {
+		if pkg == lp || strings.HasPrefix(pkg, lp+"/") {
+			return true
+		}
+	}
+	return false
+}
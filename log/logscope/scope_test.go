@@ -0,0 +1,93 @@
+// Copyright 2020 Insolar Network Ltd.
+// All rights reserved.
+// This material is licensed under the Insolar License version 1.0,
+// available at https://github.com/insolar/insolar/blob/master/LICENSE.md.
+
+package logscope
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/insolar"
+)
+
+func TestFunctionPackage(t *testing.T) {
+	for function, pkg := range map[string]string{
+		"github.com/insolar/insolar/ledger/light/proc.(*GetCode).Proceed": "github.com/insolar/insolar/ledger/light/proc",
+		"github.com/insolar/insolar/network/consensus.New.func1":          "github.com/insolar/insolar/network/consensus",
+		"testing.tRunner": "testing",
+		"main.main":       "main",
+	} {
+		require.Equal(t, pkg, functionPackage(function), function)
+	}
+
+	require.True(t, isLoggerPackage("github.com/insolar/insolar/log/zlogadapter"))
+	require.True(t, isLoggerPackage("github.com/insolar/insolar/instrumentation/inslogger"))
+	require.False(t, isLoggerPackage("github.com/insolar/insolar/logicrunner"))
+}
+
+func TestSetLevel(t *testing.T) {
+	defer ResetAll()
+
+	require.Error(t, SetLevel("", insolar.DebugLevel, 0))
+	require.Error(t, SetLevel("ledger", insolar.NoLevel, 0))
+
+	require.NoError(t, SetLevel("/ledger/light/", insolar.DebugLevel, 0))
+	require.NoError(t, SetLevel("consensus", insolar.WarnLevel, time.Hour))
+
+	levels := Levels()
+	require.Len(t, levels, 2)
+	require.Equal(t, "consensus", levels[0].Scope)
+	require.Equal(t, insolar.WarnLevel, levels[0].Level)
+	require.False(t, levels[0].Expires.IsZero())
+	require.Equal(t, "ledger/light", levels[1].Scope)
+	require.True(t, levels[1].Expires.IsZero())
+
+	require.True(t, ResetLevel("ledger/light"))
+	require.False(t, ResetLevel("ledger/light"))
+	require.Len(t, Levels(), 1)
+
+	ResetAll()
+	require.Empty(t, Levels())
+}
+
+func TestSetLevel_TTL(t *testing.T) {
+	defer ResetAll()
+
+	require.NoError(t, SetLevel("consensus", insolar.DebugLevel, 10*time.Millisecond))
+	require.Eventually(t, func() bool {
+		return len(Levels()) == 0
+	}, time.Second, 5*time.Millisecond)
+
+	// replaced level isn't reset by the timer of previous one
+	require.NoError(t, SetLevel("consensus", insolar.DebugLevel, 10*time.Millisecond))
+	require.NoError(t, SetLevel("consensus", insolar.InfoLevel, 0))
+	time.Sleep(50 * time.Millisecond)
+	require.Equal(t, []ScopeLevel{{Scope: "consensus", Level: insolar.InfoLevel}}, Levels())
+}
+
+func TestLookup(t *testing.T) {
+	defer ResetAll()
+
+	_, ok := Lookup("consensus")
+	require.False(t, ok)
+
+	require.NoError(t, SetLevel("consensus", insolar.DebugLevel, 0))
+	level, ok := Lookup("consensus")
+	require.True(t, ok)
+	require.Equal(t, insolar.DebugLevel, level)
+	_, ok = Lookup("badger")
+	require.False(t, ok)
+
+	// callers in log packages are skipped, so the test is called from "testing" package
+	require.NoError(t, SetLevel("testing", insolar.ErrorLevel, 0))
+	level, ok = Lookup("")
+	require.True(t, ok)
+	require.Equal(t, insolar.ErrorLevel, level)
+	level, ok = Lookup("consensus")
+	require.True(t, ok)
+	require.Equal(t, insolar.DebugLevel, level, "component has priority over package")
+}
@@ -0,0 +1,50 @@
+// Copyright 2020 Insolar Network Ltd.
+// All rights reserved.
+// This material is licensed under the Insolar License version 1.0,
+// available at https://github.com/insolar/insolar/blob/master/LICENSE.md.
+
+package zlogadapter
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/log/logadapter"
+	"github.com/insolar/insolar/log/logscope"
+)
+
+func TestZeroLogAdapter_ScopeLevel(t *testing.T) {
+	defer logscope.ResetAll()
+
+	pCfg := logadapter.ParsedLogConfig{
+		OutputType: insolar.DefaultLogOutput,
+		LogLevel:   insolar.InfoLevel,
+		Output: logadapter.OutputConfig{
+			Format: insolar.DefaultLogFormat,
+		},
+	}
+	msgFmt := logadapter.GetDefaultLogMsgFormatter()
+
+	log, err := NewZerologAdapter(pCfg, msgFmt)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	log, err = log.Copy().WithOutput(&buf).Build()
+	require.NoError(t, err)
+	consensus := log.WithField(logscope.ComponentField, "consensus")
+	badger := log.WithFields(map[string]interface{}{logscope.ComponentField: "badger"})
+
+	consensus.Debug("hidden by logger level")
+	require.NoError(t, logscope.SetLevel("consensus", insolar.DebugLevel, 0))
+	require.NoError(t, logscope.SetLevel("badger", insolar.ErrorLevel, 0))
+	consensus.Debug("shown by scope level")
+	badger.Warn("hidden by scope level")
+	log.Debug("hidden for logger without scope")
+
+	s := buf.String()
+	require.NotContains(t, s, "hidden")
+	require.Contains(t, s, "shown by scope level")
+}
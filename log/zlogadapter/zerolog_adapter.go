@@ -7,6 +7,7 @@ package zlogadapter
 
 import (
 	"context"
+	"fmt"
 	"io"
 	"strconv"
 	"strings"
@@ -17,6 +18,7 @@ import (
 	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/log/logadapter"
 	"github.com/insolar/insolar/log/logmetrics"
+	"github.com/insolar/insolar/log/logscope"
 )
 
 var insolarPrefix = "github.com/insolar/insolar/"
@@ -171,16 +173,21 @@ var _ insolar.Logger = &zerologAdapter{}
 type zerologAdapter struct {
 	logger zerolog.Logger
 	config *logadapter.Config
+	// component is a value of logscope.ComponentField, it is a scope of the logger
+	component string
 }
 
 // WithFields return copy of adapter with predefined fields.
 func (z *zerologAdapter) WithFields(fields map[string]interface{}) insolar.Logger {
 	zCtx := z.logger.With()
+	zCopy := *z
 	for key, value := range fields {
 		zCtx = zCtx.Interface(key, value)
+		if key == logscope.ComponentField {
+			zCopy.component = fmt.Sprint(value)
+		}
 	}
 
-	zCopy := *z
 	zCopy.logger = zCtx.Logger()
 	return &zCopy
 }
@@ -189,12 +196,29 @@ func (z *zerologAdapter) WithFields(fields map[string]interface{}) insolar.Logge
 func (z *zerologAdapter) WithField(key string, value interface{}) insolar.Logger {
 	zCopy := *z
 	zCopy.logger = z.logger.With().Interface(key, value).Logger()
+	if key == logscope.ComponentField {
+		zCopy.component = fmt.Sprint(value)
+	}
 	return &zCopy
 }
 
 func (z *zerologAdapter) newEvent(level insolar.LogLevel) *zerolog.Event {
 	m := getLevelMapping(level)
 	z.config.Metrics.OnNewEvent(m.metrics, level)
+
+	if scopeLevel, ok := logscope.Lookup(z.component); ok {
+		// level of the scope replaces levels of the logger
+		if scopeLevel > level {
+			return nil
+		}
+		scoped := z.logger.Level(zerolog.DebugLevel)
+		event := m.fn(&scoped)
+		if event != nil {
+			z.config.Metrics.OnFilteredEvent(m.metrics, level)
+		}
+		return event
+	}
+
 	event := m.fn(&z.logger)
 	if event == nil {
 		return nil
@@ -28,6 +28,15 @@ type Client interface {
 	// GetRequest returns an incoming or outgoing request for an object.
 	GetRequest(ctx context.Context, objectRef, reqRef insolar.Reference) (record.Request, error)
 
+	// GetResult returns result record of the request.
+	GetResult(ctx context.Context, objectRef, reqRef insolar.Reference) (*record.Material, error)
+
+	// GetState returns the exact state record of the object, not necessarily the latest one.
+	GetState(ctx context.Context, objectRef insolar.Reference, stateID insolar.ID) (*record.Material, error)
+
+	// GetEvent returns the event record, that was emitted by the object.
+	GetEvent(ctx context.Context, objectRef insolar.Reference, eventID insolar.ID) (*record.Material, error)
+
 	// GetPendings returns pending request IDs of an object.
 	GetPendings(ctx context.Context, objectRef insolar.Reference, skip []insolar.ID) ([]insolar.Reference, error)
 
@@ -157,6 +166,14 @@ func (t RequestResultType) String() string {
 	}
 }
 
+// ContractEvent is an event emitted by contract during execution of the request.
+type ContractEvent struct {
+	// Prototype is a prototype of the contract emitting the event.
+	Prototype insolar.Reference
+	Topic     string
+	Payload   []byte
+}
+
 type RequestResult interface {
 	Type() RequestResultType
 
@@ -166,4 +183,60 @@ type RequestResult interface {
 
 	Result() []byte
 	ObjectReference() insolar.Reference
+	Events() []ContractEvent
+}
+
+// SideEffectRecord returns state record, that is registered on ledger for side effect of the request result. It returns
+// nil if the result has no side effect.
+func SideEffectRecord(request insolar.Reference, result RequestResult) record.Record {
+	switch result.Type() {
+	case RequestSideEffectActivate:
+		parentRef, imageRef, memory := result.Activate()
+		return &record.Activate{
+			Request:     request,
+			Memory:      memory,
+			Image:       imageRef,
+			IsPrototype: false,
+			Parent:      parentRef,
+		}
+	case RequestSideEffectAmend:
+		objectStateID, objectImage, memory := result.Amend()
+		return &record.Amend{
+			Request:     request,
+			Memory:      memory,
+			Image:       objectImage,
+			IsPrototype: false,
+			PrevState:   objectStateID,
+		}
+	case RequestSideEffectDeactivate:
+		return &record.Deactivate{
+			Request:   request,
+			PrevState: result.Deactivate(),
+		}
+	default:
+		return nil
+	}
+}
+
+// EventRecords returns records of events, that are registered on ledger together with the request result. Index of
+// the event in the request makes records of equal events unique.
+func EventRecords(request insolar.Reference, result RequestResult) []*record.Event {
+	events := result.Events()
+	if len(events) == 0 {
+		return nil
+	}
+
+	objectRef := result.ObjectReference()
+	res := make([]*record.Event, 0, len(events))
+	for i, event := range events {
+		res = append(res, &record.Event{
+			Object:    *objectRef.GetLocal(),
+			Request:   request,
+			Prototype: event.Prototype,
+			Topic:     event.Topic,
+			Payload:   event.Payload,
+			Index:     uint32(i),
+		})
+	}
+	return res
 }
@@ -484,6 +484,129 @@ func (m *client) GetRequest(
 	}
 }
 
+// GetResult returns result record of the request. It returns insolar.ErrNotFound if the request has no result.
+func (m *client) GetResult(
+	ctx context.Context, object, reqRef insolar.Reference,
+) (*record.Material, error) {
+	var err error
+	ctx, instrumenter := instrument(ctx, "GetResult", &err)
+	defer instrumenter.end()
+
+	pulseNumber, err := m.pulse(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch pulse")
+	}
+
+	getRequestInfoPl := &payload.GetRequestInfo{
+		ObjectID:  *object.GetLocal(),
+		RequestID: *reqRef.GetLocal(),
+		Pulse:     pulseNumber,
+	}
+
+	pl, err := m.sendToLight(ctx, m.sender, getRequestInfoPl, object)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to send GetRequestInfo")
+	}
+
+	switch p := pl.(type) {
+	case *payload.RequestInfo:
+		if len(p.Result) == 0 {
+			return nil, insolar.ErrNotFound
+		}
+
+		result := record.Material{}
+		err = result.Unmarshal(p.Result)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal result record")
+		}
+		if _, ok := record.Unwrap(&result.Virtual).(*record.Result); !ok {
+			err = fmt.Errorf("GetResult: unexpected record: %T", record.Unwrap(&result.Virtual))
+			return nil, err
+		}
+
+		return &result, nil
+	case *payload.Error:
+		if p.Code == payload.CodeNotFound {
+			return nil, insolar.ErrNotFound
+		}
+		return nil, errors.New(p.Text)
+	default:
+		err = errors.Errorf("unexpected reply %T", pl)
+		return nil, err
+	}
+}
+
+// GetState returns the exact state record of the object. Unlike GetObject it can return any state of the object,
+// not only the latest one.
+func (m *client) GetState(
+	ctx context.Context, object insolar.Reference, stateID insolar.ID,
+) (*record.Material, error) {
+	var err error
+	ctx, instrumenter := instrument(ctx, "GetState", &err)
+	defer instrumenter.end()
+
+	state, err := m.getObjectRecord(ctx, object, stateID)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := record.Unwrap(&state.Virtual).(record.State); !ok {
+		err = fmt.Errorf("GetState: unexpected record: %T", record.Unwrap(&state.Virtual))
+		return nil, err
+	}
+	return state, nil
+}
+
+// GetEvent returns the event record, that was emitted by the object.
+func (m *client) GetEvent(
+	ctx context.Context, object insolar.Reference, eventID insolar.ID,
+) (*record.Material, error) {
+	var err error
+	ctx, instrumenter := instrument(ctx, "GetEvent", &err)
+	defer instrumenter.end()
+
+	event, err := m.getObjectRecord(ctx, object, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := record.Unwrap(&event.Virtual).(*record.Event); !ok {
+		err = fmt.Errorf("GetEvent: unexpected record: %T", record.Unwrap(&event.Virtual))
+		return nil, err
+	}
+	return event, nil
+}
+
+// getObjectRecord fetches state or event record of the object by its ID.
+func (m *client) getObjectRecord(
+	ctx context.Context, object insolar.Reference, id insolar.ID,
+) (*record.Material, error) {
+	getStatePl := &payload.GetState{
+		ObjectID: *object.GetLocal(),
+		StateID:  id,
+	}
+
+	pl, err := m.sendToLight(ctx, m.sender, getStatePl, object)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to send GetState")
+	}
+
+	switch p := pl.(type) {
+	case *payload.State:
+		rec := record.Material{}
+		err = rec.Unmarshal(p.Record)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal record")
+		}
+		return &rec, nil
+	case *payload.Error:
+		if p.Code == payload.CodeNotFound {
+			return nil, insolar.ErrNotFound
+		}
+		return nil, errors.New(p.Text)
+	default:
+		return nil, errors.Errorf("unexpected reply %T", pl)
+	}
+}
+
 // GetPendings returns a list of pending requests
 func (m *client) GetPendings(
 	ctx context.Context, object insolar.Reference, skip []insolar.ID,
@@ -741,6 +864,16 @@ func (m *client) RegisterResult(
 		Payload: result.Result(),
 	}
 
+	var events [][]byte
+	for _, event := range EventRecords(request, result) {
+		virtual := record.Wrap(event)
+		buf, err := virtual.Marshal()
+		if err != nil {
+			return errors.Wrap(err, "RegisterResult: can't serialize Event record")
+		}
+		events = append(events, buf)
+	}
+
 	var pl payload.Payload
 	switch result.Type() {
 	// ActivateObject creates activate object record in storage. Provided prototype reference will be used as objects prototype
@@ -748,16 +881,8 @@ func (m *client) RegisterResult(
 	//
 	// Request reference will be this object's identifier and referred as "object head".
 	case RequestSideEffectActivate:
-		parentRef, imageRef, memory := result.Activate()
-
 		vResultRecord := record.Wrap(&resultRecord)
-		vActivateRecord := record.Wrap(&record.Activate{
-			Request:     request,
-			Memory:      memory,
-			Image:       imageRef,
-			IsPrototype: false,
-			Parent:      parentRef,
-		})
+		vActivateRecord := record.Wrap(SideEffectRecord(request, result))
 
 		plTyped := payload.Activate{}
 		plTyped.Record, err = vActivateRecord.Marshal()
@@ -768,6 +893,7 @@ func (m *client) RegisterResult(
 		if err != nil {
 			return errors.Wrap(err, "RegisterResult: can't serialize Result record")
 		}
+		plTyped.Events = events
 		pl = &plTyped
 
 	// UpdateObject creates amend object record in storage. Provided reference should be a reference to the head of the
@@ -775,16 +901,8 @@ func (m *client) RegisterResult(
 	//
 	// Returned reference will be the latest object state (exact) reference.
 	case RequestSideEffectAmend:
-		objectStateID, objectImage, memory := result.Amend()
-
 		vResultRecord := record.Wrap(&resultRecord)
-		vAmendRecord := record.Wrap(&record.Amend{
-			Request:     request,
-			Memory:      memory,
-			Image:       objectImage,
-			IsPrototype: false,
-			PrevState:   objectStateID,
-		})
+		vAmendRecord := record.Wrap(SideEffectRecord(request, result))
 
 		plTyped := payload.Update{}
 		plTyped.Record, err = vAmendRecord.Marshal()
@@ -795,6 +913,7 @@ func (m *client) RegisterResult(
 		if err != nil {
 			return errors.Wrap(err, "RegisterResult: can't serialize Result record")
 		}
+		plTyped.Events = events
 		pl = &plTyped
 
 	// DeactivateObject creates deactivate object record in storage. Provided reference should be a reference to the head
@@ -802,13 +921,8 @@ func (m *client) RegisterResult(
 	//
 	// Deactivated object cannot be changed.
 	case RequestSideEffectDeactivate:
-		objectStateID := result.Deactivate()
-
 		vResultRecord := record.Wrap(&resultRecord)
-		vDeactivateRecord := record.Wrap(&record.Deactivate{
-			Request:   request,
-			PrevState: objectStateID,
-		})
+		vDeactivateRecord := record.Wrap(SideEffectRecord(request, result))
 
 		plTyped := payload.Deactivate{}
 		plTyped.Record, err = vDeactivateRecord.Marshal()
@@ -819,6 +933,7 @@ func (m *client) RegisterResult(
 		if err != nil {
 			return errors.Wrap(err, "RegisterResult: can't serialize Result record")
 		}
+		plTyped.Events = events
 		pl = &plTyped
 	case RequestSideEffectNone:
 		vResultRecord := record.Wrap(&resultRecord)
@@ -828,6 +943,7 @@ func (m *client) RegisterResult(
 		if err != nil {
 			return errors.Wrap(err, "RegisterResult: can't serialize Result record")
 		}
+		plTyped.Events = events
 		pl = &plTyped
 
 	default:
@@ -36,6 +36,12 @@ type ClientMock struct {
 	beforeGetCodeCounter uint64
 	GetCodeMock          mClientMockGetCode
 
+	funcGetEvent          func(ctx context.Context, objectRef insolar.Reference, eventID insolar.ID) (mp1 *record.Material, err error)
+	inspectFuncGetEvent   func(ctx context.Context, objectRef insolar.Reference, eventID insolar.ID)
+	afterGetEventCounter  uint64
+	beforeGetEventCounter uint64
+	GetEventMock          mClientMockGetEvent
+
 	funcGetObject          func(ctx context.Context, head insolar.Reference, request *insolar.Reference) (o1 ObjectDescriptor, err error)
 	inspectFuncGetObject   func(ctx context.Context, head insolar.Reference, request *insolar.Reference)
 	afterGetObjectCounter  uint64
@@ -66,6 +72,18 @@ type ClientMock struct {
 	beforeGetRequestCounter uint64
 	GetRequestMock          mClientMockGetRequest
 
+	funcGetResult          func(ctx context.Context, objectRef insolar.Reference, reqRef insolar.Reference) (mp1 *record.Material, err error)
+	inspectFuncGetResult   func(ctx context.Context, objectRef insolar.Reference, reqRef insolar.Reference)
+	afterGetResultCounter  uint64
+	beforeGetResultCounter uint64
+	GetResultMock          mClientMockGetResult
+
+	funcGetState          func(ctx context.Context, objectRef insolar.Reference, stateID insolar.ID) (mp1 *record.Material, err error)
+	inspectFuncGetState   func(ctx context.Context, objectRef insolar.Reference, stateID insolar.ID)
+	afterGetStateCounter  uint64
+	beforeGetStateCounter uint64
+	GetStateMock          mClientMockGetState
+
 	funcHasPendings          func(ctx context.Context, object insolar.Reference) (b1 bool, err error)
 	inspectFuncHasPendings   func(ctx context.Context, object insolar.Reference)
 	afterHasPendingsCounter  uint64
@@ -125,6 +143,9 @@ func NewClientMock(t minimock.Tester) *ClientMock {
 	m.GetCodeMock = mClientMockGetCode{mock: m}
 	m.GetCodeMock.callArgs = []*ClientMockGetCodeParams{}
 
+	m.GetEventMock = mClientMockGetEvent{mock: m}
+	m.GetEventMock.callArgs = []*ClientMockGetEventParams{}
+
 	m.GetObjectMock = mClientMockGetObject{mock: m}
 	m.GetObjectMock.callArgs = []*ClientMockGetObjectParams{}
 
@@ -140,6 +161,12 @@ func NewClientMock(t minimock.Tester) *ClientMock {
 	m.GetRequestMock = mClientMockGetRequest{mock: m}
 	m.GetRequestMock.callArgs = []*ClientMockGetRequestParams{}
 
+	m.GetResultMock = mClientMockGetResult{mock: m}
+	m.GetResultMock.callArgs = []*ClientMockGetResultParams{}
+
+	m.GetStateMock = mClientMockGetState{mock: m}
+	m.GetStateMock.callArgs = []*ClientMockGetStateParams{}
+
 	m.HasPendingsMock = mClientMockHasPendings{mock: m}
 	m.HasPendingsMock.callArgs = []*ClientMockHasPendingsParams{}
 
@@ -238,7 +265,7 @@ func (mmActivatePrototype *mClientMockActivatePrototype) Return(err error) *Clie
 	return mmActivatePrototype.mock
 }
 
-//Set uses given function f to mock the Client.ActivatePrototype method
+// Set uses given function f to mock the Client.ActivatePrototype method
 func (mmActivatePrototype *mClientMockActivatePrototype) Set(f func(ctx context.Context, request insolar.Reference, parent insolar.Reference, code insolar.Reference, memory []byte) (err error)) *ClientMock {
 	if mmActivatePrototype.defaultExpectation != nil {
 		mmActivatePrototype.mock.t.Fatalf("Default expectation is already set for the Client.ActivatePrototype method")
@@ -456,7 +483,7 @@ func (mmDeployCode *mClientMockDeployCode) Return(ip1 *insolar.ID, err error) *C
 	return mmDeployCode.mock
 }
 
-//Set uses given function f to mock the Client.DeployCode method
+// Set uses given function f to mock the Client.DeployCode method
 func (mmDeployCode *mClientMockDeployCode) Set(f func(ctx context.Context, code []byte, machineType insolar.MachineType) (ip1 *insolar.ID, err error)) *ClientMock {
 	if mmDeployCode.defaultExpectation != nil {
 		mmDeployCode.mock.t.Fatalf("Default expectation is already set for the Client.DeployCode method")
@@ -673,7 +700,7 @@ func (mmGetCode *mClientMockGetCode) Return(c2 CodeDescriptor, err error) *Clien
 	return mmGetCode.mock
 }
 
-//Set uses given function f to mock the Client.GetCode method
+// Set uses given function f to mock the Client.GetCode method
 func (mmGetCode *mClientMockGetCode) Set(f func(ctx context.Context, ref insolar.Reference) (c2 CodeDescriptor, err error)) *ClientMock {
 	if mmGetCode.defaultExpectation != nil {
 		mmGetCode.mock.t.Fatalf("Default expectation is already set for the Client.GetCode method")
@@ -817,6 +844,224 @@ func (m *ClientMock) MinimockGetCodeInspect() {
 	}
 }
 
+type mClientMockGetEvent struct {
+	mock               *ClientMock
+	defaultExpectation *ClientMockGetEventExpectation
+	expectations       []*ClientMockGetEventExpectation
+
+	callArgs []*ClientMockGetEventParams
+	mutex    sync.RWMutex
+}
+
+// ClientMockGetEventExpectation specifies expectation struct of the Client.GetEvent
+type ClientMockGetEventExpectation struct {
+	mock    *ClientMock
+	params  *ClientMockGetEventParams
+	results *ClientMockGetEventResults
+	Counter uint64
+}
+
+// ClientMockGetEventParams contains parameters of the Client.GetEvent
+type ClientMockGetEventParams struct {
+	ctx       context.Context
+	objectRef insolar.Reference
+	eventID   insolar.ID
+}
+
+// ClientMockGetEventResults contains results of the Client.GetEvent
+type ClientMockGetEventResults struct {
+	mp1 *record.Material
+	err error
+}
+
+// Expect sets up expected params for Client.GetEvent
+func (mmGetEvent *mClientMockGetEvent) Expect(ctx context.Context, objectRef insolar.Reference, eventID insolar.ID) *mClientMockGetEvent {
+	if mmGetEvent.mock.funcGetEvent != nil {
+		mmGetEvent.mock.t.Fatalf("ClientMock.GetEvent mock is already set by Set")
+	}
+
+	if mmGetEvent.defaultExpectation == nil {
+		mmGetEvent.defaultExpectation = &ClientMockGetEventExpectation{}
+	}
+
+	mmGetEvent.defaultExpectation.params = &ClientMockGetEventParams{ctx, objectRef, eventID}
+	for _, e := range mmGetEvent.expectations {
+		if minimock.Equal(e.params, mmGetEvent.defaultExpectation.params) {
+			mmGetEvent.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetEvent.defaultExpectation.params)
+		}
+	}
+
+	return mmGetEvent
+}
+
+// Inspect accepts an inspector function that has same arguments as the Client.GetEvent
+func (mmGetEvent *mClientMockGetEvent) Inspect(f func(ctx context.Context, objectRef insolar.Reference, eventID insolar.ID)) *mClientMockGetEvent {
+	if mmGetEvent.mock.inspectFuncGetEvent != nil {
+		mmGetEvent.mock.t.Fatalf("Inspect function is already set for ClientMock.GetEvent")
+	}
+
+	mmGetEvent.mock.inspectFuncGetEvent = f
+
+	return mmGetEvent
+}
+
+// Return sets up results that will be returned by Client.GetEvent
+func (mmGetEvent *mClientMockGetEvent) Return(mp1 *record.Material, err error) *ClientMock {
+	if mmGetEvent.mock.funcGetEvent != nil {
+		mmGetEvent.mock.t.Fatalf("ClientMock.GetEvent mock is already set by Set")
+	}
+
+	if mmGetEvent.defaultExpectation == nil {
+		mmGetEvent.defaultExpectation = &ClientMockGetEventExpectation{mock: mmGetEvent.mock}
+	}
+	mmGetEvent.defaultExpectation.results = &ClientMockGetEventResults{mp1, err}
+	return mmGetEvent.mock
+}
+
+// Set uses given function f to mock the Client.GetEvent method
+func (mmGetEvent *mClientMockGetEvent) Set(f func(ctx context.Context, objectRef insolar.Reference, eventID insolar.ID) (mp1 *record.Material, err error)) *ClientMock {
+	if mmGetEvent.defaultExpectation != nil {
+		mmGetEvent.mock.t.Fatalf("Default expectation is already set for the Client.GetEvent method")
+	}
+
+	if len(mmGetEvent.expectations) > 0 {
+		mmGetEvent.mock.t.Fatalf("Some expectations are already set for the Client.GetEvent method")
+	}
+
+	mmGetEvent.mock.funcGetEvent = f
+	return mmGetEvent.mock
+}
+
+// When sets expectation for the Client.GetEvent which will trigger the result defined by the following
+// Then helper
+func (mmGetEvent *mClientMockGetEvent) When(ctx context.Context, objectRef insolar.Reference, eventID insolar.ID) *ClientMockGetEventExpectation {
+	if mmGetEvent.mock.funcGetEvent != nil {
+		mmGetEvent.mock.t.Fatalf("ClientMock.GetEvent mock is already set by Set")
+	}
+
+	expectation := &ClientMockGetEventExpectation{
+		mock:   mmGetEvent.mock,
+		params: &ClientMockGetEventParams{ctx, objectRef, eventID},
+	}
+	mmGetEvent.expectations = append(mmGetEvent.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Client.GetEvent return parameters for the expectation previously defined by the When method
+func (e *ClientMockGetEventExpectation) Then(mp1 *record.Material, err error) *ClientMock {
+	e.results = &ClientMockGetEventResults{mp1, err}
+	return e.mock
+}
+
+// GetEvent implements Client
+func (mmGetEvent *ClientMock) GetEvent(ctx context.Context, objectRef insolar.Reference, eventID insolar.ID) (mp1 *record.Material, err error) {
+	mm_atomic.AddUint64(&mmGetEvent.beforeGetEventCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetEvent.afterGetEventCounter, 1)
+
+	if mmGetEvent.inspectFuncGetEvent != nil {
+		mmGetEvent.inspectFuncGetEvent(ctx, objectRef, eventID)
+	}
+
+	mm_params := &ClientMockGetEventParams{ctx, objectRef, eventID}
+
+	// Record call args
+	mmGetEvent.GetEventMock.mutex.Lock()
+	mmGetEvent.GetEventMock.callArgs = append(mmGetEvent.GetEventMock.callArgs, mm_params)
+	mmGetEvent.GetEventMock.mutex.Unlock()
+
+	for _, e := range mmGetEvent.GetEventMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.mp1, e.results.err
+		}
+	}
+
+	if mmGetEvent.GetEventMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetEvent.GetEventMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetEvent.GetEventMock.defaultExpectation.params
+		mm_got := ClientMockGetEventParams{ctx, objectRef, eventID}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetEvent.t.Errorf("ClientMock.GetEvent got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetEvent.GetEventMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetEvent.t.Fatal("No results are set for the ClientMock.GetEvent")
+		}
+		return (*mm_results).mp1, (*mm_results).err
+	}
+	if mmGetEvent.funcGetEvent != nil {
+		return mmGetEvent.funcGetEvent(ctx, objectRef, eventID)
+	}
+	mmGetEvent.t.Fatalf("Unexpected call to ClientMock.GetEvent. %v %v %v", ctx, objectRef, eventID)
+	return
+}
+
+// GetEventAfterCounter returns a count of finished ClientMock.GetEvent invocations
+func (mmGetEvent *ClientMock) GetEventAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetEvent.afterGetEventCounter)
+}
+
+// GetEventBeforeCounter returns a count of ClientMock.GetEvent invocations
+func (mmGetEvent *ClientMock) GetEventBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetEvent.beforeGetEventCounter)
+}
+
+// Calls returns a list of arguments used in each call to ClientMock.GetEvent.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetEvent *mClientMockGetEvent) Calls() []*ClientMockGetEventParams {
+	mmGetEvent.mutex.RLock()
+
+	argCopy := make([]*ClientMockGetEventParams, len(mmGetEvent.callArgs))
+	copy(argCopy, mmGetEvent.callArgs)
+
+	mmGetEvent.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetEventDone returns true if the count of the GetEvent invocations corresponds
+// the number of defined expectations
+func (m *ClientMock) MinimockGetEventDone() bool {
+	for _, e := range m.GetEventMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetEventMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetEventCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetEvent != nil && mm_atomic.LoadUint64(&m.afterGetEventCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockGetEventInspect logs each unmet expectation
+func (m *ClientMock) MinimockGetEventInspect() {
+	for _, e := range m.GetEventMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ClientMock.GetEvent with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetEventMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetEventCounter) < 1 {
+		if m.GetEventMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ClientMock.GetEvent")
+		} else {
+			m.t.Errorf("Expected call to ClientMock.GetEvent with params: %#v", *m.GetEventMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetEvent != nil && mm_atomic.LoadUint64(&m.afterGetEventCounter) < 1 {
+		m.t.Error("Expected call to ClientMock.GetEvent")
+	}
+}
+
 type mClientMockGetObject struct {
 	mock               *ClientMock
 	defaultExpectation *ClientMockGetObjectExpectation
@@ -891,7 +1136,7 @@ func (mmGetObject *mClientMockGetObject) Return(o1 ObjectDescriptor, err error)
 	return mmGetObject.mock
 }
 
-//Set uses given function f to mock the Client.GetObject method
+// Set uses given function f to mock the Client.GetObject method
 func (mmGetObject *mClientMockGetObject) Set(f func(ctx context.Context, head insolar.Reference, request *insolar.Reference) (o1 ObjectDescriptor, err error)) *ClientMock {
 	if mmGetObject.defaultExpectation != nil {
 		mmGetObject.mock.t.Fatalf("Default expectation is already set for the Client.GetObject method")
@@ -1109,7 +1354,7 @@ func (mmGetPendings *mClientMockGetPendings) Return(ra1 []insolar.Reference, err
 	return mmGetPendings.mock
 }
 
-//Set uses given function f to mock the Client.GetPendings method
+// Set uses given function f to mock the Client.GetPendings method
 func (mmGetPendings *mClientMockGetPendings) Set(f func(ctx context.Context, objectRef insolar.Reference, skip []insolar.ID) (ra1 []insolar.Reference, err error)) *ClientMock {
 	if mmGetPendings.defaultExpectation != nil {
 		mmGetPendings.mock.t.Fatalf("Default expectation is already set for the Client.GetPendings method")
@@ -1326,7 +1571,7 @@ func (mmGetPrototype *mClientMockGetPrototype) Return(p1 PrototypeDescriptor, er
 	return mmGetPrototype.mock
 }
 
-//Set uses given function f to mock the Client.GetPrototype method
+// Set uses given function f to mock the Client.GetPrototype method
 func (mmGetPrototype *mClientMockGetPrototype) Set(f func(ctx context.Context, head insolar.Reference) (p1 PrototypeDescriptor, err error)) *ClientMock {
 	if mmGetPrototype.defaultExpectation != nil {
 		mmGetPrototype.mock.t.Fatalf("Default expectation is already set for the Client.GetPrototype method")
@@ -1543,7 +1788,7 @@ func (mmGetPulse *mClientMockGetPulse) Return(p1 insolar.Pulse, err error) *Clie
 	return mmGetPulse.mock
 }
 
-//Set uses given function f to mock the Client.GetPulse method
+// Set uses given function f to mock the Client.GetPulse method
 func (mmGetPulse *mClientMockGetPulse) Set(f func(ctx context.Context, pn insolar.PulseNumber) (p1 insolar.Pulse, err error)) *ClientMock {
 	if mmGetPulse.defaultExpectation != nil {
 		mmGetPulse.mock.t.Fatalf("Default expectation is already set for the Client.GetPulse method")
@@ -1761,7 +2006,7 @@ func (mmGetRequest *mClientMockGetRequest) Return(r1 record.Request, err error)
 	return mmGetRequest.mock
 }
 
-//Set uses given function f to mock the Client.GetRequest method
+// Set uses given function f to mock the Client.GetRequest method
 func (mmGetRequest *mClientMockGetRequest) Set(f func(ctx context.Context, objectRef insolar.Reference, reqRef insolar.Reference) (r1 record.Request, err error)) *ClientMock {
 	if mmGetRequest.defaultExpectation != nil {
 		mmGetRequest.mock.t.Fatalf("Default expectation is already set for the Client.GetRequest method")
@@ -1905,6 +2150,442 @@ func (m *ClientMock) MinimockGetRequestInspect() {
 	}
 }
 
+type mClientMockGetResult struct {
+	mock               *ClientMock
+	defaultExpectation *ClientMockGetResultExpectation
+	expectations       []*ClientMockGetResultExpectation
+
+	callArgs []*ClientMockGetResultParams
+	mutex    sync.RWMutex
+}
+
+// ClientMockGetResultExpectation specifies expectation struct of the Client.GetResult
+type ClientMockGetResultExpectation struct {
+	mock    *ClientMock
+	params  *ClientMockGetResultParams
+	results *ClientMockGetResultResults
+	Counter uint64
+}
+
+// ClientMockGetResultParams contains parameters of the Client.GetResult
+type ClientMockGetResultParams struct {
+	ctx       context.Context
+	objectRef insolar.Reference
+	reqRef    insolar.Reference
+}
+
+// ClientMockGetResultResults contains results of the Client.GetResult
+type ClientMockGetResultResults struct {
+	mp1 *record.Material
+	err error
+}
+
+// Expect sets up expected params for Client.GetResult
+func (mmGetResult *mClientMockGetResult) Expect(ctx context.Context, objectRef insolar.Reference, reqRef insolar.Reference) *mClientMockGetResult {
+	if mmGetResult.mock.funcGetResult != nil {
+		mmGetResult.mock.t.Fatalf("ClientMock.GetResult mock is already set by Set")
+	}
+
+	if mmGetResult.defaultExpectation == nil {
+		mmGetResult.defaultExpectation = &ClientMockGetResultExpectation{}
+	}
+
+	mmGetResult.defaultExpectation.params = &ClientMockGetResultParams{ctx, objectRef, reqRef}
+	for _, e := range mmGetResult.expectations {
+		if minimock.Equal(e.params, mmGetResult.defaultExpectation.params) {
+			mmGetResult.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetResult.defaultExpectation.params)
+		}
+	}
+
+	return mmGetResult
+}
+
+// Inspect accepts an inspector function that has same arguments as the Client.GetResult
+func (mmGetResult *mClientMockGetResult) Inspect(f func(ctx context.Context, objectRef insolar.Reference, reqRef insolar.Reference)) *mClientMockGetResult {
+	if mmGetResult.mock.inspectFuncGetResult != nil {
+		mmGetResult.mock.t.Fatalf("Inspect function is already set for ClientMock.GetResult")
+	}
+
+	mmGetResult.mock.inspectFuncGetResult = f
+
+	return mmGetResult
+}
+
+// Return sets up results that will be returned by Client.GetResult
+func (mmGetResult *mClientMockGetResult) Return(mp1 *record.Material, err error) *ClientMock {
+	if mmGetResult.mock.funcGetResult != nil {
+		mmGetResult.mock.t.Fatalf("ClientMock.GetResult mock is already set by Set")
+	}
+
+	if mmGetResult.defaultExpectation == nil {
+		mmGetResult.defaultExpectation = &ClientMockGetResultExpectation{mock: mmGetResult.mock}
+	}
+	mmGetResult.defaultExpectation.results = &ClientMockGetResultResults{mp1, err}
+	return mmGetResult.mock
+}
+
+// Set uses given function f to mock the Client.GetResult method
+func (mmGetResult *mClientMockGetResult) Set(f func(ctx context.Context, objectRef insolar.Reference, reqRef insolar.Reference) (mp1 *record.Material, err error)) *ClientMock {
+	if mmGetResult.defaultExpectation != nil {
+		mmGetResult.mock.t.Fatalf("Default expectation is already set for the Client.GetResult method")
+	}
+
+	if len(mmGetResult.expectations) > 0 {
+		mmGetResult.mock.t.Fatalf("Some expectations are already set for the Client.GetResult method")
+	}
+
+	mmGetResult.mock.funcGetResult = f
+	return mmGetResult.mock
+}
+
+// When sets expectation for the Client.GetResult which will trigger the result defined by the following
+// Then helper
+func (mmGetResult *mClientMockGetResult) When(ctx context.Context, objectRef insolar.Reference, reqRef insolar.Reference) *ClientMockGetResultExpectation {
+	if mmGetResult.mock.funcGetResult != nil {
+		mmGetResult.mock.t.Fatalf("ClientMock.GetResult mock is already set by Set")
+	}
+
+	expectation := &ClientMockGetResultExpectation{
+		mock:   mmGetResult.mock,
+		params: &ClientMockGetResultParams{ctx, objectRef, reqRef},
+	}
+	mmGetResult.expectations = append(mmGetResult.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Client.GetResult return parameters for the expectation previously defined by the When method
+func (e *ClientMockGetResultExpectation) Then(mp1 *record.Material, err error) *ClientMock {
+	e.results = &ClientMockGetResultResults{mp1, err}
+	return e.mock
+}
+
+// GetResult implements Client
+func (mmGetResult *ClientMock) GetResult(ctx context.Context, objectRef insolar.Reference, reqRef insolar.Reference) (mp1 *record.Material, err error) {
+	mm_atomic.AddUint64(&mmGetResult.beforeGetResultCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetResult.afterGetResultCounter, 1)
+
+	if mmGetResult.inspectFuncGetResult != nil {
+		mmGetResult.inspectFuncGetResult(ctx, objectRef, reqRef)
+	}
+
+	mm_params := &ClientMockGetResultParams{ctx, objectRef, reqRef}
+
+	// Record call args
+	mmGetResult.GetResultMock.mutex.Lock()
+	mmGetResult.GetResultMock.callArgs = append(mmGetResult.GetResultMock.callArgs, mm_params)
+	mmGetResult.GetResultMock.mutex.Unlock()
+
+	for _, e := range mmGetResult.GetResultMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.mp1, e.results.err
+		}
+	}
+
+	if mmGetResult.GetResultMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetResult.GetResultMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetResult.GetResultMock.defaultExpectation.params
+		mm_got := ClientMockGetResultParams{ctx, objectRef, reqRef}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetResult.t.Errorf("ClientMock.GetResult got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetResult.GetResultMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetResult.t.Fatal("No results are set for the ClientMock.GetResult")
+		}
+		return (*mm_results).mp1, (*mm_results).err
+	}
+	if mmGetResult.funcGetResult != nil {
+		return mmGetResult.funcGetResult(ctx, objectRef, reqRef)
+	}
+	mmGetResult.t.Fatalf("Unexpected call to ClientMock.GetResult. %v %v %v", ctx, objectRef, reqRef)
+	return
+}
+
+// GetResultAfterCounter returns a count of finished ClientMock.GetResult invocations
+func (mmGetResult *ClientMock) GetResultAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetResult.afterGetResultCounter)
+}
+
+// GetResultBeforeCounter returns a count of ClientMock.GetResult invocations
+func (mmGetResult *ClientMock) GetResultBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetResult.beforeGetResultCounter)
+}
+
+// Calls returns a list of arguments used in each call to ClientMock.GetResult.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetResult *mClientMockGetResult) Calls() []*ClientMockGetResultParams {
+	mmGetResult.mutex.RLock()
+
+	argCopy := make([]*ClientMockGetResultParams, len(mmGetResult.callArgs))
+	copy(argCopy, mmGetResult.callArgs)
+
+	mmGetResult.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetResultDone returns true if the count of the GetResult invocations corresponds
+// the number of defined expectations
+func (m *ClientMock) MinimockGetResultDone() bool {
+	for _, e := range m.GetResultMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetResultMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetResultCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetResult != nil && mm_atomic.LoadUint64(&m.afterGetResultCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockGetResultInspect logs each unmet expectation
+func (m *ClientMock) MinimockGetResultInspect() {
+	for _, e := range m.GetResultMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ClientMock.GetResult with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetResultMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetResultCounter) < 1 {
+		if m.GetResultMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ClientMock.GetResult")
+		} else {
+			m.t.Errorf("Expected call to ClientMock.GetResult with params: %#v", *m.GetResultMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetResult != nil && mm_atomic.LoadUint64(&m.afterGetResultCounter) < 1 {
+		m.t.Error("Expected call to ClientMock.GetResult")
+	}
+}
+
+type mClientMockGetState struct {
+	mock               *ClientMock
+	defaultExpectation *ClientMockGetStateExpectation
+	expectations       []*ClientMockGetStateExpectation
+
+	callArgs []*ClientMockGetStateParams
+	mutex    sync.RWMutex
+}
+
+// ClientMockGetStateExpectation specifies expectation struct of the Client.GetState
+type ClientMockGetStateExpectation struct {
+	mock    *ClientMock
+	params  *ClientMockGetStateParams
+	results *ClientMockGetStateResults
+	Counter uint64
+}
+
+// ClientMockGetStateParams contains parameters of the Client.GetState
+type ClientMockGetStateParams struct {
+	ctx       context.Context
+	objectRef insolar.Reference
+	stateID   insolar.ID
+}
+
+// ClientMockGetStateResults contains results of the Client.GetState
+type ClientMockGetStateResults struct {
+	mp1 *record.Material
+	err error
+}
+
+// Expect sets up expected params for Client.GetState
+func (mmGetState *mClientMockGetState) Expect(ctx context.Context, objectRef insolar.Reference, stateID insolar.ID) *mClientMockGetState {
+	if mmGetState.mock.funcGetState != nil {
+		mmGetState.mock.t.Fatalf("ClientMock.GetState mock is already set by Set")
+	}
+
+	if mmGetState.defaultExpectation == nil {
+		mmGetState.defaultExpectation = &ClientMockGetStateExpectation{}
+	}
+
+	mmGetState.defaultExpectation.params = &ClientMockGetStateParams{ctx, objectRef, stateID}
+	for _, e := range mmGetState.expectations {
+		if minimock.Equal(e.params, mmGetState.defaultExpectation.params) {
+			mmGetState.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetState.defaultExpectation.params)
+		}
+	}
+
+	return mmGetState
+}
+
+// Inspect accepts an inspector function that has same arguments as the Client.GetState
+func (mmGetState *mClientMockGetState) Inspect(f func(ctx context.Context, objectRef insolar.Reference, stateID insolar.ID)) *mClientMockGetState {
+	if mmGetState.mock.inspectFuncGetState != nil {
+		mmGetState.mock.t.Fatalf("Inspect function is already set for ClientMock.GetState")
+	}
+
+	mmGetState.mock.inspectFuncGetState = f
+
+	return mmGetState
+}
+
+// Return sets up results that will be returned by Client.GetState
+func (mmGetState *mClientMockGetState) Return(mp1 *record.Material, err error) *ClientMock {
+	if mmGetState.mock.funcGetState != nil {
+		mmGetState.mock.t.Fatalf("ClientMock.GetState mock is already set by Set")
+	}
+
+	if mmGetState.defaultExpectation == nil {
+		mmGetState.defaultExpectation = &ClientMockGetStateExpectation{mock: mmGetState.mock}
+	}
+	mmGetState.defaultExpectation.results = &ClientMockGetStateResults{mp1, err}
+	return mmGetState.mock
+}
+
+// Set uses given function f to mock the Client.GetState method
+func (mmGetState *mClientMockGetState) Set(f func(ctx context.Context, objectRef insolar.Reference, stateID insolar.ID) (mp1 *record.Material, err error)) *ClientMock {
+	if mmGetState.defaultExpectation != nil {
+		mmGetState.mock.t.Fatalf("Default expectation is already set for the Client.GetState method")
+	}
+
+	if len(mmGetState.expectations) > 0 {
+		mmGetState.mock.t.Fatalf("Some expectations are already set for the Client.GetState method")
+	}
+
+	mmGetState.mock.funcGetState = f
+	return mmGetState.mock
+}
+
+// When sets expectation for the Client.GetState which will trigger the result defined by the following
+// Then helper
+func (mmGetState *mClientMockGetState) When(ctx context.Context, objectRef insolar.Reference, stateID insolar.ID) *ClientMockGetStateExpectation {
+	if mmGetState.mock.funcGetState != nil {
+		mmGetState.mock.t.Fatalf("ClientMock.GetState mock is already set by Set")
+	}
+
+	expectation := &ClientMockGetStateExpectation{
+		mock:   mmGetState.mock,
+		params: &ClientMockGetStateParams{ctx, objectRef, stateID},
+	}
+	mmGetState.expectations = append(mmGetState.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Client.GetState return parameters for the expectation previously defined by the When method
+func (e *ClientMockGetStateExpectation) Then(mp1 *record.Material, err error) *ClientMock {
+	e.results = &ClientMockGetStateResults{mp1, err}
+	return e.mock
+}
+
+// GetState implements Client
+func (mmGetState *ClientMock) GetState(ctx context.Context, objectRef insolar.Reference, stateID insolar.ID) (mp1 *record.Material, err error) {
+	mm_atomic.AddUint64(&mmGetState.beforeGetStateCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetState.afterGetStateCounter, 1)
+
+	if mmGetState.inspectFuncGetState != nil {
+		mmGetState.inspectFuncGetState(ctx, objectRef, stateID)
+	}
+
+	mm_params := &ClientMockGetStateParams{ctx, objectRef, stateID}
+
+	// Record call args
+	mmGetState.GetStateMock.mutex.Lock()
+	mmGetState.GetStateMock.callArgs = append(mmGetState.GetStateMock.callArgs, mm_params)
+	mmGetState.GetStateMock.mutex.Unlock()
+
+	for _, e := range mmGetState.GetStateMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.mp1, e.results.err
+		}
+	}
+
+	if mmGetState.GetStateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetState.GetStateMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetState.GetStateMock.defaultExpectation.params
+		mm_got := ClientMockGetStateParams{ctx, objectRef, stateID}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetState.t.Errorf("ClientMock.GetState got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetState.GetStateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetState.t.Fatal("No results are set for the ClientMock.GetState")
+		}
+		return (*mm_results).mp1, (*mm_results).err
+	}
+	if mmGetState.funcGetState != nil {
+		return mmGetState.funcGetState(ctx, objectRef, stateID)
+	}
+	mmGetState.t.Fatalf("Unexpected call to ClientMock.GetState. %v %v %v", ctx, objectRef, stateID)
+	return
+}
+
+// GetStateAfterCounter returns a count of finished ClientMock.GetState invocations
+func (mmGetState *ClientMock) GetStateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetState.afterGetStateCounter)
+}
+
+// GetStateBeforeCounter returns a count of ClientMock.GetState invocations
+func (mmGetState *ClientMock) GetStateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetState.beforeGetStateCounter)
+}
+
+// Calls returns a list of arguments used in each call to ClientMock.GetState.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetState *mClientMockGetState) Calls() []*ClientMockGetStateParams {
+	mmGetState.mutex.RLock()
+
+	argCopy := make([]*ClientMockGetStateParams, len(mmGetState.callArgs))
+	copy(argCopy, mmGetState.callArgs)
+
+	mmGetState.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetStateDone returns true if the count of the GetState invocations corresponds
+// the number of defined expectations
+func (m *ClientMock) MinimockGetStateDone() bool {
+	for _, e := range m.GetStateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetStateMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetStateCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetState != nil && mm_atomic.LoadUint64(&m.afterGetStateCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockGetStateInspect logs each unmet expectation
+func (m *ClientMock) MinimockGetStateInspect() {
+	for _, e := range m.GetStateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ClientMock.GetState with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetStateMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetStateCounter) < 1 {
+		if m.GetStateMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ClientMock.GetState")
+		} else {
+			m.t.Errorf("Expected call to ClientMock.GetState with params: %#v", *m.GetStateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetState != nil && mm_atomic.LoadUint64(&m.afterGetStateCounter) < 1 {
+		m.t.Error("Expected call to ClientMock.GetState")
+	}
+}
+
 type mClientMockHasPendings struct {
 	mock               *ClientMock
 	defaultExpectation *ClientMockHasPendingsExpectation
@@ -1978,7 +2659,7 @@ func (mmHasPendings *mClientMockHasPendings) Return(b1 bool, err error) *ClientM
 	return mmHasPendings.mock
 }
 
-//Set uses given function f to mock the Client.HasPendings method
+// Set uses given function f to mock the Client.HasPendings method
 func (mmHasPendings *mClientMockHasPendings) Set(f func(ctx context.Context, object insolar.Reference) (b1 bool, err error)) *ClientMock {
 	if mmHasPendings.defaultExpectation != nil {
 		mmHasPendings.mock.t.Fatalf("Default expectation is already set for the Client.HasPendings method")
@@ -2189,7 +2870,7 @@ func (mmInjectCodeDescriptor *mClientMockInjectCodeDescriptor) Return() *ClientM
 	return mmInjectCodeDescriptor.mock
 }
 
-//Set uses given function f to mock the Client.InjectCodeDescriptor method
+// Set uses given function f to mock the Client.InjectCodeDescriptor method
 func (mmInjectCodeDescriptor *mClientMockInjectCodeDescriptor) Set(f func(r1 insolar.Reference, c1 CodeDescriptor)) *ClientMock {
 	if mmInjectCodeDescriptor.defaultExpectation != nil {
 		mmInjectCodeDescriptor.mock.t.Fatalf("Default expectation is already set for the Client.InjectCodeDescriptor method")
@@ -2360,7 +3041,7 @@ func (mmInjectFinish *mClientMockInjectFinish) Return() *ClientMock {
 	return mmInjectFinish.mock
 }
 
-//Set uses given function f to mock the Client.InjectFinish method
+// Set uses given function f to mock the Client.InjectFinish method
 func (mmInjectFinish *mClientMockInjectFinish) Set(f func()) *ClientMock {
 	if mmInjectFinish.defaultExpectation != nil {
 		mmInjectFinish.mock.t.Fatalf("Default expectation is already set for the Client.InjectFinish method")
@@ -2512,7 +3193,7 @@ func (mmInjectPrototypeDescriptor *mClientMockInjectPrototypeDescriptor) Return(
 	return mmInjectPrototypeDescriptor.mock
 }
 
-//Set uses given function f to mock the Client.InjectPrototypeDescriptor method
+// Set uses given function f to mock the Client.InjectPrototypeDescriptor method
 func (mmInjectPrototypeDescriptor *mClientMockInjectPrototypeDescriptor) Set(f func(r1 insolar.Reference, p1 PrototypeDescriptor)) *ClientMock {
 	if mmInjectPrototypeDescriptor.defaultExpectation != nil {
 		mmInjectPrototypeDescriptor.mock.t.Fatalf("Default expectation is already set for the Client.InjectPrototypeDescriptor method")
@@ -2706,7 +3387,7 @@ func (mmRegisterIncomingRequest *mClientMockRegisterIncomingRequest) Return(rp1
 	return mmRegisterIncomingRequest.mock
 }
 
-//Set uses given function f to mock the Client.RegisterIncomingRequest method
+// Set uses given function f to mock the Client.RegisterIncomingRequest method
 func (mmRegisterIncomingRequest *mClientMockRegisterIncomingRequest) Set(f func(ctx context.Context, request *record.IncomingRequest) (rp1 *payload.RequestInfo, err error)) *ClientMock {
 	if mmRegisterIncomingRequest.defaultExpectation != nil {
 		mmRegisterIncomingRequest.mock.t.Fatalf("Default expectation is already set for the Client.RegisterIncomingRequest method")
@@ -2923,7 +3604,7 @@ func (mmRegisterOutgoingRequest *mClientMockRegisterOutgoingRequest) Return(rp1
 	return mmRegisterOutgoingRequest.mock
 }
 
-//Set uses given function f to mock the Client.RegisterOutgoingRequest method
+// Set uses given function f to mock the Client.RegisterOutgoingRequest method
 func (mmRegisterOutgoingRequest *mClientMockRegisterOutgoingRequest) Set(f func(ctx context.Context, request *record.OutgoingRequest) (rp1 *payload.RequestInfo, err error)) *ClientMock {
 	if mmRegisterOutgoingRequest.defaultExpectation != nil {
 		mmRegisterOutgoingRequest.mock.t.Fatalf("Default expectation is already set for the Client.RegisterOutgoingRequest method")
@@ -3140,7 +3821,7 @@ func (mmRegisterResult *mClientMockRegisterResult) Return(err error) *ClientMock
 	return mmRegisterResult.mock
 }
 
-//Set uses given function f to mock the Client.RegisterResult method
+// Set uses given function f to mock the Client.RegisterResult method
 func (mmRegisterResult *mClientMockRegisterResult) Set(f func(ctx context.Context, request insolar.Reference, result RequestResult) (err error)) *ClientMock {
 	if mmRegisterResult.defaultExpectation != nil {
 		mmRegisterResult.mock.t.Fatalf("Default expectation is already set for the Client.RegisterResult method")
@@ -3293,6 +3974,8 @@ func (m *ClientMock) MinimockFinish() {
 
 		m.MinimockGetCodeInspect()
 
+		m.MinimockGetEventInspect()
+
 		m.MinimockGetObjectInspect()
 
 		m.MinimockGetPendingsInspect()
@@ -3303,6 +3986,10 @@ func (m *ClientMock) MinimockFinish() {
 
 		m.MinimockGetRequestInspect()
 
+		m.MinimockGetResultInspect()
+
+		m.MinimockGetStateInspect()
+
 		m.MinimockHasPendingsInspect()
 
 		m.MinimockInjectCodeDescriptorInspect()
@@ -3342,11 +4029,14 @@ func (m *ClientMock) minimockDone() bool {
 		m.MinimockActivatePrototypeDone() &&
 		m.MinimockDeployCodeDone() &&
 		m.MinimockGetCodeDone() &&
+		m.MinimockGetEventDone() &&
 		m.MinimockGetObjectDone() &&
 		m.MinimockGetPendingsDone() &&
 		m.MinimockGetPrototypeDone() &&
 		m.MinimockGetPulseDone() &&
 		m.MinimockGetRequestDone() &&
+		m.MinimockGetResultDone() &&
+		m.MinimockGetStateDone() &&
 		m.MinimockHasPendingsDone() &&
 		m.MinimockInjectCodeDescriptorDone() &&
 		m.MinimockInjectFinishDone() &&
@@ -38,6 +38,8 @@ type TestRequestResult struct {
 	ObjectImage     insolar.Reference // amend + activate
 	ObjectStateID   insolar.ID        // amend + deactivate
 	Memory          []byte            // amend + activate
+
+	EmittedEvents []ContractEvent // every
 }
 
 func (s *TestRequestResult) Result() []byte {
@@ -64,6 +66,10 @@ func (s *TestRequestResult) ObjectReference() insolar.Reference {
 	return s.RawObjectReference
 }
 
+func (s *TestRequestResult) Events() []ContractEvent {
+	return s.EmittedEvents
+}
+
 func genAPIRequestID() string {
 	APIRequestID := utils.RandTraceID()
 	if strings.Contains(APIRequestID, "createRandomTraceIDFailed") {
@@ -273,6 +279,298 @@ func (s *ArtifactsMangerClientSuite) TestRequest_FailedToSend() {
 	s.Contains(err.Error(), "failed to send GetRequest")
 }
 
+func (s *ArtifactsMangerClientSuite) TestGetResult() {
+	// Arrange
+	objectRef := gen.Reference()
+	requestRef := gen.RecordReference()
+	result := record.Material{
+		Virtual: record.Wrap(&record.Result{
+			Object:  *objectRef.GetLocal(),
+			Request: requestRef,
+			Payload: []byte{1, 2, 3},
+		}),
+		ID: gen.ID(),
+	}
+	resultBuf, err := result.Marshal()
+	s.Require().NoError(err)
+
+	for name, test := range map[string]struct {
+		response payload.Payload
+		check    func(*record.Material, error)
+	}{
+		"success": {
+			response: &payload.RequestInfo{
+				RequestID: *requestRef.GetLocal(),
+				Result:    resultBuf,
+			},
+			check: func(got *record.Material, err error) {
+				s.NoError(err)
+				s.Equal(result, *got)
+			},
+		},
+		"no result": {
+			response: &payload.RequestInfo{
+				RequestID: *requestRef.GetLocal(),
+			},
+			check: func(_ *record.Material, err error) {
+				s.Equal(insolar.ErrNotFound, err)
+			},
+		},
+		"not a result": {
+			response: &payload.RequestInfo{
+				RequestID: *requestRef.GetLocal(),
+				Result:    s.packMaterialRecord(&record.Amend{}),
+			},
+			check: func(_ *record.Material, err error) {
+				s.Contains(err.Error(), "unexpected record")
+			},
+		},
+		"other error": {
+			response: &payload.Error{
+				Text: "some error",
+				Code: payload.CodeUnknown,
+			},
+			check: func(_ *record.Material, err error) {
+				s.Contains(err.Error(), "some error")
+			},
+		},
+	} {
+		s.Run(name, func() {
+			s.prepareContext()
+
+			pulseObject := insolar.Pulse{PulseNumber: gen.PulseNumber()}
+			s.pulseAccessor.LatestMock.Return(pulseObject, nil)
+
+			s.busSender.SendRoleMock.Set(
+				func(
+					_ context.Context,
+					msg *wmMessage.Message,
+					role insolar.DynamicRole,
+					ref insolar.Reference,
+				) (
+					<-chan *wmMessage.Message,
+					func(),
+				) {
+					s.Equal(insolar.DynamicRoleLightExecutor, role)
+
+					getInfo := payload.GetRequestInfo{}
+					err := getInfo.Unmarshal(msg.Payload)
+					s.Require().NoError(err)
+
+					s.Equal(*requestRef.GetLocal(), getInfo.RequestID)
+					s.Equal(*objectRef.GetLocal(), getInfo.ObjectID)
+					s.Equal(pulseObject.PulseNumber, getInfo.Pulse)
+					s.Equal(objectRef, ref)
+
+					resMsg, err := payload.NewMessage(test.response)
+					s.Require().NoError(err)
+
+					meta := payload.Meta{Payload: resMsg.Payload}
+					buf, err := meta.Marshal()
+					s.Require().NoError(err)
+					resMsg.Payload = buf
+
+					ch := make(chan *wmMessage.Message, 1)
+					ch <- resMsg
+					return ch, func() { close(ch) }
+				},
+			)
+
+			// Act
+			got, err := s.amClient.GetResult(s.ctx, objectRef, requestRef)
+			// Check
+			test.check(got, err)
+		})
+	}
+}
+
+func (s *ArtifactsMangerClientSuite) TestGetState() {
+	// Arrange
+	objectRef := gen.Reference()
+	stateID := gen.ID()
+	state := record.Material{
+		Virtual: record.Wrap(&record.Amend{
+			Memory: []byte{1, 2, 3},
+		}),
+		ID:       stateID,
+		ObjectID: *objectRef.GetLocal(),
+	}
+	stateBuf, err := state.Marshal()
+	s.Require().NoError(err)
+
+	for name, test := range map[string]struct {
+		response payload.Payload
+		check    func(*record.Material, error)
+	}{
+		"success": {
+			response: &payload.State{
+				Record: stateBuf,
+			},
+			check: func(got *record.Material, err error) {
+				s.NoError(err)
+				s.Equal(state, *got)
+			},
+		},
+		"not found": {
+			response: &payload.Error{
+				Text: "state not found",
+				Code: payload.CodeNotFound,
+			},
+			check: func(_ *record.Material, err error) {
+				s.Equal(insolar.ErrNotFound, err)
+			},
+		},
+		"not a state": {
+			response: &payload.State{
+				Record: s.packMaterialRecord(&record.Result{}),
+			},
+			check: func(_ *record.Material, err error) {
+				s.Contains(err.Error(), "unexpected record")
+			},
+		},
+		"unknown payload": {
+			response: &payload.PendingFinished{},
+			check: func(_ *record.Material, err error) {
+				s.Contains(err.Error(), "unexpected reply")
+			},
+		},
+	} {
+		s.Run(name, func() {
+			s.prepareContext()
+
+			s.busSender.SendRoleMock.Set(
+				func(
+					_ context.Context,
+					msg *wmMessage.Message,
+					role insolar.DynamicRole,
+					ref insolar.Reference,
+				) (
+					<-chan *wmMessage.Message,
+					func(),
+				) {
+					s.Equal(insolar.DynamicRoleLightExecutor, role)
+
+					getState := payload.GetState{}
+					err := getState.Unmarshal(msg.Payload)
+					s.Require().NoError(err)
+
+					s.Equal(stateID, getState.StateID)
+					s.Equal(*objectRef.GetLocal(), getState.ObjectID)
+					s.Equal(objectRef, ref)
+
+					resMsg, err := payload.NewMessage(test.response)
+					s.Require().NoError(err)
+
+					meta := payload.Meta{Payload: resMsg.Payload}
+					buf, err := meta.Marshal()
+					s.Require().NoError(err)
+					resMsg.Payload = buf
+
+					ch := make(chan *wmMessage.Message, 1)
+					ch <- resMsg
+					return ch, func() { close(ch) }
+				},
+			)
+
+			// Act
+			got, err := s.amClient.GetState(s.ctx, objectRef, stateID)
+			// Check
+			test.check(got, err)
+		})
+	}
+}
+
+func (s *ArtifactsMangerClientSuite) TestGetEvent() {
+	// Arrange
+	objectRef := gen.Reference()
+	eventID := gen.ID()
+	event := record.Material{
+		Virtual: record.Wrap(&record.Event{
+			Object:  *objectRef.GetLocal(),
+			Topic:   "transfer",
+			Payload: []byte{1, 2, 3},
+		}),
+		ID:       eventID,
+		ObjectID: *objectRef.GetLocal(),
+	}
+	eventBuf, err := event.Marshal()
+	s.Require().NoError(err)
+
+	for name, test := range map[string]struct {
+		response payload.Payload
+		check    func(*record.Material, error)
+	}{
+		"success": {
+			response: &payload.State{
+				Record: eventBuf,
+			},
+			check: func(got *record.Material, err error) {
+				s.NoError(err)
+				s.Equal(event, *got)
+			},
+		},
+		"not found": {
+			response: &payload.Error{
+				Text: "state not found",
+				Code: payload.CodeNotFound,
+			},
+			check: func(_ *record.Material, err error) {
+				s.Equal(insolar.ErrNotFound, err)
+			},
+		},
+		"not an event": {
+			response: &payload.State{
+				Record: s.packMaterialRecord(&record.Amend{}),
+			},
+			check: func(_ *record.Material, err error) {
+				s.Contains(err.Error(), "unexpected record")
+			},
+		},
+	} {
+		s.Run(name, func() {
+			s.prepareContext()
+
+			s.busSender.SendRoleMock.Set(
+				func(
+					_ context.Context,
+					msg *wmMessage.Message,
+					role insolar.DynamicRole,
+					ref insolar.Reference,
+				) (
+					<-chan *wmMessage.Message,
+					func(),
+				) {
+					s.Equal(insolar.DynamicRoleLightExecutor, role)
+
+					getState := payload.GetState{}
+					err := getState.Unmarshal(msg.Payload)
+					s.Require().NoError(err)
+
+					s.Equal(eventID, getState.StateID)
+					s.Equal(*objectRef.GetLocal(), getState.ObjectID)
+
+					resMsg, err := payload.NewMessage(test.response)
+					s.Require().NoError(err)
+
+					meta := payload.Meta{Payload: resMsg.Payload}
+					buf, err := meta.Marshal()
+					s.Require().NoError(err)
+					resMsg.Payload = buf
+
+					ch := make(chan *wmMessage.Message, 1)
+					ch <- resMsg
+					return ch, func() { close(ch) }
+				},
+			)
+
+			// Act
+			got, err := s.amClient.GetEvent(s.ctx, objectRef, eventID)
+			// Check
+			test.check(got, err)
+		})
+	}
+}
+
 func (s *ArtifactsMangerClientSuite) TestGetPendings() {
 	// Arrange
 	objectRef := gen.Reference()
@@ -1024,6 +1322,46 @@ func (s *ArtifactsMangerClientSuite) TestRegisterResult() {
 			check: func(err error) { s.NoError(err) },
 		},
 
+		"success with events": {
+			response: &payload.ResultInfo{
+				ObjectID: objectID,
+				ResultID: resultID,
+			},
+			result: &TestRequestResult{
+				SideEffectType:     RequestSideEffectNone,
+				RawResult:          resultBytes,
+				RawObjectReference: *insolar.NewReference(objectID),
+				EmittedEvents: []ContractEvent{
+					{Prototype: imageRef, Topic: "created", Payload: memoryBytes},
+					{Prototype: imageRef, Topic: "created", Payload: memoryBytes},
+				},
+			},
+			internalCheck: func(msg *wmMessage.Message) {
+				payloadSetResult := payload.SetResult{}
+				unmarshalError := payloadSetResult.Unmarshal(msg.Payload)
+				s.Require().NoError(unmarshalError)
+				s.Require().Len(payloadSetResult.Events, 2)
+
+				for i, buf := range payloadSetResult.Events {
+					virtualRec := &record.Virtual{}
+					unmarshalError = virtualRec.Unmarshal(buf)
+					s.Require().NoError(unmarshalError)
+
+					rec := record.Unwrap(virtualRec)
+					s.Require().IsType((*record.Event)(nil), rec)
+
+					eventRecord := rec.(*record.Event)
+					s.Equal(objectID, eventRecord.Object)
+					s.Equal(*requestRef, eventRecord.Request)
+					s.Equal(imageRef, eventRecord.Prototype)
+					s.Equal("created", eventRecord.Topic)
+					s.Equal(memoryBytes, eventRecord.Payload)
+					s.Equal(uint32(i), eventRecord.Index)
+				}
+			},
+			check: func(err error) { s.NoError(err) },
+		},
+
 		"success activate": {
 			response: &payload.ResultInfo{
 				ObjectID: objectID,
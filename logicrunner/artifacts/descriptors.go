@@ -41,6 +41,19 @@ func (d *codeDescriptor) Code() ([]byte, error) {
 	return d.code, nil
 }
 
+// NewObjectDescriptor creates descriptor of the object state, e.g. of a state fetched by GetState.
+func NewObjectDescriptor(
+	head insolar.Reference, state insolar.ID, prototype *insolar.Reference, memory []byte, parent insolar.Reference,
+) ObjectDescriptor {
+	return &objectDescriptor{
+		head:      head,
+		state:     state,
+		prototype: prototype,
+		memory:    memory,
+		parent:    parent,
+	}
+}
+
 // ObjectDescriptor represents meta info required to fetch all object data.
 type objectDescriptor struct {
 	head      insolar.Reference
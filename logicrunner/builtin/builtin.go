@@ -29,6 +29,7 @@ type LogicRunnerRPCStub interface {
 	RouteCall(rpctypes.UpRouteReq, *rpctypes.UpRouteResp) error
 	SaveAsChild(rpctypes.UpSaveAsChildReq, *rpctypes.UpSaveAsChildResp) error
 	DeactivateObject(rpctypes.UpDeactivateObjectReq, *rpctypes.UpDeactivateObjectResp) error
+	EmitEvent(rpctypes.UpEmitEventReq, *rpctypes.UpEmitEventResp) error
 }
 
 // BuiltIn is a contract runner engine
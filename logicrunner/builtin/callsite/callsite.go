@@ -0,0 +1,131 @@
+// Copyright 2020 Insolar Network Ltd.
+// All rights reserved.
+// This material is licensed under the Insolar License version 1.0,
+// available at https://github.com/insolar/insolar/blob/master/LICENSE.md.
+
+// Package callsite contains a registry of constructors and methods of builtin contracts.
+// The registry is generated by `insgocc regen-builtin` and is used to dispatch API calls
+// with `<contract>.<method>` call sites. Call sites marked with `ins:public` in the source
+// of the contracts are public.
+package callsite
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/insolar/insolar/insolar"
+)
+
+// Argument types used in argument schemas.
+const (
+	TypeString    = "string"
+	TypeBool      = "bool"
+	TypeInt       = "int"
+	TypeUint      = "uint"
+	TypeFloat     = "float"
+	TypeReference = "reference"
+	TypeBytes     = "bytes"
+)
+
+// ReferenceParam is a name of the call param with the reference of the called object.
+const ReferenceParam = "reference"
+
+// Arg describes an argument of a call site.
+type Arg struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// CallFunc calls a constructor or a method with params from the API request.
+// Constructors save a new object as a child of the caller.
+type CallFunc func(caller insolar.Reference, params map[string]interface{}) (interface{}, error)
+
+// CallSite describes a constructor or method of a contract.
+type CallSite struct {
+	Contract    string `json:"contract"`
+	Method      string `json:"method"`
+	Constructor bool   `json:"constructor"`
+	Immutable   bool   `json:"immutable"`
+	Public      bool   `json:"public"`
+	Args        []Arg  `json:"args"`
+
+	Call CallFunc `json:"-"`
+}
+
+// Name returns `<contract>.<method>` name of the call site.
+func (cs CallSite) Name() string {
+	return cs.Contract + "." + cs.Method
+}
+
+// Registry holds call sites by their names.
+type Registry struct {
+	sites     map[string]CallSite
+	contracts map[string]struct{}
+	allowed   map[string]struct{}
+}
+
+// NewRegistry creates a registry with provided call sites. All call sites are allowed.
+func NewRegistry(sites ...CallSite) *Registry {
+	r := &Registry{
+		sites:     make(map[string]CallSite, len(sites)),
+		contracts: map[string]struct{}{},
+	}
+	for _, cs := range sites {
+		r.sites[cs.Name()] = cs
+		r.contracts[cs.Contract] = struct{}{}
+	}
+	return r
+}
+
+// Public returns sorted names of public call sites.
+func (r *Registry) Public() []string {
+	var res []string
+	for name, cs := range r.sites {
+		if cs.Public {
+			res = append(res, name)
+		}
+	}
+	sort.Strings(res)
+	return res
+}
+
+// Restrict returns a copy of the registry, that allows only provided call sites.
+func (r *Registry) Restrict(names ...string) *Registry {
+	res := &Registry{
+		sites:     r.sites,
+		contracts: r.contracts,
+		allowed:   make(map[string]struct{}, len(names)),
+	}
+	for _, name := range names {
+		res.allowed[name] = struct{}{}
+	}
+	return res
+}
+
+// Lookup finds an allowed call site by its name.
+func (r *Registry) Lookup(name string) (CallSite, error) {
+	cs, ok := r.sites[name]
+	if !ok {
+		contract := strings.SplitN(name, ".", 2)[0]
+		if _, ok := r.contracts[contract]; !ok {
+			return CallSite{}, &Error{Code: ErrorUnknownContract, CallSite: name, Message: fmt.Sprintf("unknown contract '%s'", contract)}
+		}
+		return CallSite{}, &Error{Code: ErrorUnknownMethod, CallSite: name, Message: fmt.Sprintf("unknown method '%s'", name)}
+	}
+	if r.allowed != nil {
+		if _, ok := r.allowed[name]; !ok {
+			return CallSite{}, &Error{Code: ErrorNotAllowed, CallSite: name, Message: "call site is not allowed"}
+		}
+	}
+	return cs, nil
+}
+
+// Call finds the call site and calls it.
+func (r *Registry) Call(name string, caller insolar.Reference, params map[string]interface{}) (interface{}, error) {
+	cs, err := r.Lookup(name)
+	if err != nil {
+		return nil, err
+	}
+	return cs.Call(caller, params)
+}
@@ -0,0 +1,131 @@
+// Copyright 2020 Insolar Network Ltd.
+// All rights reserved.
+// This material is licensed under the Insolar License version 1.0,
+// available at https://github.com/insolar/insolar/blob/master/LICENSE.md.
+
+package callsite
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/gen"
+)
+
+func testRegistry() *Registry {
+	echo := func(caller insolar.Reference, params map[string]interface{}) (interface{}, error) {
+		return String(params, "s")
+	}
+	self := func(caller insolar.Reference, params map[string]interface{}) (interface{}, error) {
+		return caller.String(), nil
+	}
+	return NewRegistry(
+		CallSite{Contract: "first", Method: "Echo", Public: true, Args: []Arg{{Name: "s", Type: TypeString}}, Call: echo},
+		CallSite{Contract: "first", Method: "New", Constructor: true, Public: true, Call: self},
+		CallSite{Contract: "second", Method: "New", Constructor: true, Call: self},
+	)
+}
+
+func TestRegistry_Call(t *testing.T) {
+	r := testRegistry()
+	caller := gen.Reference()
+
+	res, err := r.Call("first.Echo", caller, map[string]interface{}{"s": "hello"})
+	require.NoError(t, err)
+	require.Equal(t, "hello", res)
+
+	res, err = r.Call("second.New", caller, map[string]interface{}{})
+	require.NoError(t, err)
+	require.Equal(t, caller.String(), res)
+
+	_, err = r.Call("first.Echo", caller, map[string]interface{}{})
+	require.EqualError(t, err, "failed to get 's' param, <nil>")
+}
+
+func TestRegistry_Lookup_Errors(t *testing.T) {
+	r := testRegistry()
+
+	_, err := r.Lookup("unknown.New")
+	require.IsType(t, &Error{}, err)
+	require.Equal(t, ErrorUnknownContract, err.(*Error).Code)
+
+	_, err = r.Lookup("first.Unknown")
+	require.IsType(t, &Error{}, err)
+	require.Equal(t, ErrorUnknownMethod, err.(*Error).Code)
+	require.Contains(t, err.Error(), "unknown method 'first.Unknown'")
+
+	restricted := r.Restrict("first.Echo")
+	_, err = restricted.Lookup("first.New")
+	require.IsType(t, &Error{}, err)
+	require.Equal(t, ErrorNotAllowed, err.(*Error).Code)
+
+	_, err = restricted.Lookup("first.Echo")
+	require.NoError(t, err)
+
+	_, err = r.Restrict().Lookup("first.Echo")
+	require.IsType(t, &Error{}, err)
+	require.Equal(t, ErrorNotAllowed, err.(*Error).Code)
+}
+
+func TestRegistry_Public(t *testing.T) {
+	r := testRegistry()
+	require.Equal(t, []string{"first.Echo", "first.New"}, r.Public())
+}
+
+func TestParams(t *testing.T) {
+	ref := gen.Reference()
+	params := map[string]interface{}{
+		"float":    float64(42),
+		"fraction": 4.2,
+		"number":   json.Number("-7"),
+		"string":   "15",
+		"negative": float64(-1),
+		"bool":     true,
+		"ref":      ref.String(),
+		"bytes":    "AQID",
+	}
+
+	i, err := Int(params, "float")
+	require.NoError(t, err)
+	require.Equal(t, int64(42), i)
+
+	_, err = Int(params, "fraction")
+	require.Error(t, err)
+
+	i, err = Int(params, "number")
+	require.NoError(t, err)
+	require.Equal(t, int64(-7), i)
+
+	i, err = Int(params, "string")
+	require.NoError(t, err)
+	require.Equal(t, int64(15), i)
+
+	u, err := Uint(params, "string")
+	require.NoError(t, err)
+	require.Equal(t, uint64(15), u)
+
+	_, err = Uint(params, "negative")
+	require.Error(t, err)
+
+	f, err := Float(params, "fraction")
+	require.NoError(t, err)
+	require.Equal(t, 4.2, f)
+
+	b, err := Bool(params, "bool")
+	require.NoError(t, err)
+	require.True(t, b)
+
+	r, err := Reference(params, "ref")
+	require.NoError(t, err)
+	require.Equal(t, ref, *r)
+
+	bs, err := Bytes(params, "bytes")
+	require.NoError(t, err)
+	require.Equal(t, []byte{1, 2, 3}, bs)
+
+	_, err = String(params, "missing")
+	require.EqualError(t, err, "failed to get 'missing' param, <nil>")
+}
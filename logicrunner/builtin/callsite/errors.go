@@ -0,0 +1,28 @@
+// Copyright 2020 Insolar Network Ltd.
+// All rights reserved.
+// This material is licensed under the Insolar License version 1.0,
+// available at https://github.com/insolar/insolar/blob/master/LICENSE.md.
+
+package callsite
+
+import (
+	"fmt"
+)
+
+// Codes of dispatching errors.
+const (
+	ErrorUnknownContract = "UnknownContract"
+	ErrorUnknownMethod   = "UnknownMethod"
+	ErrorNotAllowed      = "NotAllowed"
+)
+
+// Error is returned when a call site can't be dispatched.
+type Error struct {
+	Code     string `json:"code"`
+	CallSite string `json:"callSite"`
+	Message  string `json:"message"`
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("[%s] call site '%s': %s", e.Code, e.CallSite, e.Message)
+}
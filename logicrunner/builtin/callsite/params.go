@@ -0,0 +1,125 @@
+// Copyright 2020 Insolar Network Ltd.
+// All rights reserved.
+// This material is licensed under the Insolar License version 1.0,
+// available at https://github.com/insolar/insolar/blob/master/LICENSE.md.
+
+package callsite
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/insolar/insolar/insolar"
+)
+
+// Functions below extract typed arguments from decoded JSON params of the API request.
+
+func String(params map[string]interface{}, name string) (string, error) {
+	v, ok := params[name].(string)
+	if !ok {
+		return "", fmt.Errorf("failed to get '%s' param, %T", name, params[name])
+	}
+	return v, nil
+}
+
+func Bool(params map[string]interface{}, name string) (bool, error) {
+	v, ok := params[name].(bool)
+	if !ok {
+		return false, fmt.Errorf("failed to get '%s' param, %T", name, params[name])
+	}
+	return v, nil
+}
+
+// Int accepts JSON numbers and strings with integer numbers.
+func Int(params map[string]interface{}, name string) (int64, error) {
+	switch v := params[name].(type) {
+	case float64:
+		if v != float64(int64(v)) {
+			return 0, fmt.Errorf("failed to parse '%s': %v is not an integer", name, v)
+		}
+		return int64(v), nil
+	case json.Number:
+		res, err := v.Int64()
+		if err != nil {
+			return 0, fmt.Errorf("failed to parse '%s': %s", name, err.Error())
+		}
+		return res, nil
+	case string:
+		res, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("failed to parse '%s': %s", name, err.Error())
+		}
+		return res, nil
+	default:
+		return 0, fmt.Errorf("failed to get '%s' param, %T", name, params[name])
+	}
+}
+
+// Uint accepts JSON numbers and strings with non-negative integer numbers.
+func Uint(params map[string]interface{}, name string) (uint64, error) {
+	if s, ok := params[name].(string); ok {
+		res, err := strconv.ParseUint(s, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("failed to parse '%s': %s", name, err.Error())
+		}
+		return res, nil
+	}
+	v, err := Int(params, name)
+	if err != nil {
+		return 0, err
+	}
+	if v < 0 {
+		return 0, fmt.Errorf("failed to parse '%s': %d is negative", name, v)
+	}
+	return uint64(v), nil
+}
+
+// Float accepts JSON numbers and strings with numbers.
+func Float(params map[string]interface{}, name string) (float64, error) {
+	switch v := params[name].(type) {
+	case float64:
+		return v, nil
+	case json.Number:
+		res, err := v.Float64()
+		if err != nil {
+			return 0, fmt.Errorf("failed to parse '%s': %s", name, err.Error())
+		}
+		return res, nil
+	case string:
+		res, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return 0, fmt.Errorf("failed to parse '%s': %s", name, err.Error())
+		}
+		return res, nil
+	default:
+		return 0, fmt.Errorf("failed to get '%s' param, %T", name, params[name])
+	}
+}
+
+// Reference accepts object references in a string form.
+func Reference(params map[string]interface{}, name string) (*insolar.Reference, error) {
+	s, err := String(params, name)
+	if err != nil {
+		return nil, err
+	}
+	ref, err := insolar.NewObjectReferenceFromString(s)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse '%s': %s", name, err.Error())
+	}
+	return ref, nil
+}
+
+// Bytes accepts base64 encoded strings.
+func Bytes(params map[string]interface{}, name string) ([]byte, error) {
+	s, err := String(params, name)
+	if err != nil {
+		return nil, err
+	}
+	res, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse '%s': %s", name, err.Error())
+	}
+	return res, nil
+}
@@ -6,6 +6,7 @@
 package foundation
 
 import (
+	stdx509 "crypto/x509"
 	"encoding/asn1"
 	"encoding/base64"
 	"encoding/pem"
@@ -17,6 +18,7 @@ import (
 	"github.com/insolar/x-crypto/ecdsa"
 	"github.com/insolar/x-crypto/sha256"
 	"github.com/insolar/x-crypto/x509"
+	"golang.org/x/crypto/ed25519"
 )
 
 // UnmarshalSig parses the two integer components of an ASN.1-encoded ECDSA signature.
@@ -59,6 +61,13 @@ func VerifySignature(rawRequest []byte, signature string, key string, rawpublicp
 		return fmt.Errorf("problems with decoding. Key - %v", rawpublicpem)
 	}
 	x509EncodedPub := blockPub.Bytes
+	if ed25519Pk, ok := parseEd25519PublicKey(x509EncodedPub); ok {
+		// Ed25519 signs request itself, not its hash
+		if !ed25519.Verify(ed25519Pk, rawRequest, sig) {
+			return fmt.Errorf("invalid signature")
+		}
+		return nil
+	}
 	publicKey, err := x509.ParsePKIXPublicKey(x509EncodedPub)
 	if err != nil {
 		return fmt.Errorf("problems with parsing. Key - %v", rawpublicpem)
@@ -77,6 +86,17 @@ func VerifySignature(rawRequest []byte, signature string, key string, rawpublicp
 	return nil
 }
 
+// parseEd25519PublicKey parses DER encoded public key, if it is Ed25519 key.
+// x-crypto doesn't know Ed25519, so standard library is used.
+func parseEd25519PublicKey(der []byte) (ed25519.PublicKey, bool) {
+	publicKey, err := stdx509.ParsePKIXPublicKey(der)
+	if err != nil {
+		return nil, false
+	}
+	ed25519Pk, ok := publicKey.(ed25519.PublicKey)
+	return ed25519Pk, ok
+}
+
 // GetShardIndex calculates hash from string and gets it by mod
 func GetShardIndex(s string, mod int) int {
 	x := hash(s)
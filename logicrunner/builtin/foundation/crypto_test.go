@@ -0,0 +1,34 @@
+// Copyright 2020 Insolar Network Ltd.
+// All rights reserved.
+// This material is licensed under the Insolar License version 1.0,
+// available at https://github.com/insolar/insolar/blob/master/LICENSE.md.
+
+package foundation
+
+import (
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ed25519"
+)
+
+func TestVerifySignature_Ed25519(t *testing.T) {
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	x509EncodedPub, err := x509.MarshalPKIXPublicKey(publicKey)
+	require.NoError(t, err)
+	publicPEM := string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: x509EncodedPub}))
+
+	rawRequest := []byte(`{"jsonrpc":"2.0","method":"contract.call"}`)
+	signature := base64.StdEncoding.EncodeToString(ed25519.Sign(privateKey, rawRequest))
+
+	err = VerifySignature(rawRequest, signature, publicPEM, publicPEM, false)
+	require.NoError(t, err)
+
+	err = VerifySignature([]byte(`{}`), signature, publicPEM, publicPEM, false)
+	require.EqualError(t, err, "invalid signature")
+}
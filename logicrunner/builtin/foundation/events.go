@@ -0,0 +1,28 @@
+// Copyright 2020 Insolar Network Ltd.
+// All rights reserved.
+// This material is licensed under the Insolar License version 1.0,
+// available at https://github.com/insolar/insolar/blob/master/LICENSE.md.
+
+package foundation
+
+import (
+	"errors"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/logicrunner/common"
+)
+
+// Emit emits event of the contract. Payload is serialized the same way as results of contract methods.
+// Event is saved on ledger together with the result of the current request, so it's lost if the
+// request fails. Events can be read from heavy exporter filtered by topic and prototype of the contract.
+func Emit(topic string, payload interface{}) error {
+	if topic == "" {
+		return errors.New("event topic is empty")
+	}
+
+	buf, err := insolar.Serialize(payload)
+	if err != nil {
+		return err
+	}
+	return common.CurrentProxyCtx.EmitEvent(topic, buf)
+}
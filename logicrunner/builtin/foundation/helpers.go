@@ -65,6 +65,10 @@ func ExtractCanonicalPublicKey(pk string) (string, error) {
 
 	pkDecoded, err := x509.ParsePKIXPublicKey(pkASN1.Bytes)
 	if err != nil {
+		// Ed25519 keys have no curve point, their raw bytes are canonical
+		if ed25519Pk, ok := parseEd25519PublicKey(pkASN1.Bytes); ok {
+			return base64.RawURLEncoding.EncodeToString(ed25519Pk), nil
+		}
 		// This is compressed key perhaps
 		if err.Error() == "x509: failed to unmarshal elliptic curve point" && pkASN1.Type == "PUBLIC KEY" && len(pkASN1.Bytes) <= 56 {
 			return extractCanonicalPublicKeyFromCompressed(pkASN1)
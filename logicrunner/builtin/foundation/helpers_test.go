@@ -27,6 +27,12 @@ func TestExtractCanonicalPublicKey(t *testing.T) {
 			want:    "A8A3IFmdUmxvp24l2ZJmFGRH5Np5JNTxJhFqI-IOgyxJ",
 			wantErr: false,
 		},
+		{
+			name:    "ed25519",
+			args:    args{pk: "-----BEGIN PUBLIC KEY-----\nMCowBQYDK2VwAyEAA6EHv/POEL4dcN0Y50vAmWfk1jCbpQ1fHdyGZBJVMbg=\n-----END PUBLIC KEY-----\n"},
+			want:    "A6EHv_POEL4dcN0Y50vAmWfk1jCbpQ1fHdyGZBJVMbg",
+			wantErr: false,
+		},
 		{
 			name:    "wrong pk",
 			args:    args{pk: "-----BEGIN PUBLIC KEY-----\nasdnjkDFHaldfjl==\n-----END PUBLIC KEY-----\n"},
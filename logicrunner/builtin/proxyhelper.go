@@ -122,6 +122,26 @@ func (h *ProxyHelper) DeactivateObject(object insolar.Reference) error {
 	return nil
 }
 
+func (h *ProxyHelper) EmitEvent(topic string, payload []byte) error {
+	if h.GetSystemError() != nil {
+		return h.GetSystemError()
+	}
+
+	res := rpctypes.UpEmitEventResp{}
+	req := rpctypes.UpEmitEventReq{
+		UpBaseReq: h.getUpBaseReq(),
+
+		Topic:   topic,
+		Payload: payload,
+	}
+
+	if err := h.methods.EmitEvent(req, &res); err != nil {
+		h.SetSystemError(err)
+		return err
+	}
+	return nil
+}
+
 /*
 func (h *ProxyHelper) Serialize(what interface{}, to *[]byte) error {
 	panic("implement me")
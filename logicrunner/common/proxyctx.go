@@ -22,6 +22,7 @@ type ProxyHelper interface {
 		parentRef, classRef insolar.Reference, constructorName string, argsSerialized []byte,
 	) (result []byte, err error)
 	DeactivateObject(object insolar.Reference) error
+	EmitEvent(topic string, payload []byte) error
 	MakeErrorSerializable(error) error
 }
 
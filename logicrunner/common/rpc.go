@@ -14,4 +14,5 @@ type LogicRunnerRPCStub interface {
 	RouteCall(rpctypes.UpRouteReq, *rpctypes.UpRouteResp) error
 	SaveAsChild(rpctypes.UpSaveAsChildReq, *rpctypes.UpSaveAsChildResp) error
 	DeactivateObject(rpctypes.UpDeactivateObjectReq, *rpctypes.UpDeactivateObjectResp) error
+	EmitEvent(rpctypes.UpEmitEventReq, *rpctypes.UpEmitEventResp) error
 }
@@ -27,6 +27,13 @@ type Transcript struct {
 	Nonce            uint64
 	Deactivate       bool
 	OutgoingRequests []OutgoingRequest
+
+	// Mode is ExecuteCallMode for regular execution and ValidateCallMode when validator re-executes the request.
+	Mode insolar.CallMode
+	// OutgoingRequestRefs are references of outgoing requests registered during execution, in order of registration.
+	OutgoingRequestRefs []insolar.Reference
+	// Events are emitted by the contract during execution, they are registered together with the result.
+	Events []artifacts.ContractEvent
 }
 
 func NewTranscript(
@@ -41,6 +41,7 @@ type Dependencies struct {
 	OutgoingSender   OutgoingRequestSender
 	RequestsExecutor RequestsExecutor
 	PulseAccessor    pulse.Accessor
+	Validator        Validator
 }
 
 type Init struct {
@@ -190,6 +191,12 @@ func (s *Init) Present(ctx context.Context, f flow.Flow) error {
 			Message: originMeta,
 		}
 		err = f.Handle(ctx, h.Present)
+	case payload.TypeValidateRequest:
+		h := &HandleValidateRequest{
+			dep:     s.dep,
+			Message: originMeta,
+		}
+		err = f.Handle(ctx, h.Present)
 	default:
 		stats.Record(ctx, metrics.HandleUnknownMessageType.M(1))
 		err = errors.Errorf("[ Init.Present ] no handler for message type %s", payloadType)
@@ -0,0 +1,67 @@
+// Copyright 2020 Insolar Network Ltd.
+// All rights reserved.
+// This material is licensed under the Insolar License version 1.0,
+// available at https://github.com/insolar/insolar/blob/master/LICENSE.md.
+
+package logicrunner
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/flow"
+	"github.com/insolar/insolar/insolar/payload"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+)
+
+func checkPayloadValidateRequest(msg payload.ValidateRequest) error {
+	if !msg.ObjectRef.IsObjectReference() {
+		return errors.Errorf("ValidateRequest.ObjectRef should be ObjectReference; ref=%s", msg.ObjectRef.String())
+	}
+	if !msg.RequestRef.IsRecordScope() {
+		return errors.Errorf("ValidateRequest.RequestRef should be RecordReference; ref=%s", msg.RequestRef.String())
+	}
+	for _, reqRef := range msg.OutgoingRequestRefs {
+		if !reqRef.IsRecordScope() {
+			return errors.Errorf("ValidateRequest.OutgoingRequestRefs should have only RecordReferences; ref=%s", reqRef.String())
+		}
+	}
+
+	return nil
+}
+
+// HandleValidateRequest re-executes request of executor on validator node.
+type HandleValidateRequest struct {
+	dep *Dependencies
+
+	Message payload.Meta
+}
+
+func (h *HandleValidateRequest) Present(ctx context.Context, f flow.Flow) error {
+	message := payload.ValidateRequest{}
+	err := message.Unmarshal(h.Message.Payload)
+	if err != nil {
+		return errors.Wrap(err, "failed to unmarshal message")
+	}
+
+	ctx, logger := inslogger.WithFields(ctx, map[string]interface{}{
+		"object":   message.ObjectRef.String(),
+		"request":  message.RequestRef.String(),
+		"executor": h.Message.Sender.String(),
+	})
+	logger.Debug("handle ValidateRequest message")
+
+	if err := checkPayloadValidateRequest(message); err != nil {
+		logger.Warn("incorrect ValidateRequest message, ignoring: ", err.Error())
+		return nil
+	}
+
+	res := h.dep.Validator.Validate(ctx, h.Message.Sender, message)
+	if res.Status == insolar.ValidationValid {
+		logger.Debug("request is validated")
+	}
+
+	return nil
+}
@@ -0,0 +1,91 @@
+// Copyright 2020 Insolar Network Ltd.
+// All rights reserved.
+// This material is licensed under the Insolar License version 1.0,
+// available at https://github.com/insolar/insolar/blob/master/LICENSE.md.
+
+package logicrunner
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/flow"
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/insolar/payload"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/testutils"
+)
+
+func TestHandleValidateRequest_Present(t *testing.T) {
+	defer testutils.LeakTester(t)
+
+	tests := []struct {
+		name  string
+		mocks func(t minimock.Tester) (*HandleValidateRequest, flow.Flow)
+	}{
+		{
+			name: "success",
+			mocks: func(t minimock.Tester) (*HandleValidateRequest, flow.Flow) {
+				executor := gen.Reference()
+				receivedPayload := &payload.ValidateRequest{
+					ObjectRef:  gen.Reference(),
+					RequestRef: gen.RecordReference(),
+				}
+
+				buf, err := payload.Marshal(receivedPayload)
+				require.NoError(t, err, "marshal")
+
+				validator := NewValidatorMock(t)
+				validator.ValidateMock.Set(func(
+					_ context.Context, e insolar.Reference, msg payload.ValidateRequest,
+				) insolar.ValidationResult {
+					require.Equal(t, executor, e)
+					require.Equal(t, *receivedPayload, msg)
+					return insolar.ValidationResult{Status: insolar.ValidationValid}
+				})
+
+				h := &HandleValidateRequest{
+					dep:     &Dependencies{Validator: validator},
+					Message: payload.Meta{Payload: buf, Sender: executor},
+				}
+				return h, flow.NewFlowMock(t)
+			},
+		},
+		{
+			name: "incorrect request reference",
+			mocks: func(t minimock.Tester) (*HandleValidateRequest, flow.Flow) {
+				receivedPayload := &payload.ValidateRequest{
+					ObjectRef:  gen.Reference(),
+					RequestRef: gen.Reference(),
+				}
+
+				buf, err := payload.Marshal(receivedPayload)
+				require.NoError(t, err, "marshal")
+
+				h := &HandleValidateRequest{
+					dep:     &Dependencies{Validator: NewValidatorMock(t)},
+					Message: payload.Meta{Payload: buf},
+				}
+				return h, flow.NewFlowMock(t)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := flow.TestContextWithPulse(inslogger.TestContext(t), gen.PulseNumber())
+			mc := minimock.NewController(t)
+
+			h, f := test.mocks(mc)
+			err := h.Present(ctx, f)
+			require.NoError(t, err)
+
+			mc.Wait(1 * time.Minute)
+			mc.Finish()
+		})
+	}
+}
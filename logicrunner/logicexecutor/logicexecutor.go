@@ -114,6 +114,7 @@ func (le *logicExecutor) ExecuteMethod(ctx context.Context, transcript *common.T
 	}
 
 	res := requestresult.New(result, *objDesc.HeadRef())
+	res.SetEvents(transcript.Events)
 
 	if request.Immutable {
 		return res, nil
@@ -171,6 +172,7 @@ func (le *logicExecutor) ExecuteConstructor(
 	}
 
 	res := requestresult.New(result, *transcript.Request.Object)
+	res.SetEvents(transcript.Events)
 	if newData != nil {
 		res.SetActivate(*request.Base, *request.Prototype, newData)
 	}
@@ -192,7 +194,7 @@ func (le *logicExecutor) genLogicCallContext(
 	}
 
 	res := &insolar.LogicCallContext{
-		Mode: insolar.ExecuteCallMode,
+		Mode: transcript.Mode,
 
 		Request: &reqRef,
 
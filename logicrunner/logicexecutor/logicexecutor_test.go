@@ -114,6 +114,8 @@ func TestLogicExecutor_ExecuteMethod(t *testing.T) {
 						Prototype: &protoRef,
 					},
 					RequestRef: *insolar.NewReference(*insolar.NewID(insolar.PulseNumber(123), nil)),
+					// events emitted by contract are registered with the result
+					Events: []artifacts.ContractEvent{{Prototype: protoRef, Topic: "topic"}},
 				}
 				mm := machinesmanager.NewMachinesManagerMock(mc).
 					GetExecutorMock.
@@ -143,6 +145,7 @@ func TestLogicExecutor_ExecuteMethod(t *testing.T) {
 				SideEffectType:     artifacts.RequestSideEffectNone,
 				RawResult:          []byte{3, 2, 1},
 				RawObjectReference: objRef,
+				EmittedEvents:      []artifacts.ContractEvent{{Prototype: protoRef, Topic: "topic"}},
 			},
 		},
 		{
@@ -57,6 +57,8 @@ type LogicRunner struct {
 	WriteController            writecontroller.WriteController
 	FlowDispatcher             dispatcher.Dispatcher
 	ShutdownFlag               shutdown.Flag
+	Validator                  Validator
+	MisbehaviorReporter        MisbehaviorReporter
 
 	Cfg *configuration.LogicRunner
 
@@ -88,9 +90,24 @@ func (lr *LogicRunner) Init(ctx context.Context) error {
 	as := system.New()
 	lr.OutgoingSender = NewOutgoingRequestSender(as, lr.ContractRequester, lr.ArtifactManager, lr.PulseAccessor)
 
+	lr.Validator = newValidator(
+		lr.ArtifactManager,
+		lr.RequestsExecutor,
+		lr.Sender,
+		lr.JetCoordinator,
+		lr.PulseAccessor,
+		lr.PlatformCryptographyScheme,
+		lr.MisbehaviorReporter,
+		lr.Cfg.ValidationJournalSize,
+	)
+	requestsExecutor := lr.RequestsExecutor
+	if lr.Cfg.ValidateRequests {
+		requestsExecutor = newValidatingRequestsExecutor(lr.RequestsExecutor, lr.Validator)
+	}
+
 	lr.StateStorage = NewStateStorage(
 		lr.Publisher,
-		lr.RequestsExecutor,
+		requestsExecutor,
 		lr.Sender,
 		lr.JetCoordinator,
 		lr.PulseAccessor,
@@ -124,6 +141,7 @@ func (lr *LogicRunner) initHandlers() {
 		OutgoingSender:   lr.OutgoingSender,
 		RequestsExecutor: lr.RequestsExecutor,
 		PulseAccessor:    lr.PulseAccessor,
+		Validator:        lr.Validator,
 	}
 
 	initHandle := func(msg *watermillMsg.Message) *Init {
@@ -145,7 +163,7 @@ func (lr *LogicRunner) initHandlers() {
 func (lr *LogicRunner) initializeBuiltin(_ context.Context) error {
 	bi := builtin.NewBuiltIn(
 		lr.ArtifactManager,
-		NewRPCMethods(lr.ArtifactManager, lr.DescriptorsCache, lr.ContractRequester, lr.StateStorage, lr.OutgoingSender),
+		NewRPCMethods(lr.ArtifactManager, lr.DescriptorsCache, lr.ContractRequester, lr.StateStorage, lr.OutgoingSender, lr.Validator),
 		lr.builtinContracts,
 	)
 	if err := lr.MachinesManager.RegisterExecutor(insolar.MachineTypeBuiltin, bi); err != nil {
@@ -178,11 +196,30 @@ func (lr *LogicRunner) Stop(ctx context.Context) error {
 
 func (lr *LogicRunner) GracefulStop(ctx context.Context) error {
 	waitFunction := lr.ShutdownFlag.Stop(ctx)
+	// drained node doesn't receive pulses anymore, so it shouldn't wait for the next pulse
+	lr.stopIfNeeded(ctx)
 	waitFunction()
 
 	return nil
 }
 
+// PendingWork implements insolar.Drainer. Executions are pending until they are finished or handed off to the next
+// executor on pulse change.
+func (lr *LogicRunner) PendingWork(ctx context.Context) string {
+	if lr.StateStorage.IsEmpty() {
+		return ""
+	}
+	return "logic runner has pending executions"
+}
+
+// ValidationResults implements insolar.ValidationJournal.
+func (lr *LogicRunner) ValidationResults() []insolar.ValidationResult {
+	if lr.Validator == nil {
+		return nil
+	}
+	return lr.Validator.ValidationResults()
+}
+
 func (lr *LogicRunner) OnPulse(ctx context.Context, oldPulse insolar.Pulse, newPulse insolar.Pulse) error {
 	onPulseStart := time.Now()
 	ctx, span := instracer.StartSpan(ctx, "pulse.logicrunner")
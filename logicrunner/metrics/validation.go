@@ -0,0 +1,64 @@
+// Copyright 2020 Insolar Network Ltd.
+// All rights reserved.
+// This material is licensed under the Insolar License version 1.0,
+// available at https://github.com/insolar/insolar/blob/master/LICENSE.md.
+
+package metrics
+
+import (
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+
+	"github.com/insolar/insolar/instrumentation/insmetrics"
+)
+
+var (
+	TagValidationStatus = insmetrics.MustTagKey("vm_validation_status")
+)
+
+var (
+	ValidationRequested = stats.Int64(
+		"vm_validation_requested",
+		"executed requests sent to validators",
+		stats.UnitDimensionless,
+	)
+	ValidationResults = stats.Int64(
+		"vm_validation_results",
+		"validated requests by validation status",
+		stats.UnitDimensionless,
+	)
+	ValidationTiming = stats.Float64(
+		"vm_validation_latency",
+		"time spent on validation of the request",
+		stats.UnitMilliseconds,
+	)
+)
+
+func init() {
+	err := view.Register(
+		&view.View{
+			Name:        ValidationRequested.Name(),
+			Description: ValidationRequested.Description(),
+			Measure:     ValidationRequested,
+			Aggregation: view.Sum(),
+		},
+		&view.View{
+			Name:        ValidationResults.Name(),
+			Description: ValidationResults.Description(),
+			Measure:     ValidationResults,
+			TagKeys:     []tag.Key{TagValidationStatus},
+			Aggregation: view.Sum(),
+		},
+		&view.View{
+			Name:        ValidationTiming.Name(),
+			Description: ValidationTiming.Description(),
+			Measure:     ValidationTiming,
+			TagKeys:     []tag.Key{TagValidationStatus},
+			Aggregation: view.Distribution(0.001, 0.01, 0.1, 1, 10, 100, 1000, 5000, 10000, 20000),
+		},
+	)
+	if err != nil {
+		panic(err)
+	}
+}
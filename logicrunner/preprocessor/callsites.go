@@ -0,0 +1,222 @@
+// Copyright 2020 Insolar Network Ltd.
+// All rights reserved.
+// This material is licensed under the Insolar License version 1.0,
+// available at https://github.com/insolar/insolar/blob/master/LICENSE.md.
+
+package preprocessor
+
+import (
+	"fmt"
+	"go/ast"
+	"io"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// callSiteArgType describes how an argument of a supported type is extracted from API params.
+type callSiteArgType struct {
+	// Schema is a name of the type constant in the callsite package.
+	Schema string
+	// Getter is a name of the function extracting the value in the callsite package.
+	Getter string
+	// Convert is a format of an expression converting value returned by the getter to the argument type.
+	Convert string
+}
+
+func callSiteArgTypeFor(typeName string) (callSiteArgType, bool) {
+	switch typeName {
+	case "string":
+		return callSiteArgType{Schema: "TypeString", Getter: "String", Convert: "%s"}, true
+	case "bool":
+		return callSiteArgType{Schema: "TypeBool", Getter: "Bool", Convert: "%s"}, true
+	case "int", "int8", "int16", "int32", "int64":
+		return callSiteArgType{Schema: "TypeInt", Getter: "Int", Convert: typeName + "(%s)"}, true
+	case "uint", "uint8", "uint16", "uint32", "uint64":
+		return callSiteArgType{Schema: "TypeUint", Getter: "Uint", Convert: typeName + "(%s)"}, true
+	case "float32", "float64":
+		return callSiteArgType{Schema: "TypeFloat", Getter: "Float", Convert: typeName + "(%s)"}, true
+	case "insolar.Reference":
+		return callSiteArgType{Schema: "TypeReference", Getter: "Reference", Convert: "*%s"}, true
+	case "*insolar.Reference":
+		return callSiteArgType{Schema: "TypeReference", Getter: "Reference", Convert: "%s"}, true
+	case "[]byte":
+		return callSiteArgType{Schema: "TypeBytes", Getter: "Bytes", Convert: "%s"}, true
+	default:
+		return callSiteArgType{}, false
+	}
+}
+
+// publicInfo checks if the function is marked with //ins:public. The marker may rename arguments
+// of the function in API params: //ins:public(arg=param, ...).
+func publicInfo(fun *ast.FuncDecl) (bool, map[string]string, error) {
+	if fun.Doc == nil {
+		return false, nil, nil
+	}
+	for _, comment := range fun.Doc.List {
+		slice, err := skipCommentBeginning(comment.Text)
+		if err != nil || !strings.HasPrefix(slice, publicFlag) {
+			continue
+		}
+		slice = slice[len(publicFlag):]
+		if slice == "" {
+			return true, nil, nil
+		}
+		if !strings.HasPrefix(slice, "(") || !strings.HasSuffix(slice, ")") {
+			return false, nil, fmt.Errorf("%s: invalid %s marker '%s'", fun.Name.Name, publicFlag, comment.Text)
+		}
+		params := map[string]string{}
+		for _, rename := range strings.Split(slice[1:len(slice)-1], ",") {
+			pair := strings.Split(rename, "=")
+			if len(pair) != 2 || strings.TrimSpace(pair[0]) == "" || strings.TrimSpace(pair[1]) == "" {
+				return false, nil, fmt.Errorf("%s: invalid argument rename '%s' in %s marker", fun.Name.Name, rename, publicFlag)
+			}
+			params[strings.TrimSpace(pair[0])] = strings.TrimSpace(pair[1])
+		}
+		return true, params, nil
+	}
+	return false, nil, nil
+}
+
+// callSiteInfo returns template data for the function or false if the function can't be called
+// with API params, e.g. it has arguments of types that can't be built from JSON values.
+func (pf *ParsedFile) callSiteInfo(fun *ast.FuncDecl, constructor bool) (map[string]interface{}, bool, error) {
+	public, params, err := publicInfo(fun)
+	if err != nil {
+		return nil, false, err
+	}
+
+	type argInfo struct {
+		Name   string
+		Var    string
+		Schema string
+		Getter string
+		Value  string
+	}
+
+	var args []argInfo
+	for _, field := range fun.Type.Params.List {
+		argType, ok := callSiteArgTypeFor(pf.codeOfNode(field.Type))
+		if !ok || len(field.Names) == 0 {
+			return nil, false, publicError(fun, public)
+		}
+		for _, name := range field.Names {
+			if name.Name == "_" {
+				return nil, false, publicError(fun, public)
+			}
+			param := name.Name
+			if renamed, ok := params[name.Name]; ok {
+				param = renamed
+				delete(params, name.Name)
+			}
+			v := fmt.Sprintf("arg%d", len(args))
+			args = append(args, argInfo{
+				Name:   param,
+				Var:    v,
+				Schema: argType.Schema,
+				Getter: argType.Getter,
+				Value:  fmt.Sprintf(argType.Convert, v),
+			})
+		}
+	}
+
+	for arg := range params {
+		return nil, false, fmt.Errorf("%s: unknown argument '%s' in %s marker", fun.Name.Name, arg, publicFlag)
+	}
+
+	values := make([]string, 0, len(args))
+	for _, a := range args {
+		values = append(values, a.Value)
+	}
+
+	numResults := fun.Type.Results.NumFields()
+	if !constructor && numResults > 2 {
+		return nil, false, publicError(fun, public)
+	}
+
+	return map[string]interface{}{
+		"Name":        fun.Name.Name,
+		"Constructor": constructor,
+		"Immutable":   !constructor && isImmutable(fun),
+		"Public":      public,
+		"Args":        args,
+		"Values":      strings.Join(values, ", "),
+		"OnlyError":   numResults == 1,
+	}, true, nil
+}
+
+// publicError returns an error for a public function, that can't be called with API params.
+func publicError(fun *ast.FuncDecl, public bool) error {
+	if !public {
+		return nil
+	}
+	return fmt.Errorf("%s is marked with %s, but can't be called with API params", fun.Name.Name, publicFlag)
+}
+
+func (pf *ParsedFile) callSitesInfo() ([]map[string]interface{}, error) {
+	var res []map[string]interface{}
+	for _, fun := range pf.constructors[pf.contract] {
+		info, ok, err := pf.callSiteInfo(fun, true)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			res = append(res, info)
+		}
+	}
+	// saga rollback methods are called by the system only and have no proxies
+	rollbacks := make(map[string]struct{})
+	for _, fun := range pf.methods[pf.contract] {
+		if si := sagaInfo(pf, fun); si.IsSaga {
+			rollbacks[si.RollbackMethodName] = struct{}{}
+		}
+	}
+	for _, fun := range pf.methods[pf.contract] {
+		if _, isRollback := rollbacks[fun.Name.Name]; isRollback {
+			continue
+		}
+		info, ok, err := pf.callSiteInfo(fun, false)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			res = append(res, info)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i]["Name"].(string) < res[j]["Name"].(string)
+	})
+	return res, nil
+}
+
+// GenerateCallSiteRegistry writes into `out` source code of the registry of constructors
+// and methods of the contracts. Calls are made through the contracts' proxies
+// from `proxyImportPath` directory. Functions marked with //ins:public are public call sites.
+func GenerateCallSiteRegistry(out io.Writer, contracts ContractList, proxyImportPath string) error {
+	list := make([]interface{}, 0, len(contracts))
+	for _, contract := range contracts {
+		proxyPackageName, err := contract.Parsed.ProxyPackageName()
+		if err != nil {
+			return err
+		}
+		functions, err := contract.Parsed.callSitesInfo()
+		if err != nil {
+			return errors.Wrapf(err, "failed to get call sites of contract %s", contract.Name)
+		}
+		list = append(list, map[string]interface{}{
+			"Name":       contract.Name,
+			"ImportName": proxyPackageName,
+			"ImportPath": path.Join(proxyImportPath, proxyPackageName),
+			"Functions":  functions,
+		})
+	}
+
+	data := map[string]interface{}{
+		"Contracts": list,
+		"Package":   "callsites",
+	}
+
+	return formatAndWrite(out, "callsites", data)
+}
@@ -0,0 +1,369 @@
+// Copyright 2020 Insolar Network Ltd.
+// All rights reserved.
+// This material is licensed under the Insolar License version 1.0,
+// available at https://github.com/insolar/insolar/blob/master/LICENSE.md.
+
+package preprocessor
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var allowFlagStart = "ins:allow("
+var allowFlagEnd = ")"
+
+// LintSeverity is a reaction of the linter on violation of a rule.
+type LintSeverity string
+
+const (
+	LintOff     LintSeverity = "off"
+	LintWarning LintSeverity = "warning"
+	LintError   LintSeverity = "error"
+)
+
+// Names of the rules checked by ParsedFile.Lint.
+const (
+	LintRuleTime      = "time"
+	LintRuleRand      = "rand"
+	LintRuleMapRange  = "map-range"
+	LintRuleGoroutine = "goroutine"
+	LintRuleEnv       = "env"
+	LintRuleIO        = "io"
+)
+
+// LintRules maps rule name to its severity. It implements pflag.Value,
+// so it can be set from command line as a comma separated list of
+// `rule=severity` pairs, e.g. `map-range=error,goroutine=off`.
+type LintRules map[string]LintSeverity
+
+// DefaultLintRules returns rules used by insgocc when nothing is configured.
+// Everything that makes executor and validator diverge is an error, map
+// iteration is only a warning because in most cases order doesn't matter.
+func DefaultLintRules() LintRules {
+	return LintRules{
+		LintRuleTime:      LintError,
+		LintRuleRand:      LintError,
+		LintRuleMapRange:  LintWarning,
+		LintRuleGoroutine: LintError,
+		LintRuleEnv:       LintError,
+		LintRuleIO:        LintError,
+	}
+}
+
+func (r LintRules) String() string {
+	res := make([]string, 0, len(r))
+	for rule, severity := range r {
+		res = append(res, rule+"="+string(severity))
+	}
+	sort.Strings(res)
+	return strings.Join(res, ",")
+}
+
+func (r LintRules) Set(arg string) error {
+	defaults := DefaultLintRules()
+	for _, pair := range strings.Split(arg, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		parts := strings.SplitN(pair, "=", 2)
+		if len(parts) != 2 {
+			return fmt.Errorf("bad rule %q, expected rule=severity", pair)
+		}
+		rule, severity := parts[0], LintSeverity(parts[1])
+		if _, ok := defaults[rule]; !ok {
+			return fmt.Errorf("unknown lint rule: %s", rule)
+		}
+		switch severity {
+		case LintOff, LintWarning, LintError:
+		default:
+			return fmt.Errorf("unknown lint severity: %s", severity)
+		}
+		r[rule] = severity
+	}
+	return nil
+}
+
+func (r LintRules) Type() string {
+	return "lintRules"
+}
+
+// LintIssue is a non-deterministic construct found in contract's code.
+type LintIssue struct {
+	Pos      token.Position
+	Rule     string
+	Severity LintSeverity
+	Message  string
+}
+
+func (i LintIssue) String() string {
+	return fmt.Sprintf("%s: %s: %s [%s]", i.Pos, i.Severity, i.Message, i.Rule)
+}
+
+// HasLintErrors returns true if any of issues has error severity.
+func HasLintErrors(issues []LintIssue) bool {
+	for _, issue := range issues {
+		if issue.Severity == LintError {
+			return true
+		}
+	}
+	return false
+}
+
+var timeFunctions = map[string]bool{
+	"Now": true, "Since": true, "Until": true, "Sleep": true, "After": true,
+	"Tick": true, "NewTimer": true, "NewTicker": true, "AfterFunc": true,
+}
+
+// math/rand functions and types that don't touch global randomly seeded source
+var deterministicRandIdentifiers = map[string]bool{
+	"New": true, "NewSource": true, "NewZipf": true,
+	"Rand": true, "Source": true, "Source64": true, "Zipf": true,
+}
+
+var envIdentifiers = map[string]bool{
+	"Getenv": true, "LookupEnv": true, "Environ": true, "ExpandEnv": true, "Args": true,
+	"Hostname": true, "Getpid": true, "Getppid": true, "Getuid": true, "Getwd": true, "Executable": true,
+}
+
+var ioFunctions = map[string]bool{
+	"Open": true, "OpenFile": true, "Create": true, "Remove": true, "RemoveAll": true,
+	"Mkdir": true, "MkdirAll": true, "Rename": true, "Stat": true, "Lstat": true,
+	"Chdir": true, "Chmod": true, "Link": true, "Symlink": true, "Truncate": true,
+	"Pipe": true, "StartProcess": true, "Exit": true,
+}
+
+func isIOPackage(importPath string) bool {
+	switch importPath {
+	case "net", "os/exec", "os/signal", "syscall", "io/ioutil", "plugin":
+		return true
+	}
+	return strings.HasPrefix(importPath, "net/")
+}
+
+type linter struct {
+	pf     *ParsedFile
+	rules  LintRules
+	issues []LintIssue
+
+	// local package name -> import path
+	imports map[string]string
+	// file name -> line -> rules allowed by ins:allow comments
+	allowed map[string]map[int]map[string]bool
+	// names of variables, parameters and fields of map types
+	maps map[string]bool
+}
+
+// Lint looks for constructs in contract's code that give different results
+// on different nodes: wall clock, random numbers, map iteration, goroutines,
+// environment and I/O. Check works on AST only, so map iteration is found
+// by names of variables and fields and may have false positives. Any check
+// can be suppressed for a line with `//ins:allow(rule)` comment placed on
+// the same or the previous line.
+func (pf *ParsedFile) Lint(rules LintRules) []LintIssue {
+	l := &linter{
+		pf:      pf,
+		rules:   rules,
+		imports: map[string]string{},
+		allowed: map[string]map[int]map[string]bool{},
+		maps:    map[string]bool{},
+	}
+	l.parseAllowComments()
+	l.checkImports()
+	l.collectMaps()
+	ast.Inspect(pf.node, l.inspect)
+
+	sort.SliceStable(l.issues, func(i, j int) bool {
+		return l.issues[i].Pos.Offset < l.issues[j].Pos.Offset
+	})
+	return l.issues
+}
+
+func (l *linter) report(node ast.Node, rule string, format string, args ...interface{}) {
+	severity := l.rules[rule]
+	if severity == "" || severity == LintOff {
+		return
+	}
+	pos := l.pf.fileSet.Position(node.Pos())
+	lines := l.allowed[pos.Filename]
+	if lines[pos.Line][rule] || lines[pos.Line-1][rule] {
+		return
+	}
+	l.issues = append(l.issues, LintIssue{
+		Pos:      pos,
+		Rule:     rule,
+		Severity: severity,
+		Message:  fmt.Sprintf(format, args...),
+	})
+}
+
+func (l *linter) parseAllowComments() {
+	for _, group := range l.pf.node.Comments {
+		for _, comment := range group.List {
+			slice, err := skipCommentBeginning(comment.Text)
+			if err != nil {
+				continue
+			}
+			if !strings.HasPrefix(slice, allowFlagStart) || !strings.HasSuffix(slice, allowFlagEnd) {
+				continue
+			}
+			pos := l.pf.fileSet.Position(comment.Pos())
+			if l.allowed[pos.Filename] == nil {
+				l.allowed[pos.Filename] = map[int]map[string]bool{}
+			}
+			if l.allowed[pos.Filename][pos.Line] == nil {
+				l.allowed[pos.Filename][pos.Line] = map[string]bool{}
+			}
+			list := slice[len(allowFlagStart) : len(slice)-len(allowFlagEnd)]
+			for _, rule := range strings.Split(list, ",") {
+				l.allowed[pos.Filename][pos.Line][strings.TrimSpace(rule)] = true
+			}
+		}
+	}
+}
+
+func (l *linter) checkImports() {
+	for _, spec := range l.pf.node.Imports {
+		importPath, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			continue
+		}
+		name := path.Base(importPath)
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+		l.imports[name] = importPath
+
+		switch {
+		case isIOPackage(importPath):
+			l.report(spec, LintRuleIO, "package %q does I/O, contracts may change only their own state", importPath)
+		case importPath == "crypto/rand":
+			l.report(spec, LintRuleRand, "package %q is not deterministic, use rand.New(foundation.NewSource()) from math/rand", importPath)
+		}
+	}
+}
+
+func (l *linter) isMapType(expr ast.Expr) bool {
+	switch t := expr.(type) {
+	case *ast.MapType:
+		return true
+	case *ast.Ident:
+		spec, ok := l.pf.types[t.Name]
+		if !ok {
+			return false
+		}
+		_, ok = spec.Type.(*ast.MapType)
+		return ok
+	case *ast.ParenExpr:
+		return l.isMapType(t.X)
+	}
+	return false
+}
+
+// isMapValue checks if expression creates a new map or refers known map variable
+func (l *linter) isMapValue(expr ast.Expr) bool {
+	switch e := expr.(type) {
+	case *ast.CompositeLit:
+		return e.Type != nil && l.isMapType(e.Type)
+	case *ast.CallExpr:
+		if ident, ok := e.Fun.(*ast.Ident); ok && ident.Name == "make" && len(e.Args) > 0 {
+			return l.isMapType(e.Args[0])
+		}
+	case *ast.Ident:
+		return l.maps[e.Name]
+	case *ast.SelectorExpr:
+		return l.maps[e.Sel.Name]
+	case *ast.ParenExpr:
+		return l.isMapValue(e.X)
+	case *ast.StarExpr:
+		return l.isMapValue(e.X)
+	}
+	return false
+}
+
+func (l *linter) collectMaps() {
+	addFields := func(list *ast.FieldList) {
+		if list == nil {
+			return
+		}
+		for _, field := range list.List {
+			if !l.isMapType(field.Type) {
+				continue
+			}
+			for _, name := range field.Names {
+				l.maps[name.Name] = true
+			}
+		}
+	}
+
+	ast.Inspect(l.pf.node, func(node ast.Node) bool {
+		switch n := node.(type) {
+		case *ast.StructType:
+			addFields(n.Fields)
+		case *ast.FuncType:
+			addFields(n.Params)
+			addFields(n.Results)
+		case *ast.ValueSpec:
+			for i, name := range n.Names {
+				if (n.Type != nil && l.isMapType(n.Type)) || (i < len(n.Values) && l.isMapValue(n.Values[i])) {
+					l.maps[name.Name] = true
+				}
+			}
+		case *ast.AssignStmt:
+			if n.Tok != token.DEFINE || len(n.Lhs) != len(n.Rhs) {
+				return true
+			}
+			for i, lhs := range n.Lhs {
+				if ident, ok := lhs.(*ast.Ident); ok && l.isMapValue(n.Rhs[i]) {
+					l.maps[ident.Name] = true
+				}
+			}
+		}
+		return true
+	})
+}
+
+func (l *linter) inspect(node ast.Node) bool {
+	switch n := node.(type) {
+	case *ast.GoStmt:
+		l.report(n, LintRuleGoroutine, "goroutines are scheduled differently on every node, do all the work in the method call")
+	case *ast.RangeStmt:
+		if l.isMapValue(n.X) {
+			l.report(n, LintRuleMapRange, "map iteration order is random, iterate over sorted keys or keep data in foundation.StableMap")
+		}
+	case *ast.SelectorExpr:
+		l.checkSelector(n)
+	}
+	return true
+}
+
+func (l *linter) checkSelector(sel *ast.SelectorExpr) {
+	pkg, ok := sel.X.(*ast.Ident)
+	if !ok || pkg.Obj != nil {
+		// not a package, but a local variable
+		return
+	}
+	name := sel.Sel.Name
+	switch l.imports[pkg.Name] {
+	case "time":
+		if timeFunctions[name] {
+			l.report(sel, LintRuleTime, "time.%s depends on node's clock, use pulse from the call context", name)
+		}
+	case "math/rand":
+		if !deterministicRandIdentifiers[name] {
+			l.report(sel, LintRuleRand, "rand.%s uses randomly seeded source, use rand.New(foundation.NewSource())", name)
+		}
+	case "os":
+		if envIdentifiers[name] {
+			l.report(sel, LintRuleEnv, "os.%s depends on node's environment, pass data as arguments of the call", name)
+		} else if ioFunctions[name] {
+			l.report(sel, LintRuleIO, "os.%s does I/O, contracts may change only their own state", name)
+		}
+	}
+}
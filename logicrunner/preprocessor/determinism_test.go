@@ -0,0 +1,171 @@
+// Copyright 2020 Insolar Network Ltd.
+// All rights reserved.
+// This material is licensed under the Insolar License version 1.0,
+// available at https://github.com/insolar/insolar/blob/master/LICENSE.md.
+
+// +build slowtest
+
+package preprocessor
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/testutils"
+)
+
+type DeterminismSuite struct {
+	suite.Suite
+}
+
+var lintTestContract = `
+package main
+
+import (
+	"math/rand"
+	"os"
+	"time"
+	"net/http"
+
+	"github.com/insolar/insolar/logicrunner/builtin/foundation"
+)
+
+type Balances map[string]int
+
+type LintTestWallet struct {
+	foundation.BaseContract
+	Balances Balances
+	Owners   map[string]string
+}
+
+func (w *LintTestWallet) Time() (int64, error) {
+	return time.Now().Unix(), nil
+}
+
+func (w *LintTestWallet) Random() (int, error) {
+	stable := rand.New(foundation.NewSource())
+	return rand.Intn(10) + stable.Intn(10), nil
+}
+
+func (w *LintTestWallet) Maps(extra map[string]int) (int, error) {
+	total := 0
+	for _, b := range w.Balances {
+		total += b
+	}
+	for _, b := range extra {
+		total += b
+	}
+	local := make(map[int]int)
+	for k := range local {
+		total += k
+	}
+	keys := []string{"a", "b"}
+	for range keys {
+		total++
+	}
+	return total, nil
+}
+
+func (w *LintTestWallet) Goroutine() error {
+	go func() {}()
+	return nil
+}
+
+func (w *LintTestWallet) Env() (string, error) {
+	return os.Getenv("HOME") + os.Args[0], nil
+}
+
+func (w *LintTestWallet) IO() error {
+	_, err := os.Open("/etc/passwd")
+	_, _ = http.Get("http://localhost")
+	return err
+}
+
+func (w *LintTestWallet) Allowed() (int64, error) {
+	//ins:allow(time)
+	start := time.Now()
+	for range w.Owners { //ins:allow(map-range)
+	}
+	return start.Unix(), nil
+}
+`
+
+func (s *DeterminismSuite) parse(code string) *ParsedFile {
+	tmpDir, err := ioutil.TempDir("", "test-")
+	s.Require().NoError(err)
+	defer os.RemoveAll(tmpDir)
+
+	testContract := "/test.go"
+	err = WriteFile(tmpDir, testContract, code)
+	s.Require().NoError(err)
+
+	parsed, err := ParseFile(tmpDir+testContract, insolar.MachineTypeBuiltin)
+	s.Require().NoError(err)
+	return parsed
+}
+
+func (s *DeterminismSuite) TestDefaultRules() {
+	issues := s.parse(lintTestContract).Lint(DefaultLintRules())
+
+	type found struct {
+		line     int
+		rule     string
+		severity LintSeverity
+	}
+	var res []found
+	for _, issue := range issues {
+		res = append(res, found{line: issue.Pos.Line, rule: issue.Rule, severity: issue.Severity})
+	}
+	s.Equal([]found{
+		{line: 8, rule: LintRuleIO, severity: LintError},
+		{line: 22, rule: LintRuleTime, severity: LintError},
+		{line: 27, rule: LintRuleRand, severity: LintError},
+		{line: 32, rule: LintRuleMapRange, severity: LintWarning},
+		{line: 35, rule: LintRuleMapRange, severity: LintWarning},
+		{line: 39, rule: LintRuleMapRange, severity: LintWarning},
+		{line: 50, rule: LintRuleGoroutine, severity: LintError},
+		{line: 55, rule: LintRuleEnv, severity: LintError},
+		{line: 55, rule: LintRuleEnv, severity: LintError},
+		{line: 59, rule: LintRuleIO, severity: LintError},
+	}, res)
+	s.True(HasLintErrors(issues))
+	s.Contains(issues[3].String(), "foundation.StableMap")
+	s.Contains(issues[2].String(), "foundation.NewSource()")
+}
+
+func (s *DeterminismSuite) TestConfiguredRules() {
+	rules := DefaultLintRules()
+	err := rules.Set("time=off,rand=off,goroutine=off,env=off,io=warning,map-range=off")
+	s.Require().NoError(err)
+
+	issues := s.parse(lintTestContract).Lint(rules)
+	s.Len(issues, 2)
+	for _, issue := range issues {
+		s.Equal(LintRuleIO, issue.Rule)
+		s.Equal(LintWarning, issue.Severity)
+	}
+	s.False(HasLintErrors(issues))
+}
+
+func (s *DeterminismSuite) TestLintRulesSet() {
+	rules := DefaultLintRules()
+	s.Error(rules.Set("unknown=error"))
+	s.Error(rules.Set("time=fatal"))
+	s.Error(rules.Set("time"))
+
+	s.NoError(rules.Set("map-range=error, time=warning"))
+	s.Equal("env=error,goroutine=error,io=error,map-range=error,rand=error,time=warning", rules.String())
+}
+
+func TestDeterminism(t *testing.T) {
+	if useLeakTest {
+		defer testutils.LeakTester(t)
+	} else {
+		t.Parallel()
+	}
+	suite.Run(t, new(DeterminismSuite))
+}
@@ -38,6 +38,7 @@ var corePath = "github.com/insolar/insolar/insolar"
 var pkgErrorsPath = "github.com/pkg/errors"
 
 var immutableFlag = "ins:immutable"
+var publicFlag = "ins:public"
 var sagaFlagStart = "ins:saga("
 var sagaFlagEnd = ")"
 var sagaFlagStartLength = len(sagaFlagStart)
@@ -628,6 +629,8 @@ func openTemplate(fileName string) (*template.Template, error) {
 		t = wrapperTmpl
 	case "initialization":
 		t = initializationTmpl
+	case "callsites":
+		t = callSitesTmpl
 	default:
 		return nil, errors.New("unknown template")
 	}
@@ -14,6 +14,7 @@ import (
 	"os/exec"
 	"path"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -149,6 +150,87 @@ func (s *PreprocessorSuite) TestBasicGeneration() {
 	})
 }
 
+func (s *PreprocessorSuite) TestCallSiteRegistryGeneration() {
+	tmpDir, err := ioutil.TempDir("", "test_")
+	s.NoError(err)
+	defer os.RemoveAll(tmpDir) // nolint: errcheck
+
+	err = WriteFile(tmpDir, "main.go", randomTestCode)
+	s.NoError(err)
+
+	parsed, err := ParseFile(filepath.Join(tmpDir, "main.go"), insolar.MachineTypeBuiltin)
+	s.NoError(err)
+
+	contracts := ContractList{{Name: "main", Parsed: parsed}}
+	buf := bytes.Buffer{}
+	err = GenerateCallSiteRegistry(&buf, contracts, "github.com/insolar/insolar/application/builtin/proxy")
+	s.NoError(err)
+
+	code := buf.String()
+	s.Contains(code, `Method:      "Echo"`)
+	s.Contains(code, `{Name: "s", Type: XXX_callsite.TypeString}`)
+	s.Contains(code, `Method:      "Hello"`)
+	// arguments of struct types can't be built from API params
+	s.NotContains(code, `Method:      "HelloHuman"`)
+	s.NotContains(code, `Method:      "MultiArgs"`)
+}
+
+func (s *PreprocessorSuite) TestCallSiteRegistryGeneration_Public() {
+	tmpDir, err := ioutil.TempDir("", "test_")
+	s.NoError(err)
+	defer os.RemoveAll(tmpDir) // nolint: errcheck
+
+	generate := func(code string) (string, error) {
+		err := WriteFile(tmpDir, "main.go", code)
+		s.NoError(err)
+		parsed, err := ParseFile(filepath.Join(tmpDir, "main.go"), insolar.MachineTypeBuiltin)
+		s.NoError(err)
+		buf := bytes.Buffer{}
+		err = GenerateCallSiteRegistry(&buf, ContractList{{Name: "main", Parsed: parsed}}, "github.com/insolar/insolar/application/builtin/proxy")
+		return buf.String(), err
+	}
+
+	contract := `
+package main
+
+import (
+	"github.com/insolar/insolar/logicrunner/builtin/foundation"
+)
+
+type HelloWorlder struct {
+	foundation.BaseContract
+}
+
+//ins:public
+func New() (*HelloWorlder, error) {
+	return &HelloWorlder{}, nil
+}
+
+//ins:public(s=name)
+func (hw *HelloWorlder) Echo(s string) (string, error) {
+	return s, nil
+}
+
+func (hw *HelloWorlder) Internal() error {
+	return nil
+}
+`
+	code, err := generate(contract)
+	s.NoError(err)
+	s.Regexp(`Method:\s+"New",\s+Constructor:\s+true,\s+Immutable:\s+false,\s+Public:\s+true`, code)
+	s.Regexp(`Method:\s+"Internal",\s+Constructor:\s+false,\s+Immutable:\s+false,\s+Public:\s+false`, code)
+	s.Contains(code, `{Name: "name", Type: XXX_callsite.TypeString}`)
+	s.Contains(code, `XXX_callsite.String(params, "name")`)
+
+	_, err = generate(strings.Replace(contract, "//ins:public(s=name)", "//ins:public(x=name)", 1))
+	s.EqualError(err, "failed to get call sites of contract main: Echo: unknown argument 'x' in ins:public marker")
+
+	_, err = generate(strings.Replace(contract, "Internal() error", "Internal(ch chan int) error", 1))
+	s.NoError(err)
+	_, err = generate(strings.Replace(contract, "func (hw *HelloWorlder) Internal() error", "//ins:public\nfunc (hw *HelloWorlder) Internal(ch chan int) error", 1))
+	s.EqualError(err, "failed to get call sites of contract main: Internal is marked with ins:public, but can't be called with API params")
+}
+
 func (s *PreprocessorSuite) TestConstructorsParsing() {
 	tmpDir, err := ioutil.TempDir("", "test-")
 	s.NoError(err)
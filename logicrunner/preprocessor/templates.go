@@ -601,3 +601,73 @@ func InitializePrototypeDescriptors() []XXX_artifacts.PrototypeDescriptor {
     return rv
 }
 `
+
+var callSitesTmpl = `
+// Copyright 2020 Insolar Network Ltd.
+// All rights reserved.
+// This material is licensed under the Insolar License version 1.0,
+// available at https://github.com/insolar/insolar/blob/master/LICENSE.md.
+
+// Code generated by insgocc. DO NOT EDIT.
+// source template in logicrunner/preprocessor/templates
+
+package {{ .Package }}
+
+import (
+{{- range $contract := .Contracts }}
+    {{ $contract.ImportName }} "{{ $contract.ImportPath }}"
+{{- end }}
+
+    XXX_fmt "fmt"
+
+    XXX_insolar "github.com/insolar/insolar/insolar"
+    XXX_callsite "github.com/insolar/insolar/logicrunner/builtin/callsite"
+)
+
+var _ = XXX_fmt.Errorf
+
+// Registry contains constructors and methods of the contracts, that can be called from API.
+var Registry = XXX_callsite.NewRegistry(
+{{- range $contract := .Contracts }}
+{{- range $f := $contract.Functions }}
+    XXX_callsite.CallSite{
+        Contract:    "{{ $contract.Name }}",
+        Method:      "{{ $f.Name }}",
+        Constructor: {{ $f.Constructor }},
+        Immutable:   {{ $f.Immutable }},
+        Public:      {{ $f.Public }},
+        Args: []XXX_callsite.Arg{
+        {{- range $arg := $f.Args }}
+            {Name: "{{ $arg.Name }}", Type: XXX_callsite.{{ $arg.Schema }}},
+        {{- end }}
+        },
+        Call: func(caller XXX_insolar.Reference, params map[string]interface{}) (interface{}, error) {
+            {{- if not $f.Constructor }}
+            ref, err := XXX_callsite.Reference(params, XXX_callsite.ReferenceParam)
+            if err != nil {
+                return nil, err
+            }
+            {{- end }}
+            {{- range $arg := $f.Args }}
+            {{ $arg.Var }}, err := XXX_callsite.{{ $arg.Getter }}(params, "{{ $arg.Name }}")
+            if err != nil {
+                return nil, err
+            }
+            {{- end }}
+            {{- if $f.Constructor }}
+            instance, err := {{ $contract.ImportName }}.{{ $f.Name }}({{ $f.Values }}).AsChild(caller)
+            if err != nil {
+                return nil, XXX_fmt.Errorf("failed to create {{ $contract.Name }} instance from {{ $f.Name }}: %s", err.Error())
+            }
+            return instance.Reference.String(), nil
+            {{- else if $f.OnlyError }}
+            return nil, {{ $contract.ImportName }}.GetObject(*ref).{{ $f.Name }}({{ $f.Values }})
+            {{- else }}
+            return {{ $contract.ImportName }}.GetObject(*ref).{{ $f.Name }}({{ $f.Values }})
+            {{- end }}
+        },
+    },
+{{- end }}
+{{- end }}
+)
+`
@@ -23,6 +23,12 @@ type ProxyImplementationMock struct {
 	beforeDeactivateObjectCounter uint64
 	DeactivateObjectMock          mProxyImplementationMockDeactivateObject
 
+	funcEmitEvent          func(ctx context.Context, tp1 *common.Transcript, u1 rpctypes.UpEmitEventReq, up1 *rpctypes.UpEmitEventResp) (err error)
+	inspectFuncEmitEvent   func(ctx context.Context, tp1 *common.Transcript, u1 rpctypes.UpEmitEventReq, up1 *rpctypes.UpEmitEventResp)
+	afterEmitEventCounter  uint64
+	beforeEmitEventCounter uint64
+	EmitEventMock          mProxyImplementationMockEmitEvent
+
 	funcGetCode          func(ctx context.Context, tp1 *common.Transcript, u1 rpctypes.UpGetCodeReq, up1 *rpctypes.UpGetCodeResp) (err error)
 	inspectFuncGetCode   func(ctx context.Context, tp1 *common.Transcript, u1 rpctypes.UpGetCodeReq, up1 *rpctypes.UpGetCodeResp)
 	afterGetCodeCounter  uint64
@@ -52,6 +58,9 @@ func NewProxyImplementationMock(t minimock.Tester) *ProxyImplementationMock {
 	m.DeactivateObjectMock = mProxyImplementationMockDeactivateObject{mock: m}
 	m.DeactivateObjectMock.callArgs = []*ProxyImplementationMockDeactivateObjectParams{}
 
+	m.EmitEventMock = mProxyImplementationMockEmitEvent{mock: m}
+	m.EmitEventMock.callArgs = []*ProxyImplementationMockEmitEventParams{}
+
 	m.GetCodeMock = mProxyImplementationMockGetCode{mock: m}
 	m.GetCodeMock.callArgs = []*ProxyImplementationMockGetCodeParams{}
 
@@ -138,7 +147,7 @@ func (mmDeactivateObject *mProxyImplementationMockDeactivateObject) Return(err e
 	return mmDeactivateObject.mock
 }
 
-//Set uses given function f to mock the ProxyImplementation.DeactivateObject method
+// Set uses given function f to mock the ProxyImplementation.DeactivateObject method
 func (mmDeactivateObject *mProxyImplementationMockDeactivateObject) Set(f func(ctx context.Context, tp1 *common.Transcript, u1 rpctypes.UpDeactivateObjectReq, up1 *rpctypes.UpDeactivateObjectResp) (err error)) *ProxyImplementationMock {
 	if mmDeactivateObject.defaultExpectation != nil {
 		mmDeactivateObject.mock.t.Fatalf("Default expectation is already set for the ProxyImplementation.DeactivateObject method")
@@ -282,6 +291,224 @@ func (m *ProxyImplementationMock) MinimockDeactivateObjectInspect() {
 	}
 }
 
+type mProxyImplementationMockEmitEvent struct {
+	mock               *ProxyImplementationMock
+	defaultExpectation *ProxyImplementationMockEmitEventExpectation
+	expectations       []*ProxyImplementationMockEmitEventExpectation
+
+	callArgs []*ProxyImplementationMockEmitEventParams
+	mutex    sync.RWMutex
+}
+
+// ProxyImplementationMockEmitEventExpectation specifies expectation struct of the ProxyImplementation.EmitEvent
+type ProxyImplementationMockEmitEventExpectation struct {
+	mock    *ProxyImplementationMock
+	params  *ProxyImplementationMockEmitEventParams
+	results *ProxyImplementationMockEmitEventResults
+	Counter uint64
+}
+
+// ProxyImplementationMockEmitEventParams contains parameters of the ProxyImplementation.EmitEvent
+type ProxyImplementationMockEmitEventParams struct {
+	ctx context.Context
+	tp1 *common.Transcript
+	u1  rpctypes.UpEmitEventReq
+	up1 *rpctypes.UpEmitEventResp
+}
+
+// ProxyImplementationMockEmitEventResults contains results of the ProxyImplementation.EmitEvent
+type ProxyImplementationMockEmitEventResults struct {
+	err error
+}
+
+// Expect sets up expected params for ProxyImplementation.EmitEvent
+func (mmEmitEvent *mProxyImplementationMockEmitEvent) Expect(ctx context.Context, tp1 *common.Transcript, u1 rpctypes.UpEmitEventReq, up1 *rpctypes.UpEmitEventResp) *mProxyImplementationMockEmitEvent {
+	if mmEmitEvent.mock.funcEmitEvent != nil {
+		mmEmitEvent.mock.t.Fatalf("ProxyImplementationMock.EmitEvent mock is already set by Set")
+	}
+
+	if mmEmitEvent.defaultExpectation == nil {
+		mmEmitEvent.defaultExpectation = &ProxyImplementationMockEmitEventExpectation{}
+	}
+
+	mmEmitEvent.defaultExpectation.params = &ProxyImplementationMockEmitEventParams{ctx, tp1, u1, up1}
+	for _, e := range mmEmitEvent.expectations {
+		if minimock.Equal(e.params, mmEmitEvent.defaultExpectation.params) {
+			mmEmitEvent.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmEmitEvent.defaultExpectation.params)
+		}
+	}
+
+	return mmEmitEvent
+}
+
+// Inspect accepts an inspector function that has same arguments as the ProxyImplementation.EmitEvent
+func (mmEmitEvent *mProxyImplementationMockEmitEvent) Inspect(f func(ctx context.Context, tp1 *common.Transcript, u1 rpctypes.UpEmitEventReq, up1 *rpctypes.UpEmitEventResp)) *mProxyImplementationMockEmitEvent {
+	if mmEmitEvent.mock.inspectFuncEmitEvent != nil {
+		mmEmitEvent.mock.t.Fatalf("Inspect function is already set for ProxyImplementationMock.EmitEvent")
+	}
+
+	mmEmitEvent.mock.inspectFuncEmitEvent = f
+
+	return mmEmitEvent
+}
+
+// Return sets up results that will be returned by ProxyImplementation.EmitEvent
+func (mmEmitEvent *mProxyImplementationMockEmitEvent) Return(err error) *ProxyImplementationMock {
+	if mmEmitEvent.mock.funcEmitEvent != nil {
+		mmEmitEvent.mock.t.Fatalf("ProxyImplementationMock.EmitEvent mock is already set by Set")
+	}
+
+	if mmEmitEvent.defaultExpectation == nil {
+		mmEmitEvent.defaultExpectation = &ProxyImplementationMockEmitEventExpectation{mock: mmEmitEvent.mock}
+	}
+	mmEmitEvent.defaultExpectation.results = &ProxyImplementationMockEmitEventResults{err}
+	return mmEmitEvent.mock
+}
+
+// Set uses given function f to mock the ProxyImplementation.EmitEvent method
+func (mmEmitEvent *mProxyImplementationMockEmitEvent) Set(f func(ctx context.Context, tp1 *common.Transcript, u1 rpctypes.UpEmitEventReq, up1 *rpctypes.UpEmitEventResp) (err error)) *ProxyImplementationMock {
+	if mmEmitEvent.defaultExpectation != nil {
+		mmEmitEvent.mock.t.Fatalf("Default expectation is already set for the ProxyImplementation.EmitEvent method")
+	}
+
+	if len(mmEmitEvent.expectations) > 0 {
+		mmEmitEvent.mock.t.Fatalf("Some expectations are already set for the ProxyImplementation.EmitEvent method")
+	}
+
+	mmEmitEvent.mock.funcEmitEvent = f
+	return mmEmitEvent.mock
+}
+
+// When sets expectation for the ProxyImplementation.EmitEvent which will trigger the result defined by the following
+// Then helper
+func (mmEmitEvent *mProxyImplementationMockEmitEvent) When(ctx context.Context, tp1 *common.Transcript, u1 rpctypes.UpEmitEventReq, up1 *rpctypes.UpEmitEventResp) *ProxyImplementationMockEmitEventExpectation {
+	if mmEmitEvent.mock.funcEmitEvent != nil {
+		mmEmitEvent.mock.t.Fatalf("ProxyImplementationMock.EmitEvent mock is already set by Set")
+	}
+
+	expectation := &ProxyImplementationMockEmitEventExpectation{
+		mock:   mmEmitEvent.mock,
+		params: &ProxyImplementationMockEmitEventParams{ctx, tp1, u1, up1},
+	}
+	mmEmitEvent.expectations = append(mmEmitEvent.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ProxyImplementation.EmitEvent return parameters for the expectation previously defined by the When method
+func (e *ProxyImplementationMockEmitEventExpectation) Then(err error) *ProxyImplementationMock {
+	e.results = &ProxyImplementationMockEmitEventResults{err}
+	return e.mock
+}
+
+// EmitEvent implements ProxyImplementation
+func (mmEmitEvent *ProxyImplementationMock) EmitEvent(ctx context.Context, tp1 *common.Transcript, u1 rpctypes.UpEmitEventReq, up1 *rpctypes.UpEmitEventResp) (err error) {
+	mm_atomic.AddUint64(&mmEmitEvent.beforeEmitEventCounter, 1)
+	defer mm_atomic.AddUint64(&mmEmitEvent.afterEmitEventCounter, 1)
+
+	if mmEmitEvent.inspectFuncEmitEvent != nil {
+		mmEmitEvent.inspectFuncEmitEvent(ctx, tp1, u1, up1)
+	}
+
+	mm_params := &ProxyImplementationMockEmitEventParams{ctx, tp1, u1, up1}
+
+	// Record call args
+	mmEmitEvent.EmitEventMock.mutex.Lock()
+	mmEmitEvent.EmitEventMock.callArgs = append(mmEmitEvent.EmitEventMock.callArgs, mm_params)
+	mmEmitEvent.EmitEventMock.mutex.Unlock()
+
+	for _, e := range mmEmitEvent.EmitEventMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmEmitEvent.EmitEventMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmEmitEvent.EmitEventMock.defaultExpectation.Counter, 1)
+		mm_want := mmEmitEvent.EmitEventMock.defaultExpectation.params
+		mm_got := ProxyImplementationMockEmitEventParams{ctx, tp1, u1, up1}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmEmitEvent.t.Errorf("ProxyImplementationMock.EmitEvent got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmEmitEvent.EmitEventMock.defaultExpectation.results
+		if mm_results == nil {
+			mmEmitEvent.t.Fatal("No results are set for the ProxyImplementationMock.EmitEvent")
+		}
+		return (*mm_results).err
+	}
+	if mmEmitEvent.funcEmitEvent != nil {
+		return mmEmitEvent.funcEmitEvent(ctx, tp1, u1, up1)
+	}
+	mmEmitEvent.t.Fatalf("Unexpected call to ProxyImplementationMock.EmitEvent. %v %v %v %v", ctx, tp1, u1, up1)
+	return
+}
+
+// EmitEventAfterCounter returns a count of finished ProxyImplementationMock.EmitEvent invocations
+func (mmEmitEvent *ProxyImplementationMock) EmitEventAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmEmitEvent.afterEmitEventCounter)
+}
+
+// EmitEventBeforeCounter returns a count of ProxyImplementationMock.EmitEvent invocations
+func (mmEmitEvent *ProxyImplementationMock) EmitEventBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmEmitEvent.beforeEmitEventCounter)
+}
+
+// Calls returns a list of arguments used in each call to ProxyImplementationMock.EmitEvent.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmEmitEvent *mProxyImplementationMockEmitEvent) Calls() []*ProxyImplementationMockEmitEventParams {
+	mmEmitEvent.mutex.RLock()
+
+	argCopy := make([]*ProxyImplementationMockEmitEventParams, len(mmEmitEvent.callArgs))
+	copy(argCopy, mmEmitEvent.callArgs)
+
+	mmEmitEvent.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockEmitEventDone returns true if the count of the EmitEvent invocations corresponds
+// the number of defined expectations
+func (m *ProxyImplementationMock) MinimockEmitEventDone() bool {
+	for _, e := range m.EmitEventMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.EmitEventMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterEmitEventCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcEmitEvent != nil && mm_atomic.LoadUint64(&m.afterEmitEventCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockEmitEventInspect logs each unmet expectation
+func (m *ProxyImplementationMock) MinimockEmitEventInspect() {
+	for _, e := range m.EmitEventMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ProxyImplementationMock.EmitEvent with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.EmitEventMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterEmitEventCounter) < 1 {
+		if m.EmitEventMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ProxyImplementationMock.EmitEvent")
+		} else {
+			m.t.Errorf("Expected call to ProxyImplementationMock.EmitEvent with params: %#v", *m.EmitEventMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcEmitEvent != nil && mm_atomic.LoadUint64(&m.afterEmitEventCounter) < 1 {
+		m.t.Error("Expected call to ProxyImplementationMock.EmitEvent")
+	}
+}
+
 type mProxyImplementationMockGetCode struct {
 	mock               *ProxyImplementationMock
 	defaultExpectation *ProxyImplementationMockGetCodeExpectation
@@ -356,7 +583,7 @@ func (mmGetCode *mProxyImplementationMockGetCode) Return(err error) *ProxyImplem
 	return mmGetCode.mock
 }
 
-//Set uses given function f to mock the ProxyImplementation.GetCode method
+// Set uses given function f to mock the ProxyImplementation.GetCode method
 func (mmGetCode *mProxyImplementationMockGetCode) Set(f func(ctx context.Context, tp1 *common.Transcript, u1 rpctypes.UpGetCodeReq, up1 *rpctypes.UpGetCodeResp) (err error)) *ProxyImplementationMock {
 	if mmGetCode.defaultExpectation != nil {
 		mmGetCode.mock.t.Fatalf("Default expectation is already set for the ProxyImplementation.GetCode method")
@@ -574,7 +801,7 @@ func (mmRouteCall *mProxyImplementationMockRouteCall) Return(err error) *ProxyIm
 	return mmRouteCall.mock
 }
 
-//Set uses given function f to mock the ProxyImplementation.RouteCall method
+// Set uses given function f to mock the ProxyImplementation.RouteCall method
 func (mmRouteCall *mProxyImplementationMockRouteCall) Set(f func(ctx context.Context, tp1 *common.Transcript, u1 rpctypes.UpRouteReq, up1 *rpctypes.UpRouteResp) (err error)) *ProxyImplementationMock {
 	if mmRouteCall.defaultExpectation != nil {
 		mmRouteCall.mock.t.Fatalf("Default expectation is already set for the ProxyImplementation.RouteCall method")
@@ -792,7 +1019,7 @@ func (mmSaveAsChild *mProxyImplementationMockSaveAsChild) Return(err error) *Pro
 	return mmSaveAsChild.mock
 }
 
-//Set uses given function f to mock the ProxyImplementation.SaveAsChild method
+// Set uses given function f to mock the ProxyImplementation.SaveAsChild method
 func (mmSaveAsChild *mProxyImplementationMockSaveAsChild) Set(f func(ctx context.Context, tp1 *common.Transcript, u1 rpctypes.UpSaveAsChildReq, up1 *rpctypes.UpSaveAsChildResp) (err error)) *ProxyImplementationMock {
 	if mmSaveAsChild.defaultExpectation != nil {
 		mmSaveAsChild.mock.t.Fatalf("Default expectation is already set for the ProxyImplementation.SaveAsChild method")
@@ -941,6 +1168,8 @@ func (m *ProxyImplementationMock) MinimockFinish() {
 	if !m.minimockDone() {
 		m.MinimockDeactivateObjectInspect()
 
+		m.MinimockEmitEventInspect()
+
 		m.MinimockGetCodeInspect()
 
 		m.MinimockRouteCallInspect()
@@ -970,6 +1199,7 @@ func (m *ProxyImplementationMock) minimockDone() bool {
 	done := true
 	return done &&
 		m.MinimockDeactivateObjectDone() &&
+		m.MinimockEmitEventDone() &&
 		m.MinimockGetCodeDone() &&
 		m.MinimockRouteCallDone() &&
 		m.MinimockSaveAsChildDone()
@@ -19,6 +19,8 @@ type RequestResult struct {
 	ObjectImage     insolar.Reference // amend + activate
 	ObjectStateID   insolar.ID        // amend + deactivate
 	Memory          []byte            // amend + activate
+
+	EmittedEvents []artifacts.ContractEvent // every
 }
 
 func New(result []byte, objectRef insolar.Reference) *RequestResult {
@@ -74,3 +76,11 @@ func (s RequestResult) Type() artifacts.RequestResultType {
 func (s *RequestResult) ObjectReference() insolar.Reference {
 	return s.RawObjectReference
 }
+
+func (s *RequestResult) Events() []artifacts.ContractEvent {
+	return s.EmittedEvents
+}
+
+func (s *RequestResult) SetEvents(events []artifacts.ContractEvent) {
+	s.EmittedEvents = events
+}
@@ -28,10 +28,12 @@ type ProxyImplementation interface {
 	RouteCall(context.Context, *common.Transcript, rpctypes.UpRouteReq, *rpctypes.UpRouteResp) error
 	SaveAsChild(context.Context, *common.Transcript, rpctypes.UpSaveAsChildReq, *rpctypes.UpSaveAsChildResp) error
 	DeactivateObject(context.Context, *common.Transcript, rpctypes.UpDeactivateObjectReq, *rpctypes.UpDeactivateObjectResp) error
+	EmitEvent(context.Context, *common.Transcript, rpctypes.UpEmitEventReq, *rpctypes.UpEmitEventResp) error
 }
 
 type RPCMethods struct {
 	ss         StateStorage
+	validator  Validator
 	execution  ProxyImplementation
 	validation ProxyImplementation
 }
@@ -46,9 +48,11 @@ func NewRPCMethods(
 	cr insolar.ContractRequester,
 	ss StateStorage,
 	outgoingSender OutgoingRequestSender,
+	validator Validator,
 ) *RPCMethods {
 	return &RPCMethods{
 		ss:         ss,
+		validator:  validator,
 		execution:  NewExecutionProxyImplementation(dc, cr, am, outgoingSender),
 		validation: NewValidationProxyImplementation(dc),
 	}
@@ -72,6 +76,13 @@ func (m *RPCMethods) getCurrent(
 		}
 
 		return m.execution, transcript, nil
+	case insolar.ValidateCallMode:
+		transcript := m.validator.GetActiveTranscript(reqRef)
+		if transcript == nil {
+			return nil, nil, errors.New("No transcript in the validator")
+		}
+
+		return m.validation, transcript, nil
 	default:
 		panic("not implemented")
 	}
@@ -117,6 +128,16 @@ func (m *RPCMethods) DeactivateObject(req rpctypes.UpDeactivateObjectReq, rep *r
 	return impl.DeactivateObject(current.Context, current, req, rep)
 }
 
+// EmitEvent is an RPC saving event emitted by a contract, event is registered together with the result of the request
+func (m *RPCMethods) EmitEvent(req rpctypes.UpEmitEventReq, rep *rpctypes.UpEmitEventResp) error {
+	impl, current, err := m.getCurrent(req.Callee, req.Mode, req.Request)
+	if err != nil {
+		return errors.Wrap(err, "Failed to fetch current execution")
+	}
+
+	return impl.EmitEvent(current.Context, current, req, rep)
+}
+
 type executionProxyImplementation struct {
 	dc             artifacts.DescriptorsCache
 	cr             insolar.ContractRequester
@@ -196,6 +217,8 @@ func (m *executionProxyImplementation) RouteCall(
 
 	logger.Debug("registered outgoing request")
 
+	current.OutgoingRequestRefs = append(current.OutgoingRequestRefs, *getRequestReference(outReqInfo))
+
 	if req.Saga {
 		// Saga methods are not executed right away. LME will send a method
 		// to the VE when current object finishes the execution and validation.
@@ -265,6 +288,8 @@ func (m *executionProxyImplementation) SaveAsChild(
 
 	logger.Debug("registered outgoing request")
 
+	current.OutgoingRequestRefs = append(current.OutgoingRequestRefs, *getRequestReference(outReqInfo))
+
 	// if we replay abandoned request after node was down we can already have Result
 	if outReqInfo.Result != nil {
 		returns, err := unwrapResult(ctx, outReqInfo.Result)
@@ -301,6 +326,14 @@ func (m *executionProxyImplementation) DeactivateObject(
 	return nil
 }
 
+func (m *executionProxyImplementation) EmitEvent(
+	ctx context.Context, current *common.Transcript, req rpctypes.UpEmitEventReq, rep *rpctypes.UpEmitEventResp,
+) error {
+	inslogger.FromContext(ctx).Debug("contract emitting event ", req.Topic)
+
+	return addEvent(current, req)
+}
+
 type validationProxyImplementation struct {
 	dc artifacts.DescriptorsCache
 }
@@ -345,6 +378,9 @@ func (m *validationProxyImplementation) RouteCall(
 	if reqRes.Error != nil {
 		return reqRes.Error
 	}
+	if req.Saga {
+		return nil
+	}
 
 	rep.Result = reqRes.Response
 
@@ -365,6 +401,8 @@ func (m *validationProxyImplementation) SaveAsChild(
 		return reqRes.Error
 	}
 
+	rep.Result = reqRes.Response
+
 	return nil
 }
 
@@ -377,6 +415,28 @@ func (m *validationProxyImplementation) DeactivateObject(
 	return nil
 }
 
+func (m *validationProxyImplementation) EmitEvent(
+	ctx context.Context, current *common.Transcript, req rpctypes.UpEmitEventReq, rep *rpctypes.UpEmitEventResp,
+) error {
+	return addEvent(current, req)
+}
+
+func addEvent(current *common.Transcript, req rpctypes.UpEmitEventReq) error {
+	if req.Topic == "" {
+		return errors.New("event topic is empty")
+	}
+	if current.LogicContext == nil || current.LogicContext.Prototype == nil {
+		return errors.New("no prototype in the logic context of the transcript")
+	}
+
+	current.Events = append(current.Events, artifacts.ContractEvent{
+		Prototype: *current.LogicContext.Prototype,
+		Topic:     req.Topic,
+		Payload:   req.Payload,
+	})
+	return nil
+}
+
 func buildIncomingRequestFromOutgoing(outgoing *record.OutgoingRequest) *record.IncomingRequest {
 	// Currently IncomingRequest and OutgoingRequest are almost exact copies of each other
 	// thus the following code is a bit ugly. However this will change when we'll
@@ -35,6 +35,7 @@ func TestRPCMethods_New(t *testing.T) {
 		testutils.NewContractRequesterMock(t),
 		NewStateStorageMock(t),
 		NewOutgoingRequestSenderMock(t),
+		NewValidatorMock(t),
 	)
 	require.NotNil(t, m)
 }
@@ -79,7 +80,8 @@ func TestRPCMethods_DeactivateObject(t *testing.T) {
 		DeactivateObjectMock.Return(nil).
 		GetCodeMock.Return(nil).
 		RouteCallMock.Return(nil).
-		SaveAsChildMock.Return(nil)
+		SaveAsChildMock.Return(nil).
+		EmitEventMock.Return(nil)
 
 	table := []struct {
 		name string
@@ -121,6 +123,15 @@ func TestRPCMethods_DeactivateObject(t *testing.T) {
 				)
 			},
 		},
+		{
+			name: "event",
+			f: func(baseReq rpctypes.UpBaseReq) error {
+				return m.EmitEvent(
+					rpctypes.UpEmitEventReq{UpBaseReq: baseReq},
+					&rpctypes.UpEmitEventResp{},
+				)
+			},
+		},
 	}
 
 	for _, test := range table {
@@ -254,6 +265,56 @@ func TestValidationProxyImplementation_DeactivateObject(t *testing.T) {
 	}
 }
 
+func TestExecutionProxyImplementation_EmitEvent(t *testing.T) {
+	defer testutils.LeakTester(t)
+
+	ctx := inslogger.TestContext(t)
+	prototype := gen.Reference()
+
+	table := []struct {
+		name       string
+		transcript *common.Transcript
+		req        rpctypes.UpEmitEventReq
+		error      bool
+	}{
+		{
+			name:       "success",
+			transcript: &common.Transcript{LogicContext: &insolar.LogicCallContext{Prototype: &prototype}},
+			req:        rpctypes.UpEmitEventReq{Topic: "created", Payload: []byte{1}},
+		},
+		{
+			name:       "empty topic",
+			transcript: &common.Transcript{LogicContext: &insolar.LogicCallContext{Prototype: &prototype}},
+			req:        rpctypes.UpEmitEventReq{Payload: []byte{1}},
+			error:      true,
+		},
+		{
+			name:       "no logic context",
+			transcript: &common.Transcript{},
+			req:        rpctypes.UpEmitEventReq{Topic: "created", Payload: []byte{1}},
+			error:      true,
+		},
+	}
+
+	for _, test := range table {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			impl := &executionProxyImplementation{}
+			result := rpctypes.UpEmitEventResp{}
+			err := impl.EmitEvent(ctx, test.transcript, test.req, &result)
+			if !test.error {
+				require.NoError(t, err)
+				require.Equal(t, []artifacts.ContractEvent{
+					{Prototype: prototype, Topic: test.req.Topic, Payload: test.req.Payload},
+				}, test.transcript.Events)
+			} else {
+				require.Error(t, err)
+				require.Empty(t, test.transcript.Events)
+			}
+		})
+	}
+}
+
 func TestValidationProxyImplementation_RouteCall(t *testing.T) {
 	defer testutils.LeakTester(t)
 
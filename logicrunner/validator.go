@@ -0,0 +1,381 @@
+// Copyright 2020 Insolar Network Ltd.
+// All rights reserved.
+// This material is licensed under the Insolar License version 1.0,
+// available at https://github.com/insolar/insolar/blob/master/LICENSE.md.
+
+package logicrunner
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.opencensus.io/stats"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/bus"
+	"github.com/insolar/insolar/insolar/jet"
+	"github.com/insolar/insolar/insolar/payload"
+	"github.com/insolar/insolar/insolar/pulse"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/instrumentation/insmetrics"
+	"github.com/insolar/insolar/logicrunner/artifacts"
+	"github.com/insolar/insolar/logicrunner/common"
+	"github.com/insolar/insolar/logicrunner/metrics"
+)
+
+//go:generate minimock -i github.com/insolar/insolar/logicrunner.Validator -o ./ -s _mock.go -g
+
+// Validator re-executes requests of executors and compares results with the registered on ledger.
+type Validator interface {
+	insolar.ValidationJournal
+
+	// RequestValidation sends executed request to validators of the object.
+	RequestValidation(ctx context.Context, transcript *common.Transcript, res artifacts.RequestResult)
+	// Validate re-executes the request of executor and records result of validation.
+	Validate(ctx context.Context, executor insolar.Reference, msg payload.ValidateRequest) insolar.ValidationResult
+	// GetActiveTranscript returns transcript of the request, that is validated right now.
+	GetActiveTranscript(reqRef insolar.Reference) *common.Transcript
+}
+
+// MisbehaviorReporter keeps reports about misbehavior of nodes, that is found out of consensus.
+type MisbehaviorReporter interface {
+	ReportMisbehavior(ctx context.Context, node insolar.Reference, details string) error
+}
+
+type validator struct {
+	am          artifacts.Client
+	executor    RequestsExecutor
+	sender      bus.Sender
+	coordinator jet.Coordinator
+	pulses      pulse.Accessor
+	pcs         insolar.PlatformCryptographyScheme
+	reporter    MisbehaviorReporter
+	journalSize int
+
+	lock    sync.RWMutex
+	active  map[insolar.Reference]*common.Transcript
+	journal []insolar.ValidationResult
+}
+
+func newValidator(
+	am artifacts.Client,
+	executor RequestsExecutor,
+	sender bus.Sender,
+	coordinator jet.Coordinator,
+	pulses pulse.Accessor,
+	pcs insolar.PlatformCryptographyScheme,
+	reporter MisbehaviorReporter,
+	journalSize int,
+) *validator {
+	return &validator{
+		am:          am,
+		executor:    executor,
+		sender:      sender,
+		coordinator: coordinator,
+		pulses:      pulses,
+		pcs:         pcs,
+		reporter:    reporter,
+		journalSize: journalSize,
+		active:      make(map[insolar.Reference]*common.Transcript),
+	}
+}
+
+func (v *validator) RequestValidation(ctx context.Context, transcript *common.Transcript, res artifacts.RequestResult) {
+	if transcript.Request.Immutable {
+		return
+	}
+
+	objectRef := res.ObjectReference()
+	logger := inslogger.FromContext(ctx).WithField("object", objectRef.String())
+
+	msg := &payload.ValidateRequest{
+		ObjectRef:           objectRef,
+		RequestRef:          transcript.RequestRef,
+		OutgoingRequestRefs: transcript.OutgoingRequestRefs,
+	}
+	if desc := transcript.ObjectDescriptor; desc != nil {
+		msg.PrevStateID = desc.StateID()
+		if parent := desc.Parent(); parent != nil {
+			msg.Parent = *parent
+		}
+	}
+
+	latest, err := v.pulses.Latest(ctx)
+	if err != nil {
+		logger.Error("failed to get latest pulse: ", err)
+		return
+	}
+	validators, err := v.coordinator.VirtualValidatorsForObject(ctx, *objectRef.GetLocal(), latest.PulseNumber)
+	if err != nil {
+		logger.Error("failed to calculate validators: ", err)
+		return
+	}
+
+	me := v.coordinator.Me()
+	for _, node := range validators {
+		if node.Equal(me) {
+			continue
+		}
+
+		wmMsg, err := payload.NewMessage(msg)
+		if err != nil {
+			logger.Error("failed to create ValidateRequest message: ", err)
+			return
+		}
+		_, done := v.sender.SendTarget(ctx, wmMsg, node)
+		done()
+		stats.Record(ctx, metrics.ValidationRequested.M(1))
+	}
+}
+
+func (v *validator) Validate(
+	ctx context.Context, executor insolar.Reference, msg payload.ValidateRequest,
+) insolar.ValidationResult {
+	start := time.Now()
+	logger := inslogger.FromContext(ctx)
+
+	res := insolar.ValidationResult{
+		Time:     start.UTC(),
+		Object:   msg.ObjectRef.String(),
+		Request:  msg.RequestRef.String(),
+		Executor: executor.String(),
+		Status:   insolar.ValidationValid,
+	}
+
+	pn, mismatch, err := v.validate(ctx, msg)
+	res.Pulse = pn
+	switch {
+	case err != nil:
+		res.Status = insolar.ValidationFailed
+		res.Details = err.Error()
+		logger.Warn("failed to validate request: ", err)
+	case mismatch != "":
+		res.Status = insolar.ValidationMismatch
+		res.Details = mismatch
+		logger.Errorf("validation of request differs from executor %s: %s", executor, mismatch)
+	}
+
+	mctx := insmetrics.InsertTag(ctx, metrics.TagValidationStatus, string(res.Status))
+	stats.Record(mctx,
+		metrics.ValidationResults.M(1),
+		metrics.ValidationTiming.M(float64(time.Since(start).Nanoseconds())/1e6),
+	)
+
+	v.record(res)
+
+	if res.Status == insolar.ValidationMismatch && v.reporter != nil {
+		details := fmt.Sprintf("validation of request %s: %s", msg.RequestRef, mismatch)
+		if err := v.reporter.ReportMisbehavior(ctx, executor, details); err != nil {
+			logger.Warn("failed to report misbehavior: ", err)
+		}
+	}
+
+	return res
+}
+
+// validate re-executes the request. It returns pulse of the registered result and description of the difference
+// between validator and executor, it's empty if results are the same.
+func (v *validator) validate(ctx context.Context, msg payload.ValidateRequest) (insolar.PulseNumber, string, error) {
+	request, err := v.am.GetRequest(ctx, msg.ObjectRef, msg.RequestRef)
+	if err != nil {
+		return 0, "", errors.Wrap(err, "failed to get request")
+	}
+	incoming, ok := request.(*record.IncomingRequest)
+	if !ok {
+		return 0, "", errors.Errorf("unexpected request type %T", request)
+	}
+
+	resultRec, err := v.am.GetResult(ctx, msg.ObjectRef, msg.RequestRef)
+	if err != nil {
+		return 0, "", errors.Wrap(err, "failed to get result")
+	}
+	result := record.Unwrap(&resultRec.Virtual).(*record.Result)
+	pn := resultRec.ID.Pulse()
+
+	transcript := common.NewTranscriptCloneContext(ctx, msg.RequestRef, *incoming)
+	transcript.Mode = insolar.ValidateCallMode
+
+	err = v.addOutgoingRequests(ctx, transcript, msg)
+	if err != nil {
+		return pn, "", err
+	}
+
+	if incoming.CallType == record.CTMethod {
+		if msg.PrevStateID == nil {
+			return pn, "", errors.New("previous state of the object is not set")
+		}
+		transcript.ObjectDescriptor, err = v.getObject(ctx, msg.ObjectRef, *msg.PrevStateID, msg.Parent)
+		if err != nil {
+			return pn, "", err
+		}
+	}
+
+	if err := v.register(transcript); err != nil {
+		return pn, "", err
+	}
+	defer v.unregister(transcript)
+
+	res, err := v.executor.Execute(transcript.Context, transcript)
+	if err != nil {
+		return pn, "", errors.Wrap(err, "failed to execute request")
+	}
+
+	if !bytes.Equal(res.Result(), result.Payload) {
+		return pn, "result differs from registered", nil
+	}
+
+	// state and event records are identified by hash, so the same records are registered only if validator got
+	// the same state and emitted the same events
+	if sideEffect := artifacts.SideEffectRecord(msg.RequestRef, res); sideEffect != nil {
+		hash := record.HashVirtual(v.pcs.ReferenceHasher(), record.Wrap(sideEffect))
+		_, err = v.am.GetState(ctx, msg.ObjectRef, *insolar.NewID(pn, hash))
+		if err == insolar.ErrNotFound {
+			return pn, fmt.Sprintf("%s state differs from registered", res.Type()), nil
+		}
+		if err != nil {
+			return pn, "", errors.Wrap(err, "failed to get state")
+		}
+	}
+
+	// events are numbered, so executor can't register different events without mismatch of some of them, but
+	// extra events of executor over the ones of validator aren't found out, because their count isn't registered
+	for _, event := range artifacts.EventRecords(msg.RequestRef, res) {
+		hash := record.HashVirtual(v.pcs.ReferenceHasher(), record.Wrap(event))
+		_, err = v.am.GetEvent(ctx, msg.ObjectRef, *insolar.NewID(pn, hash))
+		if err == insolar.ErrNotFound {
+			return pn, fmt.Sprintf("event %d differs from registered", event.Index), nil
+		}
+		if err != nil {
+			return pn, "", errors.Wrap(err, "failed to get event")
+		}
+	}
+
+	return pn, "", nil
+}
+
+// addOutgoingRequests fills transcript with outgoing requests of executor and their results, validator doesn't make
+// outgoing calls and replays them instead.
+func (v *validator) addOutgoingRequests(
+	ctx context.Context, transcript *common.Transcript, msg payload.ValidateRequest,
+) error {
+	for _, reqRef := range msg.OutgoingRequestRefs {
+		request, err := v.am.GetRequest(ctx, msg.ObjectRef, reqRef)
+		if err != nil {
+			return errors.Wrap(err, "failed to get outgoing request")
+		}
+		outgoing, ok := request.(*record.OutgoingRequest)
+		if !ok {
+			return errors.Errorf("unexpected outgoing request type %T", request)
+		}
+		incoming := buildIncomingRequestFromOutgoing(outgoing)
+
+		// saga calls are executed after the request, they have no result yet
+		if outgoing.ReturnMode == record.ReturnSaga {
+			transcript.AddOutgoingRequest(ctx, *incoming, nil, nil)
+			continue
+		}
+
+		resultRec, err := v.am.GetResult(ctx, msg.ObjectRef, reqRef)
+		if err == insolar.ErrNotFound {
+			transcript.AddOutgoingRequest(ctx, *incoming, nil, errors.New("outgoing request has no result"))
+			continue
+		}
+		if err != nil {
+			return errors.Wrap(err, "failed to get result of outgoing request")
+		}
+		result := record.Unwrap(&resultRec.Virtual).(*record.Result)
+		transcript.AddOutgoingRequest(ctx, *incoming, result.Payload, nil)
+	}
+	return nil
+}
+
+func (v *validator) getObject(
+	ctx context.Context, objectRef insolar.Reference, stateID insolar.ID, parent insolar.Reference,
+) (artifacts.ObjectDescriptor, error) {
+	stateRec, err := v.am.GetState(ctx, objectRef, stateID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get previous state of the object")
+	}
+	state := record.Unwrap(&stateRec.Virtual).(record.State)
+	if _, ok := state.(*record.Deactivate); ok {
+		return nil, errors.New("object is deactivated")
+	}
+
+	return artifacts.NewObjectDescriptor(objectRef, stateRec.ID, state.GetImage(), state.GetMemory(), parent), nil
+}
+
+func (v *validator) register(transcript *common.Transcript) error {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+
+	if _, ok := v.active[transcript.RequestRef]; ok {
+		return errors.New("request is already being validated")
+	}
+	v.active[transcript.RequestRef] = transcript
+	return nil
+}
+
+func (v *validator) unregister(transcript *common.Transcript) {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+
+	delete(v.active, transcript.RequestRef)
+}
+
+func (v *validator) GetActiveTranscript(reqRef insolar.Reference) *common.Transcript {
+	v.lock.RLock()
+	defer v.lock.RUnlock()
+
+	return v.active[reqRef]
+}
+
+func (v *validator) record(res insolar.ValidationResult) {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+
+	v.journal = append([]insolar.ValidationResult{res}, v.journal...)
+	if len(v.journal) > v.journalSize {
+		v.journal = v.journal[:v.journalSize]
+	}
+}
+
+func (v *validator) ValidationResults() []insolar.ValidationResult {
+	v.lock.RLock()
+	defer v.lock.RUnlock()
+
+	res := make([]insolar.ValidationResult, len(v.journal))
+	copy(res, v.journal)
+	return res
+}
+
+// validatingRequestsExecutor sends requests to validation after their results are saved.
+type validatingRequestsExecutor struct {
+	RequestsExecutor
+	validator Validator
+}
+
+func newValidatingRequestsExecutor(executor RequestsExecutor, validator Validator) RequestsExecutor {
+	return &validatingRequestsExecutor{
+		RequestsExecutor: executor,
+		validator:        validator,
+	}
+}
+
+func (e *validatingRequestsExecutor) ExecuteAndSave(
+	ctx context.Context, transcript *common.Transcript,
+) (
+	artifacts.RequestResult, error,
+) {
+	res, err := e.RequestsExecutor.ExecuteAndSave(ctx, transcript)
+	if err != nil {
+		return nil, err
+	}
+
+	e.validator.RequestValidation(ctx, transcript, res)
+	return res, nil
+}
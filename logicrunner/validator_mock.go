@@ -0,0 +1,869 @@
+package logicrunner
+
+// Code generated by http://github.com/gojuno/minimock (dev). DO NOT EDIT.
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/payload"
+	"github.com/insolar/insolar/logicrunner/artifacts"
+	"github.com/insolar/insolar/logicrunner/common"
+)
+
+// ValidatorMock implements Validator
+type ValidatorMock struct {
+	t minimock.Tester
+
+	funcGetActiveTranscript          func(reqRef insolar.Reference) (tp1 *common.Transcript)
+	inspectFuncGetActiveTranscript   func(reqRef insolar.Reference)
+	afterGetActiveTranscriptCounter  uint64
+	beforeGetActiveTranscriptCounter uint64
+	GetActiveTranscriptMock          mValidatorMockGetActiveTranscript
+
+	funcRequestValidation          func(ctx context.Context, transcript *common.Transcript, res artifacts.RequestResult)
+	inspectFuncRequestValidation   func(ctx context.Context, transcript *common.Transcript, res artifacts.RequestResult)
+	afterRequestValidationCounter  uint64
+	beforeRequestValidationCounter uint64
+	RequestValidationMock          mValidatorMockRequestValidation
+
+	funcValidate          func(ctx context.Context, executor insolar.Reference, msg payload.ValidateRequest) (v1 insolar.ValidationResult)
+	inspectFuncValidate   func(ctx context.Context, executor insolar.Reference, msg payload.ValidateRequest)
+	afterValidateCounter  uint64
+	beforeValidateCounter uint64
+	ValidateMock          mValidatorMockValidate
+
+	funcValidationResults          func() (va1 []insolar.ValidationResult)
+	inspectFuncValidationResults   func()
+	afterValidationResultsCounter  uint64
+	beforeValidationResultsCounter uint64
+	ValidationResultsMock          mValidatorMockValidationResults
+}
+
+// NewValidatorMock returns a mock for Validator
+func NewValidatorMock(t minimock.Tester) *ValidatorMock {
+	m := &ValidatorMock{t: t}
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.GetActiveTranscriptMock = mValidatorMockGetActiveTranscript{mock: m}
+	m.GetActiveTranscriptMock.callArgs = []*ValidatorMockGetActiveTranscriptParams{}
+
+	m.RequestValidationMock = mValidatorMockRequestValidation{mock: m}
+	m.RequestValidationMock.callArgs = []*ValidatorMockRequestValidationParams{}
+
+	m.ValidateMock = mValidatorMockValidate{mock: m}
+	m.ValidateMock.callArgs = []*ValidatorMockValidateParams{}
+
+	m.ValidationResultsMock = mValidatorMockValidationResults{mock: m}
+
+	return m
+}
+
+type mValidatorMockGetActiveTranscript struct {
+	mock               *ValidatorMock
+	defaultExpectation *ValidatorMockGetActiveTranscriptExpectation
+	expectations       []*ValidatorMockGetActiveTranscriptExpectation
+
+	callArgs []*ValidatorMockGetActiveTranscriptParams
+	mutex    sync.RWMutex
+}
+
+// ValidatorMockGetActiveTranscriptExpectation specifies expectation struct of the Validator.GetActiveTranscript
+type ValidatorMockGetActiveTranscriptExpectation struct {
+	mock    *ValidatorMock
+	params  *ValidatorMockGetActiveTranscriptParams
+	results *ValidatorMockGetActiveTranscriptResults
+	Counter uint64
+}
+
+// ValidatorMockGetActiveTranscriptParams contains parameters of the Validator.GetActiveTranscript
+type ValidatorMockGetActiveTranscriptParams struct {
+	reqRef insolar.Reference
+}
+
+// ValidatorMockGetActiveTranscriptResults contains results of the Validator.GetActiveTranscript
+type ValidatorMockGetActiveTranscriptResults struct {
+	tp1 *common.Transcript
+}
+
+// Expect sets up expected params for Validator.GetActiveTranscript
+func (mmGetActiveTranscript *mValidatorMockGetActiveTranscript) Expect(reqRef insolar.Reference) *mValidatorMockGetActiveTranscript {
+	if mmGetActiveTranscript.mock.funcGetActiveTranscript != nil {
+		mmGetActiveTranscript.mock.t.Fatalf("ValidatorMock.GetActiveTranscript mock is already set by Set")
+	}
+
+	if mmGetActiveTranscript.defaultExpectation == nil {
+		mmGetActiveTranscript.defaultExpectation = &ValidatorMockGetActiveTranscriptExpectation{}
+	}
+
+	mmGetActiveTranscript.defaultExpectation.params = &ValidatorMockGetActiveTranscriptParams{reqRef}
+	for _, e := range mmGetActiveTranscript.expectations {
+		if minimock.Equal(e.params, mmGetActiveTranscript.defaultExpectation.params) {
+			mmGetActiveTranscript.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetActiveTranscript.defaultExpectation.params)
+		}
+	}
+
+	return mmGetActiveTranscript
+}
+
+// Inspect accepts an inspector function that has same arguments as the Validator.GetActiveTranscript
+func (mmGetActiveTranscript *mValidatorMockGetActiveTranscript) Inspect(f func(reqRef insolar.Reference)) *mValidatorMockGetActiveTranscript {
+	if mmGetActiveTranscript.mock.inspectFuncGetActiveTranscript != nil {
+		mmGetActiveTranscript.mock.t.Fatalf("Inspect function is already set for ValidatorMock.GetActiveTranscript")
+	}
+
+	mmGetActiveTranscript.mock.inspectFuncGetActiveTranscript = f
+
+	return mmGetActiveTranscript
+}
+
+// Return sets up results that will be returned by Validator.GetActiveTranscript
+func (mmGetActiveTranscript *mValidatorMockGetActiveTranscript) Return(tp1 *common.Transcript) *ValidatorMock {
+	if mmGetActiveTranscript.mock.funcGetActiveTranscript != nil {
+		mmGetActiveTranscript.mock.t.Fatalf("ValidatorMock.GetActiveTranscript mock is already set by Set")
+	}
+
+	if mmGetActiveTranscript.defaultExpectation == nil {
+		mmGetActiveTranscript.defaultExpectation = &ValidatorMockGetActiveTranscriptExpectation{mock: mmGetActiveTranscript.mock}
+	}
+	mmGetActiveTranscript.defaultExpectation.results = &ValidatorMockGetActiveTranscriptResults{tp1}
+	return mmGetActiveTranscript.mock
+}
+
+// Set uses given function f to mock the Validator.GetActiveTranscript method
+func (mmGetActiveTranscript *mValidatorMockGetActiveTranscript) Set(f func(reqRef insolar.Reference) (tp1 *common.Transcript)) *ValidatorMock {
+	if mmGetActiveTranscript.defaultExpectation != nil {
+		mmGetActiveTranscript.mock.t.Fatalf("Default expectation is already set for the Validator.GetActiveTranscript method")
+	}
+
+	if len(mmGetActiveTranscript.expectations) > 0 {
+		mmGetActiveTranscript.mock.t.Fatalf("Some expectations are already set for the Validator.GetActiveTranscript method")
+	}
+
+	mmGetActiveTranscript.mock.funcGetActiveTranscript = f
+	return mmGetActiveTranscript.mock
+}
+
+// When sets expectation for the Validator.GetActiveTranscript which will trigger the result defined by the following
+// Then helper
+func (mmGetActiveTranscript *mValidatorMockGetActiveTranscript) When(reqRef insolar.Reference) *ValidatorMockGetActiveTranscriptExpectation {
+	if mmGetActiveTranscript.mock.funcGetActiveTranscript != nil {
+		mmGetActiveTranscript.mock.t.Fatalf("ValidatorMock.GetActiveTranscript mock is already set by Set")
+	}
+
+	expectation := &ValidatorMockGetActiveTranscriptExpectation{
+		mock:   mmGetActiveTranscript.mock,
+		params: &ValidatorMockGetActiveTranscriptParams{reqRef},
+	}
+	mmGetActiveTranscript.expectations = append(mmGetActiveTranscript.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Validator.GetActiveTranscript return parameters for the expectation previously defined by the When method
+func (e *ValidatorMockGetActiveTranscriptExpectation) Then(tp1 *common.Transcript) *ValidatorMock {
+	e.results = &ValidatorMockGetActiveTranscriptResults{tp1}
+	return e.mock
+}
+
+// GetActiveTranscript implements Validator
+func (mmGetActiveTranscript *ValidatorMock) GetActiveTranscript(reqRef insolar.Reference) (tp1 *common.Transcript) {
+	mm_atomic.AddUint64(&mmGetActiveTranscript.beforeGetActiveTranscriptCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetActiveTranscript.afterGetActiveTranscriptCounter, 1)
+
+	if mmGetActiveTranscript.inspectFuncGetActiveTranscript != nil {
+		mmGetActiveTranscript.inspectFuncGetActiveTranscript(reqRef)
+	}
+
+	mm_params := &ValidatorMockGetActiveTranscriptParams{reqRef}
+
+	// Record call args
+	mmGetActiveTranscript.GetActiveTranscriptMock.mutex.Lock()
+	mmGetActiveTranscript.GetActiveTranscriptMock.callArgs = append(mmGetActiveTranscript.GetActiveTranscriptMock.callArgs, mm_params)
+	mmGetActiveTranscript.GetActiveTranscriptMock.mutex.Unlock()
+
+	for _, e := range mmGetActiveTranscript.GetActiveTranscriptMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.tp1
+		}
+	}
+
+	if mmGetActiveTranscript.GetActiveTranscriptMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetActiveTranscript.GetActiveTranscriptMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetActiveTranscript.GetActiveTranscriptMock.defaultExpectation.params
+		mm_got := ValidatorMockGetActiveTranscriptParams{reqRef}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetActiveTranscript.t.Errorf("ValidatorMock.GetActiveTranscript got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetActiveTranscript.GetActiveTranscriptMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetActiveTranscript.t.Fatal("No results are set for the ValidatorMock.GetActiveTranscript")
+		}
+		return (*mm_results).tp1
+	}
+	if mmGetActiveTranscript.funcGetActiveTranscript != nil {
+		return mmGetActiveTranscript.funcGetActiveTranscript(reqRef)
+	}
+	mmGetActiveTranscript.t.Fatalf("Unexpected call to ValidatorMock.GetActiveTranscript. %v", reqRef)
+	return
+}
+
+// GetActiveTranscriptAfterCounter returns a count of finished ValidatorMock.GetActiveTranscript invocations
+func (mmGetActiveTranscript *ValidatorMock) GetActiveTranscriptAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetActiveTranscript.afterGetActiveTranscriptCounter)
+}
+
+// GetActiveTranscriptBeforeCounter returns a count of ValidatorMock.GetActiveTranscript invocations
+func (mmGetActiveTranscript *ValidatorMock) GetActiveTranscriptBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetActiveTranscript.beforeGetActiveTranscriptCounter)
+}
+
+// Calls returns a list of arguments used in each call to ValidatorMock.GetActiveTranscript.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetActiveTranscript *mValidatorMockGetActiveTranscript) Calls() []*ValidatorMockGetActiveTranscriptParams {
+	mmGetActiveTranscript.mutex.RLock()
+
+	argCopy := make([]*ValidatorMockGetActiveTranscriptParams, len(mmGetActiveTranscript.callArgs))
+	copy(argCopy, mmGetActiveTranscript.callArgs)
+
+	mmGetActiveTranscript.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetActiveTranscriptDone returns true if the count of the GetActiveTranscript invocations corresponds
+// the number of defined expectations
+func (m *ValidatorMock) MinimockGetActiveTranscriptDone() bool {
+	for _, e := range m.GetActiveTranscriptMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetActiveTranscriptMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetActiveTranscriptCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetActiveTranscript != nil && mm_atomic.LoadUint64(&m.afterGetActiveTranscriptCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockGetActiveTranscriptInspect logs each unmet expectation
+func (m *ValidatorMock) MinimockGetActiveTranscriptInspect() {
+	for _, e := range m.GetActiveTranscriptMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ValidatorMock.GetActiveTranscript with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetActiveTranscriptMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetActiveTranscriptCounter) < 1 {
+		if m.GetActiveTranscriptMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ValidatorMock.GetActiveTranscript")
+		} else {
+			m.t.Errorf("Expected call to ValidatorMock.GetActiveTranscript with params: %#v", *m.GetActiveTranscriptMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetActiveTranscript != nil && mm_atomic.LoadUint64(&m.afterGetActiveTranscriptCounter) < 1 {
+		m.t.Error("Expected call to ValidatorMock.GetActiveTranscript")
+	}
+}
+
+type mValidatorMockRequestValidation struct {
+	mock               *ValidatorMock
+	defaultExpectation *ValidatorMockRequestValidationExpectation
+	expectations       []*ValidatorMockRequestValidationExpectation
+
+	callArgs []*ValidatorMockRequestValidationParams
+	mutex    sync.RWMutex
+}
+
+// ValidatorMockRequestValidationExpectation specifies expectation struct of the Validator.RequestValidation
+type ValidatorMockRequestValidationExpectation struct {
+	mock   *ValidatorMock
+	params *ValidatorMockRequestValidationParams
+
+	Counter uint64
+}
+
+// ValidatorMockRequestValidationParams contains parameters of the Validator.RequestValidation
+type ValidatorMockRequestValidationParams struct {
+	ctx        context.Context
+	transcript *common.Transcript
+	res        artifacts.RequestResult
+}
+
+// Expect sets up expected params for Validator.RequestValidation
+func (mmRequestValidation *mValidatorMockRequestValidation) Expect(ctx context.Context, transcript *common.Transcript, res artifacts.RequestResult) *mValidatorMockRequestValidation {
+	if mmRequestValidation.mock.funcRequestValidation != nil {
+		mmRequestValidation.mock.t.Fatalf("ValidatorMock.RequestValidation mock is already set by Set")
+	}
+
+	if mmRequestValidation.defaultExpectation == nil {
+		mmRequestValidation.defaultExpectation = &ValidatorMockRequestValidationExpectation{}
+	}
+
+	mmRequestValidation.defaultExpectation.params = &ValidatorMockRequestValidationParams{ctx, transcript, res}
+	for _, e := range mmRequestValidation.expectations {
+		if minimock.Equal(e.params, mmRequestValidation.defaultExpectation.params) {
+			mmRequestValidation.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRequestValidation.defaultExpectation.params)
+		}
+	}
+
+	return mmRequestValidation
+}
+
+// Inspect accepts an inspector function that has same arguments as the Validator.RequestValidation
+func (mmRequestValidation *mValidatorMockRequestValidation) Inspect(f func(ctx context.Context, transcript *common.Transcript, res artifacts.RequestResult)) *mValidatorMockRequestValidation {
+	if mmRequestValidation.mock.inspectFuncRequestValidation != nil {
+		mmRequestValidation.mock.t.Fatalf("Inspect function is already set for ValidatorMock.RequestValidation")
+	}
+
+	mmRequestValidation.mock.inspectFuncRequestValidation = f
+
+	return mmRequestValidation
+}
+
+// Return sets up results that will be returned by Validator.RequestValidation
+func (mmRequestValidation *mValidatorMockRequestValidation) Return() *ValidatorMock {
+	if mmRequestValidation.mock.funcRequestValidation != nil {
+		mmRequestValidation.mock.t.Fatalf("ValidatorMock.RequestValidation mock is already set by Set")
+	}
+
+	if mmRequestValidation.defaultExpectation == nil {
+		mmRequestValidation.defaultExpectation = &ValidatorMockRequestValidationExpectation{mock: mmRequestValidation.mock}
+	}
+
+	return mmRequestValidation.mock
+}
+
+// Set uses given function f to mock the Validator.RequestValidation method
+func (mmRequestValidation *mValidatorMockRequestValidation) Set(f func(ctx context.Context, transcript *common.Transcript, res artifacts.RequestResult)) *ValidatorMock {
+	if mmRequestValidation.defaultExpectation != nil {
+		mmRequestValidation.mock.t.Fatalf("Default expectation is already set for the Validator.RequestValidation method")
+	}
+
+	if len(mmRequestValidation.expectations) > 0 {
+		mmRequestValidation.mock.t.Fatalf("Some expectations are already set for the Validator.RequestValidation method")
+	}
+
+	mmRequestValidation.mock.funcRequestValidation = f
+	return mmRequestValidation.mock
+}
+
+// RequestValidation implements Validator
+func (mmRequestValidation *ValidatorMock) RequestValidation(ctx context.Context, transcript *common.Transcript, res artifacts.RequestResult) {
+	mm_atomic.AddUint64(&mmRequestValidation.beforeRequestValidationCounter, 1)
+	defer mm_atomic.AddUint64(&mmRequestValidation.afterRequestValidationCounter, 1)
+
+	if mmRequestValidation.inspectFuncRequestValidation != nil {
+		mmRequestValidation.inspectFuncRequestValidation(ctx, transcript, res)
+	}
+
+	mm_params := &ValidatorMockRequestValidationParams{ctx, transcript, res}
+
+	// Record call args
+	mmRequestValidation.RequestValidationMock.mutex.Lock()
+	mmRequestValidation.RequestValidationMock.callArgs = append(mmRequestValidation.RequestValidationMock.callArgs, mm_params)
+	mmRequestValidation.RequestValidationMock.mutex.Unlock()
+
+	for _, e := range mmRequestValidation.RequestValidationMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return
+		}
+	}
+
+	if mmRequestValidation.RequestValidationMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRequestValidation.RequestValidationMock.defaultExpectation.Counter, 1)
+		mm_want := mmRequestValidation.RequestValidationMock.defaultExpectation.params
+		mm_got := ValidatorMockRequestValidationParams{ctx, transcript, res}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRequestValidation.t.Errorf("ValidatorMock.RequestValidation got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		return
+
+	}
+	if mmRequestValidation.funcRequestValidation != nil {
+		mmRequestValidation.funcRequestValidation(ctx, transcript, res)
+		return
+	}
+	mmRequestValidation.t.Fatalf("Unexpected call to ValidatorMock.RequestValidation. %v %v %v", ctx, transcript, res)
+
+}
+
+// RequestValidationAfterCounter returns a count of finished ValidatorMock.RequestValidation invocations
+func (mmRequestValidation *ValidatorMock) RequestValidationAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRequestValidation.afterRequestValidationCounter)
+}
+
+// RequestValidationBeforeCounter returns a count of ValidatorMock.RequestValidation invocations
+func (mmRequestValidation *ValidatorMock) RequestValidationBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRequestValidation.beforeRequestValidationCounter)
+}
+
+// Calls returns a list of arguments used in each call to ValidatorMock.RequestValidation.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRequestValidation *mValidatorMockRequestValidation) Calls() []*ValidatorMockRequestValidationParams {
+	mmRequestValidation.mutex.RLock()
+
+	argCopy := make([]*ValidatorMockRequestValidationParams, len(mmRequestValidation.callArgs))
+	copy(argCopy, mmRequestValidation.callArgs)
+
+	mmRequestValidation.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRequestValidationDone returns true if the count of the RequestValidation invocations corresponds
+// the number of defined expectations
+func (m *ValidatorMock) MinimockRequestValidationDone() bool {
+	for _, e := range m.RequestValidationMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RequestValidationMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterRequestValidationCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRequestValidation != nil && mm_atomic.LoadUint64(&m.afterRequestValidationCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockRequestValidationInspect logs each unmet expectation
+func (m *ValidatorMock) MinimockRequestValidationInspect() {
+	for _, e := range m.RequestValidationMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ValidatorMock.RequestValidation with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RequestValidationMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterRequestValidationCounter) < 1 {
+		if m.RequestValidationMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ValidatorMock.RequestValidation")
+		} else {
+			m.t.Errorf("Expected call to ValidatorMock.RequestValidation with params: %#v", *m.RequestValidationMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRequestValidation != nil && mm_atomic.LoadUint64(&m.afterRequestValidationCounter) < 1 {
+		m.t.Error("Expected call to ValidatorMock.RequestValidation")
+	}
+}
+
+type mValidatorMockValidate struct {
+	mock               *ValidatorMock
+	defaultExpectation *ValidatorMockValidateExpectation
+	expectations       []*ValidatorMockValidateExpectation
+
+	callArgs []*ValidatorMockValidateParams
+	mutex    sync.RWMutex
+}
+
+// ValidatorMockValidateExpectation specifies expectation struct of the Validator.Validate
+type ValidatorMockValidateExpectation struct {
+	mock    *ValidatorMock
+	params  *ValidatorMockValidateParams
+	results *ValidatorMockValidateResults
+	Counter uint64
+}
+
+// ValidatorMockValidateParams contains parameters of the Validator.Validate
+type ValidatorMockValidateParams struct {
+	ctx      context.Context
+	executor insolar.Reference
+	msg      payload.ValidateRequest
+}
+
+// ValidatorMockValidateResults contains results of the Validator.Validate
+type ValidatorMockValidateResults struct {
+	v1 insolar.ValidationResult
+}
+
+// Expect sets up expected params for Validator.Validate
+func (mmValidate *mValidatorMockValidate) Expect(ctx context.Context, executor insolar.Reference, msg payload.ValidateRequest) *mValidatorMockValidate {
+	if mmValidate.mock.funcValidate != nil {
+		mmValidate.mock.t.Fatalf("ValidatorMock.Validate mock is already set by Set")
+	}
+
+	if mmValidate.defaultExpectation == nil {
+		mmValidate.defaultExpectation = &ValidatorMockValidateExpectation{}
+	}
+
+	mmValidate.defaultExpectation.params = &ValidatorMockValidateParams{ctx, executor, msg}
+	for _, e := range mmValidate.expectations {
+		if minimock.Equal(e.params, mmValidate.defaultExpectation.params) {
+			mmValidate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmValidate.defaultExpectation.params)
+		}
+	}
+
+	return mmValidate
+}
+
+// Inspect accepts an inspector function that has same arguments as the Validator.Validate
+func (mmValidate *mValidatorMockValidate) Inspect(f func(ctx context.Context, executor insolar.Reference, msg payload.ValidateRequest)) *mValidatorMockValidate {
+	if mmValidate.mock.inspectFuncValidate != nil {
+		mmValidate.mock.t.Fatalf("Inspect function is already set for ValidatorMock.Validate")
+	}
+
+	mmValidate.mock.inspectFuncValidate = f
+
+	return mmValidate
+}
+
+// Return sets up results that will be returned by Validator.Validate
+func (mmValidate *mValidatorMockValidate) Return(v1 insolar.ValidationResult) *ValidatorMock {
+	if mmValidate.mock.funcValidate != nil {
+		mmValidate.mock.t.Fatalf("ValidatorMock.Validate mock is already set by Set")
+	}
+
+	if mmValidate.defaultExpectation == nil {
+		mmValidate.defaultExpectation = &ValidatorMockValidateExpectation{mock: mmValidate.mock}
+	}
+	mmValidate.defaultExpectation.results = &ValidatorMockValidateResults{v1}
+	return mmValidate.mock
+}
+
+// Set uses given function f to mock the Validator.Validate method
+func (mmValidate *mValidatorMockValidate) Set(f func(ctx context.Context, executor insolar.Reference, msg payload.ValidateRequest) (v1 insolar.ValidationResult)) *ValidatorMock {
+	if mmValidate.defaultExpectation != nil {
+		mmValidate.mock.t.Fatalf("Default expectation is already set for the Validator.Validate method")
+	}
+
+	if len(mmValidate.expectations) > 0 {
+		mmValidate.mock.t.Fatalf("Some expectations are already set for the Validator.Validate method")
+	}
+
+	mmValidate.mock.funcValidate = f
+	return mmValidate.mock
+}
+
+// When sets expectation for the Validator.Validate which will trigger the result defined by the following
+// Then helper
+func (mmValidate *mValidatorMockValidate) When(ctx context.Context, executor insolar.Reference, msg payload.ValidateRequest) *ValidatorMockValidateExpectation {
+	if mmValidate.mock.funcValidate != nil {
+		mmValidate.mock.t.Fatalf("ValidatorMock.Validate mock is already set by Set")
+	}
+
+	expectation := &ValidatorMockValidateExpectation{
+		mock:   mmValidate.mock,
+		params: &ValidatorMockValidateParams{ctx, executor, msg},
+	}
+	mmValidate.expectations = append(mmValidate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Validator.Validate return parameters for the expectation previously defined by the When method
+func (e *ValidatorMockValidateExpectation) Then(v1 insolar.ValidationResult) *ValidatorMock {
+	e.results = &ValidatorMockValidateResults{v1}
+	return e.mock
+}
+
+// Validate implements Validator
+func (mmValidate *ValidatorMock) Validate(ctx context.Context, executor insolar.Reference, msg payload.ValidateRequest) (v1 insolar.ValidationResult) {
+	mm_atomic.AddUint64(&mmValidate.beforeValidateCounter, 1)
+	defer mm_atomic.AddUint64(&mmValidate.afterValidateCounter, 1)
+
+	if mmValidate.inspectFuncValidate != nil {
+		mmValidate.inspectFuncValidate(ctx, executor, msg)
+	}
+
+	mm_params := &ValidatorMockValidateParams{ctx, executor, msg}
+
+	// Record call args
+	mmValidate.ValidateMock.mutex.Lock()
+	mmValidate.ValidateMock.callArgs = append(mmValidate.ValidateMock.callArgs, mm_params)
+	mmValidate.ValidateMock.mutex.Unlock()
+
+	for _, e := range mmValidate.ValidateMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.v1
+		}
+	}
+
+	if mmValidate.ValidateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmValidate.ValidateMock.defaultExpectation.Counter, 1)
+		mm_want := mmValidate.ValidateMock.defaultExpectation.params
+		mm_got := ValidatorMockValidateParams{ctx, executor, msg}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmValidate.t.Errorf("ValidatorMock.Validate got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmValidate.ValidateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmValidate.t.Fatal("No results are set for the ValidatorMock.Validate")
+		}
+		return (*mm_results).v1
+	}
+	if mmValidate.funcValidate != nil {
+		return mmValidate.funcValidate(ctx, executor, msg)
+	}
+	mmValidate.t.Fatalf("Unexpected call to ValidatorMock.Validate. %v %v %v", ctx, executor, msg)
+	return
+}
+
+// ValidateAfterCounter returns a count of finished ValidatorMock.Validate invocations
+func (mmValidate *ValidatorMock) ValidateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmValidate.afterValidateCounter)
+}
+
+// ValidateBeforeCounter returns a count of ValidatorMock.Validate invocations
+func (mmValidate *ValidatorMock) ValidateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmValidate.beforeValidateCounter)
+}
+
+// Calls returns a list of arguments used in each call to ValidatorMock.Validate.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmValidate *mValidatorMockValidate) Calls() []*ValidatorMockValidateParams {
+	mmValidate.mutex.RLock()
+
+	argCopy := make([]*ValidatorMockValidateParams, len(mmValidate.callArgs))
+	copy(argCopy, mmValidate.callArgs)
+
+	mmValidate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockValidateDone returns true if the count of the Validate invocations corresponds
+// the number of defined expectations
+func (m *ValidatorMock) MinimockValidateDone() bool {
+	for _, e := range m.ValidateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ValidateMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterValidateCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcValidate != nil && mm_atomic.LoadUint64(&m.afterValidateCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockValidateInspect logs each unmet expectation
+func (m *ValidatorMock) MinimockValidateInspect() {
+	for _, e := range m.ValidateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ValidatorMock.Validate with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ValidateMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterValidateCounter) < 1 {
+		if m.ValidateMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ValidatorMock.Validate")
+		} else {
+			m.t.Errorf("Expected call to ValidatorMock.Validate with params: %#v", *m.ValidateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcValidate != nil && mm_atomic.LoadUint64(&m.afterValidateCounter) < 1 {
+		m.t.Error("Expected call to ValidatorMock.Validate")
+	}
+}
+
+type mValidatorMockValidationResults struct {
+	mock               *ValidatorMock
+	defaultExpectation *ValidatorMockValidationResultsExpectation
+	expectations       []*ValidatorMockValidationResultsExpectation
+}
+
+// ValidatorMockValidationResultsExpectation specifies expectation struct of the Validator.ValidationResults
+type ValidatorMockValidationResultsExpectation struct {
+	mock *ValidatorMock
+
+	results *ValidatorMockValidationResultsResults
+	Counter uint64
+}
+
+// ValidatorMockValidationResultsResults contains results of the Validator.ValidationResults
+type ValidatorMockValidationResultsResults struct {
+	va1 []insolar.ValidationResult
+}
+
+// Expect sets up expected params for Validator.ValidationResults
+func (mmValidationResults *mValidatorMockValidationResults) Expect() *mValidatorMockValidationResults {
+	if mmValidationResults.mock.funcValidationResults != nil {
+		mmValidationResults.mock.t.Fatalf("ValidatorMock.ValidationResults mock is already set by Set")
+	}
+
+	if mmValidationResults.defaultExpectation == nil {
+		mmValidationResults.defaultExpectation = &ValidatorMockValidationResultsExpectation{}
+	}
+
+	return mmValidationResults
+}
+
+// Inspect accepts an inspector function that has same arguments as the Validator.ValidationResults
+func (mmValidationResults *mValidatorMockValidationResults) Inspect(f func()) *mValidatorMockValidationResults {
+	if mmValidationResults.mock.inspectFuncValidationResults != nil {
+		mmValidationResults.mock.t.Fatalf("Inspect function is already set for ValidatorMock.ValidationResults")
+	}
+
+	mmValidationResults.mock.inspectFuncValidationResults = f
+
+	return mmValidationResults
+}
+
+// Return sets up results that will be returned by Validator.ValidationResults
+func (mmValidationResults *mValidatorMockValidationResults) Return(va1 []insolar.ValidationResult) *ValidatorMock {
+	if mmValidationResults.mock.funcValidationResults != nil {
+		mmValidationResults.mock.t.Fatalf("ValidatorMock.ValidationResults mock is already set by Set")
+	}
+
+	if mmValidationResults.defaultExpectation == nil {
+		mmValidationResults.defaultExpectation = &ValidatorMockValidationResultsExpectation{mock: mmValidationResults.mock}
+	}
+	mmValidationResults.defaultExpectation.results = &ValidatorMockValidationResultsResults{va1}
+	return mmValidationResults.mock
+}
+
+// Set uses given function f to mock the Validator.ValidationResults method
+func (mmValidationResults *mValidatorMockValidationResults) Set(f func() (va1 []insolar.ValidationResult)) *ValidatorMock {
+	if mmValidationResults.defaultExpectation != nil {
+		mmValidationResults.mock.t.Fatalf("Default expectation is already set for the Validator.ValidationResults method")
+	}
+
+	if len(mmValidationResults.expectations) > 0 {
+		mmValidationResults.mock.t.Fatalf("Some expectations are already set for the Validator.ValidationResults method")
+	}
+
+	mmValidationResults.mock.funcValidationResults = f
+	return mmValidationResults.mock
+}
+
+// ValidationResults implements Validator
+func (mmValidationResults *ValidatorMock) ValidationResults() (va1 []insolar.ValidationResult) {
+	mm_atomic.AddUint64(&mmValidationResults.beforeValidationResultsCounter, 1)
+	defer mm_atomic.AddUint64(&mmValidationResults.afterValidationResultsCounter, 1)
+
+	if mmValidationResults.inspectFuncValidationResults != nil {
+		mmValidationResults.inspectFuncValidationResults()
+	}
+
+	if mmValidationResults.ValidationResultsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmValidationResults.ValidationResultsMock.defaultExpectation.Counter, 1)
+
+		mm_results := mmValidationResults.ValidationResultsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmValidationResults.t.Fatal("No results are set for the ValidatorMock.ValidationResults")
+		}
+		return (*mm_results).va1
+	}
+	if mmValidationResults.funcValidationResults != nil {
+		return mmValidationResults.funcValidationResults()
+	}
+	mmValidationResults.t.Fatalf("Unexpected call to ValidatorMock.ValidationResults.")
+	return
+}
+
+// ValidationResultsAfterCounter returns a count of finished ValidatorMock.ValidationResults invocations
+func (mmValidationResults *ValidatorMock) ValidationResultsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmValidationResults.afterValidationResultsCounter)
+}
+
+// ValidationResultsBeforeCounter returns a count of ValidatorMock.ValidationResults invocations
+func (mmValidationResults *ValidatorMock) ValidationResultsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmValidationResults.beforeValidationResultsCounter)
+}
+
+// MinimockValidationResultsDone returns true if the count of the ValidationResults invocations corresponds
+// the number of defined expectations
+func (m *ValidatorMock) MinimockValidationResultsDone() bool {
+	for _, e := range m.ValidationResultsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ValidationResultsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterValidationResultsCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcValidationResults != nil && mm_atomic.LoadUint64(&m.afterValidationResultsCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockValidationResultsInspect logs each unmet expectation
+func (m *ValidatorMock) MinimockValidationResultsInspect() {
+	for _, e := range m.ValidationResultsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Error("Expected call to ValidatorMock.ValidationResults")
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ValidationResultsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterValidationResultsCounter) < 1 {
+		m.t.Error("Expected call to ValidatorMock.ValidationResults")
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcValidationResults != nil && mm_atomic.LoadUint64(&m.afterValidationResultsCounter) < 1 {
+		m.t.Error("Expected call to ValidatorMock.ValidationResults")
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *ValidatorMock) MinimockFinish() {
+	if !m.minimockDone() {
+		m.MinimockGetActiveTranscriptInspect()
+
+		m.MinimockRequestValidationInspect()
+
+		m.MinimockValidateInspect()
+
+		m.MinimockValidationResultsInspect()
+		m.t.FailNow()
+	}
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *ValidatorMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *ValidatorMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockGetActiveTranscriptDone() &&
+		m.MinimockRequestValidationDone() &&
+		m.MinimockValidateDone() &&
+		m.MinimockValidationResultsDone()
+}
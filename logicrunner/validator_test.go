@@ -0,0 +1,319 @@
+// Copyright 2020 Insolar Network Ltd.
+// All rights reserved.
+// This material is licensed under the Insolar License version 1.0,
+// available at https://github.com/insolar/insolar/blob/master/LICENSE.md.
+
+package logicrunner
+
+import (
+	"bytes"
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/gojuno/minimock/v3"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/bus"
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/insolar/jet"
+	"github.com/insolar/insolar/insolar/payload"
+	"github.com/insolar/insolar/insolar/pulse"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/logicrunner/artifacts"
+	"github.com/insolar/insolar/logicrunner/common"
+	"github.com/insolar/insolar/logicrunner/requestresult"
+	"github.com/insolar/insolar/testutils"
+)
+
+type misbehaviorReporter struct {
+	lock    sync.Mutex
+	reports map[insolar.Reference][]string
+}
+
+func (r *misbehaviorReporter) ReportMisbehavior(_ context.Context, node insolar.Reference, details string) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.reports == nil {
+		r.reports = map[insolar.Reference][]string{}
+	}
+	r.reports[node] = append(r.reports[node], details)
+	return nil
+}
+
+func TestValidator_RequestValidation(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	me := gen.Reference()
+	validators := []insolar.Reference{gen.Reference(), me, gen.Reference()}
+	objectRef := gen.Reference()
+	parent := gen.Reference()
+	image := gen.Reference()
+	desc := artifacts.NewObjectDescriptor(objectRef, gen.ID(), &image, []byte{1}, parent)
+	outgoing := []insolar.Reference{gen.RecordReference()}
+
+	pn := gen.PulseNumber()
+	pulses := pulse.NewAccessorMock(mc).LatestMock.Return(insolar.Pulse{PulseNumber: pn}, nil)
+	coordinator := jet.NewCoordinatorMock(mc)
+	coordinator.MeMock.Return(me)
+	coordinator.VirtualValidatorsForObjectMock.Expect(ctx, *objectRef.GetLocal(), pn).Return(validators, nil)
+
+	var targets []insolar.Reference
+	sender := bus.NewSenderMock(mc)
+	sender.SendTargetMock.Set(func(
+		_ context.Context, msg *message.Message, target insolar.Reference,
+	) (<-chan *message.Message, func()) {
+		targets = append(targets, target)
+
+		pl, err := payload.Unmarshal(msg.Payload)
+		require.NoError(t, err)
+		validate, ok := pl.(*payload.ValidateRequest)
+		require.True(t, ok)
+		require.Equal(t, objectRef, validate.ObjectRef)
+		require.Equal(t, desc.StateID(), validate.PrevStateID)
+		require.Equal(t, parent, validate.Parent)
+		require.Equal(t, outgoing, validate.OutgoingRequestRefs)
+		return make(chan *message.Message), func() {}
+	})
+
+	v := newValidator(nil, nil, sender, coordinator, pulses, nil, nil, 10)
+
+	transcript := common.NewTranscript(ctx, gen.RecordReference(), record.IncomingRequest{CallType: record.CTMethod})
+	transcript.ObjectDescriptor = desc
+	transcript.OutgoingRequestRefs = outgoing
+
+	v.RequestValidation(ctx, transcript, requestresult.New([]byte{1}, objectRef))
+	require.Equal(t, []insolar.Reference{validators[0], validators[2]}, targets)
+
+	// immutable requests don't change state, they aren't validated
+	transcript.Request.Immutable = true
+	v.RequestValidation(ctx, transcript, requestresult.New([]byte{1}, objectRef))
+	require.Len(t, targets, 2)
+}
+
+func TestValidator_Validate(t *testing.T) {
+	pcs := testutils.NewPlatformCryptographyScheme()
+	executor := gen.Reference()
+	objectRef := gen.Reference()
+	requestRef := gen.RecordReference()
+	outgoingRef := gen.RecordReference()
+	parent := gen.Reference()
+	image := gen.Reference()
+	prevStateID := gen.ID()
+	resultPulse := gen.PulseNumber()
+	prevMemory := []byte{1}
+	newMemory := []byte{2}
+	registeredResult := []byte{3}
+	outgoingResult := []byte{4}
+	registeredEvent := artifacts.ContractEvent{Prototype: image, Topic: "transfer", Payload: []byte{6}}
+
+	request := &record.IncomingRequest{
+		CallType:     record.CTMethod,
+		Object:       &objectRef,
+		Method:       "Transfer",
+		APIRequestID: "request",
+		Reason:       gen.RecordReference(),
+	}
+	outgoing := &record.OutgoingRequest{
+		Caller:       objectRef,
+		Nonce:        1,
+		Object:       &parent,
+		Method:       "Accept",
+		APIRequestID: "request",
+		Reason:       requestRef,
+	}
+	msg := payload.ValidateRequest{
+		ObjectRef:           objectRef,
+		RequestRef:          requestRef,
+		PrevStateID:         &prevStateID,
+		Parent:              parent,
+		OutgoingRequestRefs: []insolar.Reference{outgoingRef},
+	}
+
+	material := func(rec record.Record, id insolar.ID) *record.Material {
+		return &record.Material{Virtual: record.Wrap(rec), ID: id, ObjectID: *objectRef.GetLocal()}
+	}
+	// registeredStateID is an ID of the state, that executor registered after the request
+	registeredStateID := func() insolar.ID {
+		desc := artifacts.NewObjectDescriptor(objectRef, prevStateID, &image, prevMemory, parent)
+		res := requestresult.New(registeredResult, objectRef)
+		res.SetAmend(desc, newMemory)
+		virtual := record.Wrap(artifacts.SideEffectRecord(requestRef, res))
+		return *insolar.NewID(resultPulse, record.HashVirtual(pcs.ReferenceHasher(), virtual))
+	}()
+	// registeredEventID is an ID of the event, that executor registered after the request
+	registeredEventID := func() insolar.ID {
+		res := requestresult.New(registeredResult, objectRef)
+		res.SetEvents([]artifacts.ContractEvent{registeredEvent})
+		virtual := record.Wrap(artifacts.EventRecords(requestRef, res)[0])
+		return *insolar.NewID(resultPulse, record.HashVirtual(pcs.ReferenceHasher(), virtual))
+	}()
+
+	tests := []struct {
+		name    string
+		result  []byte
+		memory  []byte
+		payload []byte
+		status  insolar.ValidationStatus
+	}{
+		{
+			name:    "valid",
+			result:  registeredResult,
+			memory:  newMemory,
+			payload: registeredEvent.Payload,
+			status:  insolar.ValidationValid,
+		},
+		{
+			name:    "result mismatch",
+			result:  []byte{5},
+			memory:  newMemory,
+			payload: registeredEvent.Payload,
+			status:  insolar.ValidationMismatch,
+		},
+		{
+			name:    "state mismatch",
+			result:  registeredResult,
+			memory:  []byte{5},
+			payload: registeredEvent.Payload,
+			status:  insolar.ValidationMismatch,
+		},
+		{
+			name:    "event mismatch",
+			result:  registeredResult,
+			memory:  newMemory,
+			payload: []byte{5},
+			status:  insolar.ValidationMismatch,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := inslogger.TestContext(t)
+			mc := minimock.NewController(t)
+			defer mc.Finish()
+
+			am := artifacts.NewClientMock(mc)
+			am.GetRequestMock.Set(func(_ context.Context, obj, reqRef insolar.Reference) (record.Request, error) {
+				require.Equal(t, objectRef, obj)
+				if reqRef == outgoingRef {
+					return outgoing, nil
+				}
+				return request, nil
+			})
+			am.GetResultMock.Set(func(_ context.Context, obj, reqRef insolar.Reference) (*record.Material, error) {
+				if reqRef == outgoingRef {
+					return material(&record.Result{Payload: outgoingResult}, gen.ID()), nil
+				}
+				return material(&record.Result{Payload: registeredResult}, *insolar.NewID(resultPulse, nil)), nil
+			})
+			am.GetStateMock.Set(func(_ context.Context, obj insolar.Reference, stateID insolar.ID) (*record.Material, error) {
+				switch stateID {
+				case prevStateID:
+					return material(&record.Amend{Image: image, Memory: prevMemory}, prevStateID), nil
+				case registeredStateID:
+					return material(&record.Amend{Image: image, Memory: newMemory}, registeredStateID), nil
+				default:
+					return nil, insolar.ErrNotFound
+				}
+			})
+			// events are checked only if result and state are the same
+			if bytes.Equal(test.result, registeredResult) && bytes.Equal(test.memory, newMemory) {
+				am.GetEventMock.Set(func(_ context.Context, obj insolar.Reference, eventID insolar.ID) (*record.Material, error) {
+					if eventID != registeredEventID {
+						return nil, insolar.ErrNotFound
+					}
+					return material(&record.Event{Topic: registeredEvent.Topic}, registeredEventID), nil
+				})
+			}
+
+			var v *validator
+			requestsExecutor := NewRequestsExecutorMock(mc)
+			requestsExecutor.ExecuteMock.Set(func(
+				_ context.Context, transcript *common.Transcript,
+			) (artifacts.RequestResult, error) {
+				require.Equal(t, insolar.ValidateCallMode, transcript.Mode)
+				require.Equal(t, transcript, v.GetActiveTranscript(requestRef))
+				require.Equal(t, prevMemory, transcript.ObjectDescriptor.Memory())
+
+				// contract repeats the call of executor
+				repeated := transcript.HasOutgoingRequest(context.Background(), *buildIncomingRequestFromOutgoing(outgoing))
+				require.NotNil(t, repeated)
+				require.Equal(t, outgoingResult, repeated.Response)
+
+				res := requestresult.New(test.result, objectRef)
+				res.SetAmend(transcript.ObjectDescriptor, test.memory)
+				res.SetEvents([]artifacts.ContractEvent{{
+					Prototype: registeredEvent.Prototype,
+					Topic:     registeredEvent.Topic,
+					Payload:   test.payload,
+				}})
+				return res, nil
+			})
+
+			reporter := &misbehaviorReporter{}
+			v = newValidator(am, requestsExecutor, nil, nil, nil, pcs, reporter, 10)
+
+			res := v.Validate(ctx, executor, msg)
+			require.Equal(t, test.status, res.Status)
+			require.Equal(t, executor.String(), res.Executor)
+			require.Equal(t, requestRef.String(), res.Request)
+			require.Equal(t, []insolar.ValidationResult{res}, v.ValidationResults())
+			require.Nil(t, v.GetActiveTranscript(requestRef))
+
+			if test.status == insolar.ValidationMismatch {
+				require.Len(t, reporter.reports[executor], 1)
+			} else {
+				require.Empty(t, reporter.reports)
+			}
+		})
+	}
+}
+
+func TestValidator_ValidationResults(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	am := artifacts.NewClientMock(mc).GetRequestMock.Return(nil, errors.New("no request"))
+	v := newValidator(am, nil, nil, nil, nil, nil, nil, 2)
+
+	var requests []insolar.Reference
+	for i := 0; i < 3; i++ {
+		reqRef := gen.RecordReference()
+		requests = append(requests, reqRef)
+		v.Validate(ctx, gen.Reference(), payload.ValidateRequest{ObjectRef: gen.Reference(), RequestRef: reqRef})
+	}
+
+	results := v.ValidationResults()
+	require.Len(t, results, 2)
+	require.Equal(t, requests[2].String(), results[0].Request)
+	require.Equal(t, requests[1].String(), results[1].Request)
+	require.Equal(t, insolar.ValidationFailed, results[0].Status)
+}
+
+func TestValidatingRequestsExecutor_ExecuteAndSave(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	transcript := common.NewTranscript(ctx, gen.RecordReference(), record.IncomingRequest{})
+	res := requestresult.New([]byte{1}, gen.Reference())
+
+	inner := NewRequestsExecutorMock(mc).ExecuteAndSaveMock.Return(res, nil)
+	validator := NewValidatorMock(mc).RequestValidationMock.Expect(ctx, transcript, res).Return()
+
+	got, err := newValidatingRequestsExecutor(inner, validator).ExecuteAndSave(ctx, transcript)
+	require.NoError(t, err)
+	require.Equal(t, res, got)
+
+	// failed requests aren't validated
+	inner.ExecuteAndSaveMock.Return(nil, errors.New("failed"))
+	_, err = newValidatingRequestsExecutor(inner, validator).ExecuteAndSave(ctx, transcript)
+	require.Error(t, err)
+}
@@ -61,6 +61,7 @@ func (m *Metrics) Init(ctx context.Context) error {
 	mux.Handle("/metrics", promHandler)
 	mux.Handle("/_status", newProcStatus())
 	mux.Handle("/debug/loglevel", log.NewLoglevelChangeHandler())
+	mux.Handle("/debug/loglevel/scope", log.NewScopeLevelHandler())
 	pprof.Handle(mux)
 	if m.config.ZpagesEnabled {
 		// https://opencensus.io/zpages/
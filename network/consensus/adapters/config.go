@@ -7,9 +7,12 @@ package adapters
 
 import (
 	"context"
+	"crypto"
 	"crypto/ecdsa"
 	"time"
 
+	"github.com/pkg/errors"
+
 	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/network/consensus/common/cryptkit"
 	"github.com/insolar/insolar/network/consensus/gcpv2/api"
@@ -60,13 +63,20 @@ func NewLocalNodeConfiguration(ctx context.Context, keyStore insolar.KeyStore) *
 		panic(err)
 	}
 
-	ecdsaPrivateKey := privateKey.(*ecdsa.PrivateKey)
+	// Private key is either ecdsa private key or external signer.
+	signer, ok := privateKey.(crypto.Signer)
+	if !ok {
+		panic(errors.Errorf("node private key %T isn't a signer", privateKey))
+	}
+	if _, ok := signer.Public().(*ecdsa.PublicKey); !ok {
+		panic(errors.Errorf("node key %T isn't supported by consensus, only ECDSA node keys are supported", signer.Public()))
+	}
 
 	return &LocalNodeConfiguration{
 		ctx:              ctx,
 		timings:          defaultRoundTimings,
 		ephemeralTimings: defaultEphemeralTimings,
-		secretKeyStore:   NewECDSASecretKeyStore(ecdsaPrivateKey),
+		secretKeyStore:   NewECDSASecretKeyStore(signer),
 	}
 }
 
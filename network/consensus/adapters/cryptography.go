@@ -6,6 +6,7 @@
 package adapters
 
 import (
+	"crypto"
 	"crypto/ecdsa"
 	"io"
 
@@ -59,11 +60,12 @@ func NewECDSAPublicKeyStore(publicKey *ecdsa.PublicKey) *ECDSAPublicKeyStore {
 
 func (pks *ECDSAPublicKeyStore) PublicKeyStore() {}
 
+// ECDSASecretKeyStore keeps ecdsa private key or external signer with ecdsa public key.
 type ECDSASecretKeyStore struct {
-	privateKey *ecdsa.PrivateKey
+	privateKey crypto.Signer
 }
 
-func NewECDSASecretKeyStore(privateKey *ecdsa.PrivateKey) *ECDSASecretKeyStore {
+func NewECDSASecretKeyStore(privateKey crypto.Signer) *ECDSASecretKeyStore {
 	return &ECDSASecretKeyStore{
 		privateKey: privateKey,
 	}
@@ -72,15 +74,15 @@ func NewECDSASecretKeyStore(privateKey *ecdsa.PrivateKey) *ECDSASecretKeyStore {
 func (ks *ECDSASecretKeyStore) PrivateKeyStore() {}
 
 func (ks *ECDSASecretKeyStore) AsPublicKeyStore() cryptkit.PublicKeyStore {
-	return NewECDSAPublicKeyStore(&ks.privateKey.PublicKey)
+	return NewECDSAPublicKeyStore(ks.privateKey.Public().(*ecdsa.PublicKey))
 }
 
 type ECDSADigestSigner struct {
 	scheme     insolar.PlatformCryptographyScheme
-	privateKey *ecdsa.PrivateKey
+	privateKey crypto.Signer
 }
 
-func NewECDSADigestSigner(privateKey *ecdsa.PrivateKey, scheme insolar.PlatformCryptographyScheme) *ECDSADigestSigner {
+func NewECDSADigestSigner(privateKey crypto.Signer, scheme insolar.PlatformCryptographyScheme) *ECDSADigestSigner {
 	return &ECDSADigestSigner{
 		scheme:     scheme,
 		privateKey: privateKey,
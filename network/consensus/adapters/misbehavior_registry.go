@@ -0,0 +1,371 @@
+// Copyright 2020 Insolar Network Ltd.
+// All rights reserved.
+// This material is licensed under the Insolar License version 1.0,
+// available at https://github.com/insolar/insolar/blob/master/LICENSE.md.
+
+package adapters
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.opencensus.io/stats"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/instrumentation/insmetrics"
+	"github.com/insolar/insolar/network"
+	"github.com/insolar/insolar/network/consensus/gcpv2/api/misbehavior"
+)
+
+// storedReports are reports of the node in storage file.
+type storedReports struct {
+	Node    insolar.Reference           `json:"node"`
+	Reports []network.MisbehaviorReport `json:"reports"`
+}
+
+const (
+	categoryFraud      = "fraud"
+	categoryBlame      = "blame"
+	categoryValidation = "validation"
+	categoryUnknown    = "unknown"
+
+	// maxNodeReports is a limit of reports kept per node, the oldest reports are dropped over it.
+	maxNodeReports = 100
+)
+
+// MisbehaviorRegistry keeps misbehavior reports of consensus per node and scores nodes by actual reports.
+// Reports are kept by node references, because short IDs of nodes change, when nodes rejoin the network.
+//
+// Nodes with score over evict threshold are blacklisted, consensus marks them as frauds and evicts them.
+// Nodes with score over quarantine threshold aren't allowed to join the network.
+type MisbehaviorRegistry struct {
+	cfg configuration.Misbehavior
+	now func() time.Time
+
+	mutex sync.RWMutex
+	nodes map[insolar.Reference][]network.MisbehaviorReport
+
+	dirty chan struct{}
+	stop  chan struct{}
+	done  chan struct{}
+}
+
+// NewMisbehaviorRegistry creates registry, reports are loaded from storage file on Init.
+func NewMisbehaviorRegistry(cfg configuration.Misbehavior) *MisbehaviorRegistry {
+	return &MisbehaviorRegistry{
+		cfg:   cfg,
+		now:   time.Now,
+		nodes: map[insolar.Reference][]network.MisbehaviorReport{},
+		dirty: make(chan struct{}, 1),
+	}
+}
+
+// Init loads reports from storage file.
+func (mr *MisbehaviorRegistry) Init(ctx context.Context) error {
+	if mr.cfg.StorageFile == "" {
+		return nil
+	}
+
+	data, err := ioutil.ReadFile(filepath.Clean(mr.cfg.StorageFile))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed to read misbehavior reports")
+	}
+
+	var stored []storedReports
+	err = json.Unmarshal(data, &stored)
+	if err != nil {
+		return errors.Wrap(err, "failed to decode misbehavior reports")
+	}
+	nodes := make(map[insolar.Reference][]network.MisbehaviorReport, len(stored))
+	for _, s := range stored {
+		nodes[s.Node] = s.Reports
+	}
+
+	mr.mutex.Lock()
+	mr.nodes = nodes
+	mr.expire()
+	mr.mutex.Unlock()
+
+	mr.recordMetrics(ctx)
+	return nil
+}
+
+// Start starts saving of reports to storage file.
+func (mr *MisbehaviorRegistry) Start(ctx context.Context) error {
+	if mr.cfg.StorageFile == "" {
+		return nil
+	}
+
+	mr.stop = make(chan struct{})
+	mr.done = make(chan struct{})
+	go mr.saveLoop(ctx)
+	return nil
+}
+
+// Stop stops saving of reports and saves them for the last time.
+func (mr *MisbehaviorRegistry) Stop(ctx context.Context) error {
+	if mr.stop == nil {
+		return nil
+	}
+
+	close(mr.stop)
+	<-mr.done
+	mr.stop = nil
+	return mr.save()
+}
+
+// AddReport adds report to the node score. Reports without violator node or its full profile can't be
+// attributed by node reference, they are only counted in metrics.
+func (mr *MisbehaviorRegistry) AddReport(report misbehavior.Report) {
+	ctx := context.Background()
+	typ := report.MisbehaviorType()
+	category := categoryName(typ.Category())
+	stats.Record(
+		insmetrics.InsertTag(ctx, network.TagMisbehaviorCategory, category),
+		network.MisbehaviorReports.M(1),
+	)
+
+	node := report.ViolatorNode()
+	if node == nil || node.GetStatic() == nil || node.GetStatic().GetExtension() == nil {
+		return
+	}
+
+	mr.addReport(ctx, node.GetStatic().GetExtension().GetReference(), network.MisbehaviorReport{
+		Category: category,
+		Type:     typ.Type(),
+		Details:  reportDetails(report),
+	})
+}
+
+// ReportValidationMismatch adds report about the node, whose execution result differs from validator one.
+// Such reports are scored separately from consensus frauds, because they aren't agreed with other validators.
+func (mr *MisbehaviorRegistry) ReportValidationMismatch(ref insolar.Reference, details string) {
+	ctx := context.Background()
+	stats.Record(
+		insmetrics.InsertTag(ctx, network.TagMisbehaviorCategory, categoryValidation),
+		network.MisbehaviorReports.M(1),
+	)
+
+	mr.addReport(ctx, ref, network.MisbehaviorReport{
+		Category: categoryValidation,
+		Details:  details,
+	})
+}
+
+func (mr *MisbehaviorRegistry) addReport(ctx context.Context, ref insolar.Reference, report network.MisbehaviorReport) {
+	mr.mutex.Lock()
+	wasBlacklisted := mr.isBlacklisted(ref)
+	report.Time = mr.now().UTC()
+	reports := append(mr.nodes[ref], report)
+	if len(reports) > maxNodeReports {
+		reports = reports[len(reports)-maxNodeReports:]
+	}
+	mr.nodes[ref] = reports
+	mr.expire()
+	blacklisted := mr.isBlacklisted(ref)
+	score := mr.score(ref)
+	mr.mutex.Unlock()
+
+	if blacklisted && !wasBlacklisted {
+		inslogger.FromContext(ctx).Warnf("Node %s is blacklisted for misbehavior with score %d", ref, score)
+	}
+
+	mr.recordMetrics(ctx)
+	mr.markDirty()
+}
+
+// IsBlacklisted returns true if the node score reached evict threshold.
+func (mr *MisbehaviorRegistry) IsBlacklisted(ref insolar.Reference) bool {
+	mr.mutex.RLock()
+	defer mr.mutex.RUnlock()
+
+	return mr.isBlacklisted(ref)
+}
+
+// IsQuarantined returns true if the node score reached quarantine threshold.
+func (mr *MisbehaviorRegistry) IsQuarantined(ref insolar.Reference) bool {
+	mr.mutex.RLock()
+	defer mr.mutex.RUnlock()
+
+	return mr.cfg.QuarantineThreshold > 0 && mr.score(ref) >= mr.cfg.QuarantineThreshold
+}
+
+// Offenders returns nodes with actual reports sorted by score.
+func (mr *MisbehaviorRegistry) Offenders() []network.Offender {
+	mr.mutex.RLock()
+	defer mr.mutex.RUnlock()
+
+	offenders := make([]network.Offender, 0, len(mr.nodes))
+	for ref, reports := range mr.nodes {
+		actual := mr.actualReports(reports)
+		if len(actual) == 0 {
+			continue
+		}
+		score := mr.score(ref)
+		offenders = append(offenders, network.Offender{
+			Node:        ref,
+			Score:       score,
+			Evicted:     mr.cfg.EvictThreshold > 0 && score >= mr.cfg.EvictThreshold,
+			Quarantined: mr.cfg.QuarantineThreshold > 0 && score >= mr.cfg.QuarantineThreshold,
+			Reports:     append([]network.MisbehaviorReport(nil), actual...),
+		})
+	}
+	sort.Slice(offenders, func(i, j int) bool {
+		if offenders[i].Score != offenders[j].Score {
+			return offenders[i].Score > offenders[j].Score
+		}
+		return offenders[i].Node.Compare(offenders[j].Node) < 0
+	})
+	return offenders
+}
+
+// Forgive removes reports of the node, it is no longer blacklisted or quarantined.
+func (mr *MisbehaviorRegistry) Forgive(ref insolar.Reference) bool {
+	mr.mutex.Lock()
+	_, ok := mr.nodes[ref]
+	delete(mr.nodes, ref)
+	mr.mutex.Unlock()
+
+	if ok {
+		mr.recordMetrics(context.Background())
+		mr.markDirty()
+	}
+	return ok
+}
+
+func (mr *MisbehaviorRegistry) isBlacklisted(ref insolar.Reference) bool {
+	return mr.cfg.EvictThreshold > 0 && mr.score(ref) >= mr.cfg.EvictThreshold
+}
+
+func (mr *MisbehaviorRegistry) score(ref insolar.Reference) int {
+	score := 0
+	for _, r := range mr.actualReports(mr.nodes[ref]) {
+		switch r.Category {
+		case categoryFraud:
+			score += mr.cfg.FraudScore
+		case categoryBlame:
+			score += mr.cfg.BlameScore
+		case categoryValidation:
+			score += mr.cfg.ValidationScore
+		}
+	}
+	return score
+}
+
+// actualReports returns reports, that are not expired. Reports are ordered by time.
+func (mr *MisbehaviorRegistry) actualReports(reports []network.MisbehaviorReport) []network.MisbehaviorReport {
+	if mr.cfg.ReportTTL <= 0 {
+		return reports
+	}
+	expiration := mr.now().Add(-mr.cfg.ReportTTL)
+	idx := sort.Search(len(reports), func(i int) bool {
+		return reports[i].Time.After(expiration)
+	})
+	return reports[idx:]
+}
+
+// expire removes expired reports. Should be called under write lock.
+func (mr *MisbehaviorRegistry) expire() {
+	for ref, reports := range mr.nodes {
+		actual := mr.actualReports(reports)
+		if len(actual) == 0 {
+			delete(mr.nodes, ref)
+			continue
+		}
+		mr.nodes[ref] = actual
+	}
+}
+
+func (mr *MisbehaviorRegistry) recordMetrics(ctx context.Context) {
+	offenders := mr.Offenders()
+	evicted := 0
+	for _, o := range offenders {
+		if o.Evicted {
+			evicted++
+		}
+	}
+	stats.Record(ctx,
+		network.MisbehaviorOffenders.M(int64(len(offenders))),
+		network.MisbehaviorEvicted.M(int64(evicted)),
+	)
+}
+
+func (mr *MisbehaviorRegistry) markDirty() {
+	select {
+	case mr.dirty <- struct{}{}:
+	default:
+	}
+}
+
+func (mr *MisbehaviorRegistry) saveLoop(ctx context.Context) {
+	defer close(mr.done)
+
+	for {
+		select {
+		case <-mr.stop:
+			return
+		case <-mr.dirty:
+			err := mr.save()
+			if err != nil {
+				inslogger.FromContext(ctx).Error(err)
+			}
+		}
+	}
+}
+
+// save writes reports to temporary file and renames it, so storage file is never left partially written.
+func (mr *MisbehaviorRegistry) save() error {
+	mr.mutex.RLock()
+	stored := make([]storedReports, 0, len(mr.nodes))
+	for ref, reports := range mr.nodes {
+		stored = append(stored, storedReports{Node: ref, Reports: reports})
+	}
+	mr.mutex.RUnlock()
+
+	data, err := json.Marshal(stored)
+	if err != nil {
+		return errors.Wrap(err, "failed to encode misbehavior reports")
+	}
+
+	tmp := mr.cfg.StorageFile + ".tmp"
+	err = ioutil.WriteFile(tmp, data, 0600)
+	if err != nil {
+		return errors.Wrap(err, "failed to write misbehavior reports")
+	}
+	err = os.Rename(tmp, mr.cfg.StorageFile)
+	if err != nil {
+		return errors.Wrap(err, "failed to replace misbehavior reports")
+	}
+	return nil
+}
+
+func categoryName(category misbehavior.Category) string {
+	switch category {
+	case misbehavior.Fraud:
+		return categoryFraud
+	case misbehavior.Blame:
+		return categoryBlame
+	default:
+		return categoryUnknown
+	}
+}
+
+func reportDetails(report misbehavior.Report) string {
+	if err, ok := report.(error); ok {
+		return err.Error()
+	}
+	return fmt.Sprint(report.Details()...)
+}
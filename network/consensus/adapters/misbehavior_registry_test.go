@@ -0,0 +1,228 @@
+// Copyright 2020 Insolar Network Ltd.
+// All rights reserved.
+// This material is licensed under the Insolar License version 1.0,
+// available at https://github.com/insolar/insolar/blob/master/LICENSE.md.
+
+package adapters
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/network/consensus/gcpv2/api/misbehavior"
+	"github.com/insolar/insolar/network/consensus/gcpv2/api/profiles"
+)
+
+func testMisbehaviorConfig() configuration.Misbehavior {
+	return configuration.Misbehavior{
+		FraudScore:          10,
+		BlameScore:          1,
+		ReportTTL:           time.Hour,
+		EvictThreshold:      20,
+		QuarantineThreshold: 12,
+	}
+}
+
+func violator(t *testing.T, ref insolar.Reference) profiles.BaseNode {
+	ext := profiles.NewStaticProfileExtensionMock(t)
+	ext.GetReferenceMock.Return(ref)
+	static := profiles.NewStaticProfileMock(t)
+	static.GetExtensionMock.Return(ext)
+	node := profiles.NewBaseNodeMock(t)
+	node.GetStaticMock.Return(static)
+	return node
+}
+
+func addFraud(mr *MisbehaviorRegistry, node profiles.BaseNode) {
+	fraud := misbehavior.NewFraudFactory(nil).NewNodeFraud(misbehavior.WrongPower, "wrong power", node)
+	mr.AddReport(&fraud)
+}
+
+func addBlame(mr *MisbehaviorRegistry, node profiles.BaseNode) {
+	blame := misbehavior.NewBlameFactory(nil).NewProtocolViolation(node, "protocol violation")
+	mr.AddReport(&blame)
+}
+
+func TestMisbehaviorRegistry_Score(t *testing.T) {
+	mr := NewMisbehaviorRegistry(testMisbehaviorConfig())
+	ref := gen.Reference()
+	node := violator(t, ref)
+
+	addFraud(mr, node)
+	assert.False(t, mr.IsQuarantined(ref))
+	assert.False(t, mr.IsBlacklisted(ref))
+
+	addBlame(mr, node)
+	addBlame(mr, node)
+	assert.True(t, mr.IsQuarantined(ref))
+	assert.False(t, mr.IsBlacklisted(ref))
+
+	addFraud(mr, node)
+	assert.True(t, mr.IsBlacklisted(ref))
+	assert.False(t, mr.IsBlacklisted(gen.Reference()))
+
+	offenders := mr.Offenders()
+	require.Len(t, offenders, 1)
+	assert.Equal(t, ref, offenders[0].Node)
+	assert.Equal(t, 22, offenders[0].Score)
+	assert.True(t, offenders[0].Evicted)
+	assert.True(t, offenders[0].Quarantined)
+	require.Len(t, offenders[0].Reports, 4)
+	assert.Equal(t, categoryFraud, offenders[0].Reports[0].Category)
+	assert.Equal(t, misbehavior.WrongPower, offenders[0].Reports[0].Type)
+	assert.Equal(t, categoryBlame, offenders[0].Reports[1].Category)
+}
+
+func TestMisbehaviorRegistry_DisabledThresholds(t *testing.T) {
+	cfg := testMisbehaviorConfig()
+	cfg.EvictThreshold = 0
+	cfg.QuarantineThreshold = 0
+	mr := NewMisbehaviorRegistry(cfg)
+	ref := gen.Reference()
+	node := violator(t, ref)
+
+	for i := 0; i < 10; i++ {
+		addFraud(mr, node)
+	}
+	assert.False(t, mr.IsBlacklisted(ref))
+	assert.False(t, mr.IsQuarantined(ref))
+	assert.Len(t, mr.Offenders(), 1)
+}
+
+func TestMisbehaviorRegistry_ReportTTL(t *testing.T) {
+	mr := NewMisbehaviorRegistry(testMisbehaviorConfig())
+	now := time.Now()
+	mr.now = func() time.Time { return now }
+	fraudster, blamed := gen.Reference(), gen.Reference()
+
+	addFraud(mr, violator(t, fraudster))
+	now = now.Add(30 * time.Minute)
+	addFraud(mr, violator(t, fraudster))
+	addBlame(mr, violator(t, blamed))
+	assert.True(t, mr.IsBlacklisted(fraudster))
+
+	now = now.Add(45 * time.Minute)
+	assert.False(t, mr.IsBlacklisted(fraudster))
+	assert.False(t, mr.IsQuarantined(fraudster))
+
+	offenders := mr.Offenders()
+	require.Len(t, offenders, 2)
+	assert.Equal(t, fraudster, offenders[0].Node)
+	assert.Equal(t, 10, offenders[0].Score)
+	assert.Len(t, offenders[0].Reports, 1)
+	assert.Equal(t, blamed, offenders[1].Node)
+
+	now = now.Add(time.Hour)
+	assert.Empty(t, mr.Offenders())
+}
+
+func TestMisbehaviorRegistry_ReportsLimit(t *testing.T) {
+	mr := NewMisbehaviorRegistry(testMisbehaviorConfig())
+	node := violator(t, gen.Reference())
+
+	for i := 0; i < maxNodeReports+10; i++ {
+		addBlame(mr, node)
+	}
+	offenders := mr.Offenders()
+	require.Len(t, offenders, 1)
+	assert.Len(t, offenders[0].Reports, maxNodeReports)
+}
+
+func TestMisbehaviorRegistry_HostReport(t *testing.T) {
+	mr := NewMisbehaviorRegistry(testMisbehaviorConfig())
+
+	fraud := misbehavior.NewFraudFactory(nil).NewNodeFraud(misbehavior.WrongPower, "wrong power", nil)
+	mr.AddReport(&fraud)
+	assert.Empty(t, mr.Offenders())
+}
+
+func TestMisbehaviorRegistry_NodeWithoutFullProfile(t *testing.T) {
+	mr := NewMisbehaviorRegistry(testMisbehaviorConfig())
+
+	static := profiles.NewStaticProfileMock(t)
+	static.GetExtensionMock.Return(nil)
+	node := profiles.NewBaseNodeMock(t)
+	node.GetStaticMock.Return(static)
+	addFraud(mr, node)
+	assert.Empty(t, mr.Offenders())
+}
+
+func TestMisbehaviorRegistry_ReportValidationMismatch(t *testing.T) {
+	t.Run("not scored by default", func(t *testing.T) {
+		mr := NewMisbehaviorRegistry(testMisbehaviorConfig())
+		ref := gen.Reference()
+		mr.ReportValidationMismatch(ref, "result differs from registered")
+		mr.ReportValidationMismatch(ref, "result differs from registered")
+		assert.False(t, mr.IsBlacklisted(ref))
+		assert.False(t, mr.IsQuarantined(ref))
+
+		offenders := mr.Offenders()
+		require.Len(t, offenders, 1)
+		require.Len(t, offenders[0].Reports, 2)
+		assert.Equal(t, 0, offenders[0].Score)
+		assert.Equal(t, categoryValidation, offenders[0].Reports[0].Category)
+		assert.Equal(t, "result differs from registered", offenders[0].Reports[0].Details)
+	})
+
+	t.Run("scored by validation score", func(t *testing.T) {
+		cfg := testMisbehaviorConfig()
+		cfg.ValidationScore = 6
+		mr := NewMisbehaviorRegistry(cfg)
+		ref := gen.Reference()
+		mr.ReportValidationMismatch(ref, "result differs from registered")
+		mr.ReportValidationMismatch(ref, "result differs from registered")
+		assert.False(t, mr.IsBlacklisted(ref))
+		assert.True(t, mr.IsQuarantined(ref))
+		assert.Equal(t, 12, mr.Offenders()[0].Score)
+	})
+}
+
+func TestMisbehaviorRegistry_Forgive(t *testing.T) {
+	mr := NewMisbehaviorRegistry(testMisbehaviorConfig())
+	ref := gen.Reference()
+	addFraud(mr, violator(t, ref))
+	addFraud(mr, violator(t, ref))
+	require.True(t, mr.IsBlacklisted(ref))
+
+	assert.True(t, mr.Forgive(ref))
+	assert.False(t, mr.IsBlacklisted(ref))
+	assert.Empty(t, mr.Offenders())
+	assert.False(t, mr.Forgive(ref))
+}
+
+func TestMisbehaviorRegistry_Storage(t *testing.T) {
+	ctx := context.Background()
+	dir, err := ioutil.TempDir("", "misbehavior")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	cfg := testMisbehaviorConfig()
+	cfg.StorageFile = filepath.Join(dir, "reports.json")
+
+	mr := NewMisbehaviorRegistry(cfg)
+	require.NoError(t, mr.Init(ctx))
+	require.NoError(t, mr.Start(ctx))
+	fraudster := gen.Reference()
+	addFraud(mr, violator(t, fraudster))
+	addFraud(mr, violator(t, fraudster))
+	addBlame(mr, violator(t, gen.Reference()))
+	require.NoError(t, mr.Stop(ctx))
+
+	restored := NewMisbehaviorRegistry(cfg)
+	require.NoError(t, restored.Init(ctx))
+	assert.True(t, restored.IsBlacklisted(fraudster))
+	assert.Equal(t, mr.Offenders(), restored.Offenders())
+
+	require.NoError(t, ioutil.WriteFile(cfg.StorageFile, []byte("{"), 0600))
+	assert.Error(t, NewMisbehaviorRegistry(cfg).Init(ctx))
+}
@@ -11,21 +11,11 @@ import (
 	"github.com/insolar/insolar/network/consensus/common/cryptkit"
 	"github.com/insolar/insolar/network/consensus/common/endpoints"
 	"github.com/insolar/insolar/network/consensus/gcpv2/api/census"
-	"github.com/insolar/insolar/network/consensus/gcpv2/api/misbehavior"
 	"github.com/insolar/insolar/network/consensus/gcpv2/api/profiles"
 	"github.com/insolar/insolar/network/consensus/gcpv2/api/proofs"
 	"github.com/insolar/insolar/pulse"
 )
 
-type MisbehaviorRegistry struct{}
-
-func NewMisbehaviorRegistry() *MisbehaviorRegistry {
-	return &MisbehaviorRegistry{}
-}
-
-func (mr *MisbehaviorRegistry) AddReport(report misbehavior.Report) {
-}
-
 type MandateRegistry struct {
 	cloudHash              proofs.CloudStateHash
 	consensusConfiguration census.ConsensusConfiguration
@@ -158,6 +158,7 @@ func initNodes(ctx context.Context, mode consensus.Mode, nodes GeneratedNodes, s
 			EphemeralController: &ephemeralController{
 				allowed: true,
 			},
+			MisbehaviorRegistry: adapters.NewMisbehaviorRegistry(configuration.NewHostNetwork().Misbehavior),
 		}).ControllerFor(mode, datagramHandler, pulseHandler)
 
 		ns.controllers[i] = controller
@@ -75,6 +75,7 @@ type Dep struct {
 	PulseChanger        adapters.PulseChanger
 	StateUpdater        adapters.StateUpdater
 	EphemeralController adapters.EphemeralController
+	MisbehaviorRegistry census.MisbehaviorRegistry
 }
 
 func (cd *Dep) verify() {
@@ -109,7 +110,7 @@ func newConstructor(ctx context.Context, dep *Dep) *constructor {
 		).AsDigestHolder(),
 		c.consensusConfiguration,
 	)
-	c.misbehaviorRegistry = adapters.NewMisbehaviorRegistry()
+	c.misbehaviorRegistry = dep.MisbehaviorRegistry
 	c.offlinePopulation = adapters.NewOfflinePopulation(
 		dep.NodeKeeper,
 		dep.CertificateManager,
@@ -8,6 +8,7 @@ import (
 	mm_time "time"
 
 	"github.com/gojuno/minimock/v3"
+	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/network/consensus/gcpv2/api/misbehavior"
 )
 
@@ -20,6 +21,12 @@ type MisbehaviorRegistryMock struct {
 	afterAddReportCounter  uint64
 	beforeAddReportCounter uint64
 	AddReportMock          mMisbehaviorRegistryMockAddReport
+
+	funcIsBlacklisted          func(ref insolar.Reference) (b1 bool)
+	inspectFuncIsBlacklisted   func(ref insolar.Reference)
+	afterIsBlacklistedCounter  uint64
+	beforeIsBlacklistedCounter uint64
+	IsBlacklistedMock          mMisbehaviorRegistryMockIsBlacklisted
 }
 
 // NewMisbehaviorRegistryMock returns a mock for MisbehaviorRegistry
@@ -32,6 +39,9 @@ func NewMisbehaviorRegistryMock(t minimock.Tester) *MisbehaviorRegistryMock {
 	m.AddReportMock = mMisbehaviorRegistryMockAddReport{mock: m}
 	m.AddReportMock.callArgs = []*MisbehaviorRegistryMockAddReportParams{}
 
+	m.IsBlacklistedMock = mMisbehaviorRegistryMockIsBlacklisted{mock: m}
+	m.IsBlacklistedMock.callArgs = []*MisbehaviorRegistryMockIsBlacklistedParams{}
+
 	return m
 }
 
@@ -101,7 +111,7 @@ func (mmAddReport *mMisbehaviorRegistryMockAddReport) Return() *MisbehaviorRegis
 	return mmAddReport.mock
 }
 
-//Set uses given function f to mock the MisbehaviorRegistry.AddReport method
+// Set uses given function f to mock the MisbehaviorRegistry.AddReport method
 func (mmAddReport *mMisbehaviorRegistryMockAddReport) Set(f func(report misbehavior.Report)) *MisbehaviorRegistryMock {
 	if mmAddReport.defaultExpectation != nil {
 		mmAddReport.mock.t.Fatalf("Default expectation is already set for the MisbehaviorRegistry.AddReport method")
@@ -222,10 +232,227 @@ func (m *MisbehaviorRegistryMock) MinimockAddReportInspect() {
 	}
 }
 
+type mMisbehaviorRegistryMockIsBlacklisted struct {
+	mock               *MisbehaviorRegistryMock
+	defaultExpectation *MisbehaviorRegistryMockIsBlacklistedExpectation
+	expectations       []*MisbehaviorRegistryMockIsBlacklistedExpectation
+
+	callArgs []*MisbehaviorRegistryMockIsBlacklistedParams
+	mutex    sync.RWMutex
+}
+
+// MisbehaviorRegistryMockIsBlacklistedExpectation specifies expectation struct of the MisbehaviorRegistry.IsBlacklisted
+type MisbehaviorRegistryMockIsBlacklistedExpectation struct {
+	mock    *MisbehaviorRegistryMock
+	params  *MisbehaviorRegistryMockIsBlacklistedParams
+	results *MisbehaviorRegistryMockIsBlacklistedResults
+	Counter uint64
+}
+
+// MisbehaviorRegistryMockIsBlacklistedParams contains parameters of the MisbehaviorRegistry.IsBlacklisted
+type MisbehaviorRegistryMockIsBlacklistedParams struct {
+	ref insolar.Reference
+}
+
+// MisbehaviorRegistryMockIsBlacklistedResults contains results of the MisbehaviorRegistry.IsBlacklisted
+type MisbehaviorRegistryMockIsBlacklistedResults struct {
+	b1 bool
+}
+
+// Expect sets up expected params for MisbehaviorRegistry.IsBlacklisted
+func (mmIsBlacklisted *mMisbehaviorRegistryMockIsBlacklisted) Expect(ref insolar.Reference) *mMisbehaviorRegistryMockIsBlacklisted {
+	if mmIsBlacklisted.mock.funcIsBlacklisted != nil {
+		mmIsBlacklisted.mock.t.Fatalf("MisbehaviorRegistryMock.IsBlacklisted mock is already set by Set")
+	}
+
+	if mmIsBlacklisted.defaultExpectation == nil {
+		mmIsBlacklisted.defaultExpectation = &MisbehaviorRegistryMockIsBlacklistedExpectation{}
+	}
+
+	mmIsBlacklisted.defaultExpectation.params = &MisbehaviorRegistryMockIsBlacklistedParams{ref}
+	for _, e := range mmIsBlacklisted.expectations {
+		if minimock.Equal(e.params, mmIsBlacklisted.defaultExpectation.params) {
+			mmIsBlacklisted.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmIsBlacklisted.defaultExpectation.params)
+		}
+	}
+
+	return mmIsBlacklisted
+}
+
+// Inspect accepts an inspector function that has same arguments as the MisbehaviorRegistry.IsBlacklisted
+func (mmIsBlacklisted *mMisbehaviorRegistryMockIsBlacklisted) Inspect(f func(ref insolar.Reference)) *mMisbehaviorRegistryMockIsBlacklisted {
+	if mmIsBlacklisted.mock.inspectFuncIsBlacklisted != nil {
+		mmIsBlacklisted.mock.t.Fatalf("Inspect function is already set for MisbehaviorRegistryMock.IsBlacklisted")
+	}
+
+	mmIsBlacklisted.mock.inspectFuncIsBlacklisted = f
+
+	return mmIsBlacklisted
+}
+
+// Return sets up results that will be returned by MisbehaviorRegistry.IsBlacklisted
+func (mmIsBlacklisted *mMisbehaviorRegistryMockIsBlacklisted) Return(b1 bool) *MisbehaviorRegistryMock {
+	if mmIsBlacklisted.mock.funcIsBlacklisted != nil {
+		mmIsBlacklisted.mock.t.Fatalf("MisbehaviorRegistryMock.IsBlacklisted mock is already set by Set")
+	}
+
+	if mmIsBlacklisted.defaultExpectation == nil {
+		mmIsBlacklisted.defaultExpectation = &MisbehaviorRegistryMockIsBlacklistedExpectation{mock: mmIsBlacklisted.mock}
+	}
+	mmIsBlacklisted.defaultExpectation.results = &MisbehaviorRegistryMockIsBlacklistedResults{b1}
+	return mmIsBlacklisted.mock
+}
+
+// Set uses given function f to mock the MisbehaviorRegistry.IsBlacklisted method
+func (mmIsBlacklisted *mMisbehaviorRegistryMockIsBlacklisted) Set(f func(ref insolar.Reference) (b1 bool)) *MisbehaviorRegistryMock {
+	if mmIsBlacklisted.defaultExpectation != nil {
+		mmIsBlacklisted.mock.t.Fatalf("Default expectation is already set for the MisbehaviorRegistry.IsBlacklisted method")
+	}
+
+	if len(mmIsBlacklisted.expectations) > 0 {
+		mmIsBlacklisted.mock.t.Fatalf("Some expectations are already set for the MisbehaviorRegistry.IsBlacklisted method")
+	}
+
+	mmIsBlacklisted.mock.funcIsBlacklisted = f
+	return mmIsBlacklisted.mock
+}
+
+// When sets expectation for the MisbehaviorRegistry.IsBlacklisted which will trigger the result defined by the following
+// Then helper
+func (mmIsBlacklisted *mMisbehaviorRegistryMockIsBlacklisted) When(ref insolar.Reference) *MisbehaviorRegistryMockIsBlacklistedExpectation {
+	if mmIsBlacklisted.mock.funcIsBlacklisted != nil {
+		mmIsBlacklisted.mock.t.Fatalf("MisbehaviorRegistryMock.IsBlacklisted mock is already set by Set")
+	}
+
+	expectation := &MisbehaviorRegistryMockIsBlacklistedExpectation{
+		mock:   mmIsBlacklisted.mock,
+		params: &MisbehaviorRegistryMockIsBlacklistedParams{ref},
+	}
+	mmIsBlacklisted.expectations = append(mmIsBlacklisted.expectations, expectation)
+	return expectation
+}
+
+// Then sets up MisbehaviorRegistry.IsBlacklisted return parameters for the expectation previously defined by the When method
+func (e *MisbehaviorRegistryMockIsBlacklistedExpectation) Then(b1 bool) *MisbehaviorRegistryMock {
+	e.results = &MisbehaviorRegistryMockIsBlacklistedResults{b1}
+	return e.mock
+}
+
+// IsBlacklisted implements MisbehaviorRegistry
+func (mmIsBlacklisted *MisbehaviorRegistryMock) IsBlacklisted(ref insolar.Reference) (b1 bool) {
+	mm_atomic.AddUint64(&mmIsBlacklisted.beforeIsBlacklistedCounter, 1)
+	defer mm_atomic.AddUint64(&mmIsBlacklisted.afterIsBlacklistedCounter, 1)
+
+	if mmIsBlacklisted.inspectFuncIsBlacklisted != nil {
+		mmIsBlacklisted.inspectFuncIsBlacklisted(ref)
+	}
+
+	mm_params := &MisbehaviorRegistryMockIsBlacklistedParams{ref}
+
+	// Record call args
+	mmIsBlacklisted.IsBlacklistedMock.mutex.Lock()
+	mmIsBlacklisted.IsBlacklistedMock.callArgs = append(mmIsBlacklisted.IsBlacklistedMock.callArgs, mm_params)
+	mmIsBlacklisted.IsBlacklistedMock.mutex.Unlock()
+
+	for _, e := range mmIsBlacklisted.IsBlacklistedMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1
+		}
+	}
+
+	if mmIsBlacklisted.IsBlacklistedMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmIsBlacklisted.IsBlacklistedMock.defaultExpectation.Counter, 1)
+		mm_want := mmIsBlacklisted.IsBlacklistedMock.defaultExpectation.params
+		mm_got := MisbehaviorRegistryMockIsBlacklistedParams{ref}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmIsBlacklisted.t.Errorf("MisbehaviorRegistryMock.IsBlacklisted got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmIsBlacklisted.IsBlacklistedMock.defaultExpectation.results
+		if mm_results == nil {
+			mmIsBlacklisted.t.Fatal("No results are set for the MisbehaviorRegistryMock.IsBlacklisted")
+		}
+		return (*mm_results).b1
+	}
+	if mmIsBlacklisted.funcIsBlacklisted != nil {
+		return mmIsBlacklisted.funcIsBlacklisted(ref)
+	}
+	mmIsBlacklisted.t.Fatalf("Unexpected call to MisbehaviorRegistryMock.IsBlacklisted. %v", ref)
+	return
+}
+
+// IsBlacklistedAfterCounter returns a count of finished MisbehaviorRegistryMock.IsBlacklisted invocations
+func (mmIsBlacklisted *MisbehaviorRegistryMock) IsBlacklistedAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmIsBlacklisted.afterIsBlacklistedCounter)
+}
+
+// IsBlacklistedBeforeCounter returns a count of MisbehaviorRegistryMock.IsBlacklisted invocations
+func (mmIsBlacklisted *MisbehaviorRegistryMock) IsBlacklistedBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmIsBlacklisted.beforeIsBlacklistedCounter)
+}
+
+// Calls returns a list of arguments used in each call to MisbehaviorRegistryMock.IsBlacklisted.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmIsBlacklisted *mMisbehaviorRegistryMockIsBlacklisted) Calls() []*MisbehaviorRegistryMockIsBlacklistedParams {
+	mmIsBlacklisted.mutex.RLock()
+
+	argCopy := make([]*MisbehaviorRegistryMockIsBlacklistedParams, len(mmIsBlacklisted.callArgs))
+	copy(argCopy, mmIsBlacklisted.callArgs)
+
+	mmIsBlacklisted.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockIsBlacklistedDone returns true if the count of the IsBlacklisted invocations corresponds
+// the number of defined expectations
+func (m *MisbehaviorRegistryMock) MinimockIsBlacklistedDone() bool {
+	for _, e := range m.IsBlacklistedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.IsBlacklistedMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterIsBlacklistedCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcIsBlacklisted != nil && mm_atomic.LoadUint64(&m.afterIsBlacklistedCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockIsBlacklistedInspect logs each unmet expectation
+func (m *MisbehaviorRegistryMock) MinimockIsBlacklistedInspect() {
+	for _, e := range m.IsBlacklistedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to MisbehaviorRegistryMock.IsBlacklisted with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.IsBlacklistedMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterIsBlacklistedCounter) < 1 {
+		if m.IsBlacklistedMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to MisbehaviorRegistryMock.IsBlacklisted")
+		} else {
+			m.t.Errorf("Expected call to MisbehaviorRegistryMock.IsBlacklisted with params: %#v", *m.IsBlacklistedMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcIsBlacklisted != nil && mm_atomic.LoadUint64(&m.afterIsBlacklistedCounter) < 1 {
+		m.t.Error("Expected call to MisbehaviorRegistryMock.IsBlacklisted")
+	}
+}
+
 // MinimockFinish checks that all mocked methods have been called the expected number of times
 func (m *MisbehaviorRegistryMock) MinimockFinish() {
 	if !m.minimockDone() {
 		m.MinimockAddReportInspect()
+
+		m.MinimockIsBlacklistedInspect()
 		m.t.FailNow()
 	}
 }
@@ -249,5 +476,6 @@ func (m *MisbehaviorRegistryMock) MinimockWait(timeout mm_time.Duration) {
 func (m *MisbehaviorRegistryMock) minimockDone() bool {
 	done := true
 	return done &&
-		m.MinimockAddReportDone()
+		m.MinimockAddReportDone() &&
+		m.MinimockIsBlacklistedDone()
 }
@@ -6,6 +6,7 @@
 package census
 
 import (
+	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/network/consensus/common/cryptkit"
 	"github.com/insolar/insolar/network/consensus/common/endpoints"
 	"github.com/insolar/insolar/network/consensus/gcpv2/api/misbehavior"
@@ -31,6 +32,8 @@ type VersionedRegistries interface {
 
 type MisbehaviorRegistry interface {
 	AddReport(report misbehavior.Report)
+	// IsBlacklisted returns true if the node should be evicted from population for its misbehavior.
+	IsBlacklisted(ref insolar.Reference) bool
 }
 
 //go:generate minimock -i github.com/insolar/insolar/network/consensus/gcpv2/api/census.MandateRegistry -o . -s _mock.go -g
@@ -617,6 +617,15 @@ func (c *NodeAppearance) RegisterFraud(fraud misbehavior.FraudError) error {
 	return err
 }
 
+// InitBlacklisted marks the node, that is blacklisted by misbehavior registry, as a fraud.
+// It should be called before the node is added to population.
+func (c *NodeAppearance) InitBlacklisted() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.trust.Update(member.FraudByBlacklist)
+}
+
 func (c *NodeAppearance) RegisterBlame(blame misbehavior.BlameError) {
 	// TODO RegisterBlame
 	// inslogger.FromContext(ctx).Error(blame)
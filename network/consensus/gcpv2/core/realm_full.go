@@ -221,7 +221,12 @@ func (r *FullRealm) initHandlers(needsDynamic bool, populationCount int,
 func (r *FullRealm) initPopulation(needsDynamic bool, population census.OnlinePopulation,
 	individualHandlers []PerNodePacketDispatcherFactory, nodeCallback NodeUpdateCallback) {
 
+	misbehaviorRegistry := r.census.GetMisbehaviorRegistry()
 	initNodeFn := func(ctx context.Context, n *pop.NodeAppearance) []pop.DispatchMemberPacketFunc {
+		if ext := n.GetStatic().GetExtension(); !n.IsLocal() && ext != nil && misbehaviorRegistry.IsBlacklisted(ext.GetReference()) {
+			inslogger.FromContext(ctx).Warnf("Node %d is blacklisted for misbehavior", n.GetNodeID())
+			n.InitBlacklisted()
+		}
 		if len(individualHandlers) == 0 {
 			return nil
 		}
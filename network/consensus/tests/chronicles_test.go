@@ -101,6 +101,10 @@ func (c *EmuVersionedRegistries) FindRegisteredProfile(identity endpoints.Inboun
 func (c *EmuVersionedRegistries) AddReport(report misbehavior.Report) {
 }
 
+func (c *EmuVersionedRegistries) IsBlacklisted(ref insolar.Reference) bool {
+	return false
+}
+
 func (c *EmuVersionedRegistries) CommitNextPulse(pd pulse.Data, population census.OnlinePopulation) census.VersionedRegistries {
 	pd.EnsurePulseData()
 	cp := *c
@@ -59,22 +59,15 @@ func (pc *pulseController) verifyPulseSign(pulse insolar.Pulse) error {
 	if len(pulse.Signs) == 0 {
 		return errors.New("received empty pulse signs")
 	}
-	hashProvider := pc.CryptographyScheme.IntegrityHasher()
 	for key, psc := range pulse.Signs {
-		payload := pulsar.PulseSenderConfirmationPayload{PulseSenderConfirmation: psc}
-		hash, err := payload.Hash(hashProvider)
-		if err != nil {
-			return errors.Wrap(err, "failed to get hash from pulse payload")
-		}
 		pk, err := pc.KeyProcessor.ImportPublicKeyPEM([]byte(key))
 		if err != nil {
 			return errors.Wrap(err, "failed to import public key")
 		}
 
-		verified := pc.CryptographyService.Verify(pk, insolar.SignatureFromBytes(psc.Signature), hash)
-
-		if !verified {
-			return errors.New("cryptographic signature verification failed")
+		err = pulsar.VerifyConfirmation(pc.CryptographyService, pc.CryptographyScheme, pk, psc)
+		if err != nil {
+			return err
 		}
 	}
 	return nil
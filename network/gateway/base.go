@@ -58,6 +58,7 @@ type Base struct {
 	KeyProcessor        insolar.KeyProcessor               `inject:""`
 	Aborter             network.Aborter                    `inject:""`
 	TransportFactory    transport.Factory                  `inject:""`
+	MisbehaviorRegistry network.MisbehaviorRegistry        `inject:""`
 
 	// nolint
 	OriginProvider network.OriginProvider `inject:""`
@@ -158,6 +159,7 @@ func (g *Base) initConsensus(ctx context.Context) error {
 		StateUpdater:        proxy,
 		DatagramTransport:   g.datagramTransport,
 		EphemeralController: g,
+		MisbehaviorRegistry: g.MisbehaviorRegistry,
 	})
 
 	// transport start should be here because of TestComponents tests, couldn't createOriginCandidate with 0 port
@@ -337,6 +339,11 @@ func (g *Base) HandleNodeBootstrapRequest(ctx context.Context, request network.R
 
 	data := request.GetRequest().GetBootstrap()
 
+	if g.MisbehaviorRegistry.IsQuarantined(data.CandidateProfile.Ref) {
+		inslogger.FromContext(ctx).Warnf("Rejected bootstrap request from quarantined node %s", request.GetSender())
+		return g.HostNetwork.BuildResponse(ctx, request, &packet.BootstrapResponse{Code: packet.Reject}), nil
+	}
+
 	bootstrapPulse := GetBootstrapPulse(ctx, g.PulseAccessor)
 
 	if network.CheckShortIDCollision(g.NodeKeeper.GetAccessor(bootstrapPulse.PulseNumber).GetActiveNodes(), data.CandidateProfile.ShortID) {
@@ -11,6 +11,7 @@ import (
 
 	component "github.com/insolar/component-manager"
 	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/network/consensus/gcpv2/api/census"
 	"github.com/insolar/insolar/network/consensus/gcpv2/api/member"
 	"github.com/insolar/insolar/network/hostnetwork/host"
 	"github.com/insolar/insolar/network/hostnetwork/packet"
@@ -212,6 +213,47 @@ type TerminationHandler interface {
 	// Leave locks until network accept leaving claim
 	Leave(context.Context, insolar.PulseNumber)
 	OnLeaveApproved(context.Context)
+	// OnLeaveTimeout marks, that network hasn't approved leaving claim in time
+	OnLeaveTimeout(context.Context)
 	// Terminating is an accessor
 	Terminating() bool
+	// RequestLeave starts draining of the node, leave is claimed after given count of pulses when work is done
+	RequestLeave(context.Context, insolar.PulseNumber) error
+	// LeaveStatus returns progress of the leave
+	LeaveStatus(context.Context) insolar.LeaveStatus
+	// Left returns channel, that is closed when network approves leave
+	Left() <-chan struct{}
+}
+
+//go:generate minimock -i github.com/insolar/insolar/network.MisbehaviorRegistry -o ../testutils/network -s _mock.go -g
+
+// MisbehaviorRegistry keeps misbehavior reports of consensus and scores nodes by them.
+type MisbehaviorRegistry interface {
+	census.MisbehaviorRegistry
+
+	// IsQuarantined returns true if the node isn't allowed to join the network.
+	IsQuarantined(ref insolar.Reference) bool
+	// Offenders returns nodes with actual reports, the worst offenders go first.
+	Offenders() []Offender
+	// Forgive removes reports of the node. It returns false if the node has no reports.
+	Forgive(ref insolar.Reference) bool
+	// ReportValidationMismatch adds report about the node, whose execution result differs from validator one.
+	ReportValidationMismatch(ref insolar.Reference, details string)
+}
+
+// Offender is a node with misbehavior reports.
+type Offender struct {
+	Node        insolar.Reference   `json:"node"`
+	Score       int                 `json:"score"`
+	Evicted     bool                `json:"evicted"`
+	Quarantined bool                `json:"quarantined"`
+	Reports     []MisbehaviorReport `json:"reports"`
+}
+
+// MisbehaviorReport is a consensus report about misbehavior of the node.
+type MisbehaviorReport struct {
+	Time     time.Time `json:"time"`
+	Category string    `json:"category"`
+	Type     int       `json:"type"`
+	Details  string    `json:"details"`
 }
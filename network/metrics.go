@@ -15,6 +15,8 @@ import (
 var (
 	// TagPhase is a tag for consensus metrics.
 	TagPhase = insmetrics.MustTagKey("phase")
+	// TagMisbehaviorCategory is a tag for misbehavior reports metrics.
+	TagMisbehaviorCategory = insmetrics.MustTagKey("category")
 )
 
 var (
@@ -31,6 +33,13 @@ var (
 	FailedCheckProof = stats.Int64("consensus_proof_failed", "Consensus validate proof fails", stats.UnitDimensionless)
 	// ActiveNodes active nodes count after consensus.
 	ActiveNodes = stats.Int64("consensus_active_nodes_count", "Active nodes count after consensus", stats.UnitDimensionless)
+
+	// MisbehaviorReports consensus misbehavior reports counter.
+	MisbehaviorReports = stats.Int64("consensus_misbehavior_reports", "Consensus misbehavior reports counter", stats.UnitDimensionless)
+	// MisbehaviorOffenders count of nodes with misbehavior reports.
+	MisbehaviorOffenders = stats.Int64("consensus_misbehavior_offenders", "Count of nodes with misbehavior reports", stats.UnitDimensionless)
+	// MisbehaviorEvicted count of nodes evicted for misbehavior.
+	MisbehaviorEvicted = stats.Int64("consensus_misbehavior_evicted", "Count of nodes evicted for misbehavior", stats.UnitDimensionless)
 )
 
 func init() {
@@ -90,6 +99,23 @@ func init() {
 			Measure:     ActiveNodes,
 			Aggregation: view.LastValue(),
 		},
+		&view.View{
+			Name:        MisbehaviorReports.Name(),
+			Description: MisbehaviorReports.Description(),
+			Measure:     MisbehaviorReports,
+			Aggregation: view.Count(),
+			TagKeys:     []tag.Key{TagMisbehaviorCategory},
+		},
+		&view.View{
+			Name:        MisbehaviorOffenders.Name(),
+			Measure:     MisbehaviorOffenders,
+			Aggregation: view.LastValue(),
+		},
+		&view.View{
+			Name:        MisbehaviorEvicted.Name(),
+			Measure:     MisbehaviorEvicted,
+			Aggregation: view.LastValue(),
+		},
 	)
 	if err != nil {
 		panic(err)
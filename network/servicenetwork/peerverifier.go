@@ -0,0 +1,159 @@
+// Copyright 2020 Insolar Network Ltd.
+// All rights reserved.
+// This material is licensed under the Insolar License version 1.0,
+// available at https://github.com/insolar/insolar/blob/master/LICENSE.md.
+
+package servicenetwork
+
+import (
+	"context"
+	"crypto"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/certificate"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/network"
+	"github.com/insolar/insolar/network/storage"
+	"github.com/insolar/insolar/network/transport"
+)
+
+// peerVerifier checks keys of peers in TLS transport.
+// Peer is known, if its key is in the certificate, in the active node list or in the list of pulsar keys.
+// Keys of outgoing connections must also match the dialed address.
+// Nodes, that join the network, aren't known yet, so they are accepted by authorization certificate
+// signed by discovery nodes, the same one they are authorized with.
+type peerVerifier struct {
+	certificate   insolar.Certificate
+	nodeKeeper    func() network.NodeKeeper
+	pulseAccessor func() storage.PulseAccessor
+	pulsarKeys    []crypto.PublicKey
+
+	cryptographyService insolar.CryptographyService
+	keyProcessor        insolar.KeyProcessor
+}
+
+type peer struct {
+	address string
+	key     crypto.PublicKey
+}
+
+func (v *peerVerifier) VerifyPeer(address string, key crypto.PublicKey, authCert []byte) error {
+	if address == "" {
+		for _, pk := range v.pulsarKeys {
+			if equalKeys(pk, key) {
+				return nil
+			}
+		}
+	}
+
+	for _, p := range v.peers() {
+		if address != "" && p.address != address {
+			continue
+		}
+		if equalKeys(p.key, key) {
+			return nil
+		}
+		if address != "" {
+			return errors.Errorf("key of peer %s doesn't match the key of the node with this address", address)
+		}
+	}
+
+	if len(authCert) > 0 {
+		return v.verifyAuthCert(key, authCert)
+	}
+	if address != "" {
+		return errors.Errorf("peer %s is not in certificate or active node list", address)
+	}
+	return errors.New("peer key is not in certificate or active node list")
+}
+
+func (v *peerVerifier) verifyAuthCert(key crypto.PublicKey, data []byte) error {
+	authCert, err := certificate.Deserialize(data, v.keyProcessor)
+	if err != nil {
+		return errors.Wrap(err, "failed to deserialize authorization certificate of peer")
+	}
+	if !equalKeys(authCert.GetPublicKey(), key) {
+		return errors.New("peer key doesn't match the key of its authorization certificate")
+	}
+	valid, err := certificate.VerifyAuthorizationCertificate(v.cryptographyService, v.certificate.GetDiscoveryNodes(), authCert)
+	if err != nil {
+		return errors.Wrap(err, "failed to verify authorization certificate of peer")
+	}
+	if !valid {
+		return errors.New("authorization certificate of peer isn't signed by discovery nodes")
+	}
+	return nil
+}
+
+func (v *peerVerifier) peers() []peer {
+	var result []peer
+	for _, n := range v.certificate.GetDiscoveryNodes() {
+		result = append(result, peer{address: n.GetHost(), key: n.GetPublicKey()})
+	}
+	for _, n := range v.activeNodes() {
+		result = append(result, peer{address: n.Address(), key: n.PublicKey()})
+	}
+	return result
+}
+
+func (v *peerVerifier) activeNodes() []insolar.NetworkNode {
+	nodeKeeper, pulseAccessor := v.nodeKeeper(), v.pulseAccessor()
+	if nodeKeeper == nil || pulseAccessor == nil {
+		return nil
+	}
+	// there is no pulse before the node joins the network, peer is checked by certificate then
+	latest, err := pulseAccessor.GetLatestPulse(context.Background())
+	if err != nil {
+		return nil
+	}
+	// snapshot of active nodes is saved before the pulse, so it exists for the latest pulse
+	return nodeKeeper.GetAccessor(latest.PulseNumber).GetActiveNodes()
+}
+
+func equalKeys(a, b crypto.PublicKey) bool {
+	key, ok := a.(interface{ Equal(crypto.PublicKey) bool })
+	return ok && key.Equal(b)
+}
+
+// newTransportFactory creates transport factory for the configured protocol.
+func (n *ServiceNetwork) newTransportFactory(cert insolar.Certificate) (transport.Factory, error) {
+	if n.cfg.Transport.Protocol != "TLS" {
+		return transport.NewFactory(n.cfg.Transport), nil
+	}
+
+	provider, ok := n.CryptographyService.(transport.SignerProvider)
+	if !ok {
+		return nil, errors.New("cryptography service doesn't support TLS transport")
+	}
+	signer, err := provider.CryptoSigner()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get signer for TLS transport")
+	}
+
+	authCert, err := certificate.Serialize(cert)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to serialize certificate for TLS transport")
+	}
+
+	verifier := &peerVerifier{
+		certificate:         cert,
+		nodeKeeper:          func() network.NodeKeeper { return n.NodeKeeper },
+		pulseAccessor:       func() storage.PulseAccessor { return n.PulseAccessor },
+		cryptographyService: n.CryptographyService,
+		keyProcessor:        n.KeyProcessor,
+	}
+	for _, pem := range n.cfg.PulsarPublicKeys {
+		key, err := n.KeyProcessor.ImportPublicKeyPEM([]byte(pem))
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to import pulsar public key")
+		}
+		verifier.pulsarKeys = append(verifier.pulsarKeys, key)
+	}
+
+	return transport.NewSecureFactory(n.cfg.Transport, transport.Credentials{
+		Signer:   signer,
+		Verifier: verifier,
+		AuthCert: authCert,
+	}), nil
+}
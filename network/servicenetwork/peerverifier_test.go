@@ -0,0 +1,206 @@
+// Copyright 2020 Insolar Network Ltd.
+// All rights reserved.
+// This material is licensed under the Insolar License version 1.0,
+// available at https://github.com/insolar/insolar/blob/master/LICENSE.md.
+
+package servicenetwork
+
+import (
+	"context"
+	"crypto"
+	"io"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/ugorji/go/codec"
+
+	"github.com/insolar/insolar/certificate"
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/cryptography"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/network"
+	"github.com/insolar/insolar/network/node"
+	"github.com/insolar/insolar/network/storage"
+	"github.com/insolar/insolar/network/transport"
+	"github.com/insolar/insolar/platformpolicy"
+	"github.com/insolar/insolar/testutils"
+	networkUtils "github.com/insolar/insolar/testutils/network"
+)
+
+func generateKey(t *testing.T) crypto.PublicKey {
+	_, key := generateKeyPair(t)
+	return key
+}
+
+func generateKeyPair(t *testing.T) (crypto.PrivateKey, crypto.PublicKey) {
+	proc := platformpolicy.NewKeyProcessor()
+	key, err := proc.GeneratePrivateKey()
+	require.NoError(t, err)
+	return key, proc.ExtractPublicKey(key)
+}
+
+// authCert creates authorization certificate of the node signed by provided discovery nodes.
+func authCert(t *testing.T, key crypto.PublicKey, signers map[insolar.Reference]crypto.PrivateKey) []byte {
+	pem, err := platformpolicy.NewKeyProcessor().ExportPublicKeyPEM(key)
+	require.NoError(t, err)
+	cert := &certificate.AuthorizationCertificate{
+		PublicKey:      string(pem),
+		Reference:      gen.Reference().String(),
+		Role:           insolar.StaticRoleVirtual.String(),
+		DiscoverySigns: map[insolar.Reference][]byte{},
+	}
+	for ref, signer := range signers {
+		cert.DiscoverySigns[ref], err = cert.SignNodePart(signer)
+		require.NoError(t, err)
+	}
+	// canonical encoding doesn't depend on the order of map iteration
+	var data []byte
+	err = codec.NewEncoderBytes(&data, &codec.CborHandle{BasicHandle: codec.BasicHandle{EncodeOptions: codec.EncodeOptions{Canonical: true}}}).Encode(cert)
+	require.NoError(t, err)
+	return data
+}
+
+func TestPeerVerifier_VerifyPeer(t *testing.T) {
+	discoveryKey, activeKey, pulsarKey, unknownKey := generateKey(t), generateKey(t), generateKey(t), generateKey(t)
+
+	discovery := testutils.NewDiscoveryNodeMock(t)
+	discovery.GetHostMock.Return("127.0.0.1:1000")
+	discovery.GetPublicKeyMock.Return(discoveryKey)
+	cert := testutils.NewCertificateMock(t)
+	cert.GetDiscoveryNodesMock.Return([]insolar.DiscoveryNode{discovery})
+
+	accessor := networkUtils.NewAccessorMock(t)
+	accessor.GetActiveNodesMock.Return([]insolar.NetworkNode{
+		node.NewNode(gen.Reference(), insolar.StaticRoleVirtual, activeKey, "127.0.0.1:2000", ""),
+	})
+	nodeKeeper := networkUtils.NewNodeKeeperMock(t)
+	nodeKeeper.GetAccessorMock.Set(func(insolar.PulseNumber) network.Accessor { return accessor })
+	pulseAccessor := networkUtils.NewPulseAccessorMock(t)
+	pulseAccessor.GetLatestPulseMock.Return(*insolar.GenesisPulse, nil)
+
+	v := &peerVerifier{
+		certificate:   cert,
+		nodeKeeper:    func() network.NodeKeeper { return nodeKeeper },
+		pulseAccessor: func() storage.PulseAccessor { return pulseAccessor },
+		pulsarKeys:    []crypto.PublicKey{pulsarKey},
+	}
+
+	// outgoing connections
+	require.NoError(t, v.VerifyPeer("127.0.0.1:1000", discoveryKey, nil))
+	require.NoError(t, v.VerifyPeer("127.0.0.1:2000", activeKey, nil))
+	require.Error(t, v.VerifyPeer("127.0.0.1:1000", activeKey, nil))
+	require.Error(t, v.VerifyPeer("127.0.0.1:3000", unknownKey, nil))
+	require.Error(t, v.VerifyPeer("127.0.0.1:3000", pulsarKey, nil))
+
+	// incoming connections
+	require.NoError(t, v.VerifyPeer("", discoveryKey, nil))
+	require.NoError(t, v.VerifyPeer("", activeKey, nil))
+	require.NoError(t, v.VerifyPeer("", pulsarKey, nil))
+	require.Error(t, v.VerifyPeer("", unknownKey, nil))
+
+	// active nodes are not known before the first pulse, discovery nodes are still accepted
+	pulseAccessor.GetLatestPulseMock.Return(insolar.Pulse{}, errors.New("no pulse"))
+	require.NoError(t, v.VerifyPeer("", discoveryKey, nil))
+	require.Error(t, v.VerifyPeer("", activeKey, nil))
+}
+
+type streamHandler chan []byte
+
+func (h streamHandler) HandleStream(_ context.Context, _ string, stream io.ReadWriteCloser) {
+	buf := make([]byte, 3)
+	if _, err := io.ReadFull(stream, buf); err == nil {
+		h <- buf
+	}
+	_ = stream.Close()
+}
+
+func TestPeerVerifier_JoinerOverTLS(t *testing.T) {
+	ctx := context.Background()
+	proc := platformpolicy.NewKeyProcessor()
+	discoveryPrivate, discoveryKey := generateKeyPair(t)
+	discoveryRef := gen.Reference()
+
+	var discoveryTransport transport.StreamTransport
+	discovery := testutils.NewDiscoveryNodeMock(t)
+	discovery.GetHostMock.Set(func() string { return discoveryTransport.Address() })
+	discovery.GetPublicKeyMock.Return(discoveryKey)
+	discovery.GetNodeRefMock.Return(&discoveryRef)
+	cert := testutils.NewCertificateMock(t)
+	cert.GetDiscoveryNodesMock.Return([]insolar.DiscoveryNode{discovery})
+
+	// node isn't in the network yet, so it's known by certificate only
+	start := func(privateKey crypto.PrivateKey, authCert []byte) (transport.StreamTransport, streamHandler) {
+		verifier := &peerVerifier{
+			certificate:         cert,
+			nodeKeeper:          func() network.NodeKeeper { return nil },
+			pulseAccessor:       func() storage.PulseAccessor { return nil },
+			cryptographyService: cryptography.NewKeyBoundCryptographyService(privateKey),
+			keyProcessor:        proc,
+		}
+		factory := transport.NewSecureFactory(
+			configuration.Transport{Protocol: "TLS", Address: "127.0.0.1:0"},
+			transport.Credentials{Signer: privateKey.(crypto.Signer), Verifier: verifier, AuthCert: authCert},
+		)
+		received := make(streamHandler, 1)
+		tr, err := factory.CreateStreamTransport(received)
+		require.NoError(t, err)
+		require.NoError(t, tr.Start(ctx))
+		return tr, received
+	}
+	send := func(from, to transport.StreamTransport) error {
+		conn, err := from.Dial(ctx, to.Address())
+		if err != nil {
+			return err
+		}
+		defer conn.Close()
+		_, err = conn.Write([]byte{1, 2, 3})
+		if err != nil {
+			return err
+		}
+		// TLS 1.3 client finishes handshake before server checks its certificate
+		_, err = conn.Read(make([]byte, 1))
+		if err == io.EOF {
+			return nil
+		}
+		return err
+	}
+
+	discoveryTransport, discoveryReceived := start(discoveryPrivate, authCert(t, discoveryKey, map[insolar.Reference]crypto.PrivateKey{discoveryRef: discoveryPrivate}))
+	defer discoveryTransport.Stop(ctx) // nolint
+
+	t.Run("joiner with signed certificate", func(t *testing.T) {
+		joinerPrivate, joinerKey := generateKeyPair(t)
+		joiner, joinerReceived := start(joinerPrivate, authCert(t, joinerKey, map[insolar.Reference]crypto.PrivateKey{discoveryRef: discoveryPrivate}))
+		defer joiner.Stop(ctx) // nolint
+
+		// joiner sends authorize request and discovery node replies
+		require.NoError(t, send(joiner, discoveryTransport))
+		require.Equal(t, []byte{1, 2, 3}, <-discoveryReceived)
+		require.NoError(t, send(discoveryTransport, joiner))
+		require.Equal(t, []byte{1, 2, 3}, <-joinerReceived)
+	})
+
+	t.Run("joiner with certificate not signed by discovery", func(t *testing.T) {
+		otherPrivate, _ := generateKeyPair(t)
+		joinerPrivate, joinerKey := generateKeyPair(t)
+		joiner, joinerReceived := start(joinerPrivate, authCert(t, joinerKey, map[insolar.Reference]crypto.PrivateKey{discoveryRef: otherPrivate}))
+		defer joiner.Stop(ctx) // nolint
+
+		require.Error(t, send(joiner, discoveryTransport))
+		require.Error(t, send(discoveryTransport, joiner))
+		require.Empty(t, discoveryReceived)
+		require.Empty(t, joinerReceived)
+	})
+
+	t.Run("joiner with certificate of another key", func(t *testing.T) {
+		joinerPrivate, _ := generateKeyPair(t)
+		_, otherKey := generateKeyPair(t)
+		joiner, _ := start(joinerPrivate, authCert(t, otherKey, map[insolar.Reference]crypto.PrivateKey{discoveryRef: discoveryPrivate}))
+		defer joiner.Stop(ctx) // nolint
+
+		require.Error(t, send(joiner, discoveryTransport))
+		require.Empty(t, discoveryReceived)
+	})
+}
@@ -7,6 +7,8 @@ package servicenetwork
 
 import (
 	"context"
+	"sync"
+	"time"
 
 	"github.com/ThreeDotsLabs/watermill/message"
 	"github.com/pkg/errors"
@@ -16,6 +18,7 @@ import (
 	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/instrumentation/inslogger"
 	"github.com/insolar/insolar/network"
+	"github.com/insolar/insolar/network/consensus/adapters"
 	"github.com/insolar/insolar/network/controller"
 	"github.com/insolar/insolar/network/gateway"
 	"github.com/insolar/insolar/network/gateway/bootstrap"
@@ -55,11 +58,21 @@ type ServiceNetwork struct {
 
 	Gatewayer   network.Gatewayer
 	BaseGateway *gateway.Base
+
+	misbehaviorRegistry network.MisbehaviorRegistry
+	drainers            []insolar.Drainer
+
+	// stopLeave is closed on stop, so waiting for leave pulse and leave approval is cancelled
+	stopLeave     chan struct{}
+	stopLeaveOnce sync.Once
 }
 
+// leaveTimeout is a time of waiting for consensus to approve leave claim, it is reported in leave status after it.
+var leaveTimeout = time.Minute
+
 // NewServiceNetwork returns a new ServiceNetwork.
 func NewServiceNetwork(conf configuration.HostNetwork, rootCm *component.Manager) (*ServiceNetwork, error) {
-	serviceNetwork := &ServiceNetwork{cm: component.NewManager(rootCm), cfg: conf}
+	serviceNetwork := &ServiceNetwork{cm: component.NewManager(rootCm), cfg: conf, stopLeave: make(chan struct{})}
 	return serviceNetwork, nil
 }
 
@@ -81,14 +94,20 @@ func (n *ServiceNetwork) Init(ctx context.Context) error {
 	}
 
 	n.BaseGateway = &gateway.Base{Options: options}
+	n.misbehaviorRegistry = adapters.NewMisbehaviorRegistry(n.cfg.Misbehavior)
 	n.Gatewayer = gateway.NewGatewayer(n.BaseGateway.NewGateway(ctx, insolar.NoNetworkState))
 
 	table := &routing.Table{}
 
+	transportFactory, err := n.newTransportFactory(cert)
+	if err != nil {
+		return errors.Wrap(err, "failed to create transport factory")
+	}
+
 	n.cm.Inject(n,
 		table,
 		cert,
-		transport.NewFactory(n.cfg.Transport),
+		transportFactory,
 		hostNetwork,
 		nodeNetwork,
 		controller.NewRPCController(options),
@@ -98,7 +117,8 @@ func (n *ServiceNetwork) Init(ctx context.Context) error {
 		n.BaseGateway,
 		n.Gatewayer,
 		storage.NewMemoryStorage(),
-		termination.NewHandler(n),
+		termination.NewHandler(n, n.drainers...),
+		n.misbehaviorRegistry,
 	)
 
 	err = n.cm.Init(ctx)
@@ -123,12 +143,104 @@ func (n *ServiceNetwork) Start(ctx context.Context) error {
 	return nil
 }
 
+// AddDrainer adds components, that should complete pending work before node leaves the network. It should be
+// called before Init.
+func (n *ServiceNetwork) AddDrainer(drainers ...insolar.Drainer) {
+	n.drainers = append(n.drainers, drainers...)
+}
+
+// RequestLeave starts graceful leave of the node. Node gets zero power, so new tasks are routed to other nodes,
+// and claims leave after given count of pulses when pending work is done.
+func (n *ServiceNetwork) RequestLeave(ctx context.Context, leaveAfterPulses insolar.PulseNumber) error {
+	err := n.TerminationHandler.RequestLeave(ctx, leaveAfterPulses)
+	if err != nil {
+		return err
+	}
+
+	if controller := n.BaseGateway.ConsensusController; controller != nil {
+		controller.PrepareLeave()
+	}
+	return nil
+}
+
+// LeaveStatus returns progress of graceful leave.
+func (n *ServiceNetwork) LeaveStatus(ctx context.Context) insolar.LeaveStatus {
+	return n.TerminationHandler.LeaveStatus(ctx)
+}
+
+// Left returns channel, that is closed when node has left the network.
+func (n *ServiceNetwork) Left() <-chan struct{} {
+	return n.TerminationHandler.Left()
+}
+
+// Leave claims leave to consensus since eta pulse, TerminationHandler is notified when node is evicted.
 func (n *ServiceNetwork) Leave(ctx context.Context, eta insolar.PulseNumber) {
 	logger := inslogger.FromContext(ctx)
 	logger.Info("Gracefully stopping service network")
 
-	// TODO: fix leave
-	// n.consensusController.Leave(0)
+	go n.leave(ctx, eta)
+}
+
+func (n *ServiceNetwork) leave(ctx context.Context, eta insolar.PulseNumber) {
+	logger := inslogger.FromContext(ctx)
+
+	if eta != 0 && !n.waitPulse(ctx, eta) {
+		logger.Warn("Leave is cancelled while waiting for leave pulse")
+		return
+	}
+
+	// consensus claims leave in every pulse until it is accepted, so node waits for it without a deadline
+	if controller := n.BaseGateway.ConsensusController; controller != nil {
+		left := controller.Leave(0)
+		timeout := time.NewTimer(leaveTimeout)
+		defer timeout.Stop()
+		for accepted := false; !accepted; {
+			select {
+			case <-left:
+				accepted = true
+			case <-timeout.C:
+				logger.Warn("Leave claim isn't accepted by consensus in time, still waiting for it")
+				n.TerminationHandler.OnLeaveTimeout(ctx)
+			case <-ctx.Done():
+				logger.Warn("Leave is cancelled while waiting for leave claim approval")
+				return
+			case <-n.stopLeave:
+				logger.Warn("Leave is cancelled while waiting for leave claim approval")
+				return
+			}
+		}
+		logger.Info("Leave claim is accepted by consensus")
+	}
+
+	n.TerminationHandler.OnLeaveApproved(ctx)
+}
+
+// waitPulse waits for the pulse. It returns false if waiting is cancelled.
+func (n *ServiceNetwork) waitPulse(ctx context.Context, pn insolar.PulseNumber) bool {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			pulse, err := n.PulseAccessor.GetLatestPulse(ctx)
+			if err == nil && pulse.PulseNumber >= pn {
+				return true
+			}
+		case <-ctx.Done():
+			return false
+		case <-n.stopLeave:
+			return false
+		}
+	}
+}
+
+func (n *ServiceNetwork) cancelLeave() {
+	n.stopLeaveOnce.Do(func() {
+		if n.stopLeave != nil {
+			close(n.stopLeave)
+		}
+	})
 }
 
 func (n *ServiceNetwork) GracefulStop(ctx context.Context) error {
@@ -139,12 +251,14 @@ func (n *ServiceNetwork) GracefulStop(ctx context.Context) error {
 	logger.Info("ServiceNetwork.GracefulStop wait for accepting leaving claim")
 	n.TerminationHandler.Leave(ctx, 0)
 	logger.Info("ServiceNetwork.GracefulStop - leaving claim accepted")
+	n.cancelLeave()
 
 	return nil
 }
 
 // Stop implements insolar.Component
 func (n *ServiceNetwork) Stop(ctx context.Context) error {
+	n.cancelLeave()
 	return n.cm.Stop(ctx)
 }
 
@@ -161,6 +275,36 @@ func (n *ServiceNetwork) GetAccessor(p insolar.PulseNumber) network.Accessor {
 	return n.NodeKeeper.GetAccessor(p)
 }
 
+// RemoteProcedureRegister registers procedure, that other nodes call with SendBytes. Network must be initialized.
+func (n *ServiceNetwork) RemoteProcedureRegister(name string, method controller.RemoteProcedure) {
+	n.RPC.RemoteProcedureRegister(name, method)
+}
+
+// SendBytes calls remote procedure of the node.
+func (n *ServiceNetwork) SendBytes(ctx context.Context, nodeID insolar.Reference, name string, msgBytes []byte) ([]byte, error) {
+	return n.RPC.SendBytes(ctx, nodeID, name, msgBytes)
+}
+
+// GetMisbehaviorRegistry returns registry of consensus misbehavior reports. Network must be initialized.
+func (n *ServiceNetwork) GetMisbehaviorRegistry() network.MisbehaviorRegistry {
+	return n.misbehaviorRegistry
+}
+
+// ReportMisbehavior adds validation mismatch report about the active node, that was found outside of consensus.
+func (n *ServiceNetwork) ReportMisbehavior(ctx context.Context, node insolar.Reference, details string) error {
+	np, err := n.PulseAccessor.GetLatestPulse(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get latest pulse")
+	}
+	active := n.NodeKeeper.GetAccessor(np.PulseNumber).GetActiveNode(node)
+	if active == nil {
+		return errors.Errorf("node %s is not active", node)
+	}
+
+	n.misbehaviorRegistry.ReportValidationMismatch(node, details)
+	return nil
+}
+
 func (n *ServiceNetwork) GetCert(ctx context.Context, ref *insolar.Reference) (insolar.Certificate, error) {
 	return n.Gatewayer.Gateway().Auther().GetCert(ctx, ref)
 }
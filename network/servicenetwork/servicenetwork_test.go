@@ -8,6 +8,7 @@ package servicenetwork
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/ThreeDotsLabs/watermill"
 	"github.com/ThreeDotsLabs/watermill/message"
@@ -24,6 +25,8 @@ import (
 	"github.com/insolar/insolar/insolar/gen"
 	"github.com/insolar/insolar/insolar/payload"
 	"github.com/insolar/insolar/insolar/pulse"
+	"github.com/insolar/insolar/network/consensus"
+	"github.com/insolar/insolar/network/gateway"
 	"github.com/insolar/insolar/network/node"
 	"github.com/insolar/insolar/network/nodenetwork"
 	"github.com/insolar/insolar/testutils"
@@ -266,3 +269,105 @@ func TestServiceNetwork_processIncoming(t *testing.T) {
 	_, err = serviceNetwork.processIncoming(ctx, data)
 	assert.Error(t, err)
 }
+
+func TestServiceNetwork_ReportMisbehavior(t *testing.T) {
+	ctx := context.Background()
+	cfg := configuration.NewGenericConfiguration()
+	serviceNetwork, err := NewServiceNetwork(cfg.Host, component.NewManager(nil))
+	require.NoError(t, err)
+
+	activeRef := gen.Reference()
+	activeNode := networkUtils.NewNetworkNodeMock(t)
+
+	accessor := networkUtils.NewAccessorMock(t)
+	accessor.GetActiveNodeMock.Set(func(ref insolar.Reference) insolar.NetworkNode {
+		if ref == activeRef {
+			return activeNode
+		}
+		return nil
+	})
+	nodeKeeper := networkUtils.NewNodeKeeperMock(t)
+	nodeKeeper.GetAccessorMock.Return(accessor)
+	pulseMock := networkUtils.NewPulseAccessorMock(t)
+	pulseMock.GetLatestPulseMock.Return(*insolar.GenesisPulse, nil)
+	registry := networkUtils.NewMisbehaviorRegistryMock(t)
+	registry.ReportValidationMismatchMock.Expect(activeRef, "wrong result").Return()
+
+	serviceNetwork.NodeKeeper = nodeKeeper
+	serviceNetwork.PulseAccessor = pulseMock
+	serviceNetwork.misbehaviorRegistry = registry
+
+	err = serviceNetwork.ReportMisbehavior(ctx, activeRef, "wrong result")
+	require.NoError(t, err)
+
+	err = serviceNetwork.ReportMisbehavior(ctx, gen.Reference(), "wrong result")
+	require.Error(t, err)
+}
+
+type leavingController struct {
+	consensus.Controller
+	left chan struct{}
+}
+
+func (c *leavingController) Leave(uint32) <-chan struct{} {
+	return c.left
+}
+
+func TestServiceNetwork_leave_WaitsForApproval(t *testing.T) {
+	defer func(timeout time.Duration) { leaveTimeout = timeout }(leaveTimeout)
+	leaveTimeout = time.Millisecond
+
+	ctx := context.Background()
+	controller := &leavingController{left: make(chan struct{})}
+	timedOut := make(chan struct{})
+	approved := make(chan struct{})
+	th := testutils.NewTerminationHandlerMock(t)
+	th.OnLeaveTimeoutMock.Set(func(context.Context) { close(timedOut) })
+	th.OnLeaveApprovedMock.Set(func(context.Context) { close(approved) })
+
+	n := &ServiceNetwork{
+		BaseGateway:        &gateway.Base{ConsensusController: controller},
+		TerminationHandler: th,
+	}
+	go n.leave(ctx, 0)
+
+	select {
+	case <-timedOut:
+	case <-time.After(time.Minute):
+		require.Fail(t, "leave timeout isn't reported")
+	}
+	select {
+	case <-approved:
+		require.Fail(t, "leave is approved before consensus accepted it")
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	close(controller.left)
+	select {
+	case <-approved:
+	case <-time.After(time.Minute):
+		require.Fail(t, "leave isn't approved")
+	}
+}
+
+func TestServiceNetwork_leave_CancelledOnStop(t *testing.T) {
+	ctx := context.Background()
+	n := &ServiceNetwork{
+		BaseGateway:        &gateway.Base{ConsensusController: &leavingController{left: make(chan struct{})}},
+		TerminationHandler: testutils.NewTerminationHandlerMock(t),
+		stopLeave:          make(chan struct{}),
+	}
+
+	done := make(chan struct{})
+	go func() {
+		n.leave(ctx, 0)
+		close(done)
+	}()
+
+	n.cancelLeave()
+	select {
+	case <-done:
+	case <-time.After(time.Minute):
+		require.Fail(t, "leave isn't cancelled")
+	}
+}
@@ -8,6 +8,9 @@ package termination
 import (
 	"context"
 	"sync"
+	"time"
+
+	"github.com/pkg/errors"
 
 	"github.com/insolar/insolar/network/storage"
 
@@ -16,49 +19,186 @@ import (
 	"github.com/insolar/insolar/insolar"
 )
 
+// drainCheckPeriod is a period of checking leave pulse and pending work while node is draining.
+var drainCheckPeriod = time.Second
+
 type Handler struct {
 	sync.Mutex
 	done        chan struct{}
 	terminating bool
 
+	// left is closed when the leave is approved
+	left       chan struct{}
+	state      insolar.LeaveState
+	leavePulse insolar.PulseNumber
+	timedOut   bool
+	drainers   []insolar.Drainer
+	// stop is closed on Stop, it cancels draining
+	stop     chan struct{}
+	stopOnce sync.Once
+
 	Leaver        insolar.Leaver
 	PulseAccessor storage.PulseAccessor `inject:""`
 }
 
-func NewHandler(l insolar.Leaver) *Handler {
-	return &Handler{Leaver: l}
+func NewHandler(l insolar.Leaver, drainers ...insolar.Drainer) *Handler {
+	return &Handler{Leaver: l, drainers: drainers, stop: make(chan struct{})}
 }
 
 // TODO take ETA by role of node
 func (t *Handler) Leave(ctx context.Context, leaveAfterPulses insolar.PulseNumber) {
 	doneChan := t.leave(ctx, leaveAfterPulses)
-	<-doneChan
+	select {
+	case <-doneChan:
+	case <-ctx.Done():
+	case <-t.stop:
+	}
 }
 
 func (t *Handler) leave(ctx context.Context, leaveAfterPulses insolar.PulseNumber) chan struct{} {
 	t.Lock()
 	defer t.Unlock()
 
-	if !t.terminating {
-		t.terminating = true
-		t.done = make(chan struct{}, 1)
-
+	if !t.terminating && t.state != insolar.LeaveApproved {
 		if leaveAfterPulses == 0 {
 			inslogger.FromContext(ctx).Debug("Handler.Leave() with 0")
-			t.Leaver.Leave(ctx, 0)
+			t.announce(ctx, 0)
 		} else {
-			pulse, err := t.PulseAccessor.GetLatestPulse(ctx)
-			if err != nil {
-				inslogger.FromContext(ctx).Panicf("smth goes wrong. There is no pulse in the storage. err - %v", err)
+			eta := t.pulseAfter(ctx, leaveAfterPulses)
+			inslogger.FromContext(ctx).Debugf("Handler.Leave() with leaveAfterPulses: %+v, in pulse %+v", leaveAfterPulses, eta)
+			t.announce(ctx, eta)
+		}
+	}
+
+	return t.done
+}
+
+// announce claims leave to the network. Should be called under lock.
+func (t *Handler) announce(ctx context.Context, eta insolar.PulseNumber) {
+	t.terminating = true
+	t.done = make(chan struct{}, 1)
+	t.state = insolar.LeaveAnnounced
+	if eta != 0 {
+		t.leavePulse = eta
+	}
+	t.Leaver.Leave(ctx, eta)
+}
+
+func (t *Handler) pulseAfter(ctx context.Context, pulses insolar.PulseNumber) insolar.PulseNumber {
+	pulse, err := t.PulseAccessor.GetLatestPulse(ctx)
+	if err != nil {
+		inslogger.FromContext(ctx).Panicf("smth goes wrong. There is no pulse in the storage. err - %v", err)
+	}
+	pulseDelta := pulse.NextPulseNumber - pulse.PulseNumber
+	return pulse.PulseNumber + pulses*pulseDelta
+}
+
+// RequestLeave starts draining of the node. Leave is claimed after given count of pulses, when drainers have no
+// pending work. It doesn't wait for the leave, use Left to wait for it.
+func (t *Handler) RequestLeave(ctx context.Context, leaveAfterPulses insolar.PulseNumber) error {
+	t.Lock()
+	defer t.Unlock()
+
+	if t.terminating || t.state != insolar.LeaveNotRequested {
+		return errors.Errorf("leave is already requested, state: %s", t.state)
+	}
+
+	t.state = insolar.LeaveDraining
+	if leaveAfterPulses > 0 {
+		t.leavePulse = t.pulseAfter(ctx, leaveAfterPulses)
+	}
+	inslogger.FromContext(ctx).Infof("Leave is requested, leave pulse: %d", t.leavePulse)
+
+	go t.drain(ctx)
+	return nil
+}
+
+func (t *Handler) drain(ctx context.Context) {
+	ticker := time.NewTicker(drainCheckPeriod)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if t.tryAnnounce(ctx) {
+				return
 			}
-			pulseDelta := pulse.NextPulseNumber - pulse.PulseNumber
+		case <-ctx.Done():
+			return
+		case <-t.stop:
+			return
+		}
+	}
+}
+
+// Stop cancels draining and waiting for the leave.
+func (t *Handler) Stop(ctx context.Context) error {
+	t.stopOnce.Do(func() {
+		if t.stop != nil {
+			close(t.stop)
+		}
+	})
+	return nil
+}
+
+// tryAnnounce claims leave if leave pulse has come and there is no pending work. It returns true if node isn't
+// draining anymore.
+func (t *Handler) tryAnnounce(ctx context.Context) bool {
+	t.Lock()
+	defer t.Unlock()
 
-			inslogger.FromContext(ctx).Debugf("Handler.Leave() with leaveAfterPulses: %+v, in pulse %+v", leaveAfterPulses, pulse.PulseNumber+leaveAfterPulses*pulseDelta)
-			t.Leaver.Leave(ctx, pulse.PulseNumber+leaveAfterPulses*pulseDelta)
+	if t.state != insolar.LeaveDraining || t.terminating {
+		return true
+	}
+
+	if t.leavePulse != 0 {
+		pulse, err := t.PulseAccessor.GetLatestPulse(ctx)
+		if err != nil || pulse.PulseNumber < t.leavePulse {
+			return false
 		}
 	}
 
-	return t.done
+	if pending := t.pendingWork(ctx); len(pending) > 0 {
+		inslogger.FromContext(ctx).Debugf("Leave is delayed by pending work: %v", pending)
+		return false
+	}
+
+	inslogger.FromContext(ctx).Info("Node is drained, claiming leave")
+	t.announce(ctx, 0)
+	return true
+}
+
+func (t *Handler) pendingWork(ctx context.Context) []string {
+	var pending []string
+	for _, d := range t.drainers {
+		if work := d.PendingWork(ctx); work != "" {
+			pending = append(pending, work)
+		}
+	}
+	return pending
+}
+
+// LeaveStatus returns progress of the leave.
+func (t *Handler) LeaveStatus(ctx context.Context) insolar.LeaveStatus {
+	t.Lock()
+	defer t.Unlock()
+
+	status := insolar.LeaveStatus{State: t.state, LeavePulse: t.leavePulse, ClaimTimedOut: t.timedOut}
+	if t.state == insolar.LeaveDraining {
+		status.Pending = t.pendingWork(ctx)
+	}
+	return status
+}
+
+// Left returns channel, that is closed when the leave is approved.
+func (t *Handler) Left() <-chan struct{} {
+	t.Lock()
+	defer t.Unlock()
+
+	if t.left == nil {
+		t.left = make(chan struct{})
+	}
+	return t.left
 }
 
 func (t *Handler) OnLeaveApproved(ctx context.Context) {
@@ -67,7 +207,23 @@ func (t *Handler) OnLeaveApproved(ctx context.Context) {
 	if t.terminating {
 		inslogger.FromContext(ctx).Debug("Handler.OnLeaveApproved() received")
 		t.terminating = false
+		t.state = insolar.LeaveApproved
+		t.timedOut = false
 		close(t.done)
+		if t.left == nil {
+			t.left = make(chan struct{})
+		}
+		close(t.left)
+	}
+}
+
+// OnLeaveTimeout marks, that the leave claim isn't approved in time. Node doesn't leave until it is approved.
+func (t *Handler) OnLeaveTimeout(ctx context.Context) {
+	t.Lock()
+	defer t.Unlock()
+	if t.terminating {
+		inslogger.FromContext(ctx).Debug("Handler.OnLeaveTimeout() received")
+		t.timedOut = true
 	}
 }
 
@@ -7,6 +7,7 @@ package termination
 
 import (
 	"context"
+	"sync"
 	"testing"
 	"time"
 
@@ -128,3 +129,124 @@ func TestAbort(t *testing.T) {
 	ctx = inslogger.SetLogger(ctx, l)
 	handler.Abort(ctx, "abort")
 }
+
+func TestRequestLeave(t *testing.T) {
+	defer func(period time.Duration) { drainCheckPeriod = period }(drainCheckPeriod)
+	drainCheckPeriod = time.Millisecond
+
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	ctx := inslogger.TestContext(t)
+	leaver := testutils.NewLeaverMock(mc)
+	drainer := testutils.NewDrainerMock(mc)
+	pulseAccessor := mock.NewPulseAccessorMock(mc)
+	handler := NewHandler(leaver, drainer)
+	handler.PulseAccessor = pulseAccessor
+
+	latest := insolar.Pulse{PulseNumber: 2000000000, NextPulseNumber: 2000000010}
+	var lock sync.Mutex
+	pending := "pending executions"
+	pulseAccessor.GetLatestPulseMock.Set(func(ctx context.Context) (insolar.Pulse, error) {
+		lock.Lock()
+		defer lock.Unlock()
+		return latest, nil
+	})
+	drainer.PendingWorkMock.Set(func(ctx context.Context) string {
+		lock.Lock()
+		defer lock.Unlock()
+		return pending
+	})
+	announced := make(chan struct{})
+	leaver.LeaveMock.Set(func(ctx context.Context, eta insolar.PulseNumber) {
+		assert.Equal(t, insolar.PulseNumber(0), eta)
+		close(announced)
+	})
+
+	assert.Equal(t, insolar.LeaveStatus{}, handler.LeaveStatus(ctx))
+	require.NoError(t, handler.RequestLeave(ctx, 2))
+	require.Error(t, handler.RequestLeave(ctx, 2))
+
+	assert.Equal(t, insolar.LeaveStatus{
+		State:      insolar.LeaveDraining,
+		LeavePulse: 2000000020,
+		Pending:    []string{pending},
+	}, handler.LeaveStatus(ctx))
+
+	lock.Lock()
+	latest = insolar.Pulse{PulseNumber: 2000000020, NextPulseNumber: 2000000030}
+	lock.Unlock()
+	time.Sleep(10 * drainCheckPeriod)
+	assert.Equal(t, insolar.LeaveDraining, handler.LeaveStatus(ctx).State, "leave is delayed by pending work")
+
+	lock.Lock()
+	pending = ""
+	lock.Unlock()
+	select {
+	case <-announced:
+	case <-time.After(time.Minute):
+		require.Fail(t, "leave isn't claimed")
+	}
+	assert.Equal(t, insolar.LeaveAnnounced, handler.LeaveStatus(ctx).State)
+	assert.True(t, handler.Terminating())
+
+	handler.OnLeaveTimeout(ctx)
+	assert.Equal(t, insolar.LeaveAnnounced, handler.LeaveStatus(ctx).State, "node doesn't leave until approval")
+	assert.True(t, handler.LeaveStatus(ctx).ClaimTimedOut)
+
+	left := handler.Left()
+	handler.OnLeaveApproved(ctx)
+	select {
+	case <-left:
+	case <-time.After(time.Minute):
+		require.Fail(t, "left channel isn't closed")
+	}
+	assert.Equal(t, insolar.LeaveApproved, handler.LeaveStatus(ctx).State)
+	assert.False(t, handler.LeaveStatus(ctx).ClaimTimedOut)
+
+	// graceful stop after leave doesn't claim leave again
+	handler.Leave(ctx, 0)
+	require.Error(t, handler.RequestLeave(ctx, 0))
+}
+
+func TestLeaveWhileDraining(t *testing.T) {
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	ctx := inslogger.TestContext(t)
+	leaver := testutils.NewLeaverMock(mc)
+	handler := NewHandler(leaver)
+
+	require.NoError(t, handler.RequestLeave(ctx, 0))
+	leaver.LeaveMock.Expect(ctx, 0)
+	handler.leave(ctx, 0)
+
+	assert.Equal(t, insolar.LeaveAnnounced, handler.LeaveStatus(ctx).State)
+	assert.True(t, handler.tryAnnounce(ctx))
+}
+
+func TestStopCancelsDraining(t *testing.T) {
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	ctx := inslogger.TestContext(t)
+	drainer := testutils.NewDrainerMock(mc)
+	drainer.PendingWorkMock.Return("pending executions")
+	handler := NewHandler(testutils.NewLeaverMock(mc), drainer)
+	handler.state = insolar.LeaveDraining
+
+	drained := make(chan struct{})
+	go func() {
+		handler.drain(ctx)
+		close(drained)
+	}()
+
+	require.NoError(t, handler.Stop(ctx))
+	select {
+	case <-drained:
+	case <-time.After(time.Minute):
+		require.Fail(t, "draining isn't cancelled")
+	}
+	assert.Equal(t, insolar.LeaveDraining, handler.LeaveStatus(ctx).State)
+	require.NoError(t, handler.Stop(ctx))
+}
@@ -22,15 +22,26 @@ func NewFactory(cfg configuration.Transport) Factory {
 	return &factory{cfg: cfg}
 }
 
+// NewSecureFactory creates new transport factory, that also supports TLS protocol.
+func NewSecureFactory(cfg configuration.Transport, credentials Credentials) Factory {
+	return &factory{cfg: cfg, credentials: &credentials}
+}
+
 type factory struct {
-	cfg configuration.Transport
+	cfg         configuration.Transport
+	credentials *Credentials
 }
 
-// CreateStreamTransport creates new TCP transport
+// CreateStreamTransport creates new TCP or TLS transport
 func (f *factory) CreateStreamTransport(handler StreamHandler) (StreamTransport, error) {
 	switch f.cfg.Protocol {
 	case "TCP":
 		return newTCPTransport(f.cfg.Address, f.cfg.FixedPublicAddress, handler), nil
+	case "TLS":
+		if f.credentials == nil {
+			return nil, errors.New("TLS transport requires credentials")
+		}
+		return newTLSTransport(f.cfg.Address, f.cfg.FixedPublicAddress, handler, *f.credentials)
 	default:
 		return nil, errors.New("invalid transport configuration")
 	}
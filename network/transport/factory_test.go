@@ -78,6 +78,10 @@ func TestFactoryStreamTransport_Negative(t *testing.T) {
 			name: "invalid address",
 			cfg:  configuration.Transport{Address: "invalid"},
 		},
+		{
+			name: "TLS without credentials",
+			cfg:  configuration.Transport{Address: "localhost:0", Protocol: "TLS"},
+		},
 		{
 			name: "invalid protocol",
 			cfg:  configuration.Transport{Address: "localhost:0", FixedPublicAddress: "192.168.1.1", Protocol: "HTTP"},
@@ -0,0 +1,187 @@
+// Copyright 2020 Insolar Network Ltd.
+// All rights reserved.
+// This material is licensed under the Insolar License version 1.0,
+// available at https://github.com/insolar/insolar/blob/master/LICENSE.md.
+
+package transport
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"io"
+	"math/big"
+	"net"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/instrumentation/inslogger"
+)
+
+const (
+	tlsHandshakeTimeout = 5 * time.Second
+	// certificate is generated on every start, so it's valid as long as the node is running
+	tlsCertificateTTL = 100 * 365 * 24 * time.Hour
+)
+
+// authCertificateOID is an extension of TLS certificate, that carries authorization certificate of the node.
+var authCertificateOID = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 53649, 1, 1}
+
+// PeerVerifier checks, that the public key belongs to a known node.
+type PeerVerifier interface {
+	// VerifyPeer is called with the dialed address for outgoing connections
+	// and with empty address for incoming connections. authCert is authorization certificate
+	// from TLS certificate of the peer, it's empty if the peer didn't provide it.
+	VerifyPeer(address string, key crypto.PublicKey, authCert []byte) error
+}
+
+// PeerVerifierFunc is an adapter to use ordinary functions as PeerVerifier.
+type PeerVerifierFunc func(address string, key crypto.PublicKey, authCert []byte) error
+
+// VerifyPeer calls f(address, key, authCert).
+func (f PeerVerifierFunc) VerifyPeer(address string, key crypto.PublicKey, authCert []byte) error {
+	return f(address, key, authCert)
+}
+
+// SignerProvider is implemented by cryptography services, that can sign with node key in TLS handshakes.
+type SignerProvider interface {
+	CryptoSigner() (crypto.Signer, error)
+}
+
+// Credentials authenticate the node and its peers in TLS transport.
+// AuthCert is serialized authorization certificate of the node. It's put into TLS certificate,
+// so peers can verify the node, when it isn't in the network yet.
+type Credentials struct {
+	Signer   crypto.Signer
+	Verifier PeerVerifier
+	AuthCert []byte
+}
+
+// tlsTransport is a TCP transport with mutual TLS authentication.
+// Nodes use self-signed certificates with their keys, peer keys are checked by PeerVerifier.
+type tlsTransport struct {
+	*tcpTransport
+
+	handler     StreamHandler
+	credentials Credentials
+	certificate tls.Certificate
+}
+
+func newTLSTransport(listenAddress, fixedPublicAddress string, handler StreamHandler, credentials Credentials) (*tlsTransport, error) {
+	if credentials.Signer == nil || credentials.Verifier == nil {
+		return nil, errors.New("TLS transport requires signer and peer verifier")
+	}
+
+	certificate, err := selfSignedCertificate(credentials.Signer, credentials.AuthCert)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create TLS certificate")
+	}
+
+	t := &tlsTransport{
+		handler:     handler,
+		credentials: credentials,
+		certificate: certificate,
+	}
+	t.tcpTransport = newTCPTransport(listenAddress, fixedPublicAddress, StreamHandlerFunc(t.handleStream))
+	return t, nil
+}
+
+// Dial opens TLS connection to the address and checks, that peer key matches the address.
+func (t *tlsTransport) Dial(ctx context.Context, address string) (io.ReadWriteCloser, error) {
+	stream, err := t.tcpTransport.Dial(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+
+	conn := tls.Client(stream.(net.Conn), t.config(address))
+	err = handshake(ctx, conn)
+	if err != nil {
+		inslogger.FromContext(ctx).Warnf("[ Dial ] TLS handshake with %s failed: %s", address, err)
+		_ = conn.Close()
+		return nil, errors.Wrap(err, "[ Dial ] TLS handshake failed")
+	}
+	return conn, nil
+}
+
+func (t *tlsTransport) handleStream(ctx context.Context, address string, stream io.ReadWriteCloser) {
+	conn := tls.Server(stream.(net.Conn), t.config(""))
+	err := handshake(ctx, conn)
+	if err != nil {
+		inslogger.FromContext(ctx).Warnf("[ handleStream ] TLS handshake with %s failed: %s", address, err)
+		_ = conn.Close()
+		return
+	}
+	t.handler.HandleStream(ctx, address, conn)
+}
+
+func (t *tlsTransport) config(address string) *tls.Config {
+	return &tls.Config{
+		MinVersion:   tls.VersionTLS13,
+		Certificates: []tls.Certificate{t.certificate},
+		ClientAuth:   tls.RequireAnyClientCert,
+		// certificates are self-signed, peer key is checked by VerifyPeerCertificate
+		InsecureSkipVerify: true, // nolint
+		VerifyPeerCertificate: func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+			if len(rawCerts) == 0 {
+				return errors.New("peer didn't provide certificate")
+			}
+			cert, err := x509.ParseCertificate(rawCerts[0])
+			if err != nil {
+				return errors.Wrap(err, "failed to parse peer certificate")
+			}
+			if err := cert.CheckSignature(cert.SignatureAlgorithm, cert.RawTBSCertificate, cert.Signature); err != nil {
+				return errors.Wrap(err, "invalid peer certificate signature")
+			}
+			var authCert []byte
+			for _, ext := range cert.Extensions {
+				if ext.Id.Equal(authCertificateOID) {
+					authCert = ext.Value
+				}
+			}
+			return t.credentials.Verifier.VerifyPeer(address, cert.PublicKey, authCert)
+		},
+	}
+}
+
+func handshake(ctx context.Context, conn *tls.Conn) error {
+	ctx, cancel := context.WithTimeout(ctx, tlsHandshakeTimeout)
+	defer cancel()
+	return conn.HandshakeContext(ctx)
+}
+
+func selfSignedCertificate(signer crypto.Signer, authCert []byte) (tls.Certificate, error) {
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	now := time.Now()
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: "insolar node"},
+		NotBefore:    now.Add(-time.Hour),
+		NotAfter:     now.Add(tlsCertificateTTL),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	if len(authCert) > 0 {
+		template.ExtraExtensions = []pkix.Extension{{Id: authCertificateOID, Value: authCert}}
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, signer.Public(), signer)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: signer}, nil
+}
+
+// StreamHandlerFunc is an adapter to use ordinary functions as StreamHandler.
+type StreamHandlerFunc func(ctx context.Context, address string, stream io.ReadWriteCloser)
+
+// HandleStream calls f(ctx, address, stream).
+func (f StreamHandlerFunc) HandleStream(ctx context.Context, address string, stream io.ReadWriteCloser) {
+	f(ctx, address, stream)
+}
@@ -0,0 +1,155 @@
+// Copyright 2020 Insolar Network Ltd.
+// All rights reserved.
+// This material is licensed under the Insolar License version 1.0,
+// available at https://github.com/insolar/insolar/blob/master/LICENSE.md.
+
+package transport
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"io"
+	"sync"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/configuration"
+)
+
+// keyVerifier accepts listed keys, addresses of outgoing connections are ignored.
+type keyVerifier struct {
+	lock     sync.Mutex
+	keys     []crypto.PublicKey
+	authCert []byte
+}
+
+func (v *keyVerifier) allow(key crypto.PublicKey) {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	v.keys = append(v.keys, key)
+}
+
+func (v *keyVerifier) allowAuthCert(authCert []byte) {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	v.authCert = authCert
+}
+
+func (v *keyVerifier) VerifyPeer(_ string, key crypto.PublicKey, authCert []byte) error {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	for _, k := range v.keys {
+		if k.(*ecdsa.PublicKey).Equal(key) {
+			return nil
+		}
+	}
+	if len(authCert) > 0 && bytes.Equal(authCert, v.authCert) {
+		return nil
+	}
+	return errors.New("unknown peer")
+}
+
+type tlsNode struct {
+	transport StreamTransport
+	signer    crypto.Signer
+	verifier  *keyVerifier
+	received  chan []byte
+}
+
+func (n *tlsNode) HandleStream(ctx context.Context, address string, stream io.ReadWriteCloser) {
+	buf := make([]byte, 3)
+	_, err := io.ReadFull(stream, buf)
+	if err == nil {
+		n.received <- buf
+	}
+	_ = stream.Close()
+}
+
+func newTLSNode(t *testing.T, authCert []byte) *tlsNode {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	n := &tlsNode{signer: key, verifier: &keyVerifier{}, received: make(chan []byte, 1)}
+	f := NewSecureFactory(
+		configuration.Transport{Protocol: "TLS", Address: "127.0.0.1:0"},
+		Credentials{Signer: n.signer, Verifier: n.verifier, AuthCert: authCert},
+	)
+	n.transport, err = f.CreateStreamTransport(n)
+	require.NoError(t, err)
+	require.NoError(t, n.transport.Start(context.Background()))
+	return n
+}
+
+func TestTLSTransport(t *testing.T) {
+	ctx := context.Background()
+	n1 := newTLSNode(t, nil)
+	n2 := newTLSNode(t, nil)
+	defer n1.transport.Stop(ctx) // nolint
+	defer n2.transport.Stop(ctx) // nolint
+
+	t.Run("unknown server", func(t *testing.T) {
+		n2.verifier.allow(n1.signer.Public())
+
+		_, err := n1.transport.Dial(ctx, n2.transport.Address())
+		require.Error(t, err)
+	})
+
+	t.Run("known peers", func(t *testing.T) {
+		n1.verifier.allow(n2.signer.Public())
+
+		conn, err := n1.transport.Dial(ctx, n2.transport.Address())
+		require.NoError(t, err)
+		_, err = conn.Write([]byte{1, 2, 3})
+		require.NoError(t, err)
+		require.NoError(t, conn.Close())
+
+		require.Equal(t, []byte{1, 2, 3}, <-n2.received)
+	})
+
+	t.Run("unknown client", func(t *testing.T) {
+		n3 := newTLSNode(t, nil)
+		defer n3.transport.Stop(ctx) // nolint
+		n3.verifier.allow(n2.signer.Public())
+
+		// TLS 1.3 client finishes handshake before server checks its certificate
+		conn, err := n3.transport.Dial(ctx, n2.transport.Address())
+		if err == nil {
+			_, _ = conn.Write([]byte{1, 2, 3})
+			_, err = conn.Read(make([]byte, 1))
+			require.Error(t, err)
+		}
+		require.Empty(t, n2.received)
+	})
+
+	t.Run("client with authorization certificate", func(t *testing.T) {
+		n3 := newTLSNode(t, []byte("authorization certificate"))
+		defer n3.transport.Stop(ctx) // nolint
+		n3.verifier.allow(n2.signer.Public())
+		n2.verifier.allowAuthCert([]byte("authorization certificate"))
+		defer n2.verifier.allowAuthCert(nil)
+
+		conn, err := n3.transport.Dial(ctx, n2.transport.Address())
+		require.NoError(t, err)
+		_, err = conn.Write([]byte{4, 5, 6})
+		require.NoError(t, err)
+		require.NoError(t, conn.Close())
+
+		require.Equal(t, []byte{4, 5, 6}, <-n2.received)
+	})
+
+	t.Run("plain TCP client", func(t *testing.T) {
+		tcp := newTCPTransport("127.0.0.1:0", "", nil)
+		conn, err := tcp.Dial(ctx, n2.transport.Address())
+		require.NoError(t, err)
+		_, _ = conn.Write([]byte("GET / HTTP/1.0\r\n\r\n"))
+		_, err = conn.Read(make([]byte, 1))
+		require.Error(t, err)
+		require.Empty(t, n2.received)
+	})
+}
@@ -0,0 +1,44 @@
+// Copyright 2020 Insolar Network Ltd.
+// All rights reserved.
+// This material is licensed under the Insolar License version 1.0,
+// available at https://github.com/insolar/insolar/blob/master/LICENSE.md.
+
+package platformpolicy
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/ed25519"
+)
+
+const (
+	// ECDSA is ECDSA on P-256 curve, default signature algorithm of the platform.
+	ECDSA = "ecdsa"
+	// Ed25519 is EdDSA on Curve25519.
+	Ed25519 = "ed25519"
+)
+
+// KeyAlgorithm returns signature algorithm of public or private key. Private key may be kept by external signer.
+func KeyAlgorithm(key interface{}) (string, error) {
+	switch k := key.(type) {
+	case *ecdsa.PublicKey, *ecdsa.PrivateKey:
+		return ECDSA, nil
+	case ed25519.PublicKey, ed25519.PrivateKey:
+		return Ed25519, nil
+	case crypto.Signer:
+		return KeyAlgorithm(k.Public())
+	default:
+		return "", errors.Errorf("unsupported key type %T", key)
+	}
+}
+
+func checkAlgorithm(algorithm string) error {
+	switch algorithm {
+	case ECDSA, Ed25519:
+		return nil
+	default:
+		return errors.Errorf("unknown signature algorithm %q", algorithm)
+	}
+}
@@ -8,18 +8,23 @@ package platformpolicy
 import (
 	"crypto"
 
+	"golang.org/x/crypto/ed25519"
+
 	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/platformpolicy/internal/hash"
 	"github.com/insolar/insolar/platformpolicy/internal/sign"
 )
 
 type platformCryptographyScheme struct {
-	hashProvider hash.AlgorithmProvider
-	signProvider sign.AlgorithmProvider
+	hashProvider  hash.AlgorithmProvider
+	signProvider  sign.AlgorithmProvider
+	publicKeySize int
+	// providers are used for keys of all supported algorithms, so signatures of both kinds of keys are accepted.
+	providers map[string]sign.AlgorithmProvider
 }
 
 func (pcs *platformCryptographyScheme) PublicKeySize() int {
-	return sign.TwoBigIntBytesLength
+	return pcs.publicKeySize
 }
 
 func (pcs *platformCryptographyScheme) SignatureSize() int {
@@ -43,24 +48,55 @@ func (pcs *platformCryptographyScheme) IntegrityHasher() insolar.Hasher {
 }
 
 func (pcs *platformCryptographyScheme) DataSigner(privateKey crypto.PrivateKey, hasher insolar.Hasher) insolar.Signer {
-	return pcs.signProvider.DataSigner(privateKey, hasher)
+	return pcs.providerFor(privateKey).DataSigner(privateKey, hasher)
 }
 
 func (pcs *platformCryptographyScheme) DigestSigner(privateKey crypto.PrivateKey) insolar.Signer {
-	return pcs.signProvider.DigestSigner(privateKey)
+	return pcs.providerFor(privateKey).DigestSigner(privateKey)
 }
 
 func (pcs *platformCryptographyScheme) DataVerifier(publicKey crypto.PublicKey, hasher insolar.Hasher) insolar.Verifier {
-	return pcs.signProvider.DataVerifier(publicKey, hasher)
+	return pcs.providerFor(publicKey).DataVerifier(publicKey, hasher)
 }
 
 func (pcs *platformCryptographyScheme) DigestVerifier(publicKey crypto.PublicKey) insolar.Verifier {
-	return pcs.signProvider.DigestVerifier(publicKey)
+	return pcs.providerFor(publicKey).DigestVerifier(publicKey)
 }
 
+func (pcs *platformCryptographyScheme) providerFor(key interface{}) sign.AlgorithmProvider {
+	algorithm, err := KeyAlgorithm(key)
+	if err != nil {
+		return pcs.signProvider
+	}
+	return pcs.providers[algorithm]
+}
+
+// NewPlatformCryptographyScheme creates scheme with ECDSA signatures.
 func NewPlatformCryptographyScheme() insolar.PlatformCryptographyScheme {
-	return &platformCryptographyScheme{
-		hashProvider: hash.NewSHA3Provider(),
-		signProvider: sign.NewECDSAProvider(),
+	scheme, _ := NewPlatformCryptographySchemeWithAlgorithm(ECDSA)
+	return scheme
+}
+
+// NewPlatformCryptographySchemeWithAlgorithm creates scheme with provided signature algorithm of the deployment.
+// Signatures of keys with other supported algorithms are verified too.
+func NewPlatformCryptographySchemeWithAlgorithm(algorithm string) (insolar.PlatformCryptographyScheme, error) {
+	if err := checkAlgorithm(algorithm); err != nil {
+		return nil, err
+	}
+
+	providers := map[string]sign.AlgorithmProvider{
+		ECDSA:   sign.NewECDSAProvider(),
+		Ed25519: sign.NewEd25519Provider(),
 	}
+	publicKeySize := sign.TwoBigIntBytesLength
+	if algorithm == Ed25519 {
+		publicKeySize = ed25519.PublicKeySize
+	}
+
+	return &platformCryptographyScheme{
+		hashProvider:  hash.NewSHA3Provider(),
+		signProvider:  providers[algorithm],
+		publicKeySize: publicKeySize,
+		providers:     providers,
+	}, nil
 }
@@ -20,3 +20,47 @@ func TestNewPlatformPolicy(t *testing.T) {
 	require.NotNil(t, pcsImpl.hashProvider)
 	require.NotNil(t, pcsImpl.signProvider)
 }
+
+func TestPlatformCryptographyScheme_SignVerify(t *testing.T) {
+	for _, algorithm := range []string{ECDSA, Ed25519} {
+		t.Run(algorithm, func(t *testing.T) {
+			ks, err := NewKeyProcessorWithAlgorithm(algorithm)
+			require.NoError(t, err)
+			privateKey, err := ks.GeneratePrivateKey()
+			require.NoError(t, err)
+			publicKey := ks.ExtractPublicKey(privateKey)
+
+			keyAlgorithm, err := KeyAlgorithm(privateKey)
+			require.NoError(t, err)
+			require.Equal(t, algorithm, keyAlgorithm)
+
+			// Deployment scheme verifies signatures of keys of other algorithms too.
+			for _, schemeAlgorithm := range []string{ECDSA, Ed25519} {
+				pcs, err := NewPlatformCryptographySchemeWithAlgorithm(schemeAlgorithm)
+				require.NoError(t, err)
+				data := []byte("data")
+
+				signature, err := pcs.DataSigner(privateKey, pcs.IntegrityHasher()).Sign(data)
+				require.NoError(t, err)
+				require.True(t, pcs.DataVerifier(publicKey, pcs.IntegrityHasher()).Verify(*signature, data))
+				require.False(t, pcs.DataVerifier(publicKey, pcs.IntegrityHasher()).Verify(*signature, []byte("other")))
+
+				digest := pcs.IntegrityHasher().Hash(data)
+				signature, err = pcs.DigestSigner(privateKey).Sign(digest)
+				require.NoError(t, err)
+				require.True(t, pcs.DigestVerifier(publicKey).Verify(*signature, digest))
+			}
+		})
+	}
+}
+
+func TestNewPlatformCryptographySchemeWithAlgorithm(t *testing.T) {
+	pcs, err := NewPlatformCryptographySchemeWithAlgorithm(Ed25519)
+	require.NoError(t, err)
+	require.Equal(t, 32, pcs.PublicKeySize())
+
+	require.Equal(t, 64, NewPlatformCryptographyScheme().PublicKeySize())
+
+	_, err = NewPlatformCryptographySchemeWithAlgorithm("rsa")
+	require.Error(t, err)
+}
@@ -0,0 +1,135 @@
+// Copyright 2020 Insolar Network Ltd.
+// All rights reserved.
+// This material is licensed under the Insolar License version 1.0,
+// available at https://github.com/insolar/insolar/blob/master/LICENSE.md.
+
+package sign
+
+import (
+	"crypto"
+	"crypto/rand"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/ed25519"
+
+	"github.com/insolar/insolar/insolar"
+)
+
+// ed25519DigestSignerWrapper signs with ed25519.PrivateKey or with external signer of ed25519 key.
+type ed25519DigestSignerWrapper struct {
+	signer crypto.Signer
+}
+
+func (sw *ed25519DigestSignerWrapper) Sign(digest []byte) (*insolar.Signature, error) {
+	// Ed25519 signs message itself, so no hash function is used.
+	rawSignature, err := sw.signer.Sign(rand.Reader, digest, crypto.Hash(0))
+	if err != nil {
+		return nil, errors.Wrap(err, "[ Sign ] could't sign data")
+	}
+	signature := insolar.SignatureFromBytes(rawSignature)
+	return &signature, nil
+}
+
+type ed25519DataSignerWrapper struct {
+	ed25519DigestSignerWrapper
+	hasher insolar.Hasher
+}
+
+func (sw *ed25519DataSignerWrapper) Sign(data []byte) (*insolar.Signature, error) {
+	return sw.ed25519DigestSignerWrapper.Sign(sw.hasher.Hash(data))
+}
+
+type ed25519DigestVerifyWrapper struct {
+	publicKey ed25519.PublicKey
+}
+
+func (sw *ed25519DigestVerifyWrapper) Verify(signature insolar.Signature, data []byte) bool {
+	if len(signature.Bytes()) != ed25519.SignatureSize {
+		return false
+	}
+	return ed25519.Verify(sw.publicKey, data, signature.Bytes())
+}
+
+type ed25519DataVerifyWrapper struct {
+	ed25519DigestVerifyWrapper
+	hasher insolar.Hasher
+}
+
+func (sw *ed25519DataVerifyWrapper) Verify(signature insolar.Signature, data []byte) bool {
+	return sw.ed25519DigestVerifyWrapper.Verify(signature, sw.hasher.Hash(data))
+}
+
+type ed25519Provider struct {
+}
+
+func NewEd25519Provider() AlgorithmProvider {
+	return &ed25519Provider{}
+}
+
+func (p *ed25519Provider) DataSigner(privateKey crypto.PrivateKey, hasher insolar.Hasher) insolar.Signer {
+	return &ed25519DataSignerWrapper{
+		ed25519DigestSignerWrapper: ed25519DigestSignerWrapper{
+			signer: mustConvertPrivateKeyToEd25519Signer(privateKey),
+		},
+		hasher: hasher,
+	}
+}
+
+func (p *ed25519Provider) DigestSigner(privateKey crypto.PrivateKey) insolar.Signer {
+	return &ed25519DigestSignerWrapper{
+		signer: mustConvertPrivateKeyToEd25519Signer(privateKey),
+	}
+}
+
+func (p *ed25519Provider) DataVerifier(publicKey crypto.PublicKey, hasher insolar.Hasher) insolar.Verifier {
+	return &ed25519DataVerifyWrapper{
+		ed25519DigestVerifyWrapper: ed25519DigestVerifyWrapper{
+			publicKey: MustConvertPublicKeyToEd25519(publicKey),
+		},
+		hasher: hasher,
+	}
+}
+
+func (p *ed25519Provider) DigestVerifier(publicKey crypto.PublicKey) insolar.Verifier {
+	return &ed25519DigestVerifyWrapper{
+		publicKey: MustConvertPublicKeyToEd25519(publicKey),
+	}
+}
+
+func MustConvertPublicKeyToEd25519(publicKey crypto.PublicKey) ed25519.PublicKey {
+	ed25519PublicKey, ok := publicKey.(ed25519.PublicKey)
+	if !ok {
+		panic("Failed to convert public key to ed25519 public key")
+	}
+	return ed25519PublicKey
+}
+
+func MustConvertPrivateKeyToEd25519(privateKey crypto.PrivateKey) ed25519.PrivateKey {
+	ed25519PrivateKey, ok := privateKey.(ed25519.PrivateKey)
+	if !ok {
+		panic("Failed to convert private key to ed25519 private key")
+	}
+	return ed25519PrivateKey
+}
+
+func mustConvertPrivateKeyToEd25519Signer(privateKey crypto.PrivateKey) crypto.Signer {
+	if ed25519PrivateKey, ok := privateKey.(ed25519.PrivateKey); ok {
+		return ed25519PrivateKey
+	}
+	signer, ok := privateKey.(crypto.Signer)
+	if !ok {
+		panic("Failed to convert private key to ed25519 signer")
+	}
+	MustConvertPublicKeyToEd25519(signer.Public())
+	return signer
+}
+
+// ImportEd25519PublicKeyBinary creates ed25519 public key from its raw bytes.
+func ImportEd25519PublicKeyBinary(data []byte) (ed25519.PublicKey, error) {
+	if len(data) != ed25519.PublicKeySize {
+		return nil, errors.Errorf("invalid ed25519 public key size %d", len(data))
+	}
+	publicKey := make(ed25519.PublicKey, ed25519.PublicKeySize)
+	copy(publicKey, data)
+	return publicKey, nil
+}
@@ -25,3 +25,21 @@ func MustConvertPrivateKeyToEcdsa(privateKey crypto.PrivateKey) *ecdsa.PrivateKe
 	}
 	return ecdsaPrivateKey
 }
+
+// MustConvertPrivateKeyToSigner converts private key, that is kept out of the process, to crypto.Signer.
+func MustConvertPrivateKeyToSigner(privateKey crypto.PrivateKey) crypto.Signer {
+	signer, ok := privateKey.(crypto.Signer)
+	if !ok {
+		panic("Failed to convert private key to signer")
+	}
+	MustConvertPublicKeyToEcdsa(signer.Public())
+	return signer
+}
+
+// MustExtractEcdsaPublicKey returns ecdsa public key of the private key or external signer.
+func MustExtractEcdsaPublicKey(privateKey crypto.PrivateKey) *ecdsa.PublicKey {
+	if ecdsaPrivateKey, ok := privateKey.(*ecdsa.PrivateKey); ok {
+		return &ecdsaPrivateKey.PublicKey
+	}
+	return MustConvertPublicKeyToEcdsa(MustConvertPrivateKeyToSigner(privateKey).Public())
+}
@@ -6,8 +6,11 @@
 package sign
 
 import (
+	"crypto"
 	"crypto/ecdsa"
 	"crypto/rand"
+	"encoding/asn1"
+	"math/big"
 
 	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/log"
@@ -30,13 +33,36 @@ func (sw *ecdsaDigestSignerWrapper) Sign(digest []byte) (*insolar.Signature, err
 	return &signature, nil
 }
 
+// ecdsaExternalDigestSignerWrapper signs with a key, that is kept out of the process, e.g. by external signer.
+type ecdsaExternalDigestSignerWrapper struct {
+	signer crypto.Signer
+}
+
+func (sw *ecdsaExternalDigestSignerWrapper) Sign(digest []byte) (*insolar.Signature, error) {
+	der, err := sw.signer.Sign(rand.Reader, digest, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ DataSigner ] could't sign data")
+	}
+
+	var ecdsaSignature struct {
+		R, S *big.Int
+	}
+	rest, err := asn1.Unmarshal(der, &ecdsaSignature)
+	if err != nil || len(rest) != 0 {
+		return nil, errors.New("[ DataSigner ] could't parse signature")
+	}
+
+	signature := insolar.SignatureFromBytes(SerializeTwoBigInt(ecdsaSignature.R, ecdsaSignature.S))
+	return &signature, nil
+}
+
 type ecdsaDataSignerWrapper struct {
-	ecdsaDigestSignerWrapper
-	hasher insolar.Hasher
+	digestSigner insolar.Signer
+	hasher       insolar.Hasher
 }
 
 func (sw *ecdsaDataSignerWrapper) Sign(data []byte) (*insolar.Signature, error) {
-	return sw.ecdsaDigestSignerWrapper.Sign(sw.hasher.Hash(data))
+	return sw.digestSigner.Sign(sw.hasher.Hash(data))
 }
 
 type ecdsaDigestVerifyWrapper struct {
@@ -17,25 +17,47 @@ import (
 	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/platformpolicy/internal/sign"
 	"github.com/pkg/errors"
+	"golang.org/x/crypto/ed25519"
 )
 
 type keyProcessor struct {
-	curve elliptic.Curve
+	algorithm string
+	curve     elliptic.Curve
 }
 
+// NewKeyProcessor creates key processor for ECDSA keys.
 func NewKeyProcessor() insolar.KeyProcessor {
 	return &keyProcessor{
-		curve: elliptic.P256(),
+		algorithm: ECDSA,
+		curve:     elliptic.P256(),
 	}
 }
 
+// NewKeyProcessorWithAlgorithm creates key processor, that generates keys of provided signature algorithm.
+// PEM encoded keys of all supported algorithms are accepted.
+func NewKeyProcessorWithAlgorithm(algorithm string) (insolar.KeyProcessor, error) {
+	if err := checkAlgorithm(algorithm); err != nil {
+		return nil, err
+	}
+	return &keyProcessor{
+		algorithm: algorithm,
+		curve:     elliptic.P256(),
+	}, nil
+}
+
 func (kp *keyProcessor) GeneratePrivateKey() (crypto.PrivateKey, error) {
+	if kp.algorithm == Ed25519 {
+		_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+		return privateKey, err
+	}
 	return ecdsa.GenerateKey(kp.curve, rand.Reader)
 }
 
 func (*keyProcessor) ExtractPublicKey(privateKey crypto.PrivateKey) crypto.PublicKey {
-	ecdsaPrivateKey := sign.MustConvertPrivateKeyToEcdsa(privateKey)
-	publicKey := ecdsaPrivateKey.PublicKey
+	if ed25519PrivateKey, ok := privateKey.(ed25519.PrivateKey); ok {
+		return ed25519PrivateKey.Public()
+	}
+	publicKey := *sign.MustExtractEcdsaPublicKey(privateKey)
 	return &publicKey
 }
 
@@ -71,8 +93,13 @@ func (*keyProcessor) ImportPrivateKeyPEM(pemEncoded []byte) (crypto.PrivateKey,
 }
 
 func (*keyProcessor) ExportPublicKeyPEM(publicKey crypto.PublicKey) ([]byte, error) {
-	ecdsaPublicKey := sign.MustConvertPublicKeyToEcdsa(publicKey)
-	x509EncodedPub, err := x509.MarshalPKIXPublicKey(ecdsaPublicKey)
+	var x509Key interface{}
+	if ed25519PublicKey, ok := publicKey.(ed25519.PublicKey); ok {
+		x509Key = ed25519PublicKey
+	} else {
+		x509Key = sign.MustConvertPublicKeyToEcdsa(publicKey)
+	}
+	x509EncodedPub, err := x509.MarshalPKIXPublicKey(x509Key)
 	if err != nil {
 		return nil, errors.Wrap(err, "[ ExportPublicKey ]")
 	}
@@ -81,8 +108,13 @@ func (*keyProcessor) ExportPublicKeyPEM(publicKey crypto.PublicKey) ([]byte, err
 }
 
 func (*keyProcessor) ExportPrivateKeyPEM(privateKey crypto.PrivateKey) ([]byte, error) {
-	ecdsaPrivateKey := sign.MustConvertPrivateKeyToEcdsa(privateKey)
-	x509Encoded, err := x509.MarshalPKCS8PrivateKey(ecdsaPrivateKey)
+	var x509Key interface{}
+	if ed25519PrivateKey, ok := privateKey.(ed25519.PrivateKey); ok {
+		x509Key = ed25519PrivateKey
+	} else {
+		x509Key = sign.MustConvertPrivateKeyToEcdsa(privateKey)
+	}
+	x509Encoded, err := x509.MarshalPKCS8PrivateKey(x509Key)
 	if err != nil {
 		return nil, errors.Wrap(err, "[ ExportPrivateKey ]")
 	}
@@ -91,11 +123,22 @@ func (*keyProcessor) ExportPrivateKeyPEM(privateKey crypto.PrivateKey) ([]byte,
 }
 
 func (kp *keyProcessor) ExportPublicKeyBinary(publicKey crypto.PublicKey) ([]byte, error) {
+	if ed25519PublicKey, ok := publicKey.(ed25519.PublicKey); ok {
+		return append([]byte(nil), ed25519PublicKey...), nil
+	}
 	ecdsaPublicKey := sign.MustConvertPublicKeyToEcdsa(publicKey)
 	return sign.SerializeTwoBigInt(ecdsaPublicKey.X, ecdsaPublicKey.Y), nil
 }
 
+// ImportPublicKeyBinary creates public key of processor's algorithm, binary form doesn't carry algorithm.
 func (kp *keyProcessor) ImportPublicKeyBinary(data []byte) (crypto.PublicKey, error) {
+	if kp.algorithm == Ed25519 {
+		publicKey, err := sign.ImportEd25519PublicKeyBinary(data)
+		if err != nil {
+			return nil, errors.Wrap(err, "[ ImportPublicKeyBinary ]")
+		}
+		return publicKey, nil
+	}
 	x, y, err := sign.DeserializeTwoBigInt(data)
 	if err != nil {
 		return nil, errors.Wrap(err, "[ ImportPublicKeyBinary ]")
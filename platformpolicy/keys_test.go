@@ -60,3 +60,36 @@ func TestExportImportPublicKeyBinary(t *testing.T) {
 
 	assert.Equal(t, encoded, encodedBinPK)
 }
+
+func TestEd25519Keys(t *testing.T) {
+	ks, err := NewKeyProcessorWithAlgorithm(Ed25519)
+	require.NoError(t, err)
+
+	privateKey, err := ks.GeneratePrivateKey()
+	require.NoError(t, err)
+	publicKey := ks.ExtractPublicKey(privateKey)
+
+	encodedPrivate, err := ks.ExportPrivateKeyPEM(privateKey)
+	require.NoError(t, err)
+	decodedPrivate, err := NewKeyProcessor().ImportPrivateKeyPEM(encodedPrivate)
+	require.NoError(t, err)
+	assert.Equal(t, privateKey, decodedPrivate)
+
+	encodedPublic, err := ks.ExportPublicKeyPEM(publicKey)
+	require.NoError(t, err)
+	decodedPublic, err := NewKeyProcessor().ImportPublicKeyPEM(encodedPublic)
+	require.NoError(t, err)
+	assert.Equal(t, publicKey, decodedPublic)
+
+	bin, err := ks.ExportPublicKeyBinary(publicKey)
+	require.NoError(t, err)
+	assert.Len(t, bin, 32)
+	binPK, err := ks.ImportPublicKeyBinary(bin)
+	require.NoError(t, err)
+	assert.Equal(t, publicKey, binPK)
+}
+
+func TestNewKeyProcessorWithAlgorithm_Unknown(t *testing.T) {
+	_, err := NewKeyProcessorWithAlgorithm("rsa")
+	require.Error(t, err)
+}
@@ -0,0 +1,135 @@
+// Copyright 2020 Insolar Network Ltd.
+// All rights reserved.
+// This material is licensed under the Insolar License version 1.0,
+// available at https://github.com/insolar/insolar/blob/master/LICENSE.md.
+
+package multipulsar
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/insolar"
+)
+
+type requestType int
+
+const (
+	commitRequest requestType = iota + 1
+	revealRequest
+	signRequest
+)
+
+func (t requestType) String() string {
+	switch t {
+	case commitRequest:
+		return "commit"
+	case revealRequest:
+		return "reveal"
+	case signRequest:
+		return "sign"
+	default:
+		return "unknown"
+	}
+}
+
+// envelope is a signed request of the round initiator.
+type envelope struct {
+	Sender    string
+	Body      []byte
+	Signature []byte
+}
+
+// request is sent by the round initiator to other pulsars.
+type request struct {
+	Type        requestType
+	PulseNumber insolar.PulseNumber
+	// Commits of all pulsars, sent with reveal request.
+	Commits []Commit
+	// Reveals of all pulsars, sent with sign request.
+	Reveals []Reveal
+}
+
+type response struct {
+	Commit       *Commit
+	Reveal       *Reveal
+	Confirmation *insolar.PulseSenderConfirmation
+	Error        string
+}
+
+// Commit binds a pulsar to its entropy without disclosing it.
+type Commit struct {
+	PublicKey   string
+	PulseNumber insolar.PulseNumber
+	Hash        []byte
+	Signature   []byte
+}
+
+// Reveal discloses the committed entropy.
+type Reveal struct {
+	PublicKey   string
+	PulseNumber insolar.PulseNumber
+	Entropy     insolar.Entropy
+}
+
+// commitHash calculates the value a pulsar commits to.
+func commitHash(hasher insolar.Hasher, publicKey string, pn insolar.PulseNumber, entropy insolar.Entropy) []byte {
+	_, _ = hasher.Write(pn.Bytes())
+	_, _ = hasher.Write([]byte(publicKey))
+	_, _ = hasher.Write(entropy[:])
+	return hasher.Sum(nil)
+}
+
+// commitSigningHash calculates hash of the commit, that is signed by the pulsar.
+func commitSigningHash(hasher insolar.Hasher, commit Commit) []byte {
+	_, _ = hasher.Write(commit.PulseNumber.Bytes())
+	_, _ = hasher.Write([]byte(commit.PublicKey))
+	_, _ = hasher.Write(commit.Hash)
+	return hasher.Sum(nil)
+}
+
+// combine calculates entropy of the pulse from entropies of all pulsars.
+// Result doesn't depend on the order of reveals.
+func combine(hasher insolar.Hasher, reveals []Reveal) insolar.Entropy {
+	sorted := make([]Reveal, len(reveals))
+	copy(sorted, reveals)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].PublicKey < sorted[j].PublicKey
+	})
+
+	for _, r := range sorted {
+		_, _ = hasher.Write(r.Entropy[:])
+	}
+
+	var result insolar.Entropy
+	copy(result[:], hasher.Sum(nil))
+	return result
+}
+
+// checkReveals checks, that reveals match commits.
+func checkReveals(newHasher func() insolar.Hasher, pn insolar.PulseNumber, commits map[string]Commit, reveals []Reveal) error {
+	if len(reveals) != len(commits) {
+		return errors.Errorf("expected %d reveals, got %d", len(commits), len(reveals))
+	}
+	seen := make(map[string]struct{}, len(reveals))
+	for _, r := range reveals {
+		if _, ok := seen[r.PublicKey]; ok {
+			return errors.New("duplicated reveal")
+		}
+		seen[r.PublicKey] = struct{}{}
+
+		commit, ok := commits[r.PublicKey]
+		if !ok {
+			return errors.New("reveal of unknown pulsar")
+		}
+		if r.PulseNumber != pn {
+			return errors.Errorf("reveal is for pulse %d, expected %d", r.PulseNumber, pn)
+		}
+		if !bytes.Equal(commitHash(newHasher(), r.PublicKey, pn, r.Entropy), commit.Hash) {
+			return errors.New("revealed entropy doesn't match commit")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,335 @@
+// Copyright 2020 Insolar Network Ltd.
+// All rights reserved.
+// This material is licensed under the Insolar License version 1.0,
+// available at https://github.com/insolar/insolar/blob/master/LICENSE.md.
+
+// Package multipulsar generates entropy of pulses by several pulsars.
+//
+// Round initiator asks every pulsar to commit to its entropy, then to reveal it
+// when commits of all pulsars are known, and at last to sign the combined entropy.
+// No pulsar can choose the combined entropy, because it commits before other entropies are revealed.
+package multipulsar
+
+import (
+	"context"
+	"crypto"
+	"encoding/binary"
+	"encoding/json"
+	"io"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/network/transport"
+	"github.com/insolar/insolar/pulsar"
+	"github.com/insolar/insolar/pulsar/entropygenerator"
+)
+
+type peer struct {
+	address   string
+	publicKey crypto.PublicKey
+}
+
+// Source is an entropy source of a pulsar, that generates entropy together with other pulsars.
+// Every pulsar runs a Source to take part in rounds, initiator also uses it as pulsar.EntropySource.
+type Source struct {
+	CryptographyService        insolar.CryptographyService        `inject:""`
+	PlatformCryptographyScheme insolar.PlatformCryptographyScheme `inject:""`
+	KeyProcessor               insolar.KeyProcessor               `inject:""`
+
+	cfg       configuration.MultiPulsar
+	factory   transport.Factory
+	transport transport.StreamTransport
+	generator entropygenerator.EntropyGenerator
+
+	publicKeyRaw string
+	peers        map[string]peer
+
+	lock       sync.Mutex
+	rounds     map[insolar.PulseNumber]*round
+	lastSigned insolar.PulseNumber
+}
+
+// New creates a new Source. Factory is used to create transport for round messages.
+func New(
+	cfg configuration.MultiPulsar,
+	factory transport.Factory,
+	generator entropygenerator.EntropyGenerator,
+) *Source {
+	return &Source{
+		cfg:       cfg,
+		factory:   factory,
+		generator: generator,
+		rounds:    map[insolar.PulseNumber]*round{},
+	}
+}
+
+// Init imports public keys of peers and creates transport. Public keys must be in PEM format.
+func (s *Source) Init(ctx context.Context) error {
+	pubKey, err := s.CryptographyService.GetPublicKey()
+	if err != nil {
+		return errors.Wrap(err, "failed to get public key")
+	}
+	pubKeyRaw, err := s.KeyProcessor.ExportPublicKeyPEM(pubKey)
+	if err != nil {
+		return errors.Wrap(err, "failed to export public key")
+	}
+	s.publicKeyRaw = string(pubKeyRaw)
+
+	s.peers = make(map[string]peer, len(s.cfg.Peers))
+	for _, p := range s.cfg.Peers {
+		key, err := s.KeyProcessor.ImportPublicKeyPEM([]byte(p.PublicKey))
+		if err != nil {
+			return errors.Wrapf(err, "failed to import public key of peer %s", p.Address)
+		}
+		// keys are compared as strings, so they are normalized
+		keyRaw, err := s.KeyProcessor.ExportPublicKeyPEM(key)
+		if err != nil {
+			return errors.Wrapf(err, "failed to export public key of peer %s", p.Address)
+		}
+		if string(keyRaw) == s.publicKeyRaw {
+			return errors.Errorf("peer %s has public key of this pulsar", p.Address)
+		}
+		if _, ok := s.peers[string(keyRaw)]; ok {
+			return errors.Errorf("peer %s has duplicated public key", p.Address)
+		}
+		s.peers[string(keyRaw)] = peer{address: p.Address, publicKey: key}
+	}
+
+	s.transport, err = s.factory.CreateStreamTransport(s)
+	if err != nil {
+		return errors.Wrap(err, "failed to create transport")
+	}
+	return nil
+}
+
+// Start starts listening for round requests.
+func (s *Source) Start(ctx context.Context) error {
+	return s.transport.Start(ctx)
+}
+
+// Stop stops listening for round requests.
+func (s *Source) Stop(ctx context.Context) error {
+	if s.transport == nil {
+		return nil
+	}
+	return s.transport.Stop(ctx)
+}
+
+// PublicKey returns public key of the pulsar in PEM format.
+func (s *Source) PublicKey() string {
+	return s.publicKeyRaw
+}
+
+// Entropy runs a round with all peers and returns the combined entropy with confirmations of all pulsars.
+// It implements pulsar.EntropySource.
+func (s *Source) Entropy(ctx context.Context, pn insolar.PulseNumber) (insolar.Entropy, map[string]insolar.PulseSenderConfirmation, error) {
+	logger := inslogger.FromContext(ctx)
+	if s.cfg.RoundTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(s.cfg.RoundTimeout)*time.Millisecond)
+		defer cancel()
+	}
+
+	// commit
+	own, err := s.commit(pn)
+	if err != nil {
+		return insolar.Entropy{}, nil, errors.Wrap(err, "failed to commit")
+	}
+	commits := []Commit{own}
+	responses, err := s.broadcast(ctx, request{Type: commitRequest, PulseNumber: pn})
+	if err != nil {
+		return insolar.Entropy{}, nil, err
+	}
+	for key, resp := range responses {
+		if resp.Commit == nil || resp.Commit.PublicKey != key {
+			return insolar.Entropy{}, nil, errors.Errorf("peer %s returned invalid commit", s.peers[key].address)
+		}
+		commits = append(commits, *resp.Commit)
+	}
+	sort.Slice(commits, func(i, j int) bool {
+		return commits[i].PublicKey < commits[j].PublicKey
+	})
+	logger.Debugf("[ multipulsar ] got commits of %d pulsars for pulse %d", len(commits), pn)
+
+	// reveal
+	ownReveal, err := s.reveal(pn, commits)
+	if err != nil {
+		return insolar.Entropy{}, nil, errors.Wrap(err, "failed to reveal")
+	}
+	reveals := []Reveal{ownReveal}
+	responses, err = s.broadcast(ctx, request{Type: revealRequest, PulseNumber: pn, Commits: commits})
+	if err != nil {
+		return insolar.Entropy{}, nil, err
+	}
+	for _, resp := range responses {
+		if resp.Reveal == nil {
+			return insolar.Entropy{}, nil, errors.New("peer returned empty reveal")
+		}
+		reveals = append(reveals, *resp.Reveal)
+	}
+	err = checkReveals(s.PlatformCryptographyScheme.IntegrityHasher, pn, commitsByKey(commits), reveals)
+	if err != nil {
+		return insolar.Entropy{}, nil, errors.Wrap(err, "invalid reveals")
+	}
+	entropy := combine(s.PlatformCryptographyScheme.IntegrityHasher(), reveals)
+	logger.Debugf("[ multipulsar ] got reveals of %d pulsars for pulse %d", len(reveals), pn)
+
+	// sign
+	ownConfirmation, err := s.sign(pn, reveals)
+	if err != nil {
+		return insolar.Entropy{}, nil, errors.Wrap(err, "failed to sign")
+	}
+	confirmations := map[string]insolar.PulseSenderConfirmation{s.publicKeyRaw: ownConfirmation}
+	responses, err = s.broadcast(ctx, request{Type: signRequest, PulseNumber: pn, Reveals: reveals})
+	if err != nil {
+		return insolar.Entropy{}, nil, err
+	}
+	for key, resp := range responses {
+		c := resp.Confirmation
+		if c == nil || c.ChosenPublicKey != key || c.PulseNumber != pn || c.Entropy != entropy {
+			return insolar.Entropy{}, nil, errors.Errorf("peer %s returned invalid confirmation", s.peers[key].address)
+		}
+		err := pulsar.VerifyConfirmation(s.CryptographyService, s.PlatformCryptographyScheme, s.peers[key].publicKey, *c)
+		if err != nil {
+			return insolar.Entropy{}, nil, errors.Wrapf(err, "peer %s returned invalid confirmation", s.peers[key].address)
+		}
+		confirmations[key] = *c
+	}
+
+	return entropy, confirmations, nil
+}
+
+// broadcast sends the request to all peers and returns their responses indexed by public keys.
+func (s *Source) broadcast(ctx context.Context, req request) (map[string]response, error) {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal request")
+	}
+	signature, err := s.CryptographyService.Sign(body)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to sign request")
+	}
+	env := envelope{Sender: s.publicKeyRaw, Body: body, Signature: signature.Bytes()}
+
+	var (
+		lock      sync.Mutex
+		wg        sync.WaitGroup
+		responses = make(map[string]response, len(s.peers))
+		errs      []error
+	)
+	wg.Add(len(s.peers))
+	for key, p := range s.peers {
+		go func(key string, p peer) {
+			defer wg.Done()
+
+			resp, err := s.send(ctx, p.address, env)
+			if err == nil && resp.Error != "" {
+				err = errors.New(resp.Error)
+			}
+			if err == nil && resp.Commit != nil {
+				err = s.checkCommit(p.publicKey, *resp.Commit)
+			}
+
+			lock.Lock()
+			defer lock.Unlock()
+			if err != nil {
+				errs = append(errs, errors.Wrapf(err, "%s request to peer %s failed", req.Type, p.address))
+				return
+			}
+			responses[key] = resp
+		}(key, p)
+	}
+	wg.Wait()
+
+	if len(errs) > 0 {
+		return nil, errs[0]
+	}
+	return responses, nil
+}
+
+func (s *Source) send(ctx context.Context, address string, env envelope) (response, error) {
+	conn, err := s.transport.Dial(ctx, address)
+	if err != nil {
+		return response{}, errors.Wrap(err, "failed to connect")
+	}
+	defer conn.Close() // nolint
+	setDeadline(ctx, conn)
+
+	err = writeMessage(conn, env)
+	if err != nil {
+		return response{}, errors.Wrap(err, "failed to write request")
+	}
+	var resp response
+	err = readMessage(conn, &resp)
+	if err != nil {
+		return response{}, errors.Wrap(err, "failed to read response")
+	}
+	return resp, nil
+}
+
+func (s *Source) checkCommit(key crypto.PublicKey, commit Commit) error {
+	hash := commitSigningHash(s.PlatformCryptographyScheme.IntegrityHasher(), commit)
+	if !s.CryptographyService.Verify(key, insolar.SignatureFromBytes(commit.Signature), hash) {
+		return errors.New("invalid commit signature")
+	}
+	return nil
+}
+
+func setDeadline(ctx context.Context, conn io.ReadWriteCloser) {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return
+	}
+	if c, ok := conn.(interface{ SetDeadline(time.Time) error }); ok {
+		_ = c.SetDeadline(deadline)
+	}
+}
+
+// maxMessageSize limits size of round messages.
+const maxMessageSize = 1 << 20
+
+// writeMessage writes length-prefixed json-encoded message.
+func writeMessage(w io.Writer, msg interface{}) error {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	buf := make([]byte, 4+len(data))
+	binary.BigEndian.PutUint32(buf, uint32(len(data)))
+	copy(buf[4:], data)
+	_, err = w.Write(buf)
+	return err
+}
+
+// readMessage reads message written by writeMessage.
+func readMessage(r io.Reader, msg interface{}) error {
+	var size [4]byte
+	_, err := io.ReadFull(r, size[:])
+	if err != nil {
+		return err
+	}
+	length := binary.BigEndian.Uint32(size[:])
+	if length > maxMessageSize {
+		return errors.Errorf("message is too large: %d bytes", length)
+	}
+	data := make([]byte, length)
+	_, err = io.ReadFull(r, data)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, msg)
+}
+
+func commitsByKey(commits []Commit) map[string]Commit {
+	result := make(map[string]Commit, len(commits))
+	for _, c := range commits {
+		result[c.PublicKey] = c
+	}
+	return result
+}
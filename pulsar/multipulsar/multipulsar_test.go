@@ -0,0 +1,223 @@
+// Copyright 2020 Insolar Network Ltd.
+// All rights reserved.
+// This material is licensed under the Insolar License version 1.0,
+// available at https://github.com/insolar/insolar/blob/master/LICENSE.md.
+
+package multipulsar
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/cryptography"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/network/transport"
+	"github.com/insolar/insolar/platformpolicy"
+	"github.com/insolar/insolar/pulsar"
+	"github.com/insolar/insolar/pulsar/entropygenerator"
+	"github.com/insolar/insolar/pulse"
+	"github.com/insolar/insolar/testutils"
+)
+
+type testPulsar struct {
+	address   string
+	publicKey string
+	crypto    insolar.CryptographyService
+}
+
+func newTestPulsar(t *testing.T, address string) testPulsar {
+	proc := platformpolicy.NewKeyProcessor()
+	key, err := proc.GeneratePrivateKey()
+	require.NoError(t, err)
+	pem, err := proc.ExportPublicKeyPEM(proc.ExtractPublicKey(key))
+	require.NoError(t, err)
+	return testPulsar{
+		address:   address,
+		publicKey: string(pem),
+		crypto:    cryptography.NewKeyBoundCryptographyService(key),
+	}
+}
+
+func startSource(t *testing.T, self testPulsar, peers []testPulsar) *Source {
+	cfg := configuration.NewPulsar().MultiPulsar
+	cfg.Enabled = true
+	cfg.Transport.Address = self.address
+	for _, p := range peers {
+		if p.address != self.address {
+			cfg.Peers = append(cfg.Peers, configuration.PulsarNodeAddress{Address: p.address, PublicKey: p.publicKey})
+		}
+	}
+
+	s := New(cfg, transport.NewFakeFactory(cfg.Transport), &entropygenerator.StandardEntropyGenerator{})
+	s.CryptographyService = self.crypto
+	s.PlatformCryptographyScheme = platformpolicy.NewPlatformCryptographyScheme()
+	s.KeyProcessor = platformpolicy.NewKeyProcessor()
+
+	ctx := context.Background()
+	require.NoError(t, s.Init(ctx))
+	require.NoError(t, s.Start(ctx))
+	return s
+}
+
+// startSources starts pulsars, that know each other.
+func startSources(t *testing.T, firstPort, count int) ([]testPulsar, []*Source) {
+	pulsars := make([]testPulsar, count)
+	for i := range pulsars {
+		pulsars[i] = newTestPulsar(t, fmt.Sprintf("127.0.0.1:%d", firstPort+i))
+	}
+	sources := make([]*Source, count)
+	for i := range pulsars {
+		sources[i] = startSource(t, pulsars[i], pulsars)
+	}
+	return pulsars, sources
+}
+
+func stopSources(t *testing.T, sources []*Source) {
+	for _, s := range sources {
+		require.NoError(t, s.Stop(context.Background()))
+	}
+}
+
+func TestSource_Entropy(t *testing.T) {
+	ctx := context.Background()
+	pulsars, sources := startSources(t, 30100, 3)
+	defer stopSources(t, sources)
+
+	scheme := platformpolicy.NewPlatformCryptographyScheme()
+	proc := platformpolicy.NewKeyProcessor()
+
+	pn := insolar.PulseNumber(pulse.MinTimePulse + 100)
+	entropy, confirmations, err := sources[0].Entropy(ctx, pn)
+	require.NoError(t, err)
+	require.Len(t, confirmations, len(pulsars))
+
+	for _, p := range pulsars {
+		c, ok := confirmations[p.publicKey]
+		require.True(t, ok)
+		require.Equal(t, p.publicKey, c.ChosenPublicKey)
+		require.Equal(t, pn, c.PulseNumber)
+		require.Equal(t, entropy, c.Entropy)
+
+		key, err := proc.ImportPublicKeyPEM([]byte(p.publicKey))
+		require.NoError(t, err)
+		require.NoError(t, pulsar.VerifyConfirmation(p.crypto, scheme, key, c))
+	}
+
+	// every round gives new entropy
+	next, _, err := sources[1].Entropy(ctx, pn+10)
+	require.NoError(t, err)
+	require.NotEqual(t, entropy, next)
+
+	// signed pulse can't be signed again
+	_, _, err = sources[2].Entropy(ctx, pn)
+	require.Error(t, err)
+}
+
+func TestSource_PulsarSend(t *testing.T) {
+	pulsars, sources := startSources(t, 30200, 3)
+	defer stopSources(t, sources)
+
+	scheme := platformpolicy.NewPlatformCryptographyScheme()
+	proc := platformpolicy.NewKeyProcessor()
+	pn := insolar.PulseNumber(pulse.MinTimePulse + 100)
+
+	distributor := testutils.NewPulseDistributorMock(t)
+	distributor.DistributeMock.Set(func(ctx context.Context, p insolar.Pulse) {
+		require.Equal(t, pn, p.PulseNumber)
+		require.Len(t, p.Signs, len(pulsars))
+		for key, c := range p.Signs {
+			require.Equal(t, p.Entropy, c.Entropy)
+			pk, err := proc.ImportPublicKeyPEM([]byte(key))
+			require.NoError(t, err)
+			require.NoError(t, pulsar.VerifyConfirmation(pulsars[0].crypto, scheme, pk, c))
+		}
+	})
+
+	p := pulsar.NewPulsar(
+		configuration.NewPulsar(),
+		pulsars[0].crypto,
+		scheme,
+		proc,
+		distributor,
+		&entropygenerator.StandardEntropyGenerator{},
+	)
+	p.EntropySource = sources[0]
+
+	require.NoError(t, p.Send(context.Background(), pn))
+	distributor.MinimockWait(time.Minute)
+}
+
+func TestSource_Entropy_Errors(t *testing.T) {
+	ctx := context.Background()
+	pn := insolar.PulseNumber(pulse.MinTimePulse + 100)
+
+	t.Run("peer is unavailable", func(t *testing.T) {
+		pulsars, sources := startSources(t, 30300, 3)
+		defer stopSources(t, sources[:2])
+		require.NoError(t, sources[2].Stop(ctx))
+
+		_, _, err := sources[0].Entropy(ctx, pn)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), pulsars[2].address)
+	})
+
+	t.Run("unknown initiator", func(t *testing.T) {
+		pulsars, sources := startSources(t, 30400, 2)
+		defer stopSources(t, sources)
+
+		// stranger knows pulsars, but they don't know it
+		stranger := startSource(t, newTestPulsar(t, "127.0.0.1:30410"), pulsars)
+		defer stopSources(t, []*Source{stranger})
+
+		_, _, err := stranger.Entropy(ctx, pn)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "request from unknown pulsar")
+	})
+}
+
+func TestSource_Reveal(t *testing.T) {
+	pn := insolar.PulseNumber(pulse.MinTimePulse + 100)
+	_, sources := startSources(t, 30500, 3)
+	defer stopSources(t, sources)
+
+	commits := make([]Commit, len(sources))
+	for i, s := range sources {
+		var err error
+		commits[i], err = s.commit(pn)
+		require.NoError(t, err)
+	}
+
+	// entropy isn't revealed until commits of all pulsars are known
+	_, err := sources[0].reveal(pn, commits[:2])
+	require.Error(t, err)
+
+	// forged commit is rejected
+	forged := append([]Commit{}, commits...)
+	forged[1].Hash = commits[2].Hash
+	_, err = sources[0].reveal(pn, forged)
+	require.Error(t, err)
+
+	reveals := make([]Reveal, len(sources))
+	for i, s := range sources {
+		reveals[i], err = s.reveal(pn, commits)
+		require.NoError(t, err)
+	}
+
+	// reveals can't be replaced after commit
+	wrong := append([]Reveal{}, reveals...)
+	wrong[1].Entropy = reveals[2].Entropy
+	_, err = sources[0].sign(pn, wrong)
+	require.Error(t, err)
+
+	// entropies are combined in the same way regardless of order
+	first, err := sources[0].sign(pn, reveals)
+	require.NoError(t, err)
+	second, err := sources[1].sign(pn, []Reveal{reveals[2], reveals[0], reveals[1]})
+	require.NoError(t, err)
+	require.Equal(t, first.Entropy, second.Entropy)
+}
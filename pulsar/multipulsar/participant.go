@@ -0,0 +1,220 @@
+// Copyright 2020 Insolar Network Ltd.
+// All rights reserved.
+// This material is licensed under the Insolar License version 1.0,
+// available at https://github.com/insolar/insolar/blob/master/LICENSE.md.
+
+package multipulsar
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"encoding/json"
+	"io"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/pulsar"
+)
+
+// round is a state of the pulsar in a round.
+type round struct {
+	entropy insolar.Entropy
+	commit  Commit
+	// commits are fixed when the pulsar reveals its entropy
+	commits map[string]Commit
+}
+
+// HandleStream serves requests of the round initiator.
+func (s *Source) HandleStream(ctx context.Context, address string, stream io.ReadWriteCloser) {
+	logger := inslogger.FromContext(ctx)
+	defer stream.Close() // nolint
+	setDeadline(ctx, stream)
+
+	var env envelope
+	err := readMessage(stream, &env)
+	if err != nil {
+		logger.Warnf("[ multipulsar ] failed to read request from %s: %s", address, err)
+		return
+	}
+
+	resp, err := s.handle(env)
+	if err != nil {
+		logger.Warnf("[ multipulsar ] request from %s failed: %s", address, err)
+		resp = response{Error: err.Error()}
+	}
+
+	err = writeMessage(stream, resp)
+	if err != nil {
+		logger.Warnf("[ multipulsar ] failed to write response to %s: %s", address, err)
+	}
+}
+
+func (s *Source) handle(env envelope) (response, error) {
+	sender, ok := s.peers[env.Sender]
+	if !ok {
+		return response{}, errors.New("request from unknown pulsar")
+	}
+	if !s.CryptographyService.Verify(sender.publicKey, insolar.SignatureFromBytes(env.Signature), env.Body) {
+		return response{}, errors.New("invalid request signature")
+	}
+
+	var req request
+	err := json.Unmarshal(env.Body, &req)
+	if err != nil {
+		return response{}, errors.Wrap(err, "failed to unmarshal request")
+	}
+
+	switch req.Type {
+	case commitRequest:
+		commit, err := s.commit(req.PulseNumber)
+		return response{Commit: &commit}, err
+	case revealRequest:
+		reveal, err := s.reveal(req.PulseNumber, req.Commits)
+		return response{Reveal: &reveal}, err
+	case signRequest:
+		confirmation, err := s.sign(req.PulseNumber, req.Reveals)
+		return response{Confirmation: &confirmation}, err
+	default:
+		return response{}, errors.Errorf("unknown request type %d", req.Type)
+	}
+}
+
+// commit generates entropy of the pulsar for the pulse and returns a commit to it.
+// Repeated requests for the same pulse return the same commit.
+func (s *Source) commit(pn insolar.PulseNumber) (Commit, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if pn <= s.lastSigned {
+		return Commit{}, errors.Errorf("pulse %d is already signed, latest signed pulse is %d", pn, s.lastSigned)
+	}
+	if r, ok := s.rounds[pn]; ok {
+		return r.commit, nil
+	}
+	// unfinished rounds of previous pulses are not needed anymore
+	for old := range s.rounds {
+		if old < pn {
+			delete(s.rounds, old)
+		}
+	}
+
+	entropy := s.generator.GenerateEntropy()
+	commit := Commit{
+		PublicKey:   s.publicKeyRaw,
+		PulseNumber: pn,
+		Hash:        commitHash(s.PlatformCryptographyScheme.IntegrityHasher(), s.publicKeyRaw, pn, entropy),
+	}
+	signature, err := s.CryptographyService.Sign(commitSigningHash(s.PlatformCryptographyScheme.IntegrityHasher(), commit))
+	if err != nil {
+		return Commit{}, errors.Wrap(err, "failed to sign commit")
+	}
+	commit.Signature = signature.Bytes()
+
+	s.rounds[pn] = &round{entropy: entropy, commit: commit}
+	return commit, nil
+}
+
+// reveal discloses entropy of the pulsar when commits of all pulsars are known.
+func (s *Source) reveal(pn insolar.PulseNumber, commits []Commit) (Reveal, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	r, ok := s.rounds[pn]
+	if !ok {
+		return Reveal{}, errors.Errorf("no commit for pulse %d", pn)
+	}
+
+	byKey, err := s.checkCommits(pn, commits)
+	if err != nil {
+		return Reveal{}, err
+	}
+	if !bytes.Equal(byKey[s.publicKeyRaw].Hash, r.commit.Hash) {
+		return Reveal{}, errors.New("commit of this pulsar is changed")
+	}
+	if r.commits != nil && !sameCommits(r.commits, byKey) {
+		return Reveal{}, errors.New("entropy is already revealed for other commits")
+	}
+	r.commits = byKey
+
+	return Reveal{PublicKey: s.publicKeyRaw, PulseNumber: pn, Entropy: r.entropy}, nil
+}
+
+// sign confirms entropy combined from reveals of all pulsars.
+func (s *Source) sign(pn insolar.PulseNumber, reveals []Reveal) (insolar.PulseSenderConfirmation, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	r, ok := s.rounds[pn]
+	if !ok || r.commits == nil {
+		return insolar.PulseSenderConfirmation{}, errors.Errorf("entropy for pulse %d is not revealed", pn)
+	}
+	err := checkReveals(s.PlatformCryptographyScheme.IntegrityHasher, pn, r.commits, reveals)
+	if err != nil {
+		return insolar.PulseSenderConfirmation{}, err
+	}
+
+	confirmation, err := pulsar.SignConfirmation(s.CryptographyService, s.PlatformCryptographyScheme, insolar.PulseSenderConfirmation{
+		PulseNumber:     pn,
+		ChosenPublicKey: s.publicKeyRaw,
+		Entropy:         combine(s.PlatformCryptographyScheme.IntegrityHasher(), reveals),
+	})
+	if err != nil {
+		return insolar.PulseSenderConfirmation{}, errors.Wrap(err, "failed to sign confirmation")
+	}
+
+	delete(s.rounds, pn)
+	s.lastSigned = pn
+	return confirmation, nil
+}
+
+// checkCommits checks, that there is a single valid commit of every pulsar.
+func (s *Source) checkCommits(pn insolar.PulseNumber, commits []Commit) (map[string]Commit, error) {
+	if len(commits) != len(s.peers)+1 {
+		return nil, errors.Errorf("expected %d commits, got %d", len(s.peers)+1, len(commits))
+	}
+	byKey := make(map[string]Commit, len(commits))
+	for _, c := range commits {
+		if _, ok := byKey[c.PublicKey]; ok {
+			return nil, errors.New("duplicated commit")
+		}
+		if c.PulseNumber != pn {
+			return nil, errors.Errorf("commit is for pulse %d, expected %d", c.PulseNumber, pn)
+		}
+
+		var key crypto.PublicKey
+		if c.PublicKey == s.publicKeyRaw {
+			var err error
+			key, err = s.CryptographyService.GetPublicKey()
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to get public key")
+			}
+		} else {
+			p, ok := s.peers[c.PublicKey]
+			if !ok {
+				return nil, errors.New("commit of unknown pulsar")
+			}
+			key = p.publicKey
+		}
+		err := s.checkCommit(key, c)
+		if err != nil {
+			return nil, err
+		}
+		byKey[c.PublicKey] = c
+	}
+	return byKey, nil
+}
+
+func sameCommits(a, b map[string]Commit) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for key, c := range a {
+		if !bytes.Equal(c.Hash, b[key].Hash) {
+			return false
+		}
+	}
+	return true
+}
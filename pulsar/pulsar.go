@@ -7,12 +7,14 @@ package pulsar
 
 import (
 	"context"
+	"crypto"
 	"sync"
 	"time"
 
+	"github.com/pkg/errors"
+
 	"github.com/insolar/insolar/certificate"
 	"github.com/insolar/insolar/instrumentation/inslogger"
-	"github.com/insolar/insolar/platformpolicy"
 	"github.com/insolar/insolar/pulsar/entropygenerator"
 	"go.opencensus.io/stats"
 
@@ -29,6 +31,8 @@ type Pulsar struct {
 	PublicKeyRaw string
 
 	EntropyGenerator entropygenerator.EntropyGenerator
+	// EntropySource generates entropy for pulses instead of EntropyGenerator, if it's set.
+	EntropySource EntropySource
 
 	Certificate                certificate.Certificate
 	CryptographyService        insolar.CryptographyService
@@ -74,11 +78,21 @@ func NewPulsar(
 	return pulsar
 }
 
+// EntropySource generates entropy for a new pulse with confirmations of pulsars, that took part in its generation.
+// Confirmations are indexed by public keys of pulsars.
+type EntropySource interface {
+	Entropy(ctx context.Context, pulseNumber insolar.PulseNumber) (insolar.Entropy, map[string]insolar.PulseSenderConfirmation, error)
+}
+
 func (p *Pulsar) Send(ctx context.Context, pulseNumber insolar.PulseNumber) error {
 	logger := inslogger.FromContext(ctx)
 	logger.Infof("before sending new pulseNumber: %v", pulseNumber)
 
-	entropy, _, err := p.generateNewEntropyAndSign()
+	source := p.EntropySource
+	if source == nil {
+		source = p
+	}
+	entropy, signs, err := source.Entropy(ctx, pulseNumber)
 	if err != nil {
 		logger.Error(err)
 		return err
@@ -92,29 +106,7 @@ func (p *Pulsar) Send(ctx context.Context, pulseNumber insolar.PulseNumber) erro
 		EpochPulseNumber: pulseNumber.AsEpoch(),
 		OriginID:         [16]byte{206, 41, 229, 190, 7, 240, 162, 155, 121, 245, 207, 56, 161, 67, 189, 0},
 		PulseTimestamp:   time.Now().UnixNano(),
-		Signs:            map[string]insolar.PulseSenderConfirmation{},
-	}
-
-	payload := PulseSenderConfirmationPayload{PulseSenderConfirmation: insolar.PulseSenderConfirmation{
-		ChosenPublicKey: p.PublicKeyRaw,
-		Entropy:         entropy,
-		PulseNumber:     pulseNumber,
-	}}
-	hasher := platformpolicy.NewPlatformCryptographyScheme().IntegrityHasher()
-	hash, err := payload.Hash(hasher)
-	if err != nil {
-		return err
-	}
-	signature, err := p.CryptographyService.Sign(hash)
-	if err != nil {
-		return err
-	}
-
-	pulseForSending.Signs[p.PublicKeyRaw] = insolar.PulseSenderConfirmation{
-		ChosenPublicKey: p.PublicKeyRaw,
-		Signature:       signature.Bytes(),
-		Entropy:         entropy,
-		PulseNumber:     pulseNumber,
+		Signs:            signs,
 	}
 
 	logger.Debug("Start a process of sending pulse")
@@ -139,15 +131,58 @@ func (p *Pulsar) LastPN() insolar.PulseNumber {
 	return p.lastPN
 }
 
-func (p *Pulsar) generateNewEntropyAndSign() (insolar.Entropy, []byte, error) {
-	e := p.EntropyGenerator.GenerateEntropy()
+// Entropy generates entropy with EntropyGenerator of the pulsar and confirms it with pulsar's signature.
+func (p *Pulsar) Entropy(_ context.Context, pulseNumber insolar.PulseNumber) (insolar.Entropy, map[string]insolar.PulseSenderConfirmation, error) {
+	entropy := p.EntropyGenerator.GenerateEntropy()
 
-	sign, err := p.CryptographyService.Sign(e[:])
+	confirmation, err := SignConfirmation(p.CryptographyService, p.PlatformCryptographyScheme, insolar.PulseSenderConfirmation{
+		ChosenPublicKey: p.PublicKeyRaw,
+		Entropy:         entropy,
+		PulseNumber:     pulseNumber,
+	})
 	if err != nil {
 		return insolar.Entropy{}, nil, err
 	}
 
-	return e, sign.Bytes(), nil
+	return entropy, map[string]insolar.PulseSenderConfirmation{p.PublicKeyRaw: confirmation}, nil
+}
+
+// SignConfirmation signs hash of the confirmation payload and returns the signed confirmation.
+func SignConfirmation(
+	cryptographyService insolar.CryptographyService,
+	scheme insolar.PlatformCryptographyScheme,
+	confirmation insolar.PulseSenderConfirmation,
+) (insolar.PulseSenderConfirmation, error) {
+	payload := PulseSenderConfirmationPayload{PulseSenderConfirmation: confirmation}
+	hash, err := payload.Hash(scheme.IntegrityHasher())
+	if err != nil {
+		return insolar.PulseSenderConfirmation{}, err
+	}
+	signature, err := cryptographyService.Sign(hash)
+	if err != nil {
+		return insolar.PulseSenderConfirmation{}, err
+	}
+
+	confirmation.Signature = signature.Bytes()
+	return confirmation, nil
+}
+
+// VerifyConfirmation checks signature of the confirmation with the public key.
+func VerifyConfirmation(
+	cryptographyService insolar.CryptographyService,
+	scheme insolar.PlatformCryptographyScheme,
+	publicKey crypto.PublicKey,
+	confirmation insolar.PulseSenderConfirmation,
+) error {
+	payload := PulseSenderConfirmationPayload{PulseSenderConfirmation: confirmation}
+	hash, err := payload.Hash(scheme.IntegrityHasher())
+	if err != nil {
+		return errors.Wrap(err, "failed to get hash from pulse payload")
+	}
+	if !cryptographyService.Verify(publicKey, insolar.SignatureFromBytes(confirmation.Signature), hash) {
+		return errors.New("cryptographic signature verification failed")
+	}
+	return nil
 }
 
 // PulseSenderConfirmationPayload is a struct with info about pulse's confirmations
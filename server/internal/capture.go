@@ -0,0 +1,30 @@
+// Copyright 2020 Insolar Network Ltd.
+// All rights reserved.
+// This material is licensed under the Insolar License version 1.0,
+// available at https://github.com/insolar/insolar/blob/master/LICENSE.md.
+
+package internal
+
+import (
+	"context"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar/bus/capture"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+)
+
+// CapturePublisher wraps message.Publisher for capture of bus messages to file, if capture is enabled in config.
+func CapturePublisher(ctx context.Context, cfg configuration.Bus, pb message.Publisher) (message.Publisher, error) {
+	if cfg.CaptureFile == "" {
+		return pb, nil
+	}
+	pub, err := capture.NewPublisher(cfg.CaptureFile, pb)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to start bus capture")
+	}
+	inslogger.FromContext(ctx).Infof("Bus messages are captured to %s", cfg.CaptureFile)
+	return pub, nil
+}
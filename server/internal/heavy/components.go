@@ -83,6 +83,7 @@ type components struct {
 	outRouter   *watermillMsg.Router
 
 	replicator executor.HeavyReplicator
+	network    *servicenetwork.ServiceNetwork
 }
 
 func initTemporaryCertificateManager(ctx context.Context, cfg *configuration.GenericConfiguration) (*certificate.CertificateManager, error) {
@@ -93,7 +94,10 @@ func initTemporaryCertificateManager(ctx context.Context, cfg *configuration.Gen
 		return nil, errors.Wrap(err, "failed to load KeyStore")
 	}
 
-	platformCryptographyScheme := platformpolicy.NewPlatformCryptographyScheme()
+	platformCryptographyScheme, err := platformpolicy.NewPlatformCryptographySchemeWithAlgorithm(cfg.SignatureAlgorithm)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create platform cryptography scheme")
+	}
 	keyProcessor := platformpolicy.NewKeyProcessor()
 
 	cryptographyService := cryptography.NewCryptographyService()
@@ -138,7 +142,10 @@ func initWithPostgres(
 		// Public key manipulations.
 		KeyProcessor = platformpolicy.NewKeyProcessor()
 		// Platform cryptography.
-		CryptoScheme = platformpolicy.NewPlatformCryptographyScheme()
+		CryptoScheme, err = platformpolicy.NewPlatformCryptographySchemeWithAlgorithm(cfg.SignatureAlgorithm)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create platform cryptography scheme")
+		}
 		// Sign, verify, etc.
 		CryptoService = cryptography.NewCryptographyService()
 
@@ -175,8 +182,13 @@ func initWithPostgres(
 		pubsub := gochannel.NewGoChannel(gochannel.Config{}, wmLogger)
 		subscriber = pubsub
 		publisher = pubsub
+		var err error
+		publisher, err = internal.CapturePublisher(ctx, cfg.Bus, publisher)
+		if err != nil {
+			return nil, err
+		}
 		// Wrapped watermill publisher for introspection.
-		publisher = internal.PublisherWrapper(ctx, c.cmp, cfg.Introspection, publisher)
+		publisher = internal.PublisherWrapper(ctx, c.cmp, cfg.Introspection, insolar.StaticRoleHeavyMaterial, publisher)
 	}
 
 	// Network.
@@ -190,6 +202,7 @@ func initWithPostgres(
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to start Network")
 		}
+		c.network = NetworkService
 	}
 
 	// Storage.
@@ -276,6 +289,8 @@ func initWithPostgres(
 			Coordinator,
 			NetworkService,
 			AvailabilityChecker,
+			CryptoService,
+			NetworkService,
 			apiOptions,
 		)
 		if err != nil {
@@ -293,6 +308,8 @@ func initWithPostgres(
 			Coordinator,
 			NetworkService,
 			AvailabilityChecker,
+			CryptoService,
+			NetworkService,
 			apiOptions,
 		)
 		if err != nil {
@@ -417,12 +434,18 @@ func initWithPostgres(
 
 	// Exporter
 	var (
-		recordExporter *exporter.RecordServer
-		pulseExporter  *exporter.PulseServer
+		recordExporter      *exporter.RecordServer
+		pulseExporter       *exporter.PulseServer
+		historyExporter     *exporter.ObjectHistoryServer
+		replicationExporter *exporter.ReplicationServer
+		eventExporter       *exporter.EventServer
 	)
 	{
 		recordExporter = exporter.NewRecordServer(PulsesPostgres, RecordsPostgres, RecordsPostgres, PostgresJetKeeper, cfg.Exporter.Auth)
 		pulseExporter = exporter.NewPulseServer(PulsesPostgres, PostgresJetKeeper, NodesPostgres, cfg.Exporter.Auth)
+		historyExporter = exporter.NewObjectHistoryServer(IndexesPostgres, RecordsPostgres, PostgresJetKeeper, cfg.Exporter.Auth)
+		replicationExporter = exporter.NewReplicationServer(PulsesPostgres, PostgresJetKeeper, NodesPostgres, DropPostgres, RecordsPostgres, RecordsPostgres, IndexesPostgres, cfg.Exporter.ReplicationChunkSize, cfg.Exporter.Auth)
+		eventExporter = exporter.NewEventServer(PulsesPostgres, RecordsPostgres, RecordsPostgres, PostgresJetKeeper, cfg.Exporter.Auth)
 
 		grpcMetrics := grpc_prometheus.NewServerMetrics()
 		grpcMetrics.EnableHandlingTimeHistogram()
@@ -434,6 +457,9 @@ func initWithPostgres(
 		}
 		exporter.RegisterRecordExporterServer(grpcServer, recordExporter)
 		exporter.RegisterPulseExporterServer(grpcServer, pulseExporter)
+		exporter.RegisterObjectHistoryExporterServer(grpcServer, historyExporter)
+		exporter.RegisterReplicationExporterServer(grpcServer, replicationExporter)
+		exporter.RegisterEventExporterServer(grpcServer, eventExporter)
 
 		grpcMetrics.InitializeMetrics(grpcServer)
 		lis, err := net.Listen("tcp", cfg.Exporter.Addr)
@@ -513,7 +539,10 @@ func initWithBadger(
 		// Public key manipulations.
 		KeyProcessor = platformpolicy.NewKeyProcessor()
 		// Platform cryptography.
-		CryptoScheme = platformpolicy.NewPlatformCryptographyScheme()
+		CryptoScheme, err = platformpolicy.NewPlatformCryptographySchemeWithAlgorithm(cfg.SignatureAlgorithm)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create platform cryptography scheme")
+		}
 		// Sign, verify, etc.
 		CryptoService = cryptography.NewCryptographyService()
 
@@ -550,8 +579,13 @@ func initWithBadger(
 		pubsub := gochannel.NewGoChannel(gochannel.Config{}, wmLogger)
 		subscriber = pubsub
 		publisher = pubsub
+		var err error
+		publisher, err = internal.CapturePublisher(ctx, cfg.Bus, publisher)
+		if err != nil {
+			return nil, err
+		}
 		// Wrapped watermill publisher for introspection.
-		publisher = internal.PublisherWrapper(ctx, c.cmp, cfg.Introspection, publisher)
+		publisher = internal.PublisherWrapper(ctx, c.cmp, cfg.Introspection, insolar.StaticRoleHeavyMaterial, publisher)
 	}
 
 	// Network.
@@ -565,6 +599,7 @@ func initWithBadger(
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to start Network")
 		}
+		c.network = NetworkService
 	}
 
 	// Storage.
@@ -648,6 +683,8 @@ func initWithBadger(
 			Coordinator,
 			NetworkService,
 			AvailabilityChecker,
+			CryptoService,
+			NetworkService,
 			apiOptions,
 		)
 		if err != nil {
@@ -665,6 +702,8 @@ func initWithBadger(
 			Coordinator,
 			NetworkService,
 			AvailabilityChecker,
+			CryptoService,
+			NetworkService,
 			apiOptions,
 		)
 		if err != nil {
@@ -686,12 +725,14 @@ func initWithBadger(
 		Handler      *handler.Handler
 		Genesis      *genesis.Genesis
 		Records      *object.BadgerRecordDB
+		Indexes      *object.BadgerIndexDB
 		JetKeeper    *executor.BadgerDBJetKeeper
+		Drops        *drop.BadgerDB
 	)
 	{
 		Records = object.NewBadgerRecordDB(DB)
-		indexes := object.NewBadgerIndexDB(DB, Records)
-		drops := drop.NewBadgerDB(DB)
+		Indexes = object.NewBadgerIndexDB(DB, Records)
+		Drops = drop.NewBadgerDB(DB)
 		JetKeeper = executor.NewBadgerJetKeeper(Jets, DB, Pulses)
 
 		backupMaker, err := executor.NewBackupMaker(ctx, DB, cfg.Ledger, JetKeeper.TopSyncPulse(), DB)
@@ -699,8 +740,8 @@ func initWithBadger(
 			return nil, errors.Wrap(err, "failed create backuper")
 		}
 
-		c.rollback = executor.NewDBRollback(JetKeeper, drops, Records, indexes, Jets, Pulses, JetKeeper, Nodes, backupMaker)
-		c.stateKeeper = executor.NewInitialStateKeeper(JetKeeper, Jets, Coordinator, indexes, drops)
+		c.rollback = executor.NewDBRollback(JetKeeper, Drops, Records, Indexes, Jets, Pulses, JetKeeper, Nodes, backupMaker)
+		c.stateKeeper = executor.NewInitialStateKeeper(JetKeeper, Jets, Coordinator, Indexes, Drops)
 
 		sp := insolarPulse.NewStartPulse()
 
@@ -715,16 +756,16 @@ func initWithBadger(
 		PulseManager.FinalizationKeeper = executor.NewFinalizationKeeperDefault(JetKeeper, Pulses, cfg.LightChainLimit)
 
 		gcRunInfo := executor.NewBadgerGCRunInfo(DB, cfg.Ledger.Storage.GCRunFrequency)
-		replicator := executor.NewHeavyReplicatorDefault(Records, indexes, CryptoScheme, Pulses, drops, JetKeeper, backupMaker, Jets, gcRunInfo)
+		replicator := executor.NewHeavyReplicatorDefault(Records, Indexes, CryptoScheme, Pulses, Drops, JetKeeper, backupMaker, Jets, gcRunInfo)
 		c.replicator = replicator
 
 		h := handler.New(cfg.LightChainLimit, gcRunInfo)
 		h.RecordAccessor = Records
 		h.RecordModifier = Records
 		h.JetCoordinator = Coordinator
-		h.IndexAccessor = indexes
-		h.IndexModifier = indexes
-		h.DropModifier = drops
+		h.IndexAccessor = Indexes
+		h.IndexModifier = Indexes
+		h.DropModifier = Drops
 		h.PCS = CryptoScheme
 		h.PulseAccessor = Pulses
 		h.PulseCalculator = Pulses
@@ -745,19 +786,19 @@ func initWithBadger(
 			PCS:            CryptoScheme,
 			RecordAccessor: Records,
 			RecordModifier: Records,
-			IndexModifier:  indexes,
-			IndexAccessor:  indexes,
+			IndexModifier:  Indexes,
+			IndexAccessor:  Indexes,
 		}
 		Genesis = &genesis.Genesis{
 			ArtifactManager: artifactManager,
-			IndexModifier:   indexes,
+			IndexModifier:   Indexes,
 			BaseRecord: &genesis.BadgerBaseRecord{
 				DB:             DB,
-				DropModifier:   drops,
+				DropModifier:   Drops,
 				PulseAppender:  Pulses,
 				PulseAccessor:  Pulses,
 				RecordModifier: Records,
-				IndexModifier:  indexes,
+				IndexModifier:  Indexes,
 			},
 
 			DiscoveryNodes: genesisCfg.DiscoveryNodes,
@@ -767,12 +808,18 @@ func initWithBadger(
 
 	// Exporter
 	var (
-		recordExporter *exporter.RecordServer
-		pulseExporter  *exporter.PulseServer
+		recordExporter      *exporter.RecordServer
+		pulseExporter       *exporter.PulseServer
+		historyExporter     *exporter.ObjectHistoryServer
+		replicationExporter *exporter.ReplicationServer
+		eventExporter       *exporter.EventServer
 	)
 	{
 		recordExporter = exporter.NewRecordServer(Pulses, Records, Records, JetKeeper, cfg.Exporter.Auth)
 		pulseExporter = exporter.NewPulseServer(Pulses, JetKeeper, Nodes, cfg.Exporter.Auth)
+		historyExporter = exporter.NewObjectHistoryServer(Indexes, Records, JetKeeper, cfg.Exporter.Auth)
+		replicationExporter = exporter.NewReplicationServer(Pulses, JetKeeper, Nodes, Drops, Records, Records, Indexes, cfg.Exporter.ReplicationChunkSize, cfg.Exporter.Auth)
+		eventExporter = exporter.NewEventServer(Pulses, Records, Records, JetKeeper, cfg.Exporter.Auth)
 
 		grpcMetrics := grpc_prometheus.NewServerMetrics()
 		grpcMetrics.EnableHandlingTimeHistogram()
@@ -784,6 +831,9 @@ func initWithBadger(
 		}
 		exporter.RegisterRecordExporterServer(grpcServer, recordExporter)
 		exporter.RegisterPulseExporterServer(grpcServer, pulseExporter)
+		exporter.RegisterObjectHistoryExporterServer(grpcServer, historyExporter)
+		exporter.RegisterReplicationExporterServer(grpcServer, replicationExporter)
+		exporter.RegisterEventExporterServer(grpcServer, eventExporter)
 
 		grpcMetrics.InitializeMetrics(grpcServer)
 
@@ -897,8 +947,14 @@ func newComponents(
 	heavyCfg := cfg.GetNodeConfig()
 	switch realCfg := heavyCfg.(type) {
 	case *configuration.ConfigHeavyPg:
+		if realCfg.Replica.Enabled {
+			return initReplicaWithPostgres(ctx, *realCfg)
+		}
 		return initWithPostgres(ctx, *realCfg, genesisCfg, genesisOptions, genesisOnly, apiOptions)
 	case *configuration.HeavyBadgerConfig:
+		if realCfg.Replica.Enabled {
+			return initReplicaWithBadger(ctx, *realCfg)
+		}
 		return initWithBadger(ctx, *realCfg, genesisCfg, genesisOptions, genesisOnly, apiOptions)
 	}
 	return nil, errors.New("can't start heavy, db configuration error")
@@ -910,14 +966,31 @@ func (c *components) Start(ctx context.Context) error {
 		return errors.Wrapf(err, "rollback.Start return error: %s", err.Error())
 	}
 
-	err = c.stateKeeper.Start(ctx)
-	if err != nil {
-		return errors.Wrapf(err, "stateKeeper.Start return error: %s", err.Error())
+	// Replica has no initial state for light nodes.
+	if c.stateKeeper != nil {
+		err = c.stateKeeper.Start(ctx)
+		if err != nil {
+			return errors.Wrapf(err, "stateKeeper.Start return error: %s", err.Error())
+		}
 	}
 	return c.cmp.Start(ctx)
 }
 
+// Left returns channel, that is closed when node has left the network.
+func (c *components) Left() <-chan struct{} {
+	// Replica has no network part.
+	if c.network == nil {
+		return nil
+	}
+	return c.network.Left()
+}
+
 func (c *components) Stop(ctx context.Context) error {
+	// Replica has no network part.
+	if c.replicator == nil {
+		return c.cmp.Stop(ctx)
+	}
+
 	err := c.inRouter.Close()
 	if err != nil {
 		inslogger.FromContext(ctx).Error("Error while closing router", err)
@@ -54,9 +54,13 @@ func TestLimiters_isClientLimitExceeded(t *testing.T) {
 		method string
 	}{
 		{name: "record-export", method: "/exporter.RecordExporter/Export"},
+		{name: "record-export-filtered", method: "/exporter.RecordExporter/ExportFiltered"},
 		{name: "pulse-export", method: "/exporter.PulseExporter/Export"},
 		{name: "top-sync-pulse", method: "/exporter.PulseExporter/TopSyncPulse"},
 		{name: "next-pulse", method: "/exporter.PulseExporter/NextFinalizedPulse"},
+		{name: "object-history", method: "/exporter.ObjectHistoryExporter/Export"},
+		{name: "replication", method: "/exporter.ReplicationExporter/Export"},
+		{name: "event-export", method: "/exporter.EventExporter/Export"},
 	}
 
 	t.Run("0 rps", func(t *testing.T) {
@@ -73,9 +77,13 @@ func TestLimiters_isClientLimitExceeded(t *testing.T) {
 			t.Run(tc.name, func(t *testing.T) {
 				lim := newLimiters(configuration.Limits{PerClient: configuration.Handlers{
 					RecordExport:            1,
+					RecordExportFiltered:    1,
 					PulseExport:             1,
 					PulseTopSyncPulse:       1,
 					PulseNextFinalizedPulse: 1,
+					ObjectHistoryExport:     1,
+					ReplicationExport:       1,
+					EventExport:             1,
 				}})
 				require.False(t, lim.isClientLimitExceeded(context.Background(), tc.method))
 				require.True(t, lim.isClientLimitExceeded(context.Background(), tc.method))
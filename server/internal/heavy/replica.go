@@ -0,0 +1,271 @@
+// Copyright 2020 Insolar Network Ltd.
+// All rights reserved.
+// This material is licensed under the Insolar License version 1.0,
+// available at https://github.com/insolar/insolar/blob/master/LICENSE.md.
+
+package heavy
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+
+	"github.com/dgraph-io/badger"
+	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
+	component "github.com/insolar/component-manager"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar/jet"
+	"github.com/insolar/insolar/insolar/node"
+	insolarPulse "github.com/insolar/insolar/insolar/pulse"
+	"github.com/insolar/insolar/insolar/store"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/ledger/drop"
+	"github.com/insolar/insolar/ledger/heavy/executor"
+	"github.com/insolar/insolar/ledger/heavy/exporter"
+	"github.com/insolar/insolar/ledger/heavy/migration"
+	"github.com/insolar/insolar/ledger/heavy/replica"
+	"github.com/insolar/insolar/ledger/object"
+	"github.com/insolar/insolar/metrics"
+	"github.com/insolar/insolar/platformpolicy"
+)
+
+// replicaRole is used instead of node role, replica isn't a network node.
+const replicaRole = "heavy_replica"
+
+// initReplicaWithBadger creates components of read-only heavy replica with badger storage.
+// Replica has no network part, it only follows the primary and serves exporter API.
+func initReplicaWithBadger(ctx context.Context, cfg configuration.HeavyBadgerConfig) (*components, error) {
+	c := &components{}
+	c.cmp = component.NewManager(nil)
+	c.NodeRole = replicaRole
+
+	logger := inslogger.FromContext(ctx)
+
+	var (
+		DB       *store.BadgerDB
+		Pulses   *insolarPulse.BadgerDB
+		Nodes    *node.BadgerStorageDB
+		Jets     *jet.BadgerDBStore
+		Records  *object.BadgerRecordDB
+		Indexes  *object.BadgerIndexDB
+		Drops    *drop.BadgerDB
+		Keeper   *executor.BadgerDBJetKeeper
+		Follower *replica.Follower
+	)
+	{
+		fullDataDirectoryPath, err := filepath.Abs(cfg.Ledger.Storage.DataDirectory)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get absolute path for DataDirectory")
+		}
+		options := badger.DefaultOptions(fullDataDirectoryPath)
+		options.Logger = badgerLogger{Logger: logger.WithField("component", "badger")}
+		DB, err = store.NewBadgerDB(
+			options,
+			store.ValueLogDiscardRatio(cfg.Ledger.Storage.BadgerValueLogGCDiscardRatio),
+			store.OpenAndCloseBadgerOnStart(true),
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to initialize DB")
+		}
+		Pulses = insolarPulse.NewBadgerDB(DB)
+		Nodes = node.NewBadgerStorageDB(DB)
+		Jets = jet.NewBadgerDBStore(DB)
+		Records = object.NewBadgerRecordDB(DB)
+		Indexes = object.NewBadgerIndexDB(DB, Records)
+		Drops = drop.NewBadgerDB(DB)
+		Keeper = executor.NewBadgerJetKeeper(Jets, DB, Pulses)
+
+		c.rollback = executor.NewDBRollback(Keeper, Drops, Records, Indexes, Jets, Pulses, Keeper, Nodes)
+
+		scheme, err := platformpolicy.NewPlatformCryptographySchemeWithAlgorithm(cfg.SignatureAlgorithm)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create platform cryptography scheme")
+		}
+		conn, err := replica.Dial(cfg.Replica)
+		if err != nil {
+			return nil, err
+		}
+		Follower = replica.NewFollower(
+			cfg.Replica,
+			exporter.NewReplicationExporterClient(conn),
+			scheme,
+			Pulses,
+			Nodes,
+			Drops,
+			Records,
+			Indexes,
+			Jets,
+			Keeper,
+			executor.NewBadgerGCRunInfo(DB, cfg.Ledger.Storage.GCRunFrequency),
+			c.rollback,
+		)
+	}
+
+	metricsRegistry := metrics.GetInsolarRegistry(c.NodeRole)
+	err := serveReplicaExporter(
+		cfg.Exporter,
+		metricsRegistry,
+		exporter.NewRecordServer(Pulses, Records, Records, Keeper, cfg.Exporter.Auth),
+		exporter.NewPulseServer(Pulses, Keeper, Nodes, cfg.Exporter.Auth),
+		exporter.NewObjectHistoryServer(Indexes, Records, Keeper, cfg.Exporter.Auth),
+		exporter.NewReplicationServer(Pulses, Keeper, Nodes, Drops, Records, Records, Indexes, cfg.Exporter.ReplicationChunkSize, cfg.Exporter.Auth),
+		exporter.NewEventServer(Pulses, Records, Records, Keeper, cfg.Exporter.Auth),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	c.cmp.Inject(
+		metrics.NewMetrics(cfg.Metrics, metricsRegistry, c.NodeRole),
+		Follower,
+	)
+	err = c.cmp.Init(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to init components")
+	}
+	return c, nil
+}
+
+// initReplicaWithPostgres creates components of read-only heavy replica with postgres storage.
+// Replica has no network part, it only follows the primary and serves exporter API.
+func initReplicaWithPostgres(ctx context.Context, cfg configuration.ConfigHeavyPg) (*components, error) {
+	c := &components{}
+	c.cmp = component.NewManager(nil)
+	c.NodeRole = replicaRole
+
+	logger := inslogger.FromContext(ctx)
+
+	var (
+		Pool     *pgxpool.Pool
+		Pulses   *insolarPulse.PostgresDB
+		Nodes    *node.PostgresStorageDB
+		Jets     *jet.PostgresDBStore
+		Records  *object.PostgresRecordDB
+		Indexes  *object.PostgresIndexDB
+		Drops    *drop.PostgresDB
+		Keeper   *executor.PostgresDBJetKeeper
+		Follower *replica.Follower
+	)
+	{
+		var err error
+		Pool, err = pgxpool.Connect(context.Background(), cfg.Ledger.PostgreSQL.URL)
+		if err != nil {
+			return nil, errors.Wrap(err, "Unable to connect to PostgreSQL")
+		}
+
+		cwd, err := os.Getwd()
+		if err != nil {
+			return nil, errors.Wrap(err, "os.Getwd failed")
+		}
+		path := cfg.Ledger.PostgreSQL.MigrationPath
+		logger.Infof("About to run PostgreSQL migration, cwd = %s, migration path = %s", cwd, path)
+		ver, err := migration.MigrateDatabase(ctx, Pool, path)
+		if err != nil {
+			return nil, errors.Wrap(err, "Unable to migrate database")
+		}
+		logger.Infof("PostgreSQL database migration done, current schema version: %d", ver)
+
+		Pulses = insolarPulse.NewPostgresDB(Pool)
+		Nodes = node.NewPostgresStorageDB(Pool)
+		Jets = jet.NewPostgresDBStore(Pool)
+		Records = object.NewPostgresRecordDB(Pool)
+		Indexes = object.NewPostgresIndexDB(Pool, Records)
+		Drops = drop.NewPostgresDB(Pool)
+		Keeper = executor.NewPostgresJetKeeper(Jets, Pool, Pulses)
+
+		c.rollback = executor.NewDBRollback(Keeper, Drops, Records, Indexes, Jets, Pulses, Keeper, Nodes)
+
+		scheme, err := platformpolicy.NewPlatformCryptographySchemeWithAlgorithm(cfg.SignatureAlgorithm)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create platform cryptography scheme")
+		}
+		conn, err := replica.Dial(cfg.Replica)
+		if err != nil {
+			return nil, err
+		}
+		Follower = replica.NewFollower(
+			cfg.Replica,
+			exporter.NewReplicationExporterClient(conn),
+			scheme,
+			Pulses,
+			Nodes,
+			Drops,
+			Records,
+			Indexes,
+			Jets,
+			Keeper,
+			&executor.PostgresGCRunInfo{},
+			c.rollback,
+		)
+	}
+
+	metricsRegistry := metrics.GetInsolarRegistry(c.NodeRole)
+	err := serveReplicaExporter(
+		cfg.Exporter,
+		metricsRegistry,
+		exporter.NewRecordServer(Pulses, Records, Records, Keeper, cfg.Exporter.Auth),
+		exporter.NewPulseServer(Pulses, Keeper, Nodes, cfg.Exporter.Auth),
+		exporter.NewObjectHistoryServer(Indexes, Records, Keeper, cfg.Exporter.Auth),
+		exporter.NewReplicationServer(Pulses, Keeper, Nodes, Drops, Records, Records, Indexes, cfg.Exporter.ReplicationChunkSize, cfg.Exporter.Auth),
+		exporter.NewEventServer(Pulses, Records, Records, Keeper, cfg.Exporter.Auth),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	c.cmp.Inject(
+		metrics.NewMetrics(cfg.Metrics, metricsRegistry, c.NodeRole),
+		Follower,
+	)
+	err = c.cmp.Init(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to init components")
+	}
+	return c, nil
+}
+
+// serveReplicaExporter starts exporter API of the replica. Replica serves replication too,
+// so other replicas can follow it instead of the primary.
+func serveReplicaExporter(
+	cfg configuration.Exporter,
+	metricsRegistry *prometheus.Registry,
+	recordExporter *exporter.RecordServer,
+	pulseExporter *exporter.PulseServer,
+	historyExporter *exporter.ObjectHistoryServer,
+	replicationExporter *exporter.ReplicationServer,
+	eventExporter *exporter.EventServer,
+) error {
+	grpcMetrics := grpc_prometheus.NewServerMetrics()
+	grpcMetrics.EnableHandlingTimeHistogram()
+	metricsRegistry.MustRegister(grpcMetrics, statContractVersionClient, statHeavyVersionClient)
+
+	grpcServer, err := newGRPCServer(cfg, grpcMetrics)
+	if err != nil {
+		return errors.Wrap(err, "failed to initiate a GRPC server")
+	}
+	exporter.RegisterRecordExporterServer(grpcServer, recordExporter)
+	exporter.RegisterPulseExporterServer(grpcServer, pulseExporter)
+	exporter.RegisterObjectHistoryExporterServer(grpcServer, historyExporter)
+	exporter.RegisterReplicationExporterServer(grpcServer, replicationExporter)
+	exporter.RegisterEventExporterServer(grpcServer, eventExporter)
+
+	grpcMetrics.InitializeMetrics(grpcServer)
+
+	lis, err := net.Listen("tcp", cfg.Addr)
+	if err != nil {
+		return errors.Wrap(err, "failed to open port for Exporter")
+	}
+	setPlatformVersionMetrics(allowedVersionContract)
+	go func() {
+		if err := grpcServer.Serve(lis); err != nil {
+			panic(fmt.Errorf("exporter failed to serve: %s", err))
+		}
+	}()
+	return nil
+}
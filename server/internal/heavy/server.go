@@ -105,8 +105,12 @@ func (s *Server) Serve() {
 	var waitChannel = make(chan bool)
 
 	go func() {
-		sig := <-gracefulStop
-		logger.Debug("caught sig: ", sig)
+		select {
+		case sig := <-gracefulStop:
+			logger.Debug("caught sig: ", sig)
+		case <-cmp.Left():
+			logger.Info("node has left the network")
+		}
 
 		logger.Warn("GRACEFUL STOP APP")
 		err = cmp.Stop(ctx)
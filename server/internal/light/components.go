@@ -34,6 +34,7 @@ import (
 	"github.com/insolar/insolar/ledger/light/executor"
 	"github.com/insolar/insolar/ledger/light/handle"
 	"github.com/insolar/insolar/ledger/light/proc"
+	"github.com/insolar/insolar/ledger/light/wal"
 	"github.com/insolar/insolar/ledger/object"
 	"github.com/insolar/insolar/log/logwatermill"
 	"github.com/insolar/insolar/logicrunner/artifacts"
@@ -48,6 +49,8 @@ type components struct {
 	NodeRef, NodeRole string
 	replicator        executor.LightReplicator
 	cleaner           executor.Cleaner
+	wal               *wal.Log
+	network           *servicenetwork.ServiceNetwork
 }
 
 func initTemporaryCertificateManager(ctx context.Context, cfg *configuration.LightConfig) (*certificate.CertificateManager, error) {
@@ -58,7 +61,10 @@ func initTemporaryCertificateManager(ctx context.Context, cfg *configuration.Lig
 		return nil, errors.Wrap(err, "failed to load KeyStore")
 	}
 
-	platformCryptographyScheme := platformpolicy.NewPlatformCryptographyScheme()
+	platformCryptographyScheme, err := platformpolicy.NewPlatformCryptographySchemeWithAlgorithm(cfg.SignatureAlgorithm)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create platform cryptography scheme")
+	}
 	keyProcessor := platformpolicy.NewKeyProcessor()
 
 	cryptographyService := cryptography.NewCryptographyService()
@@ -96,7 +102,10 @@ func newComponents(ctx context.Context, cfg configuration.LightConfig, apiOption
 		// Public key manipulations.
 		KeyProcessor = platformpolicy.NewKeyProcessor()
 		// Platform cryptography.
-		CryptoScheme = platformpolicy.NewPlatformCryptographyScheme()
+		CryptoScheme, err = platformpolicy.NewPlatformCryptographySchemeWithAlgorithm(cfg.SignatureAlgorithm)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create platform cryptography scheme")
+		}
 		// Sign, verify, etc.
 		CryptoService = cryptography.NewCryptographyService()
 
@@ -131,8 +140,13 @@ func newComponents(ctx context.Context, cfg configuration.LightConfig, apiOption
 		pubsub := gochannel.NewGoChannel(gochannel.Config{}, wmLogger)
 		subscriber = pubsub
 		publisher = pubsub
+		var err error
+		publisher, err = internal.CapturePublisher(ctx, cfg.Bus, publisher)
+		if err != nil {
+			return nil, err
+		}
 		// Wrapped watermill publisher for introspection.
-		publisher = internal.PublisherWrapper(ctx, comps.cmp, cfg.Introspection, publisher)
+		publisher = internal.PublisherWrapper(ctx, comps.cmp, cfg.Introspection, insolar.StaticRoleLightMaterial, publisher)
 	}
 
 	// Network.
@@ -146,6 +160,7 @@ func newComponents(ctx context.Context, cfg configuration.LightConfig, apiOption
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to start Network")
 		}
+		comps.network = NetworkService
 	}
 
 	// Role calculations.
@@ -208,6 +223,8 @@ func newComponents(ctx context.Context, cfg configuration.LightConfig, apiOption
 			Coordinator,
 			NetworkService,
 			AvailabilityChecker,
+			CryptoService,
+			NetworkService,
 			apiOptions,
 		)
 		if err != nil {
@@ -225,6 +242,8 @@ func newComponents(ctx context.Context, cfg configuration.LightConfig, apiOption
 			Coordinator,
 			NetworkService,
 			AvailabilityChecker,
+			CryptoService,
+			NetworkService,
 			apiOptions,
 		)
 		if err != nil {
@@ -250,9 +269,23 @@ func newComponents(ctx context.Context, cfg configuration.LightConfig, apiOption
 	{
 		conf := cfg.Ledger
 		idLocker := object.NewIndexLocker()
-		drops := drop.NewStorageMemory()
-		records := object.NewRecordMemory()
-		indexes := object.NewIndexStorageMemory()
+		writeAheadLog, err := wal.NewLog(
+			conf.WAL,
+			drop.NewStorageMemory(),
+			object.NewRecordMemory(),
+			object.NewIndexStorageMemory(),
+			Pulses,
+			Nodes,
+			Jets,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to open WAL")
+		}
+		comps.wal = writeAheadLog
+		drops := writeAheadLog.Drops()
+		records := writeAheadLog.Records()
+		indexes := writeAheadLog.Indexes()
+		pulseAppender := writeAheadLog.Pulses()
 		writeController := executor.NewWriteController()
 		hotWaitReleaser := executor.NewChannelWaiter()
 
@@ -303,8 +336,10 @@ func newComponents(ctx context.Context, cfg configuration.LightConfig, apiOption
 			records,
 			indexes,
 			Jets,
+			writeAheadLog,
 		)
 		comps.replicator = lthSyncer
+		NetworkService.AddDrainer(lthSyncer)
 
 		jetSplitter := executor.NewJetSplitter(
 			conf.JetSplit, jetCalculator, Jets, Jets, drops, drops, Pulses, records,
@@ -320,7 +355,8 @@ func newComponents(ctx context.Context, cfg configuration.LightConfig, apiOption
 		)
 
 		stateIniter := executor.NewStateIniter(
-			cfg.LightChainLimit, Jets, hotWaitReleaser, drops, Nodes, Sender, Pulses, Pulses, jetCalculator, indexes,
+			cfg.LightChainLimit, Jets, hotWaitReleaser, drops, Nodes, Sender, pulseAppender, Pulses, jetCalculator, indexes,
+			writeAheadLog, lthSyncer,
 		)
 
 		dep := proc.NewDependencies(
@@ -330,6 +366,7 @@ func newComponents(ctx context.Context, cfg configuration.LightConfig, apiOption
 			Pulses,
 			Sender,
 			drops,
+			drops,
 			idLocker,
 			records,
 			indexes,
@@ -364,7 +401,7 @@ func newComponents(ctx context.Context, cfg configuration.LightConfig, apiOption
 			[]dispatcher.Dispatcher{FlowDispatcher, Requester.FlowDispatcher},
 			Nodes,
 			Pulses,
-			Pulses,
+			pulseAppender,
 			hotWaitReleaser,
 			jetSplitter,
 			lthSyncer,
@@ -409,10 +446,19 @@ func (c *components) Start(ctx context.Context) error {
 	return c.cmp.Start(ctx)
 }
 
+// Left returns channel, that is closed when node has left the network.
+func (c *components) Left() <-chan struct{} {
+	return c.network.Left()
+}
+
 func (c *components) Stop(ctx context.Context) error {
 	c.replicator.Stop()
 	c.cleaner.Stop()
-	return c.cmp.Stop(ctx)
+	err := c.cmp.Stop(ctx)
+	if err != nil {
+		return err
+	}
+	return c.wal.Close()
 }
 
 func (c *components) startWatermill(
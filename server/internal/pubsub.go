@@ -11,12 +11,13 @@ import (
 	"github.com/ThreeDotsLabs/watermill/message"
 	component "github.com/insolar/component-manager"
 	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar"
 	"golang.org/x/net/context"
 )
 
 // PublisherWrapper stub for message.Publisher introspection wrapper for binaries without introspection API.
 func PublisherWrapper(
-	ctx context.Context, cm *component.Manager, cfg configuration.Introspection, pb message.Publisher,
+	ctx context.Context, cm *component.Manager, cfg configuration.Introspection, role insolar.StaticRole, pb message.Publisher,
 ) message.Publisher {
 	return pb
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/ThreeDotsLabs/watermill/message"
 	component "github.com/insolar/component-manager"
 	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/instrumentation/introspector"
 	"github.com/insolar/insolar/instrumentation/introspector/pubsubwrap"
 	"golang.org/x/net/context"
@@ -21,6 +22,7 @@ func PublisherWrapper(
 	ctx context.Context,
 	cm *component.Manager,
 	cfg configuration.Introspection,
+	role insolar.StaticRole,
 	pb message.Publisher,
 ) message.Publisher {
 	pw := pubsubwrap.NewPublisherWrapper(pb)
@@ -30,9 +32,12 @@ func PublisherWrapper(
 	mLocker := pubsubwrap.NewMessageLockerByType(ctx)
 	pw.Middleware(mStat)
 	pw.Middleware(mLocker)
+	// fault injection is applied to messages passed filters, all of them are received by the node with role
+	fInjector := pubsubwrap.NewFaultInjector(ctx, role)
+	pw.Injector(fInjector)
 
 	// create introspection server with service which implements introproto.PublisherServer
-	service := pubsubwrap.NewPublisherService(mLocker, mStat)
+	service := pubsubwrap.NewPublisherService(mLocker, mStat, fInjector)
 	iSrv := introspector.NewServer(cfg.Addr, service)
 
 	// use component manager for lifecycle (component.Manager calls Start/Stop on server instance)
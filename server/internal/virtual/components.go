@@ -54,7 +54,8 @@ func initBootstrapComponents(ctx context.Context, cfg configuration.VirtualConfi
 	keyStore, err := keystore.NewKeyStore(cfg.KeysPath)
 	checkError(ctx, err, "failed to load KeyStore: ")
 
-	platformCryptographyScheme := platformpolicy.NewPlatformCryptographyScheme()
+	platformCryptographyScheme, err := platformpolicy.NewPlatformCryptographySchemeWithAlgorithm(cfg.SignatureAlgorithm)
+	checkError(ctx, err, "failed to create platform cryptography scheme: ")
 	keyProcessor := platformpolicy.NewKeyProcessor()
 
 	cryptographyService := cryptography.NewCryptographyService()
@@ -99,7 +100,7 @@ func initComponents(
 	builtinContracts builtin.BuiltinContracts,
 	apiOptions api.Options,
 
-) (*component.Manager, func()) {
+) (*component.Manager, *servicenetwork.ServiceNetwork, func()) {
 	cm := component.NewManager(nil)
 
 	// Watermill.
@@ -113,8 +114,11 @@ func initComponents(
 		pubsub := gochannel.NewGoChannel(gochannel.Config{}, wmLogger)
 		subscriber = pubsub
 		publisher = pubsub
+		var err error
+		publisher, err = internal.CapturePublisher(ctx, cfg.Bus, publisher)
+		checkError(ctx, err, "failed to start bus capture")
 		// Wrapped watermill Publisher for introspection.
-		publisher = internal.PublisherWrapper(ctx, cm, cfg.Introspection, publisher)
+		publisher = internal.PublisherWrapper(ctx, cm, cfg.Introspection, insolar.StaticRoleVirtual, publisher)
 	}
 
 	nw, err := servicenetwork.NewServiceNetwork(cfg.Host, cm)
@@ -131,6 +135,8 @@ func initComponents(
 
 	logicRunner, err := logicrunner.NewLogicRunner(&cfg.LogicRunner, publisher, b, builtinContracts)
 	checkError(ctx, err, "failed to start LogicRunner")
+	nw.AddDrainer(logicRunner)
+	logicRunner.MisbehaviorReporter = nw
 
 	contractRequester, err := contractrequester.New(
 		b,
@@ -153,6 +159,8 @@ func initComponents(
 		jc,
 		nw,
 		availabilityChecker,
+		cryptographyService,
+		nw,
 		apiOptions,
 	)
 	checkError(ctx, err, "failed to start ApiRunner")
@@ -168,15 +176,20 @@ func initComponents(
 		jc,
 		nw,
 		availabilityChecker,
+		cryptographyService,
+		nw,
 		apiOptions,
 	)
 	checkError(ctx, err, "failed to start AdminAPIRunner")
+	AdminAPIRunner.ValidationJournal = logicRunner
 
 	APIWrapper := api.NewWrapper(API, AdminAPIRunner)
 
 	// TODO: remove this hack in INS-3341
 	contractRequester.LR = logicRunner
 
+	contractRequester.AddResultObserver(API.Events.OnResult, AdminAPIRunner.Events.OnResult)
+
 	pm := pulsemanager.NewPulseManager()
 
 	cm.Register(
@@ -218,9 +231,9 @@ func initComponents(
 	checkError(ctx, err, "failed to init components")
 
 	// this should be done after Init due to inject
-	pm.AddDispatcher(logicRunner.FlowDispatcher, contractRequester.FlowDispatcher)
+	pm.AddDispatcher(logicRunner.FlowDispatcher, contractRequester.FlowDispatcher, API.Events, AdminAPIRunner.Events)
 
-	return cm, startWatermill(
+	return cm, nw, startWatermill(
 		ctx, wmLogger, subscriber, b,
 		nw.SendMessageHandler,
 		logicRunner.FlowDispatcher.Process,
@@ -64,7 +64,7 @@ func (s *Server) Serve() {
 		defer jaegerFlush()
 	}
 
-	cm, stopWatermill := initComponents(
+	cm, nw, stopWatermill := initComponents(
 		ctx,
 		cfg,
 		bootstrapComponents.CryptographyService,
@@ -83,13 +83,14 @@ func (s *Server) Serve() {
 	var waitChannel = make(chan bool)
 
 	go func() {
-		sig := <-gracefulStop
-		logger.Debug("caught sig: ", sig)
+		select {
+		case sig := <-gracefulStop:
+			logger.Debug("caught sig: ", sig)
+		case <-nw.Left():
+			logger.Info("node has left the network")
+		}
 
 		logger.Warn("GRACEFUL STOP APP")
-		// th.Leave(ctx, 10) TODO: is actual ??
-		logger.Info("main leave ends ")
-
 		err = cm.GracefulStop(ctx)
 		checkError(ctx, err, "failed to graceful stop components")
 
@@ -0,0 +1,281 @@
+package testutils
+
+// Code generated by http://github.com/gojuno/minimock (dev). DO NOT EDIT.
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// DrainerMock implements insolar.Drainer
+type DrainerMock struct {
+	t minimock.Tester
+
+	funcPendingWork          func(ctx context.Context) (s1 string)
+	inspectFuncPendingWork   func(ctx context.Context)
+	afterPendingWorkCounter  uint64
+	beforePendingWorkCounter uint64
+	PendingWorkMock          mDrainerMockPendingWork
+}
+
+// NewDrainerMock returns a mock for insolar.Drainer
+func NewDrainerMock(t minimock.Tester) *DrainerMock {
+	m := &DrainerMock{t: t}
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.PendingWorkMock = mDrainerMockPendingWork{mock: m}
+	m.PendingWorkMock.callArgs = []*DrainerMockPendingWorkParams{}
+
+	return m
+}
+
+type mDrainerMockPendingWork struct {
+	mock               *DrainerMock
+	defaultExpectation *DrainerMockPendingWorkExpectation
+	expectations       []*DrainerMockPendingWorkExpectation
+
+	callArgs []*DrainerMockPendingWorkParams
+	mutex    sync.RWMutex
+}
+
+// DrainerMockPendingWorkExpectation specifies expectation struct of the Drainer.PendingWork
+type DrainerMockPendingWorkExpectation struct {
+	mock    *DrainerMock
+	params  *DrainerMockPendingWorkParams
+	results *DrainerMockPendingWorkResults
+	Counter uint64
+}
+
+// DrainerMockPendingWorkParams contains parameters of the Drainer.PendingWork
+type DrainerMockPendingWorkParams struct {
+	ctx context.Context
+}
+
+// DrainerMockPendingWorkResults contains results of the Drainer.PendingWork
+type DrainerMockPendingWorkResults struct {
+	s1 string
+}
+
+// Expect sets up expected params for Drainer.PendingWork
+func (mmPendingWork *mDrainerMockPendingWork) Expect(ctx context.Context) *mDrainerMockPendingWork {
+	if mmPendingWork.mock.funcPendingWork != nil {
+		mmPendingWork.mock.t.Fatalf("DrainerMock.PendingWork mock is already set by Set")
+	}
+
+	if mmPendingWork.defaultExpectation == nil {
+		mmPendingWork.defaultExpectation = &DrainerMockPendingWorkExpectation{}
+	}
+
+	mmPendingWork.defaultExpectation.params = &DrainerMockPendingWorkParams{ctx}
+	for _, e := range mmPendingWork.expectations {
+		if minimock.Equal(e.params, mmPendingWork.defaultExpectation.params) {
+			mmPendingWork.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPendingWork.defaultExpectation.params)
+		}
+	}
+
+	return mmPendingWork
+}
+
+// Inspect accepts an inspector function that has same arguments as the Drainer.PendingWork
+func (mmPendingWork *mDrainerMockPendingWork) Inspect(f func(ctx context.Context)) *mDrainerMockPendingWork {
+	if mmPendingWork.mock.inspectFuncPendingWork != nil {
+		mmPendingWork.mock.t.Fatalf("Inspect function is already set for DrainerMock.PendingWork")
+	}
+
+	mmPendingWork.mock.inspectFuncPendingWork = f
+
+	return mmPendingWork
+}
+
+// Return sets up results that will be returned by Drainer.PendingWork
+func (mmPendingWork *mDrainerMockPendingWork) Return(s1 string) *DrainerMock {
+	if mmPendingWork.mock.funcPendingWork != nil {
+		mmPendingWork.mock.t.Fatalf("DrainerMock.PendingWork mock is already set by Set")
+	}
+
+	if mmPendingWork.defaultExpectation == nil {
+		mmPendingWork.defaultExpectation = &DrainerMockPendingWorkExpectation{mock: mmPendingWork.mock}
+	}
+	mmPendingWork.defaultExpectation.results = &DrainerMockPendingWorkResults{s1}
+	return mmPendingWork.mock
+}
+
+// Set uses given function f to mock the Drainer.PendingWork method
+func (mmPendingWork *mDrainerMockPendingWork) Set(f func(ctx context.Context) (s1 string)) *DrainerMock {
+	if mmPendingWork.defaultExpectation != nil {
+		mmPendingWork.mock.t.Fatalf("Default expectation is already set for the Drainer.PendingWork method")
+	}
+
+	if len(mmPendingWork.expectations) > 0 {
+		mmPendingWork.mock.t.Fatalf("Some expectations are already set for the Drainer.PendingWork method")
+	}
+
+	mmPendingWork.mock.funcPendingWork = f
+	return mmPendingWork.mock
+}
+
+// When sets expectation for the Drainer.PendingWork which will trigger the result defined by the following
+// Then helper
+func (mmPendingWork *mDrainerMockPendingWork) When(ctx context.Context) *DrainerMockPendingWorkExpectation {
+	if mmPendingWork.mock.funcPendingWork != nil {
+		mmPendingWork.mock.t.Fatalf("DrainerMock.PendingWork mock is already set by Set")
+	}
+
+	expectation := &DrainerMockPendingWorkExpectation{
+		mock:   mmPendingWork.mock,
+		params: &DrainerMockPendingWorkParams{ctx},
+	}
+	mmPendingWork.expectations = append(mmPendingWork.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Drainer.PendingWork return parameters for the expectation previously defined by the When method
+func (e *DrainerMockPendingWorkExpectation) Then(s1 string) *DrainerMock {
+	e.results = &DrainerMockPendingWorkResults{s1}
+	return e.mock
+}
+
+// PendingWork implements insolar.Drainer
+func (mmPendingWork *DrainerMock) PendingWork(ctx context.Context) (s1 string) {
+	mm_atomic.AddUint64(&mmPendingWork.beforePendingWorkCounter, 1)
+	defer mm_atomic.AddUint64(&mmPendingWork.afterPendingWorkCounter, 1)
+
+	if mmPendingWork.inspectFuncPendingWork != nil {
+		mmPendingWork.inspectFuncPendingWork(ctx)
+	}
+
+	mm_params := &DrainerMockPendingWorkParams{ctx}
+
+	// Record call args
+	mmPendingWork.PendingWorkMock.mutex.Lock()
+	mmPendingWork.PendingWorkMock.callArgs = append(mmPendingWork.PendingWorkMock.callArgs, mm_params)
+	mmPendingWork.PendingWorkMock.mutex.Unlock()
+
+	for _, e := range mmPendingWork.PendingWorkMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1
+		}
+	}
+
+	if mmPendingWork.PendingWorkMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPendingWork.PendingWorkMock.defaultExpectation.Counter, 1)
+		mm_want := mmPendingWork.PendingWorkMock.defaultExpectation.params
+		mm_got := DrainerMockPendingWorkParams{ctx}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPendingWork.t.Errorf("DrainerMock.PendingWork got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmPendingWork.PendingWorkMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPendingWork.t.Fatal("No results are set for the DrainerMock.PendingWork")
+		}
+		return (*mm_results).s1
+	}
+	if mmPendingWork.funcPendingWork != nil {
+		return mmPendingWork.funcPendingWork(ctx)
+	}
+	mmPendingWork.t.Fatalf("Unexpected call to DrainerMock.PendingWork. %v", ctx)
+	return
+}
+
+// PendingWorkAfterCounter returns a count of finished DrainerMock.PendingWork invocations
+func (mmPendingWork *DrainerMock) PendingWorkAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPendingWork.afterPendingWorkCounter)
+}
+
+// PendingWorkBeforeCounter returns a count of DrainerMock.PendingWork invocations
+func (mmPendingWork *DrainerMock) PendingWorkBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPendingWork.beforePendingWorkCounter)
+}
+
+// Calls returns a list of arguments used in each call to DrainerMock.PendingWork.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPendingWork *mDrainerMockPendingWork) Calls() []*DrainerMockPendingWorkParams {
+	mmPendingWork.mutex.RLock()
+
+	argCopy := make([]*DrainerMockPendingWorkParams, len(mmPendingWork.callArgs))
+	copy(argCopy, mmPendingWork.callArgs)
+
+	mmPendingWork.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPendingWorkDone returns true if the count of the PendingWork invocations corresponds
+// the number of defined expectations
+func (m *DrainerMock) MinimockPendingWorkDone() bool {
+	for _, e := range m.PendingWorkMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PendingWorkMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterPendingWorkCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPendingWork != nil && mm_atomic.LoadUint64(&m.afterPendingWorkCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockPendingWorkInspect logs each unmet expectation
+func (m *DrainerMock) MinimockPendingWorkInspect() {
+	for _, e := range m.PendingWorkMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to DrainerMock.PendingWork with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PendingWorkMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterPendingWorkCounter) < 1 {
+		if m.PendingWorkMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to DrainerMock.PendingWork")
+		} else {
+			m.t.Errorf("Expected call to DrainerMock.PendingWork with params: %#v", *m.PendingWorkMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPendingWork != nil && mm_atomic.LoadUint64(&m.afterPendingWorkCounter) < 1 {
+		m.t.Error("Expected call to DrainerMock.PendingWork")
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *DrainerMock) MinimockFinish() {
+	if !m.minimockDone() {
+		m.MinimockPendingWorkInspect()
+		m.t.FailNow()
+	}
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *DrainerMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *DrainerMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockPendingWorkDone()
+}
@@ -0,0 +1,510 @@
+package testutils
+
+// Code generated by http://github.com/gojuno/minimock (dev). DO NOT EDIT.
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	mm_insolar "github.com/insolar/insolar/insolar"
+)
+
+// GracefulLeaverMock implements insolar.GracefulLeaver
+type GracefulLeaverMock struct {
+	t minimock.Tester
+
+	funcLeaveStatus          func(ctx context.Context) (l1 mm_insolar.LeaveStatus)
+	inspectFuncLeaveStatus   func(ctx context.Context)
+	afterLeaveStatusCounter  uint64
+	beforeLeaveStatusCounter uint64
+	LeaveStatusMock          mGracefulLeaverMockLeaveStatus
+
+	funcRequestLeave          func(ctx context.Context, leaveAfterPulses mm_insolar.PulseNumber) (err error)
+	inspectFuncRequestLeave   func(ctx context.Context, leaveAfterPulses mm_insolar.PulseNumber)
+	afterRequestLeaveCounter  uint64
+	beforeRequestLeaveCounter uint64
+	RequestLeaveMock          mGracefulLeaverMockRequestLeave
+}
+
+// NewGracefulLeaverMock returns a mock for insolar.GracefulLeaver
+func NewGracefulLeaverMock(t minimock.Tester) *GracefulLeaverMock {
+	m := &GracefulLeaverMock{t: t}
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.LeaveStatusMock = mGracefulLeaverMockLeaveStatus{mock: m}
+	m.LeaveStatusMock.callArgs = []*GracefulLeaverMockLeaveStatusParams{}
+
+	m.RequestLeaveMock = mGracefulLeaverMockRequestLeave{mock: m}
+	m.RequestLeaveMock.callArgs = []*GracefulLeaverMockRequestLeaveParams{}
+
+	return m
+}
+
+type mGracefulLeaverMockLeaveStatus struct {
+	mock               *GracefulLeaverMock
+	defaultExpectation *GracefulLeaverMockLeaveStatusExpectation
+	expectations       []*GracefulLeaverMockLeaveStatusExpectation
+
+	callArgs []*GracefulLeaverMockLeaveStatusParams
+	mutex    sync.RWMutex
+}
+
+// GracefulLeaverMockLeaveStatusExpectation specifies expectation struct of the GracefulLeaver.LeaveStatus
+type GracefulLeaverMockLeaveStatusExpectation struct {
+	mock    *GracefulLeaverMock
+	params  *GracefulLeaverMockLeaveStatusParams
+	results *GracefulLeaverMockLeaveStatusResults
+	Counter uint64
+}
+
+// GracefulLeaverMockLeaveStatusParams contains parameters of the GracefulLeaver.LeaveStatus
+type GracefulLeaverMockLeaveStatusParams struct {
+	ctx context.Context
+}
+
+// GracefulLeaverMockLeaveStatusResults contains results of the GracefulLeaver.LeaveStatus
+type GracefulLeaverMockLeaveStatusResults struct {
+	l1 mm_insolar.LeaveStatus
+}
+
+// Expect sets up expected params for GracefulLeaver.LeaveStatus
+func (mmLeaveStatus *mGracefulLeaverMockLeaveStatus) Expect(ctx context.Context) *mGracefulLeaverMockLeaveStatus {
+	if mmLeaveStatus.mock.funcLeaveStatus != nil {
+		mmLeaveStatus.mock.t.Fatalf("GracefulLeaverMock.LeaveStatus mock is already set by Set")
+	}
+
+	if mmLeaveStatus.defaultExpectation == nil {
+		mmLeaveStatus.defaultExpectation = &GracefulLeaverMockLeaveStatusExpectation{}
+	}
+
+	mmLeaveStatus.defaultExpectation.params = &GracefulLeaverMockLeaveStatusParams{ctx}
+	for _, e := range mmLeaveStatus.expectations {
+		if minimock.Equal(e.params, mmLeaveStatus.defaultExpectation.params) {
+			mmLeaveStatus.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmLeaveStatus.defaultExpectation.params)
+		}
+	}
+
+	return mmLeaveStatus
+}
+
+// Inspect accepts an inspector function that has same arguments as the GracefulLeaver.LeaveStatus
+func (mmLeaveStatus *mGracefulLeaverMockLeaveStatus) Inspect(f func(ctx context.Context)) *mGracefulLeaverMockLeaveStatus {
+	if mmLeaveStatus.mock.inspectFuncLeaveStatus != nil {
+		mmLeaveStatus.mock.t.Fatalf("Inspect function is already set for GracefulLeaverMock.LeaveStatus")
+	}
+
+	mmLeaveStatus.mock.inspectFuncLeaveStatus = f
+
+	return mmLeaveStatus
+}
+
+// Return sets up results that will be returned by GracefulLeaver.LeaveStatus
+func (mmLeaveStatus *mGracefulLeaverMockLeaveStatus) Return(l1 mm_insolar.LeaveStatus) *GracefulLeaverMock {
+	if mmLeaveStatus.mock.funcLeaveStatus != nil {
+		mmLeaveStatus.mock.t.Fatalf("GracefulLeaverMock.LeaveStatus mock is already set by Set")
+	}
+
+	if mmLeaveStatus.defaultExpectation == nil {
+		mmLeaveStatus.defaultExpectation = &GracefulLeaverMockLeaveStatusExpectation{mock: mmLeaveStatus.mock}
+	}
+	mmLeaveStatus.defaultExpectation.results = &GracefulLeaverMockLeaveStatusResults{l1}
+	return mmLeaveStatus.mock
+}
+
+// Set uses given function f to mock the GracefulLeaver.LeaveStatus method
+func (mmLeaveStatus *mGracefulLeaverMockLeaveStatus) Set(f func(ctx context.Context) (l1 mm_insolar.LeaveStatus)) *GracefulLeaverMock {
+	if mmLeaveStatus.defaultExpectation != nil {
+		mmLeaveStatus.mock.t.Fatalf("Default expectation is already set for the GracefulLeaver.LeaveStatus method")
+	}
+
+	if len(mmLeaveStatus.expectations) > 0 {
+		mmLeaveStatus.mock.t.Fatalf("Some expectations are already set for the GracefulLeaver.LeaveStatus method")
+	}
+
+	mmLeaveStatus.mock.funcLeaveStatus = f
+	return mmLeaveStatus.mock
+}
+
+// When sets expectation for the GracefulLeaver.LeaveStatus which will trigger the result defined by the following
+// Then helper
+func (mmLeaveStatus *mGracefulLeaverMockLeaveStatus) When(ctx context.Context) *GracefulLeaverMockLeaveStatusExpectation {
+	if mmLeaveStatus.mock.funcLeaveStatus != nil {
+		mmLeaveStatus.mock.t.Fatalf("GracefulLeaverMock.LeaveStatus mock is already set by Set")
+	}
+
+	expectation := &GracefulLeaverMockLeaveStatusExpectation{
+		mock:   mmLeaveStatus.mock,
+		params: &GracefulLeaverMockLeaveStatusParams{ctx},
+	}
+	mmLeaveStatus.expectations = append(mmLeaveStatus.expectations, expectation)
+	return expectation
+}
+
+// Then sets up GracefulLeaver.LeaveStatus return parameters for the expectation previously defined by the When method
+func (e *GracefulLeaverMockLeaveStatusExpectation) Then(l1 mm_insolar.LeaveStatus) *GracefulLeaverMock {
+	e.results = &GracefulLeaverMockLeaveStatusResults{l1}
+	return e.mock
+}
+
+// LeaveStatus implements insolar.GracefulLeaver
+func (mmLeaveStatus *GracefulLeaverMock) LeaveStatus(ctx context.Context) (l1 mm_insolar.LeaveStatus) {
+	mm_atomic.AddUint64(&mmLeaveStatus.beforeLeaveStatusCounter, 1)
+	defer mm_atomic.AddUint64(&mmLeaveStatus.afterLeaveStatusCounter, 1)
+
+	if mmLeaveStatus.inspectFuncLeaveStatus != nil {
+		mmLeaveStatus.inspectFuncLeaveStatus(ctx)
+	}
+
+	mm_params := &GracefulLeaverMockLeaveStatusParams{ctx}
+
+	// Record call args
+	mmLeaveStatus.LeaveStatusMock.mutex.Lock()
+	mmLeaveStatus.LeaveStatusMock.callArgs = append(mmLeaveStatus.LeaveStatusMock.callArgs, mm_params)
+	mmLeaveStatus.LeaveStatusMock.mutex.Unlock()
+
+	for _, e := range mmLeaveStatus.LeaveStatusMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.l1
+		}
+	}
+
+	if mmLeaveStatus.LeaveStatusMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmLeaveStatus.LeaveStatusMock.defaultExpectation.Counter, 1)
+		mm_want := mmLeaveStatus.LeaveStatusMock.defaultExpectation.params
+		mm_got := GracefulLeaverMockLeaveStatusParams{ctx}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmLeaveStatus.t.Errorf("GracefulLeaverMock.LeaveStatus got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmLeaveStatus.LeaveStatusMock.defaultExpectation.results
+		if mm_results == nil {
+			mmLeaveStatus.t.Fatal("No results are set for the GracefulLeaverMock.LeaveStatus")
+		}
+		return (*mm_results).l1
+	}
+	if mmLeaveStatus.funcLeaveStatus != nil {
+		return mmLeaveStatus.funcLeaveStatus(ctx)
+	}
+	mmLeaveStatus.t.Fatalf("Unexpected call to GracefulLeaverMock.LeaveStatus. %v", ctx)
+	return
+}
+
+// LeaveStatusAfterCounter returns a count of finished GracefulLeaverMock.LeaveStatus invocations
+func (mmLeaveStatus *GracefulLeaverMock) LeaveStatusAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLeaveStatus.afterLeaveStatusCounter)
+}
+
+// LeaveStatusBeforeCounter returns a count of GracefulLeaverMock.LeaveStatus invocations
+func (mmLeaveStatus *GracefulLeaverMock) LeaveStatusBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLeaveStatus.beforeLeaveStatusCounter)
+}
+
+// Calls returns a list of arguments used in each call to GracefulLeaverMock.LeaveStatus.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmLeaveStatus *mGracefulLeaverMockLeaveStatus) Calls() []*GracefulLeaverMockLeaveStatusParams {
+	mmLeaveStatus.mutex.RLock()
+
+	argCopy := make([]*GracefulLeaverMockLeaveStatusParams, len(mmLeaveStatus.callArgs))
+	copy(argCopy, mmLeaveStatus.callArgs)
+
+	mmLeaveStatus.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockLeaveStatusDone returns true if the count of the LeaveStatus invocations corresponds
+// the number of defined expectations
+func (m *GracefulLeaverMock) MinimockLeaveStatusDone() bool {
+	for _, e := range m.LeaveStatusMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.LeaveStatusMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterLeaveStatusCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcLeaveStatus != nil && mm_atomic.LoadUint64(&m.afterLeaveStatusCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockLeaveStatusInspect logs each unmet expectation
+func (m *GracefulLeaverMock) MinimockLeaveStatusInspect() {
+	for _, e := range m.LeaveStatusMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to GracefulLeaverMock.LeaveStatus with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.LeaveStatusMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterLeaveStatusCounter) < 1 {
+		if m.LeaveStatusMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to GracefulLeaverMock.LeaveStatus")
+		} else {
+			m.t.Errorf("Expected call to GracefulLeaverMock.LeaveStatus with params: %#v", *m.LeaveStatusMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcLeaveStatus != nil && mm_atomic.LoadUint64(&m.afterLeaveStatusCounter) < 1 {
+		m.t.Error("Expected call to GracefulLeaverMock.LeaveStatus")
+	}
+}
+
+type mGracefulLeaverMockRequestLeave struct {
+	mock               *GracefulLeaverMock
+	defaultExpectation *GracefulLeaverMockRequestLeaveExpectation
+	expectations       []*GracefulLeaverMockRequestLeaveExpectation
+
+	callArgs []*GracefulLeaverMockRequestLeaveParams
+	mutex    sync.RWMutex
+}
+
+// GracefulLeaverMockRequestLeaveExpectation specifies expectation struct of the GracefulLeaver.RequestLeave
+type GracefulLeaverMockRequestLeaveExpectation struct {
+	mock    *GracefulLeaverMock
+	params  *GracefulLeaverMockRequestLeaveParams
+	results *GracefulLeaverMockRequestLeaveResults
+	Counter uint64
+}
+
+// GracefulLeaverMockRequestLeaveParams contains parameters of the GracefulLeaver.RequestLeave
+type GracefulLeaverMockRequestLeaveParams struct {
+	ctx              context.Context
+	leaveAfterPulses mm_insolar.PulseNumber
+}
+
+// GracefulLeaverMockRequestLeaveResults contains results of the GracefulLeaver.RequestLeave
+type GracefulLeaverMockRequestLeaveResults struct {
+	err error
+}
+
+// Expect sets up expected params for GracefulLeaver.RequestLeave
+func (mmRequestLeave *mGracefulLeaverMockRequestLeave) Expect(ctx context.Context, leaveAfterPulses mm_insolar.PulseNumber) *mGracefulLeaverMockRequestLeave {
+	if mmRequestLeave.mock.funcRequestLeave != nil {
+		mmRequestLeave.mock.t.Fatalf("GracefulLeaverMock.RequestLeave mock is already set by Set")
+	}
+
+	if mmRequestLeave.defaultExpectation == nil {
+		mmRequestLeave.defaultExpectation = &GracefulLeaverMockRequestLeaveExpectation{}
+	}
+
+	mmRequestLeave.defaultExpectation.params = &GracefulLeaverMockRequestLeaveParams{ctx, leaveAfterPulses}
+	for _, e := range mmRequestLeave.expectations {
+		if minimock.Equal(e.params, mmRequestLeave.defaultExpectation.params) {
+			mmRequestLeave.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRequestLeave.defaultExpectation.params)
+		}
+	}
+
+	return mmRequestLeave
+}
+
+// Inspect accepts an inspector function that has same arguments as the GracefulLeaver.RequestLeave
+func (mmRequestLeave *mGracefulLeaverMockRequestLeave) Inspect(f func(ctx context.Context, leaveAfterPulses mm_insolar.PulseNumber)) *mGracefulLeaverMockRequestLeave {
+	if mmRequestLeave.mock.inspectFuncRequestLeave != nil {
+		mmRequestLeave.mock.t.Fatalf("Inspect function is already set for GracefulLeaverMock.RequestLeave")
+	}
+
+	mmRequestLeave.mock.inspectFuncRequestLeave = f
+
+	return mmRequestLeave
+}
+
+// Return sets up results that will be returned by GracefulLeaver.RequestLeave
+func (mmRequestLeave *mGracefulLeaverMockRequestLeave) Return(err error) *GracefulLeaverMock {
+	if mmRequestLeave.mock.funcRequestLeave != nil {
+		mmRequestLeave.mock.t.Fatalf("GracefulLeaverMock.RequestLeave mock is already set by Set")
+	}
+
+	if mmRequestLeave.defaultExpectation == nil {
+		mmRequestLeave.defaultExpectation = &GracefulLeaverMockRequestLeaveExpectation{mock: mmRequestLeave.mock}
+	}
+	mmRequestLeave.defaultExpectation.results = &GracefulLeaverMockRequestLeaveResults{err}
+	return mmRequestLeave.mock
+}
+
+// Set uses given function f to mock the GracefulLeaver.RequestLeave method
+func (mmRequestLeave *mGracefulLeaverMockRequestLeave) Set(f func(ctx context.Context, leaveAfterPulses mm_insolar.PulseNumber) (err error)) *GracefulLeaverMock {
+	if mmRequestLeave.defaultExpectation != nil {
+		mmRequestLeave.mock.t.Fatalf("Default expectation is already set for the GracefulLeaver.RequestLeave method")
+	}
+
+	if len(mmRequestLeave.expectations) > 0 {
+		mmRequestLeave.mock.t.Fatalf("Some expectations are already set for the GracefulLeaver.RequestLeave method")
+	}
+
+	mmRequestLeave.mock.funcRequestLeave = f
+	return mmRequestLeave.mock
+}
+
+// When sets expectation for the GracefulLeaver.RequestLeave which will trigger the result defined by the following
+// Then helper
+func (mmRequestLeave *mGracefulLeaverMockRequestLeave) When(ctx context.Context, leaveAfterPulses mm_insolar.PulseNumber) *GracefulLeaverMockRequestLeaveExpectation {
+	if mmRequestLeave.mock.funcRequestLeave != nil {
+		mmRequestLeave.mock.t.Fatalf("GracefulLeaverMock.RequestLeave mock is already set by Set")
+	}
+
+	expectation := &GracefulLeaverMockRequestLeaveExpectation{
+		mock:   mmRequestLeave.mock,
+		params: &GracefulLeaverMockRequestLeaveParams{ctx, leaveAfterPulses},
+	}
+	mmRequestLeave.expectations = append(mmRequestLeave.expectations, expectation)
+	return expectation
+}
+
+// Then sets up GracefulLeaver.RequestLeave return parameters for the expectation previously defined by the When method
+func (e *GracefulLeaverMockRequestLeaveExpectation) Then(err error) *GracefulLeaverMock {
+	e.results = &GracefulLeaverMockRequestLeaveResults{err}
+	return e.mock
+}
+
+// RequestLeave implements insolar.GracefulLeaver
+func (mmRequestLeave *GracefulLeaverMock) RequestLeave(ctx context.Context, leaveAfterPulses mm_insolar.PulseNumber) (err error) {
+	mm_atomic.AddUint64(&mmRequestLeave.beforeRequestLeaveCounter, 1)
+	defer mm_atomic.AddUint64(&mmRequestLeave.afterRequestLeaveCounter, 1)
+
+	if mmRequestLeave.inspectFuncRequestLeave != nil {
+		mmRequestLeave.inspectFuncRequestLeave(ctx, leaveAfterPulses)
+	}
+
+	mm_params := &GracefulLeaverMockRequestLeaveParams{ctx, leaveAfterPulses}
+
+	// Record call args
+	mmRequestLeave.RequestLeaveMock.mutex.Lock()
+	mmRequestLeave.RequestLeaveMock.callArgs = append(mmRequestLeave.RequestLeaveMock.callArgs, mm_params)
+	mmRequestLeave.RequestLeaveMock.mutex.Unlock()
+
+	for _, e := range mmRequestLeave.RequestLeaveMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRequestLeave.RequestLeaveMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRequestLeave.RequestLeaveMock.defaultExpectation.Counter, 1)
+		mm_want := mmRequestLeave.RequestLeaveMock.defaultExpectation.params
+		mm_got := GracefulLeaverMockRequestLeaveParams{ctx, leaveAfterPulses}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRequestLeave.t.Errorf("GracefulLeaverMock.RequestLeave got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRequestLeave.RequestLeaveMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRequestLeave.t.Fatal("No results are set for the GracefulLeaverMock.RequestLeave")
+		}
+		return (*mm_results).err
+	}
+	if mmRequestLeave.funcRequestLeave != nil {
+		return mmRequestLeave.funcRequestLeave(ctx, leaveAfterPulses)
+	}
+	mmRequestLeave.t.Fatalf("Unexpected call to GracefulLeaverMock.RequestLeave. %v %v", ctx, leaveAfterPulses)
+	return
+}
+
+// RequestLeaveAfterCounter returns a count of finished GracefulLeaverMock.RequestLeave invocations
+func (mmRequestLeave *GracefulLeaverMock) RequestLeaveAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRequestLeave.afterRequestLeaveCounter)
+}
+
+// RequestLeaveBeforeCounter returns a count of GracefulLeaverMock.RequestLeave invocations
+func (mmRequestLeave *GracefulLeaverMock) RequestLeaveBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRequestLeave.beforeRequestLeaveCounter)
+}
+
+// Calls returns a list of arguments used in each call to GracefulLeaverMock.RequestLeave.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRequestLeave *mGracefulLeaverMockRequestLeave) Calls() []*GracefulLeaverMockRequestLeaveParams {
+	mmRequestLeave.mutex.RLock()
+
+	argCopy := make([]*GracefulLeaverMockRequestLeaveParams, len(mmRequestLeave.callArgs))
+	copy(argCopy, mmRequestLeave.callArgs)
+
+	mmRequestLeave.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRequestLeaveDone returns true if the count of the RequestLeave invocations corresponds
+// the number of defined expectations
+func (m *GracefulLeaverMock) MinimockRequestLeaveDone() bool {
+	for _, e := range m.RequestLeaveMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RequestLeaveMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterRequestLeaveCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRequestLeave != nil && mm_atomic.LoadUint64(&m.afterRequestLeaveCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockRequestLeaveInspect logs each unmet expectation
+func (m *GracefulLeaverMock) MinimockRequestLeaveInspect() {
+	for _, e := range m.RequestLeaveMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to GracefulLeaverMock.RequestLeave with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RequestLeaveMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterRequestLeaveCounter) < 1 {
+		if m.RequestLeaveMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to GracefulLeaverMock.RequestLeave")
+		} else {
+			m.t.Errorf("Expected call to GracefulLeaverMock.RequestLeave with params: %#v", *m.RequestLeaveMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRequestLeave != nil && mm_atomic.LoadUint64(&m.afterRequestLeaveCounter) < 1 {
+		m.t.Error("Expected call to GracefulLeaverMock.RequestLeave")
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *GracefulLeaverMock) MinimockFinish() {
+	if !m.minimockDone() {
+		m.MinimockLeaveStatusInspect()
+
+		m.MinimockRequestLeaveInspect()
+		m.t.FailNow()
+	}
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *GracefulLeaverMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *GracefulLeaverMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockLeaveStatusDone() &&
+		m.MinimockRequestLeaveDone()
+}
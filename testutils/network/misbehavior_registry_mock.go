@@ -0,0 +1,1290 @@
+package network
+
+// Code generated by http://github.com/gojuno/minimock (dev). DO NOT EDIT.
+
+import (
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/insolar/insolar/insolar"
+	mm_network "github.com/insolar/insolar/network"
+	"github.com/insolar/insolar/network/consensus/gcpv2/api/misbehavior"
+)
+
+// MisbehaviorRegistryMock implements network.MisbehaviorRegistry
+type MisbehaviorRegistryMock struct {
+	t minimock.Tester
+
+	funcAddReport          func(report misbehavior.Report)
+	inspectFuncAddReport   func(report misbehavior.Report)
+	afterAddReportCounter  uint64
+	beforeAddReportCounter uint64
+	AddReportMock          mMisbehaviorRegistryMockAddReport
+
+	funcForgive          func(ref insolar.Reference) (b1 bool)
+	inspectFuncForgive   func(ref insolar.Reference)
+	afterForgiveCounter  uint64
+	beforeForgiveCounter uint64
+	ForgiveMock          mMisbehaviorRegistryMockForgive
+
+	funcIsBlacklisted          func(ref insolar.Reference) (b1 bool)
+	inspectFuncIsBlacklisted   func(ref insolar.Reference)
+	afterIsBlacklistedCounter  uint64
+	beforeIsBlacklistedCounter uint64
+	IsBlacklistedMock          mMisbehaviorRegistryMockIsBlacklisted
+
+	funcIsQuarantined          func(ref insolar.Reference) (b1 bool)
+	inspectFuncIsQuarantined   func(ref insolar.Reference)
+	afterIsQuarantinedCounter  uint64
+	beforeIsQuarantinedCounter uint64
+	IsQuarantinedMock          mMisbehaviorRegistryMockIsQuarantined
+
+	funcOffenders          func() (oa1 []mm_network.Offender)
+	inspectFuncOffenders   func()
+	afterOffendersCounter  uint64
+	beforeOffendersCounter uint64
+	OffendersMock          mMisbehaviorRegistryMockOffenders
+
+	funcReportValidationMismatch          func(ref insolar.Reference, details string)
+	inspectFuncReportValidationMismatch   func(ref insolar.Reference, details string)
+	afterReportValidationMismatchCounter  uint64
+	beforeReportValidationMismatchCounter uint64
+	ReportValidationMismatchMock          mMisbehaviorRegistryMockReportValidationMismatch
+}
+
+// NewMisbehaviorRegistryMock returns a mock for network.MisbehaviorRegistry
+func NewMisbehaviorRegistryMock(t minimock.Tester) *MisbehaviorRegistryMock {
+	m := &MisbehaviorRegistryMock{t: t}
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.AddReportMock = mMisbehaviorRegistryMockAddReport{mock: m}
+	m.AddReportMock.callArgs = []*MisbehaviorRegistryMockAddReportParams{}
+
+	m.ForgiveMock = mMisbehaviorRegistryMockForgive{mock: m}
+	m.ForgiveMock.callArgs = []*MisbehaviorRegistryMockForgiveParams{}
+
+	m.IsBlacklistedMock = mMisbehaviorRegistryMockIsBlacklisted{mock: m}
+	m.IsBlacklistedMock.callArgs = []*MisbehaviorRegistryMockIsBlacklistedParams{}
+
+	m.IsQuarantinedMock = mMisbehaviorRegistryMockIsQuarantined{mock: m}
+	m.IsQuarantinedMock.callArgs = []*MisbehaviorRegistryMockIsQuarantinedParams{}
+
+	m.OffendersMock = mMisbehaviorRegistryMockOffenders{mock: m}
+
+	m.ReportValidationMismatchMock = mMisbehaviorRegistryMockReportValidationMismatch{mock: m}
+	m.ReportValidationMismatchMock.callArgs = []*MisbehaviorRegistryMockReportValidationMismatchParams{}
+
+	return m
+}
+
+type mMisbehaviorRegistryMockAddReport struct {
+	mock               *MisbehaviorRegistryMock
+	defaultExpectation *MisbehaviorRegistryMockAddReportExpectation
+	expectations       []*MisbehaviorRegistryMockAddReportExpectation
+
+	callArgs []*MisbehaviorRegistryMockAddReportParams
+	mutex    sync.RWMutex
+}
+
+// MisbehaviorRegistryMockAddReportExpectation specifies expectation struct of the MisbehaviorRegistry.AddReport
+type MisbehaviorRegistryMockAddReportExpectation struct {
+	mock   *MisbehaviorRegistryMock
+	params *MisbehaviorRegistryMockAddReportParams
+
+	Counter uint64
+}
+
+// MisbehaviorRegistryMockAddReportParams contains parameters of the MisbehaviorRegistry.AddReport
+type MisbehaviorRegistryMockAddReportParams struct {
+	report misbehavior.Report
+}
+
+// Expect sets up expected params for MisbehaviorRegistry.AddReport
+func (mmAddReport *mMisbehaviorRegistryMockAddReport) Expect(report misbehavior.Report) *mMisbehaviorRegistryMockAddReport {
+	if mmAddReport.mock.funcAddReport != nil {
+		mmAddReport.mock.t.Fatalf("MisbehaviorRegistryMock.AddReport mock is already set by Set")
+	}
+
+	if mmAddReport.defaultExpectation == nil {
+		mmAddReport.defaultExpectation = &MisbehaviorRegistryMockAddReportExpectation{}
+	}
+
+	mmAddReport.defaultExpectation.params = &MisbehaviorRegistryMockAddReportParams{report}
+	for _, e := range mmAddReport.expectations {
+		if minimock.Equal(e.params, mmAddReport.defaultExpectation.params) {
+			mmAddReport.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAddReport.defaultExpectation.params)
+		}
+	}
+
+	return mmAddReport
+}
+
+// Inspect accepts an inspector function that has same arguments as the MisbehaviorRegistry.AddReport
+func (mmAddReport *mMisbehaviorRegistryMockAddReport) Inspect(f func(report misbehavior.Report)) *mMisbehaviorRegistryMockAddReport {
+	if mmAddReport.mock.inspectFuncAddReport != nil {
+		mmAddReport.mock.t.Fatalf("Inspect function is already set for MisbehaviorRegistryMock.AddReport")
+	}
+
+	mmAddReport.mock.inspectFuncAddReport = f
+
+	return mmAddReport
+}
+
+// Return sets up results that will be returned by MisbehaviorRegistry.AddReport
+func (mmAddReport *mMisbehaviorRegistryMockAddReport) Return() *MisbehaviorRegistryMock {
+	if mmAddReport.mock.funcAddReport != nil {
+		mmAddReport.mock.t.Fatalf("MisbehaviorRegistryMock.AddReport mock is already set by Set")
+	}
+
+	if mmAddReport.defaultExpectation == nil {
+		mmAddReport.defaultExpectation = &MisbehaviorRegistryMockAddReportExpectation{mock: mmAddReport.mock}
+	}
+
+	return mmAddReport.mock
+}
+
+// Set uses given function f to mock the MisbehaviorRegistry.AddReport method
+func (mmAddReport *mMisbehaviorRegistryMockAddReport) Set(f func(report misbehavior.Report)) *MisbehaviorRegistryMock {
+	if mmAddReport.defaultExpectation != nil {
+		mmAddReport.mock.t.Fatalf("Default expectation is already set for the MisbehaviorRegistry.AddReport method")
+	}
+
+	if len(mmAddReport.expectations) > 0 {
+		mmAddReport.mock.t.Fatalf("Some expectations are already set for the MisbehaviorRegistry.AddReport method")
+	}
+
+	mmAddReport.mock.funcAddReport = f
+	return mmAddReport.mock
+}
+
+// AddReport implements network.MisbehaviorRegistry
+func (mmAddReport *MisbehaviorRegistryMock) AddReport(report misbehavior.Report) {
+	mm_atomic.AddUint64(&mmAddReport.beforeAddReportCounter, 1)
+	defer mm_atomic.AddUint64(&mmAddReport.afterAddReportCounter, 1)
+
+	if mmAddReport.inspectFuncAddReport != nil {
+		mmAddReport.inspectFuncAddReport(report)
+	}
+
+	mm_params := &MisbehaviorRegistryMockAddReportParams{report}
+
+	// Record call args
+	mmAddReport.AddReportMock.mutex.Lock()
+	mmAddReport.AddReportMock.callArgs = append(mmAddReport.AddReportMock.callArgs, mm_params)
+	mmAddReport.AddReportMock.mutex.Unlock()
+
+	for _, e := range mmAddReport.AddReportMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return
+		}
+	}
+
+	if mmAddReport.AddReportMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAddReport.AddReportMock.defaultExpectation.Counter, 1)
+		mm_want := mmAddReport.AddReportMock.defaultExpectation.params
+		mm_got := MisbehaviorRegistryMockAddReportParams{report}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAddReport.t.Errorf("MisbehaviorRegistryMock.AddReport got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		return
+
+	}
+	if mmAddReport.funcAddReport != nil {
+		mmAddReport.funcAddReport(report)
+		return
+	}
+	mmAddReport.t.Fatalf("Unexpected call to MisbehaviorRegistryMock.AddReport. %v", report)
+
+}
+
+// AddReportAfterCounter returns a count of finished MisbehaviorRegistryMock.AddReport invocations
+func (mmAddReport *MisbehaviorRegistryMock) AddReportAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddReport.afterAddReportCounter)
+}
+
+// AddReportBeforeCounter returns a count of MisbehaviorRegistryMock.AddReport invocations
+func (mmAddReport *MisbehaviorRegistryMock) AddReportBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddReport.beforeAddReportCounter)
+}
+
+// Calls returns a list of arguments used in each call to MisbehaviorRegistryMock.AddReport.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAddReport *mMisbehaviorRegistryMockAddReport) Calls() []*MisbehaviorRegistryMockAddReportParams {
+	mmAddReport.mutex.RLock()
+
+	argCopy := make([]*MisbehaviorRegistryMockAddReportParams, len(mmAddReport.callArgs))
+	copy(argCopy, mmAddReport.callArgs)
+
+	mmAddReport.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAddReportDone returns true if the count of the AddReport invocations corresponds
+// the number of defined expectations
+func (m *MisbehaviorRegistryMock) MinimockAddReportDone() bool {
+	for _, e := range m.AddReportMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AddReportMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterAddReportCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAddReport != nil && mm_atomic.LoadUint64(&m.afterAddReportCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockAddReportInspect logs each unmet expectation
+func (m *MisbehaviorRegistryMock) MinimockAddReportInspect() {
+	for _, e := range m.AddReportMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to MisbehaviorRegistryMock.AddReport with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AddReportMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterAddReportCounter) < 1 {
+		if m.AddReportMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to MisbehaviorRegistryMock.AddReport")
+		} else {
+			m.t.Errorf("Expected call to MisbehaviorRegistryMock.AddReport with params: %#v", *m.AddReportMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAddReport != nil && mm_atomic.LoadUint64(&m.afterAddReportCounter) < 1 {
+		m.t.Error("Expected call to MisbehaviorRegistryMock.AddReport")
+	}
+}
+
+type mMisbehaviorRegistryMockForgive struct {
+	mock               *MisbehaviorRegistryMock
+	defaultExpectation *MisbehaviorRegistryMockForgiveExpectation
+	expectations       []*MisbehaviorRegistryMockForgiveExpectation
+
+	callArgs []*MisbehaviorRegistryMockForgiveParams
+	mutex    sync.RWMutex
+}
+
+// MisbehaviorRegistryMockForgiveExpectation specifies expectation struct of the MisbehaviorRegistry.Forgive
+type MisbehaviorRegistryMockForgiveExpectation struct {
+	mock    *MisbehaviorRegistryMock
+	params  *MisbehaviorRegistryMockForgiveParams
+	results *MisbehaviorRegistryMockForgiveResults
+	Counter uint64
+}
+
+// MisbehaviorRegistryMockForgiveParams contains parameters of the MisbehaviorRegistry.Forgive
+type MisbehaviorRegistryMockForgiveParams struct {
+	ref insolar.Reference
+}
+
+// MisbehaviorRegistryMockForgiveResults contains results of the MisbehaviorRegistry.Forgive
+type MisbehaviorRegistryMockForgiveResults struct {
+	b1 bool
+}
+
+// Expect sets up expected params for MisbehaviorRegistry.Forgive
+func (mmForgive *mMisbehaviorRegistryMockForgive) Expect(ref insolar.Reference) *mMisbehaviorRegistryMockForgive {
+	if mmForgive.mock.funcForgive != nil {
+		mmForgive.mock.t.Fatalf("MisbehaviorRegistryMock.Forgive mock is already set by Set")
+	}
+
+	if mmForgive.defaultExpectation == nil {
+		mmForgive.defaultExpectation = &MisbehaviorRegistryMockForgiveExpectation{}
+	}
+
+	mmForgive.defaultExpectation.params = &MisbehaviorRegistryMockForgiveParams{ref}
+	for _, e := range mmForgive.expectations {
+		if minimock.Equal(e.params, mmForgive.defaultExpectation.params) {
+			mmForgive.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmForgive.defaultExpectation.params)
+		}
+	}
+
+	return mmForgive
+}
+
+// Inspect accepts an inspector function that has same arguments as the MisbehaviorRegistry.Forgive
+func (mmForgive *mMisbehaviorRegistryMockForgive) Inspect(f func(ref insolar.Reference)) *mMisbehaviorRegistryMockForgive {
+	if mmForgive.mock.inspectFuncForgive != nil {
+		mmForgive.mock.t.Fatalf("Inspect function is already set for MisbehaviorRegistryMock.Forgive")
+	}
+
+	mmForgive.mock.inspectFuncForgive = f
+
+	return mmForgive
+}
+
+// Return sets up results that will be returned by MisbehaviorRegistry.Forgive
+func (mmForgive *mMisbehaviorRegistryMockForgive) Return(b1 bool) *MisbehaviorRegistryMock {
+	if mmForgive.mock.funcForgive != nil {
+		mmForgive.mock.t.Fatalf("MisbehaviorRegistryMock.Forgive mock is already set by Set")
+	}
+
+	if mmForgive.defaultExpectation == nil {
+		mmForgive.defaultExpectation = &MisbehaviorRegistryMockForgiveExpectation{mock: mmForgive.mock}
+	}
+	mmForgive.defaultExpectation.results = &MisbehaviorRegistryMockForgiveResults{b1}
+	return mmForgive.mock
+}
+
+// Set uses given function f to mock the MisbehaviorRegistry.Forgive method
+func (mmForgive *mMisbehaviorRegistryMockForgive) Set(f func(ref insolar.Reference) (b1 bool)) *MisbehaviorRegistryMock {
+	if mmForgive.defaultExpectation != nil {
+		mmForgive.mock.t.Fatalf("Default expectation is already set for the MisbehaviorRegistry.Forgive method")
+	}
+
+	if len(mmForgive.expectations) > 0 {
+		mmForgive.mock.t.Fatalf("Some expectations are already set for the MisbehaviorRegistry.Forgive method")
+	}
+
+	mmForgive.mock.funcForgive = f
+	return mmForgive.mock
+}
+
+// When sets expectation for the MisbehaviorRegistry.Forgive which will trigger the result defined by the following
+// Then helper
+func (mmForgive *mMisbehaviorRegistryMockForgive) When(ref insolar.Reference) *MisbehaviorRegistryMockForgiveExpectation {
+	if mmForgive.mock.funcForgive != nil {
+		mmForgive.mock.t.Fatalf("MisbehaviorRegistryMock.Forgive mock is already set by Set")
+	}
+
+	expectation := &MisbehaviorRegistryMockForgiveExpectation{
+		mock:   mmForgive.mock,
+		params: &MisbehaviorRegistryMockForgiveParams{ref},
+	}
+	mmForgive.expectations = append(mmForgive.expectations, expectation)
+	return expectation
+}
+
+// Then sets up MisbehaviorRegistry.Forgive return parameters for the expectation previously defined by the When method
+func (e *MisbehaviorRegistryMockForgiveExpectation) Then(b1 bool) *MisbehaviorRegistryMock {
+	e.results = &MisbehaviorRegistryMockForgiveResults{b1}
+	return e.mock
+}
+
+// Forgive implements network.MisbehaviorRegistry
+func (mmForgive *MisbehaviorRegistryMock) Forgive(ref insolar.Reference) (b1 bool) {
+	mm_atomic.AddUint64(&mmForgive.beforeForgiveCounter, 1)
+	defer mm_atomic.AddUint64(&mmForgive.afterForgiveCounter, 1)
+
+	if mmForgive.inspectFuncForgive != nil {
+		mmForgive.inspectFuncForgive(ref)
+	}
+
+	mm_params := &MisbehaviorRegistryMockForgiveParams{ref}
+
+	// Record call args
+	mmForgive.ForgiveMock.mutex.Lock()
+	mmForgive.ForgiveMock.callArgs = append(mmForgive.ForgiveMock.callArgs, mm_params)
+	mmForgive.ForgiveMock.mutex.Unlock()
+
+	for _, e := range mmForgive.ForgiveMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1
+		}
+	}
+
+	if mmForgive.ForgiveMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmForgive.ForgiveMock.defaultExpectation.Counter, 1)
+		mm_want := mmForgive.ForgiveMock.defaultExpectation.params
+		mm_got := MisbehaviorRegistryMockForgiveParams{ref}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmForgive.t.Errorf("MisbehaviorRegistryMock.Forgive got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmForgive.ForgiveMock.defaultExpectation.results
+		if mm_results == nil {
+			mmForgive.t.Fatal("No results are set for the MisbehaviorRegistryMock.Forgive")
+		}
+		return (*mm_results).b1
+	}
+	if mmForgive.funcForgive != nil {
+		return mmForgive.funcForgive(ref)
+	}
+	mmForgive.t.Fatalf("Unexpected call to MisbehaviorRegistryMock.Forgive. %v", ref)
+	return
+}
+
+// ForgiveAfterCounter returns a count of finished MisbehaviorRegistryMock.Forgive invocations
+func (mmForgive *MisbehaviorRegistryMock) ForgiveAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmForgive.afterForgiveCounter)
+}
+
+// ForgiveBeforeCounter returns a count of MisbehaviorRegistryMock.Forgive invocations
+func (mmForgive *MisbehaviorRegistryMock) ForgiveBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmForgive.beforeForgiveCounter)
+}
+
+// Calls returns a list of arguments used in each call to MisbehaviorRegistryMock.Forgive.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmForgive *mMisbehaviorRegistryMockForgive) Calls() []*MisbehaviorRegistryMockForgiveParams {
+	mmForgive.mutex.RLock()
+
+	argCopy := make([]*MisbehaviorRegistryMockForgiveParams, len(mmForgive.callArgs))
+	copy(argCopy, mmForgive.callArgs)
+
+	mmForgive.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockForgiveDone returns true if the count of the Forgive invocations corresponds
+// the number of defined expectations
+func (m *MisbehaviorRegistryMock) MinimockForgiveDone() bool {
+	for _, e := range m.ForgiveMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ForgiveMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterForgiveCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcForgive != nil && mm_atomic.LoadUint64(&m.afterForgiveCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockForgiveInspect logs each unmet expectation
+func (m *MisbehaviorRegistryMock) MinimockForgiveInspect() {
+	for _, e := range m.ForgiveMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to MisbehaviorRegistryMock.Forgive with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ForgiveMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterForgiveCounter) < 1 {
+		if m.ForgiveMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to MisbehaviorRegistryMock.Forgive")
+		} else {
+			m.t.Errorf("Expected call to MisbehaviorRegistryMock.Forgive with params: %#v", *m.ForgiveMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcForgive != nil && mm_atomic.LoadUint64(&m.afterForgiveCounter) < 1 {
+		m.t.Error("Expected call to MisbehaviorRegistryMock.Forgive")
+	}
+}
+
+type mMisbehaviorRegistryMockIsBlacklisted struct {
+	mock               *MisbehaviorRegistryMock
+	defaultExpectation *MisbehaviorRegistryMockIsBlacklistedExpectation
+	expectations       []*MisbehaviorRegistryMockIsBlacklistedExpectation
+
+	callArgs []*MisbehaviorRegistryMockIsBlacklistedParams
+	mutex    sync.RWMutex
+}
+
+// MisbehaviorRegistryMockIsBlacklistedExpectation specifies expectation struct of the MisbehaviorRegistry.IsBlacklisted
+type MisbehaviorRegistryMockIsBlacklistedExpectation struct {
+	mock    *MisbehaviorRegistryMock
+	params  *MisbehaviorRegistryMockIsBlacklistedParams
+	results *MisbehaviorRegistryMockIsBlacklistedResults
+	Counter uint64
+}
+
+// MisbehaviorRegistryMockIsBlacklistedParams contains parameters of the MisbehaviorRegistry.IsBlacklisted
+type MisbehaviorRegistryMockIsBlacklistedParams struct {
+	ref insolar.Reference
+}
+
+// MisbehaviorRegistryMockIsBlacklistedResults contains results of the MisbehaviorRegistry.IsBlacklisted
+type MisbehaviorRegistryMockIsBlacklistedResults struct {
+	b1 bool
+}
+
+// Expect sets up expected params for MisbehaviorRegistry.IsBlacklisted
+func (mmIsBlacklisted *mMisbehaviorRegistryMockIsBlacklisted) Expect(ref insolar.Reference) *mMisbehaviorRegistryMockIsBlacklisted {
+	if mmIsBlacklisted.mock.funcIsBlacklisted != nil {
+		mmIsBlacklisted.mock.t.Fatalf("MisbehaviorRegistryMock.IsBlacklisted mock is already set by Set")
+	}
+
+	if mmIsBlacklisted.defaultExpectation == nil {
+		mmIsBlacklisted.defaultExpectation = &MisbehaviorRegistryMockIsBlacklistedExpectation{}
+	}
+
+	mmIsBlacklisted.defaultExpectation.params = &MisbehaviorRegistryMockIsBlacklistedParams{ref}
+	for _, e := range mmIsBlacklisted.expectations {
+		if minimock.Equal(e.params, mmIsBlacklisted.defaultExpectation.params) {
+			mmIsBlacklisted.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmIsBlacklisted.defaultExpectation.params)
+		}
+	}
+
+	return mmIsBlacklisted
+}
+
+// Inspect accepts an inspector function that has same arguments as the MisbehaviorRegistry.IsBlacklisted
+func (mmIsBlacklisted *mMisbehaviorRegistryMockIsBlacklisted) Inspect(f func(ref insolar.Reference)) *mMisbehaviorRegistryMockIsBlacklisted {
+	if mmIsBlacklisted.mock.inspectFuncIsBlacklisted != nil {
+		mmIsBlacklisted.mock.t.Fatalf("Inspect function is already set for MisbehaviorRegistryMock.IsBlacklisted")
+	}
+
+	mmIsBlacklisted.mock.inspectFuncIsBlacklisted = f
+
+	return mmIsBlacklisted
+}
+
+// Return sets up results that will be returned by MisbehaviorRegistry.IsBlacklisted
+func (mmIsBlacklisted *mMisbehaviorRegistryMockIsBlacklisted) Return(b1 bool) *MisbehaviorRegistryMock {
+	if mmIsBlacklisted.mock.funcIsBlacklisted != nil {
+		mmIsBlacklisted.mock.t.Fatalf("MisbehaviorRegistryMock.IsBlacklisted mock is already set by Set")
+	}
+
+	if mmIsBlacklisted.defaultExpectation == nil {
+		mmIsBlacklisted.defaultExpectation = &MisbehaviorRegistryMockIsBlacklistedExpectation{mock: mmIsBlacklisted.mock}
+	}
+	mmIsBlacklisted.defaultExpectation.results = &MisbehaviorRegistryMockIsBlacklistedResults{b1}
+	return mmIsBlacklisted.mock
+}
+
+// Set uses given function f to mock the MisbehaviorRegistry.IsBlacklisted method
+func (mmIsBlacklisted *mMisbehaviorRegistryMockIsBlacklisted) Set(f func(ref insolar.Reference) (b1 bool)) *MisbehaviorRegistryMock {
+	if mmIsBlacklisted.defaultExpectation != nil {
+		mmIsBlacklisted.mock.t.Fatalf("Default expectation is already set for the MisbehaviorRegistry.IsBlacklisted method")
+	}
+
+	if len(mmIsBlacklisted.expectations) > 0 {
+		mmIsBlacklisted.mock.t.Fatalf("Some expectations are already set for the MisbehaviorRegistry.IsBlacklisted method")
+	}
+
+	mmIsBlacklisted.mock.funcIsBlacklisted = f
+	return mmIsBlacklisted.mock
+}
+
+// When sets expectation for the MisbehaviorRegistry.IsBlacklisted which will trigger the result defined by the following
+// Then helper
+func (mmIsBlacklisted *mMisbehaviorRegistryMockIsBlacklisted) When(ref insolar.Reference) *MisbehaviorRegistryMockIsBlacklistedExpectation {
+	if mmIsBlacklisted.mock.funcIsBlacklisted != nil {
+		mmIsBlacklisted.mock.t.Fatalf("MisbehaviorRegistryMock.IsBlacklisted mock is already set by Set")
+	}
+
+	expectation := &MisbehaviorRegistryMockIsBlacklistedExpectation{
+		mock:   mmIsBlacklisted.mock,
+		params: &MisbehaviorRegistryMockIsBlacklistedParams{ref},
+	}
+	mmIsBlacklisted.expectations = append(mmIsBlacklisted.expectations, expectation)
+	return expectation
+}
+
+// Then sets up MisbehaviorRegistry.IsBlacklisted return parameters for the expectation previously defined by the When method
+func (e *MisbehaviorRegistryMockIsBlacklistedExpectation) Then(b1 bool) *MisbehaviorRegistryMock {
+	e.results = &MisbehaviorRegistryMockIsBlacklistedResults{b1}
+	return e.mock
+}
+
+// IsBlacklisted implements network.MisbehaviorRegistry
+func (mmIsBlacklisted *MisbehaviorRegistryMock) IsBlacklisted(ref insolar.Reference) (b1 bool) {
+	mm_atomic.AddUint64(&mmIsBlacklisted.beforeIsBlacklistedCounter, 1)
+	defer mm_atomic.AddUint64(&mmIsBlacklisted.afterIsBlacklistedCounter, 1)
+
+	if mmIsBlacklisted.inspectFuncIsBlacklisted != nil {
+		mmIsBlacklisted.inspectFuncIsBlacklisted(ref)
+	}
+
+	mm_params := &MisbehaviorRegistryMockIsBlacklistedParams{ref}
+
+	// Record call args
+	mmIsBlacklisted.IsBlacklistedMock.mutex.Lock()
+	mmIsBlacklisted.IsBlacklistedMock.callArgs = append(mmIsBlacklisted.IsBlacklistedMock.callArgs, mm_params)
+	mmIsBlacklisted.IsBlacklistedMock.mutex.Unlock()
+
+	for _, e := range mmIsBlacklisted.IsBlacklistedMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1
+		}
+	}
+
+	if mmIsBlacklisted.IsBlacklistedMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmIsBlacklisted.IsBlacklistedMock.defaultExpectation.Counter, 1)
+		mm_want := mmIsBlacklisted.IsBlacklistedMock.defaultExpectation.params
+		mm_got := MisbehaviorRegistryMockIsBlacklistedParams{ref}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmIsBlacklisted.t.Errorf("MisbehaviorRegistryMock.IsBlacklisted got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmIsBlacklisted.IsBlacklistedMock.defaultExpectation.results
+		if mm_results == nil {
+			mmIsBlacklisted.t.Fatal("No results are set for the MisbehaviorRegistryMock.IsBlacklisted")
+		}
+		return (*mm_results).b1
+	}
+	if mmIsBlacklisted.funcIsBlacklisted != nil {
+		return mmIsBlacklisted.funcIsBlacklisted(ref)
+	}
+	mmIsBlacklisted.t.Fatalf("Unexpected call to MisbehaviorRegistryMock.IsBlacklisted. %v", ref)
+	return
+}
+
+// IsBlacklistedAfterCounter returns a count of finished MisbehaviorRegistryMock.IsBlacklisted invocations
+func (mmIsBlacklisted *MisbehaviorRegistryMock) IsBlacklistedAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmIsBlacklisted.afterIsBlacklistedCounter)
+}
+
+// IsBlacklistedBeforeCounter returns a count of MisbehaviorRegistryMock.IsBlacklisted invocations
+func (mmIsBlacklisted *MisbehaviorRegistryMock) IsBlacklistedBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmIsBlacklisted.beforeIsBlacklistedCounter)
+}
+
+// Calls returns a list of arguments used in each call to MisbehaviorRegistryMock.IsBlacklisted.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmIsBlacklisted *mMisbehaviorRegistryMockIsBlacklisted) Calls() []*MisbehaviorRegistryMockIsBlacklistedParams {
+	mmIsBlacklisted.mutex.RLock()
+
+	argCopy := make([]*MisbehaviorRegistryMockIsBlacklistedParams, len(mmIsBlacklisted.callArgs))
+	copy(argCopy, mmIsBlacklisted.callArgs)
+
+	mmIsBlacklisted.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockIsBlacklistedDone returns true if the count of the IsBlacklisted invocations corresponds
+// the number of defined expectations
+func (m *MisbehaviorRegistryMock) MinimockIsBlacklistedDone() bool {
+	for _, e := range m.IsBlacklistedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.IsBlacklistedMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterIsBlacklistedCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcIsBlacklisted != nil && mm_atomic.LoadUint64(&m.afterIsBlacklistedCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockIsBlacklistedInspect logs each unmet expectation
+func (m *MisbehaviorRegistryMock) MinimockIsBlacklistedInspect() {
+	for _, e := range m.IsBlacklistedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to MisbehaviorRegistryMock.IsBlacklisted with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.IsBlacklistedMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterIsBlacklistedCounter) < 1 {
+		if m.IsBlacklistedMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to MisbehaviorRegistryMock.IsBlacklisted")
+		} else {
+			m.t.Errorf("Expected call to MisbehaviorRegistryMock.IsBlacklisted with params: %#v", *m.IsBlacklistedMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcIsBlacklisted != nil && mm_atomic.LoadUint64(&m.afterIsBlacklistedCounter) < 1 {
+		m.t.Error("Expected call to MisbehaviorRegistryMock.IsBlacklisted")
+	}
+}
+
+type mMisbehaviorRegistryMockIsQuarantined struct {
+	mock               *MisbehaviorRegistryMock
+	defaultExpectation *MisbehaviorRegistryMockIsQuarantinedExpectation
+	expectations       []*MisbehaviorRegistryMockIsQuarantinedExpectation
+
+	callArgs []*MisbehaviorRegistryMockIsQuarantinedParams
+	mutex    sync.RWMutex
+}
+
+// MisbehaviorRegistryMockIsQuarantinedExpectation specifies expectation struct of the MisbehaviorRegistry.IsQuarantined
+type MisbehaviorRegistryMockIsQuarantinedExpectation struct {
+	mock    *MisbehaviorRegistryMock
+	params  *MisbehaviorRegistryMockIsQuarantinedParams
+	results *MisbehaviorRegistryMockIsQuarantinedResults
+	Counter uint64
+}
+
+// MisbehaviorRegistryMockIsQuarantinedParams contains parameters of the MisbehaviorRegistry.IsQuarantined
+type MisbehaviorRegistryMockIsQuarantinedParams struct {
+	ref insolar.Reference
+}
+
+// MisbehaviorRegistryMockIsQuarantinedResults contains results of the MisbehaviorRegistry.IsQuarantined
+type MisbehaviorRegistryMockIsQuarantinedResults struct {
+	b1 bool
+}
+
+// Expect sets up expected params for MisbehaviorRegistry.IsQuarantined
+func (mmIsQuarantined *mMisbehaviorRegistryMockIsQuarantined) Expect(ref insolar.Reference) *mMisbehaviorRegistryMockIsQuarantined {
+	if mmIsQuarantined.mock.funcIsQuarantined != nil {
+		mmIsQuarantined.mock.t.Fatalf("MisbehaviorRegistryMock.IsQuarantined mock is already set by Set")
+	}
+
+	if mmIsQuarantined.defaultExpectation == nil {
+		mmIsQuarantined.defaultExpectation = &MisbehaviorRegistryMockIsQuarantinedExpectation{}
+	}
+
+	mmIsQuarantined.defaultExpectation.params = &MisbehaviorRegistryMockIsQuarantinedParams{ref}
+	for _, e := range mmIsQuarantined.expectations {
+		if minimock.Equal(e.params, mmIsQuarantined.defaultExpectation.params) {
+			mmIsQuarantined.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmIsQuarantined.defaultExpectation.params)
+		}
+	}
+
+	return mmIsQuarantined
+}
+
+// Inspect accepts an inspector function that has same arguments as the MisbehaviorRegistry.IsQuarantined
+func (mmIsQuarantined *mMisbehaviorRegistryMockIsQuarantined) Inspect(f func(ref insolar.Reference)) *mMisbehaviorRegistryMockIsQuarantined {
+	if mmIsQuarantined.mock.inspectFuncIsQuarantined != nil {
+		mmIsQuarantined.mock.t.Fatalf("Inspect function is already set for MisbehaviorRegistryMock.IsQuarantined")
+	}
+
+	mmIsQuarantined.mock.inspectFuncIsQuarantined = f
+
+	return mmIsQuarantined
+}
+
+// Return sets up results that will be returned by MisbehaviorRegistry.IsQuarantined
+func (mmIsQuarantined *mMisbehaviorRegistryMockIsQuarantined) Return(b1 bool) *MisbehaviorRegistryMock {
+	if mmIsQuarantined.mock.funcIsQuarantined != nil {
+		mmIsQuarantined.mock.t.Fatalf("MisbehaviorRegistryMock.IsQuarantined mock is already set by Set")
+	}
+
+	if mmIsQuarantined.defaultExpectation == nil {
+		mmIsQuarantined.defaultExpectation = &MisbehaviorRegistryMockIsQuarantinedExpectation{mock: mmIsQuarantined.mock}
+	}
+	mmIsQuarantined.defaultExpectation.results = &MisbehaviorRegistryMockIsQuarantinedResults{b1}
+	return mmIsQuarantined.mock
+}
+
+// Set uses given function f to mock the MisbehaviorRegistry.IsQuarantined method
+func (mmIsQuarantined *mMisbehaviorRegistryMockIsQuarantined) Set(f func(ref insolar.Reference) (b1 bool)) *MisbehaviorRegistryMock {
+	if mmIsQuarantined.defaultExpectation != nil {
+		mmIsQuarantined.mock.t.Fatalf("Default expectation is already set for the MisbehaviorRegistry.IsQuarantined method")
+	}
+
+	if len(mmIsQuarantined.expectations) > 0 {
+		mmIsQuarantined.mock.t.Fatalf("Some expectations are already set for the MisbehaviorRegistry.IsQuarantined method")
+	}
+
+	mmIsQuarantined.mock.funcIsQuarantined = f
+	return mmIsQuarantined.mock
+}
+
+// When sets expectation for the MisbehaviorRegistry.IsQuarantined which will trigger the result defined by the following
+// Then helper
+func (mmIsQuarantined *mMisbehaviorRegistryMockIsQuarantined) When(ref insolar.Reference) *MisbehaviorRegistryMockIsQuarantinedExpectation {
+	if mmIsQuarantined.mock.funcIsQuarantined != nil {
+		mmIsQuarantined.mock.t.Fatalf("MisbehaviorRegistryMock.IsQuarantined mock is already set by Set")
+	}
+
+	expectation := &MisbehaviorRegistryMockIsQuarantinedExpectation{
+		mock:   mmIsQuarantined.mock,
+		params: &MisbehaviorRegistryMockIsQuarantinedParams{ref},
+	}
+	mmIsQuarantined.expectations = append(mmIsQuarantined.expectations, expectation)
+	return expectation
+}
+
+// Then sets up MisbehaviorRegistry.IsQuarantined return parameters for the expectation previously defined by the When method
+func (e *MisbehaviorRegistryMockIsQuarantinedExpectation) Then(b1 bool) *MisbehaviorRegistryMock {
+	e.results = &MisbehaviorRegistryMockIsQuarantinedResults{b1}
+	return e.mock
+}
+
+// IsQuarantined implements network.MisbehaviorRegistry
+func (mmIsQuarantined *MisbehaviorRegistryMock) IsQuarantined(ref insolar.Reference) (b1 bool) {
+	mm_atomic.AddUint64(&mmIsQuarantined.beforeIsQuarantinedCounter, 1)
+	defer mm_atomic.AddUint64(&mmIsQuarantined.afterIsQuarantinedCounter, 1)
+
+	if mmIsQuarantined.inspectFuncIsQuarantined != nil {
+		mmIsQuarantined.inspectFuncIsQuarantined(ref)
+	}
+
+	mm_params := &MisbehaviorRegistryMockIsQuarantinedParams{ref}
+
+	// Record call args
+	mmIsQuarantined.IsQuarantinedMock.mutex.Lock()
+	mmIsQuarantined.IsQuarantinedMock.callArgs = append(mmIsQuarantined.IsQuarantinedMock.callArgs, mm_params)
+	mmIsQuarantined.IsQuarantinedMock.mutex.Unlock()
+
+	for _, e := range mmIsQuarantined.IsQuarantinedMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1
+		}
+	}
+
+	if mmIsQuarantined.IsQuarantinedMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmIsQuarantined.IsQuarantinedMock.defaultExpectation.Counter, 1)
+		mm_want := mmIsQuarantined.IsQuarantinedMock.defaultExpectation.params
+		mm_got := MisbehaviorRegistryMockIsQuarantinedParams{ref}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmIsQuarantined.t.Errorf("MisbehaviorRegistryMock.IsQuarantined got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmIsQuarantined.IsQuarantinedMock.defaultExpectation.results
+		if mm_results == nil {
+			mmIsQuarantined.t.Fatal("No results are set for the MisbehaviorRegistryMock.IsQuarantined")
+		}
+		return (*mm_results).b1
+	}
+	if mmIsQuarantined.funcIsQuarantined != nil {
+		return mmIsQuarantined.funcIsQuarantined(ref)
+	}
+	mmIsQuarantined.t.Fatalf("Unexpected call to MisbehaviorRegistryMock.IsQuarantined. %v", ref)
+	return
+}
+
+// IsQuarantinedAfterCounter returns a count of finished MisbehaviorRegistryMock.IsQuarantined invocations
+func (mmIsQuarantined *MisbehaviorRegistryMock) IsQuarantinedAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmIsQuarantined.afterIsQuarantinedCounter)
+}
+
+// IsQuarantinedBeforeCounter returns a count of MisbehaviorRegistryMock.IsQuarantined invocations
+func (mmIsQuarantined *MisbehaviorRegistryMock) IsQuarantinedBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmIsQuarantined.beforeIsQuarantinedCounter)
+}
+
+// Calls returns a list of arguments used in each call to MisbehaviorRegistryMock.IsQuarantined.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmIsQuarantined *mMisbehaviorRegistryMockIsQuarantined) Calls() []*MisbehaviorRegistryMockIsQuarantinedParams {
+	mmIsQuarantined.mutex.RLock()
+
+	argCopy := make([]*MisbehaviorRegistryMockIsQuarantinedParams, len(mmIsQuarantined.callArgs))
+	copy(argCopy, mmIsQuarantined.callArgs)
+
+	mmIsQuarantined.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockIsQuarantinedDone returns true if the count of the IsQuarantined invocations corresponds
+// the number of defined expectations
+func (m *MisbehaviorRegistryMock) MinimockIsQuarantinedDone() bool {
+	for _, e := range m.IsQuarantinedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.IsQuarantinedMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterIsQuarantinedCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcIsQuarantined != nil && mm_atomic.LoadUint64(&m.afterIsQuarantinedCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockIsQuarantinedInspect logs each unmet expectation
+func (m *MisbehaviorRegistryMock) MinimockIsQuarantinedInspect() {
+	for _, e := range m.IsQuarantinedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to MisbehaviorRegistryMock.IsQuarantined with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.IsQuarantinedMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterIsQuarantinedCounter) < 1 {
+		if m.IsQuarantinedMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to MisbehaviorRegistryMock.IsQuarantined")
+		} else {
+			m.t.Errorf("Expected call to MisbehaviorRegistryMock.IsQuarantined with params: %#v", *m.IsQuarantinedMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcIsQuarantined != nil && mm_atomic.LoadUint64(&m.afterIsQuarantinedCounter) < 1 {
+		m.t.Error("Expected call to MisbehaviorRegistryMock.IsQuarantined")
+	}
+}
+
+type mMisbehaviorRegistryMockOffenders struct {
+	mock               *MisbehaviorRegistryMock
+	defaultExpectation *MisbehaviorRegistryMockOffendersExpectation
+	expectations       []*MisbehaviorRegistryMockOffendersExpectation
+}
+
+// MisbehaviorRegistryMockOffendersExpectation specifies expectation struct of the MisbehaviorRegistry.Offenders
+type MisbehaviorRegistryMockOffendersExpectation struct {
+	mock *MisbehaviorRegistryMock
+
+	results *MisbehaviorRegistryMockOffendersResults
+	Counter uint64
+}
+
+// MisbehaviorRegistryMockOffendersResults contains results of the MisbehaviorRegistry.Offenders
+type MisbehaviorRegistryMockOffendersResults struct {
+	oa1 []mm_network.Offender
+}
+
+// Expect sets up expected params for MisbehaviorRegistry.Offenders
+func (mmOffenders *mMisbehaviorRegistryMockOffenders) Expect() *mMisbehaviorRegistryMockOffenders {
+	if mmOffenders.mock.funcOffenders != nil {
+		mmOffenders.mock.t.Fatalf("MisbehaviorRegistryMock.Offenders mock is already set by Set")
+	}
+
+	if mmOffenders.defaultExpectation == nil {
+		mmOffenders.defaultExpectation = &MisbehaviorRegistryMockOffendersExpectation{}
+	}
+
+	return mmOffenders
+}
+
+// Inspect accepts an inspector function that has same arguments as the MisbehaviorRegistry.Offenders
+func (mmOffenders *mMisbehaviorRegistryMockOffenders) Inspect(f func()) *mMisbehaviorRegistryMockOffenders {
+	if mmOffenders.mock.inspectFuncOffenders != nil {
+		mmOffenders.mock.t.Fatalf("Inspect function is already set for MisbehaviorRegistryMock.Offenders")
+	}
+
+	mmOffenders.mock.inspectFuncOffenders = f
+
+	return mmOffenders
+}
+
+// Return sets up results that will be returned by MisbehaviorRegistry.Offenders
+func (mmOffenders *mMisbehaviorRegistryMockOffenders) Return(oa1 []mm_network.Offender) *MisbehaviorRegistryMock {
+	if mmOffenders.mock.funcOffenders != nil {
+		mmOffenders.mock.t.Fatalf("MisbehaviorRegistryMock.Offenders mock is already set by Set")
+	}
+
+	if mmOffenders.defaultExpectation == nil {
+		mmOffenders.defaultExpectation = &MisbehaviorRegistryMockOffendersExpectation{mock: mmOffenders.mock}
+	}
+	mmOffenders.defaultExpectation.results = &MisbehaviorRegistryMockOffendersResults{oa1}
+	return mmOffenders.mock
+}
+
+// Set uses given function f to mock the MisbehaviorRegistry.Offenders method
+func (mmOffenders *mMisbehaviorRegistryMockOffenders) Set(f func() (oa1 []mm_network.Offender)) *MisbehaviorRegistryMock {
+	if mmOffenders.defaultExpectation != nil {
+		mmOffenders.mock.t.Fatalf("Default expectation is already set for the MisbehaviorRegistry.Offenders method")
+	}
+
+	if len(mmOffenders.expectations) > 0 {
+		mmOffenders.mock.t.Fatalf("Some expectations are already set for the MisbehaviorRegistry.Offenders method")
+	}
+
+	mmOffenders.mock.funcOffenders = f
+	return mmOffenders.mock
+}
+
+// Offenders implements network.MisbehaviorRegistry
+func (mmOffenders *MisbehaviorRegistryMock) Offenders() (oa1 []mm_network.Offender) {
+	mm_atomic.AddUint64(&mmOffenders.beforeOffendersCounter, 1)
+	defer mm_atomic.AddUint64(&mmOffenders.afterOffendersCounter, 1)
+
+	if mmOffenders.inspectFuncOffenders != nil {
+		mmOffenders.inspectFuncOffenders()
+	}
+
+	if mmOffenders.OffendersMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmOffenders.OffendersMock.defaultExpectation.Counter, 1)
+
+		mm_results := mmOffenders.OffendersMock.defaultExpectation.results
+		if mm_results == nil {
+			mmOffenders.t.Fatal("No results are set for the MisbehaviorRegistryMock.Offenders")
+		}
+		return (*mm_results).oa1
+	}
+	if mmOffenders.funcOffenders != nil {
+		return mmOffenders.funcOffenders()
+	}
+	mmOffenders.t.Fatalf("Unexpected call to MisbehaviorRegistryMock.Offenders.")
+	return
+}
+
+// OffendersAfterCounter returns a count of finished MisbehaviorRegistryMock.Offenders invocations
+func (mmOffenders *MisbehaviorRegistryMock) OffendersAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmOffenders.afterOffendersCounter)
+}
+
+// OffendersBeforeCounter returns a count of MisbehaviorRegistryMock.Offenders invocations
+func (mmOffenders *MisbehaviorRegistryMock) OffendersBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmOffenders.beforeOffendersCounter)
+}
+
+// MinimockOffendersDone returns true if the count of the Offenders invocations corresponds
+// the number of defined expectations
+func (m *MisbehaviorRegistryMock) MinimockOffendersDone() bool {
+	for _, e := range m.OffendersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.OffendersMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterOffendersCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcOffenders != nil && mm_atomic.LoadUint64(&m.afterOffendersCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockOffendersInspect logs each unmet expectation
+func (m *MisbehaviorRegistryMock) MinimockOffendersInspect() {
+	for _, e := range m.OffendersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Error("Expected call to MisbehaviorRegistryMock.Offenders")
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.OffendersMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterOffendersCounter) < 1 {
+		m.t.Error("Expected call to MisbehaviorRegistryMock.Offenders")
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcOffenders != nil && mm_atomic.LoadUint64(&m.afterOffendersCounter) < 1 {
+		m.t.Error("Expected call to MisbehaviorRegistryMock.Offenders")
+	}
+}
+
+type mMisbehaviorRegistryMockReportValidationMismatch struct {
+	mock               *MisbehaviorRegistryMock
+	defaultExpectation *MisbehaviorRegistryMockReportValidationMismatchExpectation
+	expectations       []*MisbehaviorRegistryMockReportValidationMismatchExpectation
+
+	callArgs []*MisbehaviorRegistryMockReportValidationMismatchParams
+	mutex    sync.RWMutex
+}
+
+// MisbehaviorRegistryMockReportValidationMismatchExpectation specifies expectation struct of the MisbehaviorRegistry.ReportValidationMismatch
+type MisbehaviorRegistryMockReportValidationMismatchExpectation struct {
+	mock   *MisbehaviorRegistryMock
+	params *MisbehaviorRegistryMockReportValidationMismatchParams
+
+	Counter uint64
+}
+
+// MisbehaviorRegistryMockReportValidationMismatchParams contains parameters of the MisbehaviorRegistry.ReportValidationMismatch
+type MisbehaviorRegistryMockReportValidationMismatchParams struct {
+	ref     insolar.Reference
+	details string
+}
+
+// Expect sets up expected params for MisbehaviorRegistry.ReportValidationMismatch
+func (mmReportValidationMismatch *mMisbehaviorRegistryMockReportValidationMismatch) Expect(ref insolar.Reference, details string) *mMisbehaviorRegistryMockReportValidationMismatch {
+	if mmReportValidationMismatch.mock.funcReportValidationMismatch != nil {
+		mmReportValidationMismatch.mock.t.Fatalf("MisbehaviorRegistryMock.ReportValidationMismatch mock is already set by Set")
+	}
+
+	if mmReportValidationMismatch.defaultExpectation == nil {
+		mmReportValidationMismatch.defaultExpectation = &MisbehaviorRegistryMockReportValidationMismatchExpectation{}
+	}
+
+	mmReportValidationMismatch.defaultExpectation.params = &MisbehaviorRegistryMockReportValidationMismatchParams{ref, details}
+	for _, e := range mmReportValidationMismatch.expectations {
+		if minimock.Equal(e.params, mmReportValidationMismatch.defaultExpectation.params) {
+			mmReportValidationMismatch.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmReportValidationMismatch.defaultExpectation.params)
+		}
+	}
+
+	return mmReportValidationMismatch
+}
+
+// Inspect accepts an inspector function that has same arguments as the MisbehaviorRegistry.ReportValidationMismatch
+func (mmReportValidationMismatch *mMisbehaviorRegistryMockReportValidationMismatch) Inspect(f func(ref insolar.Reference, details string)) *mMisbehaviorRegistryMockReportValidationMismatch {
+	if mmReportValidationMismatch.mock.inspectFuncReportValidationMismatch != nil {
+		mmReportValidationMismatch.mock.t.Fatalf("Inspect function is already set for MisbehaviorRegistryMock.ReportValidationMismatch")
+	}
+
+	mmReportValidationMismatch.mock.inspectFuncReportValidationMismatch = f
+
+	return mmReportValidationMismatch
+}
+
+// Return sets up results that will be returned by MisbehaviorRegistry.ReportValidationMismatch
+func (mmReportValidationMismatch *mMisbehaviorRegistryMockReportValidationMismatch) Return() *MisbehaviorRegistryMock {
+	if mmReportValidationMismatch.mock.funcReportValidationMismatch != nil {
+		mmReportValidationMismatch.mock.t.Fatalf("MisbehaviorRegistryMock.ReportValidationMismatch mock is already set by Set")
+	}
+
+	if mmReportValidationMismatch.defaultExpectation == nil {
+		mmReportValidationMismatch.defaultExpectation = &MisbehaviorRegistryMockReportValidationMismatchExpectation{mock: mmReportValidationMismatch.mock}
+	}
+
+	return mmReportValidationMismatch.mock
+}
+
+// Set uses given function f to mock the MisbehaviorRegistry.ReportValidationMismatch method
+func (mmReportValidationMismatch *mMisbehaviorRegistryMockReportValidationMismatch) Set(f func(ref insolar.Reference, details string)) *MisbehaviorRegistryMock {
+	if mmReportValidationMismatch.defaultExpectation != nil {
+		mmReportValidationMismatch.mock.t.Fatalf("Default expectation is already set for the MisbehaviorRegistry.ReportValidationMismatch method")
+	}
+
+	if len(mmReportValidationMismatch.expectations) > 0 {
+		mmReportValidationMismatch.mock.t.Fatalf("Some expectations are already set for the MisbehaviorRegistry.ReportValidationMismatch method")
+	}
+
+	mmReportValidationMismatch.mock.funcReportValidationMismatch = f
+	return mmReportValidationMismatch.mock
+}
+
+// ReportValidationMismatch implements network.MisbehaviorRegistry
+func (mmReportValidationMismatch *MisbehaviorRegistryMock) ReportValidationMismatch(ref insolar.Reference, details string) {
+	mm_atomic.AddUint64(&mmReportValidationMismatch.beforeReportValidationMismatchCounter, 1)
+	defer mm_atomic.AddUint64(&mmReportValidationMismatch.afterReportValidationMismatchCounter, 1)
+
+	if mmReportValidationMismatch.inspectFuncReportValidationMismatch != nil {
+		mmReportValidationMismatch.inspectFuncReportValidationMismatch(ref, details)
+	}
+
+	mm_params := &MisbehaviorRegistryMockReportValidationMismatchParams{ref, details}
+
+	// Record call args
+	mmReportValidationMismatch.ReportValidationMismatchMock.mutex.Lock()
+	mmReportValidationMismatch.ReportValidationMismatchMock.callArgs = append(mmReportValidationMismatch.ReportValidationMismatchMock.callArgs, mm_params)
+	mmReportValidationMismatch.ReportValidationMismatchMock.mutex.Unlock()
+
+	for _, e := range mmReportValidationMismatch.ReportValidationMismatchMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return
+		}
+	}
+
+	if mmReportValidationMismatch.ReportValidationMismatchMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmReportValidationMismatch.ReportValidationMismatchMock.defaultExpectation.Counter, 1)
+		mm_want := mmReportValidationMismatch.ReportValidationMismatchMock.defaultExpectation.params
+		mm_got := MisbehaviorRegistryMockReportValidationMismatchParams{ref, details}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmReportValidationMismatch.t.Errorf("MisbehaviorRegistryMock.ReportValidationMismatch got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		return
+
+	}
+	if mmReportValidationMismatch.funcReportValidationMismatch != nil {
+		mmReportValidationMismatch.funcReportValidationMismatch(ref, details)
+		return
+	}
+	mmReportValidationMismatch.t.Fatalf("Unexpected call to MisbehaviorRegistryMock.ReportValidationMismatch. %v %v", ref, details)
+
+}
+
+// ReportValidationMismatchAfterCounter returns a count of finished MisbehaviorRegistryMock.ReportValidationMismatch invocations
+func (mmReportValidationMismatch *MisbehaviorRegistryMock) ReportValidationMismatchAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReportValidationMismatch.afterReportValidationMismatchCounter)
+}
+
+// ReportValidationMismatchBeforeCounter returns a count of MisbehaviorRegistryMock.ReportValidationMismatch invocations
+func (mmReportValidationMismatch *MisbehaviorRegistryMock) ReportValidationMismatchBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReportValidationMismatch.beforeReportValidationMismatchCounter)
+}
+
+// Calls returns a list of arguments used in each call to MisbehaviorRegistryMock.ReportValidationMismatch.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmReportValidationMismatch *mMisbehaviorRegistryMockReportValidationMismatch) Calls() []*MisbehaviorRegistryMockReportValidationMismatchParams {
+	mmReportValidationMismatch.mutex.RLock()
+
+	argCopy := make([]*MisbehaviorRegistryMockReportValidationMismatchParams, len(mmReportValidationMismatch.callArgs))
+	copy(argCopy, mmReportValidationMismatch.callArgs)
+
+	mmReportValidationMismatch.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockReportValidationMismatchDone returns true if the count of the ReportValidationMismatch invocations corresponds
+// the number of defined expectations
+func (m *MisbehaviorRegistryMock) MinimockReportValidationMismatchDone() bool {
+	for _, e := range m.ReportValidationMismatchMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ReportValidationMismatchMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterReportValidationMismatchCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcReportValidationMismatch != nil && mm_atomic.LoadUint64(&m.afterReportValidationMismatchCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockReportValidationMismatchInspect logs each unmet expectation
+func (m *MisbehaviorRegistryMock) MinimockReportValidationMismatchInspect() {
+	for _, e := range m.ReportValidationMismatchMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to MisbehaviorRegistryMock.ReportValidationMismatch with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ReportValidationMismatchMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterReportValidationMismatchCounter) < 1 {
+		if m.ReportValidationMismatchMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to MisbehaviorRegistryMock.ReportValidationMismatch")
+		} else {
+			m.t.Errorf("Expected call to MisbehaviorRegistryMock.ReportValidationMismatch with params: %#v", *m.ReportValidationMismatchMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcReportValidationMismatch != nil && mm_atomic.LoadUint64(&m.afterReportValidationMismatchCounter) < 1 {
+		m.t.Error("Expected call to MisbehaviorRegistryMock.ReportValidationMismatch")
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *MisbehaviorRegistryMock) MinimockFinish() {
+	if !m.minimockDone() {
+		m.MinimockAddReportInspect()
+
+		m.MinimockForgiveInspect()
+
+		m.MinimockIsBlacklistedInspect()
+
+		m.MinimockIsQuarantinedInspect()
+
+		m.MinimockOffendersInspect()
+
+		m.MinimockReportValidationMismatchInspect()
+		m.t.FailNow()
+	}
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *MisbehaviorRegistryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *MisbehaviorRegistryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockAddReportDone() &&
+		m.MinimockForgiveDone() &&
+		m.MinimockIsBlacklistedDone() &&
+		m.MinimockIsQuarantinedDone() &&
+		m.MinimockOffendersDone() &&
+		m.MinimockReportValidationMismatchDone()
+}
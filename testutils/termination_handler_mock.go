@@ -22,12 +22,37 @@ type TerminationHandlerMock struct {
 	beforeLeaveCounter uint64
 	LeaveMock          mTerminationHandlerMockLeave
 
+	funcLeaveStatus          func(ctx context.Context) (l1 insolar.LeaveStatus)
+	inspectFuncLeaveStatus   func(ctx context.Context)
+	afterLeaveStatusCounter  uint64
+	beforeLeaveStatusCounter uint64
+	LeaveStatusMock          mTerminationHandlerMockLeaveStatus
+
+	funcLeft func() (ch1 <-chan struct {
+	})
+	inspectFuncLeft   func()
+	afterLeftCounter  uint64
+	beforeLeftCounter uint64
+	LeftMock          mTerminationHandlerMockLeft
+
 	funcOnLeaveApproved          func(ctx context.Context)
 	inspectFuncOnLeaveApproved   func(ctx context.Context)
 	afterOnLeaveApprovedCounter  uint64
 	beforeOnLeaveApprovedCounter uint64
 	OnLeaveApprovedMock          mTerminationHandlerMockOnLeaveApproved
 
+	funcOnLeaveTimeout          func(ctx context.Context)
+	inspectFuncOnLeaveTimeout   func(ctx context.Context)
+	afterOnLeaveTimeoutCounter  uint64
+	beforeOnLeaveTimeoutCounter uint64
+	OnLeaveTimeoutMock          mTerminationHandlerMockOnLeaveTimeout
+
+	funcRequestLeave          func(ctx context.Context, p1 insolar.PulseNumber) (err error)
+	inspectFuncRequestLeave   func(ctx context.Context, p1 insolar.PulseNumber)
+	afterRequestLeaveCounter  uint64
+	beforeRequestLeaveCounter uint64
+	RequestLeaveMock          mTerminationHandlerMockRequestLeave
+
 	funcTerminating          func() (b1 bool)
 	inspectFuncTerminating   func()
 	afterTerminatingCounter  uint64
@@ -45,9 +70,20 @@ func NewTerminationHandlerMock(t minimock.Tester) *TerminationHandlerMock {
 	m.LeaveMock = mTerminationHandlerMockLeave{mock: m}
 	m.LeaveMock.callArgs = []*TerminationHandlerMockLeaveParams{}
 
+	m.LeaveStatusMock = mTerminationHandlerMockLeaveStatus{mock: m}
+	m.LeaveStatusMock.callArgs = []*TerminationHandlerMockLeaveStatusParams{}
+
+	m.LeftMock = mTerminationHandlerMockLeft{mock: m}
+
 	m.OnLeaveApprovedMock = mTerminationHandlerMockOnLeaveApproved{mock: m}
 	m.OnLeaveApprovedMock.callArgs = []*TerminationHandlerMockOnLeaveApprovedParams{}
 
+	m.OnLeaveTimeoutMock = mTerminationHandlerMockOnLeaveTimeout{mock: m}
+	m.OnLeaveTimeoutMock.callArgs = []*TerminationHandlerMockOnLeaveTimeoutParams{}
+
+	m.RequestLeaveMock = mTerminationHandlerMockRequestLeave{mock: m}
+	m.RequestLeaveMock.callArgs = []*TerminationHandlerMockRequestLeaveParams{}
+
 	m.TerminatingMock = mTerminationHandlerMockTerminating{mock: m}
 
 	return m
@@ -120,7 +156,7 @@ func (mmLeave *mTerminationHandlerMockLeave) Return() *TerminationHandlerMock {
 	return mmLeave.mock
 }
 
-//Set uses given function f to mock the TerminationHandler.Leave method
+// Set uses given function f to mock the TerminationHandler.Leave method
 func (mmLeave *mTerminationHandlerMockLeave) Set(f func(ctx context.Context, p1 insolar.PulseNumber)) *TerminationHandlerMock {
 	if mmLeave.defaultExpectation != nil {
 		mmLeave.mock.t.Fatalf("Default expectation is already set for the TerminationHandler.Leave method")
@@ -241,6 +277,368 @@ func (m *TerminationHandlerMock) MinimockLeaveInspect() {
 	}
 }
 
+type mTerminationHandlerMockLeaveStatus struct {
+	mock               *TerminationHandlerMock
+	defaultExpectation *TerminationHandlerMockLeaveStatusExpectation
+	expectations       []*TerminationHandlerMockLeaveStatusExpectation
+
+	callArgs []*TerminationHandlerMockLeaveStatusParams
+	mutex    sync.RWMutex
+}
+
+// TerminationHandlerMockLeaveStatusExpectation specifies expectation struct of the TerminationHandler.LeaveStatus
+type TerminationHandlerMockLeaveStatusExpectation struct {
+	mock    *TerminationHandlerMock
+	params  *TerminationHandlerMockLeaveStatusParams
+	results *TerminationHandlerMockLeaveStatusResults
+	Counter uint64
+}
+
+// TerminationHandlerMockLeaveStatusParams contains parameters of the TerminationHandler.LeaveStatus
+type TerminationHandlerMockLeaveStatusParams struct {
+	ctx context.Context
+}
+
+// TerminationHandlerMockLeaveStatusResults contains results of the TerminationHandler.LeaveStatus
+type TerminationHandlerMockLeaveStatusResults struct {
+	l1 insolar.LeaveStatus
+}
+
+// Expect sets up expected params for TerminationHandler.LeaveStatus
+func (mmLeaveStatus *mTerminationHandlerMockLeaveStatus) Expect(ctx context.Context) *mTerminationHandlerMockLeaveStatus {
+	if mmLeaveStatus.mock.funcLeaveStatus != nil {
+		mmLeaveStatus.mock.t.Fatalf("TerminationHandlerMock.LeaveStatus mock is already set by Set")
+	}
+
+	if mmLeaveStatus.defaultExpectation == nil {
+		mmLeaveStatus.defaultExpectation = &TerminationHandlerMockLeaveStatusExpectation{}
+	}
+
+	mmLeaveStatus.defaultExpectation.params = &TerminationHandlerMockLeaveStatusParams{ctx}
+	for _, e := range mmLeaveStatus.expectations {
+		if minimock.Equal(e.params, mmLeaveStatus.defaultExpectation.params) {
+			mmLeaveStatus.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmLeaveStatus.defaultExpectation.params)
+		}
+	}
+
+	return mmLeaveStatus
+}
+
+// Inspect accepts an inspector function that has same arguments as the TerminationHandler.LeaveStatus
+func (mmLeaveStatus *mTerminationHandlerMockLeaveStatus) Inspect(f func(ctx context.Context)) *mTerminationHandlerMockLeaveStatus {
+	if mmLeaveStatus.mock.inspectFuncLeaveStatus != nil {
+		mmLeaveStatus.mock.t.Fatalf("Inspect function is already set for TerminationHandlerMock.LeaveStatus")
+	}
+
+	mmLeaveStatus.mock.inspectFuncLeaveStatus = f
+
+	return mmLeaveStatus
+}
+
+// Return sets up results that will be returned by TerminationHandler.LeaveStatus
+func (mmLeaveStatus *mTerminationHandlerMockLeaveStatus) Return(l1 insolar.LeaveStatus) *TerminationHandlerMock {
+	if mmLeaveStatus.mock.funcLeaveStatus != nil {
+		mmLeaveStatus.mock.t.Fatalf("TerminationHandlerMock.LeaveStatus mock is already set by Set")
+	}
+
+	if mmLeaveStatus.defaultExpectation == nil {
+		mmLeaveStatus.defaultExpectation = &TerminationHandlerMockLeaveStatusExpectation{mock: mmLeaveStatus.mock}
+	}
+	mmLeaveStatus.defaultExpectation.results = &TerminationHandlerMockLeaveStatusResults{l1}
+	return mmLeaveStatus.mock
+}
+
+// Set uses given function f to mock the TerminationHandler.LeaveStatus method
+func (mmLeaveStatus *mTerminationHandlerMockLeaveStatus) Set(f func(ctx context.Context) (l1 insolar.LeaveStatus)) *TerminationHandlerMock {
+	if mmLeaveStatus.defaultExpectation != nil {
+		mmLeaveStatus.mock.t.Fatalf("Default expectation is already set for the TerminationHandler.LeaveStatus method")
+	}
+
+	if len(mmLeaveStatus.expectations) > 0 {
+		mmLeaveStatus.mock.t.Fatalf("Some expectations are already set for the TerminationHandler.LeaveStatus method")
+	}
+
+	mmLeaveStatus.mock.funcLeaveStatus = f
+	return mmLeaveStatus.mock
+}
+
+// When sets expectation for the TerminationHandler.LeaveStatus which will trigger the result defined by the following
+// Then helper
+func (mmLeaveStatus *mTerminationHandlerMockLeaveStatus) When(ctx context.Context) *TerminationHandlerMockLeaveStatusExpectation {
+	if mmLeaveStatus.mock.funcLeaveStatus != nil {
+		mmLeaveStatus.mock.t.Fatalf("TerminationHandlerMock.LeaveStatus mock is already set by Set")
+	}
+
+	expectation := &TerminationHandlerMockLeaveStatusExpectation{
+		mock:   mmLeaveStatus.mock,
+		params: &TerminationHandlerMockLeaveStatusParams{ctx},
+	}
+	mmLeaveStatus.expectations = append(mmLeaveStatus.expectations, expectation)
+	return expectation
+}
+
+// Then sets up TerminationHandler.LeaveStatus return parameters for the expectation previously defined by the When method
+func (e *TerminationHandlerMockLeaveStatusExpectation) Then(l1 insolar.LeaveStatus) *TerminationHandlerMock {
+	e.results = &TerminationHandlerMockLeaveStatusResults{l1}
+	return e.mock
+}
+
+// LeaveStatus implements network.TerminationHandler
+func (mmLeaveStatus *TerminationHandlerMock) LeaveStatus(ctx context.Context) (l1 insolar.LeaveStatus) {
+	mm_atomic.AddUint64(&mmLeaveStatus.beforeLeaveStatusCounter, 1)
+	defer mm_atomic.AddUint64(&mmLeaveStatus.afterLeaveStatusCounter, 1)
+
+	if mmLeaveStatus.inspectFuncLeaveStatus != nil {
+		mmLeaveStatus.inspectFuncLeaveStatus(ctx)
+	}
+
+	mm_params := &TerminationHandlerMockLeaveStatusParams{ctx}
+
+	// Record call args
+	mmLeaveStatus.LeaveStatusMock.mutex.Lock()
+	mmLeaveStatus.LeaveStatusMock.callArgs = append(mmLeaveStatus.LeaveStatusMock.callArgs, mm_params)
+	mmLeaveStatus.LeaveStatusMock.mutex.Unlock()
+
+	for _, e := range mmLeaveStatus.LeaveStatusMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.l1
+		}
+	}
+
+	if mmLeaveStatus.LeaveStatusMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmLeaveStatus.LeaveStatusMock.defaultExpectation.Counter, 1)
+		mm_want := mmLeaveStatus.LeaveStatusMock.defaultExpectation.params
+		mm_got := TerminationHandlerMockLeaveStatusParams{ctx}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmLeaveStatus.t.Errorf("TerminationHandlerMock.LeaveStatus got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmLeaveStatus.LeaveStatusMock.defaultExpectation.results
+		if mm_results == nil {
+			mmLeaveStatus.t.Fatal("No results are set for the TerminationHandlerMock.LeaveStatus")
+		}
+		return (*mm_results).l1
+	}
+	if mmLeaveStatus.funcLeaveStatus != nil {
+		return mmLeaveStatus.funcLeaveStatus(ctx)
+	}
+	mmLeaveStatus.t.Fatalf("Unexpected call to TerminationHandlerMock.LeaveStatus. %v", ctx)
+	return
+}
+
+// LeaveStatusAfterCounter returns a count of finished TerminationHandlerMock.LeaveStatus invocations
+func (mmLeaveStatus *TerminationHandlerMock) LeaveStatusAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLeaveStatus.afterLeaveStatusCounter)
+}
+
+// LeaveStatusBeforeCounter returns a count of TerminationHandlerMock.LeaveStatus invocations
+func (mmLeaveStatus *TerminationHandlerMock) LeaveStatusBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLeaveStatus.beforeLeaveStatusCounter)
+}
+
+// Calls returns a list of arguments used in each call to TerminationHandlerMock.LeaveStatus.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmLeaveStatus *mTerminationHandlerMockLeaveStatus) Calls() []*TerminationHandlerMockLeaveStatusParams {
+	mmLeaveStatus.mutex.RLock()
+
+	argCopy := make([]*TerminationHandlerMockLeaveStatusParams, len(mmLeaveStatus.callArgs))
+	copy(argCopy, mmLeaveStatus.callArgs)
+
+	mmLeaveStatus.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockLeaveStatusDone returns true if the count of the LeaveStatus invocations corresponds
+// the number of defined expectations
+func (m *TerminationHandlerMock) MinimockLeaveStatusDone() bool {
+	for _, e := range m.LeaveStatusMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.LeaveStatusMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterLeaveStatusCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcLeaveStatus != nil && mm_atomic.LoadUint64(&m.afterLeaveStatusCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockLeaveStatusInspect logs each unmet expectation
+func (m *TerminationHandlerMock) MinimockLeaveStatusInspect() {
+	for _, e := range m.LeaveStatusMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TerminationHandlerMock.LeaveStatus with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.LeaveStatusMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterLeaveStatusCounter) < 1 {
+		if m.LeaveStatusMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to TerminationHandlerMock.LeaveStatus")
+		} else {
+			m.t.Errorf("Expected call to TerminationHandlerMock.LeaveStatus with params: %#v", *m.LeaveStatusMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcLeaveStatus != nil && mm_atomic.LoadUint64(&m.afterLeaveStatusCounter) < 1 {
+		m.t.Error("Expected call to TerminationHandlerMock.LeaveStatus")
+	}
+}
+
+type mTerminationHandlerMockLeft struct {
+	mock               *TerminationHandlerMock
+	defaultExpectation *TerminationHandlerMockLeftExpectation
+	expectations       []*TerminationHandlerMockLeftExpectation
+}
+
+// TerminationHandlerMockLeftExpectation specifies expectation struct of the TerminationHandler.Left
+type TerminationHandlerMockLeftExpectation struct {
+	mock *TerminationHandlerMock
+
+	results *TerminationHandlerMockLeftResults
+	Counter uint64
+}
+
+// TerminationHandlerMockLeftResults contains results of the TerminationHandler.Left
+type TerminationHandlerMockLeftResults struct {
+	ch1 <-chan struct {
+	}
+}
+
+// Expect sets up expected params for TerminationHandler.Left
+func (mmLeft *mTerminationHandlerMockLeft) Expect() *mTerminationHandlerMockLeft {
+	if mmLeft.mock.funcLeft != nil {
+		mmLeft.mock.t.Fatalf("TerminationHandlerMock.Left mock is already set by Set")
+	}
+
+	if mmLeft.defaultExpectation == nil {
+		mmLeft.defaultExpectation = &TerminationHandlerMockLeftExpectation{}
+	}
+
+	return mmLeft
+}
+
+// Inspect accepts an inspector function that has same arguments as the TerminationHandler.Left
+func (mmLeft *mTerminationHandlerMockLeft) Inspect(f func()) *mTerminationHandlerMockLeft {
+	if mmLeft.mock.inspectFuncLeft != nil {
+		mmLeft.mock.t.Fatalf("Inspect function is already set for TerminationHandlerMock.Left")
+	}
+
+	mmLeft.mock.inspectFuncLeft = f
+
+	return mmLeft
+}
+
+// Return sets up results that will be returned by TerminationHandler.Left
+func (mmLeft *mTerminationHandlerMockLeft) Return(ch1 <-chan struct {
+}) *TerminationHandlerMock {
+	if mmLeft.mock.funcLeft != nil {
+		mmLeft.mock.t.Fatalf("TerminationHandlerMock.Left mock is already set by Set")
+	}
+
+	if mmLeft.defaultExpectation == nil {
+		mmLeft.defaultExpectation = &TerminationHandlerMockLeftExpectation{mock: mmLeft.mock}
+	}
+	mmLeft.defaultExpectation.results = &TerminationHandlerMockLeftResults{ch1}
+	return mmLeft.mock
+}
+
+// Set uses given function f to mock the TerminationHandler.Left method
+func (mmLeft *mTerminationHandlerMockLeft) Set(f func() (ch1 <-chan struct {
+})) *TerminationHandlerMock {
+	if mmLeft.defaultExpectation != nil {
+		mmLeft.mock.t.Fatalf("Default expectation is already set for the TerminationHandler.Left method")
+	}
+
+	if len(mmLeft.expectations) > 0 {
+		mmLeft.mock.t.Fatalf("Some expectations are already set for the TerminationHandler.Left method")
+	}
+
+	mmLeft.mock.funcLeft = f
+	return mmLeft.mock
+}
+
+// Left implements network.TerminationHandler
+func (mmLeft *TerminationHandlerMock) Left() (ch1 <-chan struct {
+}) {
+	mm_atomic.AddUint64(&mmLeft.beforeLeftCounter, 1)
+	defer mm_atomic.AddUint64(&mmLeft.afterLeftCounter, 1)
+
+	if mmLeft.inspectFuncLeft != nil {
+		mmLeft.inspectFuncLeft()
+	}
+
+	if mmLeft.LeftMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmLeft.LeftMock.defaultExpectation.Counter, 1)
+
+		mm_results := mmLeft.LeftMock.defaultExpectation.results
+		if mm_results == nil {
+			mmLeft.t.Fatal("No results are set for the TerminationHandlerMock.Left")
+		}
+		return (*mm_results).ch1
+	}
+	if mmLeft.funcLeft != nil {
+		return mmLeft.funcLeft()
+	}
+	mmLeft.t.Fatalf("Unexpected call to TerminationHandlerMock.Left.")
+	return
+}
+
+// LeftAfterCounter returns a count of finished TerminationHandlerMock.Left invocations
+func (mmLeft *TerminationHandlerMock) LeftAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLeft.afterLeftCounter)
+}
+
+// LeftBeforeCounter returns a count of TerminationHandlerMock.Left invocations
+func (mmLeft *TerminationHandlerMock) LeftBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLeft.beforeLeftCounter)
+}
+
+// MinimockLeftDone returns true if the count of the Left invocations corresponds
+// the number of defined expectations
+func (m *TerminationHandlerMock) MinimockLeftDone() bool {
+	for _, e := range m.LeftMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.LeftMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterLeftCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcLeft != nil && mm_atomic.LoadUint64(&m.afterLeftCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockLeftInspect logs each unmet expectation
+func (m *TerminationHandlerMock) MinimockLeftInspect() {
+	for _, e := range m.LeftMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Error("Expected call to TerminationHandlerMock.Left")
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.LeftMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterLeftCounter) < 1 {
+		m.t.Error("Expected call to TerminationHandlerMock.Left")
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcLeft != nil && mm_atomic.LoadUint64(&m.afterLeftCounter) < 1 {
+		m.t.Error("Expected call to TerminationHandlerMock.Left")
+	}
+}
+
 type mTerminationHandlerMockOnLeaveApproved struct {
 	mock               *TerminationHandlerMock
 	defaultExpectation *TerminationHandlerMockOnLeaveApprovedExpectation
@@ -307,7 +705,7 @@ func (mmOnLeaveApproved *mTerminationHandlerMockOnLeaveApproved) Return() *Termi
 	return mmOnLeaveApproved.mock
 }
 
-//Set uses given function f to mock the TerminationHandler.OnLeaveApproved method
+// Set uses given function f to mock the TerminationHandler.OnLeaveApproved method
 func (mmOnLeaveApproved *mTerminationHandlerMockOnLeaveApproved) Set(f func(ctx context.Context)) *TerminationHandlerMock {
 	if mmOnLeaveApproved.defaultExpectation != nil {
 		mmOnLeaveApproved.mock.t.Fatalf("Default expectation is already set for the TerminationHandler.OnLeaveApproved method")
@@ -428,6 +826,409 @@ func (m *TerminationHandlerMock) MinimockOnLeaveApprovedInspect() {
 	}
 }
 
+type mTerminationHandlerMockOnLeaveTimeout struct {
+	mock               *TerminationHandlerMock
+	defaultExpectation *TerminationHandlerMockOnLeaveTimeoutExpectation
+	expectations       []*TerminationHandlerMockOnLeaveTimeoutExpectation
+
+	callArgs []*TerminationHandlerMockOnLeaveTimeoutParams
+	mutex    sync.RWMutex
+}
+
+// TerminationHandlerMockOnLeaveTimeoutExpectation specifies expectation struct of the TerminationHandler.OnLeaveTimeout
+type TerminationHandlerMockOnLeaveTimeoutExpectation struct {
+	mock   *TerminationHandlerMock
+	params *TerminationHandlerMockOnLeaveTimeoutParams
+
+	Counter uint64
+}
+
+// TerminationHandlerMockOnLeaveTimeoutParams contains parameters of the TerminationHandler.OnLeaveTimeout
+type TerminationHandlerMockOnLeaveTimeoutParams struct {
+	ctx context.Context
+}
+
+// Expect sets up expected params for TerminationHandler.OnLeaveTimeout
+func (mmOnLeaveTimeout *mTerminationHandlerMockOnLeaveTimeout) Expect(ctx context.Context) *mTerminationHandlerMockOnLeaveTimeout {
+	if mmOnLeaveTimeout.mock.funcOnLeaveTimeout != nil {
+		mmOnLeaveTimeout.mock.t.Fatalf("TerminationHandlerMock.OnLeaveTimeout mock is already set by Set")
+	}
+
+	if mmOnLeaveTimeout.defaultExpectation == nil {
+		mmOnLeaveTimeout.defaultExpectation = &TerminationHandlerMockOnLeaveTimeoutExpectation{}
+	}
+
+	mmOnLeaveTimeout.defaultExpectation.params = &TerminationHandlerMockOnLeaveTimeoutParams{ctx}
+	for _, e := range mmOnLeaveTimeout.expectations {
+		if minimock.Equal(e.params, mmOnLeaveTimeout.defaultExpectation.params) {
+			mmOnLeaveTimeout.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmOnLeaveTimeout.defaultExpectation.params)
+		}
+	}
+
+	return mmOnLeaveTimeout
+}
+
+// Inspect accepts an inspector function that has same arguments as the TerminationHandler.OnLeaveTimeout
+func (mmOnLeaveTimeout *mTerminationHandlerMockOnLeaveTimeout) Inspect(f func(ctx context.Context)) *mTerminationHandlerMockOnLeaveTimeout {
+	if mmOnLeaveTimeout.mock.inspectFuncOnLeaveTimeout != nil {
+		mmOnLeaveTimeout.mock.t.Fatalf("Inspect function is already set for TerminationHandlerMock.OnLeaveTimeout")
+	}
+
+	mmOnLeaveTimeout.mock.inspectFuncOnLeaveTimeout = f
+
+	return mmOnLeaveTimeout
+}
+
+// Return sets up results that will be returned by TerminationHandler.OnLeaveTimeout
+func (mmOnLeaveTimeout *mTerminationHandlerMockOnLeaveTimeout) Return() *TerminationHandlerMock {
+	if mmOnLeaveTimeout.mock.funcOnLeaveTimeout != nil {
+		mmOnLeaveTimeout.mock.t.Fatalf("TerminationHandlerMock.OnLeaveTimeout mock is already set by Set")
+	}
+
+	if mmOnLeaveTimeout.defaultExpectation == nil {
+		mmOnLeaveTimeout.defaultExpectation = &TerminationHandlerMockOnLeaveTimeoutExpectation{mock: mmOnLeaveTimeout.mock}
+	}
+
+	return mmOnLeaveTimeout.mock
+}
+
+// Set uses given function f to mock the TerminationHandler.OnLeaveTimeout method
+func (mmOnLeaveTimeout *mTerminationHandlerMockOnLeaveTimeout) Set(f func(ctx context.Context)) *TerminationHandlerMock {
+	if mmOnLeaveTimeout.defaultExpectation != nil {
+		mmOnLeaveTimeout.mock.t.Fatalf("Default expectation is already set for the TerminationHandler.OnLeaveTimeout method")
+	}
+
+	if len(mmOnLeaveTimeout.expectations) > 0 {
+		mmOnLeaveTimeout.mock.t.Fatalf("Some expectations are already set for the TerminationHandler.OnLeaveTimeout method")
+	}
+
+	mmOnLeaveTimeout.mock.funcOnLeaveTimeout = f
+	return mmOnLeaveTimeout.mock
+}
+
+// OnLeaveTimeout implements network.TerminationHandler
+func (mmOnLeaveTimeout *TerminationHandlerMock) OnLeaveTimeout(ctx context.Context) {
+	mm_atomic.AddUint64(&mmOnLeaveTimeout.beforeOnLeaveTimeoutCounter, 1)
+	defer mm_atomic.AddUint64(&mmOnLeaveTimeout.afterOnLeaveTimeoutCounter, 1)
+
+	if mmOnLeaveTimeout.inspectFuncOnLeaveTimeout != nil {
+		mmOnLeaveTimeout.inspectFuncOnLeaveTimeout(ctx)
+	}
+
+	mm_params := &TerminationHandlerMockOnLeaveTimeoutParams{ctx}
+
+	// Record call args
+	mmOnLeaveTimeout.OnLeaveTimeoutMock.mutex.Lock()
+	mmOnLeaveTimeout.OnLeaveTimeoutMock.callArgs = append(mmOnLeaveTimeout.OnLeaveTimeoutMock.callArgs, mm_params)
+	mmOnLeaveTimeout.OnLeaveTimeoutMock.mutex.Unlock()
+
+	for _, e := range mmOnLeaveTimeout.OnLeaveTimeoutMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return
+		}
+	}
+
+	if mmOnLeaveTimeout.OnLeaveTimeoutMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmOnLeaveTimeout.OnLeaveTimeoutMock.defaultExpectation.Counter, 1)
+		mm_want := mmOnLeaveTimeout.OnLeaveTimeoutMock.defaultExpectation.params
+		mm_got := TerminationHandlerMockOnLeaveTimeoutParams{ctx}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmOnLeaveTimeout.t.Errorf("TerminationHandlerMock.OnLeaveTimeout got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		return
+
+	}
+	if mmOnLeaveTimeout.funcOnLeaveTimeout != nil {
+		mmOnLeaveTimeout.funcOnLeaveTimeout(ctx)
+		return
+	}
+	mmOnLeaveTimeout.t.Fatalf("Unexpected call to TerminationHandlerMock.OnLeaveTimeout. %v", ctx)
+
+}
+
+// OnLeaveTimeoutAfterCounter returns a count of finished TerminationHandlerMock.OnLeaveTimeout invocations
+func (mmOnLeaveTimeout *TerminationHandlerMock) OnLeaveTimeoutAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmOnLeaveTimeout.afterOnLeaveTimeoutCounter)
+}
+
+// OnLeaveTimeoutBeforeCounter returns a count of TerminationHandlerMock.OnLeaveTimeout invocations
+func (mmOnLeaveTimeout *TerminationHandlerMock) OnLeaveTimeoutBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmOnLeaveTimeout.beforeOnLeaveTimeoutCounter)
+}
+
+// Calls returns a list of arguments used in each call to TerminationHandlerMock.OnLeaveTimeout.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmOnLeaveTimeout *mTerminationHandlerMockOnLeaveTimeout) Calls() []*TerminationHandlerMockOnLeaveTimeoutParams {
+	mmOnLeaveTimeout.mutex.RLock()
+
+	argCopy := make([]*TerminationHandlerMockOnLeaveTimeoutParams, len(mmOnLeaveTimeout.callArgs))
+	copy(argCopy, mmOnLeaveTimeout.callArgs)
+
+	mmOnLeaveTimeout.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockOnLeaveTimeoutDone returns true if the count of the OnLeaveTimeout invocations corresponds
+// the number of defined expectations
+func (m *TerminationHandlerMock) MinimockOnLeaveTimeoutDone() bool {
+	for _, e := range m.OnLeaveTimeoutMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.OnLeaveTimeoutMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterOnLeaveTimeoutCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcOnLeaveTimeout != nil && mm_atomic.LoadUint64(&m.afterOnLeaveTimeoutCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockOnLeaveTimeoutInspect logs each unmet expectation
+func (m *TerminationHandlerMock) MinimockOnLeaveTimeoutInspect() {
+	for _, e := range m.OnLeaveTimeoutMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TerminationHandlerMock.OnLeaveTimeout with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.OnLeaveTimeoutMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterOnLeaveTimeoutCounter) < 1 {
+		if m.OnLeaveTimeoutMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to TerminationHandlerMock.OnLeaveTimeout")
+		} else {
+			m.t.Errorf("Expected call to TerminationHandlerMock.OnLeaveTimeout with params: %#v", *m.OnLeaveTimeoutMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcOnLeaveTimeout != nil && mm_atomic.LoadUint64(&m.afterOnLeaveTimeoutCounter) < 1 {
+		m.t.Error("Expected call to TerminationHandlerMock.OnLeaveTimeout")
+	}
+}
+
+type mTerminationHandlerMockRequestLeave struct {
+	mock               *TerminationHandlerMock
+	defaultExpectation *TerminationHandlerMockRequestLeaveExpectation
+	expectations       []*TerminationHandlerMockRequestLeaveExpectation
+
+	callArgs []*TerminationHandlerMockRequestLeaveParams
+	mutex    sync.RWMutex
+}
+
+// TerminationHandlerMockRequestLeaveExpectation specifies expectation struct of the TerminationHandler.RequestLeave
+type TerminationHandlerMockRequestLeaveExpectation struct {
+	mock    *TerminationHandlerMock
+	params  *TerminationHandlerMockRequestLeaveParams
+	results *TerminationHandlerMockRequestLeaveResults
+	Counter uint64
+}
+
+// TerminationHandlerMockRequestLeaveParams contains parameters of the TerminationHandler.RequestLeave
+type TerminationHandlerMockRequestLeaveParams struct {
+	ctx context.Context
+	p1  insolar.PulseNumber
+}
+
+// TerminationHandlerMockRequestLeaveResults contains results of the TerminationHandler.RequestLeave
+type TerminationHandlerMockRequestLeaveResults struct {
+	err error
+}
+
+// Expect sets up expected params for TerminationHandler.RequestLeave
+func (mmRequestLeave *mTerminationHandlerMockRequestLeave) Expect(ctx context.Context, p1 insolar.PulseNumber) *mTerminationHandlerMockRequestLeave {
+	if mmRequestLeave.mock.funcRequestLeave != nil {
+		mmRequestLeave.mock.t.Fatalf("TerminationHandlerMock.RequestLeave mock is already set by Set")
+	}
+
+	if mmRequestLeave.defaultExpectation == nil {
+		mmRequestLeave.defaultExpectation = &TerminationHandlerMockRequestLeaveExpectation{}
+	}
+
+	mmRequestLeave.defaultExpectation.params = &TerminationHandlerMockRequestLeaveParams{ctx, p1}
+	for _, e := range mmRequestLeave.expectations {
+		if minimock.Equal(e.params, mmRequestLeave.defaultExpectation.params) {
+			mmRequestLeave.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRequestLeave.defaultExpectation.params)
+		}
+	}
+
+	return mmRequestLeave
+}
+
+// Inspect accepts an inspector function that has same arguments as the TerminationHandler.RequestLeave
+func (mmRequestLeave *mTerminationHandlerMockRequestLeave) Inspect(f func(ctx context.Context, p1 insolar.PulseNumber)) *mTerminationHandlerMockRequestLeave {
+	if mmRequestLeave.mock.inspectFuncRequestLeave != nil {
+		mmRequestLeave.mock.t.Fatalf("Inspect function is already set for TerminationHandlerMock.RequestLeave")
+	}
+
+	mmRequestLeave.mock.inspectFuncRequestLeave = f
+
+	return mmRequestLeave
+}
+
+// Return sets up results that will be returned by TerminationHandler.RequestLeave
+func (mmRequestLeave *mTerminationHandlerMockRequestLeave) Return(err error) *TerminationHandlerMock {
+	if mmRequestLeave.mock.funcRequestLeave != nil {
+		mmRequestLeave.mock.t.Fatalf("TerminationHandlerMock.RequestLeave mock is already set by Set")
+	}
+
+	if mmRequestLeave.defaultExpectation == nil {
+		mmRequestLeave.defaultExpectation = &TerminationHandlerMockRequestLeaveExpectation{mock: mmRequestLeave.mock}
+	}
+	mmRequestLeave.defaultExpectation.results = &TerminationHandlerMockRequestLeaveResults{err}
+	return mmRequestLeave.mock
+}
+
+// Set uses given function f to mock the TerminationHandler.RequestLeave method
+func (mmRequestLeave *mTerminationHandlerMockRequestLeave) Set(f func(ctx context.Context, p1 insolar.PulseNumber) (err error)) *TerminationHandlerMock {
+	if mmRequestLeave.defaultExpectation != nil {
+		mmRequestLeave.mock.t.Fatalf("Default expectation is already set for the TerminationHandler.RequestLeave method")
+	}
+
+	if len(mmRequestLeave.expectations) > 0 {
+		mmRequestLeave.mock.t.Fatalf("Some expectations are already set for the TerminationHandler.RequestLeave method")
+	}
+
+	mmRequestLeave.mock.funcRequestLeave = f
+	return mmRequestLeave.mock
+}
+
+// When sets expectation for the TerminationHandler.RequestLeave which will trigger the result defined by the following
+// Then helper
+func (mmRequestLeave *mTerminationHandlerMockRequestLeave) When(ctx context.Context, p1 insolar.PulseNumber) *TerminationHandlerMockRequestLeaveExpectation {
+	if mmRequestLeave.mock.funcRequestLeave != nil {
+		mmRequestLeave.mock.t.Fatalf("TerminationHandlerMock.RequestLeave mock is already set by Set")
+	}
+
+	expectation := &TerminationHandlerMockRequestLeaveExpectation{
+		mock:   mmRequestLeave.mock,
+		params: &TerminationHandlerMockRequestLeaveParams{ctx, p1},
+	}
+	mmRequestLeave.expectations = append(mmRequestLeave.expectations, expectation)
+	return expectation
+}
+
+// Then sets up TerminationHandler.RequestLeave return parameters for the expectation previously defined by the When method
+func (e *TerminationHandlerMockRequestLeaveExpectation) Then(err error) *TerminationHandlerMock {
+	e.results = &TerminationHandlerMockRequestLeaveResults{err}
+	return e.mock
+}
+
+// RequestLeave implements network.TerminationHandler
+func (mmRequestLeave *TerminationHandlerMock) RequestLeave(ctx context.Context, p1 insolar.PulseNumber) (err error) {
+	mm_atomic.AddUint64(&mmRequestLeave.beforeRequestLeaveCounter, 1)
+	defer mm_atomic.AddUint64(&mmRequestLeave.afterRequestLeaveCounter, 1)
+
+	if mmRequestLeave.inspectFuncRequestLeave != nil {
+		mmRequestLeave.inspectFuncRequestLeave(ctx, p1)
+	}
+
+	mm_params := &TerminationHandlerMockRequestLeaveParams{ctx, p1}
+
+	// Record call args
+	mmRequestLeave.RequestLeaveMock.mutex.Lock()
+	mmRequestLeave.RequestLeaveMock.callArgs = append(mmRequestLeave.RequestLeaveMock.callArgs, mm_params)
+	mmRequestLeave.RequestLeaveMock.mutex.Unlock()
+
+	for _, e := range mmRequestLeave.RequestLeaveMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRequestLeave.RequestLeaveMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRequestLeave.RequestLeaveMock.defaultExpectation.Counter, 1)
+		mm_want := mmRequestLeave.RequestLeaveMock.defaultExpectation.params
+		mm_got := TerminationHandlerMockRequestLeaveParams{ctx, p1}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRequestLeave.t.Errorf("TerminationHandlerMock.RequestLeave got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRequestLeave.RequestLeaveMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRequestLeave.t.Fatal("No results are set for the TerminationHandlerMock.RequestLeave")
+		}
+		return (*mm_results).err
+	}
+	if mmRequestLeave.funcRequestLeave != nil {
+		return mmRequestLeave.funcRequestLeave(ctx, p1)
+	}
+	mmRequestLeave.t.Fatalf("Unexpected call to TerminationHandlerMock.RequestLeave. %v %v", ctx, p1)
+	return
+}
+
+// RequestLeaveAfterCounter returns a count of finished TerminationHandlerMock.RequestLeave invocations
+func (mmRequestLeave *TerminationHandlerMock) RequestLeaveAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRequestLeave.afterRequestLeaveCounter)
+}
+
+// RequestLeaveBeforeCounter returns a count of TerminationHandlerMock.RequestLeave invocations
+func (mmRequestLeave *TerminationHandlerMock) RequestLeaveBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRequestLeave.beforeRequestLeaveCounter)
+}
+
+// Calls returns a list of arguments used in each call to TerminationHandlerMock.RequestLeave.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRequestLeave *mTerminationHandlerMockRequestLeave) Calls() []*TerminationHandlerMockRequestLeaveParams {
+	mmRequestLeave.mutex.RLock()
+
+	argCopy := make([]*TerminationHandlerMockRequestLeaveParams, len(mmRequestLeave.callArgs))
+	copy(argCopy, mmRequestLeave.callArgs)
+
+	mmRequestLeave.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRequestLeaveDone returns true if the count of the RequestLeave invocations corresponds
+// the number of defined expectations
+func (m *TerminationHandlerMock) MinimockRequestLeaveDone() bool {
+	for _, e := range m.RequestLeaveMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RequestLeaveMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterRequestLeaveCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRequestLeave != nil && mm_atomic.LoadUint64(&m.afterRequestLeaveCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockRequestLeaveInspect logs each unmet expectation
+func (m *TerminationHandlerMock) MinimockRequestLeaveInspect() {
+	for _, e := range m.RequestLeaveMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TerminationHandlerMock.RequestLeave with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RequestLeaveMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterRequestLeaveCounter) < 1 {
+		if m.RequestLeaveMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to TerminationHandlerMock.RequestLeave")
+		} else {
+			m.t.Errorf("Expected call to TerminationHandlerMock.RequestLeave with params: %#v", *m.RequestLeaveMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRequestLeave != nil && mm_atomic.LoadUint64(&m.afterRequestLeaveCounter) < 1 {
+		m.t.Error("Expected call to TerminationHandlerMock.RequestLeave")
+	}
+}
+
 type mTerminationHandlerMockTerminating struct {
 	mock               *TerminationHandlerMock
 	defaultExpectation *TerminationHandlerMockTerminatingExpectation
@@ -484,7 +1285,7 @@ func (mmTerminating *mTerminationHandlerMockTerminating) Return(b1 bool) *Termin
 	return mmTerminating.mock
 }
 
-//Set uses given function f to mock the TerminationHandler.Terminating method
+// Set uses given function f to mock the TerminationHandler.Terminating method
 func (mmTerminating *mTerminationHandlerMockTerminating) Set(f func() (b1 bool)) *TerminationHandlerMock {
 	if mmTerminating.defaultExpectation != nil {
 		mmTerminating.mock.t.Fatalf("Default expectation is already set for the TerminationHandler.Terminating method")
@@ -576,8 +1377,16 @@ func (m *TerminationHandlerMock) MinimockFinish() {
 	if !m.minimockDone() {
 		m.MinimockLeaveInspect()
 
+		m.MinimockLeaveStatusInspect()
+
+		m.MinimockLeftInspect()
+
 		m.MinimockOnLeaveApprovedInspect()
 
+		m.MinimockOnLeaveTimeoutInspect()
+
+		m.MinimockRequestLeaveInspect()
+
 		m.MinimockTerminatingInspect()
 		m.t.FailNow()
 	}
@@ -603,6 +1412,10 @@ func (m *TerminationHandlerMock) minimockDone() bool {
 	done := true
 	return done &&
 		m.MinimockLeaveDone() &&
+		m.MinimockLeaveStatusDone() &&
+		m.MinimockLeftDone() &&
 		m.MinimockOnLeaveApprovedDone() &&
+		m.MinimockOnLeaveTimeoutDone() &&
+		m.MinimockRequestLeaveDone() &&
 		m.MinimockTerminatingDone()
 }